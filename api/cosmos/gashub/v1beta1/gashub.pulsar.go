@@ -4,6 +4,7 @@ package gashubv1beta1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -18,6 +19,8 @@ var (
 	md_Params                  protoreflect.MessageDescriptor
 	fd_Params_max_tx_size      protoreflect.FieldDescriptor
 	fd_Params_min_gas_per_byte protoreflect.FieldDescriptor
+	fd_Params_fee_market       protoreflect.FieldDescriptor
+	fd_Params_default_msg_gas  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +28,8 @@ func init() {
 	md_Params = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("Params")
 	fd_Params_max_tx_size = md_Params.Fields().ByName("max_tx_size")
 	fd_Params_min_gas_per_byte = md_Params.Fields().ByName("min_gas_per_byte")
+	fd_Params_fee_market = md_Params.Fields().ByName("fee_market")
+	fd_Params_default_msg_gas = md_Params.Fields().ByName("default_msg_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -104,6 +109,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.FeeMarket != nil {
+		value := protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
+		if !f(fd_Params_fee_market, value) {
+			return
+		}
+	}
+	if x.DefaultMsgGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DefaultMsgGas)
+		if !f(fd_Params_default_msg_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +140,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxTxSize != uint64(0)
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		return x.MinGasPerByte != uint64(0)
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		return x.FeeMarket != nil
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		return x.DefaultMsgGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -143,6 +164,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxTxSize = uint64(0)
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		x.MinGasPerByte = uint64(0)
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		x.FeeMarket = nil
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		x.DefaultMsgGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -165,6 +190,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		value := x.MinGasPerByte
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		value := x.FeeMarket
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		value := x.DefaultMsgGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -189,6 +220,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxTxSize = value.Uint()
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		x.MinGasPerByte = value.Uint()
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		x.FeeMarket = value.Message().Interface().(*FeeMarketParams)
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		x.DefaultMsgGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -209,10 +244,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		if x.FeeMarket == nil {
+			x.FeeMarket = new(FeeMarketParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
 	case "cosmos.gashub.v1beta1.Params.max_tx_size":
 		panic(fmt.Errorf("field max_tx_size of message cosmos.gashub.v1beta1.Params is not mutable"))
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		panic(fmt.Errorf("field min_gas_per_byte of message cosmos.gashub.v1beta1.Params is not mutable"))
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		panic(fmt.Errorf("field default_msg_gas of message cosmos.gashub.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -230,6 +272,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.gashub.v1beta1.Params.min_gas_per_byte":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gashub.v1beta1.Params.fee_market":
+		m := new(FeeMarketParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gashub.v1beta1.Params.default_msg_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.Params"))
@@ -305,6 +352,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MinGasPerByte != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinGasPerByte))
 		}
+		if x.FeeMarket != nil {
+			l = options.Size(x.FeeMarket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DefaultMsgGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultMsgGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -334,6 +388,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DefaultMsgGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultMsgGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FeeMarket != nil {
+			encoded, err := options.Marshal(x.FeeMarket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.MinGasPerByte != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinGasPerByte))
 			i--
@@ -431,6 +504,61 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeMarket == nil {
+					x.FeeMarket = &FeeMarketParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeMarket); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultMsgGas", wireType)
+				}
+				x.DefaultMsgGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultMsgGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -467,33 +595,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgGasParams                      protoreflect.MessageDescriptor
-	fd_MsgGasParams_msg_type_url         protoreflect.FieldDescriptor
-	fd_MsgGasParams_fixed_type           protoreflect.FieldDescriptor
-	fd_MsgGasParams_grant_type           protoreflect.FieldDescriptor
-	fd_MsgGasParams_multi_send_type      protoreflect.FieldDescriptor
-	fd_MsgGasParams_grant_allowance_type protoreflect.FieldDescriptor
+	md_FeeMarketParams                             protoreflect.MessageDescriptor
+	fd_FeeMarketParams_enabled                     protoreflect.FieldDescriptor
+	fd_FeeMarketParams_denom                       protoreflect.FieldDescriptor
+	fd_FeeMarketParams_min_base_fee                protoreflect.FieldDescriptor
+	fd_FeeMarketParams_target_block_gas            protoreflect.FieldDescriptor
+	fd_FeeMarketParams_base_fee_change_denominator protoreflect.FieldDescriptor
+	fd_FeeMarketParams_fee_recipient               protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gashub_v1beta1_gashub_proto_init()
-	md_MsgGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams")
-	fd_MsgGasParams_msg_type_url = md_MsgGasParams.Fields().ByName("msg_type_url")
-	fd_MsgGasParams_fixed_type = md_MsgGasParams.Fields().ByName("fixed_type")
-	fd_MsgGasParams_grant_type = md_MsgGasParams.Fields().ByName("grant_type")
-	fd_MsgGasParams_multi_send_type = md_MsgGasParams.Fields().ByName("multi_send_type")
-	fd_MsgGasParams_grant_allowance_type = md_MsgGasParams.Fields().ByName("grant_allowance_type")
+	md_FeeMarketParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("FeeMarketParams")
+	fd_FeeMarketParams_enabled = md_FeeMarketParams.Fields().ByName("enabled")
+	fd_FeeMarketParams_denom = md_FeeMarketParams.Fields().ByName("denom")
+	fd_FeeMarketParams_min_base_fee = md_FeeMarketParams.Fields().ByName("min_base_fee")
+	fd_FeeMarketParams_target_block_gas = md_FeeMarketParams.Fields().ByName("target_block_gas")
+	fd_FeeMarketParams_base_fee_change_denominator = md_FeeMarketParams.Fields().ByName("base_fee_change_denominator")
+	fd_FeeMarketParams_fee_recipient = md_FeeMarketParams.Fields().ByName("fee_recipient")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgGasParams)(nil)
+var _ protoreflect.Message = (*fastReflection_FeeMarketParams)(nil)
 
-type fastReflection_MsgGasParams MsgGasParams
+type fastReflection_FeeMarketParams FeeMarketParams
 
-func (x *MsgGasParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgGasParams)(x)
+func (x *FeeMarketParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(x)
 }
 
-func (x *MsgGasParams) slowProtoReflect() protoreflect.Message {
+func (x *FeeMarketParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,43 +635,43 @@ func (x *MsgGasParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgGasParams_messageType fastReflection_MsgGasParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgGasParams_messageType{}
+var _fastReflection_FeeMarketParams_messageType fastReflection_FeeMarketParams_messageType
+var _ protoreflect.MessageType = fastReflection_FeeMarketParams_messageType{}
 
-type fastReflection_MsgGasParams_messageType struct{}
+type fastReflection_FeeMarketParams_messageType struct{}
 
-func (x fastReflection_MsgGasParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgGasParams)(nil)
+func (x fastReflection_FeeMarketParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(nil)
 }
-func (x fastReflection_MsgGasParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams)
+func (x fastReflection_FeeMarketParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
 }
-func (x fastReflection_MsgGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams
+func (x fastReflection_FeeMarketParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgGasParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams
+func (x *fastReflection_FeeMarketParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgGasParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgGasParams_messageType
+func (x *fastReflection_FeeMarketParams) Type() protoreflect.MessageType {
+	return _fastReflection_FeeMarketParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgGasParams) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams)
+func (x *fastReflection_FeeMarketParams) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgGasParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgGasParams)(x)
+func (x *fastReflection_FeeMarketParams) Interface() protoreflect.ProtoMessage {
+	return (*FeeMarketParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -549,39 +679,41 @@ func (x *fastReflection_MsgGasParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MsgTypeUrl != "" {
-		value := protoreflect.ValueOfString(x.MsgTypeUrl)
-		if !f(fd_MsgGasParams_msg_type_url, value) {
+func (x *fastReflection_FeeMarketParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_FeeMarketParams_enabled, value) {
 			return
 		}
 	}
-	if x.GasParams != nil {
-		switch o := x.GasParams.(type) {
-		case *MsgGasParams_FixedType:
-			v := o.FixedType
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_MsgGasParams_fixed_type, value) {
-				return
-			}
-		case *MsgGasParams_GrantType:
-			v := o.GrantType
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_MsgGasParams_grant_type, value) {
-				return
-			}
-		case *MsgGasParams_MultiSendType:
-			v := o.MultiSendType
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_MsgGasParams_multi_send_type, value) {
-				return
-			}
-		case *MsgGasParams_GrantAllowanceType:
-			v := o.GrantAllowanceType
-			value := protoreflect.ValueOfMessage(v.ProtoReflect())
-			if !f(fd_MsgGasParams_grant_allowance_type, value) {
-				return
-			}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeMarketParams_denom, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_FeeMarketParams_min_base_fee, value) {
+			return
+		}
+	}
+	if x.TargetBlockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetBlockGas)
+		if !f(fd_FeeMarketParams_target_block_gas, value) {
+			return
+		}
+	}
+	if x.BaseFeeChangeDenominator != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BaseFeeChangeDenominator)
+		if !f(fd_FeeMarketParams_base_fee_change_denominator, value) {
+			return
+		}
+	}
+	if x.FeeRecipient != "" {
+		value := protoreflect.ValueOfString(x.FeeRecipient)
+		if !f(fd_FeeMarketParams_fee_recipient, value) {
+			return
 		}
 	}
 }
@@ -597,47 +729,25 @@ func (x *fastReflection_MsgGasParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeMarketParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
-		return x.MsgTypeUrl != ""
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		if x.GasParams == nil {
-			return false
-		} else if _, ok := x.GasParams.(*MsgGasParams_FixedType); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		if x.GasParams == nil {
-			return false
-		} else if _, ok := x.GasParams.(*MsgGasParams_GrantType); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		if x.GasParams == nil {
-			return false
-		} else if _, ok := x.GasParams.(*MsgGasParams_MultiSendType); ok {
-			return true
-		} else {
-			return false
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		if x.GasParams == nil {
-			return false
-		} else if _, ok := x.GasParams.(*MsgGasParams_GrantAllowanceType); ok {
-			return true
-		} else {
-			return false
-		}
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		return x.Enabled != false
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		return x.Denom != ""
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		return x.MinBaseFee != ""
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		return x.TargetBlockGas != uint64(0)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		return x.BaseFeeChangeDenominator != uint32(0)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
+		return x.FeeRecipient != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -647,23 +757,25 @@ func (x *fastReflection_MsgGasParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeMarketParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
-		x.MsgTypeUrl = ""
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		x.GasParams = nil
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		x.GasParams = nil
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		x.GasParams = nil
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		x.GasParams = nil
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		x.Enabled = false
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		x.Denom = ""
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = ""
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		x.TargetBlockGas = uint64(0)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = uint32(0)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
+		x.FeeRecipient = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -673,48 +785,31 @@ func (x *fastReflection_MsgGasParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
-		value := x.MsgTypeUrl
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		value := x.TargetBlockGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		value := x.BaseFeeChangeDenominator
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
+		value := x.FeeRecipient
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		if x.GasParams == nil {
-			return protoreflect.ValueOfMessage((*MsgGasParams_FixedGasParams)(nil).ProtoReflect())
-		} else if v, ok := x.GasParams.(*MsgGasParams_FixedType); ok {
-			return protoreflect.ValueOfMessage(v.FixedType.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*MsgGasParams_FixedGasParams)(nil).ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		if x.GasParams == nil {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		} else if v, ok := x.GasParams.(*MsgGasParams_GrantType); ok {
-			return protoreflect.ValueOfMessage(v.GrantType.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		if x.GasParams == nil {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		} else if v, ok := x.GasParams.(*MsgGasParams_MultiSendType); ok {
-			return protoreflect.ValueOfMessage(v.MultiSendType.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		if x.GasParams == nil {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		} else if v, ok := x.GasParams.(*MsgGasParams_GrantAllowanceType); ok {
-			return protoreflect.ValueOfMessage(v.GrantAllowanceType.ProtoReflect())
-		} else {
-			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
-		}
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -728,27 +823,25 @@ func (x *fastReflection_MsgGasParams) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeMarketParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
-		x.MsgTypeUrl = value.Interface().(string)
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		cv := value.Message().Interface().(*MsgGasParams_FixedGasParams)
-		x.GasParams = &MsgGasParams_FixedType{FixedType: cv}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
-		x.GasParams = &MsgGasParams_GrantType{GrantType: cv}
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
-		x.GasParams = &MsgGasParams_MultiSendType{MultiSendType: cv}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
-		x.GasParams = &MsgGasParams_GrantAllowanceType{GrantAllowanceType: cv}
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		x.Enabled = value.Bool()
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		x.TargetBlockGas = value.Uint()
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = uint32(value.Uint())
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
+		x.FeeRecipient = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -762,130 +855,60 @@ func (x *fastReflection_MsgGasParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		if x.GasParams == nil {
-			value := &MsgGasParams_FixedGasParams{}
-			oneofValue := &MsgGasParams_FixedType{FixedType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.GasParams.(type) {
-		case *MsgGasParams_FixedType:
-			return protoreflect.ValueOfMessage(m.FixedType.ProtoReflect())
-		default:
-			value := &MsgGasParams_FixedGasParams{}
-			oneofValue := &MsgGasParams_FixedType{FixedType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		if x.GasParams == nil {
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_GrantType{GrantType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.GasParams.(type) {
-		case *MsgGasParams_GrantType:
-			return protoreflect.ValueOfMessage(m.GrantType.ProtoReflect())
-		default:
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_GrantType{GrantType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		if x.GasParams == nil {
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_MultiSendType{MultiSendType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.GasParams.(type) {
-		case *MsgGasParams_MultiSendType:
-			return protoreflect.ValueOfMessage(m.MultiSendType.ProtoReflect())
-		default:
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_MultiSendType{MultiSendType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		if x.GasParams == nil {
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_GrantAllowanceType{GrantAllowanceType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-		switch m := x.GasParams.(type) {
-		case *MsgGasParams_GrantAllowanceType:
-			return protoreflect.ValueOfMessage(m.GrantAllowanceType.ProtoReflect())
-		default:
-			value := &MsgGasParams_DynamicGasParams{}
-			oneofValue := &MsgGasParams_GrantAllowanceType{GrantAllowanceType: value}
-			x.GasParams = oneofValue
-			return protoreflect.ValueOfMessage(value.ProtoReflect())
-		}
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
-		panic(fmt.Errorf("field msg_type_url of message cosmos.gashub.v1beta1.MsgGasParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		panic(fmt.Errorf("field enabled of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		panic(fmt.Errorf("field denom of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		panic(fmt.Errorf("field target_block_gas of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		panic(fmt.Errorf("field base_fee_change_denominator of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
+		panic(fmt.Errorf("field fee_recipient of message cosmos.gashub.v1beta1.FeeMarketParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+	case "cosmos.gashub.v1beta1.FeeMarketParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gashub.v1beta1.FeeMarketParams.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gashub.v1beta1.FeeMarketParams.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gashub.v1beta1.FeeMarketParams.target_block_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.gashub.v1beta1.FeeMarketParams.fee_recipient":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
-		value := &MsgGasParams_FixedGasParams{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
-		value := &MsgGasParams_DynamicGasParams{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
-		value := &MsgGasParams_DynamicGasParams{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
-		value := &MsgGasParams_DynamicGasParams{}
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeMarketParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.gas_params":
-		if x.GasParams == nil {
-			return nil
-		}
-		switch x.GasParams.(type) {
-		case *MsgGasParams_FixedType:
-			return x.Descriptor().Fields().ByName("fixed_type")
-		case *MsgGasParams_GrantType:
-			return x.Descriptor().Fields().ByName("grant_type")
-		case *MsgGasParams_MultiSendType:
-			return x.Descriptor().Fields().ByName("multi_send_type")
-		case *MsgGasParams_GrantAllowanceType:
-			return x.Descriptor().Fields().ByName("grant_allowance_type")
-		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.FeeMarketParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -893,7 +916,7 @@ func (x *fastReflection_MsgGasParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgGasParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeMarketParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -904,7 +927,7 @@ func (x *fastReflection_MsgGasParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeMarketParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -916,7 +939,7 @@ func (x *fastReflection_MsgGasParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgGasParams) IsValid() bool {
+func (x *fastReflection_FeeMarketParams) IsValid() bool {
 	return x != nil
 }
 
@@ -926,9 +949,9 @@ func (x *fastReflection_MsgGasParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeMarketParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgGasParams)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -940,34 +963,25 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.MsgTypeUrl)
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		switch x := x.GasParams.(type) {
-		case *MsgGasParams_FixedType:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.FixedType)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *MsgGasParams_GrantType:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.GrantType)
-			n += 1 + l + runtime.Sov(uint64(l))
-		case *MsgGasParams_MultiSendType:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.MultiSendType)
+		l = len(x.MinBaseFee)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
-		case *MsgGasParams_GrantAllowanceType:
-			if x == nil {
-				break
-			}
-			l = options.Size(x.GrantAllowanceType)
+		}
+		if x.TargetBlockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetBlockGas))
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeChangeDenominator))
+		}
+		l = len(x.FeeRecipient)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -980,7 +994,7 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -999,66 +1013,46 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		switch x := x.GasParams.(type) {
-		case *MsgGasParams_FixedType:
-			encoded, err := options.Marshal(x.FixedType)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.FeeRecipient) > 0 {
+			i -= len(x.FeeRecipient)
+			copy(dAtA[i:], x.FeeRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeRecipient)))
 			i--
-			dAtA[i] = 0x12
-		case *MsgGasParams_GrantType:
-			encoded, err := options.Marshal(x.GrantType)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[i] = 0x32
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeChangeDenominator))
 			i--
-			dAtA[i] = 0x1a
-		case *MsgGasParams_MultiSendType:
-			encoded, err := options.Marshal(x.MultiSendType)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[i] = 0x28
+		}
+		if x.TargetBlockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetBlockGas))
 			i--
-			dAtA[i] = 0x22
-		case *MsgGasParams_GrantAllowanceType:
-			encoded, err := options.Marshal(x.GrantAllowanceType)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[i] = 0x20
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
-		if len(x.MsgTypeUrl) > 0 {
-			i -= len(x.MsgTypeUrl)
-			copy(dAtA[i:], x.MsgTypeUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1071,7 +1065,7 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1103,15 +1097,35 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1139,13 +1153,13 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1155,32 +1169,29 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &MsgGasParams_FixedGasParams{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.GasParams = &MsgGasParams_FixedType{v}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantType", wireType)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
 				}
-				var msglen int
+				x.TargetBlockGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1190,32 +1201,35 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.TargetBlockGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &MsgGasParams_DynamicGasParams{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.BaseFeeChangeDenominator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.GasParams = &MsgGasParams_GrantType{v}
-				iNdEx = postIndex
-			case 4:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiSendType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1225,32 +1239,1368 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgGasParams                      protoreflect.MessageDescriptor
+	fd_MsgGasParams_msg_type_url         protoreflect.FieldDescriptor
+	fd_MsgGasParams_fixed_type           protoreflect.FieldDescriptor
+	fd_MsgGasParams_grant_type           protoreflect.FieldDescriptor
+	fd_MsgGasParams_multi_send_type      protoreflect.FieldDescriptor
+	fd_MsgGasParams_grant_allowance_type protoreflect.FieldDescriptor
+	fd_MsgGasParams_wrapper_type         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gashub_v1beta1_gashub_proto_init()
+	md_MsgGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams")
+	fd_MsgGasParams_msg_type_url = md_MsgGasParams.Fields().ByName("msg_type_url")
+	fd_MsgGasParams_fixed_type = md_MsgGasParams.Fields().ByName("fixed_type")
+	fd_MsgGasParams_grant_type = md_MsgGasParams.Fields().ByName("grant_type")
+	fd_MsgGasParams_multi_send_type = md_MsgGasParams.Fields().ByName("multi_send_type")
+	fd_MsgGasParams_grant_allowance_type = md_MsgGasParams.Fields().ByName("grant_allowance_type")
+	fd_MsgGasParams_wrapper_type = md_MsgGasParams.Fields().ByName("wrapper_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgGasParams)(nil)
+
+type fastReflection_MsgGasParams MsgGasParams
+
+func (x *MsgGasParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGasParams)(x)
+}
+
+func (x *MsgGasParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgGasParams_messageType fastReflection_MsgGasParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGasParams_messageType{}
+
+type fastReflection_MsgGasParams_messageType struct{}
+
+func (x fastReflection_MsgGasParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGasParams)(nil)
+}
+func (x fastReflection_MsgGasParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams)
+}
+func (x fastReflection_MsgGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgGasParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgGasParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGasParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgGasParams) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgGasParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgGasParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgGasParams_msg_type_url, value) {
+			return
+		}
+	}
+	if x.GasParams != nil {
+		switch o := x.GasParams.(type) {
+		case *MsgGasParams_FixedType:
+			v := o.FixedType
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgGasParams_fixed_type, value) {
+				return
+			}
+		case *MsgGasParams_GrantType:
+			v := o.GrantType
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgGasParams_grant_type, value) {
+				return
+			}
+		case *MsgGasParams_MultiSendType:
+			v := o.MultiSendType
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgGasParams_multi_send_type, value) {
+				return
+			}
+		case *MsgGasParams_GrantAllowanceType:
+			v := o.GrantAllowanceType
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgGasParams_grant_allowance_type, value) {
+				return
+			}
+		case *MsgGasParams_WrapperType:
+			v := o.WrapperType
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgGasParams_wrapper_type, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		if x.GasParams == nil {
+			return false
+		} else if _, ok := x.GasParams.(*MsgGasParams_FixedType); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		if x.GasParams == nil {
+			return false
+		} else if _, ok := x.GasParams.(*MsgGasParams_GrantType); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		if x.GasParams == nil {
+			return false
+		} else if _, ok := x.GasParams.(*MsgGasParams_MultiSendType); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		if x.GasParams == nil {
+			return false
+		} else if _, ok := x.GasParams.(*MsgGasParams_GrantAllowanceType); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		if x.GasParams == nil {
+			return false
+		} else if _, ok := x.GasParams.(*MsgGasParams_WrapperType); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		x.GasParams = nil
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		x.GasParams = nil
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		x.GasParams = nil
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		x.GasParams = nil
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		x.GasParams = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		if x.GasParams == nil {
+			return protoreflect.ValueOfMessage((*MsgGasParams_FixedGasParams)(nil).ProtoReflect())
+		} else if v, ok := x.GasParams.(*MsgGasParams_FixedType); ok {
+			return protoreflect.ValueOfMessage(v.FixedType.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgGasParams_FixedGasParams)(nil).ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		if x.GasParams == nil {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		} else if v, ok := x.GasParams.(*MsgGasParams_GrantType); ok {
+			return protoreflect.ValueOfMessage(v.GrantType.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		if x.GasParams == nil {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		} else if v, ok := x.GasParams.(*MsgGasParams_MultiSendType); ok {
+			return protoreflect.ValueOfMessage(v.MultiSendType.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		if x.GasParams == nil {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		} else if v, ok := x.GasParams.(*MsgGasParams_GrantAllowanceType); ok {
+			return protoreflect.ValueOfMessage(v.GrantAllowanceType.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgGasParams_DynamicGasParams)(nil).ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		if x.GasParams == nil {
+			return protoreflect.ValueOfMessage((*MsgGasParams_WrapperGasParams)(nil).ProtoReflect())
+		} else if v, ok := x.GasParams.(*MsgGasParams_WrapperType); ok {
+			return protoreflect.ValueOfMessage(v.WrapperType.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgGasParams_WrapperGasParams)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		cv := value.Message().Interface().(*MsgGasParams_FixedGasParams)
+		x.GasParams = &MsgGasParams_FixedType{FixedType: cv}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
+		x.GasParams = &MsgGasParams_GrantType{GrantType: cv}
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
+		x.GasParams = &MsgGasParams_MultiSendType{MultiSendType: cv}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		cv := value.Message().Interface().(*MsgGasParams_DynamicGasParams)
+		x.GasParams = &MsgGasParams_GrantAllowanceType{GrantAllowanceType: cv}
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		cv := value.Message().Interface().(*MsgGasParams_WrapperGasParams)
+		x.GasParams = &MsgGasParams_WrapperType{WrapperType: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		if x.GasParams == nil {
+			value := &MsgGasParams_FixedGasParams{}
+			oneofValue := &MsgGasParams_FixedType{FixedType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.GasParams.(type) {
+		case *MsgGasParams_FixedType:
+			return protoreflect.ValueOfMessage(m.FixedType.ProtoReflect())
+		default:
+			value := &MsgGasParams_FixedGasParams{}
+			oneofValue := &MsgGasParams_FixedType{FixedType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		if x.GasParams == nil {
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_GrantType{GrantType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.GasParams.(type) {
+		case *MsgGasParams_GrantType:
+			return protoreflect.ValueOfMessage(m.GrantType.ProtoReflect())
+		default:
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_GrantType{GrantType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		if x.GasParams == nil {
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_MultiSendType{MultiSendType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.GasParams.(type) {
+		case *MsgGasParams_MultiSendType:
+			return protoreflect.ValueOfMessage(m.MultiSendType.ProtoReflect())
+		default:
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_MultiSendType{MultiSendType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		if x.GasParams == nil {
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_GrantAllowanceType{GrantAllowanceType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.GasParams.(type) {
+		case *MsgGasParams_GrantAllowanceType:
+			return protoreflect.ValueOfMessage(m.GrantAllowanceType.ProtoReflect())
+		default:
+			value := &MsgGasParams_DynamicGasParams{}
+			oneofValue := &MsgGasParams_GrantAllowanceType{GrantAllowanceType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		if x.GasParams == nil {
+			value := &MsgGasParams_WrapperGasParams{}
+			oneofValue := &MsgGasParams_WrapperType{WrapperType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.GasParams.(type) {
+		case *MsgGasParams_WrapperType:
+			return protoreflect.ValueOfMessage(m.WrapperType.ProtoReflect())
+		default:
+			value := &MsgGasParams_WrapperGasParams{}
+			oneofValue := &MsgGasParams_WrapperType{WrapperType: value}
+			x.GasParams = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message cosmos.gashub.v1beta1.MsgGasParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gashub.v1beta1.MsgGasParams.fixed_type":
+		value := &MsgGasParams_FixedGasParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_type":
+		value := &MsgGasParams_DynamicGasParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gashub.v1beta1.MsgGasParams.multi_send_type":
+		value := &MsgGasParams_DynamicGasParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type":
+		value := &MsgGasParams_DynamicGasParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gashub.v1beta1.MsgGasParams.wrapper_type":
+		value := &MsgGasParams_WrapperGasParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.gas_params":
+		if x.GasParams == nil {
+			return nil
+		}
+		switch x.GasParams.(type) {
+		case *MsgGasParams_FixedType:
+			return x.Descriptor().Fields().ByName("fixed_type")
+		case *MsgGasParams_GrantType:
+			return x.Descriptor().Fields().ByName("grant_type")
+		case *MsgGasParams_MultiSendType:
+			return x.Descriptor().Fields().ByName("multi_send_type")
+		case *MsgGasParams_GrantAllowanceType:
+			return x.Descriptor().Fields().ByName("grant_allowance_type")
+		case *MsgGasParams_WrapperType:
+			return x.Descriptor().Fields().ByName("wrapper_type")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgGasParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgGasParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgGasParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		switch x := x.GasParams.(type) {
+		case *MsgGasParams_FixedType:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.FixedType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *MsgGasParams_GrantType:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GrantType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *MsgGasParams_MultiSendType:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.MultiSendType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *MsgGasParams_GrantAllowanceType:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GrantAllowanceType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *MsgGasParams_WrapperType:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.WrapperType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGasParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.GasParams.(type) {
+		case *MsgGasParams_FixedType:
+			encoded, err := options.Marshal(x.FixedType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *MsgGasParams_GrantType:
+			encoded, err := options.Marshal(x.GrantType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		case *MsgGasParams_MultiSendType:
+			encoded, err := options.Marshal(x.MultiSendType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *MsgGasParams_GrantAllowanceType:
+			encoded, err := options.Marshal(x.GrantAllowanceType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		case *MsgGasParams_WrapperType:
+			encoded, err := options.Marshal(x.WrapperType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGasParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgGasParams_FixedGasParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.GasParams = &MsgGasParams_FixedType{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgGasParams_DynamicGasParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.GasParams = &MsgGasParams_GrantType{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiSendType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgGasParams_DynamicGasParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.GasParams = &MsgGasParams_MultiSendType{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantAllowanceType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgGasParams_DynamicGasParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.GasParams = &MsgGasParams_GrantAllowanceType{v}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WrapperType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgGasParams_WrapperGasParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.GasParams = &MsgGasParams_WrapperType{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgGasParams_FixedGasParams           protoreflect.MessageDescriptor
+	fd_MsgGasParams_FixedGasParams_fixed_gas protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gashub_v1beta1_gashub_proto_init()
+	md_MsgGasParams_FixedGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams").Messages().ByName("FixedGasParams")
+	fd_MsgGasParams_FixedGasParams_fixed_gas = md_MsgGasParams_FixedGasParams.Fields().ByName("fixed_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgGasParams_FixedGasParams)(nil)
+
+type fastReflection_MsgGasParams_FixedGasParams MsgGasParams_FixedGasParams
+
+func (x *MsgGasParams_FixedGasParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_FixedGasParams)(x)
+}
+
+func (x *MsgGasParams_FixedGasParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgGasParams_FixedGasParams_messageType fastReflection_MsgGasParams_FixedGasParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGasParams_FixedGasParams_messageType{}
+
+type fastReflection_MsgGasParams_FixedGasParams_messageType struct{}
+
+func (x fastReflection_MsgGasParams_FixedGasParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_FixedGasParams)(nil)
+}
+func (x fastReflection_MsgGasParams_FixedGasParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_FixedGasParams)
+}
+func (x fastReflection_MsgGasParams_FixedGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_FixedGasParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_FixedGasParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGasParams_FixedGasParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgGasParams_FixedGasParams) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_FixedGasParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgGasParams_FixedGasParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FixedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FixedGas)
+		if !f(fd_MsgGasParams_FixedGasParams_fixed_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		return x.FixedGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		x.FixedGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		value := x.FixedGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		x.FixedGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams_FixedGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		panic(fmt.Errorf("field fixed_gas of message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgGasParams_FixedGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgGasParams_FixedGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgGasParams_FixedGasParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGasParams_FixedGasParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgGasParams_FixedGasParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FixedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.FixedGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FixedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FixedGas))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &MsgGasParams_DynamicGasParams{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.GasParams = &MsgGasParams_MultiSendType{v}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantAllowanceType", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_FixedGasParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_FixedGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedGas", wireType)
 				}
-				var msglen int
+				x.FixedGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1260,27 +2610,11 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.FixedGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				v := &MsgGasParams_DynamicGasParams{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.GasParams = &MsgGasParams_GrantAllowanceType{v}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1317,26 +2651,28 @@ func (x *fastReflection_MsgGasParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgGasParams_FixedGasParams           protoreflect.MessageDescriptor
-	fd_MsgGasParams_FixedGasParams_fixed_gas protoreflect.FieldDescriptor
+	md_MsgGasParams_DynamicGasParams              protoreflect.MessageDescriptor
+	fd_MsgGasParams_DynamicGasParams_fixed_gas    protoreflect.FieldDescriptor
+	fd_MsgGasParams_DynamicGasParams_gas_per_item protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gashub_v1beta1_gashub_proto_init()
-	md_MsgGasParams_FixedGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams").Messages().ByName("FixedGasParams")
-	fd_MsgGasParams_FixedGasParams_fixed_gas = md_MsgGasParams_FixedGasParams.Fields().ByName("fixed_gas")
+	md_MsgGasParams_DynamicGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams").Messages().ByName("DynamicGasParams")
+	fd_MsgGasParams_DynamicGasParams_fixed_gas = md_MsgGasParams_DynamicGasParams.Fields().ByName("fixed_gas")
+	fd_MsgGasParams_DynamicGasParams_gas_per_item = md_MsgGasParams_DynamicGasParams.Fields().ByName("gas_per_item")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgGasParams_FixedGasParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgGasParams_DynamicGasParams)(nil)
 
-type fastReflection_MsgGasParams_FixedGasParams MsgGasParams_FixedGasParams
+type fastReflection_MsgGasParams_DynamicGasParams MsgGasParams_DynamicGasParams
 
-func (x *MsgGasParams_FixedGasParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgGasParams_FixedGasParams)(x)
+func (x *MsgGasParams_DynamicGasParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_DynamicGasParams)(x)
 }
 
-func (x *MsgGasParams_FixedGasParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2]
+func (x *MsgGasParams_DynamicGasParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,43 +2683,43 @@ func (x *MsgGasParams_FixedGasParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgGasParams_FixedGasParams_messageType fastReflection_MsgGasParams_FixedGasParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgGasParams_FixedGasParams_messageType{}
+var _fastReflection_MsgGasParams_DynamicGasParams_messageType fastReflection_MsgGasParams_DynamicGasParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGasParams_DynamicGasParams_messageType{}
 
-type fastReflection_MsgGasParams_FixedGasParams_messageType struct{}
+type fastReflection_MsgGasParams_DynamicGasParams_messageType struct{}
 
-func (x fastReflection_MsgGasParams_FixedGasParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgGasParams_FixedGasParams)(nil)
+func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_DynamicGasParams)(nil)
 }
-func (x fastReflection_MsgGasParams_FixedGasParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams_FixedGasParams)
+func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_DynamicGasParams)
 }
-func (x fastReflection_MsgGasParams_FixedGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams_FixedGasParams
+func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_DynamicGasParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams_FixedGasParams
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_DynamicGasParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgGasParams_FixedGasParams_messageType
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGasParams_DynamicGasParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgGasParams_FixedGasParams) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams_FixedGasParams)
+func (x *fastReflection_MsgGasParams_DynamicGasParams) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_DynamicGasParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgGasParams_FixedGasParams)(x)
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgGasParams_DynamicGasParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1391,10 +2727,16 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.FixedGas != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.FixedGas)
-		if !f(fd_MsgGasParams_FixedGasParams_fixed_gas, value) {
+		if !f(fd_MsgGasParams_DynamicGasParams_fixed_gas, value) {
+			return
+		}
+	}
+	if x.GasPerItem != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerItem)
+		if !f(fd_MsgGasParams_DynamicGasParams_gas_per_item, value) {
 			return
 		}
 	}
@@ -1411,15 +2753,17 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
 		return x.FixedGas != uint64(0)
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+		return x.GasPerItem != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1429,15 +2773,17 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
 		x.FixedGas = uint64(0)
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+		x.GasPerItem = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1447,16 +2793,19 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
 		value := x.FixedGas
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+		value := x.GasPerItem
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1470,15 +2819,17 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
 		x.FixedGas = value.Uint()
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+		x.GasPerItem = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1492,40 +2843,44 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_FixedGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
-		panic(fmt.Errorf("field fixed_gas of message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams is not mutable"))
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+		panic(fmt.Errorf("field fixed_gas of message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams is not mutable"))
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+		panic(fmt.Errorf("field gas_per_item of message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgGasParams_FixedGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgGasParams_FixedGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1533,7 +2888,7 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgGasParams_FixedGasParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1544,7 +2899,7 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_FixedGasParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1556,7 +2911,7 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgGasParams_FixedGasParams) IsValid() bool {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) IsValid() bool {
 	return x != nil
 }
 
@@ -1566,9 +2921,9 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1583,6 +2938,9 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 		if x.FixedGas != 0 {
 			n += 1 + runtime.Sov(uint64(x.FixedGas))
 		}
+		if x.GasPerItem != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerItem))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1593,7 +2951,7 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1612,6 +2970,11 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GasPerItem != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerItem))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.FixedGas != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.FixedGas))
 			i--
@@ -1628,7 +2991,7 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams_FixedGasParams)
+		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1660,10 +3023,10 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_FixedGasParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_DynamicGasParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_FixedGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_DynamicGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1685,6 +3048,25 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerItem", wireType)
+				}
+				x.GasPerItem = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerItem |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1721,28 +3103,28 @@ func (x *fastReflection_MsgGasParams_FixedGasParams) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgGasParams_DynamicGasParams              protoreflect.MessageDescriptor
-	fd_MsgGasParams_DynamicGasParams_fixed_gas    protoreflect.FieldDescriptor
-	fd_MsgGasParams_DynamicGasParams_gas_per_item protoreflect.FieldDescriptor
+	md_MsgGasParams_WrapperGasParams           protoreflect.MessageDescriptor
+	fd_MsgGasParams_WrapperGasParams_fixed_gas protoreflect.FieldDescriptor
+	fd_MsgGasParams_WrapperGasParams_max_depth protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gashub_v1beta1_gashub_proto_init()
-	md_MsgGasParams_DynamicGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams").Messages().ByName("DynamicGasParams")
-	fd_MsgGasParams_DynamicGasParams_fixed_gas = md_MsgGasParams_DynamicGasParams.Fields().ByName("fixed_gas")
-	fd_MsgGasParams_DynamicGasParams_gas_per_item = md_MsgGasParams_DynamicGasParams.Fields().ByName("gas_per_item")
+	md_MsgGasParams_WrapperGasParams = File_cosmos_gashub_v1beta1_gashub_proto.Messages().ByName("MsgGasParams").Messages().ByName("WrapperGasParams")
+	fd_MsgGasParams_WrapperGasParams_fixed_gas = md_MsgGasParams_WrapperGasParams.Fields().ByName("fixed_gas")
+	fd_MsgGasParams_WrapperGasParams_max_depth = md_MsgGasParams_WrapperGasParams.Fields().ByName("max_depth")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgGasParams_DynamicGasParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgGasParams_WrapperGasParams)(nil)
 
-type fastReflection_MsgGasParams_DynamicGasParams MsgGasParams_DynamicGasParams
+type fastReflection_MsgGasParams_WrapperGasParams MsgGasParams_WrapperGasParams
 
-func (x *MsgGasParams_DynamicGasParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgGasParams_DynamicGasParams)(x)
+func (x *MsgGasParams_WrapperGasParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_WrapperGasParams)(x)
 }
 
-func (x *MsgGasParams_DynamicGasParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[3]
+func (x *MsgGasParams_WrapperGasParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,43 +3135,43 @@ func (x *MsgGasParams_DynamicGasParams) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgGasParams_DynamicGasParams_messageType fastReflection_MsgGasParams_DynamicGasParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgGasParams_DynamicGasParams_messageType{}
+var _fastReflection_MsgGasParams_WrapperGasParams_messageType fastReflection_MsgGasParams_WrapperGasParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGasParams_WrapperGasParams_messageType{}
 
-type fastReflection_MsgGasParams_DynamicGasParams_messageType struct{}
+type fastReflection_MsgGasParams_WrapperGasParams_messageType struct{}
 
-func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgGasParams_DynamicGasParams)(nil)
+func (x fastReflection_MsgGasParams_WrapperGasParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGasParams_WrapperGasParams)(nil)
 }
-func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams_DynamicGasParams)
+func (x fastReflection_MsgGasParams_WrapperGasParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_WrapperGasParams)
 }
-func (x fastReflection_MsgGasParams_DynamicGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams_DynamicGasParams
+func (x fastReflection_MsgGasParams_WrapperGasParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_WrapperGasParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgGasParams_DynamicGasParams
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGasParams_WrapperGasParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgGasParams_DynamicGasParams_messageType
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGasParams_WrapperGasParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) New() protoreflect.Message {
-	return new(fastReflection_MsgGasParams_DynamicGasParams)
+func (x *fastReflection_MsgGasParams_WrapperGasParams) New() protoreflect.Message {
+	return new(fastReflection_MsgGasParams_WrapperGasParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgGasParams_DynamicGasParams)(x)
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgGasParams_WrapperGasParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1797,16 +3179,16 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.FixedGas != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.FixedGas)
-		if !f(fd_MsgGasParams_DynamicGasParams_fixed_gas, value) {
+		if !f(fd_MsgGasParams_WrapperGasParams_fixed_gas, value) {
 			return
 		}
 	}
-	if x.GasPerItem != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasPerItem)
-		if !f(fd_MsgGasParams_DynamicGasParams_gas_per_item, value) {
+	if x.MaxDepth != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxDepth)
+		if !f(fd_MsgGasParams_WrapperGasParams_max_depth, value) {
 			return
 		}
 	}
@@ -1823,17 +3205,17 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
 		return x.FixedGas != uint64(0)
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
-		return x.GasPerItem != uint64(0)
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		return x.MaxDepth != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1843,17 +3225,17 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
 		x.FixedGas = uint64(0)
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
-		x.GasPerItem = uint64(0)
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		x.MaxDepth = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1863,19 +3245,19 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
 		value := x.FixedGas
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
-		value := x.GasPerItem
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		value := x.MaxDepth
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1889,17 +3271,17 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
 		x.FixedGas = value.Uint()
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
-		x.GasPerItem = value.Uint()
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		x.MaxDepth = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1913,44 +3295,44 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
-		panic(fmt.Errorf("field fixed_gas of message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams is not mutable"))
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
-		panic(fmt.Errorf("field gas_per_item of message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams is not mutable"))
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
+		panic(fmt.Errorf("field fixed_gas of message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams is not mutable"))
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		panic(fmt.Errorf("field max_depth of message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.fixed_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams.gas_per_item":
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.fixed_gas":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams.max_depth":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1958,7 +3340,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1969,7 +3351,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1981,7 +3363,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) IsValid() bool {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) IsValid() bool {
 	return x != nil
 }
 
@@ -1991,9 +3373,9 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgGasParams_WrapperGasParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
+		x := input.Message.Interface().(*MsgGasParams_WrapperGasParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2008,8 +3390,8 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 		if x.FixedGas != 0 {
 			n += 1 + runtime.Sov(uint64(x.FixedGas))
 		}
-		if x.GasPerItem != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasPerItem))
+		if x.MaxDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDepth))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2021,7 +3403,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
+		x := input.Message.Interface().(*MsgGasParams_WrapperGasParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2040,8 +3422,8 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GasPerItem != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerItem))
+		if x.MaxDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDepth))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -2061,7 +3443,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgGasParams_DynamicGasParams)
+		x := input.Message.Interface().(*MsgGasParams_WrapperGasParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2093,10 +3475,10 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_DynamicGasParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_WrapperGasParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_DynamicGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGasParams_WrapperGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2120,9 +3502,9 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerItem", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
 				}
-				x.GasPerItem = 0
+				x.MaxDepth = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2132,7 +3514,7 @@ func (x *fastReflection_MsgGasParams_DynamicGasParams) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GasPerItem |= uint64(b&0x7F) << shift
+					x.MaxDepth |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2195,6 +3577,11 @@ type Params struct {
 	MaxTxSize uint64 `protobuf:"varint,1,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
 	// min_gas_per_byte is the minimum gas to be paid per byte of a transaction's
 	MinGasPerByte uint64 `protobuf:"varint,2,opt,name=min_gas_per_byte,json=minGasPerByte,proto3" json:"min_gas_per_byte,omitempty"`
+	// fee_market defines the parameters of the dynamic base fee.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,3,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// default_msg_gas is the fixed gas charged for the msg types without msg gas params. The msgs
+	// without msg gas params are rejected if it is 0.
+	DefaultMsgGas uint64 `protobuf:"varint,4,opt,name=default_msg_gas,json=defaultMsgGas,proto3" json:"default_msg_gas,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2231,6 +3618,104 @@ func (x *Params) GetMinGasPerByte() uint64 {
 	return 0
 }
 
+func (x *Params) GetFeeMarket() *FeeMarketParams {
+	if x != nil {
+		return x.FeeMarket
+	}
+	return nil
+}
+
+func (x *Params) GetDefaultMsgGas() uint64 {
+	if x != nil {
+		return x.DefaultMsgGas
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the EIP-1559 style base fee. The base fee is adjusted at the
+// end of each block according to the gas used by the block, and each tx must pay at least the base fee
+// for its gas limit.
+type FeeMarketParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled defines whether the base fee is adjusted and enforced.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the denom the base fee is paid in.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee is the lower bound of the base fee per unit of gas.
+	MinBaseFee string `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// target_block_gas is the gas used by a block for which the base fee is unchanged.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two blocks, e.g. 8 bounds it to 12.5%.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// fee_recipient is the name of the module account receiving the base fees. They are burned if empty.
+	FeeRecipient string `protobuf:"bytes,6,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+func (x *FeeMarketParams) Reset() {
+	*x = FeeMarketParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeMarketParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeMarketParams) ProtoMessage() {}
+
+// Deprecated: Use FeeMarketParams.ProtoReflect.Descriptor instead.
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeMarketParams) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeeMarketParams) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeMarketParams) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if x != nil {
+		return x.TargetBlockGas
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetBaseFeeChangeDenominator() uint32 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetFeeRecipient() string {
+	if x != nil {
+		return x.FeeRecipient
+	}
+	return ""
+}
+
 // MsgGasParams defines gas consumption for a msg type
 type MsgGasParams struct {
 	state         protoimpl.MessageState
@@ -2241,18 +3726,18 @@ type MsgGasParams struct {
 	// gas_params is the oneof that represents either fixed_gas_params or dynamic_gas_params
 	//
 	// Types that are assignable to GasParams:
-	//
 	//	*MsgGasParams_FixedType
 	//	*MsgGasParams_GrantType
 	//	*MsgGasParams_MultiSendType
 	//	*MsgGasParams_GrantAllowanceType
+	//	*MsgGasParams_WrapperType
 	GasParams isMsgGasParams_GasParams `protobuf_oneof:"gas_params"`
 }
 
 func (x *MsgGasParams) Reset() {
 	*x = MsgGasParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[1]
+		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2266,7 +3751,7 @@ func (*MsgGasParams) ProtoMessage() {}
 
 // Deprecated: Use MsgGasParams.ProtoReflect.Descriptor instead.
 func (*MsgGasParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MsgGasParams) GetMsgTypeUrl() string {
@@ -2311,6 +3796,13 @@ func (x *MsgGasParams) GetGrantAllowanceType() *MsgGasParams_DynamicGasParams {
 	return nil
 }
 
+func (x *MsgGasParams) GetWrapperType() *MsgGasParams_WrapperGasParams {
+	if x, ok := x.GetGasParams().(*MsgGasParams_WrapperType); ok {
+		return x.WrapperType
+	}
+	return nil
+}
+
 type isMsgGasParams_GasParams interface {
 	isMsgGasParams_GasParams()
 }
@@ -2335,6 +3827,11 @@ type MsgGasParams_GrantAllowanceType struct {
 	GrantAllowanceType *MsgGasParams_DynamicGasParams `protobuf:"bytes,5,opt,name=grant_allowance_type,json=grantAllowanceType,proto3,oneof"`
 }
 
+type MsgGasParams_WrapperType struct {
+	// wrapper_type specifies gas params for the msgs wrapping other msgs, e.g. authz/MsgExec.
+	WrapperType *MsgGasParams_WrapperGasParams `protobuf:"bytes,6,opt,name=wrapper_type,json=wrapperType,proto3,oneof"`
+}
+
 func (*MsgGasParams_FixedType) isMsgGasParams_GasParams() {}
 
 func (*MsgGasParams_GrantType) isMsgGasParams_GasParams() {}
@@ -2343,6 +3840,8 @@ func (*MsgGasParams_MultiSendType) isMsgGasParams_GasParams() {}
 
 func (*MsgGasParams_GrantAllowanceType) isMsgGasParams_GasParams() {}
 
+func (*MsgGasParams_WrapperType) isMsgGasParams_GasParams() {}
+
 // FixedGasParams defines the parameters for fixed gas type.
 type MsgGasParams_FixedGasParams struct {
 	state         protoimpl.MessageState
@@ -2356,7 +3855,7 @@ type MsgGasParams_FixedGasParams struct {
 func (x *MsgGasParams_FixedGasParams) Reset() {
 	*x = MsgGasParams_FixedGasParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2]
+		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2370,7 +3869,7 @@ func (*MsgGasParams_FixedGasParams) ProtoMessage() {}
 
 // Deprecated: Use MsgGasParams_FixedGasParams.ProtoReflect.Descriptor instead.
 func (*MsgGasParams_FixedGasParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{1, 0}
+	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *MsgGasParams_FixedGasParams) GetFixedGas() uint64 {
@@ -2395,7 +3894,7 @@ type MsgGasParams_DynamicGasParams struct {
 func (x *MsgGasParams_DynamicGasParams) Reset() {
 	*x = MsgGasParams_DynamicGasParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[3]
+		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2409,7 +3908,7 @@ func (*MsgGasParams_DynamicGasParams) ProtoMessage() {}
 
 // Deprecated: Use MsgGasParams_DynamicGasParams.ProtoReflect.Descriptor instead.
 func (*MsgGasParams_DynamicGasParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{1, 1}
+	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *MsgGasParams_DynamicGasParams) GetFixedGas() uint64 {
@@ -2426,6 +3925,54 @@ func (x *MsgGasParams_DynamicGasParams) GetGasPerItem() uint64 {
 	return 0
 }
 
+// WrapperGasParams defines the parameters for the msgs wrapping other msgs. The gas cost of
+// a wrapper msg is its fixed gas plus the gas cost of each of the wrapped msgs.
+type MsgGasParams_WrapperGasParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fixed_gas is the surcharge for a wrapper msg
+	FixedGas uint64 `protobuf:"varint,1,opt,name=fixed_gas,json=fixedGas,proto3" json:"fixed_gas,omitempty"`
+	// max_depth is the maximum nesting depth of the msgs wrapped by a wrapper msg, the msgs of a tx
+	// being at depth 0
+	MaxDepth uint32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (x *MsgGasParams_WrapperGasParams) Reset() {
+	*x = MsgGasParams_WrapperGasParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgGasParams_WrapperGasParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgGasParams_WrapperGasParams) ProtoMessage() {}
+
+// Deprecated: Use MsgGasParams_WrapperGasParams.ProtoReflect.Descriptor instead.
+func (*MsgGasParams_WrapperGasParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *MsgGasParams_WrapperGasParams) GetFixedGas() uint64 {
+	if x != nil {
+		return x.FixedGas
+	}
+	return 0
+}
+
+func (x *MsgGasParams_WrapperGasParams) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
 var File_cosmos_gashub_v1beta1_gashub_proto protoreflect.FileDescriptor
 
 var file_cosmos_gashub_v1beta1_gashub_proto_rawDesc = []byte{
@@ -2435,17 +3982,47 @@ var file_cosmos_gashub_v1beta1_gashub_proto_rawDesc = []byte{
 	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67,
 	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x2d, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x4d, 0x61, 0x78, 0x54, 0x78, 0x53,
-	0x69, 0x7a, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a,
-	0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x4d, 0x69,
-	0x6e, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
-	0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x3a, 0x23, 0xe8, 0xa0, 0x1f, 0x01,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x78, 0x2f, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x84, 0x05, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa5, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x4d, 0x61, 0x78, 0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x6d, 0x69, 0x6e,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x66, 0x65,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67,
+	0x47, 0x61, 0x73, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x47,
+	0x61, 0x73, 0x3a, 0x23, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb5, 0x02, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5e, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x47, 0x61, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x62, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0xc1, 0x06, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x30, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4d, 0x73, 0x67, 0x54,
 	0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55,
@@ -2472,34 +4049,46 @@ var file_cosmos_gashub_v1beta1_gashub_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x48, 0x00, 0x52, 0x12, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x41, 0x0a, 0x0e, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x66, 0x69,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x47, 0x61, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x1a, 0x41, 0x0a, 0x0e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x08, 0x66, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x1a, 0x75, 0x0a, 0x10, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x66, 0x69,
 	0x78, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2,
 	0xde, 0x1f, 0x08, 0x46, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x08, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x47, 0x61, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x1a, 0x75, 0x0a, 0x10, 0x44,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x29, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x46, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73,
-	0x52, 0x08, 0x66, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x67, 0x61,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x0a, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xd4, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x61, 0x73,
-	0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x67, 0x61, 0x73, 0x68,
-	0x75, 0x62, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x61,
-	0x73, 0x68, 0x75, 0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f,
+	0x0a, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x67, 0x61, 0x73,
+	0x50, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x1a, 0x60, 0x0a,
+	0x10, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x47, 0x61, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x29, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x46, 0x69, 0x78, 0x65, 0x64, 0x47,
+	0x61, 0x73, 0x52, 0x08, 0x66, 0x69, 0x78, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0xd4, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x42, 0x0b, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x67, 0x61, 0x73, 0x68, 0x75, 0x62, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x15, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x61,
+	0x73, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x21, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x61, 0x73, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x61, 0x73, 0x68, 0x75,
+	0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2514,23 +4103,27 @@ func file_cosmos_gashub_v1beta1_gashub_proto_rawDescGZIP() []byte {
 	return file_cosmos_gashub_v1beta1_gashub_proto_rawDescData
 }
 
-var file_cosmos_gashub_v1beta1_gashub_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cosmos_gashub_v1beta1_gashub_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_cosmos_gashub_v1beta1_gashub_proto_goTypes = []interface{}{
 	(*Params)(nil),                        // 0: cosmos.gashub.v1beta1.Params
-	(*MsgGasParams)(nil),                  // 1: cosmos.gashub.v1beta1.MsgGasParams
-	(*MsgGasParams_FixedGasParams)(nil),   // 2: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams
-	(*MsgGasParams_DynamicGasParams)(nil), // 3: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
+	(*FeeMarketParams)(nil),               // 1: cosmos.gashub.v1beta1.FeeMarketParams
+	(*MsgGasParams)(nil),                  // 2: cosmos.gashub.v1beta1.MsgGasParams
+	(*MsgGasParams_FixedGasParams)(nil),   // 3: cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams
+	(*MsgGasParams_DynamicGasParams)(nil), // 4: cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
+	(*MsgGasParams_WrapperGasParams)(nil), // 5: cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams
 }
 var file_cosmos_gashub_v1beta1_gashub_proto_depIdxs = []int32{
-	2, // 0: cosmos.gashub.v1beta1.MsgGasParams.fixed_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams
-	3, // 1: cosmos.gashub.v1beta1.MsgGasParams.grant_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
-	3, // 2: cosmos.gashub.v1beta1.MsgGasParams.multi_send_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
-	3, // 3: cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 0: cosmos.gashub.v1beta1.Params.fee_market:type_name -> cosmos.gashub.v1beta1.FeeMarketParams
+	3, // 1: cosmos.gashub.v1beta1.MsgGasParams.fixed_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams
+	4, // 2: cosmos.gashub.v1beta1.MsgGasParams.grant_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
+	4, // 3: cosmos.gashub.v1beta1.MsgGasParams.multi_send_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
+	4, // 4: cosmos.gashub.v1beta1.MsgGasParams.grant_allowance_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams
+	5, // 5: cosmos.gashub.v1beta1.MsgGasParams.wrapper_type:type_name -> cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gashub_v1beta1_gashub_proto_init() }
@@ -2552,7 +4145,7 @@ func file_cosmos_gashub_v1beta1_gashub_proto_init() {
 			}
 		}
 		file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgGasParams); i {
+			switch v := v.(*FeeMarketParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2564,7 +4157,7 @@ func file_cosmos_gashub_v1beta1_gashub_proto_init() {
 			}
 		}
 		file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgGasParams_FixedGasParams); i {
+			switch v := v.(*MsgGasParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2576,6 +4169,18 @@ func file_cosmos_gashub_v1beta1_gashub_proto_init() {
 			}
 		}
 		file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgGasParams_FixedGasParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgGasParams_DynamicGasParams); i {
 			case 0:
 				return &v.state
@@ -2587,12 +4192,25 @@ func file_cosmos_gashub_v1beta1_gashub_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgGasParams_WrapperGasParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_cosmos_gashub_v1beta1_gashub_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*MsgGasParams_FixedType)(nil),
 		(*MsgGasParams_GrantType)(nil),
 		(*MsgGasParams_MultiSendType)(nil),
 		(*MsgGasParams_GrantAllowanceType)(nil),
+		(*MsgGasParams_WrapperType)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2600,7 +4218,7 @@ func file_cosmos_gashub_v1beta1_gashub_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gashub_v1beta1_gashub_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
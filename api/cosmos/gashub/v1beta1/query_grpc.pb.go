@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_Params_FullMethodName       = "/cosmos.gashub.v1beta1.Query/Params"
 	Query_MsgGasParams_FullMethodName = "/cosmos.gashub.v1beta1.Query/MsgGasParams"
+	Query_BaseFee_FullMethodName      = "/cosmos.gashub.v1beta1.Query/BaseFee"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,8 @@ type QueryClient interface {
 	// This query only returns params that have specific MsgGasParams settings.
 	// Any msg type that does not have a specific setting will not be returned by this query.
 	MsgGasParams(ctx context.Context, in *QueryMsgGasParamsRequest, opts ...grpc.CallOption) (*QueryMsgGasParamsResponse, error)
+	// BaseFee queries the base fee per unit of gas of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -62,6 +65,15 @@ func (c *queryClient) MsgGasParams(ctx context.Context, in *QueryMsgGasParamsReq
 	return out, nil
 }
 
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -73,6 +85,8 @@ type QueryServer interface {
 	// This query only returns params that have specific MsgGasParams settings.
 	// Any msg type that does not have a specific setting will not be returned by this query.
 	MsgGasParams(context.Context, *QueryMsgGasParamsRequest) (*QueryMsgGasParamsResponse, error)
+	// BaseFee queries the base fee per unit of gas of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -86,6 +100,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) MsgGasParams(context.Context, *QueryMsgGasParamsRequest) (*QueryMsgGasParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MsgGasParams not implemented")
 }
+func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +152,24 @@ func _Query_MsgGasParams_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +185,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MsgGasParams",
 			Handler:    _Query_MsgGasParams_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gashub/v1beta1/query.proto",
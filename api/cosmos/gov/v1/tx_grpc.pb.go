@@ -21,13 +21,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName         = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName      = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName                   = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName           = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName                = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName           = "/cosmos.gov.v1.Msg/UpdateParams"
-	Msg_UpdateCrossChainParams_FullMethodName = "/cosmos.gov.v1.Msg/UpdateCrossChainParams"
+	Msg_SubmitProposal_FullMethodName              = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName           = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                        = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName                = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                     = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_UpdateCrossChainParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateCrossChainParams"
+	Msg_UpdateCrossChainParamsBatch_FullMethodName = "/cosmos.gov.v1.Msg/UpdateCrossChainParamsBatch"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +53,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCrossChainParams, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsResponse, error)
+	// UpdateCrossChainParamsBatch defines a method to send several cross-chain params changes,
+	// possibly to different destination chains, all at once.
+	UpdateCrossChainParamsBatch(ctx context.Context, in *MsgUpdateCrossChainParamsBatch, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsBatchResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +129,15 @@ func (c *msgClient) UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCro
 	return out, nil
 }
 
+func (c *msgClient) UpdateCrossChainParamsBatch(ctx context.Context, in *MsgUpdateCrossChainParamsBatch, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsBatchResponse, error) {
+	out := new(MsgUpdateCrossChainParamsBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCrossChainParamsBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -147,6 +160,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error)
+	// UpdateCrossChainParamsBatch defines a method to send several cross-chain params changes,
+	// possibly to different destination chains, all at once.
+	UpdateCrossChainParamsBatch(context.Context, *MsgUpdateCrossChainParamsBatch) (*MsgUpdateCrossChainParamsBatchResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -175,6 +191,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateCrossChainParamsBatch(context.Context, *MsgUpdateCrossChainParamsBatch) (*MsgUpdateCrossChainParamsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParamsBatch not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +333,24 @@ func _Msg_UpdateCrossChainParams_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCrossChainParamsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCrossChainParamsBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCrossChainParamsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCrossChainParamsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCrossChainParamsBatch(ctx, req.(*MsgUpdateCrossChainParamsBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +386,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCrossChainParams",
 			Handler:    _Msg_UpdateCrossChainParams_Handler,
 		},
+		{
+			MethodName: "UpdateCrossChainParamsBatch",
+			Handler:    _Msg_UpdateCrossChainParamsBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
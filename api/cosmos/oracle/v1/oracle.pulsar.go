@@ -13,10 +13,11 @@ import (
 )
 
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_relayer_timeout      protoreflect.FieldDescriptor
-	fd_Params_relayer_interval     protoreflect.FieldDescriptor
-	fd_Params_relayer_reward_share protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_relayer_timeout        protoreflect.FieldDescriptor
+	fd_Params_relayer_interval       protoreflect.FieldDescriptor
+	fd_Params_relayer_reward_share   protoreflect.FieldDescriptor
+	fd_Params_validator_reward_share protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +26,7 @@ func init() {
 	fd_Params_relayer_timeout = md_Params.Fields().ByName("relayer_timeout")
 	fd_Params_relayer_interval = md_Params.Fields().ByName("relayer_interval")
 	fd_Params_relayer_reward_share = md_Params.Fields().ByName("relayer_reward_share")
+	fd_Params_validator_reward_share = md_Params.Fields().ByName("validator_reward_share")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -110,6 +112,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ValidatorRewardShare != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ValidatorRewardShare)
+		if !f(fd_Params_validator_reward_share, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +139,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RelayerInterval != uint64(0)
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		return x.RelayerRewardShare != uint32(0)
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		return x.ValidatorRewardShare != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -153,6 +163,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.RelayerInterval = uint64(0)
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		x.RelayerRewardShare = uint32(0)
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		x.ValidatorRewardShare = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -178,6 +190,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		value := x.RelayerRewardShare
 		return protoreflect.ValueOfUint32(value)
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		value := x.ValidatorRewardShare
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -204,6 +219,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.RelayerInterval = value.Uint()
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		x.RelayerRewardShare = uint32(value.Uint())
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		x.ValidatorRewardShare = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -230,6 +247,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field relayer_interval of message cosmos.oracle.v1.Params is not mutable"))
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		panic(fmt.Errorf("field relayer_reward_share of message cosmos.oracle.v1.Params is not mutable"))
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		panic(fmt.Errorf("field validator_reward_share of message cosmos.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -249,6 +268,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.oracle.v1.Params.relayer_reward_share":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.oracle.v1.Params.validator_reward_share":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.oracle.v1.Params"))
@@ -327,6 +348,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.RelayerRewardShare != 0 {
 			n += 1 + runtime.Sov(uint64(x.RelayerRewardShare))
 		}
+		if x.ValidatorRewardShare != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorRewardShare))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -356,6 +380,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ValidatorRewardShare != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorRewardShare))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.RelayerRewardShare != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.RelayerRewardShare))
 			i--
@@ -477,6 +506,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardShare", wireType)
+				}
+				x.ValidatorRewardShare = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorRewardShare |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -990,6 +1038,10 @@ type Params struct {
 	// Reward share for the relayer sends the claim message,
 	// the other relayers signed the bls message will share the reward evenly.
 	RelayerRewardShare uint32 `protobuf:"varint,3,opt,name=relayer_reward_share,json=relayerRewardShare,proto3" json:"relayer_reward_share,omitempty"` // in percentage
+	// Share of the reward of each relayer allocated to the rewards of its validator through the
+	// distribution module, so that it is subject to the validator commission and shared with its
+	// delegators. The rest of the reward is sent to the relayer address, zero disables the routing.
+	ValidatorRewardShare uint32 `protobuf:"varint,4,opt,name=validator_reward_share,json=validatorRewardShare,proto3" json:"validator_reward_share,omitempty"` // in percentage
 }
 
 func (x *Params) Reset() {
@@ -1033,6 +1085,13 @@ func (x *Params) GetRelayerRewardShare() uint32 {
 	return 0
 }
 
+func (x *Params) GetValidatorRewardShare() uint32 {
+	if x != nil {
+		return x.ValidatorRewardShare
+	}
+	return 0
+}
+
 // RelayInterval holds start and end(exclusive) time of in-turn relayer, [start, end)
 type RelayInterval struct {
 	state         protoimpl.MessageState
@@ -1083,7 +1142,7 @@ var file_cosmos_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
 	0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x22, 0x8e, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x31, 0x22, 0xc4, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f,
 	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69,
 	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72,
@@ -1092,22 +1151,25 @@ var file_cosmos_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61,
 	0x72, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
 	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x42, 0xb1, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
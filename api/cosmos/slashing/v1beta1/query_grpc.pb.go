@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName             = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName        = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_MaintenanceWindows_FullMethodName = "/cosmos.slashing.v1beta1.Query/MaintenanceWindows"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators, or of a validator
+	MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +74,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error) {
+	out := new(QueryMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +93,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators, or of a validator
+	MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +111,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindows not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +181,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindows(ctx, req.(*QueryMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +218,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MaintenanceWindows",
+			Handler:    _Query_MaintenanceWindows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
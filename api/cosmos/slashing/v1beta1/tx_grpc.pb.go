@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
-	Msg_Impeach_FullMethodName      = "/cosmos.slashing.v1beta1.Msg/Impeach"
+	Msg_Unjail_FullMethodName             = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Impeach_FullMethodName            = "/cosmos.slashing.v1beta1.Msg/Impeach"
+	Msg_DeclareMaintenance_FullMethodName = "/cosmos.slashing.v1beta1.Msg/DeclareMaintenance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,6 +40,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// Impeach defines a method for removing an existing validator after gov proposal passes.
 	Impeach(ctx context.Context, in *MsgImpeach, opts ...grpc.CallOption) (*MsgImpeachResponse, error)
+	// DeclareMaintenance defines a method for a validator to declare a maintenance window ahead of
+	// time, during which its missed blocks are counted at a reduced weight.
+	DeclareMaintenance(ctx context.Context, in *MsgDeclareMaintenance, opts ...grpc.CallOption) (*MsgDeclareMaintenanceResponse, error)
 }
 
 type msgClient struct {
@@ -76,6 +80,15 @@ func (c *msgClient) Impeach(ctx context.Context, in *MsgImpeach, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) DeclareMaintenance(ctx context.Context, in *MsgDeclareMaintenance, opts ...grpc.CallOption) (*MsgDeclareMaintenanceResponse, error) {
+	out := new(MsgDeclareMaintenanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DeclareMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +104,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// Impeach defines a method for removing an existing validator after gov proposal passes.
 	Impeach(context.Context, *MsgImpeach) (*MsgImpeachResponse, error)
+	// DeclareMaintenance defines a method for a validator to declare a maintenance window ahead of
+	// time, during which its missed blocks are counted at a reduced weight.
+	DeclareMaintenance(context.Context, *MsgDeclareMaintenance) (*MsgDeclareMaintenanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -107,6 +123,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) Impeach(context.Context, *MsgImpeach) (*MsgImpeachResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Impeach not implemented")
 }
+func (UnimplementedMsgServer) DeclareMaintenance(context.Context, *MsgDeclareMaintenance) (*MsgDeclareMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclareMaintenance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +193,24 @@ func _Msg_Impeach_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeclareMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclareMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclareMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeclareMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclareMaintenance(ctx, req.(*MsgDeclareMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +230,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Impeach",
 			Handler:    _Msg_Impeach_Handler,
 		},
+		{
+			MethodName: "DeclareMaintenance",
+			Handler:    _Msg_DeclareMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
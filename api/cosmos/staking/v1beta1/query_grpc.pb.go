@@ -33,6 +33,8 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_PendingKeyRotations_FullMethodName           = "/cosmos.staking.v1beta1.Query/PendingKeyRotations"
+	Query_ValidatorConsPubKeyRotations_FullMethodName  = "/cosmos.staking.v1beta1.Query/ValidatorConsPubKeyRotations"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +94,10 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingKeyRotations queries the key rotations of validators that are not active yet.
+	PendingKeyRotations(ctx context.Context, in *QueryPendingKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPendingKeyRotationsResponse, error)
+	// ValidatorConsPubKeyRotations queries the consensus pubkey rotation history of a validator.
+	ValidatorConsPubKeyRotations(ctx context.Context, in *QueryValidatorConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyRotationsResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +234,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingKeyRotations(ctx context.Context, in *QueryPendingKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPendingKeyRotationsResponse, error) {
+	out := new(QueryPendingKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingKeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorConsPubKeyRotations(ctx context.Context, in *QueryValidatorConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyRotationsResponse, error) {
+	out := new(QueryValidatorConsPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorConsPubKeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +309,10 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingKeyRotations queries the key rotations of validators that are not active yet.
+	PendingKeyRotations(context.Context, *QueryPendingKeyRotationsRequest) (*QueryPendingKeyRotationsResponse, error)
+	// ValidatorConsPubKeyRotations queries the consensus pubkey rotation history of a validator.
+	ValidatorConsPubKeyRotations(context.Context, *QueryValidatorConsPubKeyRotationsRequest) (*QueryValidatorConsPubKeyRotationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +362,12 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingKeyRotations(context.Context, *QueryPendingKeyRotationsRequest) (*QueryPendingKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingKeyRotations not implemented")
+}
+func (UnimplementedQueryServer) ValidatorConsPubKeyRotations(context.Context, *QueryValidatorConsPubKeyRotationsRequest) (*QueryValidatorConsPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorConsPubKeyRotations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +633,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingKeyRotations(ctx, req.(*QueryPendingKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorConsPubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorConsPubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorConsPubKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorConsPubKeyRotations(ctx, req.(*QueryValidatorConsPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +732,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingKeyRotations",
+			Handler:    _Query_PendingKeyRotations_Handler,
+		},
+		{
+			MethodName: "ValidatorConsPubKeyRotations",
+			Handler:    _Query_ValidatorConsPubKeyRotations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -20,11 +20,13 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
+	Msg_CreateValidatorSelf_FullMethodName       = "/cosmos.staking.v1beta1.Msg/CreateValidatorSelf"
 	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
 	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
 	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
 	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	Msg_RotateConsPubKey_FullMethodName          = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
 	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
 )
 
@@ -34,6 +36,9 @@ const (
 type MsgClient interface {
 	// CreateValidator defines a method for creating a new validator.
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
+	// CreateValidatorSelf defines a method for creating a new validator signed by its operator, without
+	// a governance proposal. It is only available if enabled in the params.
+	CreateValidatorSelf(ctx context.Context, in *MsgCreateValidatorSelf, opts ...grpc.CallOption) (*MsgCreateValidatorSelfResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
 	// Delegate defines a method for performing a delegation of coins
@@ -50,6 +55,8 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -73,6 +80,15 @@ func (c *msgClient) CreateValidator(ctx context.Context, in *MsgCreateValidator,
 	return out, nil
 }
 
+func (c *msgClient) CreateValidatorSelf(ctx context.Context, in *MsgCreateValidatorSelf, opts ...grpc.CallOption) (*MsgCreateValidatorSelfResponse, error) {
+	out := new(MsgCreateValidatorSelfResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateValidatorSelf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error) {
 	out := new(MsgEditValidatorResponse)
 	err := c.cc.Invoke(ctx, Msg_EditValidator_FullMethodName, in, out, opts...)
@@ -118,6 +134,15 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -133,6 +158,9 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
+	// CreateValidatorSelf defines a method for creating a new validator signed by its operator, without
+	// a governance proposal. It is only available if enabled in the params.
+	CreateValidatorSelf(context.Context, *MsgCreateValidatorSelf) (*MsgCreateValidatorSelfResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
 	// Delegate defines a method for performing a delegation of coins
@@ -149,6 +177,8 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -163,6 +193,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateValidator not implemented")
 }
+func (UnimplementedMsgServer) CreateValidatorSelf(context.Context, *MsgCreateValidatorSelf) (*MsgCreateValidatorSelfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateValidatorSelf not implemented")
+}
 func (UnimplementedMsgServer) EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
@@ -178,6 +211,9 @@ func (UnimplementedMsgServer) Undelegate(context.Context, *MsgUndelegate) (*MsgU
 func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -212,6 +248,24 @@ func _Msg_CreateValidator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateValidatorSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateValidatorSelf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateValidatorSelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateValidatorSelf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateValidatorSelf(ctx, req.(*MsgCreateValidatorSelf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgEditValidator)
 	if err := dec(in); err != nil {
@@ -302,6 +356,24 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -331,6 +403,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateValidator",
 			Handler:    _Msg_CreateValidator_Handler,
 		},
+		{
+			MethodName: "CreateValidatorSelf",
+			Handler:    _Msg_CreateValidatorSelf_Handler,
+		},
 		{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
@@ -351,6 +427,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -422,6 +422,17 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	}
 }
 
+// DryCheckTx executes a tx as CheckTx does, on a branch of the CheckTx state that is discarded, and
+// returns the error CheckTx would return. The tx is not added to the mempool.
+func (app *BaseApp) DryCheckTx(txBytes []byte) error {
+	app.checkStateMtx.Lock()
+	defer app.checkStateMtx.Unlock()
+
+	ctx, _ := app.getContextForTx(runTxModeDryCheck, txBytes).CacheContext()
+	_, _, _, _, err := app.runTxOnContext(ctx, runTxModeDryCheck, txBytes)
+	return err
+}
+
 // DeliverTx implements the ABCI interface and executes a tx in DeliverTx mode.
 // State only gets persisted if all messages are valid and get executed successfully.
 // Otherwise, the ResponseDeliverTx will contain relevant error information.
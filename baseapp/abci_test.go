@@ -643,6 +643,44 @@ func TestABCI_CheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestABCI_DryCheckTx(t *testing.T) {
+	counterKey := []byte("counter-key")
+	pool := mempool.NewSenderNonceMempool()
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, counterKey})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// the state and the mempool are left untouched, so the tx can be checked again
+	for i := 0; i < 2; i++ {
+		require.NoError(t, suite.baseApp.DryCheckTx(txBytes))
+		require.Equal(t, int64(0), getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), counterKey))
+		require.Equal(t, 0, pool.CountTx())
+	}
+
+	r := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 1, pool.CountTx())
+
+	// the error CheckTx would return is returned
+	failTx := setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, 1, 0), true)
+	failTxBytes, err := suite.txConfig.TxEncoder()(failTx)
+	require.NoError(t, err)
+
+	err = suite.baseApp.DryCheckTx(failTxBytes)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	r = suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: failTxBytes})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), r.Code)
+}
+
 func TestABCI_DeliverTx(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
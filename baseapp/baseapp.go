@@ -45,6 +45,7 @@ const (
 	runTxPrepareProposal                  // Prepare a TM block proposal
 	runTxProcessProposal                  // Process a TM block proposal
 	runTxModePreDeliver                   // Pre-deliver a transaction
+	runTxModeDryCheck                     // Check a transaction without keeping its state or adding it to the mempool
 
 	inMemorySignatures = 4096 // Number of recent block signatures to keep in memory
 )
@@ -380,6 +381,11 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return app.snapshotManager
 }
 
+// Mempool returns the application side mempool.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64) error {
@@ -1,10 +1,13 @@
 package baseapp
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -57,19 +60,63 @@ func (e *EthQueryRouter) RegisterEthQueryBalanceHandler(srv interface{}, handler
 	e.AddRoute(EthGetBalance, handlerGen(srv))
 }
 
-// RegisterConstHandler adds router for constant eth query.
+// RegisterEthQueryGasPriceHandler adds router for EthGasPrice with a given handlerGen and server. It replaces
+// the constant gas price handler registered by RegisterConstHandler, if any.
+func (e *EthQueryRouter) RegisterEthQueryGasPriceHandler(srv interface{}, handlerGen func(interface{}) EthQueryHandler) {
+	e.routes[EthGasPrice] = handlerGen(srv)
+}
+
+// RegisterEthSendRawTransactionHandler adds router for EthSendRawTransaction, replacing the dummy handler
+// registered by RegisterConstHandler, if any. The raw txs are checked with checkTx, submitted with
+// broadcast, and their Ethereum hash is returned. The error of checkTx, if any, is returned instead.
+//
+// The txs are checked synchronously, see BaseApp.DryCheckTx, but submitted asynchronously, as the CheckTx
+// run by the mempool waits for the eth query to be handled when the ABCI connections of the node share a
+// lock.
+func (e *EthQueryRouter) RegisterEthSendRawTransactionHandler(checkTx func(txBytes []byte) error, broadcast func(txBytes []byte) error) {
+	e.routes[EthSendRawTransaction] = func(ctx sdk.Context, req cmtrpctypes.RPCRequest) (abci.ResponseEthQuery, error) {
+		var params []string
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params) != 1 {
+			return abci.ResponseEthQuery{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expected the raw tx as single param")
+		}
+		txBytes, err := hexutil.Decode(params[0])
+		if err != nil {
+			return abci.ResponseEthQuery{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if err := checkTx(txBytes); err != nil {
+			return abci.ResponseEthQuery{}, err
+		}
+
+		logger := ctx.Logger()
+		go func() {
+			if err := broadcast(txBytes); err != nil {
+				logger.Error("failed to broadcast ethereum tx", "err", err)
+			}
+		}()
+
+		return abci.ResponseEthQuery{Response: ethcrypto.Keccak256(txBytes)}, nil
+	}
+}
+
+// RegisterConstHandler adds router for constant eth query. The constant gas price and send raw
+// transaction handlers are not registered if such handlers were already registered.
 func (e *EthQueryRouter) RegisterConstHandler() {
 	e.AddRoute(EthBlockNumber, blockNumberHandler)
 	e.AddRoute(EthGetBlockByNumber, blockNumberHandler)
 	e.AddRoute(EthNetworkID, chainIdHandler)
 	e.AddRoute(EthChainID, chainIdHandler)
 	e.AddRoute(NetVersion, chainIdHandler)
-	e.AddRoute(EthGasPrice, gasPriceHandler)
+	if e.routes[EthGasPrice] == nil {
+		e.AddRoute(EthGasPrice, gasPriceHandler)
+	}
 	e.AddRoute(EthGetCode, chainIdHandler)                         // return dummy result
 	e.AddRoute(EthEstimateGas, estimateGasHandler)                 // return dummy result
 	e.AddRoute(EthCall, chainIdHandler)                            // return dummy result
 	e.AddRoute(EthGetTransactionCount, getTransactionCountHandler) // return dummy result
-	e.AddRoute(EthSendRawTransaction, chainIdHandler)              // return dummy result
+	if e.routes[EthSendRawTransaction] == nil {
+		e.AddRoute(EthSendRawTransaction, chainIdHandler) // return dummy result
+	}
 }
 
 func blockNumberHandler(ctx sdk.Context, req cmtrpctypes.RPCRequest) (abci.ResponseEthQuery, error) {
@@ -78,9 +125,12 @@ func blockNumberHandler(ctx sdk.Context, req cmtrpctypes.RPCRequest) (abci.Respo
 	return res, nil
 }
 
+// DefaultEthGasPrice is the gas price returned by the constant gas price handler
+const DefaultEthGasPrice = 5e9
+
 func gasPriceHandler(ctx sdk.Context, req cmtrpctypes.RPCRequest) (abci.ResponseEthQuery, error) {
 	var res abci.ResponseEthQuery
-	res.Response = big.NewInt(5e9).Bytes()
+	res.Response = big.NewInt(DefaultEthGasPrice).Bytes()
 	return res, nil
 }
 
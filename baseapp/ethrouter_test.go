@@ -0,0 +1,61 @@
+package baseapp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEthSendRawTransactionHandler(t *testing.T) {
+	txBytes := []byte{0x02, 0x01, 0x02, 0x03}
+	errCheck := errors.New("check error")
+
+	checkTx := func(bz []byte) error {
+		if string(bz) != string(txBytes) {
+			return errCheck
+		}
+		return nil
+	}
+	broadcasted := make(chan []byte, 1)
+	broadcast := func(bz []byte) error {
+		broadcasted <- bz
+		return nil
+	}
+
+	router := baseapp.NewEthQueryRouter()
+	router.RegisterEthSendRawTransactionHandler(checkTx, broadcast)
+	// the dummy handler does not replace the registered one
+	router.RegisterConstHandler()
+	handler := router.Route(baseapp.EthSendRawTransaction)
+	require.NotNil(t, handler)
+
+	ctx := sdk.Context{}.WithLogger(defaultLogger())
+	newReq := func(params ...string) cmtrpctypes.RPCRequest {
+		bz, err := json.Marshal(params)
+		require.NoError(t, err)
+		return cmtrpctypes.RPCRequest{Method: baseapp.EthSendRawTransaction, Params: bz}
+	}
+
+	res, err := handler(ctx, newReq(hexutil.Encode(txBytes)))
+	require.NoError(t, err)
+	require.Equal(t, ethcrypto.Keccak256(txBytes), res.Response)
+	require.Equal(t, txBytes, <-broadcasted)
+
+	_, err = handler(ctx, newReq(hexutil.Encode([]byte{0x02})))
+	require.ErrorIs(t, err, errCheck)
+
+	_, err = handler(ctx, newReq("not hex"))
+	require.Error(t, err)
+
+	_, err = handler(ctx, newReq())
+	require.Error(t, err)
+	require.Empty(t, broadcasted)
+}
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
@@ -47,6 +48,16 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 	return msr.routes[typeURL]
 }
 
+// MsgTypeURLs returns the type urls of the msgs routed by the router, sorted.
+func (msr *MsgServiceRouter) MsgTypeURLs() []string {
+	typeURLs := make([]string, 0, len(msr.routes))
+	for typeURL := range msr.routes {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs)
+	return typeURLs
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -51,6 +51,23 @@ func TestRegisterMsgService(t *testing.T) {
 	})
 }
 
+func TestMsgTypeURLs(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	typeURLs := app.MsgServiceRouter().MsgTypeURLs()
+	require.Contains(t, typeURLs, "/testpb.MsgCreateDog")
+	require.IsIncreasing(t, typeURLs)
+}
+
 func TestRegisterMsgServiceTwice(t *testing.T) {
 	// Setup baseapp.
 	var (
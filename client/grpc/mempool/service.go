@@ -0,0 +1,93 @@
+package mempool
+
+import (
+	context "context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC router.
+func RegisterMempoolService(clientCtx client.Context, server gogogrpc.Server, mp mempool.Mempool) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, mp))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+	mempool   mempool.Mempool
+}
+
+func NewQueryServer(clientCtx client.Context, mp mempool.Mempool) ServiceServer {
+	return queryServer{
+		clientCtx: clientCtx,
+		mempool:   mp,
+	}
+}
+
+func (s queryServer) Stats(_ context.Context, _ *StatsRequest) (*StatsResponse, error) {
+	inspector, err := s.inspector()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := inspector.Stats()
+	return &StatsResponse{
+		TxCount:         uint64(stats.TxCount),
+		TotalBytes:      uint64(stats.TotalBytes),
+		SenderCount:     uint64(stats.SenderCount),
+		MaxTx:           int64(stats.MaxTx),
+		MaxBytes:        uint64(stats.MaxBytes),
+		MaxTxsPerSender: uint64(stats.MaxTxsPerSender),
+		EvictedCount:    stats.EvictedCount,
+	}, nil
+}
+
+func (s queryServer) Txs(_ context.Context, req *TxsRequest) (*TxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	inspector, err := s.inspector()
+	if err != nil {
+		return nil, err
+	}
+
+	txs := inspector.Txs(int(req.Limit))
+	res := &TxsResponse{Txs: make([]*MempoolTx, 0, len(txs))}
+	for _, tx := range txs {
+		bz, err := s.clientCtx.TxConfig.TxEncoder()(tx.Tx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Txs = append(res.Txs, &MempoolTx{
+			Sender:   tx.Sender,
+			Nonce:    tx.Nonce,
+			Priority: tx.Priority,
+			Size_:    uint64(tx.Size),
+			TxBytes:  bz,
+		})
+	}
+
+	return res, nil
+}
+
+func (s queryServer) inspector() (mempool.Inspector, error) {
+	inspector, ok := s.mempool.(mempool.Inspector)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T cannot be inspected", s.mempool)
+	}
+	return inspector, nil
+}
@@ -18,6 +18,7 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
+		VerifySnapshotCmd(appCreator),
 	)
 	return cmd
 }
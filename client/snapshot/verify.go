@@ -0,0 +1,197 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/node"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	flagCommitInfo = "commit-info"
+	flagAppHash    = "app-hash"
+)
+
+// VerifySnapshotCmd returns a command to verify the integrity of a local snapshot
+func VerifySnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height> <format>",
+		Short: "Verify a local snapshot against its chunk hashes and the app hash",
+		Long: `Verify a local snapshot before it is served to peers or restored.
+
+The chunk hashes are checked against the snapshot metadata, then the snapshot is restored
+into an in-memory multistore and the recomputed commit info is compared with the expected one.
+The expected commit info is read from --commit-info (a JSON encoded CommitInfo), or from the
+commit info stored in the local application database at the snapshot height. When --app-hash
+is given, the recomputed app hash must match it as well.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			home := ctx.Config.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+
+			genDocProvider := node.DefaultGenesisDocProviderFunc(ctx.Config)
+			genDoc, err := genDocProvider()
+			if err != nil {
+				return err
+			}
+
+			config, err := serverconfig.GetConfig(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(ctx.Logger, db, nil, genDoc.ChainID, &config, ctx.Viper)
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unsupported multistore type %T", app.CommitMultiStore())
+			}
+
+			expected, err := expectedCommitInfo(cmd, cms, int64(height))
+			if err != nil {
+				return err
+			}
+
+			target, err := newScratchMultiStore(cms, ctx.Logger)
+			if err != nil {
+				return err
+			}
+
+			sm := app.SnapshotManager()
+			snapshot, err := sm.VerifyLocalSnapshot(height, uint32(format), target)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Chunks verified: height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+
+			actual, err := target.GetCommitInfo(int64(height))
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Recomputed app hash: %X\n", actual.Hash())
+
+			appHash, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+			var expectedAppHash []byte
+			if appHash != "" {
+				if expectedAppHash, err = hex.DecodeString(appHash); err != nil {
+					return fmt.Errorf("invalid app hash: %w", err)
+				}
+			}
+
+			if err := compareCommitInfo(cmd, expected, actual, expectedAppHash); err != nil {
+				return err
+			}
+			if expected == nil {
+				return nil
+			}
+
+			cmd.Printf("Snapshot matches expected app hash %X\n", expected.Hash())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCommitInfo, "", "Path to a JSON encoded CommitInfo to compare against, defaults to the commit info stored locally")
+	cmd.Flags().String(flagAppHash, "", "Hex encoded app hash the snapshot is expected to produce")
+
+	return cmd
+}
+
+// expectedCommitInfo returns the commit info the snapshot is verified against. It is read from the
+// --commit-info file if given, otherwise from the local multistore. It returns nil when neither is
+// available and an app hash was supplied instead.
+func expectedCommitInfo(cmd *cobra.Command, cms *rootmulti.Store, height int64) (*storetypes.CommitInfo, error) {
+	path, err := cmd.Flags().GetString(flagCommitInfo)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var info storetypes.CommitInfo
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		if err := cdc.UnmarshalJSON(bz, &info); err != nil {
+			return nil, fmt.Errorf("failed to decode commit info: %w", err)
+		}
+		return &info, nil
+	}
+
+	info, err := cms.GetCommitInfo(height)
+	if err != nil {
+		appHash, _ := cmd.Flags().GetString(flagAppHash)
+		if appHash != "" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("no commit info stored at height %d, pass --%s or --%s: %w", height, flagCommitInfo, flagAppHash, err)
+	}
+	return info, nil
+}
+
+// compareCommitInfo compares the recomputed commit info with the expected commit info, if any, and
+// with the expected app hash, if any. The per-store mismatches are printed before an app hash
+// mismatch is reported, so that the stores causing it are known.
+func compareCommitInfo(cmd *cobra.Command, expected, actual *storetypes.CommitInfo, expectedAppHash []byte) error {
+	var mismatches []snapshots.StoreMismatch
+	if expected != nil {
+		mismatches = snapshots.CompareCommitInfo(*expected, *actual)
+		for _, mismatch := range mismatches {
+			cmd.Printf("store %s: expected %X, got %X\n", mismatch.Name, mismatch.Expected, mismatch.Actual)
+		}
+	}
+
+	if expectedAppHash != nil && !bytes.Equal(expectedAppHash, actual.Hash()) {
+		return fmt.Errorf("app hash mismatch: expected %X, got %X", expectedAppHash, actual.Hash())
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("snapshot commit info mismatch in %d stores", len(mismatches))
+	}
+	return nil
+}
+
+// newScratchMultiStore creates an in-memory multistore with the same IAVL stores mounted as cms,
+// so a snapshot can be restored into it without touching the application database.
+func newScratchMultiStore(cms *rootmulti.Store, logger log.Logger) (*rootmulti.Store, error) {
+	target := rootmulti.NewStore(dbm.NewMemDB(), logger)
+	for _, key := range cms.StoreKeysByName() {
+		if store := cms.GetCommitKVStore(key); store != nil && store.GetStoreType() == storetypes.StoreTypeIAVL {
+			target.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	if err := target.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
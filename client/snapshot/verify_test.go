@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func commitInfo(hashes map[string]string) *storetypes.CommitInfo {
+	info := &storetypes.CommitInfo{Version: 1}
+	for name, hash := range hashes {
+		info.StoreInfos = append(info.StoreInfos, storetypes.StoreInfo{
+			Name:     name,
+			CommitId: storetypes.CommitID{Version: 1, Hash: []byte(hash)},
+		})
+	}
+	return info
+}
+
+func TestCompareCommitInfo(t *testing.T) {
+	expected := commitInfo(map[string]string{"acc": "a", "bank": "b"})
+	actual := commitInfo(map[string]string{"acc": "a", "bank": "c"})
+
+	testCases := []struct {
+		name            string
+		expected        *storetypes.CommitInfo
+		expectedAppHash []byte
+		expErr          string
+		expOut          string
+	}{
+		{
+			name:     "matching commit info",
+			expected: actual,
+		},
+		{
+			name:            "matching app hash",
+			expectedAppHash: actual.Hash(),
+		},
+		{
+			name:     "store mismatch",
+			expected: expected,
+			expErr:   "snapshot commit info mismatch in 1 stores",
+			expOut:   "store bank: expected 62, got 63\n",
+		},
+		{
+			name:            "app hash mismatch without commit info",
+			expectedAppHash: expected.Hash(),
+			expErr:          "app hash mismatch",
+		},
+		{
+			name:            "app hash mismatch reports the store mismatches",
+			expected:        expected,
+			expectedAppHash: expected.Hash(),
+			expErr:          "app hash mismatch",
+			expOut:          "store bank: expected 62, got 63\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			out := &bytes.Buffer{}
+			cmd.SetOut(out)
+
+			err := compareCommitInfo(cmd, tc.expected, actual, tc.expectedAppHash)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expOut, out.String())
+		})
+	}
+}
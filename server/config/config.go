@@ -185,8 +185,27 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// Type defines the app-side mempool implementation, either "sender-nonce"
+	// (the default) or "capped-priority".
+	Type string `mapstructure:"type"`
+
+	// MaxBytes defines the maximum total size in bytes of the txs in the
+	// capped-priority mempool, zero indicates no limit.
+	MaxBytes int64 `mapstructure:"max-bytes"`
+
+	// MaxTxsPerSender defines the maximum number of txs a single sender may
+	// have in the capped-priority mempool, zero indicates no limit.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
 }
 
+const (
+	// MempoolTypeSenderNonce selects mempool.SenderNonceMempool.
+	MempoolTypeSenderNonce = "sender-nonce"
+	// MempoolTypeCappedPriority selects mempool.CappedPriorityMempool.
+	MempoolTypeCappedPriority = "capped-priority"
+)
+
 type (
 	// StoreConfig defines application configuration for state streaming and other
 	// storage related operations.
@@ -330,6 +349,7 @@ func DefaultConfig() *Config {
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
+			Type:   MempoolTypeSenderNonce,
 		},
 	}
 }
@@ -265,6 +265,18 @@ fsync = "{{ .Streamers.File.Fsync }}"
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# Type selects the app-side mempool implementation:
+# - sender-nonce: txs are selected randomly across senders, in nonce order per sender.
+# - capped-priority: txs are selected by priority, in nonce order per sender. When the
+#   mempool is full, the lowest priority txs are evicted in favour of higher priority ones.
+type = "{{ .Mempool.Type }}"
+
+# Maximum total size in bytes of the txs in the capped-priority mempool, 0 means unlimited.
+max-bytes = {{ .Mempool.MaxBytes }}
+
+# Maximum number of txs per sender in the capped-priority mempool, 0 means unlimited.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
 `
 
 var configTemplate *template.Template
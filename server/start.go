@@ -85,7 +85,10 @@ const (
 	flagGRPCWebAddress = "grpc-web.address"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolType            = "mempool.type"
+	FlagMempoolMaxBytes        = "mempool.max-bytes"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
 
 	// db-related flags
 	FlagDBCache                 = "db.cache"
@@ -213,6 +216,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolType, serverconfig.MempoolTypeSenderNonce, "Sets the app-side mempool implementation (sender-nonce|capped-priority)")
+	cmd.Flags().Int64(FlagMempoolMaxBytes, 0, "Sets the maximum total tx bytes of the capped-priority mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs per sender of the capped-priority mempool")
 
 	cmd.Flags().Int(FlagDBCache, 1024, "Megabytes of memory allocated to database caching")
 	cmd.Flags().Int(FlagDBFDLimit, 500, "Raise the open file descriptor resource limit")
@@ -479,6 +479,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	mp, err := GetMempool(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	snapshotOptions := snapshottypes.NewSnapshotOptions(
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
@@ -497,11 +502,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
-		baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))),
-			),
-		),
+		baseapp.SetMempool(mp),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		baseapp.SetChainID(chainID),
 		baseapp.SetEnableUnsafeQuery(cast.ToBool(appOpts.Get(FlagEnableUnsafeQuery))),
@@ -509,6 +510,25 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	}
 }
 
+// GetMempool returns the app-side mempool configured by the app options, or an error if the mempool
+// type is unknown.
+func GetMempool(appOpts types.AppOptions) (mempool.Mempool, error) {
+	maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))
+
+	switch mempoolType := cast.ToString(appOpts.Get(FlagMempoolType)); mempoolType {
+	case "", config.MempoolTypeSenderNonce:
+		return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(maxTxs)), nil
+	case config.MempoolTypeCappedPriority:
+		return mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+			mempool.CappedPriorityWithMaxTx(maxTxs),
+			mempool.CappedPriorityWithMaxBytes(cast.ToInt64(appOpts.Get(FlagMempoolMaxBytes))),
+			mempool.CappedPriorityWithMaxTxsPerSender(cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown mempool type: %s", mempoolType)
+	}
+}
+
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
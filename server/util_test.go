@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -441,6 +442,19 @@ func TestEmptyMinGasPrices(t *testing.T) {
 	require.Errorf(t, err, sdkerrors.ErrAppConfig.Error())
 }
 
+func TestGetMempool(t *testing.T) {
+	mp, err := server.GetMempool(mapGetter{})
+	require.NoError(t, err)
+	require.IsType(t, &mempool.SenderNonceMempool{}, mp)
+
+	mp, err = server.GetMempool(mapGetter{server.FlagMempoolType: config.MempoolTypeCappedPriority})
+	require.NoError(t, err)
+	require.IsType(t, &mempool.CappedPriorityMempool{}, mp)
+
+	_, err = server.GetMempool(mapGetter{server.FlagMempoolType: "unknown"})
+	require.ErrorContains(t, err, "unknown mempool type: unknown")
+}
+
 type mapGetter map[string]interface{}
 
 func (m mapGetter) Get(key string) interface{} {
@@ -3,6 +3,7 @@ package simapp
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -279,3 +281,41 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
+
+func TestMsgGasParamsCoverage(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// the msgs without msg gas params are rejected by the ante handler
+	require.Empty(t, gashub.CheckMsgGasParamsCoverage(ctx, app.GashubKeeper, app.MsgServiceRouter()))
+
+	// a validator created by its operator costs as much as one created by MsgCreateValidator
+	createValidator := app.GashubKeeper.GetMsgGasParams(ctx, sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}))
+	createValidatorSelf := app.GashubKeeper.GetMsgGasParams(ctx, sdk.MsgTypeURL(&stakingtypes.MsgCreateValidatorSelf{}))
+	require.NotNil(t, createValidator.GetFixedType())
+	require.Equal(t, createValidator.GetFixedType(), createValidatorSelf.GetFixedType())
+}
+
+func TestEthGasPrice(t *testing.T) {
+	app := Setup(t, false)
+	ethGasPrice := func() *big.Int {
+		res := app.EthQuery(abci.RequestEthQuery{Request: []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_gasPrice"}`)})
+		require.Zero(t, res.Code, res.Log)
+		return new(big.Int).SetBytes(res.Response)
+	}
+
+	// the default gas price is returned while the base fee is disabled
+	require.Equal(t, big.NewInt(baseapp.DefaultEthGasPrice), ethGasPrice())
+
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	params := app.GashubKeeper.GetParams(ctx)
+	params.FeeMarket.Enabled = true
+	params.FeeMarket.MinBaseFee = sdk.NewDecWithPrec(75, 1)
+	require.NoError(t, app.GashubKeeper.SetParams(ctx, params))
+	app.GashubKeeper.SetBaseFee(ctx, params.FeeMarket.MinBaseFee)
+	app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+
+	// the base fee is rounded up
+	require.Equal(t, big.NewInt(8), ethGasPrice())
+}
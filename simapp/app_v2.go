@@ -10,6 +10,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/x/crosschain"
 	"github.com/cosmos/cosmos-sdk/x/oracle"
@@ -244,6 +245,10 @@ func NewSimApp(
 		panic(err)
 	}
 
+	if err := app.NFTKeeper.RegisterCrossChainMirrorApp(); err != nil {
+		panic(err)
+	}
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
@@ -299,6 +304,9 @@ func NewSimApp(
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
 
+	// eth_gasPrice returns the base fee of x/gashub
+	app.EthQueryRouter().RegisterEthQueryGasPriceHandler(app.GashubKeeper, gashubkeeper.EthQueryGasPriceHandlerGen)
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
@@ -324,6 +332,9 @@ func NewSimApp(
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
+	if loadLatest && app.LastBlockHeight() > 0 {
+		gashub.CheckMsgGasParamsCoverage(app.NewUncachedContext(true, tmproto.Header{}), app.GashubKeeper, app.MsgServiceRouter())
+	}
 
 	return app
 }
@@ -483,6 +483,36 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
+// VerifyLocalSnapshot checks the chunks of a local snapshot against its metadata and restores the
+// multistore part of it into target, which should be backed by a scratch database. Extension payloads
+// are not restored. The caller can then compare the commit info of target with the expected one.
+func (m *Manager) VerifyLocalSnapshot(height uint64, format uint32, target types.Snapshotter) (*types.Snapshot, error) {
+	snapshot, err := m.store.VerifyChunks(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Format != types.CurrentFormat {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	_, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return nil, err
+	}
+	defer DrainChunks(chChunks)
+
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return nil, err
+	}
+	defer streamReader.Close()
+
+	if _, err := target.Restore(snapshot.Height, snapshot.Format, streamReader); err != nil {
+		return nil, sdkerrors.Wrap(err, "multistore restore")
+	}
+	return snapshot, nil
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -243,3 +243,37 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_VerifyLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	snapshotter := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+	err := manager.RegisterExtensions(newExtSnapshotter(10))
+	require.NoError(t, err)
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	// Verifying a snapshot with an unsupported format should error
+	_, err = manager.VerifyLocalSnapshot(2, 1, &mockSnapshotter{})
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// Verifying restores the multistore items into the target only
+	target := &mockSnapshotter{}
+	verified, err := manager.VerifyLocalSnapshot(snapshot.Height, snapshot.Format, target)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, verified)
+	assert.Equal(t, items, target.items)
+
+	// A failing restore should be reported
+	_, err = manager.VerifyLocalSnapshot(snapshot.Height, snapshot.Format, target)
+	require.Error(t, err)
+}
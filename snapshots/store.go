@@ -1,8 +1,10 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
+	"fmt"
 	"hash"
 	"io"
 	"math"
@@ -174,6 +176,54 @@ func (s *Store) LoadChunk(height uint64, format, chunk uint32) (io.ReadCloser, e
 	return file, err
 }
 
+// VerifyChunks checks that every chunk of a snapshot exists on disk and matches the chunk hashes
+// recorded in its metadata, as well as the overall snapshot hash. It returns the snapshot metadata.
+func (s *Store) VerifyChunks(height uint64, format uint32) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := s.hashChunkFile(height, format, i, chunkHasher, snapshotHasher); err != nil {
+			return nil, err
+		}
+		if expected := snapshot.Metadata.ChunkHashes[i]; !bytes.Equal(chunkHasher.Sum(nil), expected) {
+			return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+				"chunk %d: expected %x, got %x", i, expected, chunkHasher.Sum(nil))
+		}
+	}
+	if !bytes.Equal(snapshotHasher.Sum(nil), snapshot.Hash) {
+		return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+			"snapshot: expected %x, got %x", snapshot.Hash, snapshotHasher.Sum(nil))
+	}
+	return snapshot, nil
+}
+
+// hashChunkFile feeds the content of a chunk file into the given hashers, resetting the chunk hasher first.
+func (s *Store) hashChunkFile(height uint64, format, index uint32, chunkHasher, snapshotHasher hash.Hash) error {
+	chunk, err := s.loadChunkFile(height, format, index)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to open snapshot chunk %d", index)
+	}
+	defer chunk.Close()
+
+	chunkHasher.Reset()
+	if _, err := io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk); err != nil {
+		return sdkerrors.Wrapf(err, "failed to read snapshot chunk %d", index)
+	}
+	return nil
+}
+
 // loadChunkFile loads a chunk from disk, and errors if it does not exist.
 func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadCloser, error) {
 	path := s.PathChunk(height, format, chunk)
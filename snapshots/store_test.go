@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -227,6 +228,30 @@ func TestStore_LoadChunk(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStore_VerifyChunks(t *testing.T) {
+	store := setupStore(t)
+	// Verifying a missing snapshot should error
+	_, err := store.VerifyChunks(9, 9)
+	require.Error(t, err)
+
+	// Verifying an intact snapshot should return its metadata
+	snapshot, err := store.VerifyChunks(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), snapshot.Chunks)
+
+	// Corrupting a chunk should be detected
+	err = os.WriteFile(store.PathChunk(2, 2, 1), []byte{9, 9, 9}, 0o600)
+	require.NoError(t, err)
+	_, err = store.VerifyChunks(2, 2)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	// Removing a chunk should be detected
+	err = os.Remove(store.PathChunk(2, 1, 0))
+	require.NoError(t, err)
+	_, err = store.VerifyChunks(2, 1)
+	require.Error(t, err)
+}
+
 func TestStore_Prune(t *testing.T) {
 	store := setupStore(t)
 	// Pruning too many snapshots should be fine
@@ -0,0 +1,47 @@
+package snapshots
+
+import (
+	"bytes"
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StoreMismatch describes a store whose commit hash differs between the expected commit info and
+// the commit info recomputed from a restored snapshot. A nil hash means the store is missing on
+// that side.
+type StoreMismatch struct {
+	Name     string
+	Expected []byte
+	Actual   []byte
+}
+
+// CompareCommitInfo compares the store hashes of two commit infos and returns the mismatching
+// stores sorted by name. An empty result means both commit infos hash to the same app hash.
+func CompareCommitInfo(expected, actual storetypes.CommitInfo) []StoreMismatch {
+	expectedHashes := make(map[string][]byte, len(expected.StoreInfos))
+	for _, info := range expected.StoreInfos {
+		expectedHashes[info.Name] = info.GetHash()
+	}
+	actualHashes := make(map[string][]byte, len(actual.StoreInfos))
+	for _, info := range actual.StoreInfos {
+		actualHashes[info.Name] = info.GetHash()
+	}
+
+	var mismatches []StoreMismatch
+	for name, expectedHash := range expectedHashes {
+		actualHash, ok := actualHashes[name]
+		if !ok || !bytes.Equal(expectedHash, actualHash) {
+			mismatches = append(mismatches, StoreMismatch{Name: name, Expected: expectedHash, Actual: actualHash})
+		}
+	}
+	for name, actualHash := range actualHashes {
+		if _, ok := expectedHashes[name]; !ok {
+			mismatches = append(mismatches, StoreMismatch{Name: name, Actual: actualHash})
+		}
+	}
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Name < mismatches[j].Name
+	})
+	return mismatches
+}
@@ -0,0 +1,39 @@
+package snapshots_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCompareCommitInfo(t *testing.T) {
+	storeInfo := func(name string, hash []byte) storetypes.StoreInfo {
+		return storetypes.StoreInfo{Name: name, CommitId: storetypes.CommitID{Version: 3, Hash: hash}}
+	}
+	expected := storetypes.CommitInfo{
+		Version:    3,
+		StoreInfos: []storetypes.StoreInfo{storeInfo("bank", []byte{1}), storeInfo("acc", []byte{2}), storeInfo("gov", []byte{3})},
+	}
+
+	// identical commit infos, in any order, have no mismatches
+	actual := storetypes.CommitInfo{
+		Version:    3,
+		StoreInfos: []storetypes.StoreInfo{storeInfo("gov", []byte{3}), storeInfo("acc", []byte{2}), storeInfo("bank", []byte{1})},
+	}
+	require.Empty(t, snapshots.CompareCommitInfo(expected, actual))
+	require.Equal(t, expected.Hash(), actual.Hash())
+
+	// changed, missing and extra stores are all reported, sorted by name
+	actual = storetypes.CommitInfo{
+		Version:    3,
+		StoreInfos: []storetypes.StoreInfo{storeInfo("acc", []byte{9}), storeInfo("bank", []byte{1}), storeInfo("nft", []byte{4})},
+	}
+	require.Equal(t, []snapshots.StoreMismatch{
+		{Name: "acc", Expected: []byte{2}, Actual: []byte{9}},
+		{Name: "gov", Expected: []byte{3}},
+		{Name: "nft", Actual: []byte{4}},
+	}, snapshots.CompareCommitInfo(expected, actual))
+}
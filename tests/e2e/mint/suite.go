@@ -65,7 +65,7 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","inflation_schedule":{"schedule_type":"INFLATION_SCHEDULE_TYPE_BONDED_RATIO","annual_rate":"0.000000000000000000","halving_interval":"0","max_supply":"0","steps":[]}}`,
 		},
 		{
 			"text output",
@@ -75,6 +75,12 @@ goal_bonded: "0.670000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
+inflation_schedule:
+  annual_rate: "0.000000000000000000"
+  halving_interval: "0"
+  max_supply: "0"
+  schedule_type: INFLATION_SCHEDULE_TYPE_BONDED_RATIO
+  steps: []
 mint_denom: stake`,
 		},
 	}
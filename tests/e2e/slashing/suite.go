@@ -114,12 +114,18 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","max_maintenance_duration":"0","maintenance_missed_block_weight":"0.100000000000000000","maintenance_period":"100000","max_maintenance_windows_per_period":2,"max_concurrent_maintenance":1,"min_maintenance_notice":"100"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`downtime_jail_duration: 600s
+maintenance_missed_block_weight: "0.100000000000000000"
+maintenance_period: "100000"
+max_concurrent_maintenance: 1
+max_maintenance_duration: "0"
+max_maintenance_windows_per_period: 2
+min_maintenance_notice: "100"
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
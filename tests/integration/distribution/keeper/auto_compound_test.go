@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+)
+
+type autoCompoundFixture struct {
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	distrKeeper   keeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
+	tstaking      *stakingtestutil.Helper
+	addrs         []sdk.AccAddress
+	valAddr       sdk.AccAddress
+}
+
+// setupAutoCompound creates a bonded validator with no commission, self delegated by addrs[0] and
+// delegated by addrs[1] with the same amount
+func setupAutoCompound(t *testing.T) *autoCompoundFixture {
+	f := &autoCompoundFixture{}
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&f.accountKeeper,
+		&f.bankKeeper,
+		&f.distrKeeper,
+		&f.stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	f.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	f.addrs = simtestutil.AddTestAddrs(f.bankKeeper, f.stakingKeeper, f.ctx, 2, sdk.NewInt(1000000000))
+	f.valAddr = f.addrs[0]
+
+	f.tstaking = stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+	f.tstaking.Commission = stakingtestutil.ZeroCommission()
+	f.tstaking.CreateValidatorWithValPower(f.valAddr, valConsPk0, 100, true)
+	f.tstaking.DelegateWithPower(f.addrs[1], f.valAddr, 100)
+
+	// end block to bond validator
+	staking.EndBlocker(f.ctx, f.stakingKeeper)
+
+	params := f.distrKeeper.GetParams(f.ctx)
+	params.AutoCompoundEpoch = 5
+	params.AutoCompoundMinAmount = math.ZeroInt()
+	params.AutoCompoundMaxPerBlock = 100
+	require.NoError(t, f.distrKeeper.SetParams(f.ctx, params))
+
+	return f
+}
+
+// allocateRewards allocates power tokens of rewards to the validator
+func (f *autoCompoundFixture) allocateRewards(t *testing.T, power int64) {
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.ctx, power)
+
+	distrAcc := f.distrKeeper.GetDistributionAccount(f.ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(f.bankKeeper, f.ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	f.accountKeeper.SetModuleAccount(f.ctx, distrAcc)
+
+	val := f.stakingKeeper.Validator(f.ctx, f.valAddr)
+	f.distrKeeper.AllocateTokensToValidator(f.ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, tokens)})
+}
+
+func (f *autoCompoundFixture) delegatedTokens(t *testing.T, delAddr sdk.AccAddress) math.Int {
+	del, found := f.stakingKeeper.GetDelegation(f.ctx, delAddr, f.valAddr)
+	require.True(t, found)
+	val, found := f.stakingKeeper.GetValidator(f.ctx, f.valAddr)
+	require.True(t, found)
+	return val.TokensFromShares(del.Shares).TruncateInt()
+}
+
+func TestSetAutoCompound(t *testing.T) {
+	f := setupAutoCompound(t)
+	ctx, distrKeeper := f.ctx, f.distrKeeper
+
+	// the validator must exist
+	err := distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.addrs[1], true)
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+
+	// disabling without record does not create one
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.addrs[1], false))
+	_, exists := distrKeeper.GetAutoCompoundRecord(ctx, f.addrs[1], f.addrs[1])
+	require.False(t, exists)
+
+	// the delegation must exist
+	addrs := simtestutil.AddTestAddrs(f.bankKeeper, f.stakingKeeper, ctx, 1, sdk.NewInt(1000000000))
+	err = distrKeeper.SetAutoCompound(ctx, addrs[0], f.valAddr, true)
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	// the rewards must be withdrawn to the delegator
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, f.addrs[1], f.addrs[0]))
+	err = distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.valAddr, true)
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, f.addrs[1], f.addrs[1]))
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.valAddr, true))
+
+	record, found := distrKeeper.GetAutoCompoundRecord(ctx, f.addrs[1], f.valAddr)
+	require.True(t, found)
+	require.True(t, record.Enabled)
+	require.True(t, record.TotalCompounded.IsZero())
+
+	// disabling keeps the record
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.valAddr, false))
+	record, found = distrKeeper.GetAutoCompoundRecord(ctx, f.addrs[1], f.valAddr)
+	require.True(t, found)
+	require.False(t, record.Enabled)
+
+	// removing the delegation disables the auto-compounding
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, f.addrs[1], f.valAddr, true))
+	f.tstaking.Undelegate(f.addrs[1], f.valAddr, f.delegatedTokens(t, f.addrs[1]), true)
+	record, found = distrKeeper.GetAutoCompoundRecord(ctx, f.addrs[1], f.valAddr)
+	require.True(t, found)
+	require.False(t, record.Enabled)
+}
+
+func TestAutoCompound(t *testing.T) {
+	f := setupAutoCompound(t)
+	distrKeeper := f.distrKeeper
+
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[1], f.valAddr, true))
+
+	initialTokens := f.delegatedTokens(t, f.addrs[1])
+	initialBalance := f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1])
+
+	// each delegator is entitled to half of the rewards
+	f.allocateRewards(t, 20)
+	reward := f.stakingKeeper.TokensFromConsensusPower(f.ctx, 10)
+
+	// nothing is compounded out of the epoch
+	f.ctx = f.ctx.WithBlockHeight(4)
+	distribution.EndBlocker(f.ctx, distrKeeper)
+	require.Equal(t, initialTokens, f.delegatedTokens(t, f.addrs[1]))
+
+	f.ctx = f.ctx.WithBlockHeight(5)
+	distribution.EndBlocker(f.ctx, distrKeeper)
+
+	// the rewards are delegated, the balance is unchanged
+	require.Equal(t, initialTokens.Add(reward), f.delegatedTokens(t, f.addrs[1]))
+	require.Equal(t, initialBalance, f.bankKeeper.GetAllBalances(f.ctx, f.addrs[1]))
+
+	record, found := distrKeeper.GetAutoCompoundRecord(f.ctx, f.addrs[1], f.valAddr)
+	require.True(t, found)
+	require.Equal(t, reward, record.TotalCompounded)
+	require.Equal(t, uint64(1), record.CompoundCount)
+	require.Equal(t, []types.AutoCompoundEntry{{Height: 5, Amount: reward}}, record.History)
+
+	// the self delegation without auto-compounding is unchanged
+	_, found = distrKeeper.GetAutoCompoundRecord(f.ctx, f.addrs[0], f.valAddr)
+	require.False(t, found)
+	require.Equal(t, f.stakingKeeper.TokensFromConsensusPower(f.ctx, 100), f.delegatedTokens(t, f.addrs[0]))
+
+	// no rewards are compounded once disabled
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[1], f.valAddr, false))
+	f.allocateRewards(t, 20)
+	f.ctx = f.ctx.WithBlockHeight(10)
+	distribution.EndBlocker(f.ctx, distrKeeper)
+	require.Equal(t, initialTokens.Add(reward), f.delegatedTokens(t, f.addrs[1]))
+}
+
+func TestAutoCompoundMinAmount(t *testing.T) {
+	f := setupAutoCompound(t)
+	distrKeeper := f.distrKeeper
+
+	params := distrKeeper.GetParams(f.ctx)
+	params.AutoCompoundMinAmount = f.stakingKeeper.TokensFromConsensusPower(f.ctx, 15)
+	require.NoError(t, distrKeeper.SetParams(f.ctx, params))
+
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[1], f.valAddr, true))
+	initialTokens := f.delegatedTokens(t, f.addrs[1])
+
+	// the rewards below the min amount keep accruing
+	f.allocateRewards(t, 20)
+	f.ctx = f.ctx.WithBlockHeight(5)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, initialTokens, f.delegatedTokens(t, f.addrs[1]))
+
+	record, _ := distrKeeper.GetAutoCompoundRecord(f.ctx, f.addrs[1], f.valAddr)
+	require.Equal(t, uint64(0), record.CompoundCount)
+
+	f.allocateRewards(t, 20)
+	f.ctx = f.ctx.WithBlockHeight(10)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, initialTokens.Add(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 20)), f.delegatedTokens(t, f.addrs[1]))
+}
+
+func TestAutoCompoundMaxPerBlock(t *testing.T) {
+	f := setupAutoCompound(t)
+	distrKeeper := f.distrKeeper
+
+	params := distrKeeper.GetParams(f.ctx)
+	params.AutoCompoundMaxPerBlock = 1
+	require.NoError(t, distrKeeper.SetParams(f.ctx, params))
+
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[0], f.valAddr, true))
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[1], f.valAddr, true))
+	f.allocateRewards(t, 20)
+
+	compoundCount := func() (count uint64) {
+		distrKeeper.IterateAutoCompoundRecords(f.ctx, func(record types.AutoCompoundRecord) (stop bool) {
+			count += record.CompoundCount
+			return false
+		})
+		return count
+	}
+
+	// the pass over the enabled delegations continues in the next blocks
+	f.ctx = f.ctx.WithBlockHeight(5)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(1), compoundCount())
+
+	f.ctx = f.ctx.WithBlockHeight(6)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(2), compoundCount())
+
+	// the pass is done
+	f.allocateRewards(t, 20)
+	f.ctx = f.ctx.WithBlockHeight(7)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(2), compoundCount())
+}
+
+func TestAutoCompoundEpochDisabledDuringPass(t *testing.T) {
+	f := setupAutoCompound(t)
+	distrKeeper := f.distrKeeper
+
+	params := distrKeeper.GetParams(f.ctx)
+	params.AutoCompoundMaxPerBlock = 1
+	require.NoError(t, distrKeeper.SetParams(f.ctx, params))
+
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[0], f.valAddr, true))
+	require.NoError(t, distrKeeper.SetAutoCompound(f.ctx, f.addrs[1], f.valAddr, true))
+	f.allocateRewards(t, 20)
+
+	compoundCount := func() (count uint64) {
+		distrKeeper.IterateAutoCompoundRecords(f.ctx, func(record types.AutoCompoundRecord) (stop bool) {
+			count += record.CompoundCount
+			return false
+		})
+		return count
+	}
+
+	// the pass is interrupted by disabling the auto compound epoch
+	f.ctx = f.ctx.WithBlockHeight(5)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(1), compoundCount())
+
+	params.AutoCompoundEpoch = 0
+	require.NoError(t, distrKeeper.SetParams(f.ctx, params))
+	f.ctx = f.ctx.WithBlockHeight(6)
+	distrKeeper.AutoCompound(f.ctx)
+
+	// the interrupted pass is not resumed once the epoch is set again
+	params.AutoCompoundEpoch = 5
+	require.NoError(t, distrKeeper.SetParams(f.ctx, params))
+	f.ctx = f.ctx.WithBlockHeight(7)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(1), compoundCount())
+
+	// a new pass starts over at the next epoch boundary, with the delegation compounded first
+	var first types.AutoCompoundRecord
+	distrKeeper.IterateAutoCompoundRecords(f.ctx, func(record types.AutoCompoundRecord) (stop bool) {
+		first = record
+		return record.CompoundCount > 0
+	})
+	require.Equal(t, uint64(1), first.CompoundCount)
+
+	f.allocateRewards(t, 20)
+	f.ctx = f.ctx.WithBlockHeight(10)
+	distrKeeper.AutoCompound(f.ctx)
+	require.Equal(t, uint64(2), compoundCount())
+	record, found := distrKeeper.GetAutoCompoundRecord(f.ctx,
+		sdk.MustAccAddressFromHex(first.DelegatorAddress), sdk.MustAccAddressFromHex(first.ValidatorAddress))
+	require.True(t, found)
+	require.Equal(t, uint64(2), record.CompoundCount)
+}
@@ -89,10 +89,13 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:            sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:      sdk.ZeroDec(),
+					BonusProposerReward:     sdk.ZeroDec(),
+					WithdrawAddrEnabled:     true,
+					AutoCompoundEpoch:       100,
+					AutoCompoundMinAmount:   sdk.NewInt(1000),
+					AutoCompoundMaxPerBlock: 50,
 				}
 
 				suite.NoError(suite.distrKeeper.SetParams(ctx, params))
@@ -617,6 +620,83 @@ func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCAutoCompound() {
+	ctx, queryClient, addrs, valAddrs := suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	tstaking := stakingtestutil.NewHelper(suite.T(), ctx, suite.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+	staking.EndBlocker(ctx, suite.stakingKeeper)
+
+	suite.Require().NoError(suite.distrKeeper.SetAutoCompound(ctx, addrs[0], valAddrs[0], true))
+
+	var (
+		req       *types.QueryAutoCompoundRequest
+		expRecord types.AutoCompoundRecord
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryAutoCompoundRequest{}
+			},
+			false,
+		},
+		{
+			"empty validator address",
+			func() {
+				req = &types.QueryAutoCompoundRequest{DelegatorAddress: addrs[0].String()}
+			},
+			false,
+		},
+		{
+			"invalid delegator address",
+			func() {
+				req = &types.QueryAutoCompoundRequest{DelegatorAddress: "invalid", ValidatorAddress: valAddrs[0].String()}
+			},
+			false,
+		},
+		{
+			"no auto compound record",
+			func() {
+				req = &types.QueryAutoCompoundRequest{DelegatorAddress: addrs[1].String(), ValidatorAddress: valAddrs[0].String()}
+				expRecord = types.NewAutoCompoundRecord(addrs[1], valAddrs[0])
+			},
+			true,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryAutoCompoundRequest{DelegatorAddress: addrs[0].String(), ValidatorAddress: valAddrs[0].String()}
+				expRecord = types.NewAutoCompoundRecord(addrs[0], valAddrs[0])
+				expRecord.Enabled = true
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.AutoCompound(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRecord, res.Record)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
 	// reset fee pool
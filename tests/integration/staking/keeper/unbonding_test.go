@@ -185,7 +185,7 @@ func TestValidatorUnbondingOnHold1(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try to unbond validator
-	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	require.NoError(t, app.StakingKeeper.UnbondAllMatureValidators(ctx))
 
 	// Check that validator unbonding is not complete (is not mature yet)
 	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -198,7 +198,7 @@ func TestValidatorUnbondingOnHold1(t *testing.T) {
 	// PROVIDER CHAIN'S UNBONDING PERIOD ENDS - BUT UNBONDING CANNOT COMPLETE
 	ctx = ctx.WithBlockTime(completionTime.Add(time.Duration(1)))
 	ctx = ctx.WithBlockHeight(completionHeight + 1)
-	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	require.NoError(t, app.StakingKeeper.UnbondAllMatureValidators(ctx))
 
 	// Check that validator unbonding is complete
 	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -241,7 +241,7 @@ func TestValidatorUnbondingOnHold2(t *testing.T) {
 	// PROVIDER CHAIN'S UNBONDING PERIOD ENDS - BUT UNBONDING CANNOT COMPLETE
 	ctx = ctx.WithBlockTime(completionTime.Add(time.Duration(1)))
 	ctx = ctx.WithBlockHeight(completionHeight + 1)
-	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	require.NoError(t, app.StakingKeeper.UnbondAllMatureValidators(ctx))
 
 	// Check that unbonding is not complete for both validators
 	validator1, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -260,7 +260,7 @@ func TestValidatorUnbondingOnHold2(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try again to unbond validators
-	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	require.NoError(t, app.StakingKeeper.UnbondAllMatureValidators(ctx))
 
 	// Check that unbonding is complete for validator1, but not for validator2
 	validator1, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -278,7 +278,7 @@ func TestValidatorUnbondingOnHold2(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try again to unbond validators
-	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	require.NoError(t, app.StakingKeeper.UnbondAllMatureValidators(ctx))
 
 	// Check that unbonding is complete for validator2
 	validator2, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
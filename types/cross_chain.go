@@ -26,6 +26,19 @@ const (
 	ChannelForbidden ChannelPermission = 0
 )
 
+func (t CrossChainPackageType) String() string {
+	switch t {
+	case SynCrossChainPackageType:
+		return "SYN"
+	case AckCrossChainPackageType:
+		return "ACK"
+	case FailAckCrossChainPackageType:
+		return "FAIL_ACK"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(t))
+	}
+}
+
 func IsValidCrossChainPackageType(packageType CrossChainPackageType) bool {
 	return packageType == SynCrossChainPackageType || packageType == AckCrossChainPackageType || packageType == FailAckCrossChainPackageType
 }
@@ -0,0 +1,387 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Mempool   = (*CappedPriorityMempool)(nil)
+	_ Inspector = (*CappedPriorityMempool)(nil)
+
+	ErrMempoolSenderMaxCapacity = errors.New("sender reached max tx capacity")
+	ErrMempoolTxTooLarge        = errors.New("tx exceeds mempool byte capacity")
+)
+
+// Inspector is implemented by mempools that can report their contents and usage,
+// e.g. to serve the mempool gRPC query service.
+type Inspector interface {
+	// Stats returns the current usage and limits of the mempool.
+	Stats() Stats
+
+	// Txs returns up to limit transactions in descending priority order. A
+	// limit <= 0 returns all transactions.
+	Txs(limit int) []TxInfo
+}
+
+// Stats defines the usage and limits of a mempool.
+type Stats struct {
+	TxCount         int
+	TotalBytes      int64
+	SenderCount     int
+	MaxTx           int
+	MaxBytes        int64
+	MaxTxsPerSender int
+	// EvictedCount is the number of txs evicted to make room for higher priority txs
+	// since the mempool was created.
+	EvictedCount uint64
+}
+
+// TxInfo defines a transaction in the mempool together with its metadata.
+type TxInfo struct {
+	Sender   string
+	Nonce    uint64
+	Priority int64
+	Size     int64
+	Tx       sdk.Tx
+}
+
+// CappedPriorityMempool is a PriorityNonceMempool with per-sender transaction
+// caps and a total byte cap. When the mempool is full, the lowest priority tx
+// is evicted in favour of a higher priority one instead of rejecting the new tx.
+//
+// The priority is the one set on the context by the ante handler, so it should
+// be paired with a TxFeeChecker that prices txs by their gashub message gas
+// rather than by the gas limit, e.g. ante.NewGashubTxFeeChecker.
+//
+// Unlike the other mempool implementations, CappedPriorityMempool is safe for
+// concurrent use, so that it can be inspected from gRPC queries. Select returns
+// an iterator over a snapshot of the mempool, which is not affected by later
+// insertions and removals.
+type CappedPriorityMempool struct {
+	mtx sync.RWMutex
+
+	pool            *PriorityNonceMempool
+	maxTx           int
+	maxBytes        int64
+	maxTxsPerSender int
+
+	senderCounts map[string]int
+	txSizes      map[txMeta]int64
+	totalBytes   int64
+	evicted      uint64
+}
+
+type CappedPriorityMempoolOption func(*CappedPriorityMempool)
+
+// CappedPriorityWithMaxTx sets the maximum number of transactions allowed in
+// the mempool with the semantics:
+//
+// <0: disabled, `Insert` is a no-op
+// 0: unlimited
+// >0: maximum number of transactions allowed
+func CappedPriorityWithMaxTx(maxTx int) CappedPriorityMempoolOption {
+	return func(mp *CappedPriorityMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// CappedPriorityWithMaxBytes sets the maximum total size in bytes of the
+// transactions in the mempool, 0 means unlimited.
+func CappedPriorityWithMaxBytes(maxBytes int64) CappedPriorityMempoolOption {
+	return func(mp *CappedPriorityMempool) {
+		mp.maxBytes = maxBytes
+	}
+}
+
+// CappedPriorityWithMaxTxsPerSender sets the maximum number of transactions a
+// single sender may have in the mempool, 0 means unlimited.
+func CappedPriorityWithMaxTxsPerSender(maxTxsPerSender int) CappedPriorityMempoolOption {
+	return func(mp *CappedPriorityMempool) {
+		mp.maxTxsPerSender = maxTxsPerSender
+	}
+}
+
+// NewCappedPriorityMempool returns a new CappedPriorityMempool. The underlying
+// PriorityNonceMempool is configured with the given options, its max tx option
+// is ignored in favour of CappedPriorityWithMaxTx.
+func NewCappedPriorityMempool(opts []CappedPriorityMempoolOption, poolOpts ...PriorityNonceMempoolOption) *CappedPriorityMempool {
+	pool := NewPriorityMempool(poolOpts...)
+	pool.maxTx = 0
+
+	mp := &CappedPriorityMempool{
+		pool:         pool,
+		senderCounts: make(map[string]int),
+		txSizes:      make(map[txMeta]int64),
+	}
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert attempts to insert a Tx into the mempool. The size of the tx is taken
+// from the tx bytes of the context.
+//
+// If the sender already has the maximum number of txs in the mempool, the tx is
+// rejected. If the mempool is full, txs with a lower priority than the new tx are
+// evicted, lowest first, until there is room for it. Evicting a tx also evicts the
+// txs of the same sender with a higher nonce, as they could no longer be included.
+// The evicted txs are restored if the tx is not inserted.
+func (mp *CappedPriorityMempool) Insert(ctx context.Context, tx sdk.Tx) (err error) {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	priority := sdkContext.Priority()
+	size := int64(len(sdkContext.TxBytes()))
+	if mp.maxBytes > 0 && size > mp.maxBytes {
+		return ErrMempoolTxTooLarge
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sk := txMeta{nonce: nonce, sender: sender}
+	oldSize, replacing := mp.txSizes[sk]
+	if !replacing && mp.maxTxsPerSender > 0 && mp.senderCounts[sender] >= mp.maxTxsPerSender {
+		return ErrMempoolSenderMaxCapacity
+	}
+
+	var evicted []evictedTx
+	defer func() {
+		if err != nil {
+			mp.restore(sdkContext, evicted)
+		}
+	}()
+
+	for mp.isFull(replacing, size-oldSize) {
+		victims, err := mp.evictLowest(sender, nonce, priority)
+		evicted = append(evicted, victims...)
+		if err != nil {
+			return err
+		}
+		// the replaced tx may have been evicted together with its sender's later txs
+		oldSize, replacing = mp.txSizes[sk]
+	}
+
+	if err := mp.pool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	if !replacing {
+		mp.senderCounts[sender]++
+	}
+	mp.txSizes[sk] = size
+	mp.totalBytes += size - oldSize
+
+	return nil
+}
+
+// isFull returns true if there is no room for a tx adding the given number of bytes.
+func (mp *CappedPriorityMempool) isFull(replacing bool, extraBytes int64) bool {
+	if !replacing && mp.maxTx > 0 && mp.pool.CountTx() >= mp.maxTx {
+		return true
+	}
+	return mp.maxBytes > 0 && mp.totalBytes+extraBytes > mp.maxBytes
+}
+
+// evictedTx is a tx evicted by Insert, kept to be restored if the insertion fails.
+type evictedTx struct {
+	tx       sdk.Tx
+	priority int64
+	size     int64
+}
+
+// evictLowest evicts the lowest priority tx in the mempool, and the later txs of
+// the same sender, if it has a lower priority than the tx being inserted. The
+// evicted txs are returned even if an error is returned.
+func (mp *CappedPriorityMempool) evictLowest(sender string, nonce uint64, priority int64) ([]evictedTx, error) {
+	lowest := mp.pool.priorityIndex.Back()
+	if lowest == nil {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+	key := lowest.Key().(txMeta)
+	if key.priority >= priority || (key.sender == sender && key.nonce < nonce) {
+		return nil, ErrMempoolTxMaxCapacity
+	}
+
+	senderIndex := mp.pool.senderIndices[key.sender]
+	var victims []evictedTx
+	for e := senderIndex.Back(); e != nil; e = e.Prev() {
+		sk := txMeta{nonce: e.Key().(txMeta).nonce, sender: key.sender}
+		victims = append(victims, evictedTx{
+			tx:       e.Value.(sdk.Tx),
+			priority: mp.pool.scores[sk].priority,
+			size:     mp.txSizes[sk],
+		})
+		if sk.nonce == key.nonce {
+			break
+		}
+	}
+
+	var evicted []evictedTx
+	for _, victim := range victims {
+		if err := mp.remove(victim.tx); err != nil {
+			return evicted, err
+		}
+		evicted = append(evicted, victim)
+		mp.evicted++
+	}
+
+	return evicted, nil
+}
+
+// restore inserts back the txs evicted by an insertion which failed.
+func (mp *CappedPriorityMempool) restore(ctx sdk.Context, evicted []evictedTx) {
+	for _, e := range evicted {
+		// the txs were in the mempool, so they fit in it again
+		if err := mp.pool.Insert(ctx.WithPriority(e.priority), e.tx); err != nil {
+			continue
+		}
+		sender, nonce, err := txSenderNonce(e.tx)
+		if err != nil {
+			continue
+		}
+		mp.senderCounts[sender]++
+		mp.txSizes[txMeta{nonce: nonce, sender: sender}] = e.size
+		mp.totalBytes += e.size
+		mp.evicted--
+	}
+}
+
+// Select returns an iterator over a snapshot of the mempool, in the order of
+// PriorityNonceMempool.Select.
+func (mp *CappedPriorityMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	var snapshot []sdk.Tx
+	for it := mp.pool.Select(ctx, txs); it != nil; it = it.Next() {
+		snapshot = append(snapshot, it.Tx())
+	}
+	if len(snapshot) == 0 {
+		return nil
+	}
+
+	return &snapshotIterator{txs: snapshot}
+}
+
+// snapshotIterator iterates over the txs selected from a CappedPriorityMempool.
+type snapshotIterator struct {
+	txs []sdk.Tx
+	i   int
+}
+
+func (it *snapshotIterator) Next() Iterator {
+	if it.i+1 >= len(it.txs) {
+		return nil
+	}
+	return &snapshotIterator{txs: it.txs, i: it.i + 1}
+}
+
+func (it *snapshotIterator) Tx() sdk.Tx {
+	return it.txs[it.i]
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *CappedPriorityMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.pool.CountTx()
+}
+
+// Remove removes a transaction from the mempool, returning an error if
+// unsuccessful.
+func (mp *CappedPriorityMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.remove(tx)
+}
+
+func (mp *CappedPriorityMempool) remove(tx sdk.Tx) error {
+	if err := mp.pool.Remove(tx); err != nil {
+		return err
+	}
+
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+	sk := txMeta{nonce: nonce, sender: sender}
+	mp.totalBytes -= mp.txSizes[sk]
+	delete(mp.txSizes, sk)
+	mp.senderCounts[sender]--
+	if mp.senderCounts[sender] <= 0 {
+		delete(mp.senderCounts, sender)
+	}
+
+	return nil
+}
+
+// Stats implements Inspector.
+func (mp *CappedPriorityMempool) Stats() Stats {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return Stats{
+		TxCount:         mp.pool.CountTx(),
+		TotalBytes:      mp.totalBytes,
+		SenderCount:     len(mp.senderCounts),
+		MaxTx:           mp.maxTx,
+		MaxBytes:        mp.maxBytes,
+		MaxTxsPerSender: mp.maxTxsPerSender,
+		EvictedCount:    mp.evicted,
+	}
+}
+
+// Txs implements Inspector.
+func (mp *CappedPriorityMempool) Txs(limit int) []TxInfo {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	var txs []TxInfo
+	for e := mp.pool.priorityIndex.Front(); e != nil; e = e.Next() {
+		if limit > 0 && len(txs) >= limit {
+			break
+		}
+		key := e.Key().(txMeta)
+		txs = append(txs, TxInfo{
+			Sender:   key.sender,
+			Nonce:    key.nonce,
+			Priority: key.priority,
+			Size:     mp.txSizes[txMeta{nonce: key.nonce, sender: key.sender}],
+			Tx:       e.Value.(sdk.Tx),
+		})
+	}
+
+	return txs
+}
+
+// txSenderNonce returns the sender and nonce of a tx, derived from its first signature.
+func txSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+	if len(sigs) == 0 {
+		return "", 0, fmt.Errorf("tx must have at least one signer")
+	}
+
+	sig := sigs[0]
+	return sdk.AccAddress(sig.PubKey.Address()).String(), sig.Sequence, nil
+}
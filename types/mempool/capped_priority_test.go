@@ -0,0 +1,194 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestCappedPriorityMempool_MaxTxsPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+		mempool.CappedPriorityWithMaxTxsPerSender(2),
+	})
+
+	txs := []testTx{
+		{priority: 10, nonce: 1, address: sa},
+		{priority: 10, nonce: 2, address: sa},
+		{priority: 10, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	err := mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolSenderMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// replacing an existing tx of the sender does not count against the cap
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{priority: 20, nonce: 2, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// removing a tx frees up room for the sender
+	require.NoError(t, mp.Remove(txs[0]))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa}))
+
+	stats := mp.Stats()
+	require.Equal(t, 3, stats.TxCount)
+	require.Equal(t, 2, stats.SenderCount)
+	require.Equal(t, 2, stats.MaxTxsPerSender)
+}
+
+func TestCappedPriorityMempool_MaxBytes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+		mempool.CappedPriorityWithMaxBytes(100),
+	})
+
+	err := mp.Insert(ctx.WithPriority(10).WithTxBytes(make([]byte, 101)), testTx{priority: 10, nonce: 1, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxTooLarge)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10).WithTxBytes(make([]byte, 40)), testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20).WithTxBytes(make([]byte, 40)), testTx{priority: 20, nonce: 1, address: sb}))
+	require.Equal(t, int64(80), mp.Stats().TotalBytes)
+
+	// a lower priority tx that does not fit is rejected
+	err = mp.Insert(ctx.WithPriority(5).WithTxBytes(make([]byte, 40)), testTx{priority: 5, nonce: 1, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// a higher priority tx evicts the lowest priority tx
+	require.NoError(t, mp.Insert(ctx.WithPriority(30).WithTxBytes(make([]byte, 40)), testTx{priority: 30, nonce: 1, address: sc}))
+	stats := mp.Stats()
+	require.Equal(t, 2, stats.TxCount)
+	require.Equal(t, int64(80), stats.TotalBytes)
+	require.Equal(t, uint64(1), stats.EvictedCount)
+
+	txs := mp.Txs(0)
+	require.Len(t, txs, 2)
+	require.Equal(t, int64(30), txs[0].Priority)
+	require.Equal(t, sc.String(), txs[0].Sender)
+	require.Equal(t, int64(40), txs[0].Size)
+	require.Equal(t, int64(20), txs[1].Priority)
+}
+
+func TestCappedPriorityMempool_Eviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+		mempool.CappedPriorityWithMaxTx(3),
+	})
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: sa},
+		{priority: 5, nonce: 2, address: sa},
+		{priority: 10, nonce: 3, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// a sender cannot evict its own earlier txs
+	err := mp.Insert(ctx.WithPriority(30), testTx{priority: 30, nonce: 4, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// evicting the lowest priority tx also evicts the later txs of the same sender
+	require.NoError(t, mp.Insert(ctx.WithPriority(15), testTx{priority: 15, nonce: 1, address: sb}))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, uint64(2), mp.Stats().EvictedCount)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{priority: 1, nonce: 1, address: sc}))
+	require.Equal(t, 3, mp.CountTx())
+
+	txs2 := mp.Txs(2)
+	require.Len(t, txs2, 2)
+	require.Equal(t, int64(20), txs2[0].Priority)
+	require.Equal(t, int64(15), txs2[1].Priority)
+
+	// disabled
+	mp = mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+		mempool.CappedPriorityWithMaxTx(-1),
+	})
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), txs[0]))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestCappedPriorityMempool_RestoreEvicted(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewCappedPriorityMempool([]mempool.CappedPriorityMempoolOption{
+		mempool.CappedPriorityWithMaxBytes(100),
+	})
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10).WithTxBytes(make([]byte, 40)), testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20).WithTxBytes(make([]byte, 40)), testTx{priority: 20, nonce: 1, address: sb}))
+
+	// evicting the lowest priority tx does not make enough room, so it is restored
+	err := mp.Insert(ctx.WithPriority(15).WithTxBytes(make([]byte, 80)), testTx{priority: 15, nonce: 1, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	stats := mp.Stats()
+	require.Equal(t, 2, stats.TxCount)
+	require.Equal(t, 2, stats.SenderCount)
+	require.Equal(t, int64(80), stats.TotalBytes)
+	require.Zero(t, stats.EvictedCount)
+
+	txs := mp.Txs(0)
+	require.Len(t, txs, 2)
+	require.Equal(t, sa.String(), txs[1].Sender)
+	require.Equal(t, int64(10), txs[1].Priority)
+	require.Equal(t, int64(40), txs[1].Size)
+}
+
+func TestCappedPriorityMempool_SelectSnapshot(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewCappedPriorityMempool(nil)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: sa},
+		{priority: 10, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	it := mp.Select(ctx, nil)
+
+	// the iterator is not affected by the txs removed and inserted after Select
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Insert(ctx.WithPriority(30), testTx{priority: 30, nonce: 2, address: sa}))
+
+	var selected []sdk.Tx
+	for ; it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	require.Equal(t, []sdk.Tx{txs[0], txs[1]}, selected)
+}
@@ -69,16 +69,22 @@ const (
 	// SIGN_MODE_EIP_712 specifies the sign mode for EIP 712 signing on the Cosmos
 	// SDK. Ref: https://eips.ethereum.org/EIPS/eip-712
 	SignMode_SIGN_MODE_EIP_712 SignMode = 712
+	// SIGN_MODE_ETH_TX specifies the sign mode of native Ethereum transactions,
+	// EIP-155 legacy transactions and EIP-1559 dynamic fee transactions, whose
+	// data carries an ABI-encoded Cosmos message.
+	// Ref: https://eips.ethereum.org/EIPS/eip-155, https://eips.ethereum.org/EIPS/eip-1559
+	SignMode_SIGN_MODE_ETH_TX SignMode = 1559
 )
 
 var SignMode_name = map[int32]string{
-	0:   "SIGN_MODE_UNSPECIFIED",
-	1:   "SIGN_MODE_DIRECT",
-	2:   "SIGN_MODE_TEXTUAL",
-	3:   "SIGN_MODE_DIRECT_AUX",
-	127: "SIGN_MODE_LEGACY_AMINO_JSON",
-	191: "SIGN_MODE_EIP_191",
-	712: "SIGN_MODE_EIP_712",
+	0:    "SIGN_MODE_UNSPECIFIED",
+	1:    "SIGN_MODE_DIRECT",
+	2:    "SIGN_MODE_TEXTUAL",
+	3:    "SIGN_MODE_DIRECT_AUX",
+	127:  "SIGN_MODE_LEGACY_AMINO_JSON",
+	191:  "SIGN_MODE_EIP_191",
+	712:  "SIGN_MODE_EIP_712",
+	1559: "SIGN_MODE_ETH_TX",
 }
 
 var SignMode_value = map[string]int32{
@@ -89,6 +95,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
 	"SIGN_MODE_EIP_712":           712,
+	"SIGN_MODE_ETH_TX":            1559,
 }
 
 func (x SignMode) String() string {
@@ -218,7 +225,6 @@ type SignatureDescriptor_Data struct {
 	// sum is the one of that specifies whether this represents single or multi-signature data
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*SignatureDescriptor_Data_Single_
 	//	*SignatureDescriptor_Data_Multi_
 	Sum isSignatureDescriptor_Data_Sum `protobuf_oneof:"sum"`
@@ -426,44 +432,44 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xed, 0x26, 0xa9, 0xda, 0x29, 0x42, 0x66, 0x49, 0x51, 0x6a, 0x90, 0xa9, 0xca, 0x81,
-	0x0a, 0xa9, 0x6b, 0x25, 0x3d, 0x54, 0xe5, 0xe6, 0x26, 0x26, 0x35, 0x6d, 0xd2, 0x62, 0xa7, 0x52,
-	0xe1, 0x62, 0xd9, 0xce, 0xd6, 0x58, 0x8d, 0xbd, 0xc6, 0xbb, 0x46, 0xf5, 0x89, 0x57, 0xe0, 0x35,
-	0x78, 0x08, 0xc4, 0x81, 0x4b, 0x8f, 0x3d, 0x72, 0x44, 0xed, 0x33, 0x70, 0x47, 0xb5, 0xe3, 0x24,
-	0x40, 0x11, 0xa2, 0x27, 0x6b, 0x66, 0xfe, 0xfd, 0xe6, 0x5f, 0xcd, 0x78, 0xe1, 0xa9, 0x47, 0x59,
-	0x48, 0x99, 0xca, 0xcf, 0x54, 0x16, 0xf8, 0x51, 0x10, 0xf9, 0xea, 0xfb, 0xa6, 0x4b, 0xb8, 0xd3,
-	0x2c, 0x63, 0x1c, 0x27, 0x94, 0x53, 0xb4, 0x52, 0x08, 0x31, 0x3f, 0xc3, 0x65, 0x61, 0x2c, 0x94,
-	0x37, 0xc6, 0x0c, 0x2f, 0xc9, 0x62, 0x4e, 0xd5, 0x30, 0x1d, 0xf1, 0x80, 0x05, 0x53, 0x50, 0x99,
-	0x28, 0x48, 0xf2, 0x8a, 0x4f, 0xa9, 0x3f, 0x22, 0x6a, 0x1e, 0xb9, 0xe9, 0x89, 0xea, 0x44, 0x59,
-	0x51, 0x5a, 0x3b, 0x81, 0xba, 0x15, 0xf8, 0x91, 0xc3, 0xd3, 0x84, 0x74, 0x08, 0xf3, 0x92, 0x20,
-	0xe6, 0x34, 0x61, 0xa8, 0x0f, 0xc0, 0xca, 0x3c, 0x6b, 0x88, 0xab, 0x95, 0xf5, 0xa5, 0x16, 0xc6,
-	0x7f, 0x75, 0x84, 0x6f, 0x80, 0x98, 0x33, 0x84, 0xb5, 0x1f, 0x55, 0xb8, 0x7f, 0x83, 0x06, 0x6d,
-	0x02, 0xc4, 0xa9, 0x3b, 0x0a, 0x3c, 0xfb, 0x94, 0x64, 0x0d, 0x71, 0x55, 0x5c, 0x5f, 0x6a, 0xd5,
-	0x71, 0xe1, 0x17, 0x97, 0x7e, 0xb1, 0x16, 0x65, 0xe6, 0x62, 0xa1, 0xdb, 0x23, 0x19, 0xea, 0x42,
-	0x75, 0xe8, 0x70, 0xa7, 0x31, 0x97, 0xcb, 0x37, 0xff, 0xcf, 0x16, 0xee, 0x38, 0xdc, 0x31, 0x73,
-	0x00, 0x92, 0x61, 0x81, 0x91, 0x77, 0x29, 0x89, 0x3c, 0xd2, 0xa8, 0xac, 0x8a, 0xeb, 0x55, 0x73,
-	0x12, 0xcb, 0x5f, 0x2b, 0x50, 0xbd, 0x96, 0xa2, 0x01, 0xcc, 0xb3, 0x20, 0xf2, 0x47, 0x64, 0x6c,
-	0xef, 0xf9, 0x2d, 0xfa, 0x61, 0x2b, 0x27, 0xec, 0x0a, 0xe6, 0x98, 0x85, 0x5e, 0x41, 0x2d, 0x9f,
-	0xd2, 0xf8, 0x12, 0xdb, 0xb7, 0x81, 0xf6, 0xae, 0x01, 0xbb, 0x82, 0x59, 0x90, 0x64, 0x1b, 0xe6,
-	0x8b, 0x36, 0x68, 0x0b, 0xaa, 0x21, 0x1d, 0x16, 0x86, 0xef, 0xb6, 0x9e, 0xfc, 0x83, 0xdd, 0xa3,
-	0x43, 0x62, 0xe6, 0x07, 0xd0, 0x23, 0x58, 0x9c, 0x0c, 0x2d, 0x77, 0x76, 0xc7, 0x9c, 0x26, 0xe4,
-	0x4f, 0x22, 0xd4, 0xf2, 0x9e, 0x68, 0x0f, 0x16, 0xdc, 0x80, 0x3b, 0x49, 0xe2, 0x94, 0x43, 0x53,
-	0xcb, 0x26, 0xc5, 0x4e, 0xe2, 0xc9, 0x0a, 0x96, 0x9d, 0xda, 0x34, 0x8c, 0x1d, 0x8f, 0xef, 0x04,
-	0x5c, 0xbb, 0x3e, 0x66, 0x4e, 0x00, 0xc8, 0xfa, 0x65, 0xd7, 0xe6, 0xf2, 0x5d, 0xbb, 0xd5, 0x50,
-	0x67, 0x30, 0x3b, 0x35, 0xa8, 0xb0, 0x34, 0x7c, 0xf6, 0x59, 0x84, 0x85, 0xf2, 0x8e, 0x68, 0x05,
-	0x96, 0x2d, 0xa3, 0xdb, 0xb7, 0x7b, 0x07, 0x1d, 0xdd, 0x3e, 0xea, 0x5b, 0x87, 0x7a, 0xdb, 0x78,
-	0x61, 0xe8, 0x1d, 0x49, 0x40, 0x75, 0x90, 0xa6, 0xa5, 0x8e, 0x61, 0xea, 0xed, 0x81, 0x24, 0xa2,
-	0x65, 0xb8, 0x37, 0xcd, 0x0e, 0xf4, 0xe3, 0xc1, 0x91, 0xb6, 0x2f, 0xcd, 0xa1, 0x06, 0xd4, 0x7f,
-	0x17, 0xdb, 0xda, 0xd1, 0xb1, 0x54, 0x41, 0x8f, 0xe1, 0xe1, 0xb4, 0xb2, 0xaf, 0x77, 0xb5, 0xf6,
-	0x6b, 0x5b, 0xeb, 0x19, 0xfd, 0x03, 0xfb, 0xa5, 0x75, 0xd0, 0x97, 0x3e, 0xa0, 0x07, 0xb3, 0x44,
-	0xdd, 0x38, 0xb4, 0x9b, 0xdb, 0x4d, 0xe9, 0x8b, 0xf8, 0x67, 0x7e, 0xab, 0xd9, 0x92, 0xce, 0x6b,
-	0x3b, 0xdd, 0xf3, 0x4b, 0x45, 0xbc, 0xb8, 0x54, 0xc4, 0xef, 0x97, 0x8a, 0xf8, 0xf1, 0x4a, 0x11,
-	0x2e, 0xae, 0x14, 0xe1, 0xdb, 0x95, 0x22, 0xbc, 0xd9, 0xf0, 0x03, 0xfe, 0x36, 0x75, 0xb1, 0x47,
-	0x43, 0xb5, 0x7c, 0x0e, 0xf2, 0xcf, 0x06, 0x1b, 0x9e, 0xaa, 0x3c, 0x8b, 0xc9, 0xec, 0x1b, 0xe3,
-	0xce, 0xe7, 0x3f, 0xd3, 0xe6, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x99, 0x2f, 0x7e, 0x7f,
-	0x04, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x26, 0xa9, 0xda, 0xd7, 0x0a, 0x99, 0x23, 0x45, 0x69, 0x40, 0x26, 0x2a, 0x03,
+	0x15, 0x52, 0xcf, 0x4a, 0x3a, 0x54, 0x65, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x5d, 0xa9,
+	0xb0, 0x58, 0xb6, 0x73, 0x35, 0x56, 0x63, 0x9f, 0xf1, 0x9d, 0x51, 0x3d, 0xf1, 0x15, 0xd8, 0xf8,
+	0x0c, 0x7c, 0x0a, 0x06, 0x96, 0x8e, 0x1d, 0x18, 0x18, 0x51, 0xfb, 0x19, 0xd8, 0x51, 0xed, 0x38,
+	0x09, 0x50, 0x84, 0xe8, 0x64, 0xbd, 0xf7, 0xfe, 0xf7, 0x7b, 0xff, 0xd3, 0x7b, 0x3e, 0x78, 0xe2,
+	0x52, 0x16, 0x50, 0x26, 0xf3, 0x33, 0x99, 0xf9, 0x5e, 0xe8, 0x87, 0x9e, 0xfc, 0xae, 0xe5, 0x10,
+	0x6e, 0xb7, 0x8a, 0x18, 0x47, 0x31, 0xe5, 0x14, 0xad, 0xe6, 0x42, 0xcc, 0xcf, 0x70, 0x51, 0x18,
+	0x0b, 0x1b, 0x1b, 0x63, 0x86, 0x1b, 0xa7, 0x11, 0xa7, 0x72, 0x90, 0x8c, 0xb8, 0xcf, 0xfc, 0x29,
+	0xa8, 0x48, 0xe4, 0xa4, 0xc6, 0xaa, 0x47, 0xa9, 0x37, 0x22, 0x72, 0x16, 0x39, 0xc9, 0x89, 0x6c,
+	0x87, 0x69, 0x5e, 0x5a, 0x3b, 0x81, 0x9a, 0xe1, 0x7b, 0xa1, 0xcd, 0x93, 0x98, 0x74, 0x09, 0x73,
+	0x63, 0x3f, 0xe2, 0x34, 0x66, 0x68, 0x00, 0xc0, 0x8a, 0x3c, 0xab, 0x0b, 0xcd, 0xf2, 0xfa, 0x52,
+	0x1b, 0xe3, 0xbf, 0x3a, 0xc2, 0x37, 0x40, 0xf4, 0x19, 0xc2, 0xda, 0x8f, 0x0a, 0xdc, 0xbb, 0x41,
+	0x83, 0x36, 0x01, 0xa2, 0xc4, 0x19, 0xf9, 0xae, 0x75, 0x4a, 0xd2, 0xba, 0xd0, 0x14, 0xd6, 0x97,
+	0xda, 0x35, 0x9c, 0xfb, 0xc5, 0x85, 0x5f, 0xac, 0x84, 0xa9, 0xbe, 0x98, 0xeb, 0xf6, 0x48, 0x8a,
+	0x7a, 0x50, 0x19, 0xda, 0xdc, 0xae, 0xcf, 0x65, 0xf2, 0xcd, 0xff, 0xb3, 0x85, 0xbb, 0x36, 0xb7,
+	0xf5, 0x0c, 0x80, 0x1a, 0xb0, 0xc0, 0xc8, 0xdb, 0x84, 0x84, 0x2e, 0xa9, 0x97, 0x9b, 0xc2, 0x7a,
+	0x45, 0x9f, 0xc4, 0x8d, 0x2f, 0x65, 0xa8, 0x5c, 0x4b, 0x91, 0x09, 0xf3, 0xcc, 0x0f, 0xbd, 0x11,
+	0x19, 0xdb, 0x7b, 0x76, 0x8b, 0x7e, 0xd8, 0xc8, 0x08, 0xbb, 0x25, 0x7d, 0xcc, 0x42, 0x2f, 0xa1,
+	0x9a, 0x4d, 0x69, 0x7c, 0x89, 0xed, 0xdb, 0x40, 0xfb, 0xd7, 0x80, 0xdd, 0x92, 0x9e, 0x93, 0x1a,
+	0x16, 0xcc, 0xe7, 0x6d, 0xd0, 0x16, 0x54, 0x02, 0x3a, 0xcc, 0x0d, 0xdf, 0x69, 0x3f, 0xfe, 0x07,
+	0xbb, 0x4f, 0x87, 0x44, 0xcf, 0x0e, 0xa0, 0x87, 0xb0, 0x38, 0x19, 0x5a, 0xe6, 0x6c, 0x59, 0x9f,
+	0x26, 0x1a, 0x9f, 0x04, 0xa8, 0x66, 0x3d, 0xd1, 0x1e, 0x2c, 0x38, 0x3e, 0xb7, 0xe3, 0xd8, 0x2e,
+	0x86, 0x26, 0x17, 0x4d, 0xf2, 0x9d, 0xc4, 0x93, 0x15, 0x2c, 0x3a, 0x75, 0x68, 0x10, 0xd9, 0x2e,
+	0xdf, 0xf1, 0xb9, 0x72, 0x7d, 0x4c, 0x9f, 0x00, 0x90, 0xf1, 0xcb, 0xae, 0xcd, 0x35, 0xcb, 0xb7,
+	0x1d, 0xea, 0x0c, 0x66, 0xa7, 0x0a, 0x65, 0x96, 0x04, 0x4f, 0xbf, 0x0a, 0xb0, 0x50, 0xdc, 0x11,
+	0xad, 0xc2, 0x8a, 0xa1, 0xf5, 0x06, 0x56, 0xff, 0xa0, 0xab, 0x5a, 0x47, 0x03, 0xe3, 0x50, 0xed,
+	0x68, 0xcf, 0x35, 0xb5, 0x2b, 0x96, 0x50, 0x0d, 0xc4, 0x69, 0xa9, 0xab, 0xe9, 0x6a, 0xc7, 0x14,
+	0x05, 0xb4, 0x02, 0x77, 0xa7, 0x59, 0x53, 0x3d, 0x36, 0x8f, 0x94, 0x7d, 0x71, 0x0e, 0xd5, 0xa1,
+	0xf6, 0xbb, 0xd8, 0x52, 0x8e, 0x8e, 0xc5, 0x32, 0x7a, 0x04, 0x0f, 0xa6, 0x95, 0x7d, 0xb5, 0xa7,
+	0x74, 0x5e, 0x59, 0x4a, 0x5f, 0x1b, 0x1c, 0x58, 0x2f, 0x8c, 0x83, 0x81, 0xf8, 0x1e, 0xdd, 0x9f,
+	0x25, 0xaa, 0xda, 0xa1, 0xd5, 0xda, 0x6e, 0x89, 0x9f, 0x85, 0x3f, 0xf3, 0x5b, 0xad, 0xb6, 0x78,
+	0x5e, 0x45, 0x2b, 0xb3, 0xbe, 0x54, 0x73, 0xd7, 0x32, 0x8f, 0xc5, 0x8f, 0xcb, 0x3b, 0xbd, 0xf3,
+	0x4b, 0x49, 0xb8, 0xb8, 0x94, 0x84, 0xef, 0x97, 0x92, 0xf0, 0xe1, 0x4a, 0x2a, 0x5d, 0x5c, 0x49,
+	0xa5, 0x6f, 0x57, 0x52, 0xe9, 0xf5, 0x86, 0xe7, 0xf3, 0x37, 0x89, 0x83, 0x5d, 0x1a, 0xc8, 0xc5,
+	0x2b, 0x91, 0x7d, 0x36, 0xd8, 0xf0, 0x54, 0xe6, 0x69, 0x44, 0x66, 0x9f, 0x1e, 0x67, 0x3e, 0xfb,
+	0xc7, 0x36, 0x7f, 0x0e, 0x00, 0x43, 0xb9, 0x07, 0x1e, 0x96, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,46 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeDecorator enforces the base fee of x/gashub. The fee of a tx must cover the base fee
+// for its gas limit, in the base fee denom. In DeliverTx, the base fee part of the fee is moved out
+// of the fee collector, so it must be placed after the DeductFeeDecorator. The base fee is not
+// enforced when simulating, nor for genesis txs.
+type BaseFeeDecorator struct {
+	fmk FeeMarketKeeper
+}
+
+func NewBaseFeeDecorator(fmk FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		fmk: fmk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params := bfd.fmk.GetParams(ctx).FeeMarket
+	if !params.Enabled || simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	gasLimit := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
+	baseFee := sdk.NewCoin(params.Denom, bfd.fmk.GetBaseFee(ctx).Mul(gasLimit).Ceil().RoundInt())
+	if fee := feeTx.GetFee(); fee.AmountOf(params.Denom).LT(baseFee.Amount) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees to pay the base fee; got: %s required: %s", fee, baseFee)
+	}
+
+	if !ctx.IsCheckTx() {
+		if err := bfd.fmk.CollectBaseFee(ctx, sdk.NewCoins(baseFee)); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
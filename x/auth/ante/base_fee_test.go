@@ -0,0 +1,96 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+func TestBaseFeeDecorator(t *testing.T) {
+	feeMarketParams := gashubtypes.DefaultParams()
+	feeMarketParams.FeeMarket.Enabled = true
+
+	testCases := []struct {
+		name       string
+		disabled   bool
+		checkTx    bool
+		simulate   bool
+		fee        sdk.Coins
+		expCollect sdk.Coins
+		expErr     error
+	}{
+		{
+			name: "disabled",
+			// no fee while the base fee is 10/gas
+			disabled: true,
+		},
+		{
+			name:     "simulate",
+			simulate: true,
+		},
+		{
+			name:    "insufficient fee in CheckTx",
+			checkTx: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)),
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "insufficient fee in DeliverTx",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "fee in another denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "base fee is not collected in CheckTx",
+			checkTx: true,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+		{
+			name:       "base fee is collected in DeliverTx",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500)),
+			expCollect: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, tc.checkTx)
+			fmk := antetestutil.NewMockFeeMarketKeeper(gomock.NewController(t))
+
+			params := feeMarketParams
+			params.FeeMarket.Enabled = !tc.disabled
+			fmk.EXPECT().GetParams(gomock.Any()).Return(params).AnyTimes()
+			fmk.EXPECT().GetBaseFee(gomock.Any()).Return(sdk.NewDec(10)).AnyTimes()
+			if tc.expCollect != nil {
+				fmk.EXPECT().CollectBaseFee(gomock.Any(), tc.expCollect).Return(nil)
+			}
+
+			accs := suite.CreateTestAccounts(1)
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(100)
+			tx, err := suite.CreateTestTx(nil, nil, nil, suite.ctx.ChainID())
+			require.NoError(t, err)
+
+			anteHandler := sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(fmk))
+			_, err = anteHandler(suite.ctx, tx, tc.simulate)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package ante
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EthFeeTx is implemented by the txs decoded from native Ethereum transactions, which carry gas price
+// caps rather than a fee.
+type EthFeeTx interface {
+	sdk.FeeTx
+	// GetEthGasFeeCaps returns the max fee per gas and the max priority fee per gas of the tx. ok is
+	// false if the tx was not decoded from a native Ethereum transaction.
+	GetEthGasFeeCaps() (gasFeeCap, gasTipCap *big.Int, ok bool)
+	SetFeeAmount(amount sdk.Coins)
+}
+
+// EthTxFeeDecorator sets the fee of the txs decoded from native Ethereum transactions, in the fee
+// market denom of x/gashub. As in EIP-1559, the gas price of a tx is the base fee plus its max priority
+// fee per gas, up to its max fee per gas, the base fee being 0 while it is disabled. It must be placed
+// before the DeductFeeDecorator. Native Ethereum transactions are rejected without a FeeMarketKeeper.
+type EthTxFeeDecorator struct {
+	fmk FeeMarketKeeper
+}
+
+func NewEthTxFeeDecorator(fmk FeeMarketKeeper) EthTxFeeDecorator {
+	return EthTxFeeDecorator{
+		fmk: fmk,
+	}
+}
+
+func (efd EthTxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ethTx, ok := tx.(EthFeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	gasFeeCap, gasTipCap, ok := ethTx.GetEthGasFeeCaps()
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if efd.fmk == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "native ethereum txs are not supported")
+	}
+	params := efd.fmk.GetParams(ctx).FeeMarket
+	if params.Denom == "" {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the fee market denom of native ethereum txs is not set")
+	}
+
+	gasPrice := gasTipCap
+	if params.Enabled {
+		baseFee := efd.fmk.GetBaseFee(ctx).Ceil().TruncateInt().BigInt()
+		gasPrice = new(big.Int).Add(baseFee, gasTipCap)
+		if gasPrice.Cmp(gasFeeCap) > 0 {
+			gasPrice = gasFeeCap
+		}
+	}
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(ethTx.GetGas()))
+	ethTx.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(params.Denom, sdkmath.NewIntFromBigInt(fee))))
+
+	return next(ctx, tx, simulate)
+}
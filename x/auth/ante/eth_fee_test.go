@@ -0,0 +1,121 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// ethFeeTx mocks a tx decoded from a native Ethereum transaction
+type ethFeeTx struct {
+	sdk.FeeTx
+	gasFeeCap, gasTipCap *big.Int
+	fee                  sdk.Coins
+}
+
+func (tx *ethFeeTx) GetEthGasFeeCaps() (*big.Int, *big.Int, bool) {
+	return tx.gasFeeCap, tx.gasTipCap, tx.gasFeeCap != nil
+}
+
+func (tx *ethFeeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx *ethFeeTx) SetFeeAmount(amount sdk.Coins) { tx.fee = amount }
+
+func TestEthTxFeeDecorator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		noKeeper  bool
+		disabled  bool
+		noDenom   bool
+		gasFeeCap int64
+		gasTipCap int64
+		expFee    sdk.Coins
+		expErr    error
+	}{
+		{
+			name:   "not a native ethereum tx",
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+		},
+		{
+			name:      "max priority fee with the fee market disabled",
+			disabled:  true,
+			gasFeeCap: 30,
+			gasTipCap: 2,
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin("gwei", 200)),
+		},
+		{
+			name:      "base fee plus max priority fee",
+			gasFeeCap: 30,
+			gasTipCap: 2,
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin("gwei", 1300)),
+		},
+		{
+			name:      "max fee caps the gas price",
+			gasFeeCap: 12,
+			gasTipCap: 5,
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin("gwei", 1200)),
+		},
+		{
+			name:      "fee market denom not set",
+			noDenom:   true,
+			gasFeeCap: 30,
+			gasTipCap: 2,
+			expErr:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:      "no fee market keeper",
+			noKeeper:  true,
+			gasFeeCap: 30,
+			gasTipCap: 2,
+			expErr:    sdkerrors.ErrNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			var fmk ante.FeeMarketKeeper
+			if !tc.noKeeper {
+				mock := antetestutil.NewMockFeeMarketKeeper(gomock.NewController(t))
+				params := gashubtypes.DefaultParams()
+				params.FeeMarket.Enabled = !tc.disabled
+				params.FeeMarket.Denom = "gwei"
+				if tc.noDenom {
+					params.FeeMarket.Denom = ""
+				}
+				mock.EXPECT().GetParams(gomock.Any()).Return(params).AnyTimes()
+				// the base fee is rounded up
+				mock.EXPECT().GetBaseFee(gomock.Any()).Return(sdk.NewDecWithPrec(105, 1)).AnyTimes()
+				fmk = mock
+			}
+
+			accs := suite.CreateTestAccounts(1)
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+			suite.txBuilder.SetGasLimit(100)
+			sdkTx, err := suite.CreateTestTx(nil, nil, nil, suite.ctx.ChainID())
+			require.NoError(t, err)
+			tx := &ethFeeTx{FeeTx: sdkTx, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}
+			if tc.gasFeeCap != 0 {
+				tx.gasFeeCap, tx.gasTipCap = big.NewInt(tc.gasFeeCap), big.NewInt(tc.gasTipCap)
+			}
+
+			anteHandler := sdk.ChainAnteDecorators(ante.NewEthTxFeeDecorator(fmk))
+			_, err = anteHandler(suite.ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFee, tx.GetFee())
+		})
+	}
+}
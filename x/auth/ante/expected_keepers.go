@@ -25,3 +25,10 @@ type GashubKeeper interface {
 	GetParams(ctx sdk.Context) (params gashubtypes.Params)
 	GetMsgGasParams(ctx sdk.Context, msgTypeUrl string) gashubtypes.MsgGasParams
 }
+
+// FeeMarketKeeper defines the expected gashub keeper enforcing the base fee.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (params gashubtypes.Params)
+	GetBaseFee(ctx sdk.Context) sdk.Dec
+	CollectBaseFee(ctx sdk.Context, fee sdk.Coins) error
+}
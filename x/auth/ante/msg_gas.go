@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	gashuberrors "github.com/cosmos/cosmos-sdk/x/gashub/errors"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
@@ -110,10 +111,27 @@ func (cmfg ConsumeMsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 func (cmfg ConsumeMsgGasDecorator) getMsgGas(ctx sdk.Context, tx sdk.Tx) (uint64, error) {
-	msgs := tx.GetMsgs()
+	return GetMsgsGas(ctx, cmfg.ghk, tx.GetMsgs())
+}
+
+// GetMsgsGas returns the gas charged by x/gashub for msgs, the msgs wrapped by the wrapper msgs being
+// charged recursively.
+func GetMsgsGas(ctx sdk.Context, ghk GashubKeeper, msgs []sdk.Msg) (uint64, error) {
+	return getMsgsGas(ctx, ghk, msgs, 0)
+}
+
+// getMsgsGas returns the gas of msgs, the msgs wrapped by the wrapper msgs being charged recursively.
+// depth is the nesting depth of msgs, the msgs of a tx being at depth 0.
+func getMsgsGas(ctx sdk.Context, ghk GashubKeeper, msgs []sdk.Msg, depth uint32) (uint64, error) {
 	totalGas := uint64(0)
 	for _, msg := range msgs {
-		mgp := cmfg.ghk.GetMsgGasParams(ctx, sdk.MsgTypeURL(msg))
+		mgp := ghk.GetMsgGasParams(ctx, sdk.MsgTypeURL(msg))
+		// the msg types without msg gas params are charged the default msg gas, if any
+		if mgp.GasParams == nil {
+			if defaultGas := ghk.GetParams(ctx).DefaultMsgGas; defaultGas != 0 {
+				mgp = *types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(msg), defaultGas)
+			}
+		}
 		feeCalcGen, err := types.GetGasCalculatorGen(mgp)
 		if err != nil {
 			return 0, errors.Wrapf(err, "unrecognized msg type: %s", sdk.MsgTypeURL(msg))
@@ -125,13 +143,33 @@ func (cmfg ConsumeMsgGasDecorator) getMsgGas(ctx sdk.Context, tx sdk.Tx) (uint64
 			return 0, err
 		}
 		totalGas += gas
+
+		if wrapperTyp := mgp.GetWrapperType(); wrapperTyp != nil {
+			if depth+1 > wrapperTyp.MaxDepth {
+				return 0, errors.Wrapf(gashuberrors.ErrMsgNestingTooDeep, "msg type: %s, max depth: %d", sdk.MsgTypeURL(msg), wrapperTyp.MaxDepth)
+			}
+
+			wrappedMsgs, err := types.GetWrappedMsgs(msg)
+			if err != nil {
+				return 0, err
+			}
+			wrappedGas, err := getMsgsGas(ctx, ghk, wrappedMsgs, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			totalGas += wrappedGas
+		}
 	}
 	return totalGas, nil
 }
 
 func (cmfg ConsumeMsgGasDecorator) getTxSizeGas(ctx sdk.Context) uint64 {
-	params := cmfg.ghk.GetParams(ctx)
-	txSize := ctx.TxSize()
+	return GetTxSizeGas(cmfg.ghk.GetParams(ctx), ctx.TxSize())
+}
+
+// GetTxSizeGas returns the gas charged by x/gashub for the size of a tx. Only the txs of at least half
+// of the max tx size are charged.
+func GetTxSizeGas(params types.Params, txSize uint64) uint64 {
 	if txSize < params.GetMaxTxSize()/2 {
 		return 0
 	}
@@ -8,8 +8,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	gashuberrors "github.com/cosmos/cosmos-sdk/x/gashub/errors"
 	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/golang/mock/gomock"
 )
 
@@ -64,6 +68,86 @@ func TestMsgGas(t *testing.T) {
 			},
 			3200,
 		},
+		{
+			"Wrapper gas type",
+			func(suite *AnteTestSuite) sdk.Msg {
+				accs := suite.CreateTestAccounts(2)
+
+				send := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+				innerExec := authz.NewMsgExec(accs[1].acc.GetAddress(), []sdk.Msg{send, send})
+				msg := authz.NewMsgExec(accs[1].acc.GetAddress(), []sdk.Msg{send, &innerExec})
+
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(&msg)).Return(*gashubtypes.NewMsgGasParamsWithDynamicGas(
+					sdk.MsgTypeURL(&msg),
+					&gashubtypes.MsgGasParams_WrapperType{WrapperType: &gashubtypes.MsgGasParams_WrapperGasParams{FixedGas: 100, MaxDepth: 2}},
+				)).Times(2)
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(send)).Return(
+					*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(send), 1200),
+				).Times(3)
+
+				return &msg
+			},
+			3800,
+		},
+		{
+			"Group proposal wrapper gas type",
+			func(suite *AnteTestSuite) sdk.Msg {
+				accs := suite.CreateTestAccounts(2)
+
+				send := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+				msg, err := group.NewMsgSubmitProposal(accs[0].acc.GetAddress().String(), []string{accs[1].acc.GetAddress().String()}, []sdk.Msg{send, send}, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+				require.NoError(t, err)
+
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(msg)).Return(*gashubtypes.NewMsgGasParamsWithDynamicGas(
+					sdk.MsgTypeURL(msg),
+					&gashubtypes.MsgGasParams_WrapperType{WrapperType: &gashubtypes.MsgGasParams_WrapperGasParams{FixedGas: 100, MaxDepth: 2}},
+				))
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(send)).Return(
+					*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(send), 1200),
+				).Times(2)
+
+				return msg
+			},
+			2500,
+		},
+		{
+			"Grant allowance gas type",
+			func(suite *AnteTestSuite) sdk.Msg {
+				accs := suite.CreateTestAccounts(2)
+
+				sendTypeURL := sdk.MsgTypeURL(&bank.MsgSend{})
+				inner, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{sendTypeURL})
+				require.NoError(t, err)
+				allowance, err := feegrant.NewAllowedMsgAllowance(inner, []string{sendTypeURL, sdk.MsgTypeURL(&bank.MsgMultiSend{})})
+				require.NoError(t, err)
+				allowance.AllowWrapperMsgs = true
+
+				msg, err := feegrant.NewMsgGrantAllowance(allowance, accs[0].acc.GetAddress(), accs[1].acc.GetAddress())
+				require.NoError(t, err)
+
+				typeUrl := sdk.MsgTypeURL(msg)
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), typeUrl).Return(*gashubtypes.NewMsgGasParamsWithDynamicGas(
+					typeUrl,
+					&gashubtypes.MsgGasParams_GrantAllowanceType{GrantAllowanceType: &gashubtypes.MsgGasParams_DynamicGasParams{FixedGas: 800, GasPerItem: 100}},
+				))
+				return msg
+			},
+			1200,
+		},
+		{
+			"Default msg gas",
+			func(suite *AnteTestSuite) sdk.Msg {
+				accs := suite.CreateTestAccounts(2)
+
+				msg := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+
+				suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(msg)).Return(gashubtypes.MsgGasParams{})
+				suite.gashubKeeper.EXPECT().GetParams(gomock.Any()).Return(gashubtypes.Params{DefaultMsgGas: 500})
+
+				return msg
+			},
+			500,
+		},
 	}
 	for _, tc := range testCases {
 		suite := SetupTestSuite(t, true)
@@ -88,3 +172,48 @@ func TestMsgGas(t *testing.T) {
 		require.Equal(t, tc.expectedGas, gasConsumedAfter-gasConsumedBefore)
 	}
 }
+
+func TestMsgGasNestingTooDeep(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	accs := suite.CreateTestAccounts(2)
+	send := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+	innerExec := authz.NewMsgExec(accs[1].acc.GetAddress(), []sdk.Msg{send})
+	msg := authz.NewMsgExec(accs[1].acc.GetAddress(), []sdk.Msg{&innerExec})
+
+	suite.gashubKeeper.EXPECT().GetParams(gomock.Any())
+	suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(&msg)).Return(*gashubtypes.NewMsgGasParamsWithDynamicGas(
+		sdk.MsgTypeURL(&msg),
+		&gashubtypes.MsgGasParams_WrapperType{WrapperType: &gashubtypes.MsgGasParams_WrapperGasParams{FixedGas: 100, MaxDepth: 1}},
+	)).Times(2)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(&msg))
+	tx, err := suite.CreateTestTx(nil, nil, nil, suite.ctx.ChainID())
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewConsumeMsgGasDecorator(suite.accountKeeper, suite.gashubKeeper))
+	_, err = anteHandler(suite.ctx, tx, true)
+	require.ErrorIs(t, err, gashuberrors.ErrMsgNestingTooDeep)
+}
+
+func TestMsgGasUnknownMsgWithoutDefaultMsgGas(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	accs := suite.CreateTestAccounts(2)
+	msg := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+
+	suite.gashubKeeper.EXPECT().GetParams(gomock.Any()).Times(2)
+	suite.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), sdk.MsgTypeURL(msg)).Return(gashubtypes.MsgGasParams{})
+
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	tx, err := suite.CreateTestTx(nil, nil, nil, suite.ctx.ChainID())
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewConsumeMsgGasDecorator(suite.accountKeeper, suite.gashubKeeper))
+	_, err = anteHandler(suite.ctx, tx, true)
+	require.ErrorIs(t, err, gashuberrors.ErrInvalidMsgGasParams)
+}
@@ -2,8 +2,12 @@ package ante_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -17,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
@@ -403,3 +408,54 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func TestEthTxSigVerification(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(suite.encCfg.InterfaceRegistry), authtx.DefaultSignModes)
+
+	priv, pubKey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	ecdsaKey, err := ethcrypto.ToECDSA(priv.Bytes())
+	require.NoError(t, err)
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	msgBz, err := suite.encCfg.Codec.Marshal(testdata.NewTestMsg(addr))
+	require.NoError(t, err)
+	data, err := authtx.EthTxMsgArgs.Pack(sdk.MsgTypeURL(&testdata.TestMsg{}), msgBz)
+	require.NoError(t, err)
+
+	newEthTx := func(chainID int64, nonce uint64) sdk.Tx {
+		ethTx, err := gethtypes.SignNewTx(ecdsaKey, gethtypes.LatestSignerForChainID(big.NewInt(chainID)), &gethtypes.DynamicFeeTx{
+			ChainID: big.NewInt(chainID), Nonce: nonce, GasTipCap: big.NewInt(0), GasFeeCap: big.NewInt(0), Gas: testdata.NewTestGasLimit(), Data: data,
+		})
+		require.NoError(t, err)
+		txBytes, err := ethTx.MarshalBinary()
+		require.NoError(t, err)
+		tx, err := txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		return tx
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler())
+	isd := ante.NewIncrementSequenceDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd, isd)
+
+	// the chain id of mechain_1000000-1 is 1000000
+	_, err = antehandler(suite.ctx, newEthTx(1000001, 0), false)
+	require.Error(t, err)
+	_, err = antehandler(suite.ctx, newEthTx(1000000, 1), false)
+	require.Error(t, err)
+
+	_, err = antehandler(suite.ctx, newEthTx(1000000, 0), false)
+	require.NoError(t, err)
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.Equal(t, uint64(1), acc.GetSequence())
+	require.True(t, pubKey.Equals(acc.GetPubKey()))
+
+	// replayed txs are rejected
+	_, err = antehandler(suite.ctx, newEthTx(1000000, 0), false)
+	require.Error(t, err)
+	_, err = antehandler(suite.ctx, newEthTx(1000000, 1), false)
+	require.NoError(t, err)
+}
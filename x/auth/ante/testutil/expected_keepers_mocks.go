@@ -177,3 +177,68 @@ func (mr *MockGashubKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockGashubKeeper)(nil).GetParams), ctx)
 }
+
+// MockFeeMarketKeeper is a mock of FeeMarketKeeper interface.
+type MockFeeMarketKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketKeeperMockRecorder
+}
+
+// MockFeeMarketKeeperMockRecorder is the mock recorder for MockFeeMarketKeeper.
+type MockFeeMarketKeeperMockRecorder struct {
+	mock *MockFeeMarketKeeper
+}
+
+// NewMockFeeMarketKeeper creates a new mock instance.
+func NewMockFeeMarketKeeper(ctrl *gomock.Controller) *MockFeeMarketKeeper {
+	mock := &MockFeeMarketKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
+	return m.recorder
+}
+
+// CollectBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) CollectBaseFee(ctx types.Context, fee types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectBaseFee", ctx, fee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CollectBaseFee indicates an expected call of CollectBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) CollectBaseFee(ctx, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).CollectBaseFee), ctx, fee)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) GetBaseFee(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) GetBaseFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBaseFee), ctx)
+}
+
+// GetParams mocks base method.
+func (m *MockFeeMarketKeeper) GetParams(ctx types.Context) types1.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types1.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockFeeMarketKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetParams), ctx)
+}
@@ -64,3 +64,31 @@ func getTxPriority(fee sdk.Coins, gas int64) int64 {
 
 	return priority
 }
+
+// NewGashubTxFeeChecker returns a TxFeeChecker that applies the validator minimum gas prices like
+// checkTxFeeWithValidatorMinGasPrices, but computes the tx priority from the fee per unit of the gas
+// charged by x/gashub, i.e. the larger of the message-type gas and the tx size gas. Since gashub gas
+// is deterministic per message type, padding the gas limit of a tx does not lower its priority.
+func NewGashubTxFeeChecker(ghk GashubKeeper) TxFeeChecker {
+	cmfg := NewConsumeMsgGasDecorator(nil, ghk)
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeCoins, _, err := checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		gas, err := cmfg.getMsgGas(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if sizeGas := cmfg.getTxSizeGas(ctx); sizeGas > gas {
+			gas = sizeGas
+		}
+		if gas == 0 {
+			gas = 1
+		}
+
+		priority := getTxPriority(feeCoins, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
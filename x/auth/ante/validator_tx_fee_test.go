@@ -0,0 +1,63 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+func TestGashubTxFeeChecker(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.ctx = s.ctx.WithIsCheckTx(true)
+
+	accs := s.CreateTestAccounts(2)
+	msg := bank.NewMsgSend(accs[0].acc.GetAddress(), accs[1].acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))))
+	typeUrl := sdk.MsgTypeURL(msg)
+	s.gashubKeeper.EXPECT().GetParams(gomock.Any()).AnyTimes()
+	s.gashubKeeper.EXPECT().GetMsgGasParams(gomock.Any(), typeUrl).Return(gashubtypes.MsgGasParams{
+		MsgTypeUrl: typeUrl,
+		GasParams:  &gashubtypes.MsgGasParams_FixedType{FixedType: &gashubtypes.MsgGasParams_FixedGasParams{FixedGas: 1200}},
+	}).AnyTimes()
+
+	checker := ante.NewGashubTxFeeChecker(s.gashubKeeper)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 12000))
+
+	// the priority does not depend on the gas limit of the tx
+	for _, gasLimit := range []uint64{1200, 200000} {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.txBuilder.SetMsgs(msg))
+		s.txBuilder.SetFeeAmount(fee)
+		s.txBuilder.SetGasLimit(gasLimit)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		require.NoError(t, err)
+
+		feeCoins, priority, err := checker(s.ctx, tx)
+		require.NoError(t, err)
+		require.Equal(t, fee, feeCoins)
+		require.Equal(t, int64(10), priority)
+	}
+
+	// the validator minimum gas prices still apply
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdkmath.LegacyNewDec(1))))
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(fee)
+	s.txBuilder.SetGasLimit(200000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	require.NoError(t, err)
+
+	_, _, err = checker(s.ctx, tx)
+	require.Error(t, err)
+}
@@ -0,0 +1,311 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+const (
+	flagSigners           = "signers"
+	flagMultisigThreshold = "multisig-threshold"
+	flagMultisigKeys      = "multisig-keys"
+	flagGashubGenesis     = "gashub-genesis"
+	flagMaxTxSize         = "max-tx-size"
+	flagMinGasPerByte     = "min-gas-per-byte"
+)
+
+// SizeCheckResult is the output of the size-check command
+type SizeCheckResult struct {
+	// TxSize is the size of the signed tx in bytes
+	TxSize uint64 `json:"tx_size" yaml:"tx_size"`
+	// MaxTxSize is the maximum size of a tx in bytes
+	MaxTxSize uint64 `json:"max_tx_size" yaml:"max_tx_size"`
+	// Headroom is the number of bytes left under MaxTxSize, negative if the tx is too large
+	Headroom int64 `json:"headroom" yaml:"headroom"`
+	// SizeGas is the gas charged for the size of the tx
+	SizeGas uint64 `json:"size_gas" yaml:"size_gas"`
+	// MsgGas is the gas charged for the msg types of the tx
+	MsgGas uint64 `json:"msg_gas" yaml:"msg_gas"`
+	// Applied is the gas which applies to the tx, either "size" or "msg"
+	Applied string `json:"applied" yaml:"applied"`
+	// Gas is the gas charged by x/gashub, the larger of SizeGas and MsgGas
+	Gas uint64 `json:"gas" yaml:"gas"`
+	// GasLimit is the gas limit of the tx
+	GasLimit uint64 `json:"gas_limit" yaml:"gas_limit"`
+	// Fee is the fee for Gas at the gas prices passed with --gas-prices, if any
+	Fee sdk.Coins `json:"fee,omitempty" yaml:"fee,omitempty"`
+}
+
+// GetSizeCheckCommand returns the size-check command to compute the size and the gashub gas of a
+// transaction once signed.
+func GetSizeCheckCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "size-check [file]",
+		Short: "Compute the signed size and the gas of a transaction generated offline",
+		Long: `Compute the size of a transaction created with the --generate-only flag once signed, and the gas
+charged for it by x/gashub. The existing signatures are replaced by signatures of the given signer set and
+sign mode: one eth_secp256k1 signature per signer, or the signatures of a multisig account per signer if
+--multisig-threshold and --multisig-keys are set. The sequence set with --sequence is used for all the signers.
+
+The command reports the gas charged for the tx size and for the msg types, which one applies, and the
+headroom under the max tx size. The gashub params are fetched once from the node, or read from the gashub
+genesis state passed with --gashub-genesis. --max-tx-size and --min-gas-per-byte override them.
+If you supply a dash (-) argument in place of an input filename, the command reads from standard input.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			gp, err := readGashubParams(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			sigs, err := sizeCheckSignatures(cmd, stdTx)
+			if err != nil {
+				return err
+			}
+
+			res, err := CheckTxSize(clientCtx.TxConfig, stdTx, sigs, gp.params, gp.msgGasParams)
+			if err != nil {
+				return err
+			}
+
+			gasPrices, _ := cmd.Flags().GetString(flags.FlagGasPrices)
+			if gasPrices != "" {
+				prices, err := sdk.ParseDecCoins(gasPrices)
+				if err != nil {
+					return err
+				}
+				gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(res.Gas))
+				for _, price := range prices {
+					res.Fee = res.Fee.Add(sdk.NewCoin(price.Denom, price.Amount.Mul(gas).Ceil().RoundInt()))
+				}
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().Int(flagSigners, 0, "The number of signers, defaults to the number of signers required by the tx")
+	cmd.Flags().Int(flagMultisigThreshold, 0, "The threshold of the multisig accounts signing the tx")
+	cmd.Flags().Int(flagMultisigKeys, 0, "The number of keys of the multisig accounts signing the tx")
+	cmd.Flags().String(flagGashubGenesis, "", "Read the gashub params from a gashub genesis state file instead of querying the node")
+	cmd.Flags().Uint64(flagMaxTxSize, 0, "Override the max tx size of the gashub params")
+	cmd.Flags().Uint64(flagMinGasPerByte, 0, "Override the min gas per byte of the gashub params")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CheckTxSize computes the size of tx signed with sigs, and the gas charged for it by x/gashub
+// according to params and msgGasParams.
+func CheckTxSize(
+	txConfig client.TxConfig, tx sdk.Tx, sigs []signing.SignatureV2,
+	params gashubtypes.Params, msgGasParams map[string]gashubtypes.MsgGasParams,
+) (SizeCheckResult, error) {
+	txBuilder, err := txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return SizeCheckResult{}, err
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return SizeCheckResult{}, err
+	}
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return SizeCheckResult{}, err
+	}
+
+	ghk := gashubParams{params: params, msgGasParams: msgGasParams}
+	msgGas, err := ante.GetMsgsGas(sdk.Context{}, ghk, tx.GetMsgs())
+	if err != nil {
+		return SizeCheckResult{}, err
+	}
+
+	res := SizeCheckResult{
+		TxSize:    uint64(len(txBytes)),
+		MaxTxSize: params.MaxTxSize,
+		Headroom:  int64(params.MaxTxSize) - int64(len(txBytes)),
+		SizeGas:   ante.GetTxSizeGas(params, uint64(len(txBytes))),
+		MsgGas:    msgGas,
+		GasLimit:  txBuilder.GetTx().GetGas(),
+	}
+	if res.SizeGas > res.MsgGas {
+		res.Applied, res.Gas = "size", res.SizeGas
+	} else {
+		res.Applied, res.Gas = "msg", res.MsgGas
+	}
+	return res, nil
+}
+
+// sizeCheckSignatures returns placeholder signatures of the signer set and sign mode set by the flags
+func sizeCheckSignatures(cmd *cobra.Command, tx sdk.Tx) ([]signing.SignatureV2, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid tx type %T", tx)
+	}
+
+	signers, _ := cmd.Flags().GetInt(flagSigners)
+	if signers == 0 {
+		signers = len(sigTx.GetSigners())
+	}
+	threshold, _ := cmd.Flags().GetInt(flagMultisigThreshold)
+	keys, _ := cmd.Flags().GetInt(flagMultisigKeys)
+	if threshold > keys || (threshold == 0) != (keys == 0) {
+		return nil, fmt.Errorf("invalid multisig threshold %d of %d keys", threshold, keys)
+	}
+	sequence, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+	signModeStr, _ := cmd.Flags().GetString(flags.FlagSignMode)
+
+	sigs := make([]signing.SignatureV2, signers)
+	for i := range sigs {
+		sig, err := PlaceholderSignature(parseSignMode(signModeStr), sequence, threshold, keys)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
+}
+
+// PlaceholderSignature returns a signature with the size of an eth_secp256k1 signature made with
+// signMode, or of the signatures of a multisig account if keys is not 0.
+func PlaceholderSignature(signMode signing.SignMode, sequence uint64, threshold, keys int) (signing.SignatureV2, error) {
+	single := func() (cryptotypes.PubKey, *signing.SingleSignatureData, error) {
+		privKey, err := ethsecp256k1.GenPrivKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		return privKey.PubKey(), &signing.SingleSignatureData{SignMode: signMode, Signature: make([]byte, ante.EthSecp256k1SigSize)}, nil
+	}
+
+	if keys == 0 {
+		pubKey, data, err := single()
+		if err != nil {
+			return signing.SignatureV2{}, err
+		}
+		return signing.SignatureV2{PubKey: pubKey, Data: data, Sequence: sequence}, nil
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, keys)
+	data := &signing.MultiSignatureData{BitArray: cryptotypes.NewCompactBitArray(keys)}
+	for i := range pubKeys {
+		pubKey, sigData, err := single()
+		if err != nil {
+			return signing.SignatureV2{}, err
+		}
+		pubKeys[i] = pubKey
+		if i < threshold {
+			data.BitArray.SetIndex(i, true)
+			data.Signatures = append(data.Signatures, sigData)
+		}
+	}
+	return signing.SignatureV2{PubKey: multisig.NewLegacyAminoPubKey(threshold, pubKeys), Data: data, Sequence: sequence}, nil
+}
+
+// parseSignMode returns the sign mode of a --sign-mode flag value, EIP-712 being the default
+func parseSignMode(signModeStr string) signing.SignMode {
+	switch signModeStr {
+	case flags.SignModeDirect:
+		return signing.SignMode_SIGN_MODE_DIRECT
+	case flags.SignModeLegacyAminoJSON:
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeDirectAux:
+		return signing.SignMode_SIGN_MODE_DIRECT_AUX
+	case flags.SignModeEIP191:
+		return signing.SignMode_SIGN_MODE_EIP_191
+	default:
+		return signing.SignMode_SIGN_MODE_EIP_712
+	}
+}
+
+// gashubParams implements ante.GashubKeeper with gashub params fetched once
+type gashubParams struct {
+	params       gashubtypes.Params
+	msgGasParams map[string]gashubtypes.MsgGasParams
+}
+
+var _ ante.GashubKeeper = gashubParams{}
+
+func (gp gashubParams) GetParams(_ sdk.Context) gashubtypes.Params {
+	return gp.params
+}
+
+func (gp gashubParams) GetMsgGasParams(_ sdk.Context, msgTypeUrl string) gashubtypes.MsgGasParams {
+	return gp.msgGasParams[msgTypeUrl]
+}
+
+// readGashubParams reads the gashub params from the genesis state file set by --gashub-genesis, or
+// queries them from the node
+func readGashubParams(clientCtx client.Context, cmd *cobra.Command) (gashubParams, error) {
+	gp := gashubParams{msgGasParams: make(map[string]gashubtypes.MsgGasParams)}
+
+	if genesisFile, _ := cmd.Flags().GetString(flagGashubGenesis); genesisFile != "" {
+		bz, err := os.ReadFile(genesisFile)
+		if err != nil {
+			return gp, err
+		}
+		var genState gashubtypes.GenesisState
+		if err := clientCtx.Codec.UnmarshalJSON(bz, &genState); err != nil {
+			return gp, err
+		}
+		gp.params = genState.Params
+		for _, mgp := range genState.MsgGasParams {
+			gp.msgGasParams[mgp.MsgTypeUrl] = mgp
+		}
+	} else {
+		queryClient := gashubtypes.NewQueryClient(clientCtx)
+		paramsRes, err := queryClient.Params(cmd.Context(), &gashubtypes.QueryParamsRequest{})
+		if err != nil {
+			return gp, err
+		}
+		gp.params = paramsRes.Params
+
+		pageReq := &query.PageRequest{}
+		for {
+			res, err := queryClient.MsgGasParams(cmd.Context(), &gashubtypes.QueryMsgGasParamsRequest{Pagination: pageReq})
+			if err != nil {
+				return gp, err
+			}
+			for _, mgp := range res.MsgGasParams {
+				gp.msgGasParams[mgp.MsgTypeUrl] = *mgp
+			}
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+
+	if cmd.Flags().Changed(flagMaxTxSize) {
+		gp.params.MaxTxSize, _ = cmd.Flags().GetUint64(flagMaxTxSize)
+	}
+	if cmd.Flags().Changed(flagMinGasPerByte) {
+		gp.params.MinGasPerByte, _ = cmd.Flags().GetUint64(flagMinGasPerByte)
+	}
+	return gp, nil
+}
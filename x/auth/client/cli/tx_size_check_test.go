@@ -0,0 +1,119 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtestutil "github.com/cosmos/cosmos-sdk/x/auth/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+func TestGetCommandSizeCheck(t *testing.T) {
+	var (
+		txCfg       client.TxConfig
+		legacyAmino *codec.LegacyAmino
+		codec       codec.Codec
+	)
+
+	err := depinject.Inject(
+		authtestutil.AppConfig,
+		&txCfg,
+		&legacyAmino,
+		&codec,
+	)
+	require.NoError(t, err)
+
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	msgSendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	builder := txCfg.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("stake", 150)})
+	jsonEncoded, err := txCfg.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFile := testutil.WriteToNewTempFile(t, string(jsonEncoded))
+
+	params := gashubtypes.DefaultParams()
+	genState := gashubtypes.NewGenesisState(
+		params,
+		[]gashubtypes.MsgGasParams{*gashubtypes.NewMsgGasParamsWithFixedGas(msgSendTypeUrl, 1200)},
+		gashubtypes.DefaultMinBaseFee,
+	)
+	genesisFile := testutil.WriteToNewTempFile(t, string(codec.MustMarshalJSON(genState)))
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txCfg).
+		WithCodec(codec)
+
+	sizeCheck := func(extraArgs ...string) cli.SizeCheckResult {
+		cmd := cli.GetSizeCheckCommand()
+		out := bytes.NewBuffer(nil)
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmdCtx := clientCtx.WithOutput(out)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &cmdCtx)
+		cmd.SetArgs(append([]string{txFile.Name(), "--gashub-genesis=" + genesisFile.Name()}, extraArgs...))
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		var res cli.SizeCheckResult
+		require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+		return res
+	}
+
+	single := sizeCheck()
+	require.Equal(t, uint64(1200), single.MsgGas)
+	require.Equal(t, uint64(0), single.SizeGas)
+	require.Equal(t, "msg", single.Applied)
+	require.Equal(t, uint64(1200), single.Gas)
+	require.Equal(t, uint64(50000), single.GasLimit)
+	require.Equal(t, params.MaxTxSize, single.MaxTxSize)
+	require.Equal(t, int64(params.MaxTxSize)-int64(single.TxSize), single.Headroom)
+
+	multisig := sizeCheck("--multisig-threshold=2", "--multisig-keys=3")
+	require.Greater(t, multisig.TxSize, single.TxSize)
+
+	twoSigners := sizeCheck("--signers=2")
+	require.Greater(t, twoSigners.TxSize, single.TxSize)
+
+	// a max tx size below twice the tx size charges the size gas
+	small := sizeCheck("--max-tx-size=100", "--min-gas-per-byte=100", "--gas-prices=2stake")
+	require.Equal(t, "size", small.Applied)
+	require.Equal(t, small.TxSize*100, small.Gas)
+	require.Negative(t, small.Headroom)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(small.Gas*2))), small.Fee)
+
+	// the estimate is the size of the tx really signed with the same sign mode and sequence
+	direct := sizeCheck("--sign-mode=direct", "--sequence=7")
+	privKey, err := ethsecp256k1.GenPrivKey()
+	require.NoError(t, err)
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: 7,
+	}))
+	signerData := authsigning.SignerData{ChainID: "test-chain", AccountNumber: 1, Sequence: 7}
+	sig, err := clienttx.SignWithPrivKey(signMode, signerData, builder, privKey, txCfg, 7)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+	signedBz, err := txCfg.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(signedBz)), direct.TxSize)
+}
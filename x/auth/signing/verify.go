@@ -20,9 +20,9 @@ import (
 func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx, sigCache *lru.ARCCache, txBytes []byte) error {
 	switch data := sigData.(type) {
 	case *signing.SingleSignatureData:
-		// EIP712 signatures are verified in a different way
+		// EIP712 signatures and the signatures of native Ethereum txs are verified in a different way
 		// In greenfield, we adapt another antehandler to reject non-EIP712 signatures
-		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_712 {
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_712 || data.SignMode == signing.SignMode_SIGN_MODE_ETH_TX {
 			// skip signature verification if we have a cache and the tx is already in it
 			if sigCache != nil && txBytes != nil {
 				if _, known := sigCache.Get(string(txBytes)); known {
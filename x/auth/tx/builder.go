@@ -31,6 +31,10 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// ethTx is the native Ethereum transaction the tx was decoded from, if any. The tx is encoded
+	// back to the raw bytes of the Ethereum transaction.
+	ethTx *ethTx
 }
 
 var (
@@ -33,6 +33,13 @@ type TxInputs struct {
 	AccountKeeper  ante.AccountKeeper    `optional:"true"`
 	BankKeeper     authtypes.BankKeeper  `optional:"true"`
 	FeeGrantKeeper feegrantkeeper.Keeper `optional:"true"`
+	GashubKeeper   GashubKeeper          `optional:"true"`
+}
+
+// GashubKeeper is the x/gashub keeper pricing the txs and enforcing the base fee.
+type GashubKeeper interface {
+	ante.GashubKeeper
+	ante.FeeMarketKeeper
 }
 
 type TxOutputs struct {
@@ -92,6 +99,16 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the txs are prioritized by their fee per unit of gashub gas, and pay the base fee, if x/gashub is used
+	var (
+		txFeeChecker    ante.TxFeeChecker
+		feeMarketKeeper ante.FeeMarketKeeper
+	)
+	if in.GashubKeeper != nil {
+		txFeeChecker = ante.NewGashubTxFeeChecker(in.GashubKeeper)
+		feeMarketKeeper = in.GashubKeeper
+	}
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   in.AccountKeeper,
@@ -99,6 +116,8 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  in.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			TxFeeChecker:    txFeeChecker,
+			FeeMarketKeeper: feeMarketKeeper,
 		},
 	)
 	if err != nil {
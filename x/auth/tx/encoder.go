@@ -18,6 +18,10 @@ func DefaultTxEncoder() sdk.TxEncoder {
 			return nil, fmt.Errorf("expected %T, got %T", &wrapper{}, tx)
 		}
 
+		if txWrapper.ethTx != nil {
+			return txWrapper.ethTx.raw, nil
+		}
+
 		raw := &txtypes.TxRaw{
 			BodyBytes:     txWrapper.getBodyBytes(),
 			AuthInfoBytes: txWrapper.getAuthInfoBytes(),
@@ -0,0 +1,338 @@
+package tx
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	// EthDynamicFeeTxType is the EIP-2718 type of EIP-1559 dynamic fee transactions
+	EthDynamicFeeTxType = 0x02
+
+	// ethLegacyTxType is the type of EIP-155 legacy transactions, which are not typed
+	ethLegacyTxType = 0x00
+)
+
+var (
+	abiStringType, _ = abi.NewType("string", "", nil)
+	abiBytesType, _  = abi.NewType("bytes", "", nil)
+
+	// EthTxMsgArgs are the ABI arguments of the data of a native Ethereum transaction: the type url and
+	// the protobuf encoding of the Cosmos message it carries.
+	EthTxMsgArgs = abi.Arguments{
+		{Name: "typeUrl", Type: abiStringType},
+		{Name: "value", Type: abiBytesType},
+	}
+)
+
+// ethAccessTuple is an entry of the access list of an EIP-1559 dynamic fee transaction
+type ethAccessTuple struct {
+	Address     [20]byte
+	StorageKeys [][32]byte
+}
+
+// ethLegacyTxRLP is the RLP encoding of an EIP-155 legacy transaction
+type ethLegacyTxRLP struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	To       []byte
+	Value    *big.Int
+	Data     []byte
+	V, R, S  *big.Int
+}
+
+// ethDynamicFeeTxRLP is the RLP encoding of an EIP-1559 dynamic fee transaction, without its type
+type ethDynamicFeeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         []byte
+	Value      *big.Int
+	Data       []byte
+	AccessList []ethAccessTuple
+	V, R, S    *big.Int
+}
+
+// ethTx is a native Ethereum transaction, an EIP-155 legacy transaction or an EIP-1559 dynamic fee
+// transaction, along with its raw bytes.
+type ethTx struct {
+	txType     byte
+	chainID    *big.Int
+	nonce      uint64
+	gasTipCap  *big.Int
+	gasFeeCap  *big.Int // the gas price of legacy transactions
+	gas        uint64
+	to         []byte
+	value      *big.Int
+	data       []byte
+	accessList []ethAccessTuple
+	recoveryID byte
+	r, s       *big.Int
+
+	raw []byte
+}
+
+// IsEthTx returns true if txBytes look like a native Ethereum transaction rather than a protobuf TxRaw,
+// which never starts with the EIP-2718 type of dynamic fee transactions nor with an RLP list prefix.
+func IsEthTx(txBytes []byte) bool {
+	return len(txBytes) > 0 && (txBytes[0] == EthDynamicFeeTxType || txBytes[0] >= 0xc0)
+}
+
+// parseEthTx parses an RLP encoded EIP-155 legacy transaction or EIP-1559 dynamic fee transaction
+func parseEthTx(txBytes []byte) (*ethTx, error) {
+	if txBytes[0] == EthDynamicFeeTxType {
+		var dtx ethDynamicFeeTxRLP
+		if err := rlp.DecodeBytes(txBytes[1:], &dtx); err != nil {
+			return nil, err
+		}
+		if !dtx.V.IsUint64() || dtx.V.Uint64() > 1 {
+			return nil, fmt.Errorf("invalid signature V %s", dtx.V)
+		}
+		return &ethTx{
+			txType:     EthDynamicFeeTxType,
+			chainID:    dtx.ChainID,
+			nonce:      dtx.Nonce,
+			gasTipCap:  dtx.GasTipCap,
+			gasFeeCap:  dtx.GasFeeCap,
+			gas:        dtx.Gas,
+			to:         dtx.To,
+			value:      dtx.Value,
+			data:       dtx.Data,
+			accessList: dtx.AccessList,
+			recoveryID: byte(dtx.V.Uint64()),
+			r:          dtx.R,
+			s:          dtx.S,
+			raw:        txBytes,
+		}, nil
+	}
+
+	var ltx ethLegacyTxRLP
+	if err := rlp.DecodeBytes(txBytes, &ltx); err != nil {
+		return nil, err
+	}
+	// V = chainID * 2 + 35 + recoveryID, unprotected transactions are rejected
+	if ltx.V.Cmp(big.NewInt(35)) < 0 {
+		return nil, fmt.Errorf("legacy transactions must be EIP-155 replay protected")
+	}
+	v := new(big.Int).Sub(ltx.V, big.NewInt(35))
+	chainID := new(big.Int).Rsh(v, 1)
+	return &ethTx{
+		txType:     ethLegacyTxType,
+		chainID:    chainID,
+		nonce:      ltx.Nonce,
+		gasTipCap:  ltx.GasPrice,
+		gasFeeCap:  ltx.GasPrice,
+		gas:        ltx.Gas,
+		to:         ltx.To,
+		value:      ltx.Value,
+		data:       ltx.Data,
+		recoveryID: byte(v.Bit(0)),
+		r:          ltx.R,
+		s:          ltx.S,
+		raw:        txBytes,
+	}, nil
+}
+
+// sigHash returns the hash signed by the sender of the transaction on the chain of chainID
+func (etx *ethTx) sigHash(chainID *big.Int) ([]byte, error) {
+	var (
+		bz  []byte
+		err error
+	)
+	switch etx.txType {
+	case EthDynamicFeeTxType:
+		bz, err = rlp.EncodeToBytes([]interface{}{
+			chainID, etx.nonce, etx.gasTipCap, etx.gasFeeCap, etx.gas, etx.to, etx.value, etx.data, etx.accessList,
+		})
+		bz = append([]byte{EthDynamicFeeTxType}, bz...)
+	default:
+		bz, err = rlp.EncodeToBytes([]interface{}{
+			etx.nonce, etx.gasFeeCap, etx.gas, etx.to, etx.value, etx.data, chainID, uint(0), uint(0),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ethcrypto.Keccak256(bz), nil
+}
+
+// signature returns the signature of the transaction in the [R || S || V] format, V being the recovery id
+func (etx *ethTx) signature() ([]byte, error) {
+	if !ethcrypto.ValidateSignatureValues(etx.recoveryID, etx.r, etx.s, true) {
+		return nil, fmt.Errorf("invalid signature values")
+	}
+	sig := make([]byte, ethcrypto.SignatureLength)
+	etx.r.FillBytes(sig[:32])
+	etx.s.FillBytes(sig[32:64])
+	sig[ethcrypto.RecoveryIDOffset] = etx.recoveryID
+	return sig, nil
+}
+
+// decodeEthTx decodes a native Ethereum transaction into a tx whose signer is recovered from the
+// signature of the transaction. The nonce of the transaction is the sequence of the signer, and its
+// data carries the ABI-encoded Cosmos message of the tx. The fee of the tx is left empty, it is set
+// by the ante handler from the gas price caps of the transaction, see GetEthGasFeeCaps.
+func decodeEthTx(cdc codec.ProtoCodecMarshaler, txBytes []byte) (sdk.Tx, error) {
+	etx, err := parseEthTx(txBytes)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid ethereum tx: %s", err)
+	}
+	if etx.value.Sign() != 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "ethereum tx value must be zero")
+	}
+	if len(etx.to) != 0 && len(etx.to) != 20 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid ethereum tx recipient length %d", len(etx.to))
+	}
+
+	// recover the signer
+	sig, err := etx.signature()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	sigHash, err := etx.sigHash(etx.chainID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	ecPubKey, err := ethcrypto.SigToPub(sigHash, sig)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "failed to recover ethereum tx signer: %s", err)
+	}
+	pubKeyAny, err := codectypes.NewAnyWithValue(&ethsecp256k1.PubKey{Key: ethcrypto.CompressPubkey(ecPubKey)})
+	if err != nil {
+		return nil, err
+	}
+
+	// unpack the cosmos message
+	args, err := EthTxMsgArgs.Unpack(etx.data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid ethereum tx data: %s", err)
+	}
+	msgAny := &codectypes.Any{TypeUrl: args[0].(string), Value: args[1].([]byte)}
+	var msg sdk.Msg
+	if err := cdc.InterfaceRegistry().UnpackAny(msgAny, &msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	if err := unknownproto.RejectUnknownFieldsStrict(msgAny.Value, msg, cdc.InterfaceRegistry()); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	if etx.gasTipCap.Cmp(etx.gasFeeCap) > 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "ethereum tx max priority fee per gas %s is higher than its max fee per gas %s",
+			etx.gasTipCap, etx.gasFeeCap)
+	}
+	fee := new(big.Int).Mul(etx.gasFeeCap, new(big.Int).SetUint64(etx.gas))
+	if fee.BitLen() > sdkmath.MaxBitLen {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "ethereum tx fee overflows")
+	}
+
+	theTx := &tx.Tx{
+		Body: &tx.TxBody{
+			Messages: []*codectypes.Any{msgAny},
+		},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pubKeyAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_ETH_TX},
+						},
+					},
+					Sequence: etx.nonce,
+				},
+			},
+			Fee: &tx.Fee{
+				GasLimit: etx.gas,
+			},
+		},
+		Signatures: [][]byte{sig},
+	}
+
+	return &wrapper{
+		tx:    theTx,
+		ethTx: etx,
+	}, nil
+}
+
+// GetEthGasFeeCaps returns the max fee per gas and the max priority fee per gas of the native Ethereum
+// transaction the tx was decoded from, which are both the gas price of legacy transactions. ok is false
+// if the tx was not decoded from a native Ethereum transaction.
+func (w *wrapper) GetEthGasFeeCaps() (gasFeeCap, gasTipCap *big.Int, ok bool) {
+	if w.ethTx == nil {
+		return nil, nil, false
+	}
+	return w.ethTx.gasFeeCap, w.ethTx.gasTipCap, true
+}
+
+// NewEthTxBroadcaster returns a function submitting the native Ethereum txs received by
+// eth_sendRawTransaction to the node of clientCtx.
+func NewEthTxBroadcaster(clientCtx client.Context) func(txBytes []byte) error {
+	return func(txBytes []byte) error {
+		res, err := clientCtx.BroadcastTxSync(txBytes)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+		}
+		return nil
+	}
+}
+
+// signModeEthTxHandler defines the SIGN_MODE_ETH_TX SignModeHandler
+type signModeEthTxHandler struct{}
+
+var _ signing.SignModeHandler = signModeEthTxHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeEthTxHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_ETH_TX
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeEthTxHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_ETH_TX}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes. It returns the hash signed by the sender of the
+// native Ethereum transaction the tx was decoded from.
+func (signModeEthTxHandler) GetSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_ETH_TX {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_ETH_TX, mode)
+	}
+
+	w, ok := tx.(*wrapper)
+	if !ok || w.ethTx == nil {
+		return nil, fmt.Errorf("expected a tx decoded from an ethereum tx, got %T", tx)
+	}
+
+	chainID, err := sdk.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
+	}
+	if w.ethTx.chainID.Cmp(chainID) != 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidChainID, "ethereum tx chain id %s, expected %s", w.ethTx.chainID, chainID)
+	}
+
+	return w.ethTx.sigHash(chainID)
+}
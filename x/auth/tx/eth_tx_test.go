@@ -0,0 +1,174 @@
+package tx
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDecodeEthTx(t *testing.T) {
+	privKey, pubKey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	ecdsaKey, err := ethcrypto.ToECDSA(privKey.Bytes())
+	require.NoError(t, err)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txConfig := NewTxConfig(marshaler, DefaultSignModes)
+
+	chainID := "mechain_1000000-1"
+	ethChainID := big.NewInt(1000000)
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	msgBz, err := marshaler.Marshal(msg)
+	require.NoError(t, err)
+	data, err := EthTxMsgArgs.Pack(sdk.MsgTypeURL(msg), msgBz)
+	require.NoError(t, err)
+	to := gethcommon.BytesToAddress(addr)
+
+	signTx := func(txData gethtypes.TxData, key *ecdsa.PrivateKey) []byte {
+		ethTx, err := gethtypes.SignNewTx(key, gethtypes.LatestSignerForChainID(ethChainID), txData)
+		require.NoError(t, err)
+		bz, err := ethTx.MarshalBinary()
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name      string
+		txData    gethtypes.TxData
+		expFeeCap *big.Int
+		expTipCap *big.Int
+		expErr    string
+	}{
+		{
+			name:      "legacy tx",
+			txData:    &gethtypes.LegacyTx{Nonce: 3, GasPrice: big.NewInt(5e9), Gas: 1e5, To: &to, Data: data},
+			expFeeCap: big.NewInt(5e9),
+			expTipCap: big.NewInt(5e9),
+		},
+		{
+			name: "dynamic fee tx",
+			txData: &gethtypes.DynamicFeeTx{
+				ChainID: ethChainID, Nonce: 3, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(6e9), Gas: 1e5, Data: data,
+				AccessList: gethtypes.AccessList{{Address: to, StorageKeys: []gethcommon.Hash{{0x01}}}},
+			},
+			expFeeCap: big.NewInt(6e9),
+			expTipCap: big.NewInt(1e9),
+		},
+		{
+			name:      "zero gas price",
+			txData:    &gethtypes.LegacyTx{Nonce: 3, GasPrice: big.NewInt(0), Gas: 1e5, To: &to, Data: data},
+			expFeeCap: big.NewInt(0),
+			expTipCap: big.NewInt(0),
+		},
+		{
+			name: "max priority fee higher than max fee",
+			txData: &gethtypes.DynamicFeeTx{
+				ChainID: ethChainID, Nonce: 3, GasTipCap: big.NewInt(7e9), GasFeeCap: big.NewInt(6e9), Gas: 1e5, Data: data,
+			},
+			expErr: "max priority fee per gas 7000000000 is higher than its max fee per gas 6000000000",
+		},
+		{
+			name:   "non zero value",
+			txData: &gethtypes.LegacyTx{Nonce: 3, GasPrice: big.NewInt(5e9), Gas: 1e5, To: &to, Value: big.NewInt(1), Data: data},
+			expErr: "ethereum tx value must be zero",
+		},
+		{
+			name:   "invalid data",
+			txData: &gethtypes.LegacyTx{Nonce: 3, GasPrice: big.NewInt(5e9), Gas: 1e5, To: &to, Data: []byte{0x01}},
+			expErr: "invalid ethereum tx data",
+		},
+		{
+			name:   "access list tx",
+			txData: &gethtypes.AccessListTx{ChainID: ethChainID, Nonce: 3, GasPrice: big.NewInt(5e9), Gas: 1e5, Data: data},
+			expErr: "tx parse error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBytes := signTx(tc.txData, ecdsaKey)
+			tx, err := txConfig.TxDecoder()(txBytes)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			sigTx := tx.(signing.Tx)
+			require.Equal(t, []sdk.Msg{msg}, sigTx.GetMsgs())
+			require.Equal(t, uint64(1e5), sigTx.GetGas())
+			// the fee is set by the ante handler from the gas price caps
+			require.Empty(t, sigTx.GetFee())
+			gasFeeCap, gasTipCap, ok := tx.(interface {
+				GetEthGasFeeCaps() (*big.Int, *big.Int, bool)
+			}).GetEthGasFeeCaps()
+			require.True(t, ok)
+			require.Equal(t, tc.expFeeCap, gasFeeCap)
+			require.Equal(t, tc.expTipCap, gasTipCap)
+			require.Equal(t, []sdk.AccAddress{addr}, sigTx.GetSigners())
+
+			sigs, err := sigTx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.True(t, pubKey.Equals(sigs[0].PubKey))
+			require.Equal(t, uint64(3), sigs[0].Sequence)
+
+			// the tx is encoded back to the ethereum tx
+			bz, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			require.Equal(t, txBytes, bz)
+
+			// the signature is only valid on the chain of the tx
+			signerData := signing.SignerData{Address: addr.String(), ChainID: chainID, AccountNumber: 1, Sequence: 3, PubKey: pubKey}
+			require.NoError(t, signing.VerifySignature(pubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), tx, nil, nil))
+
+			signerData.ChainID = "mechain_1000001-1"
+			require.Error(t, signing.VerifySignature(pubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), tx, nil, nil))
+		})
+	}
+}
+
+func TestDecodeEthTxUnprotected(t *testing.T) {
+	privKey, _, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	ecdsaKey, err := ethcrypto.ToECDSA(privKey.Bytes())
+	require.NoError(t, err)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	to := gethcommon.BytesToAddress(addr)
+	ethTx, err := gethtypes.SignNewTx(ecdsaKey, gethtypes.HomesteadSigner{}, &gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 1, To: &to})
+	require.NoError(t, err)
+	txBytes, err := ethTx.MarshalBinary()
+	require.NoError(t, err)
+
+	require.True(t, IsEthTx(txBytes))
+	_, err = DefaultTxDecoder(marshaler)(txBytes)
+	require.ErrorContains(t, err, "legacy transactions must be EIP-155 replay protected")
+}
+
+func TestEthTxHandlerRejectsProtobufTx(t *testing.T) {
+	_, pubKey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	txBuilder := NewTxConfig(marshaler, DefaultSignModes).NewTxBuilder()
+
+	signerData := signing.SignerData{Address: addr.String(), ChainID: "mechain_1000000-1", PubKey: pubKey}
+	_, err := signModeEthTxHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_ETH_TX, signerData, txBuilder.GetTx())
+	require.ErrorContains(t, err, "expected a tx decoded from an ethereum tx")
+}
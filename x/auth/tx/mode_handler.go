@@ -15,6 +15,9 @@ var DefaultSignModes = []signingtypes.SignMode{
 
 	// For greenfield, we only enable EIP-712 by default.
 	signingtypes.SignMode_SIGN_MODE_EIP_712,
+
+	// Native Ethereum transactions are signed with SIGN_MODE_ETH_TX.
+	signingtypes.SignMode_SIGN_MODE_ETH_TX,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
@@ -36,6 +39,8 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectAuxHandler{}
 		case signingtypes.SignMode_SIGN_MODE_EIP_712:
 			handlers[i] = signModeEip712Handler{}
+		case signingtypes.SignMode_SIGN_MODE_ETH_TX:
+			handlers[i] = signModeEthTxHandler{}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -14,6 +14,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 
 	modulev1 "cosmossdk.io/api/cosmos/vesting/module/v1"
 	"cosmossdk.io/core/appmodule"
@@ -24,8 +25,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule                   = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the sub-vesting
@@ -119,6 +121,15 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMe
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// DefaultMsgGasParams returns the default gas params of the vesting msgs.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgCreateVestingAccount{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgCreatePermanentLockedAccount{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgCreatePeriodicVestingAccount{}), 1.2e3),
+	}
+}
+
 //
 // App Wiring Setup
 //
@@ -7,10 +7,13 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -70,6 +73,64 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// RateLimitedAuthorization wraps an authorization and limits the coins spent and
+// the number of messages executed with it in each period.
+type RateLimitedAuthorization struct {
+	// authorization is the wrapped authorization, which must accept a message
+	// before the limits are checked
+	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// period specifies the time duration after which the limits are reset
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum number of coins, summed over the
+	// coin fields of the messages, that can be spent in the period. Only the
+	// denoms of the limit can be spent, and no limit applies if it is empty.
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// period_max_executions specifies the maximum number of messages that can be
+	// executed in the period, no limit applies if it is zero.
+	PeriodMaxExecutions uint64 `protobuf:"varint,4,opt,name=period_max_executions,json=periodMaxExecutions,proto3" json:"period_max_executions,omitempty"`
+	// period_can_spend is the number of coins left to be spent before the period_reset time
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_executions_left is the number of messages left to be executed before the period_reset time
+	PeriodExecutionsLeft uint64 `protobuf:"varint,6,opt,name=period_executions_left,json=periodExecutionsLeft,proto3" json:"period_executions_left,omitempty"`
+	// period_reset is the time at which this period resets and a new one begins,
+	// it is calculated from the time of the first message executed after the
+	// last period ended
+	PeriodReset time.Time `protobuf:"bytes,7,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *RateLimitedAuthorization) Reset()         { *m = RateLimitedAuthorization{} }
+func (m *RateLimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedAuthorization) ProtoMessage()    {}
+func (*RateLimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *RateLimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedAuthorization.Merge(m, src)
+}
+func (m *RateLimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +145,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{2}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +187,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +226,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +257,7 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*RateLimitedAuthorization)(nil), "cosmos.authz.v1beta1.RateLimitedAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +266,50 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xb6, 0x05, 0x7e, 0x0c, 0x3f, 0x08, 0xae, 0xd5, 0x2c, 0x1c, 0xb6, 0x4d, 0x35, 0xa6,
+	0x21, 0x61, 0x37, 0x20, 0x5e, 0x38, 0x49, 0xc1, 0x10, 0x0d, 0x9a, 0xb8, 0xe0, 0xc5, 0xcb, 0x66,
+	0xda, 0x7d, 0x2c, 0x13, 0xbb, 0x3b, 0x9b, 0x9d, 0x59, 0xd3, 0x72, 0xf0, 0x0f, 0xf0, 0xc4, 0xd1,
+	0xb3, 0x27, 0xe3, 0x09, 0x13, 0xfe, 0x04, 0x0f, 0x8d, 0x27, 0xe2, 0xc9, 0x13, 0x28, 0x1c, 0xf8,
+	0x37, 0xcc, 0xce, 0xcc, 0x96, 0x96, 0xa2, 0x70, 0xc1, 0x4b, 0x33, 0x33, 0xef, 0x7d, 0xef, 0xfb,
+	0xe6, 0x9b, 0xf7, 0xba, 0xa8, 0xd2, 0xa4, 0x2c, 0xa0, 0xcc, 0xc6, 0x09, 0xdf, 0xd9, 0xb5, 0xdf,
+	0x2e, 0x34, 0x80, 0xe3, 0x05, 0xb9, 0xb3, 0xa2, 0x98, 0x72, 0xaa, 0x97, 0x64, 0x86, 0x25, 0xcf,
+	0x54, 0xc6, 0xec, 0x2d, 0x1c, 0x90, 0x90, 0xda, 0xe2, 0x57, 0x26, 0xce, 0xce, 0xc8, 0x44, 0x57,
+	0xec, 0x6c, 0x85, 0x92, 0xa1, 0xb2, 0x4f, 0xa9, 0xdf, 0x02, 0x5b, 0xec, 0x1a, 0xc9, 0xb6, 0xcd,
+	0x49, 0x00, 0x8c, 0xe3, 0x20, 0x52, 0x09, 0xe6, 0xc5, 0x04, 0x2f, 0x89, 0x31, 0x27, 0x34, 0xcc,
+	0xe2, 0x4a, 0x66, 0x03, 0x33, 0xe8, 0xa9, 0x6c, 0x52, 0x92, 0xc5, 0x4b, 0x3e, 0xf5, 0xa9, 0x24,
+	0x4e, 0x57, 0x99, 0xa2, 0x8b, 0x55, 0x71, 0xd8, 0x91, 0xa1, 0x2a, 0x47, 0xa5, 0x75, 0x08, 0x21,
+	0x26, 0xcd, 0x95, 0x84, 0xef, 0xd0, 0x98, 0xec, 0x0a, 0x3a, 0x7d, 0x1a, 0x15, 0x02, 0xe6, 0x1b,
+	0x5a, 0x45, 0xab, 0x8d, 0x3b, 0xe9, 0x72, 0xf9, 0xd9, 0xb7, 0x83, 0xf9, 0xea, 0x65, 0x1e, 0x58,
+	0x03, 0xc8, 0xf7, 0x67, 0xfb, 0x73, 0x65, 0x99, 0x36, 0xcf, 0xbc, 0x37, 0xf6, 0x65, 0xd5, 0xab,
+	0x5f, 0x47, 0x90, 0xe1, 0x60, 0x0e, 0x1b, 0x24, 0x20, 0x1c, 0xbc, 0x41, 0xea, 0x06, 0x9a, 0xc4,
+	0xfd, 0x07, 0x42, 0xc4, 0xc4, 0x62, 0xc9, 0x92, 0xb7, 0xb0, 0xb2, 0x5b, 0x58, 0x2b, 0x61, 0xa7,
+	0xfe, 0xe0, 0x7a, 0xaa, 0x9c, 0xc1, 0x92, 0xfa, 0x63, 0x34, 0x1a, 0x41, 0x4c, 0xa8, 0x67, 0xe4,
+	0x45, 0xf1, 0x99, 0xa1, 0xe2, 0x6b, 0xca, 0xf8, 0xfa, 0x64, 0xf7, 0xa8, 0x9c, 0xfb, 0x70, 0x5c,
+	0xd6, 0x3e, 0x9d, 0xed, 0xcf, 0x69, 0x8e, 0xc2, 0xe9, 0xef, 0x90, 0x2e, 0x57, 0x2e, 0x8b, 0x20,
+	0xf4, 0xdc, 0x56, 0x7a, 0x15, 0xa3, 0x50, 0x29, 0x88, 0x6a, 0x4a, 0x51, 0xfa, 0x4c, 0x3d, 0x41,
+	0xab, 0x94, 0x84, 0xf5, 0x47, 0x69, 0xb5, 0xcf, 0xc7, 0xe5, 0x9a, 0x4f, 0xf8, 0x4e, 0xd2, 0xb0,
+	0x9a, 0x34, 0x50, 0x2d, 0x62, 0xf7, 0x99, 0xc6, 0x3b, 0x11, 0x30, 0x01, 0x60, 0x92, 0x75, 0x5a,
+	0x72, 0x6d, 0xa6, 0x54, 0xc2, 0x34, 0x7d, 0x11, 0xdd, 0x51, 0xfc, 0x01, 0x6e, 0xbb, 0xd0, 0x86,
+	0x66, 0x92, 0xca, 0x65, 0x46, 0xb1, 0xa2, 0xd5, 0x8a, 0xce, 0x6d, 0x19, 0x7c, 0x8e, 0xdb, 0x4f,
+	0x7a, 0x21, 0x7d, 0x17, 0xa9, 0x3a, 0x6e, 0x13, 0x87, 0x52, 0xb7, 0x31, 0x72, 0x43, 0x8a, 0xa7,
+	0x24, 0xd3, 0x2a, 0x0e, 0x85, 0x68, 0x7d, 0x09, 0xdd, 0x55, 0xdc, 0xe7, 0x5a, 0xdd, 0x16, 0x6c,
+	0x73, 0x63, 0x54, 0x08, 0x2e, 0xc9, 0xe8, 0xb9, 0xda, 0x0d, 0xd8, 0xe6, 0xfa, 0x06, 0xfa, 0x5f,
+	0xa1, 0x62, 0x60, 0xc0, 0x8d, 0x31, 0xf1, 0x5a, 0xb3, 0x43, 0xaf, 0xb5, 0x95, 0xcd, 0x91, 0x7c,
+	0xae, 0xbd, 0xde, 0x73, 0x4d, 0x48, 0xb8, 0x93, 0xa2, 0x97, 0x5f, 0x5c, 0xbf, 0x85, 0xef, 0xf5,
+	0xdd, 0xed, 0x4f, 0x9d, 0x5a, 0xfd, 0xa2, 0xa1, 0x91, 0xf5, 0x18, 0x87, 0xfc, 0x9f, 0xf4, 0xec,
+	0x1a, 0x42, 0xd0, 0x8e, 0x88, 0x6c, 0x4b, 0x23, 0x7f, 0xa5, 0x13, 0xff, 0x75, 0x8f, 0xca, 0x5a,
+	0xea, 0x84, 0xd3, 0x87, 0xab, 0x7e, 0xcc, 0x23, 0x5d, 0x68, 0x1e, 0x1c, 0xba, 0x45, 0x34, 0xe6,
+	0xa7, 0xa7, 0x10, 0xcb, 0x99, 0xaf, 0x1b, 0xdf, 0x0f, 0xe6, 0xb3, 0xbf, 0xbc, 0x15, 0xcf, 0x8b,
+	0x81, 0xb1, 0x4d, 0x1e, 0x93, 0xd0, 0x77, 0xb2, 0xc4, 0x73, 0x0c, 0x18, 0xf9, 0xeb, 0x61, 0x60,
+	0xd8, 0xa8, 0xc2, 0x4d, 0x0c, 0x77, 0xbf, 0x51, 0xc5, 0x2b, 0x8d, 0x2a, 0x0e, 0x99, 0xb4, 0x84,
+	0xa6, 0x84, 0x47, 0x2f, 0x13, 0x48, 0xe0, 0x29, 0x87, 0x40, 0xaf, 0xa2, 0xc9, 0x80, 0xf9, 0x6e,
+	0xda, 0xe6, 0x6e, 0x12, 0xb7, 0x98, 0xa1, 0x55, 0x0a, 0xb5, 0x71, 0x67, 0x22, 0x60, 0xfe, 0x56,
+	0x27, 0x82, 0x57, 0x71, 0x8b, 0xd5, 0xeb, 0xdd, 0x5f, 0x66, 0xae, 0x7b, 0x62, 0x6a, 0x87, 0x27,
+	0xa6, 0xf6, 0xf3, 0xc4, 0xd4, 0xf6, 0x4e, 0xcd, 0xdc, 0xe1, 0xa9, 0x99, 0xfb, 0x71, 0x6a, 0xe6,
+	0x5e, 0xdf, 0xff, 0xeb, 0xfc, 0xb4, 0xe5, 0xb7, 0xa6, 0x31, 0x2a, 0xf4, 0x3d, 0xfc, 0x3d, 0x00,
+	0x5f, 0xde, 0x61, 0xe6, 0x90, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +342,95 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.PeriodExecutionsLeft != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.PeriodExecutionsLeft))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PeriodMaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.PeriodMaxExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,12 +452,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n4
+		i = encodeVarintAuthz(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -331,12 +497,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n6
+		i = encodeVarintAuthz(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +591,41 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *RateLimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodMaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.PeriodMaxExecutions))
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodExecutionsLeft != 0 {
+		n += 1 + sovAuthz(uint64(m.PeriodExecutionsLeft))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +771,264 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types1.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMaxExecutions", wireType)
+			}
+			m.PeriodMaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodMaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types1.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodExecutionsLeft", wireType)
+			}
+			m.PeriodExecutionsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodExecutionsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
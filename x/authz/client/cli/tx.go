@@ -27,6 +27,10 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagGrantee           = "grantee"
+	FlagPeriod            = "period"
+	FlagPeriodSpendLimit  = "period-spend-limit"
+	FlagPeriodExecutions  = "period-max-executions"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -46,6 +50,8 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
+		NewCmdPruneExpiredGrants(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -63,7 +69,8 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --period=24h --period-spend-limit=100stake --period-max-executions=10 --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -178,6 +185,11 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
+			authorization, err = rateLimitAuthorization(cmd, authorization)
+			if err != nil {
+				return err
+			}
+
 			expire, err := getExpireTime(cmd)
 			if err != nil {
 				return err
@@ -198,9 +210,39 @@ Examples:
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period of the rate limits of the authorization, the authorization is not rate limited if zero")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "Coins the grantee can spend in each period, summed over the coin fields of the messages")
+	cmd.Flags().Uint64(FlagPeriodExecutions, 0, "Number of messages the grantee can execute in each period, unlimited if zero")
 	return cmd
 }
 
+// rateLimitAuthorization wraps authorization in a RateLimitedAuthorization if the rate limit period is set
+func rateLimitAuthorization(cmd *cobra.Command, authorization authz.Authorization) (authz.Authorization, error) {
+	period, err := cmd.Flags().GetDuration(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+	if period == 0 {
+		return authorization, nil
+	}
+
+	limit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+	spendLimit, err := sdk.ParseCoinsNormalized(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	maxExecutions, err := cmd.Flags().GetUint64(FlagPeriodExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	return authz.NewRateLimitedAuthorization(authorization, period, spendLimit, maxExecutions)
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -247,6 +289,81 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from=[granter]",
+		Short: "revoke all the authorizations of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all the authorizations of a granter, optionally only the ones granted to a grantee and/or for a msg type:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+ $ %s tx %s revoke-all --grantee=cosmos1skj.. --msg-type=%s --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var grantee sdk.AccAddress
+			granteeStr, err := cmd.Flags().GetString(FlagGrantee)
+			if err != nil {
+				return err
+			}
+			if granteeStr != "" {
+				grantee, err = sdk.AccAddressFromHexUnsafe(granteeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			msg := authz.NewMsgRevokeAll(granter, grantee, msgType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagGrantee, "", "Revoke only the authorizations granted to this grantee")
+	cmd.Flags().String(FlagMsgType, "", "Revoke only the authorizations for this msg type url")
+	return cmd
+}
+
+// NewCmdPruneExpiredGrants returns a CLI command handler for creating a MsgPruneExpiredGrants transaction.
+func NewCmdPruneExpiredGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-expired-grants --from=[pruner]",
+		Short: "prune the expired grants",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`prune the expired grants not yet deleted at the beginning of the blocks:
+Example:
+ $ %s tx %s prune-expired-grants --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgPruneExpiredGrants(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
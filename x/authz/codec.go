@@ -17,9 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
+	legacy.RegisterAminoMsg(cdc, &MsgPruneExpiredGrants{}, "cosmos-sdk/MsgPruneExpiredGrants")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&RateLimitedAuthorization{}, "cosmos-sdk/RateLimitedAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -28,12 +31,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgRevokeAll{},
+		&MsgPruneExpiredGrants{},
 	)
 
 	registry.RegisterInterface(
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&RateLimitedAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
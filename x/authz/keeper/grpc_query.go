@@ -146,32 +146,34 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantKey)
+	indexPrefix := granteeIndexKey(grantee, nil, "")
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
 
-	authorizations, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
-		auth1, err := auth.GetAuthorization()
-		if err != nil {
-			return nil, err
+	var authorizations []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		_, granter, msgType := parseGranteeIndexKey(sdk.AppendLengthPrefixedBytes(indexPrefix, key))
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found {
+			return sdkerrors.ErrNotFound.Wrapf("grant of %s from %s to %s", msgType, granter, grantee)
 		}
 
-		granter, g, _ := parseGrantStoreKey(append(GrantKey, key...))
-		if !g.Equals(grantee) {
-			return nil, nil
+		auth, err := grant.GetAuthorization()
+		if err != nil {
+			return err
 		}
 
-		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
+		authorizationAny, err := codectypes.NewAnyWithValue(auth)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, err.Error())
+			return status.Errorf(codes.Internal, err.Error())
 		}
 
-		return &authz.GrantAuthorization{
+		authorizations = append(authorizations, &authz.GrantAuthorization{
 			Authorization: authorizationAny,
-			Expiration:    auth.Expiration,
+			Expiration:    grant.Expiration,
 			Granter:       granter.String(),
 			Grantee:       grantee.String(),
-		}, nil
-	}, func() *authz.Grant {
-		return &authz.Grant{}
+		})
+		return nil
 	})
 	if err != nil {
 		return nil, err
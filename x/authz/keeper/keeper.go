@@ -15,14 +15,26 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+const (
+	// MaxPrunedGrantsPerBlock is the maximum number of expired grants deleted at the beginning of a block
+	MaxPrunedGrantsPerBlock = 200
+	// MaxPrunedGrantsPerMsg is the maximum number of expired grants deleted by a Msg/PruneExpiredGrants
+	MaxPrunedGrantsPerMsg = 75
+)
+
 type Keeper struct {
 	storeKey   storetypes.StoreKey
 	cdc        codec.BinaryCodec
 	router     *baseapp.MsgServiceRouter
 	authKeeper authz.AccountKeeper
+
+	// the reward paid from the fee collector to the pruners, see SetPruneReward
+	bankKeeper          authz.BankKeeper
+	pruneRewardPerGrant sdk.Coins
 }
 
 // NewKeeper constructs a message authorization Keeper
@@ -35,6 +47,36 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, router *base
 	}
 }
 
+// SetPruneReward sets the coins paid from the fee collector with bk to the pruner of each expired grant
+// deleted by a Msg/PruneExpiredGrants. The reward of a Msg/PruneExpiredGrants is capped by
+// MaxPrunedGrantsPerMsg grants and by the spendable balance of the fee collector. The pruners are not
+// rewarded if rewardPerGrant is empty, which is the default.
+func (k *Keeper) SetPruneReward(bk authz.BankKeeper, rewardPerGrant sdk.Coins) {
+	if !rewardPerGrant.Empty() && bk == nil {
+		panic("bank keeper is required to reward the pruners")
+	}
+	k.bankKeeper = bk
+	k.pruneRewardPerGrant = rewardPerGrant
+}
+
+// payPruneReward pays the reward of pruned expired grants from the fee collector to pruner, and
+// returns it
+func (k Keeper) payPruneReward(ctx sdk.Context, pruner sdk.AccAddress, pruned int) (sdk.Coins, error) {
+	if k.pruneRewardPerGrant.Empty() || pruned == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	reward := k.pruneRewardPerGrant.MulInt(sdk.NewInt(int64(pruned)))
+	reward = reward.Min(k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	if reward.Empty() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, pruner, reward); err != nil {
+		return nil, err
+	}
+	return reward, nil
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", authz.ModuleName))
@@ -193,6 +235,7 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 
 	bz := k.cdc.MustMarshal(&grant)
 	store.Set(skey, bz)
+	store.Set(granteeIndexKey(grantee, granter, msgType), []byte{})
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
@@ -219,6 +262,7 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	}
 
 	store.Delete(skey)
+	store.Delete(granteeIndexKey(grantee, granter, msgType))
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
@@ -227,6 +271,35 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// DeleteAllGrants revokes all the authorizations granted by the granter, and returns the number of
+// authorizations revoked. If grantee is not empty, only the authorizations granted to it are revoked,
+// and if msgType is not empty, only the authorizations for this message type are revoked.
+func (k Keeper) DeleteAllGrants(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(grantee, granter, ""))
+
+	type grantKey struct {
+		grantee sdk.AccAddress
+		msgType string
+	}
+	var keys []grantKey
+	for ; iter.Valid(); iter.Next() {
+		_, granteeAddr, msgTypeURL := parseGrantStoreKey(iter.Key())
+		if msgType == "" || msgType == msgTypeURL {
+			keys = append(keys, grantKey{grantee: granteeAddr, msgType: msgTypeURL})
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		if err := k.DeleteGrant(ctx, key.grantee, granter, key.msgType); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(keys)), nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -372,30 +445,56 @@ func (k Keeper) removeFromGrantQueue(ctx sdk.Context, grantKey []byte, granter,
 	return nil
 }
 
-// DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
-func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
+// DequeueAndDeleteExpiredGrants deletes at most limit expired grants from the state and grant queue.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
+	_, err := k.DeleteExpiredGrants(ctx, nil, limit)
+	return err
+}
+
+// DeleteExpiredGrants deletes expired grants from the state and grant queue on behalf of pruner, which
+// is empty when the grants are pruned at the beginning of a block, and returns the number of grants
+// deleted. The grants expiring at the same time for a granter and grantee are deleted together, so the
+// number of grants deleted may slightly exceed limit.
+func (k Keeper) DeleteExpiredGrants(ctx sdk.Context, pruner sdk.AccAddress, limit int) (int, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	var prunerAddr string
+	if pruner != nil {
+		prunerAddr = pruner.String()
+	}
+
+	count := 0
+	for ; iterator.Valid() && count < limit; iterator.Next() {
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
-			return err
+			return count, err
 		}
 
 		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
 		if err != nil {
-			return err
+			return count, err
 		}
 
 		store.Delete(iterator.Key())
 
 		for _, typeURL := range queueItem.MsgTypeUrls {
 			store.Delete(grantStoreKey(grantee, granter, typeURL))
+			store.Delete(granteeIndexKey(grantee, granter, typeURL))
+			count++
+
+			if err := ctx.EventManager().EmitTypedEvent(&authz.EventPruneGrant{
+				MsgTypeUrl: typeURL,
+				Granter:    granter.String(),
+				Grantee:    grantee.String(),
+				Pruner:     prunerAddr,
+			}); err != nil {
+				return count, err
+			}
 		}
 	}
 
-	return nil
+	return count, nil
 }
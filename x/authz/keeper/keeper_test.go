@@ -294,6 +294,36 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionRateLimited() {
+	require := s.Require()
+	granterAddr, granteeAddr, recipientAddr := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	a, err := authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(bankSendAuthMsgType), time.Hour, coins100, 2)
+	require.NoError(err)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, nil))
+
+	send := func(ctx sdk.Context, amount sdk.Coins) error {
+		_, err := s.authzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{
+			&banktypes.MsgSend{Amount: amount, FromAddress: granterAddr.String(), ToAddress: recipientAddr.String()},
+		})
+		return err
+	}
+
+	require.NoError(send(s.ctx, coins10))
+	authzs, err := s.authzKeeper.GetAuthorizations(s.ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(authzs, 1)
+	limited := authzs[0].(*authz.RateLimitedAuthorization)
+	require.Equal(coins100.Sub(coins10...), limited.PeriodCanSpend)
+	require.Equal(uint64(1), limited.PeriodExecutionsLeft)
+
+	require.ErrorContains(send(s.ctx, coins100), "requested amount is more than the period spend limit")
+	require.NoError(send(s.ctx, coins10))
+	require.ErrorContains(send(s.ctx, coins10), "no executions left in the period")
+
+	require.NoError(send(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)), coins100))
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
@@ -370,7 +400,7 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.NoError(err)
 
 	newCtx := s.ctx.WithBlockTime(exp.AddDate(1, 0, 0))
-	err = s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx)
+	err = s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx, authzkeeper.MaxPrunedGrantsPerBlock)
 	require.NoError(err)
 
 	s.T().Log("verify expired grants are pruned from the state")
@@ -15,9 +15,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grantee_granter_Bytes>: []byte{}
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey           = []byte{0x01} // prefix for each key
+	GrantQueuePrefix   = []byte{0x02}
+	GranteeIndexPrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -38,6 +40,24 @@ func grantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType strin
 	return key
 }
 
+// granteeIndexKey - return the key indexing a grant by its grantee
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func granteeIndexKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	return sdk.AppendLengthPrefixedBytes(GranteeIndexPrefix, grantee, granter, m)
+}
+
+// parseGranteeIndexKey - split grantee, granter address and msg type from the grantee index key
+func parseGranteeIndexKey(key []byte) (granteeAddr, granterAddr sdk.AccAddress, msgType string) {
+	// the grantee index key has the layout of the authorization key with the addresses swapped
+	return parseGrantStoreKey(key)
+}
+
 // parseGrantStoreKey - split granter, grantee address and msg type from the authorization key
 func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
 	// key is of format:
@@ -68,6 +68,54 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(goCtx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromHexUnsafe(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	var grantee sdk.AccAddress
+	if msg.Grantee != "" {
+		grantee, err = sdk.AccAddressFromHexUnsafe(msg.Grantee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	revoked, err := k.DeleteAllGrants(ctx, granter, grantee, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if revoked == 0 {
+		return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no authorization granted by %s", granter)
+	}
+
+	return &authz.MsgRevokeAllResponse{Revoked: revoked}, nil
+}
+
+// PruneExpiredGrants implements the MsgServer.PruneExpiredGrants method.
+func (k Keeper) PruneExpiredGrants(goCtx context.Context, msg *authz.MsgPruneExpiredGrants) (*authz.MsgPruneExpiredGrantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pruner, err := sdk.AccAddressFromHexUnsafe(msg.Pruner)
+	if err != nil {
+		return nil, err
+	}
+
+	pruned, err := k.DeleteExpiredGrants(ctx, pruner, MaxPrunedGrantsPerMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	reward, err := k.payPruneReward(ctx, pruner, pruned)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgPruneExpiredGrantsResponse{Pruned: uint64(pruned), Reward: reward}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
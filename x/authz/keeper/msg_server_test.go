@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/golang/mock/gomock"
@@ -275,3 +276,179 @@ func (suite *TestSuite) TestExec() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestRevokeAll() {
+	granter, grantee1, grantee2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	voteMsgType := "/cosmos.gov.v1.MsgVote"
+
+	testCases := []struct {
+		name       string
+		msg        *authz.MsgRevokeAll
+		expErr     string
+		expRevoked uint64
+		expLeft    int
+	}{
+		{
+			name:   "invalid granter",
+			msg:    &authz.MsgRevokeAll{Granter: "invalid"},
+			expErr: "invalid address hex length",
+		},
+		{
+			name:   "invalid grantee",
+			msg:    &authz.MsgRevokeAll{Granter: granter.String(), Grantee: "invalid"},
+			expErr: "invalid address hex length",
+		},
+		{
+			name:       "all grants",
+			msg:        &authz.MsgRevokeAll{Granter: granter.String()},
+			expRevoked: 4,
+		},
+		{
+			name:       "grants to a grantee",
+			msg:        &authz.MsgRevokeAll{Granter: granter.String(), Grantee: grantee1.String()},
+			expRevoked: 2,
+			expLeft:    2,
+		},
+		{
+			name:       "grants for a msg type",
+			msg:        &authz.MsgRevokeAll{Granter: granter.String(), MsgTypeUrl: voteMsgType},
+			expRevoked: 2,
+			expLeft:    2,
+		},
+		{
+			name:       "grants to a grantee for a msg type",
+			msg:        &authz.MsgRevokeAll{Granter: granter.String(), Grantee: grantee2.String(), MsgTypeUrl: voteMsgType},
+			expRevoked: 1,
+			expLeft:    3,
+		},
+		{
+			name:   "no grant to revoke",
+			msg:    &authz.MsgRevokeAll{Granter: grantee1.String()},
+			expErr: "authorization not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			for _, grantee := range []sdk.AccAddress{grantee1, grantee2} {
+				suite.createSendAuthorization(grantee, granter)
+				suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(voteMsgType), nil))
+			}
+
+			res, err := suite.msgSrvr.RevokeAll(suite.ctx, tc.msg)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRevoked, res.Revoked)
+
+			grants, err := suite.queryClient.GranterGrants(suite.ctx, &authz.QueryGranterGrantsRequest{Granter: granter.String()})
+			suite.Require().NoError(err)
+			suite.Require().Len(grants.Grants, tc.expLeft)
+
+			revokeEvents := 0
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == "cosmos.authz.v1beta1.EventRevoke" {
+					revokeEvents++
+				}
+			}
+			suite.Require().Equal(int(tc.expRevoked), revokeEvents)
+		})
+	}
+}
+
+func (suite *TestSuite) TestPruneExpiredGrants() {
+	granter, pruner := suite.addrs[0], suite.addrs[1]
+	grantees := simtestutil.CreateIncrementalAccounts(keeper.MaxPrunedGrantsPerMsg + 10)[2:]
+	now := suite.ctx.BlockTime()
+	expiration := now.Add(time.Hour)
+
+	for _, grantee := range grantees {
+		suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(bankSendAuthMsgType), &expiration))
+	}
+	// a grant which does not expire
+	suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, pruner, granter, authz.NewGenericAuthorization(bankSendAuthMsgType), nil))
+
+	_, err := suite.msgSrvr.PruneExpiredGrants(suite.ctx, &authz.MsgPruneExpiredGrants{Pruner: "invalid"})
+	suite.Require().ErrorContains(err, "invalid address hex length")
+
+	res, err := suite.msgSrvr.PruneExpiredGrants(suite.ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Pruned)
+
+	ctx := suite.ctx.WithBlockTime(expiration.Add(time.Second)).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(sdk.NewGasMeter(1e6))
+	ctx.GasMeter().ConsumeGas(1e5, "tx")
+
+	res, err = suite.msgSrvr.PruneExpiredGrants(ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(keeper.MaxPrunedGrantsPerMsg), res.Pruned)
+	// the pruners are not rewarded by default
+	suite.Require().Empty(res.Reward)
+	// no gas is refunded to the pruner
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(1e5))
+
+	pruneEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "cosmos.authz.v1beta1.EventPruneGrant" {
+			pruneEvents++
+		}
+	}
+	suite.Require().Equal(keeper.MaxPrunedGrantsPerMsg, pruneEvents)
+
+	res, err = suite.msgSrvr.PruneExpiredGrants(ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(grantees)-keeper.MaxPrunedGrantsPerMsg), res.Pruned)
+
+	grants, err := suite.queryClient.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{Granter: granter.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants.Grants, 1)
+	suite.Require().Equal(pruner.String(), grants.Grants[0].Grantee)
+
+	for _, grantee := range grantees {
+		res, err := suite.queryClient.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{Grantee: grantee.String()})
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.Grants)
+	}
+}
+
+func (suite *TestSuite) TestPruneExpiredGrantsReward() {
+	granter, pruner := suite.addrs[0], suite.addrs[1]
+	grantees := simtestutil.CreateIncrementalAccounts(keeper.MaxPrunedGrantsPerMsg + 12)[2:]
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	for _, grantee := range grantees {
+		suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(bankSendAuthMsgType), &expiration))
+	}
+
+	k := suite.authzKeeper
+	k.SetPruneReward(suite.bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 1)))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	ctx := suite.ctx.WithBlockTime(expiration.Add(time.Second))
+
+	// the reward is capped by the balance of the fee collector
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feeCollector).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+	expReward := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, pruner, expReward).Return(nil)
+	res, err := k.PruneExpiredGrants(ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(keeper.MaxPrunedGrantsPerMsg), res.Pruned)
+	suite.Require().Equal(expReward, res.Reward)
+
+	// the reward is paid per grant deleted
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), feeCollector).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 1000)))
+	expReward = sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, pruner, expReward).Return(nil)
+	res, err = k.PruneExpiredGrants(ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(grantees)-keeper.MaxPrunedGrantsPerMsg), res.Pruned)
+	suite.Require().Equal(expReward, res.Reward)
+
+	// nothing is paid when no grant is deleted
+	res, err = k.PruneExpiredGrants(ctx, &authz.MsgPruneExpiredGrants{Pruner: pruner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Pruned)
+	suite.Require().Empty(res.Reward)
+}
@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for store prefixes
+//
+// - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: Grant
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+var (
+	GrantPrefix        = []byte{0x01}
+	GranteeIndexPrefix = []byte{0x03}
+)
+
+// MigrateStore performs in-place store migrations from version 2 to 3. The
+// migration includes:
+//
+// - create secondary index of the authorizations by grantee
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	grantsStore := prefix.NewStore(store, GrantPrefix)
+
+	grantsIter := grantsStore.Iterator(nil, nil)
+	defer grantsIter.Close()
+
+	for ; grantsIter.Valid(); grantsIter.Next() {
+		store.Set(GranteeIndexKey(grantsIter.Key()), []byte{})
+	}
+
+	return nil
+}
+
+// GranteeIndexKey returns the grantee index key of a grant from its key without the prefix
+func GranteeIndexKey(grantKey []byte) []byte {
+	// key is of format:
+	// <granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+	kv.AssertKeyAtLeastLength(grantKey, 1)
+	granterEnd := 1 + int(grantKey[0])
+	kv.AssertKeyAtLeastLength(grantKey, granterEnd+1)
+	granteeEnd := granterEnd + 1 + int(grantKey[granterEnd])
+	kv.AssertKeyAtLeastLength(grantKey, granteeEnd)
+
+	granter := grantKey[1:granterEnd]
+	grantee := grantKey[granterEnd+1 : granteeEnd]
+	return sdk.AppendLengthPrefixedBytes(
+		GranteeIndexPrefix, address.MustLengthPrefix(grantee), address.MustLengthPrefix(granter), grantKey[granteeEnd:],
+	)
+}
@@ -0,0 +1,51 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v2"
+	v3 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v3"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigration(t *testing.T) {
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	genericMsgType := "/cosmos.gov.v1.MsgVote"
+
+	store.Set(v2.GrantStoreKey(grantee1, granter, sendMsgType), []byte{0x01})
+	store.Set(v2.GrantStoreKey(grantee1, granter, genericMsgType), []byte{0x01})
+	store.Set(v2.GrantStoreKey(grantee2, granter, sendMsgType), []byte{0x01})
+
+	require.NoError(t, v3.MigrateStore(ctx, authzKey))
+
+	indexKey := func(grantee sdk.AccAddress, msgType string) []byte {
+		key := append([]byte{0x03, byte(len(grantee))}, grantee...)
+		key = append(key, byte(len(granter)))
+		key = append(key, granter...)
+		return append(key, msgType...)
+	}
+	require.True(t, store.Has(indexKey(grantee1, sendMsgType)))
+	require.True(t, store.Has(indexKey(grantee1, genericMsgType)))
+	require.True(t, store.Has(indexKey(grantee2, sendMsgType)))
+	require.False(t, store.Has(indexKey(grantee2, genericMsgType)))
+
+	iter := sdk.KVStorePrefixIterator(store, v3.GranteeIndexPrefix)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	require.Equal(t, 3, count)
+}
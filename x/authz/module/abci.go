@@ -6,9 +6,9 @@ import (
 )
 
 // BeginBlocker is called at the beginning of every block
-func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
-	// delete all the mature grants
-	if err := keeper.DequeueAndDeleteExpiredGrants(ctx); err != nil {
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// delete the mature grants, the ones left over are deleted in the next blocks or by Msg/PruneExpiredGrants
+	if err := k.DequeueAndDeleteExpiredGrants(ctx, keeper.MaxPrunedGrantsPerBlock); err != nil {
 		panic(err)
 	}
 }
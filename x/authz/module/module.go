@@ -27,12 +27,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/authz/simulation"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
 var (
-	_ module.BeginBlockAppModule = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.BeginBlockAppModule         = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the authz module.
@@ -55,6 +57,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", authz.ModuleName, err))
 	}
+	err = cfg.RegisterMigration(authz.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", authz.ModuleName, err))
+	}
 }
 
 // RegisterLegacyAminoCodec registers the authz module's types for the given codec.
@@ -157,7 +163,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// DefaultMsgGasParams returns the default gas params of the authz msgs declared by this module, the
+// gas params of MsgGrant and MsgExec being set by the gashub genesis.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&authz.MsgRevokeAll{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&authz.MsgPruneExpiredGrants{}), 1.2e3),
+	}
+}
 
 // BeginBlock returns the begin blocker for the authz module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -174,6 +189,7 @@ func init() {
 type AuthzInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Key              *store.KVStoreKey
 	Cdc              codec.Codec
 	AccountKeeper    authz.AccountKeeper
@@ -191,6 +207,13 @@ type AuthzOutputs struct {
 
 func ProvideModule(in AuthzInputs) AuthzOutputs {
 	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
+	if in.Config.PruneRewardPerGrant != "" {
+		rewardPerGrant, err := sdk.ParseCoinsNormalized(in.Config.PruneRewardPerGrant)
+		if err != nil {
+			panic(fmt.Errorf("invalid prune reward per grant: %w", err))
+		}
+		k.SetPruneReward(in.BankKeeper, rewardPerGrant)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return AuthzOutputs{AuthzKeeper: k, Module: m}
 }
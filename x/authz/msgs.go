@@ -17,11 +17,15 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevokeAll{}
+	_ sdk.Msg = &MsgPruneExpiredGrants{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
 	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgRevokeAll{}
+	_ legacytx.LegacyMsg = &MsgPruneExpiredGrants{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -172,6 +176,102 @@ func (msg MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll. grantee and msgTypeURL are optional filters of the
+// authorizations revoked.
+//
+//nolint:interfacer
+func NewMsgRevokeAll(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string) MsgRevokeAll {
+	msg := MsgRevokeAll{
+		Granter:    granter.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+	if !grantee.Empty() {
+		msg.Grantee = grantee.String()
+	}
+	return msg
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromHexUnsafe(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeAll) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromHexUnsafe(msg.Granter)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if msg.Grantee != "" {
+		grantee, err := sdk.AccAddressFromHexUnsafe(msg.Grantee)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		}
+
+		if granter.Equals(grantee) {
+			return ErrGranteeIsGranter
+		}
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAll) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAll) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgPruneExpiredGrants creates a new MsgPruneExpiredGrants
+//
+//nolint:interfacer
+func NewMsgPruneExpiredGrants(pruner sdk.AccAddress) MsgPruneExpiredGrants {
+	return MsgPruneExpiredGrants{
+		Pruner: pruner.String(),
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgPruneExpiredGrants) GetSigners() []sdk.AccAddress {
+	pruner, _ := sdk.AccAddressFromHexUnsafe(msg.Pruner)
+	return []sdk.AccAddress{pruner}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgPruneExpiredGrants) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Pruner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid pruner address: %s", err)
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgPruneExpiredGrants) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgPruneExpiredGrants) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgPruneExpiredGrants) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 //
 //nolint:interfacer
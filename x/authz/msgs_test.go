@@ -77,6 +77,34 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeAllAuthorizations(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, "hello", false},
+		{"granter is grantee", granter, granter, "", false},
+		{"all grants", granter, nil, "", true},
+		{"grants to a grantee", granter, grantee, "", true},
+		{"grants to a grantee for a msg type", granter, grantee, "hello", true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRevokeAll(tc.granter, tc.grantee, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg := authz.NewMsgPruneExpiredGrants(nil)
+	require.Error(t, msg.ValidateBasic())
+	msg = authz.NewMsgPruneExpiredGrants(granter)
+	require.NoError(t, msg.ValidateBasic())
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
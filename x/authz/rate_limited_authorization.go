@@ -0,0 +1,227 @@
+package authz
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                    = &RateLimitedAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &RateLimitedAuthorization{}
+)
+
+// NewRateLimitedAuthorization creates a new RateLimitedAuthorization wrapping a, which limits the coins
+// spent and the number of messages executed in each period.
+func NewRateLimitedAuthorization(a Authorization, period time.Duration, spendLimit sdk.Coins, maxExecutions uint64) (*RateLimitedAuthorization, error) {
+	authorization := &RateLimitedAuthorization{
+		Period:              period,
+		PeriodSpendLimit:    spendLimit,
+		PeriodMaxExecutions: maxExecutions,
+	}
+	if err := authorization.SetAuthorization(a); err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+// SetAuthorization sets the wrapped authorization
+func (a *RateLimitedAuthorization) SetAuthorization(authorization Authorization) error {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", authorization)
+	}
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	a.Authorization = any
+	return nil
+}
+
+// GetAuthorization returns the wrapped authorization
+func (a RateLimitedAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	av := a.Authorization.GetCachedValue()
+	authorization, ok := av.(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
+	}
+	return authorization, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a RateLimitedAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RateLimitedAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The message must be accepted by the wrapped authorization,
+// then the coins of the message and its execution are deducted from what is left in the period.
+func (a RateLimitedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept {
+		return resp, err
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	if !a.PeriodSpendLimit.Empty() {
+		coins, err := MsgCoins(msg)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+		var isNeg bool
+		a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(coins...)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than the period spend limit")
+		}
+	}
+
+	if a.PeriodMaxExecutions != 0 {
+		if a.PeriodExecutionsLeft == 0 {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("no executions left in the period")
+		}
+		a.PeriodExecutionsLeft--
+	}
+
+	if resp.Delete {
+		return resp, nil
+	}
+	if resp.Updated != nil {
+		if err := a.SetAuthorization(resp.Updated); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod resets the coins and executions left in the period if the period reset is reached.
+// If we are within one period from the reset, the next reset is one period after it, otherwise it
+// is one period from blockTime.
+func (a *RateLimitedAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodExecutionsLeft = a.PeriodMaxExecutions
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RateLimitedAuthorization) ValidateBasic() error {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if a.PeriodSpendLimit.Empty() && a.PeriodMaxExecutions == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period spend limit and max executions cannot be both empty")
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	if !a.PeriodCanSpend.DenomsSubsetOf(a.PeriodSpendLimit) && !a.PeriodCanSpend.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period can spend has different denoms than period spend limit")
+	}
+	if a.PeriodExecutionsLeft > a.PeriodMaxExecutions {
+		return sdkerrors.ErrInvalidRequest.Wrap("period executions left is more than max executions")
+	}
+
+	return nil
+}
+
+var (
+	coinType  = reflect.TypeOf(sdk.Coin{})
+	coinsType = reflect.TypeOf(sdk.Coins{})
+)
+
+// MsgCoins returns the sum of the coins in the top level fields of msg of type sdk.Coin, *sdk.Coin
+// or sdk.Coins. An error is returned if msg has coins nested in other fields, e.g. the inputs of
+// MsgMultiSend, as they cannot be accounted for.
+func MsgCoins(msg sdk.Msg) (sdk.Coins, error) {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	coins := sdk.NewCoins()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		switch {
+		case field.Type() == coinsType:
+			coins = coins.Add(field.Interface().(sdk.Coins)...)
+		case field.Type() == coinType:
+			coins = coins.Add(field.Interface().(sdk.Coin))
+		case field.Type() == reflect.PointerTo(coinType):
+			if !field.IsNil() {
+				coins = coins.Add(*field.Interface().(*sdk.Coin))
+			}
+		case hasNestedCoins(field.Type(), map[reflect.Type]bool{}):
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("coins nested in field %s of %T cannot be accounted for by the period spend limit",
+				v.Type().Field(i).Name, msg)
+		}
+	}
+	return coins, nil
+}
+
+// hasNestedCoins returns whether values of type t can hold a sdk.Coin.
+func hasNestedCoins(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if t == coinType {
+		return true
+	}
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return hasNestedCoins(t.Elem(), seen)
+	case reflect.Map:
+		return hasNestedCoins(t.Key(), seen) || hasNestedCoins(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasNestedCoins(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
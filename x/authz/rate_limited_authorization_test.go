@@ -0,0 +1,154 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRateLimitedAuthorization(t *testing.T) {
+	key := sdk.NewKVStoreKey(authz.ModuleName)
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test")).WithBlockHeader(tmproto.Header{Time: now})
+
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	send := func(amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: granter.String(), ToAddress: grantee.String(), Amount: amount}
+	}
+
+	t.Log("verify ValidateBasic")
+	_, err := authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(sendMsgType), time.Hour, coins(100), 2)
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		period        time.Duration
+		spendLimit    sdk.Coins
+		maxExecutions uint64
+		expectPass    bool
+	}{
+		{time.Hour, coins(100), 0, true},
+		{time.Hour, nil, 2, true},
+		{0, coins(100), 2, false},
+		{time.Hour, nil, 0, false},
+		{time.Hour, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, 2, false},
+	} {
+		a, err := authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(sendMsgType), tc.period, tc.spendLimit, tc.maxExecutions)
+		require.NoError(t, err)
+		require.Equal(t, sendMsgType, a.MsgTypeURL())
+		if tc.expectPass {
+			require.NoError(t, a.ValidateBasic())
+		} else {
+			require.Error(t, a.ValidateBasic())
+		}
+	}
+
+	t.Log("verify the spend limit and executions of a period")
+	var a authz.Authorization
+	a, err = authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(sendMsgType), time.Hour, coins(100), 2)
+	require.NoError(t, err)
+
+	resp, err := a.Accept(ctx, send(coins(60)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	a = resp.Updated
+	limited := a.(*authz.RateLimitedAuthorization)
+	require.Equal(t, coins(40), limited.PeriodCanSpend)
+	require.Equal(t, uint64(1), limited.PeriodExecutionsLeft)
+	require.Equal(t, now.Add(time.Hour), limited.PeriodReset)
+
+	_, err = a.Accept(ctx, send(coins(50)))
+	require.ErrorContains(t, err, "requested amount is more than the period spend limit")
+	_, err = a.Accept(ctx, send(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.ErrorContains(t, err, "requested amount is more than the period spend limit")
+
+	resp, err = a.Accept(ctx, send(coins(40)))
+	require.NoError(t, err)
+	a = resp.Updated
+	_, err = a.Accept(ctx, send(coins(0)))
+	require.ErrorContains(t, err, "no executions left in the period")
+
+	t.Log("verify the limits are reset in the next period")
+	resp, err = a.Accept(ctx.WithBlockTime(now.Add(time.Hour)), send(coins(100)))
+	require.NoError(t, err)
+	limited = resp.Updated.(*authz.RateLimitedAuthorization)
+	require.True(t, limited.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(2*time.Hour), limited.PeriodReset)
+
+	resp, err = limited.Accept(ctx.WithBlockTime(now.Add(5*time.Hour)), send(coins(10)))
+	require.NoError(t, err)
+	require.Equal(t, now.Add(6*time.Hour), resp.Updated.(*authz.RateLimitedAuthorization).PeriodReset)
+
+	t.Log("verify the wrapped authorization is updated and deleted")
+	a, err = authz.NewRateLimitedAuthorization(banktypes.NewSendAuthorization(coins(30), nil), time.Hour, nil, 10)
+	require.NoError(t, err)
+	resp, err = a.Accept(ctx, send(coins(20)))
+	require.NoError(t, err)
+	inner, err := resp.Updated.(*authz.RateLimitedAuthorization).GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, coins(10), inner.(*banktypes.SendAuthorization).SpendLimit)
+
+	resp, err = resp.Updated.Accept(ctx, send(coins(10)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	_, err = a.Accept(ctx, send(coins(40)))
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+}
+
+func TestMsgCoins(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5))
+	coins, err := authz.MsgCoins(&banktypes.MsgSend{Amount: amount})
+	require.NoError(t, err)
+	require.Equal(t, amount, coins)
+	coins, err = authz.MsgCoins(&stakingtypes.MsgDelegate{Amount: sdk.NewInt64Coin("stake", 7)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), coins)
+	coins, err = authz.MsgCoins(&banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+
+	// the coins of the inputs of a multi send cannot be accounted for
+	_, err = authz.MsgCoins(&banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(granter, amount)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(grantee, amount)},
+	})
+	require.ErrorContains(t, err, "coins nested in field Inputs")
+}
+
+func TestRateLimitedAuthorizationMultiSend(t *testing.T) {
+	key := sdk.NewKVStoreKey(authz.ModuleName)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test")).WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(granter, amount)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(grantee, amount)},
+	}
+
+	// a multi send cannot bypass the period spend limit
+	a, err := authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(multiSendMsgType), time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0)
+	require.NoError(t, err)
+	_, err = a.Accept(ctx, multiSend)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// it is only limited by the number of executions without a period spend limit
+	a, err = authz.NewRateLimitedAuthorization(authz.NewGenericAuthorization(multiSendMsgType), time.Hour, nil, 1)
+	require.NoError(t, err)
+	resp, err := a.Accept(ctx, multiSend)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	_, err = resp.Updated.Accept(ctx, multiSend)
+	require.ErrorContains(t, err, "no executions left in the period")
+}
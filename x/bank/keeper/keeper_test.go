@@ -567,6 +567,82 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	var calls []string
+	redirect := func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	}
+	freeze := func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "freeze")
+		if toAddr.Equals(accAddrs[3]) {
+			return nil, fmt.Errorf("account %s is frozen", toAddr)
+		}
+		return toAddr, nil
+	}
+	suite.bankKeeper.AppendSendRestriction(freeze)
+	suite.bankKeeper.PrependSendRestriction(redirect)
+
+	// the restrictions run in order and can substitute the recipient
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal([]string{"redirect", "freeze"}, calls)
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// a restriction error aborts the transfer
+	calls = nil
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt), "is frozen")
+	require.Equal([]string{"redirect", "freeze"}, calls)
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	// the restrictions apply to the transfers from module accounts
+	calls = nil
+	suite.mockMintCoins(mintAcc)
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[2])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[1], sendAmt))
+	require.Equal([]string{"redirect", "freeze"}, calls)
+	require.Equal(sendAmt.Add(sendAmt...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// the restrictions apply to every output of a multi send
+	calls = nil
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sendAmt.Add(sendAmt...)}}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sendAmt},
+		{Address: accAddrs[2].String(), Coins: sendAmt},
+	}
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, []sdk.AccAddress{accAddrs[2], accAddrs[2]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal([]string{"redirect", "freeze", "redirect", "freeze"}, calls)
+	require.Equal(sdk.NewCoins(newFooCoin(40)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	multiInputs := []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sendAmt},
+		{Address: accAddrs[0].String(), Coins: sendAmt},
+	}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, multiInputs, outputs), banktypes.ErrMultipleSenders)
+
+	// no restriction is applied once cleared
+	calls = nil
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[3])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt))
+	require.Empty(calls)
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
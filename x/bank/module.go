@@ -28,6 +28,7 @@ import (
 	v1bank "github.com/cosmos/cosmos-sdk/x/bank/migrations/v1"
 	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -35,9 +36,10 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                   = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -177,6 +179,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// DefaultMsgGasParams returns the default gas params of the bank msgs declared by this module.
+// MsgSetSendEnabled is only executed by governance.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgSetSendEnabled{}), 0),
+	}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// newTestRestriction returns a SendRestrictionFn recording its calls in calls. It substitutes the
+// recipient by newToAddr if not nil, and returns err if not nil.
+func newTestRestriction(name string, calls *[]string, newToAddr sdk.AccAddress, err error) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name)
+		if err != nil {
+			return nil, err
+		}
+		if newToAddr != nil {
+			return newToAddr, nil
+		}
+		return toAddr, nil
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	otherAddr := sdk.AccAddress("other_______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	errFrozen := errors.New("frozen")
+
+	var calls []string
+	testCases := []struct {
+		name      string
+		fn        types.SendRestrictionFn
+		expNil    bool
+		expCalls  []string
+		expToAddr sdk.AccAddress
+		expErr    error
+	}{
+		{
+			name:   "no restrictions",
+			fn:     types.ComposeSendRestrictions(),
+			expNil: true,
+		},
+		{
+			name:   "nil restrictions",
+			fn:     types.ComposeSendRestrictions(nil, nil),
+			expNil: true,
+		},
+		{
+			name:      "single restriction",
+			fn:        types.ComposeSendRestrictions(nil, newTestRestriction("a", &calls, nil, nil), nil),
+			expCalls:  []string{"a"},
+			expToAddr: toAddr,
+		},
+		{
+			name: "restrictions run in order",
+			fn: types.ComposeSendRestrictions(
+				newTestRestriction("a", &calls, nil, nil),
+				newTestRestriction("b", &calls, nil, nil),
+				newTestRestriction("c", &calls, nil, nil),
+			),
+			expCalls:  []string{"a", "b", "c"},
+			expToAddr: toAddr,
+		},
+		{
+			name:      "then runs the second restriction after the first one",
+			fn:        newTestRestriction("a", &calls, nil, nil).Then(newTestRestriction("b", &calls, otherAddr, nil)),
+			expCalls:  []string{"a", "b"},
+			expToAddr: otherAddr,
+		},
+		{
+			name: "substituted recipient is passed to the next restriction",
+			fn: types.ComposeSendRestrictions(
+				newTestRestriction("a", &calls, otherAddr, nil),
+				func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+					calls = append(calls, "b:"+toAddr.String())
+					return toAddr, nil
+				},
+			),
+			expCalls:  []string{"a", "b:" + otherAddr.String()},
+			expToAddr: otherAddr,
+		},
+		{
+			name: "error stops the composition",
+			fn: types.ComposeSendRestrictions(
+				newTestRestriction("a", &calls, nil, nil),
+				newTestRestriction("b", &calls, nil, errFrozen),
+				newTestRestriction("c", &calls, nil, nil),
+			),
+			expCalls: []string{"a", "b"},
+			expErr:   errFrozen,
+		},
+		{
+			name:      "no-op restriction",
+			fn:        types.ComposeSendRestrictions(types.NoOpSendRestrictionFn),
+			expToAddr: toAddr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			if tc.expNil {
+				require.Nil(t, tc.fn)
+				return
+			}
+
+			newToAddr, err := tc.fn(sdk.Context{}, fromAddr, toAddr, amt)
+			require.Equal(t, tc.expCalls, calls)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expToAddr, newToAddr)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/exported"
 	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -35,8 +36,9 @@ import (
 const ConsensusVersion = 2
 
 var (
-	_ module.EndBlockAppModule = AppModule{}
-	_ module.AppModuleBasic    = AppModuleBasic{}
+	_ module.EndBlockAppModule           = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // Module init related flags
@@ -175,6 +177,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// DefaultMsgGasParams returns the default gas params of the crisis msgs, MsgUpdateParams being only
+// executed by governance.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgUpdateParams{}), 0),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgVerifyInvariant{}), 1.2e3),
+	}
+}
+
 // EndBlock returns the end blocker for the crisis module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
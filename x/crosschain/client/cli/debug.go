@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// DebugCmd returns the offline debugging commands for cross chain packages, to be added to
+// the debug command of the application.
+func DebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crosschain",
+		Short: "Tools for debugging cross chain packages",
+	}
+
+	cmd.AddCommand(DecodePackageCmd())
+
+	return cmd
+}
+
+// DecodePackageCmd returns a command to decode a hex encoded cross chain package offline.
+func DecodePackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [hex]",
+		Short: "Decode a hex encoded cross chain package or claim payload",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Decode a hex encoded cross chain package, i.e. the package header followed by the payload.
+The payload is decoded if the channel is given with --%s and its payload format is known,
+otherwise it is printed as hex. With --%s, the input is decoded as the payload of a
+MsgClaim instead, i.e. the RLP encoded list of packages relayed in a single claim.
+
+Example:
+$ %s debug crosschain decode 0x00000000006541c1a1... --%s 3
+$ %s debug crosschain decode 0xf8b5f8b3... --%s
+`, FlagChannel, FlagClaim, version.AppName, FlagChannel, version.AppName, FlagClaim),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input := args[0]
+			if !strings.HasPrefix(input, "0x") {
+				input = "0x" + input
+			}
+			bz, err := hexutil.Decode(input)
+			if err != nil {
+				return fmt.Errorf("invalid hex: %w", err)
+			}
+
+			claim, err := cmd.Flags().GetBool(FlagClaim)
+			if err != nil {
+				return err
+			}
+			channelId, err := cmd.Flags().GetUint8(FlagChannel)
+			if err != nil {
+				return err
+			}
+
+			var decoded interface{}
+			if claim {
+				decoded, err = DecodeClaimPayload(bz)
+			} else {
+				decoded, err = DecodePackage(sdk.ChannelID(channelId), bz)
+			}
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint8(FlagChannel, 0, "Channel id the package was sent on, used to decode the payload")
+	cmd.Flags().Bool(FlagClaim, false, "Decode the input as the RLP encoded payload of a MsgClaim")
+
+	return cmd
+}
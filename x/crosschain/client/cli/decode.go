@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// PayloadDecoder decodes the payload of a cross-chain package, without the package header,
+// into a human readable form.
+type PayloadDecoder func(packageType sdk.CrossChainPackageType, payload []byte) (interface{}, error)
+
+// payloadDecoders are the decoders of the channel payloads known to the CLI. Payloads of
+// other channels are printed as hex.
+var payloadDecoders = map[sdk.ChannelID]PayloadDecoder{
+	govtypes.SyncParamsChannelID: decodeSyncParamsPayload,
+}
+
+// DecodedPackage is the human readable form of a cross-chain package.
+type DecodedPackage struct {
+	ChannelId     sdk.ChannelID    `json:"channel_id,omitempty"`
+	Sequence      *uint64          `json:"sequence,omitempty"`
+	PackageType   string           `json:"package_type"`
+	Timestamp     uint64           `json:"timestamp"`
+	Time          string           `json:"time"`
+	RelayerFee    string           `json:"relayer_fee"`
+	AckRelayerFee string           `json:"ack_relayer_fee,omitempty"`
+	Messages      []DecodedMessage `json:"messages,omitempty"`
+	Payload       interface{}      `json:"payload,omitempty"`
+	PayloadError  string           `json:"payload_error,omitempty"`
+}
+
+// DecodedMessage is the human readable form of a message bundled in a multi-message package.
+type DecodedMessage struct {
+	ChannelId    sdk.ChannelID `json:"channel_id"`
+	RelayFee     string        `json:"relay_fee"`
+	AckRelayFee  string        `json:"ack_relay_fee"`
+	Sender       string        `json:"sender"`
+	Payload      interface{}   `json:"payload"`
+	PayloadError string        `json:"payload_error,omitempty"`
+}
+
+// SyncParamsPayload is the human readable form of a gov SyncParamsPackage.
+type SyncParamsPayload struct {
+	Key     string   `json:"key"`
+	Value   string   `json:"value"`
+	Targets []string `json:"targets"`
+}
+
+// DecodePackage decodes a cross-chain package, i.e. the package header followed by the
+// payload, sent on the given channel. The payload is decoded if the channel is known.
+func DecodePackage(channelId sdk.ChannelID, bz []byte) (*DecodedPackage, error) {
+	header, err := sdk.DecodePackageHeader(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &DecodedPackage{
+		ChannelId:   channelId,
+		PackageType: header.PackageType.String(),
+		Timestamp:   header.Timestamp,
+		Time:        time.Unix(int64(header.Timestamp), 0).UTC().Format(time.RFC3339),
+		RelayerFee:  header.RelayerFee.String(),
+	}
+	if header.PackageType == sdk.SynCrossChainPackageType {
+		decoded.AckRelayerFee = header.AckRelayerFee.String()
+	}
+
+	payload := bz[sdk.GetPackageHeaderLength(header.PackageType):]
+	if channelId == oracletypes.MultiMessageChannelId && header.PackageType == sdk.SynCrossChainPackageType {
+		messages, err := decodeMultiMessagePayload(bz)
+		if err != nil {
+			decoded.Payload = hex.EncodeToString(payload)
+			decoded.PayloadError = err.Error()
+		} else {
+			decoded.Messages = messages
+		}
+		return decoded, nil
+	}
+
+	decoded.Payload, err = decodePayload(channelId, header.PackageType, payload)
+	if err != nil {
+		decoded.PayloadError = err.Error()
+	}
+	return decoded, nil
+}
+
+// DecodeClaimPayload decodes the payload of a MsgClaim, i.e. the RLP encoded packages relayed
+// in a single claim.
+func DecodeClaimPayload(bz []byte) ([]*DecodedPackage, error) {
+	var packages oracletypes.Packages
+	if err := rlp.DecodeBytes(bz, &packages); err != nil {
+		return nil, fmt.Errorf("failed to decode claim payload: %w", err)
+	}
+
+	decoded := make([]*DecodedPackage, 0, len(packages))
+	for i, pack := range packages {
+		decodedPackage, err := DecodePackage(pack.ChannelId, pack.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode package %d of channel %d: %w", i, pack.ChannelId, err)
+		}
+		sequence := pack.Sequence
+		decodedPackage.Sequence = &sequence
+		decoded = append(decoded, decodedPackage)
+	}
+	return decoded, nil
+}
+
+// decodeMultiMessagePayload decodes the messages of a multi-message package the same way the
+// oracle module does when the package is claimed.
+func decodeMultiMessagePayload(bz []byte) ([]DecodedMessage, error) {
+	if len(bz) < sdk.SynPackageHeaderLength+sdk.PackageTypeLength {
+		return nil, fmt.Errorf("multi-message payload is too short")
+	}
+
+	messages, err := oracletypes.DecodeMultiMessage(bz[sdk.SynPackageHeaderLength+sdk.PackageTypeLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make([]DecodedMessage, 0, len(messages))
+	for _, message := range messages {
+		msg, err := oracletypes.DecodeCrossChainMessage(message)
+		if err != nil {
+			return nil, err
+		}
+
+		decodedMsg := DecodedMessage{
+			ChannelId:   msg.ChannelId,
+			RelayFee:    msg.RelayFee.String(),
+			AckRelayFee: msg.AckRelayFee.String(),
+			Sender:      msg.Sender.Hex(),
+		}
+		decodedMsg.Payload, err = decodePayload(msg.ChannelId, sdk.SynCrossChainPackageType, msg.MsgBytes)
+		if err != nil {
+			decodedMsg.PayloadError = err.Error()
+		}
+		decoded = append(decoded, decodedMsg)
+	}
+	return decoded, nil
+}
+
+// decodePayload decodes the payload with the decoder of the channel. The payload is returned as
+// hex if the channel has no decoder or the payload cannot be decoded.
+func decodePayload(channelId sdk.ChannelID, packageType sdk.CrossChainPackageType, payload []byte) (interface{}, error) {
+	decoder, ok := payloadDecoders[channelId]
+	if !ok {
+		return hex.EncodeToString(payload), nil
+	}
+
+	decoded, err := decoder(packageType, payload)
+	if err != nil {
+		return hex.EncodeToString(payload), err
+	}
+	return decoded, nil
+}
+
+func decodeSyncParamsPayload(packageType sdk.CrossChainPackageType, payload []byte) (interface{}, error) {
+	if packageType != sdk.SynCrossChainPackageType {
+		return hex.EncodeToString(payload), nil
+	}
+
+	pack, err := govtypes.DeserializeSyncParamsPackage(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sync params package: %w", err)
+	}
+
+	targets := make([]string, 0, len(pack.Target)/common.AddressLength)
+	for i := 0; i+common.AddressLength <= len(pack.Target); i += common.AddressLength {
+		targets = append(targets, common.BytesToAddress(pack.Target[i:i+common.AddressLength]).Hex())
+	}
+
+	return SyncParamsPayload{
+		Key:     pack.Key,
+		Value:   hex.EncodeToString(pack.Value),
+		Targets: targets,
+	}, nil
+}
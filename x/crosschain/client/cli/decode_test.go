@@ -0,0 +1,106 @@
+package cli_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// multiMessagePayload is a multi-message payload bundling two messages of channel 6 sent
+// by 0x7fa9385be102ac3eac297483dd6233d62b3e1496.
+const multiMessagePayload = "000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000022000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000e35fa931a00000000000000000000000000000000000000000000000000001626218b45860000000000000000000000000007fa9385be102ac3eac297483dd6233d62b3e149600000000000000000000000000000000000000000000000000000000000000e10200000000000000000000000000000000000000000000000000000000000000200000000000000000000000007fa9385be102ac3eac297483dd6233d62b3e1496000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000005746573743100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000e35fa931a00000000000000000000000000000000000000000000000000001626218b45860000000000000000000000000007fa9385be102ac3eac297483dd6233d62b3e149600000000000000000000000000000000000000000000000000000000000000e10200000000000000000000000000000000000000000000000000000000000000200000000000000000000000007fa9385be102ac3eac297483dd6233d62b3e1496000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000057465737432000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+
+func TestDecodePackage_SyncParams(t *testing.T) {
+	target := common.HexToAddress("0x7fa9385be102ac3eac297483dd6233d62b3e1496")
+	payload, err := govtypes.SyncParamsPackage{
+		Key:    "relayerFee",
+		Value:  big.NewInt(1000).FillBytes(make([]byte, 32)),
+		Target: target.Bytes(),
+	}.Serialize()
+	require.NoError(t, err)
+
+	header := sdk.EncodePackageHeader(sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     1700000000,
+		RelayerFee:    big.NewInt(5),
+		AckRelayerFee: big.NewInt(6),
+	})
+
+	decoded, err := cli.DecodePackage(govtypes.SyncParamsChannelID, append(header, payload...))
+	require.NoError(t, err)
+	require.Equal(t, "SYN", decoded.PackageType)
+	require.Equal(t, uint64(1700000000), decoded.Timestamp)
+	require.Equal(t, "2023-11-14T22:13:20Z", decoded.Time)
+	require.Equal(t, "5", decoded.RelayerFee)
+	require.Equal(t, "6", decoded.AckRelayerFee)
+	require.Empty(t, decoded.PayloadError)
+	require.Equal(t, cli.SyncParamsPayload{
+		Key:     "relayerFee",
+		Value:   hex.EncodeToString(big.NewInt(1000).FillBytes(make([]byte, 32))),
+		Targets: []string{target.Hex()},
+	}, decoded.Payload)
+
+	// unknown channels leave the payload as hex
+	decoded, err = cli.DecodePackage(sdk.ChannelID(100), append(header, payload...))
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(payload), decoded.Payload)
+}
+
+func TestDecodePackage_MultiMessage(t *testing.T) {
+	payload, err := hex.DecodeString(multiMessagePayload)
+	require.NoError(t, err)
+
+	header := sdk.EncodePackageHeader(sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     1700000000,
+		RelayerFee:    big.NewInt(1),
+		AckRelayerFee: big.NewInt(1),
+	})
+	bz := append(append(header, 0), payload...)
+
+	decoded, err := cli.DecodePackage(oracletypes.MultiMessageChannelId, bz)
+	require.NoError(t, err)
+	require.Empty(t, decoded.PayloadError)
+	require.Len(t, decoded.Messages, 2)
+	for _, msg := range decoded.Messages {
+		require.Equal(t, sdk.ChannelID(6), msg.ChannelId)
+		require.Equal(t, "0x7FA9385bE102ac3EAc297483Dd6233D62b3e1496", msg.Sender)
+		require.Equal(t, "250000000000000", msg.RelayFee)
+		require.Equal(t, "99750000000000000", msg.AckRelayFee)
+	}
+}
+
+func TestDecodeClaimPayload(t *testing.T) {
+	header := sdk.EncodePackageHeader(sdk.PackageHeader{
+		PackageType:   sdk.AckCrossChainPackageType,
+		Timestamp:     1700000000,
+		RelayerFee:    big.NewInt(3),
+		AckRelayerFee: sdk.NilAckRelayerFee,
+	})
+	packageBytes, err := rlp.EncodeToBytes(oracletypes.Packages{
+		{ChannelId: 1, Sequence: 7, Payload: append(header, []byte("ack")...)},
+	})
+	require.NoError(t, err)
+
+	decoded, err := cli.DecodeClaimPayload(packageBytes)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	require.Equal(t, sdk.ChannelID(1), decoded[0].ChannelId)
+	require.Equal(t, uint64(7), *decoded[0].Sequence)
+	require.Equal(t, "ACK", decoded[0].PackageType)
+	require.Equal(t, "3", decoded[0].RelayerFee)
+	require.Empty(t, decoded[0].AckRelayerFee)
+	require.Equal(t, hex.EncodeToString([]byte("ack")), decoded[0].Payload)
+
+	_, err = cli.DecodeClaimPayload([]byte("invalid"))
+	require.Error(t, err)
+}
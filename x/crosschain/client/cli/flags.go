@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagDecode  = "decode"
+	FlagChannel = "channel"
+	FlagClaim   = "claim"
+)
@@ -1,12 +1,17 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
 )
 
@@ -22,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryParamsCmd(),
+		QueryCrossChainPackageCmd(),
 	)
 
 	return cmd
@@ -57,3 +63,72 @@ $ <appd> query crosschain params
 
 	return cmd
 }
+
+// QueryCrossChainPackageCmd returns the command handler for querying a cross chain package.
+func QueryCrossChainPackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package [dest-chain-id] [channel-id] [sequence]",
+		Short: "Query a cross chain package sent to a destination chain",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cross chain package sent to a destination chain on a channel with the given sequence.
+With --%s, the package header, the messages of multi-message packages and known channel payloads are decoded.
+
+Example:
+$ %s query crosschain package 97 3 12 --%s
+`, FlagDecode, version.AppName, FlagDecode),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destChainId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid dest chain id: %w", err)
+			}
+			channelId, err := strconv.ParseUint(args[1], 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid channel id: %w", err)
+			}
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CrossChainPackage(cmd.Context(), &types.QueryCrossChainPackageRequest{
+				DestChainId: uint32(destChainId),
+				ChannelId:   uint32(channelId),
+				Sequence:    sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			decode, err := cmd.Flags().GetBool(FlagDecode)
+			if err != nil {
+				return err
+			}
+			if !decode {
+				return clientCtx.PrintProto(res)
+			}
+
+			decoded, err := DecodePackage(sdk.ChannelID(channelId), res.Package)
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(decoded)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDecode, false, "Decode the package header and payload")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
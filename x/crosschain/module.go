@@ -21,15 +21,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
-	"github.com/cosmos/cosmos-sdk/x/params/client/cli"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                   = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the params module.
@@ -69,9 +71,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 // GetTxCmd returns no root tx command for the params module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
 
-// GetQueryCmd returns no root query command for the params module.
+// GetQueryCmd returns the root query command for the crosschain module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.NewQueryCmd()
+	return cli.GetQueryCmd()
 }
 
 func (am AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -139,6 +141,14 @@ func (am AppModule) ExportGenesis(_ sdk.Context, _ codec.JSONCodec) json.RawMess
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// DefaultMsgGasParams returns the default gas params of the crosschain msgs declared by this module,
+// which are only executed by governance.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgMintModuleTokens{}), 0),
+	}
+}
+
 //
 // App Wiring Setup
 //
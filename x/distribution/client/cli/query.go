@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryAutoCompound(),
 	)
 
 	return distQueryCmd
@@ -364,3 +365,57 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAutoCompound implements the query auto-compound command.
+func GetCmdQueryAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the auto-compounding status and history of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the auto-compounding of the rewards of a delegation is enabled, with
+the total amount compounded and the most recent compounds.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			validatorAddr, err := sdk.AccAddressFromHexUnsafe(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompound(
+				cmd.Context(),
+				&types.QueryAutoCompoundRequest{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: validatorAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
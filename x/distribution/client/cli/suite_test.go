@@ -90,12 +90,15 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false}`,
+			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false,"auto_compound_epoch":"0","auto_compound_min_amount":"0","auto_compound_max_per_block":0}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`base_proposer_reward: "0"
+			`auto_compound_epoch: "0"
+auto_compound_max_per_block: 0
+auto_compound_min_amount: "0"
+base_proposer_reward: "0"
 bonus_proposer_reward: "0"
 community_tax: "0"
 withdraw_addr_enabled: false`,
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -282,3 +284,48 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [true|false]",
+		Short: "enable or disable the auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the rewards of a delegation. The rewards
+in the bond denom are periodically withdrawn and delegated to the same validator, which requires the
+withdraw address of the delegator to be the delegator address.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			enable, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enable)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
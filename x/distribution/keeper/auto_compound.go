@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a delegation. The
+// rewards are only compounded if they are withdrawn to the delegator address. Disabling the
+// auto-compounding of a delegation without auto compound record is a no-op.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, enable bool) error {
+	if enable {
+		if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+			return sdkerrors.Wrap(types.ErrNoValidatorExists, valAddr.String())
+		}
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+		if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+			return types.ErrAutoCompoundWithdrawAddr
+		}
+	}
+
+	record, found := k.GetAutoCompoundRecord(ctx, delAddr, valAddr)
+	if !found {
+		if !enable {
+			return nil
+		}
+		record = types.NewAutoCompoundRecord(delAddr, valAddr)
+	}
+	record.Enabled = enable
+	k.SetAutoCompoundRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enable)),
+		),
+	)
+	return nil
+}
+
+// AutoCompound compounds the rewards of the delegations with auto-compounding enabled. A pass over
+// all of them starts every auto compound epoch, at most AutoCompoundMaxPerBlock delegations are
+// compounded per block until the pass is done. A pass in progress is dropped when the auto compound
+// epoch is set to 0, so that the next pass starts at an epoch boundary once it is set again.
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+	if params.AutoCompoundEpoch == 0 {
+		store.Delete(types.AutoCompoundCursorKey)
+		return
+	}
+
+	start := store.Get(types.AutoCompoundCursorKey)
+	if start == nil {
+		if uint64(ctx.BlockHeight())%params.AutoCompoundEpoch != 0 {
+			return
+		}
+		start = types.AutoCompoundEnabledPrefix
+	}
+
+	// the keys are collected first, as compounding writes to the store
+	keys := make([][]byte, 0, params.AutoCompoundMaxPerBlock)
+	var next []byte
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundEnabledPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if len(keys) == int(params.AutoCompoundMaxPerBlock) {
+			next = iter.Key()
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	if next != nil {
+		store.Set(types.AutoCompoundCursorKey, next)
+	} else {
+		store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	minAmount := params.AutoCompoundMinAmount
+	if minAmount.IsNil() {
+		minAmount = math.ZeroInt()
+	}
+	for _, key := range keys {
+		delAddr, valAddr := types.GetAutoCompoundAddresses(key)
+		k.autoCompoundDelegation(ctx, delAddr, valAddr, minAmount)
+	}
+}
+
+// autoCompoundDelegation compounds the rewards of a delegation if they reach the min amount, the
+// failures are logged and do not affect the other delegations.
+func (k Keeper) autoCompoundDelegation(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, minAmount math.Int) {
+	cacheCtx, write := ctx.CacheContext()
+	amount, err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr, minAmount)
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto compound rewards",
+			"delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+		return
+	}
+	if !amount.IsPositive() {
+		return
+	}
+	write()
+
+	record, found := k.GetAutoCompoundRecord(ctx, delAddr, valAddr)
+	if !found {
+		record = types.NewAutoCompoundRecord(delAddr, valAddr)
+		record.Enabled = true
+	}
+	record.AddEntry(ctx.BlockHeight(), amount)
+	k.SetAutoCompoundRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and delegates the rewards in the
+// bond denom to the same validator, if they reach the min amount. It returns the amount delegated.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, minAmount math.Int) (math.Int, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), nil
+	}
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return math.ZeroInt(), nil
+	}
+	// the withdrawn rewards must be owned by the delegator to be delegated
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return math.ZeroInt(), nil
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	// check the rewards before withdrawing them, so that the rewards below the min amount keep
+	// accruing in the delegation
+	previewCtx, _ := ctx.CacheContext()
+	endingPeriod := k.IncrementValidatorPeriod(previewCtx, validator)
+	rewards := k.CalculateDelegationRewards(previewCtx, validator, del, endingPeriod)
+	if amount := rewards.AmountOf(bondDenom).TruncateInt(); !amount.IsPositive() || amount.LT(minAmount) {
+		return math.ZeroInt(), nil
+	}
+
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	amount := withdrawn.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return math.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// GetAutoCompoundRecord returns the auto-compound record of a delegation
+func (k Keeper) GetAutoCompoundRecord(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) (record types.AutoCompoundRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAutoCompoundRecordKey(delAddr, valAddr))
+	if b == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(b, &record)
+	return record, true
+}
+
+// SetAutoCompoundRecord sets the auto-compound record of a delegation, and marks its
+// auto-compounding as enabled or not
+func (k Keeper) SetAutoCompoundRecord(ctx sdk.Context, record types.AutoCompoundRecord) {
+	delAddr := sdk.MustAccAddressFromHex(record.DelegatorAddress)
+	valAddr := sdk.MustAccAddressFromHex(record.ValidatorAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundRecordKey(delAddr, valAddr), k.cdc.MustMarshal(&record))
+	if record.Enabled {
+		store.Set(types.GetAutoCompoundEnabledKey(delAddr, valAddr), []byte{})
+	} else {
+		store.Delete(types.GetAutoCompoundEnabledKey(delAddr, valAddr))
+	}
+}
+
+// DeleteAutoCompoundRecord deletes the auto-compound record of a delegation
+func (k Keeper) DeleteAutoCompoundRecord(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundRecordKey(delAddr, valAddr))
+	store.Delete(types.GetAutoCompoundEnabledKey(delAddr, valAddr))
+}
+
+// IterateAutoCompoundRecords iterates over the auto-compound records of the delegations
+func (k Keeper) IterateAutoCompoundRecords(ctx sdk.Context, handler func(record types.AutoCompoundRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundRecordPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.AutoCompoundRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
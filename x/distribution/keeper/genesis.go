@@ -79,6 +79,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, record := range data.AutoCompoundRecords {
+		k.SetAutoCompoundRecord(ctx, record)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -184,5 +187,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.AutoCompoundRecord, 0)
+	k.IterateAutoCompoundRecords(ctx,
+		func(record types.AutoCompoundRecord) (stop bool) {
+			autoCompounds = append(autoCompounds, record)
+			return false
+		},
+	)
+
+	genesis := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genesis.AutoCompoundRecords = autoCompounds
+	return genesis
 }
@@ -293,3 +293,36 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoCompound queries the auto-compounding status and history of a delegation
+func (k Querier) AutoCompound(c context.Context, req *types.QueryAutoCompoundRequest) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAdr, err := sdk.AccAddressFromHexUnsafe(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAdr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetAutoCompoundRecord(ctx, delAdr, valAdr)
+	if !found {
+		record = types.NewAutoCompoundRecord(delAdr, valAdr)
+	}
+
+	return &types.QueryAutoCompoundResponse{Record: record}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -123,10 +124,20 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _, _ sdk.AccAddress) error {
+// disable the auto-compounding of the removed delegation, its history is kept
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) error {
+	record, found := h.k.GetAutoCompoundRecord(ctx, delAddr, valAddr)
+	if found && record.Enabled {
+		record.Enabled = false
+		h.k.SetAutoCompoundRecord(ctx, record)
+	}
 	return nil
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) AfterConsensusPubKeyUpdate(_ sdk.Context, _ sdk.AccAddress, _, _ cryptotypes.PubKey) error {
+	return nil
+}
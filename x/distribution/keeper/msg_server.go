@@ -158,3 +158,21 @@ func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommu
 
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromHexUnsafe(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetAutoCompound(ctx, delegatorAddress, valAddr, msg.Enable); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
@@ -52,10 +52,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "community tax > 1",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(2, 0),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:          sdk.NewDecWithPrec(2, 0),
+				BaseProposerReward:    sdk.ZeroDec(),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
@@ -63,10 +64,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "negative community tax",
 			input: types.Params{
-				CommunityTax:        sdk.NewDecWithPrec(-2, 1),
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:          sdk.NewDecWithPrec(-2, 1),
+				BaseProposerReward:    sdk.ZeroDec(),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
 			},
 			expErr:    true,
 			expErrMsg: "community tax should be non-negative and less than one",
@@ -74,10 +76,11 @@ func TestParams(t *testing.T) {
 		{
 			name: "base proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:          communityTax,
+				BaseProposerReward:    sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
 			},
 			expErr:    false,
 			expErrMsg: "base proposer rewards should not be taken into account",
@@ -85,21 +88,49 @@ func TestParams(t *testing.T) {
 		{
 			name: "bonus proposer reward > 1",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:          communityTax,
+				BaseProposerReward:    sdk.NewDecWithPrec(1, 2),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
 			},
 			expErr:    false,
 			expErrMsg: "bonus proposer rewards should not be taken into account",
 		},
+		{
+			name: "auto compound max per block zero",
+			input: types.Params{
+				CommunityTax:          communityTax,
+				BaseProposerReward:    sdk.ZeroDec(),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundEpoch:     10,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
+			},
+			expErr:    true,
+			expErrMsg: "auto compound max per block must be positive",
+		},
+		{
+			name: "auto compound enabled",
+			input: types.Params{
+				CommunityTax:            communityTax,
+				BaseProposerReward:      sdk.ZeroDec(),
+				BonusProposerReward:     sdk.ZeroDec(),
+				WithdrawAddrEnabled:     withdrawAddrEnabled,
+				AutoCompoundEpoch:       10,
+				AutoCompoundMinAmount:   sdk.NewInt(1000),
+				AutoCompoundMaxPerBlock: 100,
+			},
+			expErr: false,
+		},
 		{
 			name: "all good",
 			input: types.Params{
-				CommunityTax:        communityTax,
-				BaseProposerReward:  sdk.ZeroDec(),
-				BonusProposerReward: sdk.ZeroDec(),
-				WithdrawAddrEnabled: withdrawAddrEnabled,
+				CommunityTax:          communityTax,
+				BaseProposerReward:    sdk.ZeroDec(),
+				BonusProposerReward:   sdk.ZeroDec(),
+				WithdrawAddrEnabled:   withdrawAddrEnabled,
+				AutoCompoundMinAmount: sdk.ZeroInt(),
 			},
 			expErr: false,
 		},
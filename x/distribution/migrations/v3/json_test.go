@@ -41,6 +41,7 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_records": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -48,6 +49,9 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_epoch": "0",
+		"auto_compound_max_per_block": 100,
+		"auto_compound_min_amount": "0",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
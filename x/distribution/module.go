@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -34,9 +35,11 @@ import (
 const ConsensusVersion = 3
 
 var (
-	_ module.BeginBlockAppModule = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.BeginBlockAppModule         = AppModule{}
+	_ module.EndBlockAppModule           = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the distribution module.
@@ -172,11 +175,28 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// DefaultMsgGasParams returns the default gas params of the distribution msgs declared by this module,
+// MsgCommunityPoolSpend being only executed by governance.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgCommunityPoolSpend{}), 0),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgFundCommunityPool{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgSetAutoCompound{}), 1.2e3),
+	}
+}
+
 // BeginBlock returns the begin blocker for the distribution module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	BeginBlocker(ctx, req, am.keeper)
 }
 
+// EndBlock returns the end blocker for the distribution module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the distribution module.
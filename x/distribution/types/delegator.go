@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // create a new DelegatorStartingInfo
@@ -12,3 +17,45 @@ func NewDelegatorStartingInfo(previousPeriod uint64, stake sdk.Dec, height uint6
 		Height:         height,
 	}
 }
+
+// create a new AutoCompoundRecord
+func NewAutoCompoundRecord(delAddr, valAddr sdk.AccAddress) AutoCompoundRecord {
+	return AutoCompoundRecord{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		TotalCompounded:  math.ZeroInt(),
+	}
+}
+
+// AddEntry records an auto-compounding of the rewards, only the last MaxAutoCompoundHistory
+// entries are kept in the history
+func (r *AutoCompoundRecord) AddEntry(height int64, amount math.Int) {
+	r.TotalCompounded = r.TotalCompounded.Add(amount)
+	r.CompoundCount++
+	r.History = append(r.History, AutoCompoundEntry{Height: height, Amount: amount})
+	if len(r.History) > MaxAutoCompoundHistory {
+		r.History = r.History[len(r.History)-MaxAutoCompoundHistory:]
+	}
+}
+
+// Validate performs a basic validation of the auto-compound record
+func (r AutoCompoundRecord) Validate() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(r.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(r.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if r.TotalCompounded.IsNil() || r.TotalCompounded.IsNegative() {
+		return fmt.Errorf("invalid total compounded amount: %s", r.TotalCompounded)
+	}
+	if len(r.History) > MaxAutoCompoundHistory {
+		return fmt.Errorf("auto compound history too long: %d", len(r.History))
+	}
+	for _, entry := range r.History {
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return fmt.Errorf("invalid auto compound amount: %s", entry.Amount)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestAutoCompoundRecordAddEntry(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("delegator_address___"))
+	valAddr := sdk.AccAddress([]byte("validator_address___"))
+
+	record := types.NewAutoCompoundRecord(delAddr, valAddr)
+	require.NoError(t, record.Validate())
+
+	for i := 1; i <= types.MaxAutoCompoundHistory+2; i++ {
+		record.AddEntry(int64(i), sdk.NewInt(int64(i)))
+	}
+
+	total := (types.MaxAutoCompoundHistory + 2) * (types.MaxAutoCompoundHistory + 3) / 2
+	require.Equal(t, sdk.NewInt(int64(total)), record.TotalCompounded)
+	require.Equal(t, uint64(types.MaxAutoCompoundHistory+2), record.CompoundCount)
+
+	// only the most recent entries are kept
+	require.Len(t, record.History, types.MaxAutoCompoundHistory)
+	require.Equal(t, int64(3), record.History[0].Height)
+	require.Equal(t, int64(types.MaxAutoCompoundHistory+2), record.History[types.MaxAutoCompoundHistory-1].Height)
+	require.NoError(t, record.Validate())
+}
+
+func TestAutoCompoundRecordValidate(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("delegator_address___"))
+	valAddr := sdk.AccAddress([]byte("validator_address___"))
+
+	record := types.NewAutoCompoundRecord(delAddr, valAddr)
+	record.DelegatorAddress = ""
+	require.Error(t, record.Validate())
+
+	record = types.NewAutoCompoundRecord(delAddr, valAddr)
+	record.TotalCompounded = sdk.NewInt(-1)
+	require.Error(t, record.Validate())
+
+	record = types.NewAutoCompoundRecord(delAddr, valAddr)
+	record.History = append(record.History, types.AutoCompoundEntry{Height: 1, Amount: sdk.ZeroInt()})
+	require.Error(t, record.Validate())
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,15 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch is the number of blocks between the passes compounding the rewards of
+	// the delegations with auto-compounding enabled, zero disables auto-compounding.
+	AutoCompoundEpoch uint64 `protobuf:"varint,5,opt,name=auto_compound_epoch,json=autoCompoundEpoch,proto3" json:"auto_compound_epoch,omitempty"`
+	// auto_compound_min_amount is the minimum amount of rewards in the bond denom of a delegation
+	// to compound them.
+	AutoCompoundMinAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=auto_compound_min_amount,json=autoCompoundMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"auto_compound_min_amount"`
+	// auto_compound_max_per_block is the maximum number of delegations compounded in a block, a
+	// pass over the delegations is spread over several blocks if needed.
+	AutoCompoundMaxPerBlock uint32 `protobuf:"varint,7,opt,name=auto_compound_max_per_block,json=autoCompoundMaxPerBlock,proto3" json:"auto_compound_max_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +88,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxPerBlock() uint32 {
+	if m != nil {
+		return m.AutoCompoundMaxPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -619,6 +643,138 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundEntry is an auto-compounding of the rewards of a delegation.
+type AutoCompoundEntry struct {
+	// height is the height of the block the rewards were compounded in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the amount of rewards in the bond denom delegated again.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *AutoCompoundEntry) Reset()         { *m = AutoCompoundEntry{} }
+func (m *AutoCompoundEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundEntry) ProtoMessage()    {}
+func (*AutoCompoundEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundEntry.Merge(m, src)
+}
+func (m *AutoCompoundEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundEntry proto.InternalMessageInfo
+
+func (m *AutoCompoundEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AutoCompoundRecord is the auto-compounding setting and history of a delegation.
+type AutoCompoundRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// enabled tells whether the rewards of the delegation are compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// total_compounded is the total amount of rewards compounded.
+	TotalCompounded cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_compounded,json=totalCompounded,proto3,customtype=cosmossdk.io/math.Int" json:"total_compounded"`
+	// compound_count is the number of times the rewards were compounded.
+	CompoundCount uint64 `protobuf:"varint,5,opt,name=compound_count,json=compoundCount,proto3" json:"compound_count,omitempty"`
+	// history is the last auto-compoundings of the rewards, from the oldest to the newest.
+	History []AutoCompoundEntry `protobuf:"bytes,6,rep,name=history,proto3" json:"history"`
+}
+
+func (m *AutoCompoundRecord) Reset()         { *m = AutoCompoundRecord{} }
+func (m *AutoCompoundRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRecord) ProtoMessage()    {}
+func (*AutoCompoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCompoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRecord.Merge(m, src)
+}
+func (m *AutoCompoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRecord proto.InternalMessageInfo
+
+func (m *AutoCompoundRecord) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRecord) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AutoCompoundRecord) GetCompoundCount() uint64 {
+	if m != nil {
+		return m.CompoundCount
+	}
+	return 0
+}
+
+func (m *AutoCompoundRecord) GetHistory() []AutoCompoundEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -632,6 +788,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundEntry)(nil), "cosmos.distribution.v1beta1.AutoCompoundEntry")
+	proto.RegisterType((*AutoCompoundRecord)(nil), "cosmos.distribution.v1beta1.AutoCompoundRecord")
 }
 
 func init() {
@@ -639,69 +797,84 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x34, 0x8e, 0x93, 0x4c, 0x69, 0x42, 0x27, 0x4e, 0xea, 0xb8, 0x95, 0x6d, 0xad, 0x44,
-	0x49, 0x43, 0x63, 0x93, 0x22, 0x24, 0x64, 0x21, 0xa4, 0xd8, 0x0e, 0x82, 0x53, 0xa3, 0x0d, 0x02,
-	0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xee, 0xce, 0x2c, 0x33, 0x63, 0x27, 0x3d, 0x70, 0xaf,
-	0x7a, 0x00, 0x8e, 0x15, 0xa7, 0x08, 0x2e, 0x15, 0xa7, 0x1c, 0xf2, 0x21, 0x2a, 0x4e, 0x55, 0x0f,
-	0x80, 0x2a, 0x14, 0x50, 0x72, 0x08, 0xe2, 0x53, 0xa0, 0xd9, 0x19, 0xaf, 0x9d, 0x10, 0xa2, 0x4a,
-	0xad, 0xd5, 0x4b, 0xe2, 0x79, 0x6f, 0xf7, 0xfd, 0xfe, 0xcc, 0x9b, 0x37, 0x0b, 0xab, 0x3e, 0x97,
-	0x11, 0x97, 0xb5, 0x80, 0x4a, 0x25, 0x68, 0xbb, 0xa7, 0x28, 0x67, 0xb5, 0xfe, 0x5a, 0x9b, 0x28,
-	0xbc, 0x76, 0x2a, 0x58, 0x8d, 0x05, 0x57, 0x1c, 0x5d, 0x37, 0xcf, 0x57, 0x4f, 0xa5, 0xec, 0xf3,
-	0xc5, 0x7c, 0x87, 0x77, 0x78, 0xf2, 0x5c, 0x4d, 0xff, 0x32, 0xaf, 0x14, 0x4b, 0x16, 0xa2, 0x8d,
-	0x25, 0x49, 0x4b, 0xfb, 0x9c, 0xda, 0x92, 0xc5, 0x25, 0x93, 0xf7, 0xcc, 0x8b, 0xb6, 0xbe, 0x49,
-	0x5d, 0xc5, 0x11, 0x65, 0xbc, 0x96, 0xfc, 0x35, 0x21, 0x67, 0x7f, 0x02, 0xe6, 0x36, 0xb1, 0xc0,
-	0x91, 0x44, 0x18, 0x5e, 0xf1, 0x79, 0x14, 0xf5, 0x18, 0x55, 0xf7, 0x3d, 0x85, 0x77, 0x0b, 0xa0,
-	0x02, 0x96, 0x67, 0x1a, 0x1f, 0x3e, 0x39, 0x2c, 0x67, 0x9e, 0x1f, 0x96, 0x6f, 0x76, 0xa8, 0xea,
-	0xf6, 0xda, 0x55, 0x9f, 0x47, 0xb6, 0xaa, 0xfd, 0xb7, 0x2a, 0x83, 0x7b, 0x35, 0x75, 0x3f, 0x26,
-	0xb2, 0xda, 0x22, 0xfe, 0xb3, 0x83, 0x55, 0x68, 0x41, 0x5b, 0xc4, 0x77, 0xdf, 0x48, 0x4b, 0x7e,
-	0x86, 0x77, 0x51, 0x0c, 0xf3, 0x9a, 0xb6, 0xe6, 0x16, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x0e, 0x16,
-	0x41, 0xe1, 0x52, 0x82, 0xf4, 0xd1, 0xcb, 0x20, 0x15, 0x80, 0x8b, 0x74, 0xed, 0x4d, 0x5b, 0xda,
-	0x4d, 0x2a, 0x23, 0x01, 0x17, 0xda, 0x9c, 0xf5, 0xe4, 0x7f, 0x20, 0x27, 0x5e, 0x09, 0xe4, 0x7c,
-	0x52, 0xfc, 0x0c, 0xe6, 0x1d, 0xb8, 0xb0, 0x43, 0x55, 0x37, 0x10, 0x78, 0xc7, 0xc3, 0x41, 0x20,
-	0x3c, 0xc2, 0x70, 0x3b, 0x24, 0x41, 0x21, 0x5b, 0x01, 0xcb, 0xd3, 0xee, 0xfc, 0x20, 0xb9, 0x1e,
-	0x04, 0x62, 0xc3, 0xa4, 0xea, 0xb7, 0x1e, 0xed, 0x95, 0x33, 0x0f, 0x4f, 0xf6, 0x57, 0x2a, 0x23,
-	0xb8, 0xbb, 0xa7, 0xfb, 0xc8, 0xec, 0x93, 0xf3, 0x2b, 0x80, 0xc5, 0xcf, 0x71, 0x48, 0x03, 0xac,
-	0xb8, 0xf8, 0x84, 0x4a, 0xc5, 0x05, 0xf5, 0x71, 0x68, 0xc0, 0x25, 0xfa, 0x16, 0xc0, 0x6b, 0x7e,
-	0x2f, 0xea, 0x85, 0x58, 0xd1, 0x3e, 0xb1, 0x72, 0x3d, 0x81, 0x15, 0xe5, 0x05, 0x50, 0x99, 0x58,
-	0xbe, 0x7c, 0xe7, 0x86, 0xed, 0xd2, 0xaa, 0xf6, 0x6b, 0xd0, 0x6d, 0x5a, 0x50, 0x93, 0x53, 0xd6,
-	0xf8, 0x40, 0x5b, 0xf2, 0xf3, 0x9f, 0xe5, 0x77, 0x5e, 0xcc, 0x12, 0xfd, 0x8e, 0x7c, 0x7c, 0xb2,
-	0xbf, 0x02, 0xdc, 0x85, 0x21, 0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x36, 0x9c, 0x13, 0x64, 0x9b,
-	0x08, 0xc2, 0x7c, 0xe2, 0xf9, 0xbc, 0xc7, 0x54, 0xb2, 0xdf, 0x57, 0xdc, 0xd9, 0x34, 0xdc, 0xd4,
-	0x51, 0xe7, 0x27, 0x00, 0xaf, 0xa5, 0xc2, 0x9a, 0x3d, 0x21, 0x08, 0x53, 0x03, 0x55, 0x31, 0x9c,
-	0x32, 0x4a, 0xe4, 0x98, 0x45, 0x0c, 0x60, 0xd0, 0x22, 0xcc, 0xc5, 0x44, 0x50, 0x6e, 0xba, 0x33,
-	0xeb, 0xda, 0x95, 0xf3, 0x08, 0xc0, 0x52, 0xca, 0x72, 0xdd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51,
-	0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84, 0x7e, 0xba, 0x1a, 0x33, 0xdf, 0x11, 0x24, 0xe7, 0x3b,
-	0x00, 0xaf, 0xa7, 0xd4, 0xee, 0xf6, 0x94, 0x54, 0x98, 0x05, 0x94, 0x75, 0x5e, 0x9b, 0x89, 0xce,
-	0x0f, 0x00, 0xce, 0xa7, 0x8c, 0xb6, 0x42, 0x2c, 0xbb, 0x1b, 0x7d, 0xc2, 0x14, 0xba, 0x05, 0xdf,
-	0xec, 0x0f, 0xc2, 0x9e, 0xb5, 0x19, 0x24, 0x36, 0xcf, 0xa5, 0xf1, 0xcd, 0x24, 0x8c, 0xbe, 0x84,
-	0xd3, 0xdb, 0x02, 0xfb, 0xfa, 0x04, 0xd8, 0x39, 0xf1, 0x72, 0x13, 0x29, 0xad, 0xa6, 0xed, 0xca,
-	0x9f, 0x43, 0x4e, 0xa2, 0xaf, 0xe1, 0xe2, 0x90, 0x9d, 0xd4, 0x09, 0x8f, 0x24, 0x19, 0x6b, 0xdb,
-	0xbb, 0xd5, 0x0b, 0xc6, 0x76, 0xf5, 0x9c, 0x92, 0x8d, 0x19, 0x4d, 0xd9, 0x78, 0x93, 0xef, 0x9f,
-	0x03, 0x59, 0xcf, 0xea, 0xf3, 0xef, 0x3c, 0x00, 0x70, 0xea, 0x63, 0x42, 0x36, 0x39, 0x0f, 0xd1,
-	0x37, 0x70, 0x76, 0x38, 0x8e, 0x63, 0xce, 0xc3, 0x31, 0xef, 0xd9, 0x70, 0xf8, 0x6b, 0x78, 0xe7,
-	0xe1, 0x25, 0x58, 0x6c, 0x8e, 0x46, 0xb6, 0x62, 0xc2, 0x02, 0x33, 0xe9, 0x70, 0x88, 0xf2, 0x70,
-	0x52, 0x51, 0x15, 0x12, 0x73, 0x49, 0xb8, 0x66, 0x81, 0x2a, 0xf0, 0x72, 0x40, 0xa4, 0x2f, 0x68,
-	0x3c, 0xdc, 0x2e, 0x77, 0x34, 0x84, 0x6e, 0xc0, 0x19, 0x41, 0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x99,
-	0xc1, 0xee, 0x30, 0x80, 0xba, 0x30, 0x87, 0xa3, 0x64, 0x42, 0x64, 0x13, 0xad, 0x4b, 0xe7, 0x6a,
-	0x4d, 0x84, 0xbe, 0x6f, 0x85, 0x2e, 0xbf, 0x80, 0xd0, 0x11, 0x95, 0xb6, 0x7e, 0xfd, 0xf6, 0x83,
-	0xbd, 0x72, 0x46, 0x7b, 0xfe, 0xf7, 0x5e, 0x39, 0xf3, 0xcb, 0xc1, 0x6a, 0xd1, 0x02, 0x75, 0x78,
-	0x7f, 0x04, 0x87, 0x29, 0x4d, 0x13, 0x38, 0xcf, 0x01, 0x5c, 0x68, 0x91, 0x90, 0x74, 0x92, 0x6d,
-	0x53, 0x58, 0x28, 0xca, 0x3a, 0x9f, 0xb2, 0xed, 0x64, 0xb8, 0xc5, 0x82, 0xf4, 0x29, 0xd7, 0x57,
-	0xcc, 0x68, 0x1f, 0xcf, 0x0e, 0xc2, 0xb6, 0x8d, 0x5d, 0x38, 0x29, 0x15, 0xbe, 0x47, 0x5e, 0x49,
-	0x0f, 0x9b, 0x52, 0xa8, 0x05, 0x73, 0x5d, 0x42, 0x3b, 0x5d, 0xe3, 0x64, 0xb6, 0x71, 0xfb, 0x9f,
-	0xc3, 0xf2, 0x9c, 0x2f, 0x88, 0x1e, 0xbb, 0xcc, 0x33, 0xa9, 0x1f, 0x4f, 0xf6, 0x57, 0xce, 0xc6,
-	0xac, 0x15, 0x66, 0xe1, 0xfc, 0x01, 0xe0, 0x92, 0x15, 0x47, 0x39, 0x4b, 0x65, 0xda, 0xcb, 0x6c,
-	0x03, 0x5e, 0x1d, 0x9e, 0x05, 0x7d, 0x9b, 0x11, 0x29, 0xed, 0x97, 0x41, 0xe1, 0xd9, 0xc1, 0x6a,
-	0xde, 0xb2, 0x5a, 0x37, 0x99, 0x2d, 0x25, 0xf4, 0xbc, 0x19, 0x1e, 0x6e, 0x1b, 0x47, 0x0c, 0xe6,
-	0xd2, 0xbb, 0x7e, 0x9c, 0x5d, 0x6c, 0x51, 0xea, 0xd3, 0x76, 0x7f, 0x81, 0xf3, 0x1b, 0x80, 0x6f,
-	0xfd, 0x7f, 0x23, 0x7f, 0x41, 0x55, 0xb7, 0x45, 0x62, 0x2e, 0xa9, 0x1a, 0x53, 0x4f, 0x2f, 0x8e,
-	0xf4, 0xb4, 0x4e, 0xd9, 0x15, 0x2a, 0xc0, 0xa9, 0xc0, 0x00, 0x17, 0x26, 0x93, 0xc4, 0x60, 0x59,
-	0xbf, 0x39, 0xe0, 0x7e, 0x71, 0x5f, 0x36, 0xee, 0x3e, 0x3e, 0x2a, 0x81, 0x27, 0x47, 0x25, 0xf0,
-	0xf4, 0xa8, 0x04, 0xfe, 0x3a, 0x2a, 0x81, 0xef, 0x8f, 0x4b, 0x99, 0xa7, 0xc7, 0xa5, 0xcc, 0xef,
-	0xc7, 0xa5, 0xcc, 0x57, 0x6b, 0x17, 0x7a, 0x77, 0xe6, 0xd3, 0x22, 0xb1, 0xb2, 0x9d, 0x4b, 0xbe,
-	0x09, 0xdf, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x9e, 0xb6, 0x03, 0xc6, 0x0a, 0x00, 0x00,
+	// 1226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbd, 0x6f, 0x14, 0x47,
+	0x14, 0xbf, 0xf1, 0xc7, 0x19, 0x0f, 0x01, 0xe3, 0xb1, 0x0d, 0x8b, 0x41, 0x77, 0xa7, 0x95, 0x20,
+	0xe6, 0xc3, 0xe7, 0x40, 0x14, 0x29, 0xb2, 0x50, 0x24, 0xfb, 0xec, 0x28, 0x14, 0x11, 0xd6, 0x3a,
+	0x22, 0x51, 0x9a, 0xd5, 0xdc, 0xee, 0x70, 0x37, 0xf2, 0xee, 0xcc, 0x66, 0x66, 0xee, 0xb0, 0x8b,
+	0x74, 0x29, 0x10, 0x45, 0x42, 0x89, 0x52, 0xa1, 0xa4, 0x41, 0xa9, 0x28, 0xf8, 0x23, 0x50, 0x2a,
+	0x44, 0x91, 0x44, 0x28, 0x22, 0x91, 0x29, 0x88, 0xf2, 0x57, 0x44, 0xf3, 0xb1, 0x7b, 0x6b, 0xe3,
+	0x58, 0xa0, 0xd8, 0x4a, 0x63, 0x7b, 0xde, 0xdb, 0x79, 0xbf, 0xf7, 0xf1, 0x7b, 0xf3, 0x9e, 0x61,
+	0x33, 0xe2, 0x32, 0xe5, 0x72, 0x21, 0xa6, 0x52, 0x09, 0xda, 0xee, 0x29, 0xca, 0xd9, 0x42, 0xff,
+	0x4a, 0x9b, 0x28, 0x7c, 0x65, 0x87, 0xb0, 0x99, 0x09, 0xae, 0x38, 0x3a, 0x63, 0xbf, 0x6f, 0xee,
+	0x50, 0xb9, 0xef, 0x67, 0xa7, 0x3b, 0xbc, 0xc3, 0xcd, 0x77, 0x0b, 0xfa, 0x2f, 0x7b, 0x65, 0xb6,
+	0xe6, 0x20, 0xda, 0x58, 0x92, 0xc2, 0x74, 0xc4, 0xa9, 0x33, 0x39, 0x7b, 0xda, 0xea, 0x43, 0x7b,
+	0xd1, 0xd9, 0xb7, 0xaa, 0x49, 0x9c, 0x52, 0xc6, 0x17, 0xcc, 0x4f, 0x2b, 0xf2, 0xbf, 0x19, 0x85,
+	0xd5, 0x35, 0x2c, 0x70, 0x2a, 0x11, 0x86, 0xc7, 0x22, 0x9e, 0xa6, 0x3d, 0x46, 0xd5, 0x56, 0xa8,
+	0xf0, 0xa6, 0x07, 0x1a, 0x60, 0x6e, 0x7c, 0xf9, 0xda, 0x93, 0x17, 0xf5, 0xca, 0xf3, 0x17, 0xf5,
+	0xf3, 0x1d, 0xaa, 0xba, 0xbd, 0x76, 0x33, 0xe2, 0xa9, 0xb3, 0xea, 0x7e, 0xcd, 0xcb, 0x78, 0x63,
+	0x41, 0x6d, 0x65, 0x44, 0x36, 0x57, 0x48, 0xf4, 0xec, 0xf1, 0x3c, 0x74, 0xa0, 0x2b, 0x24, 0x0a,
+	0xde, 0x29, 0x4c, 0x7e, 0x86, 0x37, 0x51, 0x06, 0xa7, 0xb5, 0xdb, 0xda, 0xb7, 0x8c, 0x4b, 0x22,
+	0x42, 0x41, 0x6e, 0x63, 0x11, 0x7b, 0x43, 0x06, 0xe9, 0xa3, 0xff, 0x82, 0xe4, 0x81, 0x00, 0x69,
+	0xdb, 0x6b, 0xce, 0x74, 0x60, 0x2c, 0x23, 0x01, 0x67, 0xda, 0x9c, 0xf5, 0xe4, 0x6b, 0x90, 0xc3,
+	0x07, 0x02, 0x39, 0x65, 0x8c, 0xef, 0xc2, 0xbc, 0x0a, 0x67, 0x6e, 0x53, 0xd5, 0x8d, 0x05, 0xbe,
+	0x1d, 0xe2, 0x38, 0x16, 0x21, 0x61, 0xb8, 0x9d, 0x90, 0xd8, 0x1b, 0x69, 0x80, 0xb9, 0x23, 0xc1,
+	0x54, 0xae, 0x5c, 0x8a, 0x63, 0xb1, 0x6a, 0x55, 0xa8, 0x09, 0xa7, 0x70, 0x4f, 0xf1, 0x30, 0xe2,
+	0x69, 0xc6, 0x7b, 0x2c, 0x0e, 0x49, 0xc6, 0xa3, 0xae, 0x37, 0xda, 0x00, 0x73, 0x23, 0xc1, 0xa4,
+	0x56, 0xb5, 0x9c, 0x66, 0x55, 0x2b, 0x50, 0x0c, 0xbd, 0x9d, 0xdf, 0xa7, 0x94, 0x85, 0x38, 0xe5,
+	0x3d, 0xa6, 0xbc, 0xaa, 0x09, 0xed, 0x92, 0x0b, 0x6d, 0xc6, 0x3a, 0x2c, 0xe3, 0x8d, 0x26, 0xe5,
+	0x0b, 0x29, 0x56, 0xdd, 0xe6, 0x75, 0xa6, 0x4a, 0x91, 0x5c, 0x67, 0x2a, 0x98, 0x29, 0x23, 0x7c,
+	0x4a, 0xd9, 0x92, 0xb1, 0x84, 0xae, 0xc1, 0x33, 0xbb, 0x50, 0xf0, 0x66, 0x98, 0x11, 0x11, 0xb6,
+	0x13, 0x1e, 0x6d, 0x78, 0x63, 0x0d, 0x30, 0x77, 0x2c, 0x38, 0xb5, 0xe3, 0x2e, 0xde, 0x5c, 0x23,
+	0x62, 0x59, 0xab, 0x17, 0x2f, 0xdc, 0x7f, 0x50, 0xaf, 0xdc, 0x7d, 0xf5, 0xe8, 0x62, 0xa3, 0x94,
+	0xcb, 0xcd, 0x9d, 0xbd, 0x61, 0xb9, 0xe7, 0xff, 0x02, 0xe0, 0xec, 0x4d, 0x9c, 0xd0, 0x18, 0x2b,
+	0x2e, 0x3e, 0xa1, 0x52, 0x71, 0x41, 0x23, 0x9c, 0xd8, 0x84, 0x4a, 0xf4, 0x2d, 0x80, 0xa7, 0xa2,
+	0x5e, 0xda, 0x4b, 0xb0, 0xa2, 0x7d, 0xe2, 0x4a, 0x18, 0x0a, 0xac, 0x28, 0xf7, 0x40, 0x63, 0x78,
+	0xee, 0xe8, 0xd5, 0xb3, 0xae, 0xf3, 0x9a, 0x9a, 0x03, 0x79, 0x07, 0xe9, 0x22, 0xb5, 0x38, 0x65,
+	0xcb, 0x1f, 0xea, 0x5c, 0xfc, 0xf4, 0x47, 0xfd, 0xd2, 0x9b, 0x95, 0x59, 0xdf, 0x91, 0x0f, 0x5f,
+	0x3d, 0xba, 0x08, 0x82, 0x99, 0x01, 0xac, 0x75, 0x26, 0xd0, 0xa0, 0xe8, 0x5d, 0x38, 0x21, 0xc8,
+	0x2d, 0x22, 0x08, 0x8b, 0x48, 0x18, 0x99, 0xac, 0x0f, 0x99, 0x64, 0x1c, 0x2f, 0xc4, 0x2d, 0x2d,
+	0xf5, 0x7f, 0x04, 0xf0, 0x54, 0x11, 0x58, 0xab, 0x27, 0x04, 0x61, 0x2a, 0x8f, 0x2a, 0x83, 0x63,
+	0x36, 0x12, 0x79, 0xc8, 0x41, 0xe4, 0x30, 0xe8, 0x24, 0xac, 0x66, 0x44, 0x50, 0x6e, 0x3b, 0x6e,
+	0x24, 0x70, 0x27, 0xff, 0x3e, 0x80, 0xb5, 0xc2, 0xcb, 0xa5, 0xc8, 0xc5, 0x4c, 0xe2, 0x16, 0x4f,
+	0x53, 0x2a, 0x25, 0xe5, 0x0c, 0xf5, 0x21, 0x8c, 0x8a, 0xd3, 0x21, 0xfb, 0x5b, 0x42, 0xf2, 0xbf,
+	0x03, 0xf0, 0x4c, 0xe1, 0xda, 0x8d, 0x9e, 0x92, 0x0a, 0xb3, 0x98, 0xb2, 0xce, 0xff, 0x96, 0x44,
+	0xff, 0x7b, 0x00, 0xa7, 0x0a, 0x8f, 0xd6, 0x13, 0x2c, 0xbb, 0xab, 0x7d, 0xc2, 0x14, 0xba, 0x00,
+	0x4f, 0xf4, 0x73, 0x71, 0xe8, 0xd2, 0x0c, 0x4c, 0x9a, 0x27, 0x0a, 0xf9, 0x9a, 0x11, 0xa3, 0x2f,
+	0xe0, 0x91, 0x5b, 0x02, 0x47, 0xba, 0x03, 0xbc, 0xa1, 0x03, 0x78, 0x65, 0x0b, 0x6b, 0x3a, 0x5d,
+	0xd3, 0x7b, 0x38, 0x27, 0xd1, 0x57, 0xf0, 0xe4, 0xc0, 0x3b, 0xa9, 0x15, 0x21, 0x31, 0x1a, 0x97,
+	0xb6, 0xf7, 0x9a, 0xfb, 0x8c, 0xa2, 0xe6, 0x1e, 0x26, 0x97, 0xc7, 0xb5, 0xcb, 0x36, 0x37, 0xd3,
+	0xfd, 0x3d, 0x20, 0x17, 0x47, 0x74, 0xff, 0xfb, 0x77, 0x00, 0x1c, 0xfb, 0x98, 0x90, 0x35, 0xce,
+	0x13, 0xf4, 0x35, 0x3c, 0x3e, 0x18, 0x31, 0x19, 0xe7, 0xc9, 0x21, 0xd7, 0x6c, 0x30, 0xd0, 0x34,
+	0xbc, 0x7f, 0x77, 0x08, 0xce, 0xb6, 0xca, 0x92, 0xf5, 0x8c, 0xb0, 0xd8, 0xbe, 0xde, 0x38, 0x41,
+	0xd3, 0x70, 0x54, 0x51, 0x95, 0x10, 0x3b, 0xf8, 0x02, 0x7b, 0x40, 0x0d, 0x78, 0x34, 0x26, 0x32,
+	0x12, 0x34, 0x1b, 0x94, 0x2b, 0x28, 0x8b, 0xd0, 0x59, 0x38, 0x2e, 0x48, 0x44, 0x33, 0x4a, 0x98,
+	0xb2, 0x73, 0x25, 0x18, 0x08, 0x50, 0x17, 0x56, 0xdd, 0xbb, 0x3c, 0x62, 0x62, 0x3d, 0xbd, 0x67,
+	0xac, 0x26, 0xd0, 0x0f, 0x5c, 0xa0, 0x73, 0x6f, 0x10, 0x68, 0x29, 0x4a, 0x67, 0x7f, 0xf1, 0xf2,
+	0x9d, 0x07, 0xf5, 0x8a, 0xce, 0xf9, 0x5f, 0x0f, 0xea, 0x95, 0x9f, 0x1f, 0xcf, 0xcf, 0x3a, 0xa0,
+	0x0e, 0xef, 0x97, 0x70, 0x98, 0xd2, 0x6e, 0x02, 0xff, 0x39, 0x80, 0x33, 0x2b, 0x24, 0x21, 0x1d,
+	0x53, 0x36, 0x85, 0x85, 0xa2, 0xac, 0x73, 0x9d, 0xdd, 0x32, 0x8f, 0x5b, 0x26, 0x48, 0x9f, 0x72,
+	0x3d, 0x36, 0xcb, 0x3c, 0x3e, 0x9e, 0x8b, 0x1d, 0x8d, 0x03, 0x38, 0x2a, 0x15, 0xde, 0x20, 0x07,
+	0xc2, 0x61, 0x6b, 0x0a, 0xad, 0xc0, 0x6a, 0x97, 0xd0, 0x4e, 0xd7, 0x66, 0x72, 0x64, 0xf9, 0xf2,
+	0xdf, 0x2f, 0xea, 0x13, 0x91, 0x20, 0xfa, 0xd9, 0x65, 0xa1, 0x55, 0xfd, 0xf0, 0xea, 0xd1, 0xc5,
+	0xdd, 0x32, 0x97, 0x0a, 0x7b, 0xf0, 0x7f, 0x07, 0xf0, 0xb4, 0x0b, 0x8e, 0x72, 0x56, 0x84, 0xe9,
+	0x06, 0xf4, 0x2a, 0x9c, 0x1c, 0xf4, 0x82, 0x9e, 0xd0, 0x44, 0x4a, 0xb7, 0xed, 0x78, 0xcf, 0x1e,
+	0xcf, 0x4f, 0x3b, 0xaf, 0x96, 0xac, 0x66, 0x5d, 0x09, 0xfd, 0xde, 0x0c, 0x9a, 0xdb, 0xc9, 0x11,
+	0x83, 0xd5, 0x62, 0x7f, 0x39, 0x4c, 0x16, 0x3b, 0x94, 0xc5, 0x23, 0xae, 0xbe, 0xc0, 0xff, 0x15,
+	0xc0, 0x73, 0xff, 0x4e, 0xe4, 0xcf, 0xa9, 0xea, 0xae, 0x90, 0x8c, 0x4b, 0xaa, 0x0e, 0x89, 0xd3,
+	0x27, 0x4b, 0x9c, 0xd6, 0x2a, 0x77, 0x42, 0x1e, 0x1c, 0x8b, 0x2d, 0xb0, 0xd9, 0x5c, 0xc6, 0x83,
+	0xfc, 0xb8, 0x78, 0x3e, 0xf7, 0x7d, 0x7f, 0x5e, 0xfa, 0x19, 0x9c, 0x5c, 0x2a, 0x2f, 0x3b, 0x4c,
+	0x89, 0x2d, 0x0d, 0xe7, 0x38, 0xa1, 0xa3, 0x18, 0xce, 0xab, 0x8c, 0x5a, 0x85, 0x1b, 0x43, 0x6f,
+	0xbf, 0xf2, 0xb8, 0xab, 0xfe, 0xbd, 0x61, 0x88, 0xca, 0x90, 0x01, 0x89, 0xb8, 0xe5, 0x48, 0x9c,
+	0xd3, 0xe6, 0xcd, 0x39, 0x52, 0x5c, 0xc9, 0x39, 0xb2, 0x27, 0xd5, 0x86, 0xde, 0x9a, 0x6a, 0x1e,
+	0x1c, 0xcb, 0x97, 0xc8, 0x61, 0xb3, 0x44, 0xe6, 0x47, 0x74, 0x13, 0x9e, 0x50, 0x5c, 0xe1, 0xa4,
+	0xd8, 0xd1, 0xdc, 0x9e, 0xf9, 0x96, 0xd9, 0x98, 0x30, 0x46, 0x5a, 0x85, 0x0d, 0x74, 0xce, 0x3c,
+	0xd5, 0xe6, 0xe4, 0x16, 0x1c, 0xbb, 0x8b, 0x1e, 0xcb, 0xa5, 0x66, 0xbf, 0x41, 0xeb, 0x70, 0xac,
+	0x6b, 0xd6, 0xb5, 0x2d, 0xaf, 0x6a, 0x9a, 0xa0, 0xb9, 0xef, 0x1c, 0x79, 0xad, 0xb6, 0xe5, 0x29,
+	0x92, 0x5b, 0x5a, 0xbe, 0xf1, 0x70, 0xbb, 0x06, 0x9e, 0x6c, 0xd7, 0xc0, 0xd3, 0xed, 0x1a, 0xf8,
+	0x73, 0xbb, 0x06, 0xee, 0xbd, 0xac, 0x55, 0x9e, 0xbe, 0xac, 0x55, 0x7e, 0x7b, 0x59, 0xab, 0x7c,
+	0x79, 0x65, 0xdf, 0x06, 0xda, 0xb5, 0x5f, 0x9a, 0x7e, 0x6a, 0x57, 0xcd, 0x3f, 0x3b, 0xef, 0xff,
+	0x33, 0x00, 0xee, 0x1c, 0x75, 0x29, 0x9f, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -735,6 +908,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpoch != that1.AutoCompoundEpoch {
+		return false
+	}
+	if !this.AutoCompoundMinAmount.Equal(that1.AutoCompoundMinAmount) {
+		return false
+	}
+	if this.AutoCompoundMaxPerBlock != that1.AutoCompoundMaxPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1042,6 +1224,77 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundEntry)
+	if !ok {
+		that2, ok := that.(AutoCompoundEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *AutoCompoundRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundRecord)
+	if !ok {
+		that2, ok := that.(AutoCompoundRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.TotalCompounded.Equal(that1.TotalCompounded) {
+		return false
+	}
+	if this.CompoundCount != that1.CompoundCount {
+		return false
+	}
+	if len(this.History) != len(that1.History) {
+		return false
+	}
+	for i := range this.History {
+		if !this.History[i].Equal(&that1.History[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1062,6 +1315,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AutoCompoundMinAmount.Size()
+		i -= size
+		if _, err := m.AutoCompoundMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.AutoCompoundEpoch != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1578,6 +1851,120 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CompoundCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.CompoundCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalCompounded.Size()
+		i -= size
+		if _, err := m.TotalCompounded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1604,6 +1991,14 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundEpoch != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundEpoch))
+	}
+	l = m.AutoCompoundMinAmount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundMaxPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxPerBlock))
+	}
 	return n
 }
 
@@ -1809,17 +2204,62 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *AutoCompoundEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *AutoCompoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TotalCompounded.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.CompoundCount != 0 {
+		n += 1 + sovDistribution(uint64(m.CompoundCount))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1966,6 +2406,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpoch", wireType)
+			}
+			m.AutoCompoundEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxPerBlock", wireType)
+			}
+			m.AutoCompoundMaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3260,6 +3772,330 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCompounded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCompounded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundCount", wireType)
+			}
+			m.CompoundCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompoundCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, AutoCompoundEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundRecords:             []AutoCompoundRecord{},
 	}
 }
 
@@ -45,5 +46,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, record := range gs.AutoCompoundRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -348,6 +348,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_records defines the auto-compounding records of the delegations at genesis.
+	AutoCompoundRecords []AutoCompoundRecord `protobuf:"bytes,11,rep,name=auto_compound_records,json=autoCompoundRecords,proto3" json:"auto_compound_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,65 +401,67 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x25, 0x4d, 0xc6, 0x45, 0xb4, 0xdb, 0x34, 0x6c, 0xd2, 0xb2, 0x4e, 0x4b, 0x0f,
-	0x05, 0xd4, 0x35, 0x31, 0x08, 0xaa, 0x22, 0x90, 0x62, 0xb7, 0xe5, 0xe3, 0xd2, 0xc8, 0x96, 0x40,
-	0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x5e, 0x8f, 0xb0, 0x77, 0xac, 0x99, 0xf1, 0x1a, 0x90, 0x38, 0x70,
-	0x02, 0x21, 0x90, 0x38, 0xc2, 0xad, 0xc7, 0x0a, 0x09, 0x89, 0x03, 0x7f, 0x44, 0x25, 0x2e, 0x15,
-	0x27, 0x4e, 0x7c, 0x38, 0x07, 0xe0, 0x9f, 0x40, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xda, 0xed, 0xd6,
-	0x69, 0x93, 0x4b, 0xe2, 0x9d, 0x79, 0x1f, 0xbf, 0xdf, 0x7b, 0x3f, 0xbd, 0x37, 0xf0, 0x39, 0x8f,
-	0xf2, 0x31, 0xe5, 0x0d, 0x9f, 0x70, 0xc1, 0x48, 0x7f, 0x2a, 0x08, 0x0d, 0x1b, 0xd1, 0x6e, 0x1f,
-	0x0b, 0xb4, 0xdb, 0x08, 0x70, 0x88, 0x39, 0xe1, 0xee, 0x84, 0x51, 0x41, 0xad, 0xf3, 0xca, 0xd4,
-	0x35, 0x4d, 0x5d, 0x6d, 0xba, 0xbd, 0x11, 0xd0, 0x80, 0x4a, 0xbb, 0x46, 0xfc, 0x4b, 0xb9, 0x6c,
-	0x3b, 0x3a, 0x7a, 0x1f, 0x71, 0x9c, 0x46, 0xf5, 0x28, 0x09, 0xf5, 0xbd, 0x5b, 0x96, 0x7d, 0x21,
-	0x8f, 0xb2, 0xdf, 0x52, 0xf6, 0x3d, 0x95, 0x48, 0xe3, 0x51, 0x57, 0x67, 0xd0, 0x98, 0x84, 0xb4,
-	0x21, 0xff, 0xaa, 0xa3, 0x4b, 0x3f, 0x02, 0x78, 0xee, 0x06, 0x1e, 0xe1, 0x00, 0x09, 0xca, 0xde,
-	0x23, 0x62, 0xe8, 0x33, 0x34, 0x7b, 0x3b, 0x1c, 0x50, 0xeb, 0x26, 0x3c, 0xe3, 0x27, 0x17, 0x3d,
-	0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x83, 0x1d, 0x70, 0x65, 0xbd, 0x65, 0xff, 0xfa, 0xf3, 0xd5, 0x0d,
-	0x1d, 0x79, 0x4f, 0xdd, 0x74, 0x05, 0x23, 0x61, 0xd0, 0x39, 0x9d, 0xba, 0xe8, 0x73, 0xab, 0x0d,
-	0x4f, 0xcf, 0x74, 0xd8, 0x34, 0x4a, 0xf5, 0x21, 0x51, 0x9e, 0x4a, 0x3c, 0xf4, 0xf1, 0xf5, 0xb5,
-	0x2f, 0xef, 0xd4, 0x2b, 0xff, 0xdc, 0xa9, 0x57, 0x2e, 0xfd, 0x07, 0xe0, 0xc5, 0x77, 0xd1, 0x88,
-	0xf8, 0x71, 0x8e, 0xdb, 0x53, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0xcf, 0x10, 0xf3, 0x79, 0x07,
-	0x7b, 0x94, 0xf9, 0x31, 0xf6, 0x28, 0x31, 0x5a, 0x1e, 0x7b, 0xea, 0x92, 0x60, 0xff, 0x02, 0xc0,
-	0xb3, 0x34, 0xcb, 0xd1, 0x63, 0x2a, 0x89, 0x5d, 0xdd, 0x59, 0xb9, 0x52, 0x6b, 0x5e, 0xd0, 0x9d,
-	0x71, 0xe3, 0xce, 0x25, 0x4d, 0x76, 0x6f, 0x60, 0xaf, 0x4d, 0x49, 0xd8, 0xba, 0x76, 0xef, 0xf7,
-	0x7a, 0xe5, 0x87, 0x3f, 0xea, 0x2f, 0x04, 0x44, 0x0c, 0xa7, 0x7d, 0xd7, 0xa3, 0x63, 0xdd, 0x0c,
-	0xfd, 0xef, 0x2a, 0xf7, 0x3f, 0x6a, 0x88, 0x4f, 0x26, 0x98, 0x27, 0x3e, 0xfc, 0xee, 0xdf, 0x3f,
-	0x3d, 0x0f, 0x3a, 0x16, 0xcd, 0xd1, 0x32, 0x0a, 0xf0, 0x17, 0x80, 0x97, 0xd3, 0x02, 0xec, 0x79,
-	0xde, 0x74, 0x3c, 0x1d, 0x21, 0x81, 0xfd, 0x36, 0x1d, 0x8f, 0x09, 0xe7, 0x84, 0x86, 0x47, 0x5b,
-	0x83, 0x21, 0xac, 0xa1, 0x2c, 0x8b, 0x6c, 0x5d, 0xad, 0xf9, 0x9a, 0x5b, 0xa2, 0x73, 0xb7, 0x1c,
-	0x5e, 0x6b, 0x3d, 0xae, 0x8c, 0xa2, 0x6a, 0x86, 0x36, 0x38, 0xfe, 0x0b, 0xe0, 0x4e, 0x1a, 0xe4,
-	0x2d, 0xc2, 0x05, 0x65, 0xc4, 0x43, 0xa3, 0x63, 0xe9, 0xf1, 0x26, 0x5c, 0x9d, 0x60, 0x46, 0xa8,
-	0xa2, 0x76, 0xa2, 0xa3, 0xbf, 0xac, 0x0f, 0xe1, 0xc9, 0xa4, 0xdd, 0x2b, 0x92, 0xf3, 0xab, 0xcb,
-	0x71, 0xce, 0xc1, 0x35, 0xf9, 0x26, 0x21, 0x0d, 0xae, 0xbf, 0x00, 0xf8, 0x4c, 0xea, 0xdc, 0x9e,
-	0x32, 0x86, 0x43, 0x71, 0x2c, 0x44, 0xdf, 0xcf, 0x08, 0xa9, 0x26, 0xbe, 0xbc, 0x1c, 0xa1, 0x45,
-	0x4c, 0x0f, 0x61, 0xf3, 0x7d, 0x15, 0x9e, 0x4f, 0xc7, 0x49, 0x57, 0x20, 0x26, 0x48, 0x18, 0xc4,
-	0xe3, 0x24, 0xe3, 0x72, 0x14, 0x43, 0xa5, 0xb0, 0x24, 0xd5, 0x43, 0x97, 0xa4, 0x0f, 0x9f, 0xe4,
-	0x1a, 0x63, 0x8f, 0x84, 0x03, 0xaa, 0x3b, 0xdd, 0x2c, 0x2d, 0x4c, 0x21, 0x3d, 0xb3, 0x2c, 0xa7,
-	0xb8, 0x71, 0x61, 0xd4, 0xe6, 0x9b, 0x2a, 0xdc, 0x4a, 0xab, 0xda, 0x1d, 0x21, 0x3e, 0xbc, 0x19,
-	0xc9, 0xc2, 0x1e, 0xb1, 0x9c, 0x87, 0x98, 0x04, 0x43, 0x91, 0xc8, 0x59, 0x7d, 0x19, 0x32, 0x5f,
-	0x59, 0x90, 0x39, 0x85, 0xe7, 0xb2, 0xb4, 0x3c, 0x06, 0xd5, 0xc3, 0x31, 0x2a, 0xfb, 0x84, 0x2c,
-	0xc5, 0x8b, 0xcb, 0x69, 0x24, 0x63, 0x63, 0x16, 0xe2, 0x6c, 0x94, 0xbf, 0x37, 0xea, 0xf1, 0xf5,
-	0x3a, 0x3c, 0xf5, 0xa6, 0xda, 0x9e, 0x5d, 0x81, 0x04, 0xb6, 0x6e, 0xc1, 0xd5, 0x09, 0x62, 0x68,
-	0xac, 0x78, 0xd7, 0x9a, 0xcf, 0x96, 0x26, 0xdf, 0x97, 0xa6, 0x66, 0x3e, 0xed, 0x6d, 0xbd, 0x03,
-	0xd7, 0x06, 0x18, 0xf7, 0x26, 0x94, 0x8e, 0xb4, 0xd4, 0x2f, 0x97, 0x46, 0xba, 0x85, 0xf1, 0x3e,
-	0xa5, 0xa3, 0x05, 0x69, 0x0f, 0xd4, 0x99, 0x35, 0x83, 0x76, 0x26, 0xd8, 0x74, 0x91, 0xc5, 0x62,
-	0x89, 0xe7, 0xc2, 0xca, 0xf2, 0x6a, 0x31, 0x77, 0xab, 0x99, 0x69, 0xd3, 0x2f, 0xb2, 0x90, 0x12,
-	0x9f, 0x30, 0x1c, 0x11, 0x3a, 0x95, 0xab, 0x7c, 0x42, 0x39, 0x66, 0xb2, 0x29, 0xa5, 0x7a, 0x48,
-	0x5c, 0xf6, 0xb5, 0x87, 0xf5, 0x69, 0xf1, 0x06, 0x7b, 0x42, 0x42, 0x7f, 0x63, 0xb9, 0xee, 0x3e,
-	0x68, 0xcd, 0x9a, 0x34, 0x0a, 0x96, 0x96, 0xf5, 0x1d, 0x80, 0x17, 0x0d, 0x4d, 0x67, 0xa3, 0xbe,
-	0xe7, 0xa5, 0xdb, 0x80, 0xdb, 0xab, 0x12, 0xca, 0xde, 0x63, 0x6c, 0x94, 0x3c, 0x9a, 0x7a, 0x54,
-	0xea, 0xc0, 0xad, 0xaf, 0x00, 0xbc, 0x90, 0x41, 0x1b, 0xa6, 0x33, 0x3b, 0x2d, 0xd0, 0x49, 0x89,
-	0xea, 0xf5, 0x47, 0x9c, 0xf9, 0x79, 0x44, 0xdb, 0xd1, 0x03, 0x8d, 0xad, 0xcf, 0x01, 0xdc, 0xca,
-	0xc0, 0x78, 0x6a, 0xde, 0xa6, 0x48, 0xd6, 0x24, 0x92, 0xeb, 0x8f, 0x32, 0xac, 0xf3, 0x30, 0x9e,
-	0x8e, 0x8a, 0x2d, 0xad, 0xcf, 0x4c, 0x9d, 0x2f, 0x0c, 0x45, 0x6e, 0xaf, 0x4b, 0x04, 0xd7, 0x0e,
-	0x3f, 0x15, 0xf3, 0xf9, 0x33, 0xb5, 0x9b, 0x76, 0xdc, 0x9a, 0xc1, 0xcd, 0xc2, 0x31, 0xc4, 0x6d,
-	0x28, 0x93, 0xbf, 0x72, 0xd8, 0x39, 0x94, 0x4f, 0xbd, 0x51, 0x30, 0x8d, 0x8c, 0xd5, 0xd5, 0xba,
-	0x7d, 0x77, 0xee, 0x80, 0x7b, 0x73, 0x07, 0xdc, 0x9f, 0x3b, 0xe0, 0xcf, 0xb9, 0x03, 0xbe, 0x3d,
-	0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x83, 0xdd, 0xd2, 0x67, 0xdc, 0xc7,
-	0x8b, 0xaf, 0x73, 0xf9, 0xaa, 0xeb, 0xaf, 0xca, 0x17, 0xf6, 0x4b, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x88, 0x91, 0xf2, 0x3f, 0x0c, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xdf, 0xd9, 0x84, 0x34, 0x99, 0x2d, 0xa2, 0x75, 0x3e, 0x70, 0xd2, 0xb2, 0x9b, 0x96, 0x1e,
+	0x0a, 0xa8, 0x5e, 0x12, 0x10, 0x54, 0x45, 0x20, 0x25, 0xdb, 0x96, 0x8f, 0x4b, 0xa3, 0x44, 0x02,
+	0x81, 0x90, 0xac, 0x59, 0x7b, 0xe2, 0x1d, 0xb1, 0xeb, 0xb1, 0x66, 0xc6, 0x5e, 0x40, 0xe2, 0xc0,
+	0x09, 0x84, 0x84, 0xc4, 0x11, 0x6e, 0x3d, 0x56, 0x48, 0x48, 0x1c, 0xf8, 0x23, 0x2a, 0x71, 0xa9,
+	0x38, 0x71, 0xe2, 0x23, 0x39, 0x00, 0xff, 0x03, 0x42, 0xc8, 0x33, 0x63, 0x7b, 0x56, 0x76, 0x9d,
+	0x4d, 0x9b, 0x5c, 0x76, 0xd7, 0x33, 0xef, 0xbd, 0xdf, 0xef, 0xf7, 0xde, 0xd3, 0x7b, 0x5e, 0xf8,
+	0x9c, 0x47, 0xf9, 0x88, 0xf2, 0xae, 0x4f, 0xb8, 0x60, 0xa4, 0x1f, 0x0b, 0x42, 0xc3, 0x6e, 0xb2,
+	0xd1, 0xc7, 0x02, 0x6d, 0x74, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x13, 0x31, 0x2a, 0xa8, 0x75, 0x41,
+	0x99, 0x3a, 0xa6, 0xa9, 0xa3, 0x4d, 0xd7, 0x96, 0x02, 0x1a, 0x50, 0x69, 0xd7, 0x4d, 0x7f, 0x29,
+	0x97, 0xb5, 0xb6, 0x8e, 0xde, 0x47, 0x1c, 0xe7, 0x51, 0x3d, 0x4a, 0x42, 0x7d, 0xef, 0xd4, 0xa1,
+	0x4f, 0xe0, 0x28, 0xfb, 0x55, 0x65, 0xef, 0x2a, 0x20, 0xcd, 0x47, 0x5d, 0x9d, 0x47, 0x23, 0x12,
+	0xd2, 0xae, 0xfc, 0x54, 0x47, 0x97, 0x7f, 0x00, 0x70, 0xf9, 0x26, 0x1e, 0xe2, 0x00, 0x09, 0xca,
+	0xde, 0x23, 0x62, 0xe0, 0x33, 0x34, 0x7e, 0x3b, 0xdc, 0xa7, 0xd6, 0x2d, 0x78, 0xde, 0xcf, 0x2e,
+	0x5c, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x83, 0x75, 0x70, 0x75, 0x61, 0xdb, 0xfe, 0xe5, 0xa7, 0x6b,
+	0x4b, 0x3a, 0xf2, 0x96, 0xba, 0xd9, 0x13, 0x8c, 0x84, 0xc1, 0xee, 0xb9, 0xdc, 0x45, 0x9f, 0x5b,
+	0x3d, 0x78, 0x6e, 0xac, 0xc3, 0xe6, 0x51, 0x9a, 0x47, 0x44, 0x79, 0x2a, 0xf3, 0xd0, 0xc7, 0x37,
+	0xe6, 0xbf, 0xbc, 0xdb, 0x69, 0xfc, 0x7d, 0xb7, 0xd3, 0xb8, 0xfc, 0x1f, 0x80, 0x97, 0xde, 0x45,
+	0x43, 0xe2, 0xa7, 0x18, 0x77, 0x62, 0xc1, 0x05, 0x0a, 0xfd, 0xd4, 0x07, 0x8f, 0x11, 0xf3, 0xf9,
+	0x2e, 0xf6, 0x28, 0xf3, 0x53, 0xee, 0x49, 0x66, 0x34, 0x3d, 0xf7, 0xdc, 0x25, 0xe3, 0xfe, 0x05,
+	0x80, 0x8b, 0xb4, 0xc0, 0x70, 0x99, 0x02, 0xb1, 0x9b, 0xeb, 0x33, 0x57, 0x5b, 0x9b, 0x17, 0x75,
+	0x65, 0x9c, 0xb4, 0x72, 0x59, 0x91, 0x9d, 0x9b, 0xd8, 0xeb, 0x51, 0x12, 0x6e, 0x5f, 0xbf, 0xff,
+	0x5b, 0xa7, 0xf1, 0xfd, 0xef, 0x9d, 0x17, 0x02, 0x22, 0x06, 0x71, 0xdf, 0xf1, 0xe8, 0x48, 0x17,
+	0x43, 0x7f, 0x5d, 0xe3, 0xfe, 0x47, 0x5d, 0xf1, 0x49, 0x84, 0x79, 0xe6, 0xc3, 0xef, 0xfd, 0xf5,
+	0xe3, 0xf3, 0x60, 0xd7, 0xa2, 0x25, 0x59, 0x46, 0x02, 0xfe, 0x04, 0xf0, 0x4a, 0x9e, 0x80, 0x2d,
+	0xcf, 0x8b, 0x47, 0xf1, 0x10, 0x09, 0xec, 0xf7, 0xe8, 0x68, 0x44, 0x38, 0x27, 0x34, 0x3c, 0xd9,
+	0x1c, 0x0c, 0x60, 0x0b, 0x15, 0x28, 0xb2, 0x74, 0xad, 0xcd, 0xd7, 0x9c, 0x9a, 0x3e, 0x77, 0xea,
+	0xe9, 0x6d, 0x2f, 0xa4, 0x99, 0x51, 0x52, 0xcd, 0xd0, 0x86, 0xc6, 0x7f, 0x00, 0x5c, 0xcf, 0x83,
+	0xbc, 0x45, 0xb8, 0xa0, 0x8c, 0x78, 0x68, 0x78, 0x2a, 0x35, 0x5e, 0x81, 0x73, 0x11, 0x66, 0x84,
+	0x2a, 0x69, 0xb3, 0xbb, 0xfa, 0xc9, 0xfa, 0x10, 0x9e, 0xc9, 0xca, 0x3d, 0x23, 0x35, 0xbf, 0x3a,
+	0x9d, 0xe6, 0x12, 0x5d, 0x53, 0x6f, 0x16, 0xd2, 0xd0, 0xfa, 0x33, 0x80, 0xcf, 0xe4, 0xce, 0xbd,
+	0x98, 0x31, 0x1c, 0x8a, 0x53, 0x11, 0xfa, 0x7e, 0x21, 0x48, 0x15, 0xf1, 0xe5, 0xe9, 0x04, 0x4d,
+	0x72, 0x3a, 0x42, 0xcd, 0x77, 0x4d, 0x78, 0x21, 0x1f, 0x27, 0x7b, 0x02, 0x31, 0x41, 0xc2, 0x20,
+	0x1d, 0x27, 0x85, 0x96, 0x93, 0x18, 0x2a, 0x95, 0x29, 0x69, 0x1e, 0x3b, 0x25, 0x7d, 0xf8, 0x24,
+	0xd7, 0x1c, 0x5d, 0x12, 0xee, 0x53, 0x5d, 0xe9, 0xcd, 0xda, 0xc4, 0x54, 0xca, 0x33, 0xd3, 0x72,
+	0x96, 0x1b, 0x17, 0x46, 0x6e, 0xbe, 0x6e, 0xc2, 0xd5, 0x3c, 0xab, 0x7b, 0x43, 0xc4, 0x07, 0xb7,
+	0x12, 0x99, 0xd8, 0x13, 0x6e, 0xe7, 0x01, 0x26, 0xc1, 0x40, 0x64, 0xed, 0xac, 0x9e, 0x8c, 0x36,
+	0x9f, 0x99, 0x68, 0x73, 0x0a, 0x97, 0x0b, 0x58, 0x9e, 0x92, 0x72, 0x71, 0xca, 0xca, 0x9e, 0x95,
+	0xa9, 0x78, 0x71, 0xba, 0x1e, 0x29, 0xd4, 0x98, 0x89, 0x58, 0x4c, 0xca, 0xf7, 0x46, 0x3e, 0xfe,
+	0x5d, 0x80, 0x67, 0xdf, 0x54, 0xdb, 0x73, 0x4f, 0x20, 0x81, 0xad, 0xdb, 0x70, 0x2e, 0x42, 0x0c,
+	0x8d, 0x94, 0xee, 0xd6, 0xe6, 0xb3, 0xb5, 0xe0, 0x3b, 0xd2, 0xd4, 0xc4, 0xd3, 0xde, 0xd6, 0x3b,
+	0x70, 0x7e, 0x1f, 0x63, 0x37, 0xa2, 0x74, 0xa8, 0x5b, 0xfd, 0x4a, 0x6d, 0xa4, 0xdb, 0x18, 0xef,
+	0x50, 0x3a, 0x9c, 0x68, 0xed, 0x7d, 0x75, 0x66, 0x8d, 0xa1, 0x5d, 0x34, 0x6c, 0xbe, 0xc8, 0xd2,
+	0x66, 0x49, 0xe7, 0xc2, 0xcc, 0xf4, 0xdd, 0x62, 0xee, 0x56, 0x13, 0x69, 0xc5, 0xaf, 0xb2, 0x90,
+	0x2d, 0x1e, 0x31, 0x9c, 0x10, 0x1a, 0xcb, 0x55, 0x1e, 0x51, 0x8e, 0x99, 0x3d, 0x7b, 0x54, 0x3f,
+	0x64, 0x2e, 0x3b, 0xda, 0xc3, 0xfa, 0xb4, 0x7a, 0x83, 0x3d, 0x21, 0xa9, 0xbf, 0x31, 0x5d, 0x75,
+	0x1f, 0xb6, 0x66, 0x4d, 0x19, 0x15, 0x4b, 0xcb, 0xfa, 0x16, 0xc0, 0x4b, 0x46, 0x4f, 0x17, 0xa3,
+	0xde, 0xf5, 0xf2, 0x6d, 0xc0, 0xed, 0x39, 0x49, 0x65, 0xeb, 0x31, 0x36, 0x4a, 0x99, 0x4d, 0x27,
+	0xa9, 0x75, 0xe0, 0xd6, 0x57, 0x00, 0x5e, 0x2c, 0xa8, 0x0d, 0xf2, 0x99, 0x9d, 0x27, 0xe8, 0x8c,
+	0x64, 0xf5, 0xfa, 0x23, 0xce, 0xfc, 0x32, 0xa3, 0xb5, 0xe4, 0xa1, 0xc6, 0xd6, 0xe7, 0x00, 0xae,
+	0x16, 0x64, 0x3c, 0x35, 0x6f, 0x73, 0x26, 0xf3, 0x92, 0xc9, 0x8d, 0x47, 0x19, 0xd6, 0x65, 0x1a,
+	0x4f, 0x27, 0xd5, 0x96, 0xd6, 0x67, 0x66, 0x9f, 0x4f, 0x0c, 0x45, 0x6e, 0x2f, 0x48, 0x06, 0xd7,
+	0x8f, 0x3f, 0x15, 0xcb, 0xf8, 0x2b, 0x7e, 0x95, 0x1d, 0xb7, 0xc6, 0x70, 0xa5, 0x72, 0x0c, 0x71,
+	0x1b, 0x4a, 0xf0, 0x57, 0x8e, 0x3b, 0x87, 0xca, 0xd0, 0x4b, 0x15, 0xd3, 0x88, 0x5b, 0x21, 0x5c,
+	0x46, 0xb1, 0xa0, 0x69, 0x47, 0x46, 0x34, 0x0e, 0x7d, 0x97, 0x49, 0x47, 0x6e, 0xb7, 0x24, 0x6e,
+	0xb7, 0x16, 0x77, 0x2b, 0x16, 0xb4, 0xa7, 0x1d, 0xcb, 0x80, 0x8b, 0xa8, 0x74, 0x6d, 0xac, 0xca,
+	0xed, 0x3b, 0xf7, 0x0e, 0xda, 0xe0, 0xfe, 0x41, 0x1b, 0x3c, 0x38, 0x68, 0x83, 0x3f, 0x0e, 0xda,
+	0xe0, 0x9b, 0xc3, 0x76, 0xe3, 0xc1, 0x61, 0xbb, 0xf1, 0xeb, 0x61, 0xbb, 0xf1, 0xc1, 0x46, 0xed,
+	0x6b, 0xe3, 0xc7, 0x93, 0xff, 0x06, 0xe4, 0x5b, 0x64, 0x7f, 0x4e, 0xbe, 0xd1, 0xbf, 0xf4, 0xff,
+	0x00, 0x45, 0x39, 0xf4, 0x13, 0xaf, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -783,6 +787,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundRecords) > 0 {
+		for iNdEx := len(m.AutoCompoundRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1102,6 +1120,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundRecords) > 0 {
+		for _, e := range m.AutoCompoundRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2370,6 +2394,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundRecords = append(m.AutoCompoundRecords, AutoCompoundRecord{})
+			if err := m.AutoCompoundRecords[len(m.AutoCompoundRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,12 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<delAddrLen (1 Byte)><delAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompoundRecord
+//
+// - 0x0B<delAddrLen (1 Byte)><delAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{} (auto-compound enabled)
+//
+// - 0x0C: the key of the next auto-compound enabled delegation to compound
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +60,10 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundRecordPrefix  = []byte{0x0A} // key for the auto-compound records of the delegations
+	AutoCompoundEnabledPrefix = []byte{0x0B} // key for the delegations with auto-compounding enabled
+	AutoCompoundCursorKey     = []byte{0x0C} // key for the next delegation to compound of the current pass
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -214,3 +224,29 @@ func GetValidatorSlashEventKey(v sdk.AccAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundAddresses creates the addresses from an auto-compound record or enabled key.
+func GetAutoCompoundAddresses(key []byte) (delAddr, valAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<delAddrLen (1 Byte)><delAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.AccAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetAutoCompoundRecordKey creates the key for the auto-compound record of a delegation.
+func GetAutoCompoundRecordKey(d, v sdk.AccAddress) []byte {
+	return append(append(AutoCompoundRecordPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetAutoCompoundEnabledKey creates the key marking the auto-compounding of a delegation as enabled.
+func GetAutoCompoundEnabledKey(d, v sdk.AccAddress) []byte {
+	return append(append(AutoCompoundEnabledPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
@@ -32,6 +32,30 @@ func TestMsgSetWithdrawAddress(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.AccAddress
+		enable        bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+		{emptyDelAddr, emptyValAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enable)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawDelegatorReward
 func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	tests := []struct {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -9,6 +10,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default auto-compound parameters, auto-compounding is disabled by default
+var (
+	DefaultAutoCompoundEpoch       uint64 = 0
+	DefaultAutoCompoundMinAmount          = math.ZeroInt()
+	DefaultAutoCompoundMaxPerBlock uint32 = 100
+)
+
+// MaxAutoCompoundHistory is the number of auto-compoundings kept in the history of a delegation
+const MaxAutoCompoundHistory = 10
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
@@ -16,6 +27,10 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.ZeroDec(),            // deprecated
 		BonusProposerReward: sdk.ZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundEpoch:       DefaultAutoCompoundEpoch,
+		AutoCompoundMinAmount:   DefaultAutoCompoundMinAmount,
+		AutoCompoundMaxPerBlock: DefaultAutoCompoundMaxPerBlock,
 	}
 }
 
@@ -32,7 +47,7 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
-	return nil
+	return validateAutoCompound(p.AutoCompoundEpoch, p.AutoCompoundMinAmount, p.AutoCompoundMaxPerBlock)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -62,3 +77,23 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+// validateAutoCompound validates the auto-compound parameters, they are only required when
+// auto-compounding is enabled so that the params of the chains created before stay valid.
+func validateAutoCompound(epoch uint64, minAmount math.Int, maxPerBlock uint32) error {
+	if !minAmount.IsNil() && minAmount.IsNegative() {
+		return fmt.Errorf("auto compound min amount must not be negative: %s", minAmount)
+	}
+	if epoch == 0 {
+		return nil
+	}
+
+	if minAmount.IsNil() {
+		return errors.New("auto compound min amount must be not nil")
+	}
+	if maxPerBlock == 0 {
+		return errors.New("auto compound max per block must be positive")
+	}
+
+	return nil
+}
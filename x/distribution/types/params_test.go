@@ -43,6 +43,35 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateAutoCompound(t *testing.T) {
+	tests := []struct {
+		name        string
+		epoch       uint64
+		minAmount   sdk.Int
+		maxPerBlock uint32
+		wantErr     bool
+	}{
+		{"disabled", 0, sdk.Int{}, 0, false},
+		{"disabled with negative min amount", 0, sdk.NewInt(-1), 100, true},
+		{"enabled", 100, sdk.NewInt(1000), 100, false},
+		{"enabled with zero min amount", 100, sdk.ZeroInt(), 1, false},
+		{"enabled with nil min amount", 100, sdk.Int{}, 100, true},
+		{"enabled with negative min amount", 100, sdk.NewInt(-1), 100, true},
+		{"enabled with zero max per block", 100, sdk.NewInt(1000), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.AutoCompoundEpoch = tt.epoch
+			p.AutoCompoundMinAmount = tt.minAmount
+			p.AutoCompoundMaxPerBlock = tt.maxPerBlock
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -952,6 +952,95 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundRequest is the request type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryAutoCompoundRequest) Reset()         { *m = QueryAutoCompoundRequest{} }
+func (m *QueryAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundResponse is the response type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundResponse struct {
+	// record defines the auto-compounding setting and history of the delegation.
+	Record AutoCompoundRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryAutoCompoundResponse) Reset()         { *m = QueryAutoCompoundResponse{} }
+func (m *QueryAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundResponse) GetRecord() AutoCompoundRecord {
+	if m != nil {
+		return m.Record
+	}
+	return AutoCompoundRecord{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1062,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRequest")
+	proto.RegisterType((*QueryAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundResponse")
 }
 
 func init() {
@@ -980,86 +1071,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf6, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x99, 0x46, 0xc8, 0xd9, 0x04, 0x3b, 0xda,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x93, 0x48, 0xa5, 0x34, 0x54, 0x10, 0x3b, 0x09, 0x45, 0xad, 0xfa,
-	0xc7, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0xac, 0x17, 0xec, 0x1d, 0x67, 0x67, 0x9d, 0x10,
-	0x55, 0xbd, 0x14, 0x21, 0x15, 0x4e, 0x08, 0x2e, 0x3d, 0xe6, 0x88, 0x38, 0x71, 0x00, 0x71, 0xe4,
-	0xc2, 0xa1, 0xc7, 0x0a, 0x24, 0xc4, 0x09, 0x50, 0x02, 0xa2, 0x1c, 0x90, 0xb8, 0x71, 0x45, 0x9e,
-	0x99, 0xb5, 0x77, 0xe3, 0xf5, 0xfa, 0x5f, 0x7c, 0x49, 0x56, 0x6f, 0xe6, 0x7d, 0xef, 0x7d, 0xef,
-	0xcd, 0x9b, 0xf9, 0x12, 0x98, 0xcd, 0x13, 0x5a, 0x22, 0x54, 0xd1, 0x4c, 0xea, 0xd8, 0x66, 0xae,
-	0xe2, 0x98, 0xc4, 0x52, 0x76, 0x16, 0x73, 0xba, 0xa3, 0x2e, 0x2a, 0xdb, 0x15, 0xdd, 0xde, 0x4b,
-	0x96, 0x6d, 0xe2, 0x10, 0x3c, 0xc9, 0x37, 0x26, 0xbd, 0x1b, 0x93, 0x62, 0xa3, 0x74, 0x5e, 0xa0,
-	0xe4, 0x54, 0xaa, 0x73, 0xaf, 0x1a, 0x46, 0x59, 0x35, 0x4c, 0x4b, 0x65, 0xbb, 0x19, 0x90, 0x34,
-	0x6e, 0x10, 0x83, 0xb0, 0x4f, 0xa5, 0xfa, 0x25, 0xac, 0x53, 0x06, 0x21, 0x46, 0x51, 0x57, 0xd4,
-	0xb2, 0xa9, 0xa8, 0x96, 0x45, 0x1c, 0xe6, 0x42, 0xc5, 0x6a, 0xdc, 0x8b, 0xef, 0x22, 0xe7, 0x89,
-	0xe9, 0x62, 0x26, 0xc3, 0x58, 0xf8, 0x32, 0xe6, 0xfb, 0x27, 0xf8, 0xfe, 0x2c, 0x4f, 0x43, 0x30,
-	0xe3, 0x4b, 0x63, 0x6a, 0xc9, 0xb4, 0x88, 0xc2, 0x7e, 0x72, 0x93, 0x3c, 0x0e, 0xf8, 0x76, 0x95,
-	0xd3, 0x2d, 0xd5, 0x56, 0x4b, 0x34, 0xa3, 0x6f, 0x57, 0x74, 0xea, 0xc8, 0xf7, 0xe0, 0x8c, 0xcf,
-	0x4a, 0xcb, 0xc4, 0xa2, 0x3a, 0xde, 0x80, 0xa1, 0x32, 0xb3, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xb5,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x3b, 0xa7, 0x86, 0x9f, 0xfc, 0x92, 0x88, 0x7c, 0xf1, 0xe7,
-	0x57, 0xe7, 0x51, 0x46, 0x78, 0xcb, 0x16, 0x9c, 0x65, 0xf0, 0x6f, 0xa9, 0x45, 0x53, 0x53, 0x1d,
-	0x62, 0xaf, 0x79, 0xfc, 0xdf, 0xb0, 0xb6, 0x88, 0xc8, 0x03, 0xaf, 0xc3, 0xd8, 0x8e, 0xbb, 0x27,
-	0xab, 0x6a, 0x9a, 0xad, 0x53, 0x1e, 0x7b, 0x38, 0x15, 0xfb, 0xe1, 0xeb, 0x85, 0x71, 0x11, 0x7e,
-	0x95, 0xaf, 0xdc, 0x71, 0x6c, 0xd3, 0x32, 0x32, 0xa3, 0x35, 0x17, 0x61, 0x97, 0xff, 0x88, 0xc2,
-	0xb9, 0x56, 0x01, 0x05, 0xc5, 0x34, 0x8c, 0x92, 0xb2, 0x6e, 0x77, 0x14, 0x70, 0xc4, 0xf5, 0x10,
-	0x66, 0xfc, 0x10, 0xc1, 0x18, 0xd5, 0x8b, 0x5b, 0xd9, 0x1c, 0xb1, 0xb4, 0xac, 0xad, 0xef, 0xaa,
-	0xb6, 0x46, 0x63, 0xd1, 0xe9, 0x81, 0xb9, 0x53, 0x4b, 0x53, 0x6e, 0xcd, 0xaa, 0xfd, 0xae, 0xd5,
-	0x6a, 0x4d, 0xcf, 0xa7, 0x89, 0x69, 0xa5, 0x2e, 0x55, 0x8b, 0xf5, 0xe5, 0xaf, 0x89, 0x79, 0xc3,
-	0x74, 0x0a, 0x95, 0x5c, 0x32, 0x4f, 0x4a, 0xa2, 0x85, 0xe2, 0xd7, 0x02, 0xd5, 0xde, 0x57, 0x9c,
-	0xbd, 0xb2, 0x4e, 0x5d, 0x1f, 0xca, 0x6b, 0x3b, 0x52, 0x0d, 0x98, 0x22, 0x96, 0x96, 0xe1, 0xe1,
-	0xf0, 0x36, 0x40, 0x9e, 0x94, 0x4a, 0x26, 0xa5, 0x26, 0xb1, 0x62, 0x03, 0x6d, 0x04, 0x5f, 0xee,
-	0x22, 0x78, 0xc6, 0x13, 0x44, 0x2e, 0xc3, 0xac, 0xbf, 0xcc, 0x37, 0x2b, 0x0e, 0x75, 0x54, 0x4b,
-	0xab, 0x56, 0x89, 0xa7, 0x75, 0xcc, 0x9d, 0xfd, 0x18, 0xc1, 0x5c, 0xeb, 0x90, 0xa2, 0xb7, 0xf7,
-	0xe0, 0xa4, 0xdb, 0x0b, 0x7e, 0x7e, 0x2f, 0x85, 0x9e, 0xdf, 0x10, 0x48, 0xef, 0xa1, 0x76, 0x31,
-	0xe5, 0x02, 0x24, 0xfc, 0xa9, 0xa4, 0x6b, 0x95, 0x39, 0x66, 0xd6, 0x9f, 0x20, 0x98, 0x6e, 0x1e,
-	0x4a, 0xb0, 0xdd, 0xf2, 0xf5, 0x9f, 0x13, 0x5e, 0x69, 0x8f, 0xf0, 0x6a, 0x3e, 0x5f, 0x29, 0x55,
-	0x8a, 0xaa, 0xa3, 0x6b, 0x75, 0x60, 0x2f, 0x67, 0x6f, 0xd3, 0x3f, 0x8a, 0xc2, 0x94, 0x3f, 0x99,
-	0x3b, 0x45, 0x95, 0x16, 0xf4, 0x63, 0x6e, 0x35, 0x9e, 0x85, 0x11, 0xea, 0xa8, 0xb6, 0x63, 0x5a,
-	0x46, 0xb6, 0xa0, 0x9b, 0x46, 0xc1, 0x89, 0x45, 0xa7, 0xd1, 0xdc, 0x60, 0xe6, 0x39, 0xd7, 0x7c,
-	0x95, 0x59, 0xf1, 0x0c, 0x9c, 0xd6, 0x59, 0xb3, 0xdc, 0x6d, 0x03, 0x6c, 0xdb, 0xff, 0xb9, 0x51,
-	0x6c, 0xda, 0x00, 0xa8, 0xdf, 0xde, 0xb1, 0x41, 0x56, 0x9d, 0x73, 0xbe, 0xe9, 0xe0, 0x0f, 0x44,
-	0xfd, 0x32, 0x33, 0x74, 0x41, 0x28, 0xe3, 0xf1, 0xbc, 0xfc, 0xbf, 0x47, 0xfb, 0x89, 0xc8, 0xe3,
-	0xfd, 0x04, 0x92, 0xbf, 0x43, 0xf0, 0x42, 0x93, 0x3a, 0x88, 0x8e, 0xbc, 0x09, 0x27, 0x29, 0x37,
-	0xc5, 0x10, 0x1b, 0xc7, 0x0b, 0xed, 0xb5, 0x83, 0xe1, 0xac, 0xef, 0xe8, 0x96, 0xe3, 0x3b, 0x77,
-	0x02, 0x0b, 0xbf, 0xee, 0xa3, 0x12, 0x65, 0x54, 0x66, 0x5b, 0x52, 0xe1, 0x39, 0x79, 0xb9, 0xc8,
-	0xdf, 0xba, 0x0c, 0xd6, 0xf4, 0xa2, 0x6e, 0x30, 0x5b, 0xe3, 0xd4, 0x6a, 0x7c, 0xad, 0x93, 0x56,
-	0xd6, 0x5c, 0xdc, 0x56, 0x06, 0x9e, 0x88, 0x68, 0xa7, 0x27, 0x82, 0xd7, 0xfe, 0xd9, 0x7e, 0x22,
-	0x22, 0x7f, 0x86, 0x20, 0xde, 0x2c, 0x73, 0x51, 0xfc, 0xb2, 0x77, 0xf8, 0xfb, 0x79, 0x11, 0xd7,
-	0xee, 0x83, 0x0a, 0xc8, 0x47, 0x72, 0xba, 0x4b, 0x1c, 0xb5, 0xd8, 0x97, 0x92, 0x7a, 0x6a, 0xf1,
-	0x0f, 0x82, 0x99, 0xd0, 0xb8, 0xa2, 0x20, 0xef, 0x1e, 0x2d, 0xc8, 0xc5, 0xd0, 0xd3, 0x58, 0x47,
-	0x5b, 0x73, 0x63, 0x73, 0xc4, 0xa0, 0xbb, 0x10, 0x17, 0xe1, 0x84, 0x53, 0x0d, 0xda, 0xe7, 0x47,
-	0x8f, 0x07, 0x91, 0x6d, 0x71, 0xf3, 0xd6, 0x32, 0xab, 0x8d, 0x4e, 0xff, 0xca, 0x7c, 0x5d, 0x5c,
-	0xc1, 0x81, 0x31, 0x45, 0x89, 0xe3, 0x00, 0xb5, 0x43, 0xcb, 0xab, 0x3c, 0x9c, 0xf1, 0x58, 0x3c,
-	0x68, 0xbb, 0xf0, 0xa2, 0x1f, 0x6d, 0xd3, 0x74, 0x0a, 0x9a, 0xad, 0xee, 0x8a, 0xc0, 0x7d, 0xa3,
-	0xb1, 0x23, 0xa4, 0x58, 0xf3, 0xc0, 0x75, 0x61, 0xb4, 0x2b, 0x96, 0xda, 0x17, 0x46, 0xbb, 0x7e,
-	0x30, 0x4f, 0xdc, 0x49, 0x98, 0x60, 0x71, 0xab, 0xef, 0x4b, 0xc5, 0x32, 0x9d, 0xbd, 0x5b, 0x84,
-	0x14, 0x5d, 0xf9, 0xf9, 0x08, 0x81, 0x14, 0xb4, 0x2a, 0x52, 0x79, 0x0f, 0x06, 0xcb, 0x84, 0x14,
-	0xfb, 0x3c, 0xc7, 0x2c, 0xc6, 0xd2, 0xf7, 0x63, 0x70, 0x82, 0xa5, 0x82, 0x1f, 0x23, 0x18, 0xe2,
-	0x92, 0x16, 0x2b, 0xa1, 0x93, 0xd2, 0xa8, 0xa7, 0xa5, 0x0b, 0xed, 0x3b, 0x70, 0x8e, 0xf2, 0xfc,
-	0xc3, 0x1f, 0x7f, 0xff, 0x3c, 0x7a, 0x16, 0xcf, 0x28, 0x61, 0xf2, 0x9f, 0xeb, 0x69, 0xfc, 0x17,
-	0x82, 0x89, 0xa6, 0xd2, 0x16, 0xa7, 0x5a, 0x07, 0x6f, 0x25, 0xc4, 0xa5, 0x74, 0x4f, 0x18, 0x82,
-	0x53, 0x9a, 0x71, 0xba, 0x82, 0x57, 0x42, 0x39, 0xd5, 0xe7, 0x43, 0xb9, 0xdf, 0xf0, 0x4a, 0x3c,
-	0xc0, 0x1f, 0x46, 0x61, 0x32, 0x44, 0x99, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x2a, 0x4f, 0xa5, 0xf5,
-	0x1e, 0x51, 0x04, 0xe3, 0x4d, 0xc6, 0xf8, 0x36, 0xbe, 0xd9, 0x03, 0x63, 0x85, 0xd4, 0xf1, 0xdd,
-	0xbf, 0x25, 0xf0, 0x01, 0x82, 0x33, 0x01, 0xe2, 0x0f, 0xbf, 0xd2, 0x41, 0xde, 0x0d, 0xf2, 0x54,
-	0xba, 0xd2, 0xa5, 0xb7, 0x60, 0x7b, 0x83, 0xb1, 0xbd, 0x8a, 0x37, 0x7a, 0x61, 0x5b, 0x57, 0x96,
-	0xf8, 0x27, 0x04, 0xa3, 0x47, 0xc5, 0x14, 0x7e, 0xb9, 0x83, 0x1c, 0xfd, 0x42, 0x54, 0xba, 0xdc,
-	0x8d, 0xab, 0xe0, 0x76, 0x8d, 0x71, 0x5b, 0xc7, 0xe9, 0x5e, 0xb8, 0xb9, 0x8a, 0xed, 0x6f, 0x04,
-	0x63, 0x0d, 0x4a, 0x05, 0xb7, 0x91, 0x5e, 0x33, 0x61, 0x26, 0xad, 0x74, 0xe5, 0x2b, 0xb8, 0x65,
-	0x19, 0xb7, 0xb7, 0xf1, 0x66, 0x28, 0xb7, 0xda, 0x23, 0x42, 0x95, 0xfb, 0x0d, 0x6f, 0xd0, 0x03,
-	0x45, 0x9c, 0xcc, 0xc0, 0x99, 0x7d, 0x86, 0xe0, 0xf9, 0x60, 0x35, 0x82, 0x5f, 0xed, 0x24, 0xf1,
-	0x00, 0xfd, 0x24, 0xbd, 0xd6, 0x3d, 0x40, 0x47, 0xad, 0x6d, 0x8f, 0x3e, 0x1b, 0xcc, 0x00, 0x49,
-	0xd0, 0xce, 0x60, 0x36, 0x57, 0x2f, 0xed, 0x0c, 0x66, 0x88, 0x0e, 0x69, 0x73, 0x30, 0x5b, 0x30,
-	0xac, 0x9f, 0x6d, 0xfc, 0x2f, 0x82, 0x58, 0x33, 0xc1, 0x80, 0x57, 0x3b, 0xc8, 0x35, 0x58, 0xe5,
-	0x48, 0xa9, 0x5e, 0x20, 0x04, 0xe7, 0xbb, 0x8c, 0xf3, 0x0d, 0x7c, 0xbd, 0x17, 0xce, 0x47, 0x15,
-	0x0f, 0xfe, 0x06, 0xc1, 0x69, 0x9f, 0x28, 0xc1, 0x17, 0x5b, 0xe7, 0x1a, 0xa4, 0x71, 0xa4, 0x97,
-	0x3a, 0xf6, 0x13, 0xc4, 0x96, 0x19, 0xb1, 0x05, 0x3c, 0x1f, 0x4a, 0x2c, 0xef, 0xfa, 0x66, 0xab,
-	0x32, 0x26, 0x75, 0xed, 0xc9, 0x41, 0x1c, 0x3d, 0x3d, 0x88, 0xa3, 0xdf, 0x0e, 0xe2, 0xe8, 0xd3,
-	0xc3, 0x78, 0xe4, 0xe9, 0x61, 0x3c, 0xf2, 0xf3, 0x61, 0x3c, 0xf2, 0xce, 0x62, 0xa8, 0x30, 0xfa,
-	0xc0, 0x8f, 0xce, 0x74, 0x52, 0x6e, 0x88, 0xfd, 0xeb, 0x70, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0xdf, 0x9a, 0x20, 0x60, 0x15, 0x00, 0x00,
+	// 1334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0x6d, 0x4a, 0x5f, 0x5b, 0x9a, 0x4c, 0x22, 0xe4, 0x6c, 0x82, 0x1d, 0x6d, 0x48,
+	0x13, 0x35, 0x8a, 0xb7, 0x49, 0x44, 0x28, 0x0d, 0x11, 0xc4, 0x4e, 0x42, 0x51, 0xab, 0xfe, 0xb8,
+	0x85, 0x08, 0x50, 0x64, 0xad, 0xbd, 0x1b, 0x7b, 0xc1, 0xde, 0x71, 0xf6, 0x27, 0x21, 0xaa, 0x7a,
+	0x29, 0x42, 0x2a, 0x9c, 0x10, 0x5c, 0x7a, 0xcc, 0x11, 0xc1, 0x05, 0x21, 0x10, 0x47, 0xae, 0x3d,
+	0x56, 0x20, 0x21, 0x4e, 0x80, 0x92, 0x22, 0xca, 0x01, 0x89, 0x1b, 0x57, 0xe4, 0x99, 0x59, 0x7b,
+	0x37, 0x5e, 0xaf, 0xbd, 0x76, 0x2c, 0x71, 0x69, 0xad, 0xd9, 0x79, 0xdf, 0xfb, 0xbe, 0xf7, 0xe6,
+	0xcd, 0x7e, 0x1b, 0x98, 0xcc, 0x13, 0xb3, 0x4c, 0x4c, 0x49, 0xd1, 0x4c, 0xcb, 0xd0, 0x72, 0xb6,
+	0xa5, 0x11, 0x5d, 0xda, 0x9e, 0xcd, 0xa9, 0x96, 0x3c, 0x2b, 0x6d, 0xd9, 0xaa, 0xb1, 0x9b, 0xac,
+	0x18, 0xc4, 0x22, 0x78, 0x84, 0x6d, 0x4c, 0xba, 0x37, 0x26, 0xf9, 0x46, 0xe1, 0x02, 0x47, 0xc9,
+	0xc9, 0xa6, 0xca, 0xa2, 0x6a, 0x18, 0x15, 0xb9, 0xa0, 0xe9, 0x32, 0xdd, 0x4d, 0x81, 0x84, 0xa1,
+	0x02, 0x29, 0x10, 0xfa, 0x53, 0xaa, 0xfe, 0xe2, 0xab, 0xa3, 0x05, 0x42, 0x0a, 0x25, 0x55, 0x92,
+	0x2b, 0x9a, 0x24, 0xeb, 0x3a, 0xb1, 0x68, 0x88, 0xc9, 0x9f, 0xc6, 0xdd, 0xf8, 0x0e, 0x72, 0x9e,
+	0x68, 0x0e, 0x66, 0x32, 0x48, 0x85, 0x87, 0x31, 0xdb, 0x3f, 0xcc, 0xf6, 0x67, 0x19, 0x0d, 0xae,
+	0x8c, 0x3d, 0x1a, 0x90, 0xcb, 0x9a, 0x4e, 0x24, 0xfa, 0x2f, 0x5b, 0x12, 0x87, 0x00, 0xdf, 0xaa,
+	0x6a, 0xba, 0x29, 0x1b, 0x72, 0xd9, 0xcc, 0xa8, 0x5b, 0xb6, 0x6a, 0x5a, 0xe2, 0x06, 0x0c, 0x7a,
+	0x56, 0xcd, 0x0a, 0xd1, 0x4d, 0x15, 0xaf, 0x41, 0x5f, 0x85, 0xae, 0xc4, 0xd0, 0x18, 0x9a, 0x3a,
+	0x3d, 0x37, 0x9e, 0x0c, 0x28, 0x5c, 0x92, 0x05, 0xa7, 0x4e, 0x3d, 0xfa, 0x35, 0x11, 0xf9, 0xe2,
+	0xcf, 0xaf, 0x2f, 0xa0, 0x0c, 0x8f, 0x16, 0x75, 0x98, 0xa0, 0xf0, 0x6f, 0xc9, 0x25, 0x4d, 0x91,
+	0x2d, 0x62, 0xac, 0xb8, 0xe2, 0xdf, 0xd0, 0x37, 0x09, 0xe7, 0x81, 0x57, 0x61, 0x60, 0xdb, 0xd9,
+	0x93, 0x95, 0x15, 0xc5, 0x50, 0x4d, 0x96, 0xfb, 0x54, 0x2a, 0xf6, 0xe3, 0xb7, 0x33, 0x43, 0x3c,
+	0xfd, 0x32, 0x7b, 0x72, 0xdb, 0x32, 0x34, 0xbd, 0x90, 0xe9, 0xaf, 0x85, 0xf0, 0x75, 0xf1, 0x8f,
+	0x28, 0x9c, 0x6f, 0x95, 0x90, 0x4b, 0x4c, 0x43, 0x3f, 0xa9, 0xa8, 0x46, 0xa8, 0x84, 0xe7, 0x9c,
+	0x08, 0xbe, 0x8c, 0xef, 0x23, 0x18, 0x30, 0xd5, 0xd2, 0x66, 0x36, 0x47, 0x74, 0x25, 0x6b, 0xa8,
+	0x3b, 0xb2, 0xa1, 0x98, 0xb1, 0xe8, 0xd8, 0xb1, 0xa9, 0xd3, 0x73, 0xa3, 0x4e, 0xcd, 0xaa, 0xfd,
+	0xae, 0xd5, 0x6a, 0x45, 0xcd, 0xa7, 0x89, 0xa6, 0xa7, 0x2e, 0x55, 0x8b, 0xf5, 0xe5, 0x6f, 0x89,
+	0xe9, 0x82, 0x66, 0x15, 0xed, 0x5c, 0x32, 0x4f, 0xca, 0xbc, 0x85, 0xfc, 0xbf, 0x19, 0x53, 0x79,
+	0x5f, 0xb2, 0x76, 0x2b, 0xaa, 0xe9, 0xc4, 0x98, 0xac, 0xb6, 0xe7, 0xaa, 0x09, 0x53, 0x44, 0x57,
+	0x32, 0x2c, 0x1d, 0xde, 0x02, 0xc8, 0x93, 0x72, 0x59, 0x33, 0x4d, 0x8d, 0xe8, 0xb1, 0x63, 0x6d,
+	0x24, 0x9f, 0xef, 0x20, 0x79, 0xc6, 0x95, 0x44, 0xac, 0xc0, 0xa4, 0xb7, 0xcc, 0x37, 0x6c, 0xcb,
+	0xb4, 0x64, 0x5d, 0xa9, 0x56, 0x89, 0xd1, 0x3a, 0xe2, 0xce, 0x7e, 0x8c, 0x60, 0xaa, 0x75, 0x4a,
+	0xde, 0xdb, 0x0d, 0x38, 0xe9, 0xf4, 0x82, 0x9d, 0xdf, 0x4b, 0x81, 0xe7, 0x37, 0x00, 0xd2, 0x7d,
+	0xa8, 0x1d, 0x4c, 0xb1, 0x08, 0x09, 0x2f, 0x95, 0x74, 0xad, 0x32, 0x47, 0xac, 0xfa, 0x13, 0x04,
+	0x63, 0xcd, 0x53, 0x71, 0xb5, 0x9b, 0x9e, 0xfe, 0x33, 0xc1, 0x8b, 0xed, 0x09, 0x5e, 0xce, 0xe7,
+	0xed, 0xb2, 0x5d, 0x92, 0x2d, 0x55, 0xa9, 0x03, 0xbb, 0x35, 0xbb, 0x9b, 0xfe, 0x51, 0x14, 0x46,
+	0xbd, 0x64, 0x6e, 0x97, 0x64, 0xb3, 0xa8, 0x1e, 0x71, 0xab, 0xf1, 0x24, 0x9c, 0x33, 0x2d, 0xd9,
+	0xb0, 0x34, 0xbd, 0x90, 0x2d, 0xaa, 0x5a, 0xa1, 0x68, 0xc5, 0xa2, 0x63, 0x68, 0xea, 0x78, 0xe6,
+	0x59, 0x67, 0xf9, 0x0a, 0x5d, 0xc5, 0xe3, 0x70, 0x56, 0xd5, 0x15, 0xd7, 0xb6, 0x63, 0x74, 0xdb,
+	0x19, 0xb6, 0xc8, 0x37, 0xad, 0x01, 0xd4, 0x6f, 0xef, 0xd8, 0x71, 0x5a, 0x9d, 0xf3, 0x9e, 0xe9,
+	0x60, 0x2f, 0x88, 0xfa, 0x65, 0x56, 0x50, 0xb9, 0xa0, 0x8c, 0x2b, 0xf2, 0xf2, 0x33, 0x0f, 0xf6,
+	0x12, 0x91, 0x87, 0x7b, 0x09, 0x24, 0xfe, 0x80, 0xe0, 0xf9, 0x26, 0x75, 0xe0, 0x1d, 0x79, 0x13,
+	0x4e, 0x9a, 0x6c, 0x29, 0x86, 0xe8, 0x38, 0x5e, 0x6c, 0xaf, 0x1d, 0x14, 0x67, 0x75, 0x5b, 0xd5,
+	0x2d, 0xcf, 0xb9, 0xe3, 0x58, 0xf8, 0x75, 0x8f, 0x94, 0x28, 0x95, 0x32, 0xd9, 0x52, 0x0a, 0xe3,
+	0xe4, 0xd6, 0x22, 0x7e, 0xef, 0x28, 0x58, 0x51, 0x4b, 0x6a, 0x81, 0xae, 0x35, 0x4e, 0xad, 0xc2,
+	0x9e, 0x85, 0x69, 0x65, 0x2d, 0xc4, 0x69, 0xa5, 0xef, 0x89, 0x88, 0x86, 0x3d, 0x11, 0xac, 0xf6,
+	0x4f, 0xf7, 0x12, 0x11, 0xf1, 0x33, 0x04, 0xf1, 0x66, 0xcc, 0x79, 0xf1, 0x2b, 0xee, 0xe1, 0xef,
+	0xe5, 0x45, 0x5c, 0xbb, 0x0f, 0x6c, 0x10, 0x0f, 0x71, 0xba, 0x43, 0x2c, 0xb9, 0xd4, 0x93, 0x92,
+	0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xf1, 0xc0, 0xbc, 0xbc, 0x20, 0xef, 0x1e, 0x2e, 0xc8, 0x42, 0xe0,
+	0x69, 0xac, 0xa3, 0xad, 0x38, 0xb9, 0x19, 0xa2, 0xdf, 0x5d, 0x88, 0x4b, 0x70, 0xc2, 0xaa, 0x26,
+	0xed, 0xf1, 0x4b, 0x8f, 0x25, 0x11, 0x0d, 0x7e, 0xf3, 0xd6, 0x98, 0xd5, 0x46, 0xa7, 0x77, 0x65,
+	0xbe, 0x06, 0x63, 0xcd, 0x73, 0xf2, 0x12, 0xc7, 0x01, 0x6a, 0x87, 0x96, 0x55, 0xf9, 0x54, 0xc6,
+	0xb5, 0xe2, 0x42, 0xdb, 0x81, 0x17, 0xbc, 0x68, 0xeb, 0x9a, 0x55, 0x54, 0x0c, 0x79, 0x87, 0x27,
+	0xee, 0x99, 0x8c, 0x6d, 0x98, 0x68, 0x91, 0xb8, 0x6e, 0x8c, 0x76, 0xf8, 0xa3, 0xf6, 0x8d, 0xd1,
+	0x8e, 0x17, 0xcc, 0x95, 0x77, 0x04, 0x86, 0x69, 0xde, 0xea, 0xfb, 0xc5, 0xd6, 0x35, 0x6b, 0xf7,
+	0x26, 0x21, 0x25, 0xc7, 0x7e, 0x3e, 0x40, 0x20, 0xf8, 0x3d, 0xe5, 0x54, 0xde, 0x83, 0xe3, 0x15,
+	0x42, 0x4a, 0x3d, 0x9e, 0x63, 0x9a, 0x43, 0xfc, 0x06, 0x41, 0x8c, 0x52, 0x59, 0xb6, 0x2d, 0x92,
+	0x26, 0xe5, 0x0a, 0xb1, 0x75, 0x85, 0xf3, 0xfc, 0xdf, 0x5e, 0x87, 0x04, 0x86, 0x7d, 0x38, 0xf3,
+	0xea, 0x65, 0xa0, 0xcf, 0x50, 0xf3, 0xc4, 0x50, 0xb8, 0x27, 0x90, 0x02, 0xc7, 0xde, 0x0b, 0x91,
+	0x27, 0xde, 0x79, 0xe7, 0x48, 0x73, 0x5f, 0x0d, 0xc2, 0x09, 0x9a, 0x11, 0x3f, 0x44, 0xd0, 0xc7,
+	0x8c, 0x3f, 0x0e, 0x06, 0x6e, 0xfc, 0xea, 0x10, 0x2e, 0xb6, 0x1f, 0xc0, 0xb4, 0x88, 0xd3, 0xf7,
+	0x7f, 0x7a, 0xf2, 0x79, 0x74, 0x02, 0x8f, 0x4b, 0x41, 0x1f, 0x49, 0xec, 0xab, 0x03, 0xff, 0x85,
+	0x60, 0xb8, 0xe9, 0x07, 0x00, 0x4e, 0xb5, 0x4e, 0xde, 0xea, 0x73, 0x45, 0x48, 0x77, 0x85, 0xc1,
+	0x35, 0xa5, 0xa9, 0xa6, 0x25, 0xbc, 0x18, 0xa8, 0xa9, 0x7e, 0x8b, 0x48, 0x77, 0x1b, 0x0e, 0xcf,
+	0x3d, 0xfc, 0x61, 0x14, 0x46, 0x02, 0xfc, 0x2b, 0x5e, 0x09, 0xc1, 0xb4, 0xa9, 0x89, 0x17, 0x56,
+	0xbb, 0x44, 0xe1, 0x8a, 0xd7, 0xa9, 0xe2, 0x5b, 0xf8, 0x46, 0x17, 0x8a, 0x25, 0x52, 0xc7, 0x77,
+	0xbe, 0xb8, 0xf0, 0x3e, 0x82, 0x41, 0x1f, 0x8b, 0x8c, 0x5f, 0x09, 0xc1, 0xbb, 0xc1, 0xc4, 0x0b,
+	0x4b, 0x1d, 0x46, 0x73, 0xb5, 0xd7, 0xa9, 0xda, 0x2b, 0x78, 0xad, 0x1b, 0xb5, 0x75, 0xff, 0x8d,
+	0x7f, 0x46, 0xd0, 0x7f, 0xd8, 0x72, 0xe2, 0x97, 0x43, 0x70, 0xf4, 0xda, 0x75, 0xe1, 0x72, 0x27,
+	0xa1, 0x5c, 0xdb, 0x55, 0xaa, 0x6d, 0x15, 0xa7, 0xbb, 0xd1, 0xe6, 0xf8, 0xda, 0xbf, 0x11, 0x0c,
+	0x34, 0xf8, 0x39, 0xdc, 0x06, 0xbd, 0x66, 0xf6, 0x55, 0x58, 0xec, 0x28, 0x96, 0x6b, 0xcb, 0x52,
+	0x6d, 0x6f, 0xe3, 0xf5, 0x40, 0x6d, 0xb5, 0xcb, 0xdd, 0x94, 0xee, 0x36, 0xbc, 0x1b, 0xee, 0x49,
+	0xfc, 0x64, 0xfa, 0xce, 0xec, 0x53, 0x04, 0xcf, 0xf9, 0x7b, 0x36, 0xfc, 0x6a, 0x18, 0xe2, 0x3e,
+	0x2e, 0x53, 0x78, 0xad, 0x73, 0x80, 0x50, 0xad, 0x6d, 0x4f, 0x3e, 0x1d, 0x4c, 0x1f, 0xe3, 0xd4,
+	0xce, 0x60, 0x36, 0xf7, 0x78, 0xc2, 0x52, 0x87, 0xd1, 0xa1, 0x06, 0xb3, 0x85, 0xc2, 0xfa, 0xd9,
+	0xc6, 0xff, 0x22, 0x88, 0x35, 0xb3, 0x55, 0x78, 0x39, 0x04, 0x57, 0x7f, 0x2f, 0x28, 0xa4, 0xba,
+	0x81, 0xe0, 0x9a, 0xef, 0x50, 0xcd, 0xd7, 0xf1, 0xb5, 0x6e, 0x34, 0x1f, 0xf6, 0x85, 0xf8, 0x3b,
+	0x04, 0x67, 0x3d, 0xd6, 0x0d, 0x2f, 0xb4, 0xe6, 0xea, 0xe7, 0x04, 0x85, 0x97, 0x42, 0xc7, 0x71,
+	0x61, 0xf3, 0x54, 0xd8, 0x0c, 0x9e, 0x0e, 0x14, 0x96, 0x77, 0x62, 0xb3, 0x55, 0xb3, 0x87, 0x9f,
+	0x20, 0x38, 0xe3, 0x36, 0x3c, 0xf8, 0xc5, 0xd6, 0xe9, 0x7d, 0x7c, 0xa1, 0xb0, 0x10, 0x36, 0x8c,
+	0x93, 0x56, 0x29, 0xe9, 0x2c, 0xde, 0xe8, 0xa6, 0x1b, 0xb2, 0x6d, 0x91, 0x6c, 0x9e, 0x43, 0xfb,
+	0x5d, 0x34, 0xa9, 0xab, 0x8f, 0xf6, 0xe3, 0xe8, 0xf1, 0x7e, 0x1c, 0xfd, 0xbe, 0x1f, 0x47, 0x9f,
+	0x1e, 0xc4, 0x23, 0x8f, 0x0f, 0xe2, 0x91, 0x5f, 0x0e, 0xe2, 0x91, 0x77, 0x66, 0x03, 0x5d, 0xf2,
+	0x07, 0x5e, 0x3e, 0xd4, 0x34, 0xe7, 0xfa, 0xe8, 0xdf, 0x91, 0xe7, 0xff, 0x1b, 0x00, 0x38, 0x26,
+	0x1e, 0xc2, 0x6d, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1095,6 +1191,8 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding status and history of a delegation.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -1195,6 +1293,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1218,6 +1325,8 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding status and history of a delegation.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1254,6 +1363,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompound(ctx context.Context, req *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1439,6 +1551,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1483,6 +1613,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2196,6 +2330,76 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2499,6 +2703,34 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4336,6 +4568,203 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
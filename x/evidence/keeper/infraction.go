@@ -74,6 +74,9 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		}
 	}
 
+	// the signing info follows the validator across consensus pubkey rotations
+	consAddr = k.stakingKeeper.ValidatorIdentifier(ctx, consAddr)
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
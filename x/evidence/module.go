@@ -25,12 +25,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/simulation"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
 var (
-	_ module.BeginBlockAppModule = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.BeginBlockAppModule         = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -164,6 +166,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// DefaultMsgGasParams returns the default gas params of the evidence msgs.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgSubmitEvidence{}), 1.2e3),
+	}
+}
+
 // BeginBlock executes all ABCI BeginBlock logic respective to the evidence module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	BeginBlocker(ctx, req, am.keeper)
@@ -16,6 +16,7 @@ type (
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryUsageByGranter(),
+		GetCmdQueryParams(),
 	)
 
 	return feegrantQueryCmd
@@ -175,3 +177,75 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryUsageByGranter returns cmd to query for the usage ledgers of the grants given by a granter.
+func GetCmdQueryUsageByGranter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage-by-granter [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the usage of all grants by a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the usage ledgers of all the grants issued for a granter address.
+
+Example:
+$ %s query feegrant usage-by-granter [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UsageByGranter(
+				cmd.Context(),
+				&feegrant.QueryUsageByGranterRequest{
+					Granter:    granterAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "usages")
+
+	return cmd
+}
+
+// GetCmdQueryParams returns cmd to query for the parameters of the feegrant module.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current feegrant parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &feegrant.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
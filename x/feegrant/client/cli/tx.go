@@ -17,11 +17,12 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration    = "expiration"
+	FlagPeriod        = "period"
+	FlagPeriodLimit   = "period-limit"
+	FlagSpendLimit    = "spend-limit"
+	FlagAllowedMsgs   = "allowed-messages"
+	FlagAllowWrappers = "allow-wrapper-messages"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -154,10 +155,16 @@ Examples:
 			}
 
 			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+				allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
 				}
+
+				allowedMsgAllowance.AllowWrapperMsgs, err = cmd.Flags().GetBool(FlagAllowWrappers)
+				if err != nil {
+					return err
+				}
+				grant = allowedMsgAllowance
 			}
 
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
@@ -171,6 +178,7 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().Bool(FlagAllowWrappers, false, "Allow the messages wrapping allowed messages, like the authz MsgExec, with the allowed messages")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
@@ -184,6 +184,10 @@ type AllowedMsgAllowance struct {
 	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
 	// allowed_messages are the messages for which the grantee has the access.
 	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// allow_wrapper_msgs defines whether the grantee has the access for the messages
+	// wrapping other messages, like the authz MsgExec. The wrapped messages must be
+	// allowed too. A wrapper message whose type is in allowed_messages is also allowed.
+	AllowWrapperMsgs bool `protobuf:"varint,3,opt,name=allow_wrapper_msgs,json=allowWrapperMsgs,proto3" json:"allow_wrapper_msgs,omitempty"`
 }
 
 func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
@@ -227,6 +231,9 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *types1.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// usage is the usage ledger of the grant, it is only recorded when the usage ledger is enabled in
+	// the params.
+	Usage *GrantUsage `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -283,11 +290,149 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+func (m *Grant) GetUsage() *GrantUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// GrantUsage is the usage ledger of a grant, recording the fees paid by the grant.
+type GrantUsage struct {
+	// total_spent is the amount of fees paid by the grant since it was granted.
+	TotalSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spent"`
+	// period_spent is the amount of fees paid by the grant in the current period of its periodic
+	// allowance. It is empty if the allowance is not periodic.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
+	// period_reset is the reset time of the period period_spent is recorded for.
+	PeriodReset *time.Time `protobuf:"bytes,3,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// use_count is the number of txs whose fees were paid by the grant.
+	UseCount uint64 `protobuf:"varint,4,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	// last_used_height is the height of the last tx whose fees were paid by the grant.
+	LastUsedHeight int64 `protobuf:"varint,5,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+}
+
+func (m *GrantUsage) Reset()         { *m = GrantUsage{} }
+func (m *GrantUsage) String() string { return proto.CompactTextString(m) }
+func (*GrantUsage) ProtoMessage()    {}
+func (*GrantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *GrantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantUsage.Merge(m, src)
+}
+func (m *GrantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantUsage proto.InternalMessageInfo
+
+func (m *GrantUsage) GetTotalSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpent
+	}
+	return nil
+}
+
+func (m *GrantUsage) GetPeriodSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpent
+	}
+	return nil
+}
+
+func (m *GrantUsage) GetPeriodReset() *time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return nil
+}
+
+func (m *GrantUsage) GetUseCount() uint64 {
+	if m != nil {
+		return m.UseCount
+	}
+	return 0
+}
+
+func (m *GrantUsage) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+// Params defines the parameters of the feegrant module.
+type Params struct {
+	// usage_ledger_enabled enables the recording of the usage ledger of the grants.
+	UsageLedgerEnabled bool `protobuf:"varint,1,opt,name=usage_ledger_enabled,json=usageLedgerEnabled,proto3" json:"usage_ledger_enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetUsageLedgerEnabled() bool {
+	if m != nil {
+		return m.UsageLedgerEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*GrantUsage)(nil), "cosmos.feegrant.v1beta1.GrantUsage")
+	proto.RegisterType((*Params)(nil), "cosmos.feegrant.v1beta1.Params")
 }
 
 func init() {
@@ -295,47 +440,59 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xb6, 0x28, 0x17, 0x28, 0xad, 0xa9, 0x84, 0x53, 0x21, 0xbb, 0x8a, 0x04, 0x4d,
-	0x2b, 0xd5, 0x56, 0x8b, 0x58, 0x3a, 0x35, 0x2e, 0xa2, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90, 0x50,
-	0x74, 0xb6, 0xaf, 0xe6, 0x44, 0xec, 0x33, 0x3e, 0x17, 0x1a, 0x06, 0x66, 0xc4, 0x80, 0x32, 0x32,
-	0x32, 0x22, 0xa6, 0x0e, 0xe5, 0x3b, 0x54, 0x0c, 0xa8, 0x62, 0x62, 0x22, 0x28, 0x19, 0x3a, 0xf3,
-	0x0d, 0x90, 0xef, 0xce, 0x8e, 0x9b, 0x50, 0x68, 0x25, 0xba, 0x24, 0x77, 0xef, 0xde, 0xfb, 0xfd,
-	0x79, 0xef, 0x45, 0x01, 0xb7, 0x1c, 0x42, 0x7d, 0x42, 0x8d, 0x1d, 0x84, 0xbc, 0x08, 0x06, 0xb1,
-	0xf1, 0x62, 0xc9, 0x46, 0x31, 0x5c, 0xca, 0x02, 0x7a, 0x18, 0x91, 0x98, 0xc8, 0xd7, 0x79, 0x9e,
-	0x9e, 0x85, 0x45, 0xde, 0xcc, 0xb4, 0x47, 0x3c, 0xc2, 0x72, 0x8c, 0xe4, 0xc4, 0xd3, 0x67, 0x2a,
-	0x1e, 0x21, 0x5e, 0x13, 0x19, 0xec, 0x66, 0xef, 0xee, 0x18, 0x30, 0x68, 0xa5, 0x4f, 0x1c, 0xa9,
-	0xc1, 0x6b, 0x04, 0x2c, 0x7f, 0x52, 0x85, 0x18, 0x1b, 0x52, 0x94, 0x09, 0x71, 0x08, 0x0e, 0xc4,
-	0xfb, 0x14, 0xf4, 0x71, 0x40, 0x0c, 0xf6, 0x29, 0x42, 0xda, 0x20, 0x51, 0x8c, 0x7d, 0x44, 0x63,
-	0xe8, 0x87, 0x29, 0xe6, 0x60, 0x82, 0xbb, 0x1b, 0xc1, 0x18, 0x13, 0x81, 0x59, 0x7d, 0x37, 0x02,
-	0x26, 0x4c, 0x48, 0xb1, 0x53, 0x6f, 0x36, 0xc9, 0x4b, 0x18, 0x38, 0x48, 0x7e, 0x0e, 0xca, 0x34,
-	0x44, 0x81, 0xdb, 0x68, 0x62, 0x1f, 0xc7, 0x8a, 0x34, 0x5b, 0xac, 0x95, 0x97, 0x2b, 0xba, 0x90,
-	0x9a, 0x88, 0x4b, 0xdd, 0xeb, 0x6b, 0x04, 0x07, 0xe6, 0x9d, 0xc3, 0x1f, 0x5a, 0xe1, 0x53, 0x47,
-	0xab, 0x79, 0x38, 0x7e, 0xba, 0x6b, 0xeb, 0x0e, 0xf1, 0x85, 0x2f, 0xf1, 0xb5, 0x48, 0xdd, 0x67,
-	0x46, 0xdc, 0x0a, 0x11, 0x65, 0x05, 0xf4, 0xe3, 0xf1, 0xfe, 0x82, 0x64, 0x01, 0x46, 0xb2, 0x91,
-	0x70, 0xc8, 0xab, 0x00, 0xa0, 0xbd, 0x10, 0x73, 0x65, 0xca, 0xc8, 0xac, 0x54, 0x2b, 0x2f, 0xcf,
-	0xe8, 0x5c, 0xba, 0x9e, 0x4a, 0xd7, 0x1f, 0xa5, 0xde, 0xcc, 0xd1, 0x76, 0x47, 0x93, 0xac, 0x5c,
-	0xcd, 0xca, 0xfa, 0x97, 0x83, 0xc5, 0x9b, 0xa7, 0x0c, 0x49, 0xbf, 0x87, 0x50, 0x66, 0xef, 0xc1,
-	0xdb, 0xe3, 0xfd, 0x85, 0x4a, 0x4e, 0xd8, 0x49, 0xf7, 0xd5, 0xcf, 0xa3, 0x60, 0x6a, 0x0b, 0x45,
-	0x98, 0xb8, 0xf9, 0x9e, 0xdc, 0x07, 0x63, 0x76, 0x92, 0xa7, 0x48, 0x4c, 0xdb, 0x9c, 0x7e, 0x1a,
-	0xd5, 0x49, 0x34, 0xb3, 0x94, 0xf4, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x2a, 0x18, 0x0f, 0x19, 0xbc,
-	0xb0, 0x59, 0x19, 0xb2, 0x79, 0x57, 0x4c, 0xc8, 0xbc, 0x92, 0x14, 0xbf, 0xef, 0x68, 0x12, 0x07,
-	0x10, 0x75, 0xf2, 0x6b, 0x20, 0xf3, 0x53, 0x23, 0x3f, 0xa6, 0xe2, 0x05, 0x8d, 0x69, 0x92, 0x73,
-	0x6d, 0xf7, 0x87, 0xf5, 0x0a, 0x88, 0x58, 0xc3, 0x81, 0x01, 0xd7, 0xa0, 0x8c, 0x5e, 0x10, 0xfb,
-	0x04, 0x67, 0x5a, 0x83, 0x01, 0x13, 0x20, 0x6f, 0x80, 0xcb, 0x82, 0x3b, 0x42, 0x14, 0xc5, 0xca,
-	0xd8, 0x3f, 0x57, 0x85, 0x35, 0xb1, 0x9d, 0x35, 0xb1, 0xcc, 0xcb, 0xad, 0xa4, 0x7a, 0xe5, 0xe1,
-	0xb9, 0x96, 0xe6, 0x46, 0x4e, 0xe8, 0xd0, 0x86, 0x54, 0x7f, 0x49, 0xe0, 0x1a, 0xbb, 0x21, 0x77,
-	0x93, 0x7a, 0xfd, 0xcd, 0x79, 0x02, 0x4a, 0x30, 0xbd, 0x88, 0xed, 0x99, 0x1e, 0x92, 0x5b, 0x0f,
-	0x5a, 0xe6, 0xfc, 0x99, 0xc5, 0x58, 0x7d, 0x44, 0x79, 0x1e, 0x4c, 0x42, 0xce, 0xda, 0xf0, 0x11,
-	0xa5, 0xd0, 0x43, 0x54, 0x19, 0x99, 0x2d, 0xd6, 0x4a, 0xd6, 0x55, 0x11, 0xdf, 0x14, 0xe1, 0x95,
-	0xad, 0x37, 0x1f, 0xb4, 0xc2, 0xb9, 0x1c, 0xab, 0x39, 0xc7, 0x7f, 0xf0, 0x56, 0xfd, 0x2a, 0x81,
-	0xb1, 0xf5, 0x04, 0x42, 0x5e, 0x06, 0x97, 0x18, 0x16, 0x8a, 0x98, 0xc7, 0x92, 0xa9, 0x7c, 0x3b,
-	0x58, 0x9c, 0x16, 0x44, 0x75, 0xd7, 0x8d, 0x10, 0xa5, 0xdb, 0x71, 0x84, 0x03, 0xcf, 0x4a, 0x13,
-	0xfb, 0x35, 0x88, 0xfd, 0x14, 0xce, 0x50, 0x33, 0xd0, 0xcd, 0xe2, 0xff, 0xee, 0xa6, 0x59, 0x3f,
-	0xec, 0xaa, 0xd2, 0x51, 0x57, 0x95, 0x7e, 0x76, 0x55, 0xa9, 0xdd, 0x53, 0x0b, 0x47, 0x3d, 0xb5,
-	0xf0, 0xbd, 0xa7, 0x16, 0x1e, 0xcf, 0xfd, 0x75, 0x6f, 0xf7, 0xb2, 0xff, 0x0b, 0x7b, 0x9c, 0xc9,
-	0xb8, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x3d, 0x09, 0x1d, 0x5a, 0x06, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0x33, 0x45,
+	0x18, 0xee, 0x76, 0x5b, 0xa4, 0x53, 0x45, 0x18, 0x9b, 0xb8, 0x45, 0xb3, 0xad, 0x35, 0x4a, 0x21,
+	0xb2, 0x15, 0x8c, 0x07, 0x7b, 0xa2, 0x5b, 0x15, 0x34, 0x90, 0x90, 0x45, 0x62, 0x62, 0x42, 0x36,
+	0xd3, 0xee, 0xb0, 0x6c, 0xdc, 0xdd, 0x59, 0x77, 0x66, 0x05, 0x3c, 0x18, 0x8f, 0xea, 0xc1, 0x70,
+	0xf4, 0xa6, 0x47, 0xe3, 0x89, 0x03, 0xfe, 0x07, 0xe2, 0x89, 0x78, 0xf2, 0x24, 0x06, 0x0e, 0xfc,
+	0x8d, 0x2f, 0x3b, 0x33, 0xed, 0x96, 0xf2, 0xf1, 0x7d, 0x90, 0x7c, 0xbd, 0xc0, 0xce, 0x3b, 0xef,
+	0xfb, 0x3c, 0xcf, 0xfb, 0xcc, 0xcc, 0x9b, 0x82, 0x77, 0xfb, 0x84, 0x06, 0x84, 0xb6, 0xf6, 0x31,
+	0x76, 0x63, 0x14, 0xb2, 0xd6, 0xb7, 0x2b, 0x3d, 0xcc, 0xd0, 0xca, 0x30, 0x60, 0x44, 0x31, 0x61,
+	0x04, 0xbe, 0x2e, 0xf2, 0x8c, 0x61, 0x58, 0xe6, 0xcd, 0x57, 0x5c, 0xe2, 0x12, 0x9e, 0xd3, 0x4a,
+	0xbf, 0x44, 0xfa, 0x7c, 0xd5, 0x25, 0xc4, 0xf5, 0x71, 0x8b, 0xaf, 0x7a, 0xc9, 0x7e, 0x0b, 0x85,
+	0xc7, 0x83, 0x2d, 0x81, 0x64, 0x8b, 0x1a, 0x09, 0x2b, 0xb6, 0x74, 0x29, 0xa6, 0x87, 0x28, 0x1e,
+	0x0a, 0xe9, 0x13, 0x2f, 0x94, 0xfb, 0x73, 0x28, 0xf0, 0x42, 0xd2, 0xe2, 0x7f, 0x65, 0xa8, 0x36,
+	0x4e, 0xc4, 0xbc, 0x00, 0x53, 0x86, 0x82, 0x68, 0x80, 0x39, 0x9e, 0xe0, 0x24, 0x31, 0x62, 0x1e,
+	0x91, 0x98, 0x8d, 0x5f, 0xf2, 0x60, 0xc6, 0x44, 0xd4, 0xeb, 0x77, 0x7c, 0x9f, 0x1c, 0xa2, 0xb0,
+	0x8f, 0xe1, 0x37, 0xa0, 0x4c, 0x23, 0x1c, 0x3a, 0xb6, 0xef, 0x05, 0x1e, 0xd3, 0x94, 0xba, 0xda,
+	0x2c, 0xaf, 0x56, 0x0d, 0x29, 0x35, 0x15, 0x37, 0xe8, 0xde, 0xe8, 0x12, 0x2f, 0x34, 0x3f, 0x3c,
+	0xff, 0xaf, 0x96, 0xfb, 0xf3, 0xb2, 0xd6, 0x74, 0x3d, 0x76, 0x90, 0xf4, 0x8c, 0x3e, 0x09, 0x64,
+	0x5f, 0xf2, 0xdf, 0x32, 0x75, 0xbe, 0x6e, 0xb1, 0xe3, 0x08, 0x53, 0x5e, 0x40, 0xff, 0xb8, 0x39,
+	0x5d, 0x52, 0x2c, 0xc0, 0x49, 0x36, 0x53, 0x0e, 0xb8, 0x06, 0x00, 0x3e, 0x8a, 0x3c, 0xa1, 0x4c,
+	0xcb, 0xd7, 0x95, 0x66, 0x79, 0x75, 0xde, 0x10, 0xd2, 0x8d, 0x81, 0x74, 0xe3, 0x8b, 0x41, 0x6f,
+	0x66, 0xe1, 0xe4, 0xb2, 0xa6, 0x58, 0x23, 0x35, 0xed, 0xf5, 0xbf, 0xcf, 0x96, 0xdf, 0xb9, 0xe7,
+	0x90, 0x8c, 0x4f, 0x31, 0x1e, 0xb6, 0xf7, 0xd9, 0xcf, 0x37, 0xa7, 0x4b, 0xd5, 0x11, 0x61, 0xb7,
+	0xbb, 0x6f, 0xfc, 0x55, 0x00, 0x73, 0xdb, 0x38, 0xf6, 0x88, 0x33, 0xea, 0xc9, 0x06, 0x28, 0xf6,
+	0xd2, 0x3c, 0x4d, 0xe1, 0xda, 0x16, 0x8c, 0xfb, 0xa8, 0x6e, 0xa3, 0x99, 0xa5, 0xd4, 0x1b, 0xd1,
+	0xaf, 0x00, 0x80, 0x6b, 0x60, 0x2a, 0xe2, 0xf0, 0xb2, 0xcd, 0xea, 0x9d, 0x36, 0x3f, 0x96, 0x27,
+	0x64, 0xbe, 0x92, 0x16, 0xff, 0x7a, 0x59, 0x53, 0x04, 0x80, 0xac, 0x83, 0xdf, 0x03, 0x28, 0xbe,
+	0xec, 0xd1, 0x63, 0x52, 0x27, 0x74, 0x4c, 0xb3, 0x82, 0x6b, 0x27, 0x3b, 0xac, 0xef, 0x80, 0x8c,
+	0xd9, 0x7d, 0x14, 0x0a, 0x0d, 0x5a, 0x61, 0x42, 0xec, 0x33, 0x82, 0xa9, 0x8b, 0x42, 0x2e, 0x00,
+	0x6e, 0x82, 0x97, 0x25, 0x77, 0x8c, 0x29, 0x66, 0x5a, 0xf1, 0xb9, 0x57, 0x85, 0x9b, 0x78, 0x32,
+	0x34, 0xb1, 0x2c, 0xca, 0xad, 0xb4, 0xba, 0xfd, 0xf9, 0xa3, 0x2e, 0xcd, 0x9b, 0x23, 0x42, 0xef,
+	0xdc, 0x90, 0xc6, 0x6f, 0x79, 0xf0, 0x1a, 0x5f, 0x61, 0x67, 0x8b, 0xba, 0xd9, 0xcd, 0xd9, 0x03,
+	0x25, 0x34, 0x58, 0xc8, 0xdb, 0x53, 0xb9, 0x23, 0xb7, 0x13, 0x1e, 0x9b, 0x8b, 0x0f, 0x16, 0x63,
+	0x65, 0x88, 0x70, 0x11, 0xcc, 0x22, 0xc1, 0x6a, 0x07, 0x98, 0x52, 0xe4, 0x62, 0xaa, 0xe5, 0xeb,
+	0x6a, 0xb3, 0x64, 0xbd, 0x2a, 0xe3, 0x5b, 0x32, 0x0c, 0xdf, 0x03, 0x90, 0x87, 0xec, 0xc3, 0x18,
+	0x45, 0x11, 0x8e, 0xed, 0x80, 0xba, 0x54, 0x53, 0xeb, 0x4a, 0x73, 0xda, 0x12, 0x20, 0x5f, 0x8a,
+	0x8d, 0x2d, 0xea, 0xd2, 0xf6, 0xf6, 0x8f, 0xbf, 0xd7, 0x72, 0x8f, 0xf2, 0x47, 0x1f, 0xf1, 0xe7,
+	0x29, 0x4e, 0x34, 0x7e, 0xca, 0x83, 0xe2, 0x7a, 0x0a, 0x01, 0x57, 0xc1, 0x4b, 0x1c, 0x0b, 0xc7,
+	0xdc, 0x91, 0x92, 0xa9, 0xfd, 0x73, 0xb6, 0x5c, 0x91, 0x44, 0x1d, 0xc7, 0x89, 0x31, 0xa5, 0x3b,
+	0x2c, 0xf6, 0x42, 0xd7, 0x1a, 0x24, 0x66, 0x35, 0x58, 0xcb, 0x3f, 0xac, 0x66, 0xcc, 0x7b, 0xf5,
+	0x85, 0x7b, 0xff, 0x11, 0x28, 0x26, 0xa9, 0xb5, 0x5a, 0x81, 0x43, 0xbf, 0x7d, 0xef, 0x50, 0xe0,
+	0x5d, 0xef, 0xa6, 0xa9, 0x96, 0xa8, 0x68, 0xfc, 0xa0, 0x02, 0x90, 0x45, 0xd3, 0x91, 0xcb, 0x08,
+	0x43, 0x3e, 0x7f, 0x4d, 0x13, 0x1c, 0xb9, 0x9c, 0x24, 0x7d, 0x49, 0x0c, 0xd2, 0xe1, 0x4b, 0x12,
+	0x9c, 0xf9, 0x09, 0x71, 0x96, 0xb3, 0xf9, 0xc1, 0x60, 0x77, 0xec, 0xf9, 0xaa, 0x0f, 0x9c, 0xf4,
+	0xa3, 0xaf, 0x16, 0xbe, 0x01, 0x4a, 0x09, 0xc5, 0x76, 0x9f, 0x24, 0x21, 0xe3, 0xd6, 0x17, 0xac,
+	0xe9, 0x84, 0xe2, 0x6e, 0xba, 0x86, 0x4d, 0x30, 0xeb, 0x23, 0xca, 0xec, 0x84, 0x62, 0xc7, 0x3e,
+	0xc0, 0x9e, 0x7b, 0x20, 0x86, 0x84, 0x6a, 0xcd, 0xa4, 0xf1, 0x5d, 0x8a, 0x9d, 0x0d, 0x1e, 0x6d,
+	0xec, 0x81, 0xa9, 0x6d, 0x14, 0xa3, 0x80, 0xc2, 0xf7, 0x41, 0x85, 0x9f, 0x8a, 0xed, 0x63, 0xc7,
+	0xc5, 0xb1, 0x8d, 0x43, 0xd4, 0xf3, 0xb1, 0xc3, 0xef, 0xe6, 0xb4, 0x05, 0xf9, 0xde, 0x26, 0xdf,
+	0xfa, 0x44, 0xec, 0xb4, 0xdf, 0x1a, 0x9f, 0x07, 0x47, 0xd9, 0x2f, 0x08, 0x01, 0x6a, 0x76, 0xce,
+	0xaf, 0x74, 0xe5, 0xe2, 0x4a, 0x57, 0xfe, 0xbf, 0xd2, 0x95, 0x93, 0x6b, 0x3d, 0x77, 0x71, 0xad,
+	0xe7, 0xfe, 0xbd, 0xd6, 0x73, 0x5f, 0x2d, 0x3c, 0xd3, 0xc0, 0x0c, 0xa9, 0x37, 0xc5, 0xfd, 0xf8,
+	0xe0, 0xc9, 0x00, 0x26, 0xf5, 0x80, 0xa4, 0xa5, 0x08, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -482,6 +639,16 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowWrapperMsgs {
+		i--
+		if m.AllowWrapperMsgs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowedMessages) > 0 {
 		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedMessages[iNdEx])
@@ -526,6 +693,18 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -555,6 +734,110 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GrantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UseCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.UseCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodReset != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintFeegrant(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalSpent) > 0 {
+		for iNdEx := len(m.TotalSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsageLedgerEnabled {
+		i--
+		if m.UsageLedgerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -628,6 +911,9 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 			n += 1 + l + sovFeegrant(uint64(l))
 		}
 	}
+	if m.AllowWrapperMsgs {
+		n += 2
+	}
 	return n
 }
 
@@ -649,6 +935,53 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalSpent) > 0 {
+		for _, e := range m.TotalSpent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.PeriodReset != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.UseCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.UseCount))
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovFeegrant(uint64(m.LastUsedHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsageLedgerEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1092,6 +1425,26 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowWrapperMsgs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowWrapperMsgs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -1242,6 +1595,304 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &GrantUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSpent = append(m.TotalSpent, types.Coin{})
+			if err := m.TotalSpent[len(m.TotalSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodReset == nil {
+				m.PeriodReset = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCount", wireType)
+			}
+			m.UseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageLedgerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsageLedgerEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
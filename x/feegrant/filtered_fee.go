@@ -10,7 +10,21 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const allowedMessagesListSizeLimitation = 54
+const (
+	allowedMessagesListSizeLimitation = 54
+
+	// maxWrappedMsgsDepth is the maximum nesting depth of the msgs wrapped by the msgs of a tx
+	maxWrappedMsgsDepth = 3
+)
+
+// MsgWrapper is implemented by the msgs executing other msgs, like the authz MsgExec. The msgs
+// they wrap must be allowed by an AllowedMsgAllowance too.
+type MsgWrapper interface {
+	sdk.Msg
+
+	// GetMessages returns the msgs wrapped by the msg
+	GetMessages() ([]sdk.Msg, error)
+}
 
 var (
 	_ FeeAllowanceI                 = (*AllowedMsgAllowance)(nil)
@@ -63,8 +77,8 @@ func (a *AllowedMsgAllowance) SetAllowance(allowance FeeAllowanceI) error {
 
 // Accept method checks for the filtered messages has valid expiry
 func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
-	if !a.allMsgTypesAllowed(ctx, msgs) {
-		return false, sdkerrors.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+	if err := a.allMsgTypesAllowed(ctx, a.allowedMsgsToMap(ctx), msgs, 0); err != nil {
+		return false, err
 	}
 
 	allowance, err := a.GetAllowance()
@@ -90,16 +104,33 @@ func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool
 	return msgsMap
 }
 
-func (a *AllowedMsgAllowance) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
-	msgsMap := a.allowedMsgsToMap(ctx)
-
+// allMsgTypesAllowed checks that the types of msgs are allowed, and that the msgs wrapped by the
+// wrapper msgs are allowed recursively. depth is the nesting depth of msgs, the msgs of a tx being
+// at depth 0.
+func (a *AllowedMsgAllowance) allMsgTypesAllowed(ctx sdk.Context, msgsMap map[string]bool, msgs []sdk.Msg, depth int) error {
 	for _, msg := range msgs {
-		if !msgsMap[sdk.MsgTypeURL(msg)] {
-			return false
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		wrapper, isWrapper := msg.(MsgWrapper)
+		if !msgsMap[msgTypeURL] && !(isWrapper && a.AllowWrapperMsgs) {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "message %s does not exist in allowed messages", msgTypeURL)
+		}
+		if !isWrapper {
+			continue
+		}
+
+		if depth+1 > maxWrappedMsgsDepth {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "messages wrapped by %s are nested deeper than %d", msgTypeURL, maxWrappedMsgsDepth)
+		}
+		wrappedMsgs, err := wrapper.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := a.allMsgTypesAllowed(ctx, msgsMap, wrappedMsgs, depth+1); err != nil {
+			return err
 		}
 	}
 
-	return true
+	return nil
 }
 
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
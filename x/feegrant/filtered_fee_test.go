@@ -11,9 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func TestFilteredFeeValidAllow(t *testing.T) {
@@ -187,3 +189,88 @@ func TestFilteredFeeValidAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestFilteredFeeWrappedMsgs(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now()})
+
+	grantee := sdk.AccAddress("grantee")
+	send := &banktypes.MsgSend{}
+	vote := &govv1.MsgVote{}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+	sendTypeURL := sdk.MsgTypeURL(send)
+	execTypeURL := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	cases := map[string]struct {
+		allowedMsgs   []string
+		allowWrappers bool
+		msgs          []sdk.Msg
+		accept        bool
+	}{
+		"wrapped msg allowed with wrappers allowed": {
+			allowedMsgs:   []string{sendTypeURL},
+			allowWrappers: true,
+			msgs:          []sdk.Msg{exec(send)},
+			accept:        true,
+		},
+		"wrapped msg allowed with wrapper allowed": {
+			allowedMsgs: []string{sendTypeURL, execTypeURL},
+			msgs:        []sdk.Msg{exec(send)},
+			accept:      true,
+		},
+		"wrappers not allowed": {
+			allowedMsgs: []string{sendTypeURL},
+			msgs:        []sdk.Msg{exec(send)},
+			accept:      false,
+		},
+		"wrapped msg not allowed with wrappers allowed": {
+			allowedMsgs:   []string{sendTypeURL},
+			allowWrappers: true,
+			msgs:          []sdk.Msg{exec(send, vote)},
+			accept:        false,
+		},
+		"wrapped msg not allowed with wrapper allowed": {
+			allowedMsgs: []string{sendTypeURL, execTypeURL},
+			msgs:        []sdk.Msg{send, exec(vote)},
+			accept:      false,
+		},
+		"nested wrapped msg allowed": {
+			allowedMsgs:   []string{sendTypeURL},
+			allowWrappers: true,
+			msgs:          []sdk.Msg{exec(exec(exec(send)))},
+			accept:        true,
+		},
+		"nested wrapped msg not allowed": {
+			allowedMsgs:   []string{sendTypeURL},
+			allowWrappers: true,
+			msgs:          []sdk.Msg{exec(exec(send, vote))},
+			accept:        false,
+		},
+		"wrappers nested too deep": {
+			allowedMsgs:   []string{sendTypeURL},
+			allowWrappers: true,
+			msgs:          []sdk.Msg{exec(exec(exec(exec(send))))},
+			accept:        false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, tc.allowedMsgs)
+			require.NoError(t, err)
+			allowance.AllowWrapperMsgs = tc.allowWrappers
+
+			_, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), tc.msgs)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
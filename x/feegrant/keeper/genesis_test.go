@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	feegranttestutil "github.com/cosmos/cosmos-sdk/x/feegrant/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type GenesisTestSuite struct {
@@ -34,7 +35,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	accountKeeper := feegranttestutil.NewMockAccountKeeper(ctrl)
 	accountKeeper.EXPECT().GetAccount(gomock.Any(), granteeAddr).Return(authtypes.NewBaseAccountWithAddress(granteeAddr)).AnyTimes()
 
-	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper)
+	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	suite.ctx = testCtx.Ctx
 }
@@ -56,6 +57,11 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
 	suite.Require().NoError(err)
 
+	// the params and the usage ledger are exported too
+	suite.Require().NoError(suite.feegrantKeeper.SetParams(suite.ctx, feegrant.NewParams(true)))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granterAddr, granteeAddr, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10))), []sdk.Msg{})
+	suite.Require().NoError(err)
+
 	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
 	// revoke fee allowance
@@ -70,6 +76,8 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	newGenesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(genesis, newGenesis)
+	suite.Require().True(newGenesis.Params.UsageLedgerEnabled)
+	suite.Require().Equal(uint64(1), newGenesis.Allowances[0].Usage.UseCount)
 }
 
 func (suite *GenesisTestSuite) TestInitGenesis() {
@@ -125,3 +125,57 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// UsageByGranter queries the usage ledgers of all the allowances granted by the given granter
+func (q Keeper) UsageByGranter(c context.Context, req *feegrant.QueryUsageByGranterRequest) (*feegrant.QueryUsageByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromHexUnsafe(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	prefixStore := prefix.NewStore(store, feegrant.FeeAllowanceKeyPrefix)
+	usages, pageRes, err := query.GenericFilteredPaginate(q.cdc, prefixStore, req.Pagination, func(key []byte, grant *feegrant.Grant) (*feegrant.GranteeUsage, error) {
+		// ParseAddressesFromFeeAllowanceKey expects the full key including the prefix.
+		granter, _ := feegrant.ParseAddressesFromFeeAllowanceKey(append(feegrant.FeeAllowanceKeyPrefix, key...))
+		if !granter.Equals(granterAddr) {
+			return nil, nil
+		}
+
+		usage := &feegrant.GranteeUsage{Grantee: grant.Grantee}
+		if grant.Usage != nil {
+			usage.Usage = *grant.Usage
+		}
+		return usage, nil
+	}, func() *feegrant.Grant {
+		return &feegrant.Grant{}
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &feegrant.QueryUsageByGranterResponse{
+		Usages:     make([]feegrant.GranteeUsage, len(usages)),
+		Pagination: pageRes,
+	}
+	for i, usage := range usages {
+		res.Usages[i] = *usage
+	}
+	return res, nil
+}
+
+// Params queries the parameters of the feegrant module
+func (q Keeper) Params(c context.Context, req *feegrant.QueryParamsRequest) (*feegrant.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &feegrant.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
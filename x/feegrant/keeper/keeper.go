@@ -21,19 +21,54 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	authKeeper feegrant.AccountKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper, authority string) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		authKeeper: ak,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/feegrant module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetParams sets the x/feegrant module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p feegrant.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(feegrant.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feegrant module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p feegrant.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
@@ -123,28 +158,28 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return nil
 }
 
-// UpdateAllowance updates the existing grant.
+// UpdateAllowance updates the existing grant, keeping its usage ledger.
 func (k Keeper) UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
-	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
-
-	_, err := k.getGrant(ctx, granter, grantee)
+	existingGrant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
 
+	return k.updateAllowance(ctx, granter, grantee, feeAllowance, existingGrant.Usage)
+}
+
+// updateAllowance stores the grant of feeAllowance with the usage ledger usage.
+func (k Keeper) updateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, usage *feegrant.GrantUsage) error {
 	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
 	if err != nil {
 		return err
 	}
+	grant.Usage = usage
 
-	bz, err := k.cdc.Marshal(&grant)
-	if err != nil {
+	if err := k.setGrant(ctx, granter, grantee, grant); err != nil {
 		return err
 	}
 
-	store.Set(key, bz)
-
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			feegrant.EventTypeUpdateFeeGrant,
@@ -189,6 +224,18 @@ func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (
 	return grant.GetGrant()
 }
 
+// setGrant stores the grant between both accounts
+func (k Keeper) setGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, grant feegrant.Grant) error {
+	bz, err := k.cdc.Marshal(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.FeeAllowanceKey(granter, grantee), bz)
+	return nil
+}
+
 // getGrant returns entire grant between both accounts
 func (k Keeper) getGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (*feegrant.Grant, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -259,8 +306,54 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 	emitUseGrantEvent(ctx, granter.String(), grantee.String())
 
+	usage := f.Usage
+	if k.GetParams(ctx).UsageLedgerEnabled {
+		usage = recordGrantUsage(ctx, usage, grant, fee)
+	}
+
 	// if fee allowance is accepted, store the updated state of the allowance
-	return k.UpdateAllowance(ctx, granter, grantee, grant)
+	return k.updateAllowance(ctx, granter, grantee, grant, usage)
+}
+
+// recordGrantUsage returns usage updated with the fee paid by the grant of allowance in the
+// current block. The fees paid in a period of a periodic allowance are recorded until its reset.
+func recordGrantUsage(ctx sdk.Context, usage *feegrant.GrantUsage, allowance feegrant.FeeAllowanceI, fee sdk.Coins) *feegrant.GrantUsage {
+	var updated feegrant.GrantUsage
+	if usage != nil {
+		updated = *usage
+	}
+
+	updated.TotalSpent = updated.TotalSpent.Add(fee...)
+	updated.UseCount++
+	updated.LastUsedHeight = ctx.BlockHeight()
+
+	if periodic := periodicAllowance(allowance); periodic != nil {
+		if updated.PeriodReset == nil || !updated.PeriodReset.Equal(periodic.PeriodReset) {
+			periodReset := periodic.PeriodReset
+			updated.PeriodReset = &periodReset
+			updated.PeriodSpent = nil
+		}
+		updated.PeriodSpent = updated.PeriodSpent.Add(fee...)
+	}
+
+	return &updated
+}
+
+// periodicAllowance returns the periodic allowance of allowance, which is either allowance or the
+// allowance wrapped by it, or nil if there is none.
+func periodicAllowance(allowance feegrant.FeeAllowanceI) *feegrant.PeriodicAllowance {
+	switch allowance := allowance.(type) {
+	case *feegrant.PeriodicAllowance:
+		return allowance
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil {
+			return nil
+		}
+		return periodicAllowance(inner)
+	default:
+		return nil
+	}
 }
 
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
@@ -275,6 +368,10 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
 	for _, f := range data.Allowances {
 		granter, err := sdk.AccAddressFromHexUnsafe(f.Granter)
 		if err != nil {
@@ -294,6 +391,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 		if err != nil {
 			return err
 		}
+
+		// the usage ledger is kept with the grant
+		if f.Usage != nil {
+			if err := k.setGrant(ctx, granter, grantee, f); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -309,6 +413,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		Params:     k.GetParams(ctx),
 	}, err
 }
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -16,6 +17,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type KeeperTestSuite struct {
@@ -47,7 +50,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), suite.addrs[2]).Return(authtypes.NewBaseAccountWithAddress(suite.addrs[2])).AnyTimes()
 	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), suite.addrs[3]).Return(authtypes.NewBaseAccountWithAddress(suite.addrs[3])).AnyTimes()
 
-	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, suite.accountKeeper)
+	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, suite.accountKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	suite.ctx = testCtx.Ctx
 	suite.msgSrvr = keeper.NewMsgServerImpl(suite.feegrantKeeper)
 	suite.atom = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(555)))
@@ -463,3 +466,90 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUseGrantedFeeUsageLedger() {
+	blockTime := suite.ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	atom := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+	}
+	usageOf := func(ctx sdk.Context, grantee sdk.AccAddress) feegrant.GrantUsage {
+		res, err := suite.feegrantKeeper.UsageByGranter(ctx, &feegrant.QueryUsageByGranterRequest{Granter: suite.addrs[0].String()})
+		suite.Require().NoError(err)
+		for _, usage := range res.Usages {
+			if usage.Grantee == grantee.String() {
+				return usage.Usage
+			}
+		}
+		suite.FailNow("grant not found")
+		return feegrant.GrantUsage{}
+	}
+
+	// the usage is not recorded when the usage ledger is disabled
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], atom(1), []sdk.Msg{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.GrantUsage{}, usageOf(suite.ctx, suite.addrs[1]))
+
+	suite.Require().NoError(suite.feegrantKeeper.SetParams(suite.ctx, feegrant.NewParams(true)))
+
+	basicAllowance := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear}
+	periodicAllowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+		Basic:            *basicAllowance,
+		Period:           time.Hour,
+		PeriodSpendLimit: atom(10),
+	}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], periodicAllowance)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithBlockHeight(10)
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], atom(2), []sdk.Msg{})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], atom(2), []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(11)
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], atom(3), []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+
+	// the grants not used by the transaction are not recorded
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], atom(100), []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().Error(err)
+
+	suite.Require().Equal(feegrant.GrantUsage{
+		TotalSpent:     atom(2),
+		UseCount:       1,
+		LastUsedHeight: 10,
+	}, usageOf(ctx, suite.addrs[1]))
+
+	periodReset := blockTime.Add(time.Hour)
+	suite.Require().Equal(feegrant.GrantUsage{
+		TotalSpent:     atom(5),
+		PeriodSpent:    atom(5),
+		PeriodReset:    &periodReset,
+		UseCount:       2,
+		LastUsedHeight: 11,
+	}, usageOf(ctx, suite.addrs[2]))
+
+	// the fees of a new period are recorded from zero
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(blockTime.Add(90 * time.Minute))
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], atom(4), []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+
+	periodReset = blockTime.Add(2 * time.Hour)
+	expUsage := feegrant.GrantUsage{
+		TotalSpent:     atom(9),
+		PeriodSpent:    atom(4),
+		PeriodReset:    &periodReset,
+		UseCount:       3,
+		LastUsedHeight: 12,
+	}
+	suite.Require().Equal(expUsage, usageOf(ctx, suite.addrs[2]))
+
+	// the usage is kept when the allowance is updated
+	err = suite.feegrantKeeper.UpdateAllowance(ctx, suite.addrs[0], suite.addrs[2], basicAllowance)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expUsage, usageOf(ctx, suite.addrs[2]))
+}
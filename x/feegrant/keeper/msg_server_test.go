@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
 )
 
@@ -270,3 +271,24 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	params := feegrant.NewParams(true)
+
+	_, err := suite.msgSrvr.UpdateParams(suite.ctx, &feegrant.MsgUpdateParams{
+		Authority: suite.addrs[0].String(),
+		Params:    params,
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	suite.Require().Equal(feegrant.DefaultParams(), suite.feegrantKeeper.GetParams(suite.ctx))
+
+	_, err = suite.msgSrvr.UpdateParams(suite.ctx, &feegrant.MsgUpdateParams{
+		Authority: suite.feegrantKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.feegrantKeeper.Params(suite.ctx, &feegrant.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
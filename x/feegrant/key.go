@@ -29,6 +29,10 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// ParamsKey is the key of the feegrant module params
+	// - 0x02: params
+	ParamsKey = []byte{0x02}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -10,8 +10,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateParams{}
+	_, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateParams{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -136,3 +136,33 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateParams) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateParams) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,20 @@
+package feegrant
+
+// NewParams returns Params instance with the given values.
+func NewParams(usageLedgerEnabled bool) Params {
+	return Params{
+		UsageLedgerEnabled: usageLedgerEnabled,
+	}
+}
+
+// DefaultParams returns default x/feegrant module parameters.
+func DefaultParams() Params {
+	return Params{
+		UsageLedgerEnabled: false,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	return nil
+}
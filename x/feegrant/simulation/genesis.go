@@ -10,6 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
+// Simulation parameter constants
+const UsageLedgerEnabled = "usage_ledger_enabled"
+
 // genFeeGrants returns a slice of randomly generated allowances.
 func genFeeGrants(r *rand.Rand, accounts []simtypes.Account) []feegrant.Grant {
 	// prevent errors if len < 1
@@ -71,7 +74,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feegrants = genFeeGrants(r, simState.Accounts) },
 	)
 
-	feegrantGenesis := feegrant.NewGenesisState(feegrants)
+	var usageLedgerEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UsageLedgerEnabled, &usageLedgerEnabled, simState.Rand,
+		func(r *rand.Rand) { usageLedgerEnabled = r.Intn(2) == 0 },
+	)
+
+	feegrantGenesis := feegrant.NewGenesisState(feegrants, feegrant.NewParams(usageLedgerEnabled))
 	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
 	if err != nil {
 		panic(err)
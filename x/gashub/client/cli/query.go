@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryParamsCmd(),
+		QueryBaseFeeCmd(),
 	)
 
 	return cmd
@@ -57,3 +58,34 @@ $ <appd> query gashub params
 
 	return cmd
 }
+
+// QueryBaseFeeCmd returns the command handler for base fee querying.
+func QueryBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the base fee per unit of gas of the next block",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the base fee per unit of gas of the next block:
+
+$ <appd> query gashub base-fee
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
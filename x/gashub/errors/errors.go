@@ -5,4 +5,7 @@ import "cosmossdk.io/errors"
 // gashubCodespace is the codespace for all errors defined in gashub package
 const gashubCodespace = "gashub"
 
-var ErrInvalidMsgGasParams = errors.Register(gashubCodespace, 2, "msg gas params are invalid")
+var (
+	ErrInvalidMsgGasParams = errors.Register(gashubCodespace, 2, "msg gas params are invalid")
+	ErrMsgNestingTooDeep   = errors.Register(gashubCodespace, 3, "msgs are nested too deep")
+)
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// GetBaseFee returns the base fee per unit of gas of the next block. It is never lower than
+// the min base fee.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	minBaseFee := k.GetParams(ctx).FeeMarket.MinBaseFee
+	if minBaseFee.IsNil() {
+		minBaseFee = sdk.ZeroDec()
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return minBaseFee
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.MaxDec(baseFee, minBaseFee)
+}
+
+// SetBaseFee sets the base fee per unit of gas of the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// UpdateBaseFee adjusts the base fee of the next block according to the gas used by the current block.
+// The base fee is left unchanged if the fee market is disabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx).FeeMarket
+	if !params.Enabled {
+		return
+	}
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+
+	k.SetBaseFee(ctx, types.NextBaseFee(k.GetBaseFee(ctx), gasUsed, params))
+}
+
+// CollectBaseFee moves the base fee paid by a tx out of the fee collector. It is sent to the
+// configured fee recipient module, or burned if there is none.
+func (k Keeper) CollectBaseFee(ctx sdk.Context, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if recipient := k.GetParams(ctx).FeeMarket.FeeRecipient; recipient != "" {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, recipient, fee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fee); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
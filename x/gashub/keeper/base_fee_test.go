@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+func (suite *KeeperTestSuite) feeMarketParams() types.Params {
+	params := types.DefaultParams()
+	params.FeeMarket = types.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.NewDec(10),
+		TargetBlockGas:           1000,
+		BaseFeeChangeDenominator: 8,
+	}
+	return params
+}
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	require := suite.Require()
+
+	testCases := []struct {
+		name       string
+		disabled   bool
+		baseFee    sdk.Dec
+		gasUsed    uint64
+		expBaseFee sdk.Dec
+	}{
+		{"disabled", true, sdk.NewDec(800), 2000, sdk.NewDec(800)},
+		{"block at target", false, sdk.NewDec(800), 1000, sdk.NewDec(800)},
+		{"full block", false, sdk.NewDec(800), 2000, sdk.NewDec(900)},
+		{"half full block", false, sdk.NewDec(800), 1500, sdk.NewDec(850)},
+		{"empty block", false, sdk.NewDec(800), 0, sdk.NewDec(700)},
+		{"empty block at min base fee", false, sdk.NewDec(10), 0, sdk.NewDec(10)},
+		{"full block below min base fee", false, sdk.ZeroDec(), 2000, sdk.MustNewDecFromStr("11.25")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.feeMarketParams()
+			params.FeeMarket.Enabled = !tc.disabled
+			require.NoError(suite.gashubKeeper.SetParams(suite.ctx, params))
+			suite.gashubKeeper.SetBaseFee(suite.ctx, tc.baseFee)
+
+			ctx := suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(1e6))
+			ctx.BlockGasMeter().ConsumeGas(tc.gasUsed, "test")
+			suite.gashubKeeper.UpdateBaseFee(ctx)
+
+			require.Equal(tc.expBaseFee, suite.gashubKeeper.GetBaseFee(ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNextBaseFee() {
+	params := suite.feeMarketParams().FeeMarket
+	params.MinBaseFee = sdk.ZeroDec()
+
+	// the base fee keeps increasing above the target even when it is too small to be scaled
+	suite.Require().Equal(sdk.SmallestDec(), types.NextBaseFee(sdk.ZeroDec(), 2000, params))
+}
+
+func (suite *KeeperTestSuite) TestCollectBaseFee() {
+	require := suite.Require()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// the base fee is burned by default
+	require.NoError(suite.gashubKeeper.SetParams(suite.ctx, suite.feeMarketParams()))
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, types.ModuleName, fee).Return(nil),
+		suite.bankKeeper.EXPECT().BurnCoins(suite.ctx, types.ModuleName, fee).Return(nil),
+	)
+	require.NoError(suite.gashubKeeper.CollectBaseFee(suite.ctx, fee))
+
+	// the fee recipient must be a module account
+	params := suite.feeMarketParams()
+	params.FeeMarket.FeeRecipient = "unknown"
+	suite.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	require.Error(suite.gashubKeeper.SetParams(suite.ctx, params))
+
+	// the base fee is sent to the fee recipient
+	params.FeeMarket.FeeRecipient = distrtypes.ModuleName
+	suite.accountKeeper.EXPECT().GetModuleAddress(distrtypes.ModuleName).Return(authtypes.NewModuleAddress(distrtypes.ModuleName))
+	require.NoError(suite.gashubKeeper.SetParams(suite.ctx, params))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, distrtypes.ModuleName, fee).Return(nil)
+	require.NoError(suite.gashubKeeper.CollectBaseFee(suite.ctx, fee))
+
+	// nothing is collected for a zero fee
+	require.NoError(suite.gashubKeeper.CollectBaseFee(suite.ctx, sdk.NewCoins()))
+}
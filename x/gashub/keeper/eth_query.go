@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// EthQueryGasPriceHandlerGen returns a handler of eth_gasPrice returning the base fee, rounded up.
+// The default gas price is returned if the base fee is disabled.
+func EthQueryGasPriceHandlerGen(srv interface{}) baseapp.EthQueryHandler {
+	return func(ctx sdk.Context, req cmtrpctypes.RPCRequest) (abci.ResponseEthQuery, error) {
+		res, err := srv.(types.QueryServer).BaseFee(ctx, &types.QueryBaseFeeRequest{})
+		if err != nil {
+			return abci.ResponseEthQuery{}, err
+		}
+		if !res.Enabled {
+			return abci.ResponseEthQuery{Response: big.NewInt(baseapp.DefaultEthGasPrice).Bytes()}, nil
+		}
+		return abci.ResponseEthQuery{Response: res.BaseFee.Amount.Ceil().TruncateInt().BigInt().Bytes()}, nil
+	}
+}
@@ -14,6 +14,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, mgh := range genState.GetMsgGasParams() {
 		k.SetMsgGasParams(ctx, mgh)
 	}
+	k.SetDefaultMsgGasParams(ctx)
+
+	if !genState.BaseFee.IsNil() {
+		k.SetBaseFee(ctx, genState.BaseFee)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -24,5 +29,5 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	for _, mgh := range k.GetAllMsgGasParams(ctx) {
 		mghs = append(mghs, *mgh)
 	}
-	return types.NewGenesisState(params, mghs)
+	return types.NewGenesisState(params, mghs, k.GetBaseFee(ctx))
 }
@@ -65,3 +65,18 @@ func (k Keeper) getMsgGasParams(ctx sdk.Context, url string) (*types.MsgGasParam
 	mgp := k.GetMsgGasParams(ctx, url)
 	return &mgp, true
 }
+
+// BaseFee returns the base fee per unit of gas of the next block
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx).FeeMarket
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee: sdk.DecCoin{Denom: params.Denom, Amount: k.GetBaseFee(ctx)},
+		Enabled: params.Enabled,
+	}, nil
+}
@@ -4,12 +4,13 @@ import (
 	gocontext "context"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
-	// It should be empty params
+	suite.Require().NoError(suite.gashubKeeper.SetParams(suite.ctx, types.DefaultParams()))
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
@@ -167,3 +168,15 @@ func (suite *KeeperTestSuite) TestQueryMsgGasParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryBaseFee() {
+	ctx, gashubKeeper := suite.ctx, suite.gashubKeeper
+
+	suite.Require().NoError(gashubKeeper.SetParams(ctx, suite.feeMarketParams()))
+	gashubKeeper.SetBaseFee(ctx, sdk.NewDec(800))
+
+	res, err := suite.queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Enabled)
+	suite.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(800)), res.BaseFee)
+}
@@ -14,8 +14,13 @@ import (
 // Keeper encodes/decodes accounts using the go-amino (binary)
 // encoding/decoding library.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+
+	// the default msg gas params declared by the modules, shared by all the copies of the keeper
+	defaultMsgGasParams *[]types.MsgGasParams
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -24,12 +29,16 @@ type Keeper struct {
 
 // NewKeeper returns a new gashub keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, authority string,
 ) Keeper {
 	return Keeper{
-		storeKey:  storeKey,
-		cdc:       cdc,
-		authority: authority,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		authority:     authority,
+
+		defaultMsgGasParams: &[]types.MsgGasParams{},
 	}
 }
 
@@ -63,6 +72,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
 	}
+	if recipient := params.FeeMarket.FeeRecipient; recipient != "" && k.accountKeeper.GetModuleAddress(recipient) == nil {
+		return fmt.Errorf("invalid base fee recipient: module account %s does not exist", recipient)
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
@@ -7,6 +7,7 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -17,6 +18,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gashub/keeper"
+	gashubtestutil "github.com/cosmos/cosmos-sdk/x/gashub/testutil"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -25,8 +27,10 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	ctx          sdk.Context
-	gashubKeeper keeper.Keeper
+	ctx           sdk.Context
+	gashubKeeper  keeper.Keeper
+	accountKeeper *gashubtestutil.MockAccountKeeper
+	bankKeeper    *gashubtestutil.MockBankKeeper
 
 	queryClient types.QueryClient
 	msgServer   types.MsgServer
@@ -44,10 +48,16 @@ func (suite *KeeperTestSuite) SetupTest() {
 	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: cmttime.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
+	ctrl := gomock.NewController(suite.T())
+	suite.accountKeeper = gashubtestutil.NewMockAccountKeeper(ctrl)
+	suite.bankKeeper = gashubtestutil.NewMockBankKeeper(ctrl)
+
 	suite.ctx = ctx
 	suite.gashubKeeper = keeper.NewKeeper(
 		encCfg.Codec,
 		key,
+		suite.accountKeeper,
+		suite.bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -67,6 +77,8 @@ func (suite *KeeperTestSuite) TestGetAuthority() {
 		return keeper.NewKeeper(
 			moduletestutil.MakeTestEncodingConfig().Codec,
 			storetypes.NewKVStoreKey(types.StoreKey),
+			nil,
+			nil,
 			authority,
 		)
 	}
@@ -356,3 +368,25 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		require.Equal(actual.MinGasPerByte, expected[1])
 	})
 }
+
+func (suite *KeeperTestSuite) TestSetDefaultMsgGasParams() {
+	ctx, gashubKeeper := suite.ctx, suite.gashubKeeper
+	require := suite.Require()
+
+	governed := *types.NewMsgGasParamsWithFixedGas("governed", 1200)
+	gashubKeeper.SetMsgGasParams(ctx, governed)
+
+	require.Equal([]string{"declared", "undeclared"}, gashubKeeper.GetMsgTypesWithoutMsgGasParams(ctx, []string{"declared", "governed", "undeclared"}))
+
+	// the default msg gas params do not override the existing ones
+	gashubKeeper.RegisterDefaultMsgGasParams(
+		*types.NewMsgGasParamsWithFixedGas("declared", 800),
+		*types.NewMsgGasParamsWithFixedGas("governed", 800),
+	)
+	gashubKeeper.SetDefaultMsgGasParams(ctx)
+
+	declared := gashubKeeper.GetMsgGasParams(ctx, "declared")
+	require.Equal(uint64(800), declared.GetFixedType().FixedGas)
+	require.Equal(governed, gashubKeeper.GetMsgGasParams(ctx, "governed"))
+	require.Equal([]string{"undeclared"}, gashubKeeper.GetMsgTypesWithoutMsgGasParams(ctx, []string{"declared", "governed", "undeclared"}))
+}
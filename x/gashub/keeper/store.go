@@ -71,3 +71,31 @@ func (k Keeper) GetAllMsgGasParams(ctx sdk.Context) (mgps []*types.MsgGasParams)
 
 	return mgps
 }
+
+// RegisterDefaultMsgGasParams registers default msg gas params declared by a module. They are set by
+// SetDefaultMsgGasParams for the msg types without msg gas params.
+func (k Keeper) RegisterDefaultMsgGasParams(mgps ...types.MsgGasParams) {
+	*k.defaultMsgGasParams = append(*k.defaultMsgGasParams, mgps...)
+}
+
+// SetDefaultMsgGasParams sets the registered default msg gas params of the msg types without msg gas
+// params. It is called at genesis, and can be called by upgrade handlers adding modules or msgs.
+func (k Keeper) SetDefaultMsgGasParams(ctx sdk.Context) {
+	for _, mgp := range *k.defaultMsgGasParams {
+		if k.HasMsgGasParams(ctx, mgp.MsgTypeUrl) {
+			continue
+		}
+		k.SetMsgGasParams(ctx, mgp)
+	}
+}
+
+// GetMsgTypesWithoutMsgGasParams returns the msg type urls without msg gas params, which are charged
+// the default msg gas.
+func (k Keeper) GetMsgTypesWithoutMsgGasParams(ctx sdk.Context, msgTypeUrls []string) (missing []string) {
+	for _, msgTypeUrl := range msgTypeUrls {
+		if !k.HasMsgGasParams(ctx, msgTypeUrl) {
+			missing = append(missing, msgTypeUrl)
+		}
+	}
+	return missing
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	modulev1 "cosmossdk.io/api/cosmos/gashub/module/v1"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,6 +14,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -34,6 +37,9 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.EndBlockAppModule   = AppModule{}
+
+	_ types.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the gashub module.
@@ -146,6 +152,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// DefaultMsgGasParams returns the default gas params of the gashub msgs, which are only executed by governance.
+func (AppModule) DefaultMsgGasParams() []types.MsgGasParams {
+	return []types.MsgGasParams{
+		*types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgUpdateParams{}), 0),
+		*types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&types.MsgSetMsgGasParams{}), 0),
+	}
+}
+
+// EndBlock returns the end blocker for the gashub module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the gashub module
@@ -171,6 +192,7 @@ func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.Weighte
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeRegisterDefaultMsgGasParams),
 	)
 }
 
@@ -181,6 +203,9 @@ type GashubInputs struct {
 	Config *modulev1.Module
 	Cdc    codec.Codec
 	Key    *storetypes.KVStoreKey
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
 }
 
 //nolint:revive
@@ -201,6 +226,8 @@ func ProvideModule(in GashubInputs) GashubOutputs {
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Key,
+		in.AccountKeeper,
+		in.BankKeeper,
 		authority.String(),
 	)
 
@@ -208,3 +235,47 @@ func ProvideModule(in GashubInputs) GashubOutputs {
 
 	return GashubOutputs{GashubKeeper: k, Module: m}
 }
+
+// InvokeRegisterDefaultMsgGasParams registers the default msg gas params declared by the app modules.
+func InvokeRegisterDefaultMsgGasParams(k keeper.Keeper, modules map[string]appmodule.AppModule) {
+	mods := make(map[string]interface{}, len(modules))
+	for name, m := range modules {
+		mods[name] = m
+	}
+	RegisterModulesDefaultMsgGasParams(k, mods)
+}
+
+// RegisterModulesDefaultMsgGasParams registers the default msg gas params declared by the modules
+// implementing types.HasDefaultMsgGasParams, in the order of their names. They are set at genesis for
+// the msg types without msg gas params.
+func RegisterModulesDefaultMsgGasParams(k keeper.Keeper, modules map[string]interface{}) {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if m, ok := modules[name].(types.HasDefaultMsgGasParams); ok {
+			k.RegisterDefaultMsgGasParams(m.DefaultMsgGasParams()...)
+		}
+	}
+}
+
+// CheckMsgGasParamsCoverage returns and logs the msgs routed by the msg service router which have no
+// msg gas params. They are charged the default msg gas, or rejected if it is 0.
+func CheckMsgGasParamsCoverage(ctx sdk.Context, k keeper.Keeper, router *baseapp.MsgServiceRouter) []string {
+	missing := k.GetMsgTypesWithoutMsgGasParams(ctx, router.MsgTypeURLs())
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if defaultGas := k.GetParams(ctx).DefaultMsgGas; defaultGas != 0 {
+		k.Logger(ctx).Info("msgs without msg gas params are charged the default msg gas",
+			"default_msg_gas", defaultGas, "msg_types", strings.Join(missing, ","))
+	} else {
+		k.Logger(ctx).Error("msgs without msg gas params are rejected",
+			"msg_types", strings.Join(missing, ","))
+	}
+	return missing
+}
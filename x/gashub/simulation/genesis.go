@@ -48,6 +48,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	gashubGenesis := types.GenesisState{
 		Params:       params,
 		MsgGasParams: msgGasParams,
+		BaseFee:      types.DefaultMinBaseFee,
 	}
 
 	paramsBytes, err := json.MarshalIndent(&gashubGenesis.Params, "", " ")
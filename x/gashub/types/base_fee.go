@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee computes the base fee of the next block from the base fee and the gas used by the
+// current block, following EIP-1559. The base fee moves towards the target block gas by at most
+// 1/BaseFeeChangeDenominator per block, and never goes below MinBaseFee.
+func NextBaseFee(baseFee sdk.Dec, gasUsed uint64, params FeeMarketParams) sdk.Dec {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+
+	delta := baseFee.Mul(used.Sub(target)).Quo(target).QuoInt64(int64(params.BaseFeeChangeDenominator))
+	// the base fee must keep increasing when blocks are above the target, even if it is close to zero
+	if gasUsed > params.TargetBlockGas && !delta.IsPositive() {
+		delta = sdk.SmallestDec()
+	}
+
+	next := baseFee.Add(delta)
+	if next.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+	return next
+}
@@ -21,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgGasParams_GrantType{}, "cosmos-sdk/MsgGasParams/GrantType", nil)
 	cdc.RegisterConcrete(&MsgGasParams_MultiSendType{}, "cosmos-sdk/MsgGasParams/MultiSendType", nil)
 	cdc.RegisterConcrete(&MsgGasParams_GrantAllowanceType{}, "cosmos-sdk/MsgGasParams/GrantAllowanceType", nil)
+	cdc.RegisterConcrete(&MsgGasParams_WrapperType{}, "cosmos-sdk/MsgGasParams/WrapperType", nil)
 
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/gashub/Params", nil)
 }
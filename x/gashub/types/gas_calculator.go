@@ -8,6 +8,8 @@ import (
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/gashub/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -44,6 +46,13 @@ var (
 		}
 		return nil
 	}
+
+	WrapperGasCalculatorGen = func(mgh MsgGasParams) GasCalculator {
+		if wrapperTyp := mgh.GetWrapperType(); wrapperTyp != nil {
+			return WrapperCalculator(wrapperTyp.FixedGas)
+		}
+		return nil
+	}
 )
 
 func GetGasCalculatorGen(mgp MsgGasParams) (GasCalculatorGenerator, error) {
@@ -56,6 +65,8 @@ func GetGasCalculatorGen(mgp MsgGasParams) (GasCalculatorGenerator, error) {
 		return MsgMultiSendGasCalculatorGen, nil
 	case mgp.GetGrantAllowanceType() != nil:
 		return MsgGrantAllowanceGasCalculatorGen, nil
+	case mgp.GetWrapperType() != nil:
+		return WrapperGasCalculatorGen, nil
 	default:
 		return nil, errorsmod.Wrap(errors.ErrInvalidMsgGasParams, "unknown MsgGasParams type")
 	}
@@ -115,16 +126,78 @@ func GrantAllowanceCalculator(fixedGas, gasPerItem uint64) GasCalculator {
 		}
 
 		msgGrantAllowance := msg.(*feegrant.MsgGrantAllowance)
-		var num int
 		feeAllowance, err := msgGrantAllowance.GetFeeAllowanceI()
 		if err != nil {
 			return 0, err
 		}
-		if feeAllowance, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
-			num = len(feeAllowance.AllowedMessages)
+		num, err := allowedMsgItems(feeAllowance)
+		if err != nil {
+			return 0, err
 		}
 
 		totalGas := fixedGas + uint64(num)*gasPerItem
 		return totalGas, nil
 	}
 }
+
+// allowedMsgItems returns the number of items of the allow-lists of an allowance and the allowances
+// it wraps, the permission of the wrapper msgs counting as one item.
+func allowedMsgItems(feeAllowance feegrant.FeeAllowanceI) (int, error) {
+	allowedMsgAllowance, ok := feeAllowance.(*feegrant.AllowedMsgAllowance)
+	if !ok {
+		return 0, nil
+	}
+
+	num := len(allowedMsgAllowance.AllowedMessages)
+	if allowedMsgAllowance.AllowWrapperMsgs {
+		num++
+	}
+
+	inner, err := allowedMsgAllowance.GetAllowance()
+	if err != nil {
+		return 0, err
+	}
+	innerNum, err := allowedMsgItems(inner)
+	if err != nil {
+		return 0, err
+	}
+	return num + innerNum, nil
+}
+
+// WrapperCalculator returns the surcharge of a wrapper msg, the gas of the wrapped msgs being
+// charged according to their own msg gas params.
+func WrapperCalculator(fixedGas uint64) GasCalculator {
+	return func(msg types.Msg) (uint64, error) {
+		if _, err := GetWrappedMsgs(msg); err != nil {
+			return 0, err
+		}
+		return fixedGas, nil
+	}
+}
+
+// wrapperMsgTypeUrls are the type urls of the wrapper msgs unwrapped by GetWrappedMsgs
+var wrapperMsgTypeUrls = map[string]bool{
+	types.MsgTypeURL(&authz.MsgExec{}):           true,
+	types.MsgTypeURL(&gov.MsgSubmitProposal{}):   true,
+	types.MsgTypeURL(&group.MsgSubmitProposal{}): true,
+}
+
+// IsWrapperMsgType returns true if the msgs wrapped by the msgs of a type are known by GetWrappedMsgs
+func IsWrapperMsgType(msgTypeUrl string) bool {
+	return wrapperMsgTypeUrls[msgTypeUrl]
+}
+
+// GetWrappedMsgs returns the msgs wrapped by a known wrapper msg. The msgs executed by a group
+// MsgExec are not part of the tx, they are charged when the group proposal is submitted.
+func GetWrappedMsgs(msg types.Msg) ([]types.Msg, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		return msg.GetMessages()
+	case *gov.MsgSubmitProposal:
+		return msg.GetMsgs()
+	case *group.MsgSubmitProposal:
+		return msg.GetMsgs()
+	default:
+		return nil, errorsmod.Wrapf(errors.ErrInvalidMsgGasParams, "msg type %s does not wrap msgs", types.MsgTypeURL(msg))
+	}
+}
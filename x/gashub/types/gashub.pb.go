@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,11 @@ type Params struct {
 	MaxTxSize uint64 `protobuf:"varint,1,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
 	// min_gas_per_byte is the minimum gas to be paid per byte of a transaction's
 	MinGasPerByte uint64 `protobuf:"varint,2,opt,name=min_gas_per_byte,json=minGasPerByte,proto3" json:"min_gas_per_byte,omitempty"`
+	// fee_market defines the parameters of the dynamic base fee.
+	FeeMarket FeeMarketParams `protobuf:"bytes,3,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market"`
+	// default_msg_gas is the fixed gas charged for the msg types without msg gas params. The msgs
+	// without msg gas params are rejected if it is 0.
+	DefaultMsgGas uint64 `protobuf:"varint,4,opt,name=default_msg_gas,json=defaultMsgGas,proto3" json:"default_msg_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,17 +86,117 @@ func (m *Params) GetMinGasPerByte() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeMarket() FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return FeeMarketParams{}
+}
+
+func (m *Params) GetDefaultMsgGas() uint64 {
+	if m != nil {
+		return m.DefaultMsgGas
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the EIP-1559 style base fee. The base fee is adjusted at the
+// end of each block according to the gas used by the block, and each tx must pay at least the base fee
+// for its gas limit.
+type FeeMarketParams struct {
+	// enabled defines whether the base fee is adjusted and enforced.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the denom the base fee is paid in.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee is the lower bound of the base fee per unit of gas.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee"`
+	// target_block_gas is the gas used by a block for which the base fee is unchanged.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two blocks, e.g. 8 bounds it to 12.5%.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// fee_recipient is the name of the module account receiving the base fees. They are burned if empty.
+	FeeRecipient string `protobuf:"bytes,6,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2f12e3606fbd41, []int{1}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
 // MsgGasParams defines gas consumption for a msg type
 type MsgGasParams struct {
 	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// gas_params is the oneof that represents either fixed_gas_params or dynamic_gas_params
 	//
 	// Types that are valid to be assigned to GasParams:
-	//
 	//	*MsgGasParams_FixedType
 	//	*MsgGasParams_GrantType
 	//	*MsgGasParams_MultiSendType
 	//	*MsgGasParams_GrantAllowanceType
+	//	*MsgGasParams_WrapperType
 	GasParams isMsgGasParams_GasParams `protobuf_oneof:"gas_params"`
 }
 
@@ -97,7 +204,7 @@ func (m *MsgGasParams) Reset()         { *m = MsgGasParams{} }
 func (m *MsgGasParams) String() string { return proto.CompactTextString(m) }
 func (*MsgGasParams) ProtoMessage()    {}
 func (*MsgGasParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa2f12e3606fbd41, []int{1}
+	return fileDescriptor_aa2f12e3606fbd41, []int{2}
 }
 func (m *MsgGasParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,11 +252,15 @@ type MsgGasParams_MultiSendType struct {
 type MsgGasParams_GrantAllowanceType struct {
 	GrantAllowanceType *MsgGasParams_DynamicGasParams `protobuf:"bytes,5,opt,name=grant_allowance_type,json=grantAllowanceType,proto3,oneof" json:"grant_allowance_type,omitempty"`
 }
+type MsgGasParams_WrapperType struct {
+	WrapperType *MsgGasParams_WrapperGasParams `protobuf:"bytes,6,opt,name=wrapper_type,json=wrapperType,proto3,oneof" json:"wrapper_type,omitempty"`
+}
 
 func (*MsgGasParams_FixedType) isMsgGasParams_GasParams()          {}
 func (*MsgGasParams_GrantType) isMsgGasParams_GasParams()          {}
 func (*MsgGasParams_MultiSendType) isMsgGasParams_GasParams()      {}
 func (*MsgGasParams_GrantAllowanceType) isMsgGasParams_GasParams() {}
+func (*MsgGasParams_WrapperType) isMsgGasParams_GasParams()        {}
 
 func (m *MsgGasParams) GetGasParams() isMsgGasParams_GasParams {
 	if m != nil {
@@ -193,6 +304,13 @@ func (m *MsgGasParams) GetGrantAllowanceType() *MsgGasParams_DynamicGasParams {
 	return nil
 }
 
+func (m *MsgGasParams) GetWrapperType() *MsgGasParams_WrapperGasParams {
+	if x, ok := m.GetGasParams().(*MsgGasParams_WrapperType); ok {
+		return x.WrapperType
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgGasParams) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -200,6 +318,7 @@ func (*MsgGasParams) XXX_OneofWrappers() []interface{} {
 		(*MsgGasParams_GrantType)(nil),
 		(*MsgGasParams_MultiSendType)(nil),
 		(*MsgGasParams_GrantAllowanceType)(nil),
+		(*MsgGasParams_WrapperType)(nil),
 	}
 }
 
@@ -213,7 +332,7 @@ func (m *MsgGasParams_FixedGasParams) Reset()         { *m = MsgGasParams_FixedG
 func (m *MsgGasParams_FixedGasParams) String() string { return proto.CompactTextString(m) }
 func (*MsgGasParams_FixedGasParams) ProtoMessage()    {}
 func (*MsgGasParams_FixedGasParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa2f12e3606fbd41, []int{1, 0}
+	return fileDescriptor_aa2f12e3606fbd41, []int{2, 0}
 }
 func (m *MsgGasParams_FixedGasParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +380,7 @@ func (m *MsgGasParams_DynamicGasParams) Reset()         { *m = MsgGasParams_Dyna
 func (m *MsgGasParams_DynamicGasParams) String() string { return proto.CompactTextString(m) }
 func (*MsgGasParams_DynamicGasParams) ProtoMessage()    {}
 func (*MsgGasParams_DynamicGasParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa2f12e3606fbd41, []int{1, 1}
+	return fileDescriptor_aa2f12e3606fbd41, []int{2, 1}
 }
 func (m *MsgGasParams_DynamicGasParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,11 +423,70 @@ func (m *MsgGasParams_DynamicGasParams) GetGasPerItem() uint64 {
 	return 0
 }
 
+// WrapperGasParams defines the parameters for the msgs wrapping other msgs. The gas cost of
+// a wrapper msg is its fixed gas plus the gas cost of each of the wrapped msgs.
+type MsgGasParams_WrapperGasParams struct {
+	// fixed_gas is the surcharge for a wrapper msg
+	FixedGas uint64 `protobuf:"varint,1,opt,name=fixed_gas,json=fixedGas,proto3" json:"fixed_gas,omitempty"`
+	// max_depth is the maximum nesting depth of the msgs wrapped by a wrapper msg, the msgs of a tx
+	// being at depth 0
+	MaxDepth uint32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (m *MsgGasParams_WrapperGasParams) Reset()         { *m = MsgGasParams_WrapperGasParams{} }
+func (m *MsgGasParams_WrapperGasParams) String() string { return proto.CompactTextString(m) }
+func (*MsgGasParams_WrapperGasParams) ProtoMessage()    {}
+func (*MsgGasParams_WrapperGasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa2f12e3606fbd41, []int{2, 2}
+}
+func (m *MsgGasParams_WrapperGasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasParams_WrapperGasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasParams_WrapperGasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasParams_WrapperGasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasParams_WrapperGasParams.Merge(m, src)
+}
+func (m *MsgGasParams_WrapperGasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasParams_WrapperGasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasParams_WrapperGasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasParams_WrapperGasParams proto.InternalMessageInfo
+
+func (m *MsgGasParams_WrapperGasParams) GetFixedGas() uint64 {
+	if m != nil {
+		return m.FixedGas
+	}
+	return 0
+}
+
+func (m *MsgGasParams_WrapperGasParams) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.gashub.v1beta1.Params")
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.gashub.v1beta1.FeeMarketParams")
 	proto.RegisterType((*MsgGasParams)(nil), "cosmos.gashub.v1beta1.MsgGasParams")
 	proto.RegisterType((*MsgGasParams_FixedGasParams)(nil), "cosmos.gashub.v1beta1.MsgGasParams.FixedGasParams")
 	proto.RegisterType((*MsgGasParams_DynamicGasParams)(nil), "cosmos.gashub.v1beta1.MsgGasParams.DynamicGasParams")
+	proto.RegisterType((*MsgGasParams_WrapperGasParams)(nil), "cosmos.gashub.v1beta1.MsgGasParams.WrapperGasParams")
 }
 
 func init() {
@@ -316,39 +494,57 @@ func init() {
 }
 
 var fileDescriptor_aa2f12e3606fbd41 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x63, 0x48, 0xab, 0xe6, 0x6d, 0x52, 0x5a, 0xab, 0x48, 0x95, 0x07, 0x1b, 0x85, 0x85,
-	0x3f, 0xaa, 0x4d, 0x0b, 0x53, 0xb6, 0x5a, 0xfc, 0x1d, 0x22, 0x55, 0x4e, 0xbb, 0x30, 0x60, 0x5d,
-	0xd2, 0xeb, 0xf5, 0x84, 0xcf, 0x17, 0xf9, 0x2e, 0x60, 0x77, 0x66, 0x62, 0x62, 0x64, 0xe4, 0x23,
-	0xf0, 0x31, 0x18, 0x3b, 0x32, 0x59, 0xc8, 0x19, 0xe0, 0x63, 0xa0, 0xbb, 0xb3, 0xa3, 0x16, 0x75,
-	0xa0, 0xca, 0x62, 0xbd, 0x77, 0x7a, 0xdf, 0xdf, 0xf3, 0xf8, 0xd5, 0x73, 0xd0, 0x9f, 0x70, 0xc1,
-	0xb8, 0x08, 0x08, 0x12, 0x67, 0xb3, 0x71, 0xf0, 0x61, 0x6f, 0x8c, 0x25, 0xda, 0xab, 0x8f, 0xfe,
-	0x34, 0xe3, 0x92, 0xdb, 0x77, 0x4d, 0x8f, 0x5f, 0x5f, 0xd6, 0x3d, 0xce, 0x36, 0xe1, 0x84, 0xeb,
-	0x8e, 0x40, 0x55, 0xa6, 0xd9, 0xd9, 0x42, 0x8c, 0xa6, 0x3c, 0xd0, 0x5f, 0x73, 0xd5, 0xff, 0x6a,
-	0xc1, 0xea, 0x21, 0xca, 0x10, 0x13, 0xf6, 0x2e, 0xac, 0x33, 0x94, 0xc7, 0x32, 0x8f, 0x05, 0x3d,
-	0xc7, 0x3b, 0xd6, 0x3d, 0xeb, 0x41, 0x3b, 0xec, 0x55, 0xa5, 0xd7, 0x19, 0xa2, 0xfc, 0x28, 0x1f,
-	0xd1, 0x73, 0x1c, 0x75, 0x58, 0x53, 0xda, 0x03, 0xd8, 0x64, 0x34, 0x8d, 0x09, 0x12, 0xf1, 0x14,
-	0x67, 0xf1, 0xb8, 0x90, 0x78, 0xe7, 0x96, 0x9e, 0xd9, 0xaa, 0x4a, 0xaf, 0x37, 0xa4, 0xe9, 0x2b,
-	0x24, 0x0e, 0x71, 0x16, 0x16, 0x12, 0x47, 0x3d, 0x76, 0xf9, 0x38, 0xb8, 0xff, 0xe7, 0x9b, 0x67,
-	0x7d, 0xfe, 0xfd, 0xfd, 0x91, 0x63, 0xec, 0xef, 0x8a, 0x93, 0xf7, 0x41, 0xde, 0xfc, 0xa8, 0xf1,
-	0xd3, 0xff, 0xb4, 0x02, 0xdd, 0xa1, 0x20, 0x6a, 0xcc, 0x18, 0x7c, 0x02, 0x5d, 0x26, 0x48, 0x2c,
-	0x8b, 0x29, 0x8e, 0x67, 0x59, 0xa2, 0x1d, 0x76, 0xc2, 0x8d, 0xaa, 0xf4, 0x60, 0x28, 0xc8, 0x51,
-	0x31, 0xc5, 0xc7, 0x59, 0x12, 0x01, 0x5b, 0xd4, 0xf6, 0x08, 0xe0, 0x94, 0xe6, 0xf8, 0x44, 0xcf,
-	0x68, 0x77, 0xeb, 0xfb, 0xfb, 0xfe, 0xb5, 0x2b, 0xf3, 0x2f, 0x4b, 0xf9, 0x2f, 0xd5, 0xd4, 0xe2,
-	0xf8, 0xba, 0x15, 0x75, 0x34, 0x47, 0x71, 0xed, 0x63, 0x00, 0x92, 0xa1, 0x54, 0x1a, 0xe8, 0x6d,
-	0x0d, 0x7d, 0xf6, 0x3f, 0xd0, 0xe7, 0x45, 0x8a, 0x18, 0x9d, 0x5c, 0xc1, 0x6a, 0x92, 0xc6, 0xbe,
-	0x83, 0x3b, 0x6c, 0x96, 0x48, 0x1a, 0x0b, 0x9c, 0xd6, 0x86, 0xdb, 0x4b, 0xb1, 0x7b, 0x1a, 0x37,
-	0xc2, 0xa9, 0xb1, 0x7d, 0x06, 0xdb, 0xc6, 0x36, 0x4a, 0x12, 0xfe, 0x11, 0xa5, 0x13, 0x6c, 0x44,
-	0x56, 0x96, 0x12, 0xb1, 0x35, 0xf3, 0xa0, 0x41, 0x2a, 0x25, 0xe7, 0x00, 0x36, 0xae, 0xee, 0xcf,
-	0x7e, 0x08, 0x66, 0x7f, 0x2a, 0x2d, 0x75, 0xb0, 0xba, 0x55, 0xe9, 0xad, 0x35, 0x6d, 0xd1, 0xda,
-	0x69, 0x5d, 0x0d, 0xda, 0x2a, 0x1a, 0xce, 0x0c, 0x36, 0xff, 0x15, 0xbb, 0x01, 0x44, 0x25, 0xa5,
-	0xc9, 0x25, 0x95, 0x98, 0xd5, 0xb9, 0xd4, 0x49, 0x31, 0x29, 0x7c, 0x23, 0x31, 0x8b, 0x80, 0x2c,
-	0x6a, 0x23, 0x6b, 0xbe, 0x61, 0x17, 0x40, 0x4f, 0x6b, 0xd9, 0xf0, 0xc5, 0x8f, 0xca, 0xb5, 0x2e,
-	0x2a, 0xd7, 0xfa, 0x55, 0xb9, 0xd6, 0x97, 0xb9, 0xdb, 0xba, 0x98, 0xbb, 0xad, 0x9f, 0x73, 0xb7,
-	0xf5, 0xf6, 0x31, 0xa1, 0x52, 0xed, 0x6a, 0xc2, 0x59, 0x50, 0x3f, 0xd5, 0xeb, 0xe2, 0xac, 0xb6,
-	0x2c, 0xc6, 0xab, 0xfa, 0xbd, 0x3d, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x92, 0xbd, 0xe8,
-	0xd5, 0x03, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x4b, 0x1a, 0xe2, 0x97, 0xb8, 0xcd, 0x5a, 0x45, 0x32, 0x59, 0x29, 0xa9, 0xb2, 0xd2,
+	0x2a, 0x2c, 0x6a, 0xc2, 0x16, 0x2e, 0x54, 0x70, 0x58, 0x13, 0x5a, 0x38, 0x44, 0xaa, 0xdc, 0x5d,
+	0x21, 0x38, 0xd4, 0x8c, 0x9d, 0x17, 0xc7, 0xaa, 0xc7, 0x8e, 0x3c, 0x13, 0x9a, 0xf4, 0x23, 0x70,
+	0xe2, 0x23, 0x70, 0x41, 0xe2, 0xd8, 0x03, 0x7c, 0x00, 0x6e, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x08,
+	0xa5, 0x87, 0xf2, 0x31, 0xd0, 0xcc, 0xd8, 0x69, 0x5a, 0x01, 0x6a, 0xd5, 0x8b, 0x35, 0x6f, 0xe6,
+	0xbd, 0xdf, 0xef, 0xf7, 0xfe, 0xf8, 0x41, 0xcb, 0x4f, 0x18, 0x4d, 0x58, 0x37, 0x20, 0x6c, 0x34,
+	0xf1, 0xba, 0xdf, 0xbd, 0xf4, 0x90, 0x93, 0x97, 0x99, 0xd9, 0x19, 0xa7, 0x09, 0x4f, 0xcc, 0x77,
+	0x94, 0x4f, 0x27, 0xbb, 0xcc, 0x7c, 0xea, 0x5b, 0x41, 0x12, 0x24, 0xd2, 0xa3, 0x2b, 0x4e, 0xca,
+	0xb9, 0xfe, 0x84, 0xd0, 0x30, 0x4e, 0xba, 0xf2, 0x9b, 0x5d, 0xbd, 0xab, 0xe2, 0x5d, 0xe5, 0x9b,
+	0x81, 0x49, 0xa3, 0xf5, 0xd3, 0x1a, 0x94, 0x0e, 0x49, 0x4a, 0x28, 0x33, 0x77, 0xa0, 0x42, 0xc9,
+	0xd4, 0xe5, 0x53, 0x97, 0x85, 0x67, 0x68, 0x69, 0xdb, 0x5a, 0xbb, 0x68, 0x1b, 0x8b, 0x79, 0x53,
+	0xef, 0x93, 0xe9, 0xeb, 0xe9, 0x51, 0x78, 0x86, 0x8e, 0x4e, 0xf3, 0xa3, 0xb9, 0x07, 0x35, 0x1a,
+	0xc6, 0x6e, 0x40, 0x98, 0x3b, 0xc6, 0xd4, 0xf5, 0x66, 0x1c, 0xad, 0x35, 0x19, 0xf3, 0x64, 0x31,
+	0x6f, 0x1a, 0xfd, 0x30, 0x3e, 0x20, 0xec, 0x10, 0x53, 0x7b, 0xc6, 0xd1, 0x31, 0xe8, 0xaa, 0x69,
+	0x1e, 0x02, 0x0c, 0x11, 0x5d, 0x4a, 0xd2, 0x13, 0xe4, 0xd6, 0x5b, 0xdb, 0x5a, 0xbb, 0xb2, 0xfb,
+	0xbc, 0xf3, 0xaf, 0x59, 0x76, 0xf6, 0x11, 0xfb, 0xd2, 0x4f, 0xc9, 0xb4, 0xf5, 0x8b, 0x79, 0xb3,
+	0xf0, 0xf3, 0xf5, 0xf9, 0x0b, 0xcd, 0xd1, 0x87, 0xf9, 0x9b, 0xf9, 0x31, 0x6c, 0x0e, 0x70, 0x48,
+	0x26, 0x11, 0x77, 0x29, 0x0b, 0x84, 0x2a, 0xab, 0x78, 0x23, 0xa6, 0xa7, 0x9e, 0xfa, 0x2c, 0x38,
+	0x20, 0xcc, 0x31, 0x06, 0xab, 0xe6, 0xde, 0xb3, 0xbf, 0x7f, 0x6c, 0x6a, 0xdf, 0x5f, 0x9f, 0xbf,
+	0xa8, 0x2b, 0x01, 0x3b, 0x6c, 0x70, 0xd2, 0x9d, 0xe6, 0x0d, 0x51, 0xac, 0xad, 0x5f, 0xd7, 0x60,
+	0xf3, 0x8e, 0x12, 0xd3, 0x82, 0xb7, 0x31, 0x26, 0x5e, 0x84, 0x03, 0x59, 0xac, 0xb2, 0x93, 0x9b,
+	0xe6, 0x16, 0xac, 0x0f, 0x30, 0x4e, 0xa8, 0x2c, 0x88, 0xee, 0x28, 0xc3, 0x3c, 0x86, 0xaa, 0xa8,
+	0x98, 0x47, 0x18, 0xba, 0x43, 0x44, 0x99, 0xb7, 0x6e, 0x7f, 0x22, 0xf2, 0xf9, 0x73, 0xde, 0x7c,
+	0x1e, 0x84, 0x5c, 0xe4, 0xed, 0x27, 0x34, 0x6b, 0x51, 0x77, 0x45, 0x0f, 0x9f, 0x8d, 0x91, 0x75,
+	0x7a, 0xe8, 0xff, 0xfe, 0xcb, 0x0e, 0x64, 0x85, 0xea, 0xa1, 0xef, 0x00, 0x0d, 0x63, 0x9b, 0x30,
+	0xdc, 0x47, 0x34, 0xdb, 0x50, 0xe3, 0x24, 0x0d, 0x90, 0xbb, 0x5e, 0x94, 0xf8, 0x27, 0x37, 0x45,
+	0x70, 0x36, 0xd4, 0xbd, 0x2d, 0xae, 0x0f, 0x08, 0x33, 0x3f, 0x85, 0xa7, 0xb9, 0x0a, 0xd7, 0x1f,
+	0x91, 0x38, 0x40, 0x57, 0x4a, 0x0c, 0x63, 0xc2, 0x93, 0xd4, 0x5a, 0xdf, 0xd6, 0xda, 0x86, 0x63,
+	0x79, 0x0a, 0xf7, 0x33, 0xe9, 0xd0, 0xbb, 0x79, 0x37, 0x9f, 0x81, 0x21, 0x22, 0x53, 0xf4, 0xc3,
+	0x71, 0x88, 0x31, 0xb7, 0x4a, 0x32, 0xcd, 0xea, 0x10, 0xd1, 0xc9, 0xef, 0xf6, 0x8a, 0xa2, 0xac,
+	0xad, 0xdf, 0x4a, 0x50, 0x55, 0x75, 0xce, 0x8a, 0xf6, 0x01, 0x54, 0x45, 0x83, 0x44, 0x3e, 0xee,
+	0x24, 0x8d, 0x64, 0xe5, 0x74, 0x7b, 0x63, 0x31, 0x6f, 0x42, 0x9f, 0x05, 0xaf, 0x67, 0x63, 0x7c,
+	0x93, 0x46, 0x0e, 0xd0, 0xe5, 0xd9, 0x3c, 0x02, 0x18, 0x86, 0x53, 0x1c, 0xc8, 0x18, 0x59, 0xd1,
+	0xca, 0xee, 0xee, 0x7f, 0x0c, 0xcb, 0x2a, 0x55, 0x67, 0x5f, 0x44, 0x2d, 0xcd, 0x2f, 0x0a, 0x8e,
+	0x2e, 0x71, 0x04, 0xae, 0xf9, 0x06, 0x20, 0x48, 0x49, 0xcc, 0x15, 0xa8, 0x9a, 0xc0, 0x8f, 0xee,
+	0x03, 0xda, 0x9b, 0xc5, 0x84, 0x86, 0xfe, 0x2d, 0x58, 0x89, 0x24, 0x61, 0x8f, 0x61, 0x93, 0x4e,
+	0x22, 0x1e, 0xba, 0x0c, 0xe3, 0x4c, 0x70, 0xf1, 0x51, 0xd8, 0x86, 0x84, 0x3b, 0xc2, 0x58, 0xc9,
+	0x1e, 0xc1, 0x96, 0x92, 0x4d, 0xa2, 0x28, 0x39, 0x25, 0xb1, 0x8f, 0x8a, 0x64, 0xfd, 0x51, 0x24,
+	0xa6, 0xc4, 0x7c, 0x95, 0x43, 0x4a, 0xa6, 0xaf, 0xa1, 0x7a, 0x9a, 0x92, 0xb1, 0xf8, 0xb5, 0x25,
+	0x43, 0xe9, 0xfe, 0x0c, 0x5f, 0xa9, 0xb8, 0x55, 0x86, 0x4a, 0x86, 0x25, 0xa0, 0xeb, 0xaf, 0x60,
+	0xe3, 0x76, 0x6b, 0xcc, 0xf7, 0x40, 0xb5, 0x46, 0x8e, 0xac, 0x5a, 0x3c, 0xd5, 0xc5, 0xbc, 0x59,
+	0xce, 0xdd, 0x9c, 0xf2, 0x30, 0x3b, 0xa9, 0xb1, 0xaa, 0x4f, 0xa0, 0x76, 0x37, 0x8f, 0x07, 0x80,
+	0x88, 0x21, 0xcc, 0xf7, 0x56, 0xc8, 0x91, 0x66, 0x7b, 0x4b, 0x0e, 0xa1, 0xda, 0x52, 0x5f, 0x72,
+	0xa4, 0x0e, 0x04, 0xcb, 0x73, 0x46, 0xfb, 0x2d, 0xd4, 0xee, 0x26, 0xf7, 0x10, 0xda, 0xa7, 0x20,
+	0xf6, 0xa7, 0x3b, 0xc0, 0x31, 0x1f, 0x49, 0x4e, 0xc3, 0x29, 0x53, 0x32, 0xed, 0x09, 0x5b, 0x31,
+	0xa8, 0xaf, 0x5d, 0x05, 0x90, 0xfa, 0xd4, 0xc6, 0xfb, 0xfc, 0x62, 0xd1, 0xd0, 0x2e, 0x17, 0x0d,
+	0xed, 0xaf, 0x45, 0x43, 0xfb, 0xe1, 0xaa, 0x51, 0xb8, 0xbc, 0x6a, 0x14, 0xfe, 0xb8, 0x6a, 0x14,
+	0xbe, 0x79, 0xff, 0x7f, 0x77, 0xc6, 0x72, 0x87, 0xc9, 0xe5, 0xe1, 0x95, 0xe4, 0xc6, 0xff, 0xf0,
+	0x9f, 0x01, 0x00, 0x20, 0x96, 0xc6, 0x06, 0x72, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -376,6 +572,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MinGasPerByte != that1.MinGasPerByte {
 		return false
 	}
+	if !this.FeeMarket.Equal(&that1.FeeMarket) {
+		return false
+	}
+	if this.DefaultMsgGas != that1.DefaultMsgGas {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketParams)
+	if !ok {
+		that2, ok := that.(FeeMarketParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.FeeRecipient != that1.FeeRecipient {
+		return false
+	}
 	return true
 }
 func (this *MsgGasParams) Equal(that interface{}) bool {
@@ -507,6 +748,30 @@ func (this *MsgGasParams_GrantAllowanceType) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgGasParams_WrapperType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasParams_WrapperType)
+	if !ok {
+		that2, ok := that.(MsgGasParams_WrapperType)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WrapperType.Equal(that1.WrapperType) {
+		return false
+	}
+	return true
+}
 func (this *MsgGasParams_FixedGasParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -558,6 +823,33 @@ func (this *MsgGasParams_DynamicGasParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgGasParams_WrapperGasParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasParams_WrapperGasParams)
+	if !ok {
+		that2, ok := that.(MsgGasParams_WrapperGasParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FixedGas != that1.FixedGas {
+		return false
+	}
+	if this.MaxDepth != that1.MaxDepth {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -578,6 +870,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DefaultMsgGas != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.DefaultMsgGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGashub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.MinGasPerByte != 0 {
 		i = encodeVarintGashub(dAtA, i, uint64(m.MinGasPerByte))
 		i--
@@ -591,6 +898,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintGashub(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGashub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGashub(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgGasParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -714,6 +1088,27 @@ func (m *MsgGasParams_GrantAllowanceType) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MsgGasParams_WrapperType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasParams_WrapperType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WrapperType != nil {
+		{
+			size, err := m.WrapperType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGashub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MsgGasParams_FixedGasParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -775,6 +1170,39 @@ func (m *MsgGasParams_DynamicGasParams) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGasParams_WrapperGasParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasParams_WrapperGasParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasParams_WrapperGasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepth != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FixedGas != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.FixedGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGashub(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGashub(v)
 	base := offset
@@ -798,6 +1226,39 @@ func (m *Params) Size() (n int) {
 	if m.MinGasPerByte != 0 {
 		n += 1 + sovGashub(uint64(m.MinGasPerByte))
 	}
+	l = m.FeeMarket.Size()
+	n += 1 + l + sovGashub(uint64(l))
+	if m.DefaultMsgGas != 0 {
+		n += 1 + sovGashub(uint64(m.DefaultMsgGas))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGashub(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGashub(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGashub(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovGashub(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovGashub(uint64(l))
+	}
 	return n
 }
 
@@ -865,6 +1326,18 @@ func (m *MsgGasParams_GrantAllowanceType) Size() (n int) {
 	}
 	return n
 }
+func (m *MsgGasParams_WrapperType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WrapperType != nil {
+		l = m.WrapperType.Size()
+		n += 1 + l + sovGashub(uint64(l))
+	}
+	return n
+}
 func (m *MsgGasParams_FixedGasParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -892,6 +1365,21 @@ func (m *MsgGasParams_DynamicGasParams) Size() (n int) {
 	return n
 }
 
+func (m *MsgGasParams_WrapperGasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FixedGas != 0 {
+		n += 1 + sovGashub(uint64(m.FixedGas))
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovGashub(uint64(m.MaxDepth))
+	}
+	return n
+}
+
 func sovGashub(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -965,6 +1453,264 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMsgGas", wireType)
+			}
+			m.DefaultMsgGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultMsgGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGashub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGashub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGashub(dAtA[iNdEx:])
@@ -1187,6 +1933,41 @@ func (m *MsgGasParams) Unmarshal(dAtA []byte) error {
 			}
 			m.GasParams = &MsgGasParams_GrantAllowanceType{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrapperType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgGasParams_WrapperGasParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.GasParams = &MsgGasParams_WrapperType{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGashub(dAtA[iNdEx:])
@@ -1365,6 +2146,94 @@ func (m *MsgGasParams_DynamicGasParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGasParams_WrapperGasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGashub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrapperGasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrapperGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedGas", wireType)
+			}
+			m.FixedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGashub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGashub(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Validate performs basic validation of supply genesis data returning an
@@ -14,6 +15,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if !gs.BaseFee.IsNil() && gs.BaseFee.IsNegative() {
+		return fmt.Errorf("invalid base fee: %s", gs.BaseFee)
+	}
+
 	seenMsgGasParams := make(map[string]bool)
 
 	for _, mgp := range gs.GetMsgGasParams() {
@@ -30,10 +35,11 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, msgGasParamsSet []MsgGasParams) *GenesisState {
+func NewGenesisState(params Params, msgGasParamsSet []MsgGasParams, baseFee sdk.Dec) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		MsgGasParams: msgGasParamsSet,
+		BaseFee:      baseFee,
 	}
 }
 
@@ -59,7 +65,6 @@ func DefaultGenesisState() *GenesisState {
 		*NewMsgGasParamsWithFixedGas("/greenfield.payment.MsgUpdateParams", 0),
 		*NewMsgGasParamsWithFixedGas("/greenfield.challenge.MsgUpdateParams", 0),
 		*NewMsgGasParamsWithFixedGas("/greenfield.permission.MsgUpdateParams", 0),
-		*NewMsgGasParamsWithFixedGas("/cosmos.authz.v1beta1.MsgExec", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.authz.v1beta1.MsgRevoke", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.bank.v1beta1.MsgSend", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgSetWithdrawAddress", 1.2e3),
@@ -67,7 +72,6 @@ func DefaultGenesisState() *GenesisState {
 		*NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.feegrant.v1beta1.MsgRevokeAllowance", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgDeposit", 1.2e3),
-		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgSubmitProposal", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVote", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVoteWeighted", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.oracle.v1.MsgClaim", 1e3),
@@ -155,8 +159,26 @@ func DefaultGenesisState() *GenesisState {
 				},
 			},
 		),
+		*NewMsgGasParamsWithDynamicGas(
+			"/cosmos.authz.v1beta1.MsgExec",
+			&MsgGasParams_WrapperType{
+				WrapperType: &MsgGasParams_WrapperGasParams{
+					FixedGas: 1.2e3,
+					MaxDepth: 2,
+				},
+			},
+		),
+		*NewMsgGasParamsWithDynamicGas(
+			"/cosmos.gov.v1.MsgSubmitProposal",
+			&MsgGasParams_WrapperType{
+				WrapperType: &MsgGasParams_WrapperGasParams{
+					FixedGas: 2e6,
+					MaxDepth: 2,
+				},
+			},
+		),
 	}
-	return NewGenesisState(DefaultParams(), defaultMsgGasParamsSet)
+	return NewGenesisState(DefaultParams(), defaultMsgGasParamsSet, DefaultMinBaseFee)
 }
 
 // GetGenesisStateFromAppState returns x/gashub GenesisState given raw application
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// msg_gas_params defines the gas consumption for a msg type.
 	MsgGasParams []MsgGasParams `protobuf:"bytes,2,rep,name=msg_gas_params,json=msgGasParams,proto3" json:"msg_gas_params"`
+	// base_fee is the base fee per unit of gas of the next block.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,23 +92,27 @@ func init() {
 }
 
 var fileDescriptor_665a04d9f9e8ff32 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4f, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x94, 0x70, 0x98, 0x08, 0xd1, 0x0b, 0x51, 0x23, 0x98,
-	0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0xcb, 0x18, 0xb9, 0x78, 0xdc, 0x21,
-	0xb6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x70, 0xb1, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16,
-	0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xea, 0x61, 0x75, 0x85, 0x5e, 0x00, 0x58, 0x91,
-	0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0x13, 0x0a,
-	0xe1, 0xe2, 0xcb, 0x2d, 0x4e, 0x8f, 0x4f, 0x4f, 0x2c, 0x8e, 0x87, 0x9a, 0xc4, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0xa4, 0x8c, 0xc3, 0x24, 0xdf, 0xe2, 0x74, 0xf7, 0xc4, 0x62, 0x4c, 0xf3, 0x78, 0x72,
-	0x91, 0x25, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d,
-	0xb3, 0x04, 0x64, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x34, 0x10, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6,
-	0x7e, 0x05, 0x2c, 0x44, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xde, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x4d, 0x57, 0x25, 0x64, 0x81, 0x01, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4b, 0x3a, 0x41,
+	0x18, 0xc7, 0x77, 0x7e, 0x82, 0xbf, 0x5c, 0x25, 0x68, 0x29, 0x30, 0xa1, 0x55, 0x14, 0x42, 0x0a,
+	0x67, 0xd0, 0xae, 0x1d, 0x42, 0x2c, 0x4f, 0x41, 0x58, 0x10, 0x74, 0x59, 0x66, 0xb7, 0xa7, 0x49,
+	0x62, 0x1c, 0xf1, 0x99, 0xa2, 0xde, 0x45, 0x2f, 0xa3, 0x63, 0x87, 0x5e, 0x84, 0x47, 0xe9, 0x14,
+	0x1d, 0x24, 0xf4, 0xd0, 0xcb, 0x28, 0x76, 0x66, 0x82, 0xa0, 0xec, 0xb2, 0x3b, 0x7f, 0x3e, 0xcf,
+	0xe7, 0xf9, 0xce, 0x8c, 0x5f, 0x4b, 0x14, 0x4a, 0x85, 0x4c, 0x70, 0xbc, 0xbc, 0x8e, 0xd9, 0x4d,
+	0x33, 0x06, 0xcd, 0x9b, 0x4c, 0xc0, 0x00, 0xb0, 0x8f, 0x74, 0x38, 0x52, 0x5a, 0x05, 0x6b, 0x16,
+	0xa2, 0x16, 0xa2, 0x0e, 0x2a, 0xad, 0x0a, 0x25, 0x94, 0x21, 0x58, 0x3a, 0xb2, 0x70, 0xa9, 0xba,
+	0xc0, 0x68, 0x6b, 0x2d, 0xb3, 0xc2, 0x65, 0x7f, 0xa0, 0x98, 0xf9, 0xba, 0xa5, 0x75, 0x5b, 0x16,
+	0x59, 0x9f, 0x6b, 0x68, 0x26, 0xd5, 0x0f, 0xe2, 0x17, 0xba, 0x36, 0xd0, 0xb1, 0xe6, 0x1a, 0x82,
+	0x3d, 0x3f, 0x3b, 0xe4, 0x23, 0x2e, 0xb1, 0x48, 0x2a, 0xa4, 0x9e, 0x6f, 0x6d, 0xd0, 0x5f, 0x03,
+	0xd2, 0x23, 0x03, 0xb5, 0x73, 0xe3, 0x69, 0xd9, 0x7b, 0x78, 0x7f, 0xdc, 0x22, 0x3d, 0x57, 0x17,
+	0x9c, 0xf8, 0xcb, 0x12, 0x45, 0x24, 0x38, 0x46, 0xce, 0xf4, 0xaf, 0x92, 0xa9, 0xe7, 0x5b, 0xb5,
+	0x05, 0xa6, 0x43, 0x14, 0x5d, 0x8e, 0x3f, 0x7d, 0x05, 0xf9, 0x6d, 0x23, 0x38, 0xf5, 0x97, 0x62,
+	0x8e, 0x10, 0x5d, 0x00, 0x14, 0x33, 0x15, 0x52, 0xcf, 0xb5, 0x77, 0x53, 0xf4, 0x75, 0x5a, 0xde,
+	0x14, 0x7d, 0x9d, 0xfa, 0x12, 0x25, 0xdd, 0xd9, 0xdc, 0xaf, 0x81, 0xe7, 0x57, 0x4c, 0xdf, 0x0d,
+	0x01, 0x69, 0x07, 0x92, 0xe7, 0xa7, 0x86, 0xef, 0x02, 0x74, 0x20, 0xe9, 0xfd, 0x4f, 0x6d, 0x07,
+	0x00, 0xed, 0xfd, 0xf1, 0x2c, 0x24, 0x93, 0x59, 0x48, 0xde, 0x66, 0x21, 0xb9, 0x9f, 0x87, 0xde,
+	0x64, 0x1e, 0x7a, 0x2f, 0xf3, 0xd0, 0x3b, 0xdb, 0xfe, 0x53, 0x7c, 0xfb, 0xf5, 0x0a, 0xa6, 0x43,
+	0x9c, 0x35, 0xf7, 0xb9, 0xf3, 0x39, 0x00, 0x0a, 0x2b, 0x8e, 0x52, 0xf5, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +135,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.MsgGasParams) > 0 {
 		for iNdEx := len(m.MsgGasParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +197,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +304,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
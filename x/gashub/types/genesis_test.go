@@ -19,6 +19,7 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{"default genesisState", *DefaultGenesisState(), false},
 		{"empty genesisState", GenesisState{}, true},
 		{
 			"invalid params ",
@@ -29,6 +30,30 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"wrapper msg gas params without max depth",
+			GenesisState{
+				Params: DefaultParams(),
+				MsgGasParams: []MsgGasParams{
+					*NewMsgGasParamsWithDynamicGas("/cosmos.authz.v1beta1.MsgExec", &MsgGasParams_WrapperType{
+						WrapperType: &MsgGasParams_WrapperGasParams{FixedGas: 1200},
+					}),
+				},
+			},
+			true,
+		},
+		{
+			"wrapper msg gas params of a msg not wrapping msgs",
+			GenesisState{
+				Params: DefaultParams(),
+				MsgGasParams: []MsgGasParams{
+					*NewMsgGasParamsWithDynamicGas("/cosmos.bank.v1beta1.MsgSend", &MsgGasParams_WrapperType{
+						WrapperType: &MsgGasParams_WrapperGasParams{FixedGas: 1200, MaxDepth: 2},
+					}),
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
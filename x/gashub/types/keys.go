@@ -17,6 +17,8 @@ var (
 	ParamsKey = []byte{0x00} // key for x/gashub module params
 
 	MsgGasParamsPrefix = []byte{0x01} // key for msg gas params
+
+	BaseFeeKey = []byte{0x02} // key for the base fee of the next block
 )
 
 func GetMsgTypeUrl(key []byte) string {
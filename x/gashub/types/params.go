@@ -2,14 +2,30 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
 const (
 	DefaultMaxTxSize     uint64 = 64 * 1024 // 64kb
 	DefaultMinGasPerByte uint64 = 5
+	DefaultDefaultMsgGas uint64 = 0
+
+	DefaultTargetBlockGas           uint64 = 1e7
+	DefaultBaseFeeChangeDenominator uint32 = 8
 )
 
+// DefaultMinBaseFee is the default lower bound of the base fee per unit of gas
+var DefaultMinBaseFee = sdk.NewDec(5e9)
+
+// HasDefaultMsgGasParams is implemented by the modules declaring the default gas params of their msgs.
+// They are set for the msg types without msg gas params at genesis, or when an upgrade handler calls
+// Keeper.SetDefaultMsgGasParams.
+type HasDefaultMsgGasParams interface {
+	DefaultMsgGasParams() []MsgGasParams
+}
+
 // NewMsgGasParamsWithFixedGas creates a new MsgGasParams object with fixed gas
 func NewMsgGasParamsWithFixedGas(msgTypeUrl string, gas uint64) *MsgGasParams {
 	return &MsgGasParams{
@@ -33,6 +49,8 @@ func NewParams(
 	return Params{
 		MaxTxSize:     maxTxSize,
 		MinGasPerByte: minGasPerByte,
+		FeeMarket:     DefaultFeeMarketParams(),
+		DefaultMsgGas: DefaultDefaultMsgGas,
 	}
 }
 
@@ -41,6 +59,19 @@ func DefaultParams() Params {
 	return Params{
 		MaxTxSize:     DefaultMaxTxSize,
 		MinGasPerByte: DefaultMinGasPerByte,
+		FeeMarket:     DefaultFeeMarketParams(),
+		DefaultMsgGas: DefaultDefaultMsgGas,
+	}
+}
+
+// DefaultFeeMarketParams returns a default set of fee market parameters, the base fee being disabled.
+func DefaultFeeMarketParams() FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                  false,
+		Denom:                    sdk.DefaultBondDenom,
+		MinBaseFee:               DefaultMinBaseFee,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
 	}
 }
 
@@ -80,6 +111,32 @@ func (p Params) Validate() error {
 	if err := validateMinGasPerByte(p.MinGasPerByte); err != nil {
 		return err
 	}
+	if err := p.FeeMarket.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate checks that the fee market parameters have valid values. They are only checked
+// when the base fee is enabled.
+func (p FeeMarketParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("invalid min base fee: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("invalid target block gas: %d", p.TargetBlockGas)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("invalid base fee change denominator: %d", p.BaseFeeChangeDenominator)
+	}
 
 	return nil
 }
@@ -105,6 +162,13 @@ func (mgp MsgGasParams) Validate() error {
 		if p.GrantAllowanceType.FixedGas == 0 || p.GrantAllowanceType.GasPerItem == 0 {
 			return fmt.Errorf("invalid gas. cannot be zero")
 		}
+	case *MsgGasParams_WrapperType:
+		if !IsWrapperMsgType(mgp.MsgTypeUrl) {
+			return fmt.Errorf("invalid wrapper gas params. msg type %s does not wrap msgs", mgp.MsgTypeUrl)
+		}
+		if p.WrapperType.MaxDepth == 0 {
+			return fmt.Errorf("invalid max depth. cannot be zero")
+		}
 	default:
 		return fmt.Errorf("unknown or unspecified gas type")
 	}
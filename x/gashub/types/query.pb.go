@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -224,48 +225,148 @@ func (m *QueryMsgGasParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBaseFeeRequest defines the request type for querying the base fee.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af85680fb3beada8, []int{4}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse defines the response type for querying the base fee.
+type QueryBaseFeeResponse struct {
+	// base_fee is the base fee per unit of gas of the next block.
+	BaseFee types.DecCoin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// enabled defines whether the base fee is enforced.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af85680fb3beada8, []int{5}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.gashub.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.gashub.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryMsgGasParamsRequest)(nil), "cosmos.gashub.v1beta1.QueryMsgGasParamsRequest")
 	proto.RegisterType((*QueryMsgGasParamsResponse)(nil), "cosmos.gashub.v1beta1.QueryMsgGasParamsResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.gashub.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.gashub.v1beta1.QueryBaseFeeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gashub/v1beta1/query.proto", fileDescriptor_af85680fb3beada8) }
 
 var fileDescriptor_af85680fb3beada8 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x3b, 0xbb, 0x58, 0xd8, 0xe9, 0x2a, 0x38, 0xae, 0x50, 0xa3, 0x9b, 0xd5, 0x88, 0x6e,
-	0xad, 0x98, 0x71, 0xe3, 0x17, 0x90, 0x05, 0x5d, 0x3c, 0x08, 0x1a, 0x14, 0xc1, 0x4b, 0x99, 0xd4,
-	0x61, 0x0c, 0x36, 0x99, 0x6c, 0xde, 0x64, 0xb1, 0x57, 0x0f, 0x22, 0x78, 0x11, 0xfc, 0x0c, 0x82,
-	0x37, 0xfd, 0x02, 0xde, 0xf7, 0xb8, 0xe0, 0xc5, 0x93, 0x48, 0x2b, 0xf8, 0x35, 0x64, 0xfe, 0x74,
-	0x9b, 0x60, 0xea, 0xf6, 0xd2, 0x26, 0x33, 0xcf, 0xfb, 0xbc, 0xbf, 0x67, 0xde, 0x09, 0xbe, 0x32,
-	0x94, 0x90, 0x48, 0xa0, 0x82, 0xc1, 0xcb, 0x32, 0xa2, 0x07, 0x3b, 0x11, 0x2f, 0xd8, 0x0e, 0xdd,
-	0x2f, 0x79, 0x3e, 0xf6, 0xb3, 0x5c, 0x16, 0x92, 0x9c, 0x37, 0x12, 0xdf, 0x48, 0x7c, 0x2b, 0x71,
-	0x36, 0x84, 0x14, 0x52, 0x2b, 0xa8, 0x7a, 0x32, 0x62, 0xe7, 0x92, 0x90, 0x52, 0x8c, 0x38, 0x65,
-	0x59, 0x4c, 0x59, 0x9a, 0xca, 0x82, 0x15, 0xb1, 0x4c, 0xc1, 0xee, 0x7a, 0xcd, 0xdd, 0xac, 0xb3,
-	0xd1, 0x9c, 0x65, 0x49, 0x9c, 0x4a, 0xaa, 0x7f, 0xed, 0xd2, 0x45, 0x5b, 0xa6, 0xa9, 0xe8, 0x41,
-	0x0d, 0xcf, 0xe9, 0xdb, 0xcd, 0x88, 0x01, 0x3f, 0x56, 0x18, 0xdf, 0x8c, 0x89, 0x38, 0xd5, 0x00,
-	0x46, 0xeb, 0x6d, 0x60, 0xf2, 0x58, 0x29, 0x1e, 0xb1, 0x9c, 0x25, 0x10, 0xf2, 0xfd, 0x92, 0x43,
-	0xe1, 0x3d, 0xc3, 0xe7, 0x6a, 0xab, 0x90, 0xc9, 0x14, 0x38, 0xb9, 0x8b, 0xdb, 0x99, 0x5e, 0xe9,
-	0xa2, 0xcb, 0xa8, 0xd7, 0x09, 0x36, 0xfd, 0xc6, 0x83, 0xf0, 0x4d, 0xd9, 0xee, 0xda, 0xe1, 0xcf,
-	0xad, 0xd6, 0xe7, 0x3f, 0x5f, 0xfb, 0x28, 0xb4, 0x75, 0xde, 0x5b, 0x84, 0xbb, 0xda, 0xf9, 0x21,
-	0x88, 0x3d, 0x06, 0xb5, 0xae, 0xc4, 0xc3, 0xa7, 0x13, 0x10, 0x83, 0x62, 0x9c, 0xf1, 0x41, 0x99,
-	0x8f, 0x54, 0x97, 0xd5, 0xde, 0x5a, 0xd8, 0x49, 0x40, 0x3c, 0x19, 0x67, 0xfc, 0x69, 0x3e, 0x02,
-	0x72, 0x1f, 0xe3, 0x79, 0x86, 0xee, 0x50, 0x63, 0x5c, 0x9f, 0x61, 0xa8, 0xc0, 0xbe, 0x39, 0x89,
-	0x39, 0x8a, 0xe0, 0xd6, 0x3f, 0xac, 0x54, 0x7a, 0x5f, 0x10, 0xbe, 0xd0, 0x00, 0x62, 0x83, 0x3e,
-	0xc0, 0x67, 0x14, 0x89, 0x60, 0x30, 0x38, 0x0e, 0xbc, 0xda, 0xeb, 0x04, 0x57, 0x17, 0x04, 0xae,
-	0x99, 0xac, 0x27, 0x95, 0x37, 0xb2, 0xd7, 0x00, 0xbc, 0x7d, 0x22, 0xb0, 0xe1, 0xa8, 0x12, 0x07,
-	0xdf, 0x56, 0xf0, 0x29, 0x4d, 0x4c, 0xde, 0x23, 0xdc, 0xb6, 0xee, 0x37, 0x16, 0x00, 0xfd, 0x3b,
-	0x53, 0xa7, 0xbf, 0x8c, 0xd4, 0xf4, 0xf5, 0xfa, 0xef, 0xd4, 0xd4, 0xde, 0x7c, 0xff, 0xfd, 0x71,
-	0x65, 0x8b, 0x6c, 0xd2, 0xe6, 0x3b, 0x6a, 0x4e, 0x86, 0x7c, 0x42, 0x78, 0xbd, 0x9a, 0x9f, 0xd0,
-	0xff, 0x35, 0x6a, 0x98, 0xbb, 0x73, 0x7b, 0xf9, 0x02, 0xcb, 0x17, 0xcc, 0xf9, 0xb6, 0xc9, 0xb5,
-	0x05, 0x7c, 0xf5, 0x09, 0xee, 0xde, 0x3b, 0x9c, 0xb8, 0xe8, 0x68, 0xe2, 0xa2, 0x5f, 0x13, 0x17,
-	0x7d, 0x98, 0xba, 0xad, 0xa3, 0xa9, 0xdb, 0xfa, 0x31, 0x75, 0x5b, 0xcf, 0x6f, 0x8a, 0xb8, 0x50,
-	0x7d, 0x87, 0x32, 0x99, 0x59, 0x99, 0xbf, 0x5b, 0xf0, 0xe2, 0x15, 0x7d, 0x3d, 0xf3, 0x55, 0xf7,
-	0x12, 0xa2, 0xb6, 0xfe, 0x6e, 0xee, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x33, 0x63, 0x00, 0x9e,
-	0x27, 0x04, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x55, 0xb4, 0x9b, 0x3b, 0x90, 0x30, 0x9d, 0x14, 0xc2, 0x96, 0x6e, 0x41, 0xb0,
+	0xd2, 0x41, 0xcc, 0xca, 0x0b, 0xa0, 0x02, 0x9b, 0x38, 0x20, 0x41, 0x04, 0x42, 0xe2, 0x52, 0x39,
+	0x99, 0x31, 0x11, 0x4d, 0x9c, 0xc5, 0xe9, 0x44, 0xaf, 0x1c, 0x10, 0x12, 0x97, 0x49, 0x3c, 0x03,
+	0x12, 0x37, 0x78, 0x8c, 0x1d, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0x8b, 0xc4, 0x6b, 0xa0, 0xc4, 0xce,
+	0x9a, 0x88, 0x74, 0xeb, 0xa5, 0x6d, 0xec, 0xff, 0xf7, 0xff, 0x7e, 0x7f, 0x7f, 0x4e, 0xe1, 0x96,
+	0xcb, 0x85, 0xcf, 0x05, 0x66, 0x44, 0xbc, 0x19, 0x3a, 0xf8, 0x68, 0xd7, 0xa1, 0x31, 0xd9, 0xc5,
+	0x87, 0x43, 0x1a, 0x8d, 0xac, 0x30, 0xe2, 0x31, 0x47, 0x6b, 0x52, 0x62, 0x49, 0x89, 0xa5, 0x24,
+	0x7a, 0x93, 0x71, 0xc6, 0x53, 0x05, 0x4e, 0x7e, 0x49, 0xb1, 0xbe, 0xce, 0x38, 0x67, 0x03, 0x8a,
+	0x49, 0xe8, 0x61, 0x12, 0x04, 0x3c, 0x26, 0xb1, 0xc7, 0x03, 0xa1, 0x76, 0xcd, 0xf2, 0x6e, 0xca,
+	0x59, 0x6a, 0x2e, 0x13, 0xdf, 0x0b, 0x38, 0x4e, 0x3f, 0xd5, 0xd2, 0x35, 0x55, 0x96, 0x52, 0xe1,
+	0xa3, 0x02, 0x9e, 0xde, 0x51, 0x9b, 0x0e, 0x11, 0xf4, 0x54, 0x21, 0x7d, 0x43, 0xc2, 0xbc, 0x20,
+	0x05, 0x50, 0x5a, 0x23, 0xaf, 0xcd, 0x54, 0x2e, 0xf7, 0xd4, 0xbe, 0xd9, 0x84, 0xe8, 0x59, 0xe2,
+	0xf0, 0x94, 0x44, 0xc4, 0x17, 0x36, 0x3d, 0x1c, 0x52, 0x11, 0x9b, 0x2f, 0xe1, 0x95, 0xc2, 0xaa,
+	0x08, 0x79, 0x20, 0x28, 0xba, 0x0f, 0x6b, 0x61, 0xba, 0xa2, 0x81, 0x4d, 0xd0, 0x6e, 0x74, 0x37,
+	0xac, 0xd2, 0x83, 0xb2, 0x64, 0x59, 0x6f, 0xe5, 0xe4, 0x57, 0xab, 0xf2, 0xf5, 0xef, 0xf7, 0x0e,
+	0xb0, 0x55, 0x9d, 0xf9, 0x01, 0x40, 0x2d, 0x75, 0x7e, 0x22, 0xd8, 0x3e, 0x11, 0x85, 0xae, 0xc8,
+	0x84, 0x17, 0x7d, 0xc1, 0xfa, 0xf1, 0x28, 0xa4, 0xfd, 0x61, 0x34, 0x48, 0xba, 0x54, 0xdb, 0x2b,
+	0x76, 0xc3, 0x17, 0xec, 0xf9, 0x28, 0xa4, 0x2f, 0xa2, 0x81, 0x40, 0x7b, 0x10, 0xce, 0x32, 0x6a,
+	0x6e, 0x8a, 0x71, 0x33, 0xc3, 0x48, 0x42, 0x5a, 0xf2, 0xa4, 0x66, 0x28, 0x8c, 0x2a, 0x7f, 0x3b,
+	0x57, 0x69, 0x7e, 0x03, 0xf0, 0x6a, 0x09, 0x88, 0x0a, 0xfa, 0x18, 0x5e, 0x4a, 0x48, 0x18, 0x11,
+	0xfd, 0xd3, 0xc0, 0xd5, 0x76, 0xa3, 0x7b, 0x7d, 0x4e, 0xe0, 0x82, 0xc9, 0xaa, 0x9f, 0x7b, 0x42,
+	0xfb, 0x25, 0xc0, 0xdb, 0xe7, 0x02, 0x4b, 0x8e, 0x02, 0xf1, 0x9a, 0x9a, 0x49, 0x8f, 0x08, 0xba,
+	0x47, 0xb3, 0x50, 0x66, 0x0c, 0x9b, 0xc5, 0x65, 0x15, 0xa1, 0x07, 0x97, 0x13, 0xf7, 0xfe, 0x6b,
+	0x4a, 0xd5, 0xb4, 0xd6, 0x0b, 0x5d, 0xb3, 0x7e, 0x0f, 0xa9, 0xfb, 0x80, 0x7b, 0x41, 0x7e, 0x58,
+	0x75, 0x47, 0x7a, 0x21, 0x0d, 0xd6, 0x69, 0x40, 0x9c, 0x01, 0x3d, 0xd0, 0x96, 0x36, 0x41, 0x7b,
+	0xd9, 0xce, 0x1e, 0xbb, 0xe3, 0x2a, 0xbc, 0x90, 0xb6, 0x45, 0x9f, 0x00, 0xac, 0xa9, 0xa8, 0xb7,
+	0xe6, 0x9c, 0xce, 0xff, 0x17, 0x4c, 0xef, 0x2c, 0x22, 0x95, 0x49, 0xcc, 0xce, 0xc7, 0x84, 0xea,
+	0xfd, 0x8f, 0x3f, 0x9f, 0x97, 0x5a, 0x68, 0x03, 0x97, 0xbf, 0x50, 0x72, 0x4c, 0xe8, 0x0b, 0x80,
+	0xab, 0xf9, 0x61, 0x20, 0x7c, 0x56, 0xa3, 0x92, 0x4b, 0xa8, 0xdf, 0x5d, 0xbc, 0x40, 0xf1, 0x75,
+	0x67, 0x7c, 0xdb, 0xe8, 0xc6, 0x1c, 0xbe, 0xe2, 0x75, 0x42, 0xc7, 0x00, 0xd6, 0xd5, 0xc4, 0xd0,
+	0x99, 0x67, 0x51, 0x9c, 0xb6, 0xbe, 0xb3, 0x90, 0x56, 0x81, 0xdd, 0x9e, 0x81, 0x6d, 0xa1, 0xd6,
+	0x1c, 0xb0, 0xec, 0x92, 0xf4, 0x1e, 0x9d, 0x4c, 0x0c, 0x30, 0x9e, 0x18, 0xe0, 0xf7, 0xc4, 0x00,
+	0xc7, 0x53, 0xa3, 0x32, 0x9e, 0x1a, 0x95, 0x9f, 0x53, 0xa3, 0xf2, 0x6a, 0x87, 0x79, 0x71, 0xd2,
+	0xcc, 0xe5, 0x7e, 0x66, 0x22, 0xbf, 0xee, 0x88, 0x83, 0xb7, 0xf8, 0x5d, 0xe6, 0x98, 0xbc, 0xb7,
+	0xc2, 0xa9, 0xa5, 0xff, 0x2b, 0xf7, 0xfe, 0x0d, 0x00, 0xa5, 0x69, 0xce, 0x0c, 0x67, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +388,8 @@ type QueryClient interface {
 	// This query only returns params that have specific MsgGasParams settings.
 	// Any msg type that does not have a specific setting will not be returned by this query.
 	MsgGasParams(ctx context.Context, in *QueryMsgGasParamsRequest, opts ...grpc.CallOption) (*QueryMsgGasParamsResponse, error)
+	// BaseFee queries the base fee per unit of gas of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -315,6 +418,15 @@ func (c *queryClient) MsgGasParams(ctx context.Context, in *QueryMsgGasParamsReq
 	return out, nil
 }
 
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gashub.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/gashub module.
@@ -324,6 +436,8 @@ type QueryServer interface {
 	// This query only returns params that have specific MsgGasParams settings.
 	// Any msg type that does not have a specific setting will not be returned by this query.
 	MsgGasParams(context.Context, *QueryMsgGasParamsRequest) (*QueryMsgGasParamsResponse, error)
+	// BaseFee queries the base fee per unit of gas of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -336,6 +450,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) MsgGasParams(ctx context.Context, req *QueryMsgGasParamsRequest) (*QueryMsgGasParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MsgGasParams not implemented")
 }
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -377,6 +494,24 @@ func _Query_MsgGasParams_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gashub.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gashub.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -389,6 +524,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MsgGasParams",
 			Handler:    _Query_MsgGasParams_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gashub/v1beta1/query.proto",
@@ -547,6 +686,72 @@ func (m *QueryMsgGasParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -616,6 +821,29 @@ func (m *QueryMsgGasParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -993,6 +1221,159 @@ func (m *QueryMsgGasParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
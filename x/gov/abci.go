@@ -85,6 +85,12 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 						break
 					}
 
+					// track the delivery of the cross-chain params changes sent by the proposal
+					err = keeper.TrackCrossChainDelivery(cacheCtx, proposal.Id, msg, res)
+					if err != nil {
+						break
+					}
+
 					events = append(events, res.GetEvents()...)
 				}
 			}
@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryCrossChainDeliveries(),
 	)
 
 	return govQueryCmd
@@ -508,6 +509,63 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryCrossChainDeliveries implements the command to query the delivery status of
+// cross-chain params changes sent by proposals.
+func GetCmdQueryCrossChainDeliveries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-deliveries [proposal-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the delivery status of cross-chain params changes sent by proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delivery status of the cross-chain params changes sent by proposals.
+If a proposal-id is given, only the deliveries of that proposal are returned.
+
+Example:
+$ %s query gov cross-chain-deliveries
+$ %s query gov cross-chain-deliveries 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			var proposalID uint64
+			if len(args) > 0 {
+				// validate that the proposal id is a uint
+				proposalID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CrossChainDeliveries(
+				cmd.Context(),
+				&v1.QueryCrossChainDeliveriesRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "cross-chain deliveries")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -40,6 +40,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetProposal(ctx, *proposal)
 	}
 
+	for _, delivery := range data.CrossChainDeliveries {
+		k.SetCrossChainDelivery(ctx, *delivery)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -69,10 +73,11 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
+		StartingProposalId:   startingProposalID,
+		Deposits:             proposalsDeposits,
+		Votes:                proposalsVotes,
+		Proposals:            proposals,
+		Params:               &params,
+		CrossChainDeliveries: k.GetAllCrossChainDeliveries(ctx),
 	}
 }
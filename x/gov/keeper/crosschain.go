@@ -27,19 +27,27 @@ func (app SyncParamsApp) ExecuteSynPackage(ctx sdk.Context, _ *sdk.CrossChainApp
 	return sdk.ExecuteResult{}
 }
 
-func (app SyncParamsApp) ExecuteAckPackage(ctx sdk.Context, _ *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
-	app.keeper.Logger(ctx).Error("received sync params in ack package", "payload", hex.EncodeToString(payload))
+func (app SyncParamsApp) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Info("received sync params ack package", "src_chain_id", appCtx.SrcChainId, "sequence", appCtx.Sequence, "payload", hex.EncodeToString(payload))
+	if err := app.keeper.UpdateCrossChainDeliveryStatus(ctx, appCtx.SrcChainId, appCtx.Sequence, govv1.DeliveryStatusAcked, payload); err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
 	return sdk.ExecuteResult{}
 }
 
-func (app SyncParamsApp) ExecuteFailAckPackage(ctx sdk.Context, _ *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
-	app.keeper.Logger(ctx).Error("received sync params fail ack package", "payload", hex.EncodeToString(payload))
+func (app SyncParamsApp) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Error("received sync params fail ack package", "src_chain_id", appCtx.SrcChainId, "sequence", appCtx.Sequence, "payload", hex.EncodeToString(payload))
+	if err := app.keeper.UpdateCrossChainDeliveryStatus(ctx, appCtx.SrcChainId, appCtx.Sequence, govv1.DeliveryStatusFailed, payload); err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
 	return sdk.ExecuteResult{}
 }
 
-func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.CrossChainParamsChange) error {
+// SyncParams sends the cross-chain params change to the destination chain and returns the sequence
+// of the sync params package.
+func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.CrossChainParamsChange) (uint64, error) {
 	if err := cpc.ValidateBasic(); err != nil {
-		return err
+		return 0, err
 	}
 	values := make([]byte, 0)
 	addresses := make([]byte, 0)
@@ -52,7 +60,7 @@ func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.C
 		} else {
 			value, err = hex.DecodeString(v)
 			if err != nil {
-				return sdkerrors.Wrapf(types.ErrInvalidValue, "value is not valid %s", v)
+				return 0, sdkerrors.Wrapf(types.ErrInvalidValue, "value is not valid %s", v)
 			}
 		}
 		values = append(values, value...)
@@ -68,14 +76,14 @@ func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.C
 
 	encodedPackage, err := pack.Serialize()
 	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "fail to serialize, err: %s", err.Error())
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "fail to serialize, err: %s", err.Error())
 	}
 
 	if !k.crossChainKeeper.IsDestChainSupported(destChainId) {
-		return sdkerrors.Wrapf(types.ErrChainNotSupported, "destination chain (%d) is not supported", destChainId)
+		return 0, sdkerrors.Wrapf(types.ErrChainNotSupported, "destination chain (%d) is not supported", destChainId)
 	}
 
-	_, err = k.crossChainKeeper.CreateRawIBCPackageWithFee(
+	return k.crossChainKeeper.CreateRawIBCPackageWithFee(
 		ctx,
 		destChainId,
 		types.SyncParamsChannelID,
@@ -84,5 +92,150 @@ func (k Keeper) SyncParams(ctx sdk.Context, destChainId sdk.ChainID, cpc govv1.C
 		big.NewInt(0),
 		big.NewInt(0),
 	)
-	return err
+}
+
+// ValidateCrossChainParamsUpdates checks that all the params changes of a batch are valid and that
+// all their destination chains are supported.
+func (k Keeper) ValidateCrossChainParamsUpdates(ctx sdk.Context, updates []govv1.CrossChainParamsUpdate) error {
+	if len(updates) == 0 {
+		return sdkerrors.Wrap(types.ErrEmptyChange, "no cross-chain params updates")
+	}
+	for i, update := range updates {
+		if err := update.Params.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "update %d to chain %d", i, update.DestChainId)
+		}
+		if !k.crossChainKeeper.IsDestChainSupported(sdk.ChainID(update.DestChainId)) {
+			return sdkerrors.Wrapf(types.ErrChainNotSupported, "update %d: destination chain (%d) is not supported", i, update.DestChainId)
+		}
+	}
+	return nil
+}
+
+// TrackCrossChainDelivery records the deliveries of the params changes sent by a MsgUpdateCrossChainParams
+// or a MsgUpdateCrossChainParamsBatch executed by a proposal as pending. It is a no-op for other messages.
+func (k Keeper) TrackCrossChainDelivery(ctx sdk.Context, proposalID uint64, msg sdk.Msg, res *sdk.Result) error {
+	var deliveries []govv1.CrossChainDelivery
+	switch msg := msg.(type) {
+	case *govv1.MsgUpdateCrossChainParams:
+		updateRes, ok := singleMsgResponse(res).(*govv1.MsgUpdateCrossChainParamsResponse)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "unexpected msg responses for %s", sdk.MsgTypeURL(msg))
+		}
+		deliveries = append(deliveries, govv1.NewCrossChainDelivery(proposalID, msg.DestChainId, updateRes.Sequence, msg.Params))
+	case *govv1.MsgUpdateCrossChainParamsBatch:
+		batchRes, ok := singleMsgResponse(res).(*govv1.MsgUpdateCrossChainParamsBatchResponse)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "unexpected msg responses for %s", sdk.MsgTypeURL(msg))
+		}
+		if len(batchRes.Sequences) != len(msg.Updates) {
+			return sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "expected %d sequences, got %d", len(msg.Updates), len(batchRes.Sequences))
+		}
+		for i, update := range msg.Updates {
+			deliveries = append(deliveries, govv1.NewCrossChainDelivery(proposalID, update.DestChainId, batchRes.Sequences[i], update.Params))
+		}
+	default:
+		return nil
+	}
+
+	for _, delivery := range deliveries {
+		k.SetCrossChainDelivery(ctx, delivery)
+		if err := emitCrossChainDeliveryEvent(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// singleMsgResponse returns the unpacked msg response of a result holding exactly one, nil otherwise.
+func singleMsgResponse(res *sdk.Result) interface{} {
+	if res == nil || len(res.MsgResponses) != 1 {
+		return nil
+	}
+	return res.MsgResponses[0].GetCachedValue()
+}
+
+// UpdateCrossChainDeliveryStatus updates the status of the delivery of the sync params package sent
+// to the destination chain with the given sequence. The destination chain acknowledges the sync
+// params packages in order, so the sequence of an ack or fail ack package is the sequence of the
+// package it acknowledges. Packages that were not sent by a proposal are ignored.
+func (k Keeper) UpdateCrossChainDeliveryStatus(ctx sdk.Context, destChainID sdk.ChainID, sequence uint64, status govv1.CrossChainDeliveryStatus, ackPayload []byte) error {
+	delivery, found := k.GetCrossChainDeliveryBySequence(ctx, destChainID, sequence)
+	if !found {
+		k.Logger(ctx).Info("no cross-chain delivery found for ack package", "dest_chain_id", destChainID, "sequence", sequence)
+		return nil
+	}
+
+	delivery.Status = status
+	delivery.AckPayload = ackPayload
+	k.SetCrossChainDelivery(ctx, delivery)
+
+	return emitCrossChainDeliveryEvent(ctx, delivery)
+}
+
+// GetCrossChainDelivery gets the delivery of a params change sent by a proposal
+func (k Keeper) GetCrossChainDelivery(ctx sdk.Context, proposalID uint64, destChainID sdk.ChainID, sequence uint64) (delivery govv1.CrossChainDelivery, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CrossChainDeliveryKey(proposalID, destChainID, sequence))
+	if bz == nil {
+		return delivery, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &delivery)
+	return delivery, true
+}
+
+// GetCrossChainDeliveryBySequence gets the delivery of the sync params package sent to the
+// destination chain with the given sequence
+func (k Keeper) GetCrossChainDeliveryBySequence(ctx sdk.Context, destChainID sdk.ChainID, sequence uint64) (delivery govv1.CrossChainDelivery, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CrossChainDeliveryBySequenceKey(destChainID, sequence))
+	if bz == nil {
+		return delivery, false
+	}
+
+	return k.GetCrossChainDelivery(ctx, types.GetProposalIDFromBytes(bz), destChainID, sequence)
+}
+
+// SetCrossChainDelivery sets a cross-chain delivery to the gov store
+func (k Keeper) SetCrossChainDelivery(ctx sdk.Context, delivery govv1.CrossChainDelivery) {
+	store := ctx.KVStore(k.storeKey)
+	destChainID := sdk.ChainID(delivery.DestChainId)
+	store.Set(types.CrossChainDeliveryKey(delivery.ProposalId, destChainID, delivery.Sequence), k.cdc.MustMarshal(&delivery))
+	store.Set(types.CrossChainDeliveryBySequenceKey(destChainID, delivery.Sequence), types.GetProposalIDBytes(delivery.ProposalId))
+}
+
+// GetAllCrossChainDeliveries returns all the cross-chain deliveries from the store
+func (k Keeper) GetAllCrossChainDeliveries(ctx sdk.Context) (deliveries []*govv1.CrossChainDelivery) {
+	k.IterateAllCrossChainDeliveries(ctx, func(delivery govv1.CrossChainDelivery) bool {
+		deliveries = append(deliveries, &delivery)
+		return false
+	})
+	return
+}
+
+// IterateAllCrossChainDeliveries iterates over all the stored cross-chain deliveries and performs
+// a callback function.
+func (k Keeper) IterateAllCrossChainDeliveries(ctx sdk.Context, cb func(delivery govv1.CrossChainDelivery) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CrossChainDeliveriesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delivery govv1.CrossChainDelivery
+		k.cdc.MustUnmarshal(iterator.Value(), &delivery)
+
+		if cb(delivery) {
+			break
+		}
+	}
+}
+
+func emitCrossChainDeliveryEvent(ctx sdk.Context, delivery govv1.CrossChainDelivery) error {
+	return ctx.EventManager().EmitTypedEvent(&govv1.EventCrossChainDelivery{
+		ProposalId:  delivery.ProposalId,
+		DestChainId: delivery.DestChainId,
+		Sequence:    delivery.Sequence,
+		Key:         delivery.Key,
+		Status:      delivery.Status,
+	})
 }
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestCrossChainDelivery() {
+	suite.reset()
+	ctx := suite.ctx
+
+	msg := &v1.MsgUpdateCrossChainParams{
+		Authority:   suite.govKeeper.GetAuthority(),
+		DestChainId: 714,
+		Params: v1.CrossChainParamsChange{
+			Key:     "batchSizeForOracle",
+			Values:  []string{"0000000000000000000000000000000000000000000000000000000000000033"},
+			Targets: []string{"0x6c615C766EE6b7e69275b0D070eF50acc93ab880"},
+		},
+	}
+
+	// other messages are not tracked
+	suite.Require().NoError(suite.govKeeper.TrackCrossChainDelivery(ctx, 1, &v1.MsgUpdateParams{}, &sdk.Result{}))
+	suite.Require().Empty(suite.govKeeper.GetAllCrossChainDeliveries(ctx))
+
+	for i, proposalID := range []uint64{1, 2} {
+		msgRes, err := codectypes.NewAnyWithValue(&v1.MsgUpdateCrossChainParamsResponse{Sequence: uint64(i)})
+		suite.Require().NoError(err)
+		err = suite.govKeeper.TrackCrossChainDelivery(ctx, proposalID, msg, &sdk.Result{MsgResponses: []*codectypes.Any{msgRes}})
+		suite.Require().NoError(err)
+	}
+
+	delivery, found := suite.govKeeper.GetCrossChainDelivery(ctx, 1, 714, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.DeliveryStatusPending, delivery.Status)
+	suite.Require().Equal(msg.Params.Key, delivery.Key)
+	suite.Require().Equal(msg.Params.Targets, delivery.Targets)
+
+	// ack and fail ack packages update the status of the delivery with the same sequence
+	suite.Require().NoError(suite.govKeeper.UpdateCrossChainDeliveryStatus(ctx, 714, 0, v1.DeliveryStatusAcked, []byte("ack")))
+	suite.Require().NoError(suite.govKeeper.UpdateCrossChainDeliveryStatus(ctx, 714, 1, v1.DeliveryStatusFailed, nil))
+	// unknown packages are ignored
+	suite.Require().NoError(suite.govKeeper.UpdateCrossChainDeliveryStatus(ctx, 714, 2, v1.DeliveryStatusAcked, nil))
+
+	res, err := suite.queryClient.CrossChainDeliveries(ctx, &v1.QueryCrossChainDeliveriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Deliveries, 2)
+	suite.Require().Equal(v1.DeliveryStatusAcked, res.Deliveries[0].Status)
+	suite.Require().Equal([]byte("ack"), res.Deliveries[0].AckPayload)
+	suite.Require().Equal(v1.DeliveryStatusFailed, res.Deliveries[1].Status)
+
+	res, err = suite.queryClient.CrossChainDeliveries(ctx, &v1.QueryCrossChainDeliveriesRequest{ProposalId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Deliveries, 1)
+	suite.Require().Equal(uint64(2), res.Deliveries[0].ProposalId)
+	suite.Require().Equal(uint64(1), res.Deliveries[0].Sequence)
+
+	events := ctx.EventManager().Events()
+	var deliveryEvents int
+	for _, event := range events {
+		if event.Type == proto.MessageName(&v1.EventCrossChainDelivery{}) {
+			deliveryEvents++
+		}
+	}
+	suite.Require().Equal(4, deliveryEvents)
+}
+
+func (suite *KeeperTestSuite) TestCrossChainDeliveryBatch() {
+	suite.reset()
+	ctx := suite.ctx
+
+	upgrade := v1.CrossChainParamsChange{
+		Key:     "upgrade",
+		Values:  []string{"0xeAE67217D95E786a9309A363437066428b97c046"},
+		Targets: []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"},
+	}
+	msg := v1.NewMsgUpdateCrossChainParamsBatch(suite.govKeeper.GetAuthority(), []v1.CrossChainParamsUpdate{
+		{DestChainId: 714, Params: upgrade},
+		{DestChainId: 97, Params: upgrade},
+	})
+
+	// the number of sequences must match the number of updates
+	msgRes, err := codectypes.NewAnyWithValue(&v1.MsgUpdateCrossChainParamsBatchResponse{Sequences: []uint64{3}})
+	suite.Require().NoError(err)
+	err = suite.govKeeper.TrackCrossChainDelivery(ctx, 1, msg, &sdk.Result{MsgResponses: []*codectypes.Any{msgRes}})
+	suite.Require().Error(err)
+
+	msgRes, err = codectypes.NewAnyWithValue(&v1.MsgUpdateCrossChainParamsBatchResponse{Sequences: []uint64{3, 5}})
+	suite.Require().NoError(err)
+	err = suite.govKeeper.TrackCrossChainDelivery(ctx, 1, msg, &sdk.Result{MsgResponses: []*codectypes.Any{msgRes}})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.CrossChainDeliveries(ctx, &v1.QueryCrossChainDeliveriesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Deliveries, 2)
+	suite.Require().Equal(uint32(97), res.Deliveries[0].DestChainId)
+	suite.Require().Equal(uint64(5), res.Deliveries[0].Sequence)
+	suite.Require().Equal(uint32(714), res.Deliveries[1].DestChainId)
+	suite.Require().Equal(uint64(3), res.Deliveries[1].Sequence)
+
+	// each chain acknowledges its own package
+	suite.Require().NoError(suite.govKeeper.UpdateCrossChainDeliveryStatus(ctx, 97, 5, v1.DeliveryStatusFailed, nil))
+	delivery, found := suite.govKeeper.GetCrossChainDelivery(ctx, 1, 97, 5)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.DeliveryStatusFailed, delivery.Status)
+	delivery, found = suite.govKeeper.GetCrossChainDelivery(ctx, 1, 714, 3)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.DeliveryStatusPending, delivery.Status)
+}
@@ -281,6 +281,38 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// CrossChainDeliveries returns the delivery status of the cross-chain params changes sent by proposals
+func (q Keeper) CrossChainDeliveries(c context.Context, req *v1.QueryCrossChainDeliveriesRequest) (*v1.QueryCrossChainDeliveriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var deliveries []*v1.CrossChainDelivery
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	keyPrefix := types.CrossChainDeliveriesKeyPrefix
+	if req.ProposalId != 0 {
+		keyPrefix = types.CrossChainDeliveriesKey(req.ProposalId)
+	}
+	deliveriesStore := prefix.NewStore(store, keyPrefix)
+
+	pageRes, err := query.Paginate(deliveriesStore, req.Pagination, func(key []byte, value []byte) error {
+		var delivery v1.CrossChainDelivery
+		if err := q.cdc.Unmarshal(value, &delivery); err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, &delivery)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryCrossChainDeliveriesResponse{Deliveries: deliveries, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -197,10 +197,33 @@ func (k msgServer) UpdateCrossChainParams(goCtx context.Context, msg *v1.MsgUpda
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.SyncParams(ctx, sdk.ChainID(msg.DestChainId), msg.Params); err != nil {
+	sequence, err := k.SyncParams(ctx, sdk.ChainID(msg.DestChainId), msg.Params)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateCrossChainParamsResponse{Sequence: sequence}, nil
+}
+
+// UpdateCrossChainParamsBatch implements the MsgServer.UpdateCrossChainParamsBatch method.
+func (k msgServer) UpdateCrossChainParamsBatch(goCtx context.Context, msg *v1.MsgUpdateCrossChainParamsBatch) (*v1.MsgUpdateCrossChainParamsBatchResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateCrossChainParamsUpdates(ctx, msg.Updates); err != nil {
 		return nil, err
 	}
-	return &v1.MsgUpdateCrossChainParamsResponse{}, nil
+
+	// the packages are created in the message's cached context, so none of them is sent if any fails
+	sequences := make([]uint64, 0, len(msg.Updates))
+	for i, update := range msg.Updates {
+		sequence, err := k.SyncParams(ctx, sdk.ChainID(update.DestChainId), update.Params)
+		if err != nil {
+			return nil, errors.Wrapf(err, "update %d to chain %d", i, update.DestChainId)
+		}
+		sequences = append(sequences, sequence)
+	}
+	return &v1.MsgUpdateCrossChainParamsBatchResponse{Sequences: sequences}, nil
 }
 
 type legacyMsgServer struct {
@@ -80,6 +80,13 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			}
 		}
 
+		// A batch of cross-chain params changes is only sent if all the destination chains are
+		// supported, reject it early rather than letting the whole proposal fail upon execution.
+		if msg, ok := msg.(*v1.MsgUpdateCrossChainParamsBatch); ok {
+			if err := keeper.ValidateCrossChainParamsUpdates(ctx, msg.Updates); err != nil {
+				return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+			}
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
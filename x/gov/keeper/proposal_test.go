@@ -296,3 +296,74 @@ func (suite *KeeperTestSuite) TestUpdateCrossChainParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateCrossChainParamsBatch() {
+	paramsChange := v1.CrossChainParamsChange{
+		Key:     "batchSizeForOracle",
+		Values:  []string{"0000000000000000000000000000000000000000000000000000000000000033"},
+		Targets: []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"},
+	}
+	upgrade := v1.CrossChainParamsChange{
+		Key:     "upgrade",
+		Values:  []string{"0xeAE67217D95E786a9309A363437066428b97c046"},
+		Targets: []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"},
+	}
+
+	testCases := []struct {
+		name      string
+		request   *v1.MsgUpdateCrossChainParamsBatch
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &v1.MsgUpdateCrossChainParamsBatch{
+				Authority: "0x76d244CE05c3De4BbC6fDd7F56379B145709ade9",
+				Updates:   []v1.CrossChainParamsUpdate{{DestChainId: 714, Params: paramsChange}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty updates",
+			request: &v1.MsgUpdateCrossChainParamsBatch{
+				Authority: suite.govKeeper.GetAuthority(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "one invalid change fails the whole batch",
+			request: &v1.MsgUpdateCrossChainParamsBatch{
+				Authority: suite.govKeeper.GetAuthority(),
+				Updates: []v1.CrossChainParamsUpdate{
+					{DestChainId: 714, Params: upgrade},
+					{DestChainId: 97, Params: v1.CrossChainParamsChange{Key: "upgrade", Values: []string{"not_an_hex_address"}, Targets: []string{"not_an_hex_address"}}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "upgrade smart contract on several chains",
+			request: &v1.MsgUpdateCrossChainParamsBatch{
+				Authority: suite.govKeeper.GetAuthority(),
+				Updates: []v1.CrossChainParamsUpdate{
+					{DestChainId: 714, Params: upgrade},
+					{DestChainId: 97, Params: upgrade},
+					{DestChainId: 97, Params: paramsChange},
+				},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := suite.msgSrvr.UpdateCrossChainParamsBatch(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Sequences, len(tc.request.Updates))
+			}
+		})
+	}
+}
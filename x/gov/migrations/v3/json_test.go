@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"cross_chain_deliveries": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -56,6 +56,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"cross_chain_deliveries": [],
 	"deposit_params": null,
 	"deposits": [],
 	"params": {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
@@ -42,3 +44,17 @@ func (p SyncParamsPackage) Serialize() ([]byte, error) {
 	}
 	return encodedBytes, nil
 }
+
+// DeserializeSyncParamsPackage decodes a SyncParamsPackage from its cross-chain payload.
+func DeserializeSyncParamsPackage(serializedPackage []byte) (*SyncParamsPackage, error) {
+	unpacked, err := syncParamsPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, ok := abi.ConvertType(unpacked[0], SyncParamsPackage{}).(SyncParamsPackage)
+	if !ok {
+		return nil, fmt.Errorf("invalid sync params package")
+	}
+	return &pack, nil
+}
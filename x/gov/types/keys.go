@@ -38,6 +38,10 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40<proposalID_Bytes><destChainID_Bytes><sequence_Bytes>: CrossChainDelivery
+//
+// - 0x41<destChainID_Bytes><sequence_Bytes>: proposalID
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -51,6 +55,9 @@ var (
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
+
+	CrossChainDeliveriesKeyPrefix         = []byte{0x40}
+	CrossChainDeliveryBySequenceKeyPrefix = []byte{0x41}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -117,6 +124,29 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// CrossChainDeliveriesKey gets the first part of the cross-chain deliveries key based on the proposalID
+func CrossChainDeliveriesKey(proposalID uint64) []byte {
+	return append(CrossChainDeliveriesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// CrossChainDeliveryKey key of a specific cross-chain delivery from the store
+func CrossChainDeliveryKey(proposalID uint64, destChainID sdk.ChainID, sequence uint64) []byte {
+	return append(CrossChainDeliveriesKey(proposalID), crossChainSequenceBytes(destChainID, sequence)...)
+}
+
+// CrossChainDeliveryBySequenceKey gets the key of the proposalID that sent the sync params package
+// with the given sequence to the destination chain
+func CrossChainDeliveryBySequenceKey(destChainID sdk.ChainID, sequence uint64) []byte {
+	return append(CrossChainDeliveryBySequenceKeyPrefix, crossChainSequenceBytes(destChainID, sequence)...)
+}
+
+func crossChainSequenceBytes(destChainID sdk.ChainID, sequence uint64) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint32(bz[:4], uint32(destChainID))
+	binary.BigEndian.PutUint64(bz[4:], sequence)
+	return bz
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -32,6 +32,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
 		&MsgUpdateCrossChainParams{},
+		&MsgUpdateCrossChainParamsBatch{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -51,3 +51,22 @@ func (m *CrossChainParamsChange) ValidateBasic() error {
 	}
 	return nil
 }
+
+const (
+	DeliveryStatusNil     = CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED
+	DeliveryStatusPending = CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_PENDING
+	DeliveryStatusAcked   = CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_ACKED
+	DeliveryStatusFailed  = CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_FAILED
+)
+
+// NewCrossChainDelivery creates a new pending CrossChainDelivery for a params change sent by a proposal
+func NewCrossChainDelivery(proposalID uint64, destChainID uint32, sequence uint64, cpc CrossChainParamsChange) CrossChainDelivery {
+	return CrossChainDelivery{
+		ProposalId:  proposalID,
+		DestChainId: destChainID,
+		Sequence:    sequence,
+		Key:         cpc.Key,
+		Targets:     cpc.Targets,
+		Status:      DeliveryStatusPending,
+	}
+}
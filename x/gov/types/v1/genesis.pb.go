@@ -45,6 +45,8 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// cross_chain_deliveries defines all the cross-chain params change deliveries present at genesis.
+	CrossChainDeliveries []*CrossChainDelivery `protobuf:"bytes,9,rep,name=cross_chain_deliveries,json=crossChainDeliveries,proto3" json:"cross_chain_deliveries,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -139,6 +141,13 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetCrossChainDeliveries() []*CrossChainDelivery {
+	if m != nil {
+		return m.CrossChainDeliveries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -146,30 +155,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0xfc, 0x07, 0x70, 0x31, 0x7e, 0xd0, 0x80, 0x69, 0x88, 0x2b, 0x8c,
-	0xa1, 0x15, 0x8c, 0x0f, 0x20, 0xc1, 0x10, 0x77, 0xa4, 0x1a, 0x17, 0x6e, 0x48, 0xa1, 0x93, 0xda,
-	0x08, 0xdc, 0xa6, 0x77, 0x9c, 0xc8, 0x5b, 0xf8, 0x58, 0x2e, 0xd9, 0xe9, 0xd2, 0xc0, 0x8b, 0x18,
-	0x66, 0x5a, 0xc1, 0xea, 0x6a, 0x92, 0x7b, 0x7e, 0xe7, 0xcc, 0xc9, 0xcd, 0xa5, 0x8d, 0x09, 0xe0,
-	0x0c, 0xd0, 0xf6, 0x41, 0xda, 0xb2, 0x63, 0xfb, 0x7c, 0xce, 0x31, 0x40, 0x2b, 0x8c, 0x40, 0x00,
-	0xab, 0x6a, 0xd1, 0xf2, 0x41, 0x5a, 0xb2, 0x53, 0xaf, 0xa5, 0x58, 0x90, 0x9a, 0x3b, 0x79, 0xcf,
-	0xd1, 0xca, 0x40, 0x3b, 0x6f, 0x85, 0x2b, 0x38, 0x3b, 0xa7, 0x07, 0x28, 0xdc, 0x48, 0x04, 0x73,
-	0x7f, 0x14, 0x46, 0x10, 0x02, 0xba, 0xd3, 0x51, 0xe0, 0x19, 0xa4, 0x49, 0x5a, 0x79, 0x87, 0x25,
-	0xda, 0x30, 0x96, 0x6e, 0x3c, 0xd6, 0xa5, 0x25, 0x8f, 0x87, 0x80, 0x81, 0x40, 0x23, 0xdb, 0xcc,
-	0xb5, 0xca, 0xdd, 0x23, 0xeb, 0xc7, 0xef, 0x56, 0x5f, 0xcb, 0xce, 0x37, 0xc7, 0x4e, 0x69, 0x41,
-	0x82, 0xe0, 0x68, 0xe4, 0x94, 0x61, 0x3f, 0x65, 0xb8, 0x07, 0xc1, 0x1d, 0x4d, 0xb0, 0x4b, 0xfa,
-	0x3f, 0xe9, 0x81, 0x46, 0x5e, 0xe1, 0xb5, 0x14, 0x9e, 0x94, 0x71, 0xb6, 0x24, 0x1b, 0xd0, 0xbd,
-	0xf8, 0xb7, 0x51, 0xe8, 0x46, 0xee, 0x0c, 0x8d, 0x42, 0x93, 0xb4, 0xca, 0xdd, 0xe3, 0xbf, 0xbb,
-	0x0d, 0x15, 0xd3, 0xcb, 0x1a, 0xc4, 0xa9, 0x7a, 0xbb, 0x23, 0xd6, 0xa7, 0x55, 0x09, 0x7a, 0x1d,
-	0x3a, 0xa7, 0xa8, 0x72, 0x1a, 0xbf, 0x2b, 0x6f, 0xd6, 0xb2, 0x8d, 0xa9, 0xc8, 0x9d, 0x09, 0xbb,
-	0xa2, 0x15, 0xe1, 0x4e, 0xa7, 0x8b, 0x24, 0xe4, 0x9f, 0x0a, 0xa9, 0xa7, 0x42, 0xee, 0x36, 0xc8,
-	0x4e, 0x46, 0x59, 0x6c, 0x07, 0xac, 0x4d, 0x8b, 0xb1, 0xb9, 0xa4, 0xcc, 0x87, 0xe9, 0x2d, 0x28,
-	0xd1, 0x89, 0xa1, 0xde, 0xf5, 0xdb, 0xca, 0x24, 0xcb, 0x95, 0x49, 0x3e, 0x57, 0x26, 0x79, 0x5d,
-	0x9b, 0x99, 0xe5, 0xda, 0xcc, 0x7c, 0xac, 0xcd, 0xcc, 0xc3, 0x99, 0x1f, 0x88, 0xc7, 0xe7, 0xb1,
-	0x35, 0x81, 0x99, 0x1d, 0xdf, 0x85, 0x7e, 0xda, 0xe8, 0x3d, 0xd9, 0x2f, 0xea, 0x48, 0xc4, 0x22,
-	0xe4, 0x68, 0xcb, 0xce, 0xb8, 0xa8, 0xee, 0xe4, 0xe2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xb2,
-	0x17, 0xb2, 0x6e, 0x02, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xda, 0x40,
+	0x14, 0x85, 0x31, 0x7f, 0x85, 0x01, 0xba, 0x98, 0x52, 0xb0, 0xa0, 0xb2, 0x68, 0x57, 0x54, 0x15,
+	0x76, 0xa1, 0xea, 0x03, 0x14, 0xa8, 0x50, 0x77, 0xc8, 0xad, 0x1a, 0x29, 0x1b, 0xcb, 0xd8, 0x23,
+	0x33, 0x8a, 0x61, 0x2c, 0xdf, 0xc9, 0x28, 0xbc, 0x45, 0x1e, 0x25, 0x8f, 0x91, 0x25, 0xcb, 0x2c,
+	0x23, 0x78, 0x91, 0xc8, 0x33, 0x76, 0x20, 0x4e, 0x56, 0x23, 0xdd, 0xf3, 0x9d, 0x33, 0x57, 0x47,
+	0x17, 0xf5, 0x3d, 0x06, 0x1b, 0x06, 0x56, 0xc0, 0x84, 0x25, 0xc6, 0x56, 0x40, 0xb6, 0x04, 0x28,
+	0x98, 0x51, 0xcc, 0x38, 0xc3, 0x2d, 0x25, 0x9a, 0x01, 0x13, 0xa6, 0x18, 0xf7, 0xba, 0x39, 0x96,
+	0x09, 0xc5, 0x7d, 0xb9, 0x2b, 0xa3, 0xe6, 0x42, 0x39, 0xff, 0x72, 0x97, 0x13, 0xfc, 0x1d, 0xb5,
+	0x81, 0xbb, 0x31, 0xa7, 0xdb, 0xc0, 0x89, 0x62, 0x16, 0x31, 0x70, 0x43, 0x87, 0xfa, 0xba, 0x36,
+	0xd0, 0x86, 0x65, 0x1b, 0x67, 0xda, 0x32, 0x95, 0xfe, 0xf8, 0x78, 0x82, 0x6a, 0x3e, 0x89, 0x18,
+	0x50, 0x0e, 0x7a, 0x71, 0x50, 0x1a, 0x36, 0x26, 0x1d, 0xf3, 0xc5, 0xef, 0xe6, 0x5c, 0xc9, 0xf6,
+	0x33, 0x87, 0xbf, 0xa2, 0x8a, 0x60, 0x9c, 0x80, 0x5e, 0x92, 0x86, 0x0f, 0x39, 0xc3, 0x7f, 0xc6,
+	0x89, 0xad, 0x08, 0xfc, 0x13, 0xd5, 0xb3, 0x3d, 0x40, 0x2f, 0x4b, 0xbc, 0x9b, 0xc3, 0xb3, 0x65,
+	0xec, 0x13, 0x89, 0x17, 0xe8, 0x7d, 0xfa, 0x9b, 0x13, 0xb9, 0xb1, 0xbb, 0x01, 0xbd, 0x32, 0xd0,
+	0x86, 0x8d, 0xc9, 0xa7, 0xb7, 0x77, 0x5b, 0x4a, 0x66, 0x5a, 0xd4, 0x35, 0xbb, 0xe5, 0x9f, 0x8f,
+	0xf0, 0x1c, 0xb5, 0x04, 0x53, 0x75, 0xa8, 0x9c, 0xaa, 0xcc, 0xe9, 0xbf, 0x5e, 0x39, 0xa9, 0xe5,
+	0x14, 0xd3, 0x14, 0x67, 0x13, 0xfc, 0x0b, 0x35, 0xb9, 0x1b, 0x86, 0xbb, 0x2c, 0xe4, 0x9d, 0x0c,
+	0xe9, 0xe5, 0x42, 0xfe, 0x25, 0xc8, 0x59, 0x46, 0x83, 0x9f, 0x06, 0x78, 0x84, 0xaa, 0xa9, 0xb9,
+	0x26, 0xcd, 0x1f, 0xf3, 0x2d, 0x48, 0xd1, 0x4e, 0x21, 0x7c, 0x81, 0x3a, 0x5e, 0xcc, 0x00, 0x1c,
+	0x6f, 0xed, 0xd2, 0xad, 0xe3, 0x93, 0x90, 0x0a, 0x12, 0x53, 0x02, 0x7a, 0x5d, 0x96, 0xf8, 0x39,
+	0x67, 0x9f, 0x25, 0xf0, 0x2c, 0x61, 0xe7, 0x0a, 0xdd, 0xd9, 0x6d, 0x2f, 0x3f, 0xa3, 0x04, 0xa6,
+	0xbf, 0xef, 0x0f, 0x86, 0xb6, 0x3f, 0x18, 0xda, 0xe3, 0xc1, 0xd0, 0x6e, 0x8f, 0x46, 0x61, 0x7f,
+	0x34, 0x0a, 0x0f, 0x47, 0xa3, 0x70, 0xf9, 0x2d, 0xa0, 0x7c, 0x7d, 0xbd, 0x32, 0x3d, 0xb6, 0xb1,
+	0xd2, 0x83, 0x53, 0xcf, 0x08, 0xfc, 0x2b, 0xeb, 0x46, 0x5e, 0x1f, 0xdf, 0x45, 0x04, 0x2c, 0x31,
+	0x5e, 0x55, 0xe5, 0x01, 0xfe, 0x78, 0x1a, 0x00, 0x34, 0x09, 0xfd, 0x42, 0xc7, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +203,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CrossChainDeliveries) > 0 {
+		for iNdEx := len(m.CrossChainDeliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainDeliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -344,6 +369,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.CrossChainDeliveries) > 0 {
+		for _, e := range m.CrossChainDeliveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -647,6 +678,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainDeliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainDeliveries = append(m.CrossChainDeliveries, &CrossChainDelivery{})
+			if err := m.CrossChainDeliveries[len(m.CrossChainDeliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
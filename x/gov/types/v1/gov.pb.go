@@ -121,6 +121,45 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 
+// CrossChainDeliveryStatus enumerates the delivery statuses of a cross-chain params change.
+type CrossChainDeliveryStatus int32
+
+const (
+	// CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED defines the default delivery status.
+	CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED CrossChainDeliveryStatus = 0
+	// CROSS_CHAIN_DELIVERY_STATUS_PENDING defines a delivery whose package has been sent to the
+	// destination chain but not acknowledged yet.
+	CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_PENDING CrossChainDeliveryStatus = 1
+	// CROSS_CHAIN_DELIVERY_STATUS_ACKED defines a delivery whose package has been acknowledged by
+	// the destination chain.
+	CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_ACKED CrossChainDeliveryStatus = 2
+	// CROSS_CHAIN_DELIVERY_STATUS_FAILED defines a delivery whose package failed to be executed
+	// on the destination chain.
+	CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_FAILED CrossChainDeliveryStatus = 3
+)
+
+var CrossChainDeliveryStatus_name = map[int32]string{
+	0: "CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED",
+	1: "CROSS_CHAIN_DELIVERY_STATUS_PENDING",
+	2: "CROSS_CHAIN_DELIVERY_STATUS_ACKED",
+	3: "CROSS_CHAIN_DELIVERY_STATUS_FAILED",
+}
+
+var CrossChainDeliveryStatus_value = map[string]int32{
+	"CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED": 0,
+	"CROSS_CHAIN_DELIVERY_STATUS_PENDING":     1,
+	"CROSS_CHAIN_DELIVERY_STATUS_ACKED":       2,
+	"CROSS_CHAIN_DELIVERY_STATUS_FAILED":      3,
+}
+
+func (x CrossChainDeliveryStatus) String() string {
+	return proto.EnumName(CrossChainDeliveryStatus_name, int32(x))
+}
+
+func (CrossChainDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	// option defines the valid vote options, it must not contain duplicate vote options.
@@ -738,15 +777,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -923,9 +962,110 @@ func (m *CrossChainParamsChange) GetTargets() []string {
 	return nil
 }
 
+// CrossChainDelivery defines the delivery of a cross-chain params change sent by a proposal.
+type CrossChainDelivery struct {
+	// proposal_id is the id of the proposal that sent the params change.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// dest_chain_id is the id of the chain the params change was sent to.
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// sequence is the sequence of the sync params package on the destination chain.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// key is the parameter updated, or 'upgrade' for a contract upgrade.
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// targets are the addresses of the contracts updated, in hex format.
+	Targets []string `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`
+	// status is the delivery status of the params change.
+	Status CrossChainDeliveryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmos.gov.v1.CrossChainDeliveryStatus" json:"status,omitempty"`
+	// ack_payload is the payload of the ack or fail ack package received for the params change.
+	AckPayload []byte `protobuf:"bytes,7,opt,name=ack_payload,json=ackPayload,proto3" json:"ack_payload,omitempty"`
+}
+
+func (m *CrossChainDelivery) Reset()         { *m = CrossChainDelivery{} }
+func (m *CrossChainDelivery) String() string { return proto.CompactTextString(m) }
+func (*CrossChainDelivery) ProtoMessage()    {}
+func (*CrossChainDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *CrossChainDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainDelivery.Merge(m, src)
+}
+func (m *CrossChainDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainDelivery proto.InternalMessageInfo
+
+func (m *CrossChainDelivery) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *CrossChainDelivery) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *CrossChainDelivery) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CrossChainDelivery) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CrossChainDelivery) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *CrossChainDelivery) GetStatus() CrossChainDeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CrossChainDeliveryStatus_CROSS_CHAIN_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (m *CrossChainDelivery) GetAckPayload() []byte {
+	if m != nil {
+		return m.AckPayload
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.gov.v1.CrossChainDeliveryStatus", CrossChainDeliveryStatus_name, CrossChainDeliveryStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
@@ -936,97 +1076,108 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*CrossChainParamsChange)(nil), "cosmos.gov.v1.CrossChainParamsChange")
+	proto.RegisterType((*CrossChainDelivery)(nil), "cosmos.gov.v1.CrossChainDelivery")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x72, 0x13, 0xc7,
-	0x16, 0xf6, 0x58, 0xb2, 0x2c, 0x1d, 0x5b, 0xb2, 0x68, 0x7c, 0xcd, 0xd8, 0x60, 0xd9, 0xe8, 0x52,
-	0x94, 0x2f, 0x3f, 0xd2, 0x35, 0x5c, 0xee, 0x86, 0x6c, 0x64, 0x6b, 0x08, 0xa2, 0x88, 0xa5, 0x8c,
-	0x06, 0x53, 0xa4, 0x52, 0x35, 0xd5, 0xf6, 0x34, 0xd2, 0x14, 0x9a, 0x69, 0x65, 0xba, 0x25, 0xd0,
-	0x23, 0x64, 0xc7, 0x32, 0x95, 0x55, 0x96, 0x59, 0x66, 0x41, 0xe5, 0x19, 0x58, 0x25, 0x14, 0x9b,
-	0x24, 0x1b, 0x92, 0x82, 0xaa, 0xa4, 0x8a, 0xa7, 0x48, 0xf5, 0xcf, 0x48, 0xb2, 0xac, 0x94, 0x0d,
-	0x1b, 0x7b, 0xfa, 0x9c, 0xef, 0x3b, 0x7d, 0xfa, 0xfc, 0x75, 0x0b, 0xce, 0x1d, 0x52, 0x16, 0x50,
-	0x56, 0x6e, 0xd1, 0x7e, 0xb9, 0xbf, 0x2d, 0xfe, 0x95, 0xba, 0x11, 0xe5, 0x14, 0x65, 0x95, 0xa2,
-	0x24, 0x24, 0xfd, 0xed, 0xb5, 0x82, 0xc6, 0x1d, 0x60, 0x46, 0xca, 0xfd, 0xed, 0x03, 0xc2, 0xf1,
-	0x76, 0xf9, 0x90, 0xfa, 0xa1, 0x82, 0xaf, 0x2d, 0xb7, 0x68, 0x8b, 0xca, 0xcf, 0xb2, 0xf8, 0xd2,
-	0xd2, 0x8d, 0x16, 0xa5, 0xad, 0x0e, 0x29, 0xcb, 0xd5, 0x41, 0xef, 0x71, 0x99, 0xfb, 0x01, 0x61,
-	0x1c, 0x07, 0x5d, 0x0d, 0x58, 0x9d, 0x04, 0xe0, 0x70, 0xa0, 0x55, 0x85, 0x49, 0x95, 0xd7, 0x8b,
-	0x30, 0xf7, 0x69, 0xbc, 0xe3, 0xaa, 0xf2, 0xc8, 0x55, 0x9b, 0x6a, 0x6f, 0x95, 0xea, 0x0c, 0x0e,
-	0xfc, 0x90, 0x96, 0xe5, 0x5f, 0x25, 0x2a, 0x52, 0x40, 0x0f, 0x89, 0xdf, 0x6a, 0x73, 0xe2, 0xed,
-	0x53, 0x4e, 0xea, 0x5d, 0x61, 0x09, 0x6d, 0x43, 0x8a, 0xca, 0x2f, 0xd3, 0xd8, 0x34, 0xb6, 0x72,
-	0x37, 0x56, 0x4b, 0x47, 0x4e, 0x5d, 0x1a, 0x41, 0x6d, 0x0d, 0x44, 0x97, 0x21, 0xf5, 0x54, 0x1a,
-	0x32, 0x67, 0x37, 0x8d, 0xad, 0xcc, 0x4e, 0xee, 0xf5, 0x8b, 0xeb, 0xa0, 0x59, 0x55, 0x72, 0x68,
-	0x6b, 0x6d, 0xf1, 0x3b, 0x03, 0xe6, 0xab, 0xa4, 0x4b, 0x99, 0xcf, 0xd1, 0x06, 0x2c, 0x74, 0x23,
-	0xda, 0xa5, 0x0c, 0x77, 0x5c, 0xdf, 0x93, 0x7b, 0x25, 0x6d, 0x88, 0x45, 0x35, 0x0f, 0xfd, 0x1f,
-	0x32, 0x9e, 0xc2, 0xd2, 0x48, 0xdb, 0x35, 0x5f, 0xbf, 0xb8, 0xbe, 0xac, 0xed, 0x56, 0x3c, 0x2f,
-	0x22, 0x8c, 0x35, 0x79, 0xe4, 0x87, 0x2d, 0x7b, 0x04, 0x45, 0x9f, 0x40, 0x0a, 0x07, 0xb4, 0x17,
-	0x72, 0x33, 0xb1, 0x99, 0xd8, 0x5a, 0x18, 0xf9, 0x2f, 0xd2, 0x54, 0xd2, 0x69, 0x2a, 0xed, 0x52,
-	0x3f, 0xdc, 0xc9, 0xbc, 0x7c, 0xb3, 0x31, 0xf3, 0xfd, 0x5f, 0x3f, 0x5c, 0x31, 0x6c, 0xcd, 0x29,
-	0xfe, 0x39, 0x07, 0xe9, 0x86, 0x76, 0x02, 0xe5, 0x60, 0x76, 0xe8, 0xda, 0xac, 0xef, 0xa1, 0xff,
-	0x42, 0x3a, 0x20, 0x8c, 0xe1, 0x16, 0x61, 0xe6, 0xac, 0x34, 0xbe, 0x5c, 0x52, 0x19, 0x29, 0xc5,
-	0x19, 0x29, 0x55, 0xc2, 0x81, 0x3d, 0x44, 0xa1, 0x5b, 0x90, 0x62, 0x1c, 0xf3, 0x1e, 0x33, 0x13,
-	0x32, 0x98, 0xeb, 0x13, 0xc1, 0x8c, 0xb7, 0x6a, 0x4a, 0x90, 0xad, 0xc1, 0xe8, 0x2e, 0xa0, 0xc7,
-	0x7e, 0x88, 0x3b, 0x2e, 0xc7, 0x9d, 0xce, 0xc0, 0x8d, 0x08, 0xeb, 0x75, 0xb8, 0x99, 0xdc, 0x34,
-	0xb6, 0x16, 0x6e, 0xac, 0x4d, 0x98, 0x70, 0x04, 0xc4, 0x96, 0x08, 0x3b, 0x2f, 0x59, 0x63, 0x12,
-	0x54, 0x81, 0x05, 0xd6, 0x3b, 0x08, 0x7c, 0xee, 0x8a, 0x32, 0x33, 0xe7, 0xb4, 0x89, 0x49, 0xaf,
-	0x9d, 0xb8, 0x06, 0x77, 0x92, 0xcf, 0x7f, 0xdf, 0x30, 0x6c, 0x50, 0x24, 0x21, 0x46, 0xf7, 0x20,
-	0xaf, 0xa3, 0xeb, 0x92, 0xd0, 0x53, 0x76, 0x52, 0xa7, 0xb4, 0x93, 0xd3, 0x4c, 0x2b, 0xf4, 0xa4,
-	0xad, 0x1a, 0x64, 0x39, 0xe5, 0xb8, 0xe3, 0x6a, 0xb9, 0x39, 0xff, 0x01, 0x39, 0x5a, 0x94, 0xd4,
-	0xb8, 0x80, 0xee, 0xc3, 0x99, 0x3e, 0xe5, 0x7e, 0xd8, 0x72, 0x19, 0xc7, 0x91, 0x3e, 0x5f, 0xfa,
-	0x94, 0x7e, 0x2d, 0x29, 0x6a, 0x53, 0x30, 0xa5, 0x63, 0x77, 0x41, 0x8b, 0x46, 0x67, 0xcc, 0x9c,
-	0xd2, 0x56, 0x56, 0x11, 0xe3, 0x23, 0xae, 0x89, 0x22, 0xe1, 0xd8, 0xc3, 0x1c, 0x9b, 0x20, 0xca,
-	0xd6, 0x1e, 0xae, 0xd1, 0x32, 0xcc, 0x71, 0x9f, 0x77, 0x88, 0xb9, 0x20, 0x15, 0x6a, 0x81, 0x4c,
-	0x98, 0x67, 0xbd, 0x20, 0xc0, 0xd1, 0xc0, 0x5c, 0x94, 0xf2, 0x78, 0x89, 0xfe, 0x07, 0x69, 0xd5,
-	0x11, 0x24, 0x32, 0xb3, 0x27, 0xb4, 0xc0, 0x10, 0x89, 0xfe, 0x0d, 0xd9, 0xc7, 0xd8, 0xef, 0x10,
-	0xcf, 0x8d, 0x08, 0x66, 0x34, 0x34, 0x73, 0xd2, 0xea, 0xa2, 0x12, 0xda, 0x52, 0x56, 0xfc, 0xc5,
-	0x80, 0x85, 0xf1, 0x42, 0xb9, 0x0a, 0x99, 0x01, 0x61, 0xee, 0xa1, 0xec, 0x1c, 0xe3, 0x58, 0x1b,
-	0xd7, 0x42, 0x6e, 0xa7, 0x07, 0x84, 0xed, 0x0a, 0x3d, 0xba, 0x09, 0x59, 0x7c, 0xc0, 0x38, 0xf6,
-	0x43, 0x4d, 0x98, 0x9d, 0x4a, 0x58, 0xd4, 0x20, 0x45, 0xfa, 0x0f, 0xa4, 0x43, 0xaa, 0xf1, 0x89,
-	0xa9, 0xf8, 0xf9, 0x90, 0x2a, 0xe8, 0x6d, 0x40, 0x21, 0x75, 0x9f, 0xfa, 0xbc, 0xed, 0xf6, 0x09,
-	0x8f, 0x49, 0xc9, 0xa9, 0xa4, 0xa5, 0x90, 0x3e, 0xf4, 0x79, 0x7b, 0x9f, 0x70, 0x45, 0x2e, 0xfe,
-	0x68, 0x40, 0x52, 0x0c, 0xa9, 0x93, 0x47, 0x4c, 0x09, 0xe6, 0xfa, 0x94, 0x93, 0x93, 0xc7, 0x8b,
-	0x82, 0xa1, 0xdb, 0x30, 0xaf, 0x26, 0x1e, 0x33, 0x93, 0xb2, 0x6e, 0x2f, 0x4e, 0xf4, 0xe2, 0xf1,
-	0x71, 0x6a, 0xc7, 0x8c, 0x23, 0x75, 0x31, 0x77, 0xb4, 0x2e, 0xee, 0x25, 0xd3, 0x89, 0x7c, 0xb2,
-	0xf8, 0x9b, 0x01, 0x59, 0x5d, 0xdd, 0x0d, 0x1c, 0xe1, 0x80, 0xa1, 0x47, 0xb0, 0x10, 0xf8, 0xe1,
-	0xb0, 0x59, 0x8c, 0x93, 0x9a, 0x65, 0x5d, 0x34, 0xcb, 0xfb, 0x37, 0x1b, 0xff, 0x1a, 0x63, 0x5d,
-	0xa3, 0x81, 0xcf, 0x49, 0xd0, 0xe5, 0x03, 0x1b, 0x02, 0x3f, 0x8c, 0xdb, 0x27, 0x00, 0x14, 0xe0,
-	0x67, 0x31, 0xc8, 0xed, 0x92, 0xc8, 0xa7, 0x9e, 0x0c, 0x84, 0xd8, 0x61, 0xb2, 0xe6, 0xab, 0xfa,
-	0x9e, 0xd9, 0xb9, 0xf4, 0xfe, 0xcd, 0xc6, 0x85, 0xe3, 0xc4, 0xd1, 0x26, 0xdf, 0x88, 0x96, 0xc8,
-	0x07, 0xf8, 0x59, 0x7c, 0x12, 0xa9, 0x2f, 0x3a, 0xb0, 0xb8, 0x2f, 0xdb, 0x44, 0x9f, 0xac, 0x0a,
-	0xba, 0x6d, 0xe2, 0x9d, 0x8d, 0x93, 0x76, 0x4e, 0x4a, 0xcb, 0x8b, 0x8a, 0xa5, 0xad, 0x7e, 0x1b,
-	0x17, 0xb1, 0xb6, 0x7a, 0x19, 0x52, 0x5f, 0xf5, 0x68, 0xd4, 0x0b, 0xa6, 0x54, 0xb0, 0xbc, 0x88,
-	0x94, 0x16, 0x5d, 0x83, 0x0c, 0x6f, 0x47, 0x84, 0xb5, 0x69, 0xc7, 0xfb, 0x87, 0x3b, 0x6b, 0x04,
-	0x40, 0xb7, 0x20, 0x27, 0xab, 0x70, 0x44, 0x49, 0x4c, 0xa5, 0x64, 0x05, 0xca, 0x89, 0x41, 0xc5,
-	0x9f, 0x93, 0x90, 0xd2, 0x7e, 0x59, 0x1f, 0x98, 0xc7, 0xb1, 0xa1, 0x37, 0x9e, 0xb3, 0xcf, 0x3e,
-	0x2e, 0x67, 0xc9, 0xe9, 0x39, 0x39, 0x9e, 0x83, 0xc4, 0x47, 0xe4, 0x60, 0x2c, 0xe6, 0xc9, 0xd3,
-	0xc7, 0x7c, 0xee, 0xc3, 0x63, 0x9e, 0x3a, 0x45, 0xcc, 0x51, 0x0d, 0x56, 0x45, 0xa0, 0xfd, 0xd0,
-	0xe7, 0xfe, 0xe8, 0x96, 0x71, 0xa5, 0xfb, 0xe6, 0xfc, 0x54, 0x0b, 0x2b, 0x81, 0x1f, 0xd6, 0x14,
-	0x5e, 0x87, 0xc7, 0x16, 0x68, 0xb4, 0x05, 0xf9, 0x83, 0x5e, 0x14, 0xba, 0xa2, 0xf5, 0x5d, 0x7d,
-	0x42, 0x31, 0x83, 0xd3, 0x76, 0x4e, 0xc8, 0x45, 0x8b, 0x7f, 0xae, 0x4e, 0x56, 0x81, 0x75, 0x89,
-	0x1c, 0x0e, 0x9b, 0x61, 0x82, 0x22, 0x22, 0xd8, 0x72, 0xfe, 0xa6, 0xed, 0x35, 0x01, 0x8a, 0x2f,
-	0xfc, 0x38, 0x13, 0x0a, 0x81, 0x2e, 0x41, 0x6e, 0xb4, 0x99, 0x38, 0x92, 0xb9, 0x24, 0x39, 0x8b,
-	0xf1, 0x56, 0x62, 0xbc, 0x15, 0xbf, 0x84, 0x95, 0xdd, 0x88, 0x32, 0xb6, 0xdb, 0xc6, 0x7e, 0xa8,
-	0x4a, 0x6b, 0xb7, 0x8d, 0xc3, 0x16, 0x41, 0x79, 0x48, 0x3c, 0x21, 0x03, 0x55, 0xf5, 0xb6, 0xf8,
-	0x44, 0x2b, 0x90, 0xea, 0xe3, 0x4e, 0x4f, 0xbf, 0x54, 0x32, 0xb6, 0x5e, 0x89, 0xcb, 0x86, 0xe3,
-	0xa8, 0x45, 0x38, 0x93, 0xef, 0xa3, 0x8c, 0x1d, 0x2f, 0xaf, 0x7c, 0x6d, 0x00, 0x8c, 0xbd, 0x03,
-	0xcf, 0xc3, 0xb9, 0xfd, 0xba, 0x63, 0xb9, 0xf5, 0x86, 0x53, 0xab, 0xef, 0xb9, 0x0f, 0xf6, 0x9a,
-	0x0d, 0x6b, 0xb7, 0x76, 0xa7, 0x66, 0x55, 0xf3, 0x33, 0xe8, 0x2c, 0x2c, 0x8d, 0x2b, 0x1f, 0x59,
-	0xcd, 0xbc, 0x81, 0xce, 0xc1, 0xd9, 0x71, 0x61, 0x65, 0xa7, 0xe9, 0x54, 0x6a, 0x7b, 0xf9, 0x59,
-	0x84, 0x20, 0x37, 0xae, 0xd8, 0xab, 0xe7, 0x13, 0xe8, 0x02, 0x98, 0x47, 0x65, 0xee, 0xc3, 0x9a,
-	0x73, 0xd7, 0xdd, 0xb7, 0x9c, 0x7a, 0x3e, 0x79, 0xe5, 0x27, 0x03, 0x72, 0x47, 0xdf, 0x46, 0x68,
-	0x03, 0xce, 0x37, 0xec, 0x7a, 0xa3, 0xde, 0xac, 0xdc, 0x77, 0x9b, 0x4e, 0xc5, 0x79, 0xd0, 0x9c,
-	0xf0, 0xa9, 0x08, 0x85, 0x49, 0x40, 0xd5, 0x6a, 0xd4, 0x9b, 0x35, 0xc7, 0x6d, 0x58, 0x76, 0xad,
-	0x5e, 0xcd, 0x1b, 0xe8, 0x22, 0xac, 0x4f, 0x62, 0xf6, 0xeb, 0x4e, 0x6d, 0xef, 0xd3, 0x18, 0x32,
-	0x8b, 0xd6, 0x60, 0x65, 0x12, 0xd2, 0xa8, 0x34, 0x9b, 0x56, 0x55, 0x39, 0x3d, 0xa9, 0xb3, 0xad,
-	0x7b, 0xd6, 0xae, 0x63, 0x55, 0xf3, 0xc9, 0x69, 0xcc, 0x3b, 0x95, 0xda, 0x7d, 0xab, 0x9a, 0x9f,
-	0xdb, 0xb1, 0x5e, 0xbe, 0x2d, 0x18, 0xaf, 0xde, 0x16, 0x8c, 0x3f, 0xde, 0x16, 0x8c, 0xe7, 0xef,
-	0x0a, 0x33, 0xaf, 0xde, 0x15, 0x66, 0x7e, 0x7d, 0x57, 0x98, 0xf9, 0xe2, 0x6a, 0xcb, 0xe7, 0xed,
-	0xde, 0x41, 0xe9, 0x90, 0x06, 0xfa, 0xc5, 0xae, 0xff, 0x5d, 0x67, 0xde, 0x93, 0xf2, 0x33, 0xf9,
-	0x2b, 0x84, 0x0f, 0xba, 0x84, 0x89, 0x9f, 0x18, 0x29, 0xd9, 0x93, 0x37, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xce, 0x40, 0x9f, 0xc9, 0xa3, 0x0c, 0x00, 0x00,
+	// 1516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x73, 0x1a, 0xc9,
+	0x15, 0xd7, 0xc0, 0x80, 0xe0, 0xf1, 0x21, 0xb6, 0x57, 0x91, 0x47, 0xda, 0x15, 0xc8, 0xec, 0xc6,
+	0x56, 0xe4, 0x35, 0x44, 0xbb, 0xd9, 0x5c, 0x36, 0x55, 0x29, 0x04, 0xb3, 0xab, 0x71, 0x14, 0x20,
+	0x03, 0x2b, 0x97, 0x53, 0xa9, 0x9a, 0x6a, 0x31, 0x6d, 0x98, 0x12, 0x33, 0xcd, 0xce, 0x34, 0xac,
+	0x39, 0xe7, 0x94, 0x9b, 0x8f, 0xa9, 0x9c, 0x72, 0xcc, 0x31, 0x07, 0x57, 0xae, 0xb9, 0xfa, 0x94,
+	0xb8, 0x7c, 0x49, 0x72, 0x71, 0x52, 0x76, 0x55, 0x52, 0xe5, 0xbf, 0x22, 0xd5, 0x3d, 0x3d, 0x80,
+	0x10, 0xb6, 0x64, 0x5f, 0xa4, 0xe9, 0xf7, 0x7e, 0xbf, 0xd7, 0xef, 0xf5, 0xfb, 0xe8, 0x06, 0x6e,
+	0xf4, 0x68, 0xe0, 0xd2, 0xa0, 0xda, 0xa7, 0x93, 0xea, 0xe4, 0x90, 0xff, 0xab, 0x8c, 0x7c, 0xca,
+	0x28, 0xca, 0x85, 0x8a, 0x0a, 0x97, 0x4c, 0x0e, 0x77, 0x8a, 0x12, 0x77, 0x86, 0x03, 0x52, 0x9d,
+	0x1c, 0x9e, 0x11, 0x86, 0x0f, 0xab, 0x3d, 0xea, 0x78, 0x21, 0x7c, 0x67, 0xb3, 0x4f, 0xfb, 0x54,
+	0x7c, 0x56, 0xf9, 0x97, 0x94, 0x96, 0xfa, 0x94, 0xf6, 0x87, 0xa4, 0x2a, 0x56, 0x67, 0xe3, 0x87,
+	0x55, 0xe6, 0xb8, 0x24, 0x60, 0xd8, 0x1d, 0x49, 0xc0, 0xf6, 0x32, 0x00, 0x7b, 0x53, 0xa9, 0x2a,
+	0x2e, 0xab, 0xec, 0xb1, 0x8f, 0x99, 0x43, 0xa3, 0x1d, 0xb7, 0x43, 0x8f, 0xac, 0x70, 0x53, 0xe9,
+	0x6d, 0xa8, 0xfa, 0x00, 0xbb, 0x8e, 0x47, 0xab, 0xe2, 0x6f, 0x28, 0x2a, 0x53, 0x40, 0xf7, 0x89,
+	0xd3, 0x1f, 0x30, 0x62, 0x9f, 0x52, 0x46, 0x5a, 0x23, 0x6e, 0x09, 0x1d, 0x42, 0x92, 0x8a, 0x2f,
+	0x4d, 0xd9, 0x53, 0xf6, 0xf3, 0x9f, 0x6f, 0x57, 0x2e, 0x44, 0x5d, 0x99, 0x43, 0x4d, 0x09, 0x44,
+	0xb7, 0x20, 0xf9, 0xbd, 0x30, 0xa4, 0xc5, 0xf6, 0x94, 0xfd, 0xf4, 0x51, 0xfe, 0xf9, 0x93, 0xbb,
+	0x20, 0x59, 0x0d, 0xd2, 0x33, 0xa5, 0xb6, 0xfc, 0x47, 0x05, 0xd6, 0x1b, 0x64, 0x44, 0x03, 0x87,
+	0xa1, 0x12, 0x64, 0x46, 0x3e, 0x1d, 0xd1, 0x00, 0x0f, 0x2d, 0xc7, 0x16, 0x7b, 0xa9, 0x26, 0x44,
+	0x22, 0xc3, 0x46, 0x3f, 0x85, 0xb4, 0x1d, 0x62, 0xa9, 0x2f, 0xed, 0x6a, 0xcf, 0x9f, 0xdc, 0xdd,
+	0x94, 0x76, 0x6b, 0xb6, 0xed, 0x93, 0x20, 0xe8, 0x30, 0xdf, 0xf1, 0xfa, 0xe6, 0x1c, 0x8a, 0x7e,
+	0x06, 0x49, 0xec, 0xd2, 0xb1, 0xc7, 0xb4, 0xf8, 0x5e, 0x7c, 0x3f, 0x33, 0xf7, 0x9f, 0xa7, 0xa9,
+	0x22, 0xd3, 0x54, 0xa9, 0x53, 0xc7, 0x3b, 0x4a, 0x3f, 0x7d, 0x51, 0x5a, 0xfb, 0xd3, 0xff, 0xfe,
+	0x7c, 0xa0, 0x98, 0x92, 0x53, 0xfe, 0x6f, 0x02, 0x52, 0x6d, 0xe9, 0x04, 0xca, 0x43, 0x6c, 0xe6,
+	0x5a, 0xcc, 0xb1, 0xd1, 0x8f, 0x21, 0xe5, 0x92, 0x20, 0xc0, 0x7d, 0x12, 0x68, 0x31, 0x61, 0x7c,
+	0xb3, 0x12, 0x66, 0xa4, 0x12, 0x65, 0xa4, 0x52, 0xf3, 0xa6, 0xe6, 0x0c, 0x85, 0xbe, 0x84, 0x64,
+	0xc0, 0x30, 0x1b, 0x07, 0x5a, 0x5c, 0x1c, 0xe6, 0xee, 0xd2, 0x61, 0x46, 0x5b, 0x75, 0x04, 0xc8,
+	0x94, 0x60, 0x74, 0x0c, 0xe8, 0xa1, 0xe3, 0xe1, 0xa1, 0xc5, 0xf0, 0x70, 0x38, 0xb5, 0x7c, 0x12,
+	0x8c, 0x87, 0x4c, 0x53, 0xf7, 0x94, 0xfd, 0xcc, 0xe7, 0x3b, 0x4b, 0x26, 0xba, 0x1c, 0x62, 0x0a,
+	0x84, 0x59, 0x10, 0xac, 0x05, 0x09, 0xaa, 0x41, 0x26, 0x18, 0x9f, 0xb9, 0x0e, 0xb3, 0x78, 0x99,
+	0x69, 0x09, 0x69, 0x62, 0xd9, 0xeb, 0x6e, 0x54, 0x83, 0x47, 0xea, 0xe3, 0x7f, 0x97, 0x14, 0x13,
+	0x42, 0x12, 0x17, 0xa3, 0x7b, 0x50, 0x90, 0xa7, 0x6b, 0x11, 0xcf, 0x0e, 0xed, 0x24, 0xaf, 0x69,
+	0x27, 0x2f, 0x99, 0xba, 0x67, 0x0b, 0x5b, 0x06, 0xe4, 0x18, 0x65, 0x78, 0x68, 0x49, 0xb9, 0xb6,
+	0xfe, 0x0e, 0x39, 0xca, 0x0a, 0x6a, 0x54, 0x40, 0x27, 0xf0, 0xc1, 0x84, 0x32, 0xc7, 0xeb, 0x5b,
+	0x01, 0xc3, 0xbe, 0x8c, 0x2f, 0x75, 0x4d, 0xbf, 0x36, 0x42, 0x6a, 0x87, 0x33, 0x85, 0x63, 0xc7,
+	0x20, 0x45, 0xf3, 0x18, 0xd3, 0xd7, 0xb4, 0x95, 0x0b, 0x89, 0x51, 0x88, 0x3b, 0xbc, 0x48, 0x18,
+	0xb6, 0x31, 0xc3, 0x1a, 0xf0, 0xb2, 0x35, 0x67, 0x6b, 0xb4, 0x09, 0x09, 0xe6, 0xb0, 0x21, 0xd1,
+	0x32, 0x42, 0x11, 0x2e, 0x90, 0x06, 0xeb, 0xc1, 0xd8, 0x75, 0xb1, 0x3f, 0xd5, 0xb2, 0x42, 0x1e,
+	0x2d, 0xd1, 0x4f, 0x20, 0x15, 0x76, 0x04, 0xf1, 0xb5, 0xdc, 0x15, 0x2d, 0x30, 0x43, 0xa2, 0x4f,
+	0x20, 0xf7, 0x10, 0x3b, 0x43, 0x62, 0x5b, 0x3e, 0xc1, 0x01, 0xf5, 0xb4, 0xbc, 0xb0, 0x9a, 0x0d,
+	0x85, 0xa6, 0x90, 0x95, 0xff, 0xa1, 0x40, 0x66, 0xb1, 0x50, 0xee, 0x40, 0x7a, 0x4a, 0x02, 0xab,
+	0x27, 0x3a, 0x47, 0xb9, 0xd4, 0xc6, 0x86, 0xc7, 0xcc, 0xd4, 0x94, 0x04, 0x75, 0xae, 0x47, 0x5f,
+	0x40, 0x0e, 0x9f, 0x05, 0x0c, 0x3b, 0x9e, 0x24, 0xc4, 0x56, 0x12, 0xb2, 0x12, 0x14, 0x92, 0x7e,
+	0x04, 0x29, 0x8f, 0x4a, 0x7c, 0x7c, 0x25, 0x7e, 0xdd, 0xa3, 0x21, 0xf4, 0x2b, 0x40, 0x1e, 0xb5,
+	0xbe, 0x77, 0xd8, 0xc0, 0x9a, 0x10, 0x16, 0x91, 0xd4, 0x95, 0xa4, 0x0d, 0x8f, 0xde, 0x77, 0xd8,
+	0xe0, 0x94, 0xb0, 0x90, 0x5c, 0xfe, 0x8b, 0x02, 0x2a, 0x1f, 0x52, 0x57, 0x8f, 0x98, 0x0a, 0x24,
+	0x26, 0x94, 0x91, 0xab, 0xc7, 0x4b, 0x08, 0x43, 0x5f, 0xc1, 0x7a, 0x38, 0xf1, 0x02, 0x4d, 0x15,
+	0x75, 0x7b, 0x73, 0xa9, 0x17, 0x2f, 0x8f, 0x53, 0x33, 0x62, 0x5c, 0xa8, 0x8b, 0xc4, 0xc5, 0xba,
+	0xb8, 0xa7, 0xa6, 0xe2, 0x05, 0xb5, 0xfc, 0x2f, 0x05, 0x72, 0xb2, 0xba, 0xdb, 0xd8, 0xc7, 0x6e,
+	0x80, 0x1e, 0x40, 0xc6, 0x75, 0xbc, 0x59, 0xb3, 0x28, 0x57, 0x35, 0xcb, 0x2e, 0x6f, 0x96, 0xd7,
+	0x2f, 0x4a, 0x3f, 0x58, 0x60, 0x7d, 0x46, 0x5d, 0x87, 0x11, 0x77, 0xc4, 0xa6, 0x26, 0xb8, 0x8e,
+	0x17, 0xb5, 0x8f, 0x0b, 0xc8, 0xc5, 0x8f, 0x22, 0x90, 0x35, 0x22, 0xbe, 0x43, 0x6d, 0x71, 0x10,
+	0x7c, 0x87, 0xe5, 0x9a, 0x6f, 0xc8, 0x7b, 0xe6, 0xe8, 0xd3, 0xd7, 0x2f, 0x4a, 0x1f, 0x5f, 0x26,
+	0xce, 0x37, 0xf9, 0x3d, 0x6f, 0x89, 0x82, 0x8b, 0x1f, 0x45, 0x91, 0x08, 0x7d, 0xb9, 0x0b, 0xd9,
+	0x53, 0xd1, 0x26, 0x32, 0xb2, 0x06, 0xc8, 0xb6, 0x89, 0x76, 0x56, 0xae, 0xda, 0x59, 0x15, 0x96,
+	0xb3, 0x21, 0x4b, 0x5a, 0xfd, 0x43, 0x54, 0xc4, 0xd2, 0xea, 0x2d, 0x48, 0x7e, 0x37, 0xa6, 0xfe,
+	0xd8, 0xd5, 0x94, 0xd5, 0x17, 0x51, 0xa8, 0x45, 0x9f, 0x41, 0x9a, 0x0d, 0x7c, 0x12, 0x0c, 0xe8,
+	0xd0, 0x7e, 0xc3, 0x9d, 0x35, 0x07, 0xa0, 0x2f, 0x21, 0x2f, 0xaa, 0x70, 0x4e, 0x89, 0xaf, 0xa4,
+	0xe4, 0x38, 0xaa, 0x1b, 0x81, 0xca, 0x7f, 0x57, 0x21, 0x29, 0xfd, 0xd2, 0xdf, 0x31, 0x8f, 0x0b,
+	0x43, 0x6f, 0x31, 0x67, 0xbf, 0x7c, 0xbf, 0x9c, 0xa9, 0xab, 0x73, 0x72, 0x39, 0x07, 0xf1, 0xf7,
+	0xc8, 0xc1, 0xc2, 0x99, 0xab, 0xd7, 0x3f, 0xf3, 0xc4, 0xbb, 0x9f, 0x79, 0xf2, 0x1a, 0x67, 0x8e,
+	0x0c, 0xd8, 0xe6, 0x07, 0xed, 0x78, 0x0e, 0x73, 0xe6, 0xb7, 0x8c, 0x25, 0xdc, 0xd7, 0xd6, 0x57,
+	0x5a, 0xd8, 0x72, 0x1d, 0xcf, 0x08, 0xf1, 0xf2, 0x78, 0x4c, 0x8e, 0x46, 0xfb, 0x50, 0x38, 0x1b,
+	0xfb, 0x9e, 0xc5, 0x5b, 0xdf, 0x92, 0x11, 0xf2, 0x19, 0x9c, 0x32, 0xf3, 0x5c, 0xce, 0x5b, 0xfc,
+	0x57, 0x61, 0x64, 0x35, 0xd8, 0x15, 0xc8, 0xd9, 0xb0, 0x99, 0x25, 0xc8, 0x27, 0x9c, 0x2d, 0xe6,
+	0x6f, 0xca, 0xdc, 0xe1, 0xa0, 0xe8, 0xc2, 0x8f, 0x32, 0x11, 0x22, 0xd0, 0xa7, 0x90, 0x9f, 0x6f,
+	0xc6, 0x43, 0xd2, 0x36, 0x04, 0x27, 0x1b, 0x6d, 0xc5, 0xc7, 0x5b, 0xf9, 0x37, 0xb0, 0x55, 0xf7,
+	0x69, 0x10, 0xd4, 0x07, 0xd8, 0xf1, 0xc2, 0xd2, 0xaa, 0x0f, 0xb0, 0xd7, 0x27, 0xa8, 0x00, 0xf1,
+	0x73, 0x32, 0x0d, 0xab, 0xde, 0xe4, 0x9f, 0x68, 0x0b, 0x92, 0x13, 0x3c, 0x1c, 0xcb, 0x97, 0x4a,
+	0xda, 0x94, 0x2b, 0x7e, 0xd9, 0x30, 0xec, 0xf7, 0x09, 0x0b, 0xc4, 0xfb, 0x28, 0x6d, 0x46, 0xcb,
+	0xf2, 0x6f, 0x63, 0x80, 0xe6, 0xe6, 0x1b, 0x64, 0xe8, 0x4c, 0x88, 0x3f, 0xbd, 0x7a, 0x8a, 0x96,
+	0x21, 0x67, 0x93, 0x80, 0x59, 0x3d, 0x4e, 0xb3, 0x9c, 0xb0, 0x20, 0x73, 0x66, 0x86, 0x0b, 0x85,
+	0x29, 0xc3, 0xe6, 0xc3, 0x2f, 0x20, 0xdf, 0x8d, 0x89, 0xd7, 0x23, 0xa2, 0xca, 0x54, 0x73, 0xb6,
+	0x8e, 0x7c, 0x57, 0xe7, 0xbe, 0x2f, 0xf8, 0x98, 0xb8, 0xe0, 0x23, 0xfa, 0xf9, 0xec, 0x3d, 0x95,
+	0x14, 0xef, 0xa9, 0xdb, 0x4b, 0x03, 0xf8, 0xb2, 0xff, 0x4b, 0x2f, 0xab, 0x12, 0x64, 0x70, 0xef,
+	0xdc, 0x1a, 0xe1, 0xe9, 0x90, 0x62, 0x5b, 0x94, 0x44, 0xd6, 0x04, 0xdc, 0x3b, 0x6f, 0x87, 0x92,
+	0x83, 0xdf, 0x29, 0x00, 0x0b, 0xaf, 0xe1, 0x8f, 0xe0, 0xc6, 0x69, 0xab, 0xab, 0x5b, 0xad, 0x76,
+	0xd7, 0x68, 0x35, 0xad, 0x6f, 0x9b, 0x9d, 0xb6, 0x5e, 0x37, 0xbe, 0x36, 0xf4, 0x46, 0x61, 0x0d,
+	0x7d, 0x08, 0x1b, 0x8b, 0xca, 0x07, 0x7a, 0xa7, 0xa0, 0xa0, 0x1b, 0xf0, 0xe1, 0xa2, 0xb0, 0x76,
+	0xd4, 0xe9, 0xd6, 0x8c, 0x66, 0x21, 0x86, 0x10, 0xe4, 0x17, 0x15, 0xcd, 0x56, 0x21, 0x8e, 0x3e,
+	0x06, 0xed, 0xa2, 0xcc, 0xba, 0x6f, 0x74, 0x8f, 0xad, 0x53, 0xbd, 0xdb, 0x2a, 0xa8, 0x07, 0x7f,
+	0x53, 0x20, 0x7f, 0xf1, 0x85, 0x88, 0x4a, 0xf0, 0x51, 0xdb, 0x6c, 0xb5, 0x5b, 0x9d, 0xda, 0x89,
+	0xd5, 0xe9, 0xd6, 0xba, 0xdf, 0x76, 0x96, 0x7c, 0x2a, 0x43, 0x71, 0x19, 0xd0, 0xd0, 0xdb, 0xad,
+	0x8e, 0xd1, 0xb5, 0xda, 0xba, 0x69, 0xb4, 0x1a, 0x05, 0x05, 0xdd, 0x84, 0xdd, 0x65, 0xcc, 0x69,
+	0xab, 0x6b, 0x34, 0xbf, 0x89, 0x20, 0x31, 0xb4, 0x03, 0x5b, 0xcb, 0x90, 0x76, 0xad, 0xd3, 0xd1,
+	0x1b, 0xa1, 0xd3, 0xcb, 0x3a, 0x53, 0xbf, 0xa7, 0xd7, 0xbb, 0x7a, 0xa3, 0xa0, 0xae, 0x62, 0x7e,
+	0x5d, 0x33, 0x4e, 0xf4, 0x46, 0x21, 0x71, 0xf0, 0x57, 0x05, 0xb4, 0x37, 0xa5, 0x08, 0xdd, 0x81,
+	0xdb, 0x75, 0xb3, 0xd5, 0xe9, 0x58, 0xf5, 0xe3, 0x9a, 0xd1, 0xb4, 0x1a, 0xfa, 0x89, 0x71, 0xaa,
+	0x9b, 0x0f, 0x56, 0x87, 0x79, 0x1b, 0x3e, 0x79, 0x1b, 0xb8, 0xad, 0x37, 0x1b, 0x46, 0xf3, 0x9b,
+	0x82, 0x82, 0x7e, 0x08, 0x37, 0xdf, 0x06, 0xac, 0xd5, 0x7f, 0xa1, 0xf3, 0x78, 0x6f, 0x41, 0xf9,
+	0x6d, 0x30, 0x19, 0x41, 0xfc, 0x48, 0x7f, 0xfa, 0xb2, 0xa8, 0x3c, 0x7b, 0x59, 0x54, 0xfe, 0xf3,
+	0xb2, 0xa8, 0x3c, 0x7e, 0x55, 0x5c, 0x7b, 0xf6, 0xaa, 0xb8, 0xf6, 0xcf, 0x57, 0xc5, 0xb5, 0x5f,
+	0xdf, 0xe9, 0x3b, 0x6c, 0x30, 0x3e, 0xab, 0xf4, 0xa8, 0x2b, 0x7f, 0x79, 0xc9, 0x7f, 0x77, 0x03,
+	0xfb, 0xbc, 0xfa, 0x48, 0xfc, 0x9a, 0x64, 0xd3, 0x11, 0x09, 0xf8, 0x4f, 0xc5, 0xa4, 0x98, 0xad,
+	0x5f, 0xfc, 0x7f, 0x00, 0x8f, 0xa3, 0x1d, 0xd9, 0x6b, 0x0e, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1655,6 +1806,72 @@ func (m *CrossChainParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *CrossChainDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AckPayload) > 0 {
+		i -= len(m.AckPayload)
+		copy(dAtA[i:], m.AckPayload)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AckPayload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Targets[iNdEx])
+			copy(dAtA[i:], m.Targets[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Targets[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1948,6 +2165,41 @@ func (m *CrossChainParamsChange) Size() (n int) {
 	return n
 }
 
+func (m *CrossChainDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovGov(uint64(m.DestChainId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGov(uint64(m.Sequence))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Targets) > 0 {
+		for _, s := range m.Targets {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	l = len(m.AckPayload)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3873,6 +4125,230 @@ func (m *CrossChainParamsChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CrossChainDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CrossChainDeliveryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckPayload = append(m.AckPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.AckPayload == nil {
+				m.AckPayload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,8 +15,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgUpdateCrossChainParams{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgUpdateCrossChainParams{}, &MsgUpdateCrossChainParamsBatch{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -329,3 +329,36 @@ func (msg MsgUpdateCrossChainParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgUpdateCrossChainParamsBatch creates a new MsgUpdateCrossChainParamsBatch.
+func NewMsgUpdateCrossChainParamsBatch(authority string, updates []CrossChainParamsUpdate) *MsgUpdateCrossChainParamsBatch {
+	return &MsgUpdateCrossChainParamsBatch{Authority: authority, Updates: updates}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateCrossChainParamsBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if len(msg.Updates) == 0 {
+		return types.ErrEmptyChange.Wrap("no cross-chain params updates")
+	}
+	for i, update := range msg.Updates {
+		if err := update.Params.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "update %d to chain %d", i, update.DestChainId)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateCrossChainParamsBatch) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateCrossChainParamsBatch.
+func (msg MsgUpdateCrossChainParamsBatch) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromHexUnsafe(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
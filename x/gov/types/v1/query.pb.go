@@ -893,6 +893,116 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryCrossChainDeliveriesRequest is the request type for the Query/CrossChainDeliveries RPC method.
+type QueryCrossChainDeliveriesRequest struct {
+	// proposal_id defines the unique id of the proposal, all deliveries are returned if it is zero.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCrossChainDeliveriesRequest) Reset()         { *m = QueryCrossChainDeliveriesRequest{} }
+func (m *QueryCrossChainDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainDeliveriesRequest) ProtoMessage()    {}
+func (*QueryCrossChainDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryCrossChainDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainDeliveriesRequest.Merge(m, src)
+}
+func (m *QueryCrossChainDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainDeliveriesRequest proto.InternalMessageInfo
+
+func (m *QueryCrossChainDeliveriesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryCrossChainDeliveriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCrossChainDeliveriesResponse is the response type for the Query/CrossChainDeliveries RPC method.
+type QueryCrossChainDeliveriesResponse struct {
+	// deliveries defines the requested cross-chain deliveries.
+	Deliveries []*CrossChainDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCrossChainDeliveriesResponse) Reset()         { *m = QueryCrossChainDeliveriesResponse{} }
+func (m *QueryCrossChainDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainDeliveriesResponse) ProtoMessage()    {}
+func (*QueryCrossChainDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryCrossChainDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainDeliveriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainDeliveriesResponse.Merge(m, src)
+}
+func (m *QueryCrossChainDeliveriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainDeliveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainDeliveriesResponse proto.InternalMessageInfo
+
+func (m *QueryCrossChainDeliveriesResponse) GetDeliveries() []*CrossChainDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+func (m *QueryCrossChainDeliveriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -910,73 +1020,80 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryCrossChainDeliveriesRequest)(nil), "cosmos.gov.v1.QueryCrossChainDeliveriesRequest")
+	proto.RegisterType((*QueryCrossChainDeliveriesResponse)(nil), "cosmos.gov.v1.QueryCrossChainDeliveriesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
-	0x14, 0x8e, 0x27, 0x8f, 0xce, 0x9c, 0x34, 0x01, 0x4e, 0x1f, 0x19, 0x4c, 0x99, 0x06, 0x87, 0x26,
-	0x81, 0x12, 0x5f, 0x26, 0x7d, 0x49, 0x50, 0x16, 0x0d, 0x25, 0x05, 0x89, 0x45, 0x98, 0x56, 0x2c,
-	0xd8, 0x44, 0x4e, 0xc6, 0x32, 0x16, 0x13, 0x5f, 0x77, 0xee, 0x9d, 0x11, 0x21, 0x8d, 0x90, 0x2a,
-	0x21, 0x58, 0x01, 0x12, 0x15, 0xf0, 0x43, 0xf8, 0x11, 0x2c, 0x2b, 0xd8, 0x20, 0x56, 0x28, 0xe1,
-	0x87, 0x20, 0xdf, 0x7b, 0xec, 0xb1, 0x1d, 0x8f, 0x33, 0x53, 0x55, 0xac, 0x22, 0xdf, 0xfb, 0x9d,
-	0xef, 0x7c, 0xe7, 0x79, 0x33, 0xf0, 0xf2, 0x2e, 0x17, 0x7b, 0x5c, 0x30, 0x8f, 0xf7, 0x59, 0xbf,
-	0xc9, 0x1e, 0xf6, 0xdc, 0xee, 0xbe, 0x1d, 0x76, 0xb9, 0xe4, 0x38, 0xa7, 0xaf, 0x6c, 0x8f, 0xf7,
-	0xed, 0x7e, 0xd3, 0x7c, 0x93, 0x90, 0x3b, 0x8e, 0x70, 0x35, 0x8e, 0xf5, 0x9b, 0x3b, 0xae, 0x74,
-	0x9a, 0x2c, 0x74, 0x3c, 0x3f, 0x70, 0xa4, 0xcf, 0x03, 0x6d, 0x6a, 0x5e, 0xf2, 0x38, 0xf7, 0x3a,
-	0x2e, 0x73, 0x42, 0x9f, 0x39, 0x41, 0xc0, 0xa5, 0xba, 0x14, 0x74, 0xbb, 0x90, 0xf5, 0x19, 0xf1,
-	0xeb, 0x0b, 0x12, 0xb3, 0xad, 0xbe, 0x18, 0xb9, 0x57, 0x1f, 0xd6, 0x2d, 0x38, 0xff, 0x49, 0xe4,
-	0x73, 0xab, 0xcb, 0x43, 0x2e, 0x9c, 0x4e, 0xcb, 0x7d, 0xd8, 0x73, 0x85, 0xc4, 0xcb, 0x30, 0x1b,
-	0xd2, 0xd1, 0xb6, 0xdf, 0xae, 0x1b, 0x8b, 0xc6, 0xea, 0x54, 0x0b, 0xe2, 0xa3, 0x8f, 0xda, 0xd6,
-	0xc7, 0x70, 0x21, 0x67, 0x28, 0x42, 0x1e, 0x08, 0x17, 0xaf, 0x41, 0x35, 0x86, 0x29, 0xb3, 0xd9,
-	0xf5, 0x05, 0x3b, 0x13, 0xb1, 0x9d, 0x98, 0x24, 0x40, 0xeb, 0x87, 0x4a, 0x8e, 0x4e, 0xc4, 0x42,
-	0x36, 0xe1, 0x85, 0x44, 0x88, 0x90, 0x8e, 0xec, 0x09, 0xc5, 0x3a, 0xbf, 0xfe, 0xea, 0x10, 0xd6,
-	0xfb, 0x0a, 0xd4, 0x9a, 0x0f, 0x33, 0xdf, 0x68, 0xc3, 0x74, 0x9f, 0x4b, 0xb7, 0x5b, 0xaf, 0x2c,
-	0x1a, 0xab, 0xb5, 0x8d, 0xfa, 0x1f, 0xbf, 0xad, 0x9d, 0x27, 0x82, 0x3b, 0xed, 0x76, 0xd7, 0x15,
-	0xe2, 0xbe, 0xec, 0xfa, 0x81, 0xd7, 0xd2, 0x30, 0xbc, 0x09, 0xb5, 0xb6, 0x1b, 0x72, 0xe1, 0x4b,
-	0xde, 0xad, 0x4f, 0x9e, 0x62, 0x33, 0x80, 0xe2, 0x26, 0xc0, 0xa0, 0x6c, 0xf5, 0x29, 0x95, 0x80,
-	0xe5, 0x58, 0x6a, 0x54, 0x63, 0x5b, 0xf7, 0x02, 0xd5, 0xd8, 0xde, 0x72, 0x3c, 0x97, 0x62, 0x6d,
-	0xa5, 0x2c, 0xad, 0x5f, 0x0d, 0xb8, 0x98, 0xcf, 0x08, 0x65, 0xf8, 0x06, 0xd4, 0xe2, 0xe0, 0xa2,
-	0x64, 0x4c, 0x96, 0xa5, 0x78, 0x80, 0xc4, 0x7b, 0x19, 0x65, 0x15, 0xa5, 0x6c, 0xe5, 0x54, 0x65,
-	0xda, 0x67, 0x46, 0xda, 0x2e, 0xbc, 0xa8, 0x94, 0x7d, 0xca, 0xa5, 0x3b, 0x6a, 0xbf, 0x8c, 0x9b,
-	0x7f, 0xeb, 0x36, 0xbc, 0x94, 0x72, 0x42, 0x91, 0xaf, 0xc0, 0x54, 0x74, 0x4b, 0x7d, 0x75, 0x2e,
-	0x17, 0xb4, 0x82, 0x2a, 0x80, 0xf5, 0x28, 0x65, 0x2d, 0x46, 0xd6, 0xb8, 0x59, 0x90, 0xa1, 0x67,
-	0xa9, 0xdd, 0x77, 0x06, 0x60, 0xda, 0x3d, 0xa9, 0x7f, 0x43, 0xa7, 0x20, 0xae, 0x59, 0xa1, 0x7c,
-	0x8d, 0x78, 0x7e, 0xb5, 0xba, 0x41, 0x4a, 0xb6, 0x9c, 0xae, 0xb3, 0x97, 0xc9, 0x84, 0x3a, 0xd8,
-	0x96, 0xfb, 0xa1, 0x4e, 0x67, 0x2d, 0x32, 0x8b, 0x8e, 0x1e, 0xec, 0x87, 0xae, 0xf5, 0x73, 0x05,
-	0xce, 0x65, 0xec, 0x28, 0x84, 0xbb, 0x30, 0xd7, 0xe7, 0xd2, 0x0f, 0xbc, 0x6d, 0x0d, 0xa6, 0x4a,
-	0xbc, 0x72, 0x32, 0x14, 0x3f, 0xf0, 0xb4, 0xed, 0x46, 0xa5, 0x6e, 0xb4, 0xce, 0xf6, 0x53, 0x27,
-	0x78, 0x0f, 0xe6, 0x69, 0x60, 0x62, 0x1a, 0x1d, 0xe1, 0xa5, 0x1c, 0xcd, 0x5d, 0x0d, 0x4a, 0xf1,
-	0xcc, 0xb5, 0xd3, 0x47, 0x78, 0x07, 0xce, 0x4a, 0xa7, 0xd3, 0xd9, 0x8f, 0x69, 0x26, 0x15, 0x8d,
-	0x99, 0xa3, 0x79, 0x10, 0x41, 0x52, 0x24, 0xb3, 0x72, 0x70, 0x80, 0x6b, 0x30, 0x43, 0xc6, 0x7a,
-	0x56, 0x2f, 0xe4, 0x27, 0x49, 0x27, 0x80, 0x40, 0x56, 0x40, 0x79, 0x21, 0x69, 0x23, 0xb7, 0x56,
-	0x66, 0x9d, 0x54, 0x46, 0x5e, 0x27, 0xd6, 0x87, 0xb4, 0x9f, 0x13, 0x7f, 0x54, 0x88, 0xb7, 0xe1,
-	0x0c, 0x81, 0xa8, 0x04, 0x17, 0x8b, 0x73, 0xd7, 0x8a, 0x61, 0xd6, 0xd7, 0x59, 0xa6, 0xff, 0x7f,
-	0x2a, 0x9e, 0x18, 0xb4, 0xe3, 0x07, 0x0a, 0x28, 0x98, 0x75, 0xa8, 0x92, 0xca, 0x78, 0x36, 0x86,
-	0x45, 0x93, 0xe0, 0x9e, 0xdf, 0x84, 0xbc, 0x03, 0x0b, 0x4a, 0x95, 0xea, 0x92, 0x96, 0x2b, 0x7a,
-	0x1d, 0x39, 0xc6, 0x23, 0x58, 0x3f, 0x69, 0x9b, 0x54, 0x68, 0x5a, 0xf5, 0x19, 0xd5, 0xa7, 0xb0,
-	0x29, 0xc9, 0x44, 0x03, 0xd7, 0xff, 0xae, 0xc2, 0xb4, 0xa2, 0xc3, 0x6f, 0x0c, 0xa8, 0xc6, 0x2b,
-	0x1c, 0x97, 0x72, 0x96, 0x45, 0xef, 0xb5, 0xf9, 0x7a, 0x39, 0x48, 0x6b, 0xb2, 0xec, 0xc7, 0x7f,
-	0xfe, 0xfb, 0x53, 0x65, 0x15, 0x97, 0x59, 0xf6, 0x5f, 0x85, 0xe4, 0x91, 0x60, 0x07, 0xa9, 0x80,
-	0x0f, 0xf1, 0x2b, 0xa8, 0x25, 0xcf, 0x0f, 0x96, 0xba, 0x88, 0xdb, 0xc9, 0xbc, 0x72, 0x0a, 0x8a,
-	0x94, 0x2c, 0x2a, 0x25, 0x26, 0xd6, 0x87, 0x29, 0xc1, 0x6f, 0x0d, 0x98, 0x8a, 0x56, 0x22, 0x5e,
-	0x2e, 0x62, 0x4c, 0xbd, 0x3d, 0xe6, 0xe2, 0x70, 0x00, 0x79, 0xbb, 0xad, 0xbc, 0xdd, 0xc4, 0xeb,
-	0xa3, 0xc5, 0xcd, 0xd4, 0x12, 0x66, 0x07, 0xea, 0x25, 0x3a, 0xc4, 0xc7, 0x06, 0x4c, 0xab, 0x4d,
-	0x8e, 0x43, 0x3d, 0x25, 0xe1, 0xbf, 0x56, 0x82, 0x20, 0x31, 0xd7, 0x95, 0x18, 0x1b, 0xdf, 0x1a,
-	0x47, 0x0c, 0x3e, 0x82, 0x19, 0xda, 0x58, 0x85, 0x2e, 0x32, 0xfb, 0xdd, 0xb4, 0xca, 0x20, 0x24,
-	0xe3, 0xaa, 0x92, 0x71, 0x05, 0x97, 0xf2, 0x32, 0x14, 0x8c, 0x1d, 0xa4, 0x1e, 0x88, 0x43, 0xfc,
-	0xc5, 0x80, 0x33, 0x34, 0x83, 0x58, 0x48, 0x9e, 0xdd, 0x87, 0xe6, 0x52, 0x29, 0x86, 0x14, 0xbc,
-	0xaf, 0x14, 0xbc, 0x87, 0xef, 0x8e, 0x98, 0x88, 0x78, 0xf6, 0xd9, 0x41, 0xb2, 0x1f, 0x0f, 0xf1,
-	0x7b, 0x03, 0xaa, 0xf1, 0x42, 0xc1, 0x32, 0xb7, 0xa2, 0x74, 0x54, 0xf2, 0x3b, 0xc9, 0xba, 0xa5,
-	0xc4, 0x35, 0x91, 0x8d, 0x29, 0x0e, 0x9f, 0x18, 0x30, 0x9b, 0x1a, 0x6e, 0x5c, 0x2e, 0x72, 0x77,
-	0x72, 0xd9, 0x98, 0x2b, 0xa7, 0xe2, 0x9e, 0xb1, 0x7f, 0xd4, 0x72, 0xd9, 0xf8, 0xe0, 0xf7, 0xa3,
-	0x86, 0xf1, 0xf4, 0xa8, 0x61, 0xfc, 0x73, 0xd4, 0x30, 0x7e, 0x3c, 0x6e, 0x4c, 0x3c, 0x3d, 0x6e,
-	0x4c, 0xfc, 0x75, 0xdc, 0x98, 0xf8, 0xec, 0xaa, 0xe7, 0xcb, 0xcf, 0x7b, 0x3b, 0xf6, 0x2e, 0xdf,
-	0x8b, 0x19, 0xf5, 0x9f, 0x35, 0xd1, 0xfe, 0x82, 0x7d, 0xa9, 0xe8, 0xa3, 0x2e, 0x10, 0xd1, 0xef,
-	0x92, 0x19, 0xf5, 0xb3, 0xe1, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xe4, 0xf6, 0xe9,
-	0xe0, 0x0c, 0x00, 0x00,
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
+	0x14, 0xce, 0x9d, 0x3c, 0x9a, 0x39, 0x69, 0x02, 0xdc, 0x26, 0xcd, 0x60, 0xca, 0x74, 0xe2, 0x90,
+	0x07, 0x94, 0xd8, 0x9d, 0xf4, 0x25, 0x41, 0x59, 0xe4, 0x41, 0x0a, 0x12, 0x8b, 0xe0, 0x56, 0x2c,
+	0xd8, 0x8c, 0x9c, 0x19, 0xcb, 0xb5, 0x98, 0xf8, 0xba, 0xbe, 0x9e, 0x11, 0x43, 0x1a, 0x21, 0x55,
+	0xaa, 0x40, 0x42, 0x02, 0x24, 0x2a, 0xe0, 0x27, 0xb0, 0x63, 0xc3, 0x8f, 0x60, 0x59, 0xc1, 0x86,
+	0x25, 0x4a, 0xf8, 0x21, 0xc8, 0xf7, 0x1e, 0x7b, 0x6c, 0xc7, 0xe3, 0x4c, 0xaa, 0x08, 0x56, 0xa3,
+	0xb9, 0xf7, 0x3b, 0xdf, 0xf9, 0xce, 0xe3, 0x9e, 0x33, 0x03, 0xaf, 0x36, 0x19, 0xdf, 0x67, 0x5c,
+	0xb7, 0x59, 0x57, 0xef, 0xd6, 0xf5, 0x47, 0x1d, 0xcb, 0xef, 0x69, 0x9e, 0xcf, 0x02, 0x46, 0xa7,
+	0xe5, 0x95, 0x66, 0xb3, 0xae, 0xd6, 0xad, 0x2b, 0x6f, 0x21, 0x72, 0xcf, 0xe4, 0x96, 0xc4, 0xe9,
+	0xdd, 0xfa, 0x9e, 0x15, 0x98, 0x75, 0xdd, 0x33, 0x6d, 0xc7, 0x35, 0x03, 0x87, 0xb9, 0xd2, 0x54,
+	0xb9, 0x62, 0x33, 0x66, 0xb7, 0x2d, 0xdd, 0xf4, 0x1c, 0xdd, 0x74, 0x5d, 0x16, 0x88, 0x4b, 0x8e,
+	0xb7, 0xf3, 0x69, 0x9f, 0x21, 0xbf, 0xbc, 0x40, 0x31, 0x0d, 0xf1, 0x4d, 0x47, 0xf7, 0xe2, 0x8b,
+	0x7a, 0x07, 0x66, 0x3f, 0x0e, 0x7d, 0xee, 0xfa, 0xcc, 0x63, 0xdc, 0x6c, 0x1b, 0xd6, 0xa3, 0x8e,
+	0xc5, 0x03, 0x7a, 0x15, 0xa6, 0x3c, 0x3c, 0x6a, 0x38, 0xad, 0x0a, 0xa9, 0x91, 0xd5, 0x31, 0x03,
+	0xa2, 0xa3, 0x0f, 0x5b, 0xea, 0x47, 0x30, 0x97, 0x31, 0xe4, 0x1e, 0x73, 0xb9, 0x45, 0x6f, 0xc0,
+	0x64, 0x04, 0x13, 0x66, 0x53, 0xeb, 0xf3, 0x5a, 0x2a, 0x62, 0x2d, 0x36, 0x89, 0x81, 0xea, 0x77,
+	0xa5, 0x0c, 0x1d, 0x8f, 0x84, 0xec, 0xc0, 0x4b, 0xb1, 0x10, 0x1e, 0x98, 0x41, 0x87, 0x0b, 0xd6,
+	0x99, 0xf5, 0xd7, 0x07, 0xb0, 0xde, 0x17, 0x20, 0x63, 0xc6, 0x4b, 0x7d, 0xa7, 0x1a, 0x8c, 0x77,
+	0x59, 0x60, 0xf9, 0x95, 0x52, 0x8d, 0xac, 0x96, 0x37, 0x2b, 0x7f, 0xfc, 0xb6, 0x36, 0x8b, 0x04,
+	0x1b, 0xad, 0x96, 0x6f, 0x71, 0x7e, 0x3f, 0xf0, 0x1d, 0xd7, 0x36, 0x24, 0x8c, 0xde, 0x86, 0x72,
+	0xcb, 0xf2, 0x18, 0x77, 0x02, 0xe6, 0x57, 0x46, 0x4f, 0xb1, 0xe9, 0x43, 0xe9, 0x0e, 0x40, 0xbf,
+	0x6c, 0x95, 0x31, 0x91, 0x80, 0xe5, 0x48, 0x6a, 0x58, 0x63, 0x4d, 0xf6, 0x02, 0xd6, 0x58, 0xdb,
+	0x35, 0x6d, 0x0b, 0x63, 0x35, 0x12, 0x96, 0xea, 0xcf, 0x04, 0x2e, 0x67, 0x33, 0x82, 0x19, 0xbe,
+	0x05, 0xe5, 0x28, 0xb8, 0x30, 0x19, 0xa3, 0x45, 0x29, 0xee, 0x23, 0xe9, 0xbd, 0x94, 0xb2, 0x92,
+	0x50, 0xb6, 0x72, 0xaa, 0x32, 0xe9, 0x33, 0x25, 0xad, 0x09, 0x2f, 0x0b, 0x65, 0x9f, 0xb0, 0xc0,
+	0x1a, 0xb6, 0x5f, 0xce, 0x9a, 0x7f, 0xf5, 0x2e, 0xbc, 0x92, 0x70, 0x82, 0x91, 0xaf, 0xc0, 0x58,
+	0x78, 0x8b, 0x7d, 0x75, 0x29, 0x13, 0xb4, 0x80, 0x0a, 0x80, 0xfa, 0x38, 0x61, 0xcd, 0x87, 0xd6,
+	0xb8, 0x93, 0x93, 0xa1, 0x17, 0xa9, 0xdd, 0xd7, 0x04, 0x68, 0xd2, 0x3d, 0xaa, 0x7f, 0x53, 0xa6,
+	0x20, 0xaa, 0x59, 0xae, 0x7c, 0x89, 0x38, 0xbf, 0x5a, 0xdd, 0x42, 0x25, 0xbb, 0xa6, 0x6f, 0xee,
+	0xa7, 0x32, 0x21, 0x0e, 0x1a, 0x41, 0xcf, 0x93, 0xe9, 0x2c, 0x1b, 0x20, 0x8f, 0x1e, 0xf4, 0x3c,
+	0x4b, 0xfd, 0xb1, 0x04, 0x97, 0x52, 0x76, 0x18, 0xc2, 0x36, 0x4c, 0x77, 0x59, 0xe0, 0xb8, 0x76,
+	0x43, 0x82, 0xb1, 0x12, 0xaf, 0x9d, 0x0c, 0xc5, 0x71, 0x6d, 0x69, 0xbb, 0x59, 0xaa, 0x10, 0xe3,
+	0x62, 0x37, 0x71, 0x42, 0xef, 0xc1, 0x0c, 0x3e, 0x98, 0x88, 0x46, 0x46, 0x78, 0x25, 0x43, 0xb3,
+	0x2d, 0x41, 0x09, 0x9e, 0xe9, 0x56, 0xf2, 0x88, 0x6e, 0xc0, 0xc5, 0xc0, 0x6c, 0xb7, 0x7b, 0x11,
+	0xcd, 0xa8, 0xa0, 0x51, 0x32, 0x34, 0x0f, 0x42, 0x48, 0x82, 0x64, 0x2a, 0xe8, 0x1f, 0xd0, 0x35,
+	0x98, 0x40, 0x63, 0xf9, 0x56, 0xe7, 0xb2, 0x2f, 0x49, 0x26, 0x00, 0x41, 0xaa, 0x8b, 0x79, 0x41,
+	0x69, 0x43, 0xb7, 0x56, 0x6a, 0x9c, 0x94, 0x86, 0x1e, 0x27, 0xea, 0x07, 0x30, 0x9b, 0xf6, 0x87,
+	0x85, 0xb8, 0x0e, 0x17, 0x10, 0x84, 0x25, 0xb8, 0x9c, 0x9f, 0x3b, 0x23, 0x82, 0xa9, 0x5f, 0xa6,
+	0x99, 0xfe, 0xfb, 0x57, 0xf1, 0x8c, 0xc0, 0x5c, 0x46, 0x01, 0x06, 0xb3, 0x0e, 0x93, 0xa8, 0x32,
+	0x7a, 0x1b, 0x83, 0xa2, 0x89, 0x71, 0xe7, 0xf7, 0x42, 0xde, 0x81, 0x79, 0xa1, 0x4a, 0x74, 0x89,
+	0x61, 0xf1, 0x4e, 0x3b, 0x38, 0xc3, 0x12, 0xac, 0x9c, 0xb4, 0x8d, 0x2b, 0x34, 0x2e, 0xfa, 0xac,
+	0x42, 0x06, 0x37, 0x25, 0x9a, 0x48, 0xa0, 0xfa, 0x0d, 0x81, 0x9a, 0xa0, 0xdb, 0xf2, 0x19, 0xe7,
+	0x5b, 0x0f, 0x4d, 0xc7, 0xdd, 0xb6, 0xda, 0x4e, 0xd7, 0xf2, 0x9d, 0xff, 0x61, 0x88, 0xfd, 0x4a,
+	0x60, 0xa1, 0x40, 0x0d, 0x46, 0xb9, 0x01, 0xd0, 0x8a, 0x4f, 0xb1, 0x78, 0x0b, 0x99, 0x50, 0x4f,
+	0x10, 0xf4, 0x8c, 0x84, 0xd1, 0xb9, 0x55, 0x72, 0xfd, 0x29, 0xc0, 0xb8, 0x50, 0x4c, 0x9f, 0x12,
+	0x98, 0x8c, 0x56, 0x20, 0x5d, 0xcc, 0xc8, 0xc9, 0xfb, 0xbd, 0xa3, 0xbc, 0x51, 0x0c, 0x92, 0xde,
+	0x54, 0xed, 0xc9, 0x9f, 0xff, 0xfc, 0x50, 0x5a, 0xa5, 0xcb, 0x7a, 0xfa, 0xa7, 0x56, 0xbc, 0x64,
+	0xf5, 0x83, 0x44, 0x71, 0x0e, 0xe9, 0x17, 0x50, 0x8e, 0x38, 0x38, 0x2d, 0x74, 0x11, 0xd5, 0x57,
+	0x59, 0x3a, 0x05, 0x85, 0x4a, 0x6a, 0x42, 0x89, 0x42, 0x2b, 0x83, 0x94, 0xd0, 0xaf, 0x08, 0x8c,
+	0x85, 0x2b, 0x85, 0x5e, 0xcd, 0x63, 0x4c, 0xec, 0x6e, 0xa5, 0x36, 0x18, 0x80, 0xde, 0xee, 0x0a,
+	0x6f, 0xb7, 0xe9, 0xcd, 0xe1, 0xe2, 0xd6, 0xc5, 0x12, 0xd3, 0x0f, 0xc2, 0x0f, 0xff, 0x90, 0x3e,
+	0x21, 0x30, 0x1e, 0xd2, 0x71, 0x3a, 0xd0, 0x53, 0x1c, 0xfe, 0x42, 0x01, 0x02, 0xc5, 0xdc, 0x14,
+	0x62, 0x34, 0xfa, 0xf6, 0x59, 0xc4, 0xd0, 0xc7, 0x30, 0x81, 0x13, 0x3f, 0xd7, 0x45, 0x6a, 0x3f,
+	0x2a, 0x6a, 0x11, 0x04, 0x65, 0x5c, 0x13, 0x32, 0x96, 0xe8, 0x62, 0x56, 0x86, 0x80, 0xe9, 0x07,
+	0x89, 0x05, 0x7b, 0x48, 0x7f, 0x22, 0x70, 0x01, 0x67, 0x18, 0xcd, 0x25, 0x4f, 0xef, 0x13, 0x65,
+	0xb1, 0x10, 0x83, 0x0a, 0xb6, 0x84, 0x82, 0xf7, 0xe8, 0xbb, 0x43, 0x26, 0x22, 0x9a, 0x9d, 0xfa,
+	0x41, 0xbc, 0x5f, 0x0e, 0xe9, 0xb7, 0x04, 0x26, 0x91, 0x98, 0xd3, 0x22, 0xb7, 0xbc, 0xf0, 0xa9,
+	0x64, 0x67, 0xba, 0x7a, 0x47, 0x88, 0xab, 0x53, 0xfd, 0x8c, 0xe2, 0xe8, 0x33, 0x02, 0x53, 0x89,
+	0xe1, 0x48, 0x97, 0xf3, 0xdc, 0x9d, 0x1c, 0xd6, 0xca, 0xca, 0xa9, 0xb8, 0x17, 0xec, 0x1f, 0x31,
+	0x9c, 0xe9, 0x2f, 0x04, 0x66, 0xf3, 0x26, 0x21, 0xd5, 0xf3, 0xfc, 0x16, 0x4c, 0x70, 0xe5, 0xfa,
+	0xf0, 0x06, 0xa8, 0x78, 0x4d, 0x28, 0x5e, 0xa1, 0x4b, 0x19, 0xc5, 0xcd, 0xd0, 0xa8, 0xd1, 0x0c,
+	0xad, 0x1a, 0xfd, 0x81, 0xba, 0xf9, 0xfe, 0xef, 0x47, 0x55, 0xf2, 0xfc, 0xa8, 0x4a, 0xfe, 0x3e,
+	0xaa, 0x92, 0xef, 0x8f, 0xab, 0x23, 0xcf, 0x8f, 0xab, 0x23, 0x7f, 0x1d, 0x57, 0x47, 0x3e, 0xbd,
+	0x66, 0x3b, 0xc1, 0xc3, 0xce, 0x9e, 0xd6, 0x64, 0xfb, 0x11, 0x95, 0xfc, 0x58, 0xe3, 0xad, 0xcf,
+	0xf4, 0xcf, 0x05, 0x6f, 0xd8, 0xb0, 0x3c, 0xfc, 0x0b, 0x3a, 0x21, 0xfe, 0x21, 0xde, 0xf8, 0x77,
+	0x00, 0xa2, 0x94, 0xb9, 0xff, 0xcb, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1124,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// CrossChainDeliveries queries the delivery status of the cross-chain params changes sent by
+	// proposals.
+	CrossChainDeliveries(ctx context.Context, in *QueryCrossChainDeliveriesRequest, opts ...grpc.CallOption) (*QueryCrossChainDeliveriesResponse, error)
 }
 
 type queryClient struct {
@@ -1089,6 +1209,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) CrossChainDeliveries(ctx context.Context, in *QueryCrossChainDeliveriesRequest, opts ...grpc.CallOption) (*QueryCrossChainDeliveriesResponse, error) {
+	out := new(QueryCrossChainDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/CrossChainDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1107,6 +1236,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// CrossChainDeliveries queries the delivery status of the cross-chain params changes sent by
+	// proposals.
+	CrossChainDeliveries(context.Context, *QueryCrossChainDeliveriesRequest) (*QueryCrossChainDeliveriesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1137,6 +1269,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) CrossChainDeliveries(ctx context.Context, req *QueryCrossChainDeliveriesRequest) (*QueryCrossChainDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainDeliveries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1286,6 +1421,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CrossChainDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/CrossChainDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainDeliveries(ctx, req.(*QueryCrossChainDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1475,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "CrossChainDeliveries",
+			Handler:    _Query_CrossChainDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1975,6 +2132,95 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCrossChainDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainDeliveriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainDeliveriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainDeliveriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2245,6 +2491,41 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCrossChainDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossChainDeliveriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3950,6 +4231,231 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCrossChainDeliveriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainDeliveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainDeliveriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainDeliveriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainDeliveriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deliveries = append(m.Deliveries, &CrossChainDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -753,6 +753,8 @@ func (m *MsgUpdateCrossChainParams) GetDestChainId() uint32 {
 
 // MsgUpdateCrossChainParamsResponse defines the response structure for executing a MsgUpdateCrossChainParams message.
 type MsgUpdateCrossChainParamsResponse struct {
+	// sequence is the sequence of the sync params package sent to the destination chain.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *MsgUpdateCrossChainParamsResponse) Reset()         { *m = MsgUpdateCrossChainParamsResponse{} }
@@ -788,6 +790,172 @@ func (m *MsgUpdateCrossChainParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCrossChainParamsResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateCrossChainParamsResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// MsgUpdateCrossChainParamsBatch sends several cross-chain params changes, possibly to different
+// destination chains. Either all the changes are sent or none of them.
+type MsgUpdateCrossChainParamsBatch struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// updates defines the params changes to send and their destination chains.
+	Updates []CrossChainParamsUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *MsgUpdateCrossChainParamsBatch) Reset()         { *m = MsgUpdateCrossChainParamsBatch{} }
+func (m *MsgUpdateCrossChainParamsBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCrossChainParamsBatch) ProtoMessage()    {}
+func (*MsgUpdateCrossChainParamsBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
+}
+func (m *MsgUpdateCrossChainParamsBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCrossChainParamsBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCrossChainParamsBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCrossChainParamsBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCrossChainParamsBatch.Merge(m, src)
+}
+func (m *MsgUpdateCrossChainParamsBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCrossChainParamsBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCrossChainParamsBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCrossChainParamsBatch proto.InternalMessageInfo
+
+func (m *MsgUpdateCrossChainParamsBatch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCrossChainParamsBatch) GetUpdates() []CrossChainParamsUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+// CrossChainParamsUpdate defines a cross-chain params change and its destination chain.
+type CrossChainParamsUpdate struct {
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// for cross chain param change or contract upgrade
+	Params CrossChainParamsChange `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *CrossChainParamsUpdate) Reset()         { *m = CrossChainParamsUpdate{} }
+func (m *CrossChainParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*CrossChainParamsUpdate) ProtoMessage()    {}
+func (*CrossChainParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
+}
+func (m *CrossChainParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainParamsUpdate.Merge(m, src)
+}
+func (m *CrossChainParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainParamsUpdate proto.InternalMessageInfo
+
+func (m *CrossChainParamsUpdate) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *CrossChainParamsUpdate) GetParams() CrossChainParamsChange {
+	if m != nil {
+		return m.Params
+	}
+	return CrossChainParamsChange{}
+}
+
+// MsgUpdateCrossChainParamsBatchResponse defines the response structure for executing a
+// MsgUpdateCrossChainParamsBatch message.
+type MsgUpdateCrossChainParamsBatchResponse struct {
+	// sequences are the sequences of the sync params packages, in the order of the updates.
+	Sequences []uint64 `protobuf:"varint,1,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+}
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) Reset() {
+	*m = MsgUpdateCrossChainParamsBatchResponse{}
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCrossChainParamsBatchResponse) ProtoMessage()    {}
+func (*MsgUpdateCrossChainParamsBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{16}
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCrossChainParamsBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCrossChainParamsBatchResponse.Merge(m, src)
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCrossChainParamsBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCrossChainParamsBatchResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) GetSequences() []uint64 {
+	if m != nil {
+		return m.Sequences
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -803,75 +971,84 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateCrossChainParams)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParams")
 	proto.RegisterType((*MsgUpdateCrossChainParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParamsResponse")
+	proto.RegisterType((*MsgUpdateCrossChainParamsBatch)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParamsBatch")
+	proto.RegisterType((*CrossChainParamsUpdate)(nil), "cosmos.gov.v1.CrossChainParamsUpdate")
+	proto.RegisterType((*MsgUpdateCrossChainParamsBatchResponse)(nil), "cosmos.gov.v1.MsgUpdateCrossChainParamsBatchResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb1, 0xdd, 0x4c, 0x48, 0xaa, 0xac, 0xdc, 0x74, 0xbd, 0x2a, 0x9b, 0x64, 0x03,
-	0xc8, 0x4a, 0xc8, 0x6e, 0x1d, 0x68, 0x85, 0x4c, 0x85, 0xa8, 0x4d, 0x05, 0x95, 0x30, 0x54, 0xae,
-	0x28, 0x12, 0x42, 0x8a, 0xc6, 0xde, 0x61, 0xbc, 0x22, 0xbb, 0xb3, 0xda, 0x19, 0x5b, 0xf1, 0xad,
-	0x82, 0x1b, 0x27, 0x7e, 0x06, 0xc7, 0x1c, 0x7a, 0xeb, 0x1f, 0x28, 0x9c, 0x2a, 0x4e, 0x9c, 0x2a,
-	0x94, 0x08, 0x22, 0xf1, 0x27, 0x40, 0xf3, 0xb1, 0x6b, 0x7b, 0xd7, 0x76, 0x22, 0x90, 0xb8, 0x58,
-	0x33, 0xef, 0xfb, 0xbc, 0x1f, 0xcf, 0xe3, 0x79, 0x67, 0x16, 0x6c, 0x76, 0x09, 0x0d, 0x08, 0x75,
-	0x31, 0x19, 0xb8, 0x83, 0x9a, 0xcb, 0x4e, 0x9c, 0x28, 0x26, 0x8c, 0xe8, 0x6b, 0xd2, 0xee, 0x60,
-	0x32, 0x70, 0x06, 0x35, 0xd3, 0x52, 0xb0, 0x0e, 0xa4, 0xc8, 0x1d, 0xd4, 0x3a, 0x88, 0xc1, 0x9a,
-	0xdb, 0x25, 0x7e, 0x28, 0xe1, 0xe6, 0xcd, 0xc9, 0x34, 0x3c, 0x4a, 0x3a, 0xca, 0x98, 0x60, 0x22,
-	0x96, 0x2e, 0x5f, 0x29, 0x6b, 0x45, 0xc2, 0x8f, 0xa4, 0x43, 0x95, 0x52, 0x2e, 0x4c, 0x08, 0x3e,
-	0x46, 0xae, 0xd8, 0x75, 0xfa, 0xdf, 0xb8, 0x30, 0x1c, 0x66, 0x8a, 0x04, 0x14, 0xf3, 0x22, 0x01,
-	0xc5, 0xca, 0xb1, 0x01, 0x03, 0x3f, 0x24, 0xae, 0xf8, 0x95, 0x26, 0xfb, 0xe7, 0x45, 0xb0, 0xd1,
-	0xa2, 0xf8, 0x71, 0xbf, 0x13, 0xf8, 0xec, 0x51, 0x4c, 0x22, 0x42, 0xe1, 0xb1, 0x7e, 0x1b, 0x5c,
-	0x0b, 0x10, 0xa5, 0x10, 0x23, 0x6a, 0x68, 0xdb, 0x4b, 0xd5, 0xd5, 0xc3, 0xb2, 0x23, 0xeb, 0x39,
-	0x49, 0x3d, 0xe7, 0x7e, 0x38, 0x6c, 0xa7, 0x28, 0xbd, 0x05, 0xae, 0xfb, 0xa1, 0xcf, 0x7c, 0x78,
-	0x7c, 0xe4, 0xa1, 0x88, 0x50, 0x9f, 0x19, 0x8b, 0x22, 0xb0, 0xe2, 0xa8, 0xb6, 0xb9, 0x24, 0x8e,
-	0x92, 0xc4, 0x69, 0x12, 0x3f, 0x6c, 0xac, 0xbc, 0x78, 0xb5, 0xb5, 0xf0, 0xd3, 0xc5, 0xe9, 0x9e,
-	0xd6, 0x5e, 0x57, 0xc1, 0x1f, 0xc9, 0x58, 0xfd, 0x5d, 0x70, 0x2d, 0x12, 0xcd, 0xa0, 0xd8, 0x58,
-	0xda, 0xd6, 0xaa, 0x2b, 0x0d, 0xe3, 0xd7, 0x67, 0x07, 0x65, 0x95, 0xea, 0xbe, 0xe7, 0xc5, 0x88,
-	0xd2, 0xc7, 0x2c, 0xf6, 0x43, 0xdc, 0x4e, 0x91, 0xba, 0xc9, 0xdb, 0x66, 0xd0, 0x83, 0x0c, 0x1a,
-	0xcb, 0x3c, 0xaa, 0x9d, 0xee, 0xf5, 0x32, 0x28, 0x30, 0x9f, 0x1d, 0x23, 0xa3, 0x20, 0x1c, 0x72,
-	0xa3, 0x1b, 0xa0, 0x44, 0xfb, 0x41, 0x00, 0xe3, 0xa1, 0x51, 0x14, 0xf6, 0x64, 0x5b, 0xaf, 0x7d,
-	0x77, 0x71, 0xba, 0x97, 0xa6, 0xfe, 0xe1, 0xe2, 0x74, 0x6f, 0x4b, 0x56, 0x3f, 0xa0, 0xde, 0xb7,
-	0x5c, 0xd6, 0x9c, 0x6a, 0xf6, 0x3d, 0x50, 0xc9, 0x19, 0xdb, 0x88, 0x46, 0x24, 0xa4, 0x48, 0xdf,
-	0x02, 0xab, 0x91, 0xb2, 0x1d, 0xf9, 0x9e, 0xa1, 0x6d, 0x6b, 0xd5, 0xe5, 0x36, 0x48, 0x4c, 0x0f,
-	0x3d, 0xfb, 0xb9, 0x06, 0xca, 0x2d, 0x8a, 0x1f, 0x9c, 0xa0, 0xee, 0xa7, 0x08, 0xc3, 0xee, 0xb0,
-	0x49, 0x42, 0x86, 0x42, 0xa6, 0x7f, 0x06, 0x4a, 0x5d, 0xb9, 0x14, 0x51, 0x33, 0xfe, 0x8b, 0x86,
-	0xf5, 0xcb, 0xb3, 0x03, 0x73, 0xe2, 0x34, 0x26, 0x52, 0x8b, 0xd8, 0x76, 0x92, 0x44, 0xbf, 0x05,
-	0x56, 0x60, 0x9f, 0xf5, 0x48, 0xec, 0xb3, 0xa1, 0xb1, 0x28, 0x58, 0x8f, 0x0c, 0xf5, 0x3b, 0x9c,
-	0xf7, 0x68, 0xcf, 0x89, 0xdb, 0x39, 0xe2, 0xb9, 0x26, 0x6d, 0x0b, 0xdc, 0x9a, 0x66, 0x4f, 0xe8,
-	0xdb, 0x7f, 0x68, 0xa0, 0xd4, 0xa2, 0xf8, 0x09, 0x61, 0x48, 0xbf, 0x33, 0x45, 0x8a, 0x46, 0xf9,
-	0xaf, 0x57, 0x5b, 0xe3, 0x66, 0x79, 0x2e, 0xc6, 0x04, 0xd2, 0x1d, 0x50, 0x18, 0x10, 0x86, 0x62,
-	0xd9, 0xf3, 0x9c, 0x03, 0x21, 0x61, 0x7a, 0x0d, 0x14, 0x49, 0xc4, 0x7c, 0x12, 0x8a, 0x13, 0xb4,
-	0x3e, 0x3a, 0x89, 0x52, 0x1d, 0x87, 0xf7, 0xf2, 0xb9, 0x00, 0xb4, 0x15, 0x70, 0xde, 0x01, 0xaa,
-	0xbf, 0xc1, 0x85, 0x91, 0xa9, 0xb9, 0x28, 0x37, 0x72, 0xa2, 0xf0, 0x7c, 0xf6, 0x06, 0xb8, 0xae,
-	0x96, 0x29, 0xf5, 0xbf, 0xb5, 0xd4, 0xf6, 0x25, 0xf2, 0x71, 0x8f, 0x21, 0xef, 0xff, 0x92, 0xe0,
-	0x7d, 0x50, 0x92, 0xcc, 0xa8, 0xb1, 0x24, 0xa6, 0x71, 0x27, 0xa3, 0x41, 0xd2, 0xd0, 0x98, 0x16,
-	0x49, 0xc4, 0x5c, 0x31, 0xde, 0x9e, 0x14, 0xe3, 0xf5, 0xa9, 0x62, 0x24, 0xc9, 0xed, 0x0a, 0xb8,
-	0x99, 0x31, 0xa5, 0xe2, 0xfc, 0xa9, 0x01, 0xd0, 0xa2, 0x38, 0x99, 0xfb, 0x7f, 0xa9, 0xcb, 0x5d,
-	0xb0, 0xa2, 0x6e, 0x1d, 0x72, 0xb9, 0x36, 0x23, 0xa8, 0x7e, 0x0f, 0x14, 0x61, 0x40, 0xfa, 0x21,
-	0x53, 0xf2, 0x5c, 0xed, 0xb2, 0x52, 0x31, 0xf5, 0x7d, 0x31, 0x2a, 0x69, 0x36, 0x2e, 0x84, 0x91,
-	0x13, 0x42, 0x31, 0xb3, 0xcb, 0x40, 0x1f, 0xed, 0x52, 0xfa, 0xcf, 0xe5, 0xd9, 0xf8, 0x22, 0xf2,
-	0x20, 0x43, 0x8f, 0x60, 0x0c, 0x03, 0xca, 0xc9, 0x8c, 0xe6, 0x53, 0xbb, 0x8c, 0x4c, 0x0a, 0xd5,
-	0xdf, 0x03, 0xc5, 0x48, 0x64, 0x10, 0x0a, 0xac, 0x1e, 0xde, 0xc8, 0xfc, 0xd7, 0x32, 0xfd, 0x04,
-	0x11, 0x89, 0xaf, 0xdf, 0xcd, 0xcf, 0xfc, 0xee, 0x18, 0x91, 0x93, 0xe4, 0xb9, 0xca, 0x74, 0xaa,
-	0xfe, 0xd7, 0x71, 0x53, 0x4a, 0xec, 0xfb, 0x45, 0x71, 0x19, 0x4a, 0x5f, 0x33, 0x26, 0x94, 0x36,
-	0x7b, 0xd0, 0x0f, 0xff, 0x23, 0xc5, 0x4f, 0x32, 0x14, 0xdf, 0xcc, 0x50, 0xcc, 0x16, 0x6a, 0xf6,
-	0x60, 0x88, 0xd1, 0x14, 0xca, 0xba, 0x0d, 0xd6, 0x3c, 0x44, 0xd9, 0x51, 0x97, 0x83, 0xf9, 0x51,
-	0xe3, 0x77, 0xc4, 0x5a, 0x7b, 0x95, 0x1b, 0x45, 0x82, 0x87, 0x5e, 0xfd, 0xc3, 0xbc, 0x2c, 0x07,
-	0x73, 0x65, 0xc9, 0x96, 0xb7, 0x77, 0xc1, 0xce, 0x4c, 0x67, 0x22, 0xd5, 0xe1, 0xd3, 0x02, 0x58,
-	0x6a, 0x51, 0xac, 0x7f, 0x0d, 0xd6, 0x33, 0xcf, 0xf0, 0x76, 0x86, 0x5e, 0xee, 0x75, 0x31, 0xab,
-	0x97, 0x21, 0xd2, 0xf7, 0x07, 0x81, 0x8d, 0xfc, 0xd3, 0xb2, 0x9b, 0x0f, 0xcf, 0x81, 0xcc, 0xfd,
-	0x2b, 0x80, 0xd2, 0x32, 0x1f, 0x80, 0x65, 0x71, 0xc7, 0x6f, 0xe6, 0x83, 0xb8, 0xdd, 0xb4, 0xa6,
-	0xdb, 0xd3, 0xf8, 0x27, 0xe0, 0xb5, 0x89, 0x8b, 0x72, 0x06, 0x3e, 0xf1, 0x9b, 0x6f, 0xcd, 0xf7,
-	0xa7, 0x79, 0x3f, 0x06, 0xa5, 0xe4, 0x8e, 0xa9, 0xe4, 0x43, 0x94, 0xcb, 0xdc, 0x99, 0xe9, 0x1a,
-	0x6f, 0x70, 0x62, 0x5a, 0xa7, 0x34, 0x38, 0xee, 0x9f, 0xd6, 0xe0, 0xb4, 0x81, 0xd1, 0x19, 0xd8,
-	0x9c, 0x31, 0x2c, 0xd5, 0x59, 0x19, 0xb2, 0x48, 0xf3, 0xf6, 0x55, 0x91, 0x49, 0x55, 0xb3, 0xf0,
-	0x94, 0x4f, 0x45, 0xe3, 0xc1, 0x8b, 0x33, 0x4b, 0x7b, 0x79, 0x66, 0x69, 0xbf, 0x9f, 0x59, 0xda,
-	0x8f, 0xe7, 0xd6, 0xc2, 0xcb, 0x73, 0x6b, 0xe1, 0xb7, 0x73, 0x6b, 0xe1, 0xab, 0x7d, 0xec, 0xb3,
-	0x5e, 0xbf, 0xe3, 0x74, 0x49, 0xa0, 0xbe, 0x3f, 0xdd, 0xdc, 0x08, 0xb0, 0x61, 0x84, 0x28, 0xff,
-	0xda, 0x2d, 0x8a, 0x0f, 0x92, 0x77, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x75, 0x7f, 0xe1, 0x20,
-	0x2d, 0x0b, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
+	0x1b, 0xce, 0xe4, 0x62, 0x27, 0x6f, 0xbe, 0xa4, 0xca, 0xc8, 0x4d, 0xc7, 0xf3, 0x85, 0x49, 0x32,
+	0x85, 0x28, 0x4a, 0xc8, 0xb8, 0x0e, 0xa4, 0x42, 0xa6, 0x02, 0xea, 0x10, 0xa0, 0x08, 0x43, 0xe5,
+	0x8a, 0x22, 0x21, 0xa4, 0x68, 0xe2, 0x39, 0x8c, 0x47, 0x64, 0xe6, 0x18, 0x9f, 0x63, 0x2b, 0xde,
+	0x21, 0x2a, 0x75, 0xc1, 0x8a, 0x9f, 0xc1, 0x32, 0x8b, 0xee, 0xfa, 0x07, 0x0a, 0xab, 0x8a, 0x15,
+	0xab, 0x0a, 0x25, 0x82, 0x48, 0xf0, 0x23, 0x40, 0xe7, 0x32, 0xc7, 0xf6, 0x8c, 0x2f, 0x69, 0x41,
+	0x6c, 0xac, 0x39, 0xef, 0xfb, 0xbc, 0x97, 0xe7, 0x39, 0x57, 0xc3, 0x72, 0x0d, 0x93, 0x10, 0x93,
+	0x82, 0x8f, 0xdb, 0x85, 0x76, 0xb1, 0x40, 0x4f, 0x9c, 0x46, 0x13, 0x53, 0xac, 0x2f, 0x08, 0xbb,
+	0xe3, 0xe3, 0xb6, 0xd3, 0x2e, 0x9a, 0x96, 0x84, 0x1d, 0xb9, 0x04, 0x15, 0xda, 0xc5, 0x23, 0x44,
+	0xdd, 0x62, 0xa1, 0x86, 0x83, 0x48, 0xc0, 0xcd, 0x6b, 0xfd, 0x69, 0x58, 0x94, 0x70, 0xe4, 0x7c,
+	0xec, 0x63, 0xfe, 0x59, 0x60, 0x5f, 0xd2, 0x9a, 0x17, 0xf0, 0x43, 0xe1, 0x90, 0xa5, 0xa4, 0xcb,
+	0xc7, 0xd8, 0x3f, 0x46, 0x05, 0x3e, 0x3a, 0x6a, 0x7d, 0x59, 0x70, 0xa3, 0x4e, 0xa2, 0x48, 0x48,
+	0x7c, 0x56, 0x24, 0x24, 0xbe, 0x74, 0x2c, 0xb9, 0x61, 0x10, 0xe1, 0x02, 0xff, 0x15, 0x26, 0xfb,
+	0xc7, 0x49, 0x58, 0xaa, 0x10, 0xff, 0x5e, 0xeb, 0x28, 0x0c, 0xe8, 0xdd, 0x26, 0x6e, 0x60, 0xe2,
+	0x1e, 0xeb, 0x37, 0x60, 0x36, 0x44, 0x84, 0xb8, 0x3e, 0x22, 0x86, 0xb6, 0x36, 0xb5, 0x39, 0xbf,
+	0x9b, 0x73, 0x44, 0x3d, 0x27, 0xae, 0xe7, 0xdc, 0x8e, 0x3a, 0x55, 0x85, 0xd2, 0x2b, 0x70, 0x25,
+	0x88, 0x02, 0x1a, 0xb8, 0xc7, 0x87, 0x1e, 0x6a, 0x60, 0x12, 0x50, 0x63, 0x92, 0x07, 0xe6, 0x1d,
+	0xd9, 0x36, 0x93, 0xc4, 0x91, 0x92, 0x38, 0xfb, 0x38, 0x88, 0xca, 0x73, 0x4f, 0x9e, 0xad, 0x4e,
+	0xfc, 0x70, 0x71, 0xba, 0xa5, 0x55, 0x17, 0x65, 0xf0, 0xbb, 0x22, 0x56, 0x7f, 0x1d, 0x66, 0x1b,
+	0xbc, 0x19, 0xd4, 0x34, 0xa6, 0xd6, 0xb4, 0xcd, 0xb9, 0xb2, 0xf1, 0xf3, 0xa3, 0x9d, 0x9c, 0x4c,
+	0x75, 0xdb, 0xf3, 0x9a, 0x88, 0x90, 0x7b, 0xb4, 0x19, 0x44, 0x7e, 0x55, 0x21, 0x75, 0x93, 0xb5,
+	0x4d, 0x5d, 0xcf, 0xa5, 0xae, 0x31, 0xcd, 0xa2, 0xaa, 0x6a, 0xac, 0xe7, 0x60, 0x86, 0x06, 0xf4,
+	0x18, 0x19, 0x33, 0xdc, 0x21, 0x06, 0xba, 0x01, 0x59, 0xd2, 0x0a, 0x43, 0xb7, 0xd9, 0x31, 0x32,
+	0xdc, 0x1e, 0x0f, 0x4b, 0xc5, 0x6f, 0x2f, 0x4e, 0xb7, 0x54, 0xea, 0xef, 0x2e, 0x4e, 0xb7, 0x56,
+	0x45, 0xf5, 0x1d, 0xe2, 0x7d, 0xc5, 0x64, 0x4d, 0xa9, 0x66, 0xdf, 0x82, 0x7c, 0xca, 0x58, 0x45,
+	0xa4, 0x81, 0x23, 0x82, 0xf4, 0x55, 0x98, 0x6f, 0x48, 0xdb, 0x61, 0xe0, 0x19, 0xda, 0x9a, 0xb6,
+	0x39, 0x5d, 0x85, 0xd8, 0x74, 0xc7, 0xb3, 0x1f, 0x6b, 0x90, 0xab, 0x10, 0xff, 0xe0, 0x04, 0xd5,
+	0x3e, 0x42, 0xbe, 0x5b, 0xeb, 0xec, 0xe3, 0x88, 0xa2, 0x88, 0xea, 0x1f, 0x43, 0xb6, 0x26, 0x3e,
+	0x79, 0xd4, 0x90, 0xb9, 0x28, 0x5b, 0x3f, 0x3d, 0xda, 0x31, 0xfb, 0x56, 0x63, 0x2c, 0x35, 0x8f,
+	0xad, 0xc6, 0x49, 0xf4, 0x15, 0x98, 0x73, 0x5b, 0xb4, 0x8e, 0x9b, 0x01, 0xed, 0x18, 0x93, 0x9c,
+	0x75, 0xd7, 0x50, 0xda, 0x63, 0xbc, 0xbb, 0x63, 0x46, 0xdc, 0x4e, 0x11, 0x4f, 0x35, 0x69, 0x5b,
+	0xb0, 0x32, 0xc8, 0x1e, 0xd3, 0xb7, 0x7f, 0xd3, 0x20, 0x5b, 0x21, 0xfe, 0x7d, 0x4c, 0x91, 0xbe,
+	0x37, 0x40, 0x8a, 0x72, 0xee, 0x8f, 0x67, 0xab, 0xbd, 0x66, 0xb1, 0x2e, 0x7a, 0x04, 0xd2, 0x1d,
+	0x98, 0x69, 0x63, 0x8a, 0x9a, 0xc6, 0xe4, 0x98, 0x05, 0x21, 0x60, 0x7a, 0x11, 0x32, 0xb8, 0x41,
+	0x03, 0x1c, 0xf1, 0x15, 0xb4, 0xd8, 0x5d, 0x89, 0x42, 0x1d, 0x87, 0xf5, 0xf2, 0x09, 0x07, 0x54,
+	0x25, 0x70, 0xd4, 0x02, 0x2a, 0xbd, 0xcc, 0x84, 0x11, 0xa9, 0x99, 0x28, 0x57, 0x53, 0xa2, 0xb0,
+	0x7c, 0xf6, 0x12, 0x5c, 0x91, 0x9f, 0x8a, 0xfa, 0x5f, 0x9a, 0xb2, 0x7d, 0x86, 0x02, 0xbf, 0x4e,
+	0x91, 0xf7, 0x5f, 0x49, 0xf0, 0x26, 0x64, 0x05, 0x33, 0x62, 0x4c, 0xf1, 0xdd, 0xb8, 0x9e, 0xd0,
+	0x20, 0x6e, 0xa8, 0x47, 0x8b, 0x38, 0x62, 0xa4, 0x18, 0xaf, 0xf6, 0x8b, 0xf1, 0xd2, 0x40, 0x31,
+	0xe2, 0xe4, 0x76, 0x1e, 0xae, 0x25, 0x4c, 0x4a, 0x9c, 0xdf, 0x35, 0x80, 0x0a, 0xf1, 0xe3, 0x7d,
+	0xff, 0x82, 0xba, 0xdc, 0x84, 0x39, 0x79, 0xea, 0xe0, 0xf1, 0xda, 0x74, 0xa1, 0xfa, 0x2d, 0xc8,
+	0xb8, 0x21, 0x6e, 0x45, 0x54, 0xca, 0x73, 0xb9, 0xc3, 0x4a, 0xc6, 0x94, 0xb6, 0xf9, 0x56, 0x51,
+	0xd9, 0x98, 0x10, 0x46, 0x4a, 0x08, 0xc9, 0xcc, 0xce, 0x81, 0xde, 0x1d, 0x29, 0xfa, 0x8f, 0xc5,
+	0xda, 0xf8, 0xb4, 0xe1, 0xb9, 0x14, 0xdd, 0x75, 0x9b, 0x6e, 0x48, 0x18, 0x99, 0xee, 0xfe, 0xd4,
+	0xc6, 0x91, 0x51, 0x50, 0xfd, 0x0d, 0xc8, 0x34, 0x78, 0x06, 0xae, 0xc0, 0xfc, 0xee, 0xd5, 0xc4,
+	0x5c, 0x8b, 0xf4, 0x7d, 0x44, 0x04, 0xbe, 0x74, 0x33, 0xbd, 0xe7, 0xaf, 0xf7, 0x10, 0x39, 0x89,
+	0xaf, 0xab, 0x44, 0xa7, 0x72, 0x5e, 0x7b, 0x4d, 0x8a, 0xd8, 0x83, 0x49, 0xc8, 0x2b, 0xdf, 0x7e,
+	0x13, 0x13, 0xb2, 0x5f, 0x77, 0x83, 0xe8, 0x1f, 0x52, 0xfc, 0x20, 0x41, 0xf1, 0x95, 0x04, 0xc5,
+	0x64, 0xa1, 0xfd, 0xba, 0x1b, 0xf9, 0x68, 0x00, 0x65, 0xdd, 0x86, 0x05, 0x0f, 0x11, 0x7a, 0x58,
+	0x63, 0x60, 0xb6, 0xd4, 0xd8, 0x19, 0xb1, 0x50, 0x9d, 0x67, 0x46, 0x9e, 0xe0, 0x8e, 0x57, 0x7a,
+	0x27, 0x2d, 0xcb, 0xce, 0x48, 0x59, 0x92, 0xe5, 0xed, 0xb7, 0x61, 0x7d, 0xa8, 0x53, 0xdd, 0x0c,
+	0x26, 0xcc, 0x12, 0xf4, 0x75, 0x0b, 0x45, 0x35, 0x24, 0xaf, 0x05, 0x35, 0xb6, 0xff, 0xd4, 0xc0,
+	0x1a, 0x9a, 0xa1, 0xec, 0xd2, 0x5a, 0xfd, 0x85, 0xb5, 0xfc, 0x10, 0xb2, 0x2d, 0x9e, 0x96, 0xc8,
+	0x9b, 0x7a, 0x9c, 0x98, 0xa2, 0x89, 0x5e, 0x31, 0xe3, 0x04, 0xa5, 0x83, 0xb4, 0x52, 0xbb, 0xcf,
+	0xa5, 0x14, 0xa7, 0x62, 0x3f, 0xd4, 0x60, 0x79, 0x70, 0xd5, 0xf4, 0x7c, 0x69, 0xa9, 0xf9, 0xfa,
+	0xf7, 0x56, 0x87, 0xfd, 0x1e, 0x6c, 0x8c, 0x6e, 0x55, 0x4d, 0xde, 0x0a, 0xcc, 0xc5, 0x93, 0x25,
+	0x9e, 0x4a, 0xd3, 0xd5, 0xae, 0x61, 0xf7, 0x61, 0x06, 0xa6, 0x2a, 0xc4, 0xd7, 0xbf, 0x80, 0xc5,
+	0xc4, 0x0b, 0x6b, 0x2d, 0xd1, 0x5b, 0xea, 0xe1, 0x60, 0x6e, 0x8e, 0x43, 0xa8, 0x1e, 0x10, 0x2c,
+	0xa5, 0x5f, 0x0d, 0xd7, 0xd3, 0xe1, 0x29, 0x90, 0xb9, 0x7d, 0x09, 0x90, 0x2a, 0xf3, 0x16, 0x4c,
+	0xf3, 0xeb, 0x7b, 0x39, 0x1d, 0xc4, 0xec, 0xa6, 0x35, 0xd8, 0xae, 0xe2, 0xef, 0xc3, 0xff, 0xfa,
+	0xee, 0xc0, 0x21, 0xf8, 0xd8, 0x6f, 0x6e, 0x8c, 0xf6, 0xab, 0xbc, 0xef, 0x43, 0x36, 0xbe, 0x3e,
+	0xf2, 0xe9, 0x10, 0xe9, 0x32, 0xd7, 0x87, 0xba, 0x7a, 0x1b, 0xec, 0x3b, 0x88, 0x07, 0x34, 0xd8,
+	0xeb, 0x37, 0x37, 0x46, 0xfb, 0x55, 0x5e, 0x0a, 0xcb, 0x43, 0xce, 0xc1, 0xcd, 0x61, 0x19, 0x92,
+	0x48, 0xf3, 0xc6, 0x65, 0x91, 0xaa, 0xea, 0x03, 0x0d, 0xfe, 0x3f, 0xea, 0xdc, 0xd8, 0xb9, 0x6c,
+	0x46, 0x0e, 0x37, 0xf7, 0x9e, 0x0b, 0x1e, 0x77, 0x61, 0xce, 0x7c, 0xc3, 0x36, 0x56, 0xf9, 0xe0,
+	0xc9, 0x99, 0xa5, 0x3d, 0x3d, 0xb3, 0xb4, 0x5f, 0xcf, 0x2c, 0xed, 0xfb, 0x73, 0x6b, 0xe2, 0xe9,
+	0xb9, 0x35, 0xf1, 0xcb, 0xb9, 0x35, 0xf1, 0xf9, 0xb6, 0x1f, 0xd0, 0x7a, 0xeb, 0xc8, 0xa9, 0xe1,
+	0x50, 0xfe, 0xc1, 0x29, 0xa4, 0x4e, 0x0e, 0xda, 0x69, 0x20, 0xc2, 0xfe, 0x4e, 0x65, 0xf8, 0x8b,
+	0xf7, 0xb5, 0xbf, 0x07, 0x00, 0xfb, 0xed, 0x8e, 0x36, 0x8e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -904,6 +1081,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCrossChainParams, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsResponse, error)
+	// UpdateCrossChainParamsBatch defines a method to send several cross-chain params changes,
+	// possibly to different destination chains, all at once.
+	UpdateCrossChainParamsBatch(ctx context.Context, in *MsgUpdateCrossChainParamsBatch, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsBatchResponse, error)
 }
 
 type msgClient struct {
@@ -977,6 +1157,15 @@ func (c *msgClient) UpdateCrossChainParams(ctx context.Context, in *MsgUpdateCro
 	return out, nil
 }
 
+func (c *msgClient) UpdateCrossChainParamsBatch(ctx context.Context, in *MsgUpdateCrossChainParamsBatch, opts ...grpc.CallOption) (*MsgUpdateCrossChainParamsBatchResponse, error) {
+	out := new(MsgUpdateCrossChainParamsBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UpdateCrossChainParamsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -997,6 +1186,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdateCrossChainParams defines a method to send IBC package to update cross-chain params
 	UpdateCrossChainParams(context.Context, *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error)
+	// UpdateCrossChainParamsBatch defines a method to send several cross-chain params changes,
+	// possibly to different destination chains, all at once.
+	UpdateCrossChainParamsBatch(context.Context, *MsgUpdateCrossChainParamsBatch) (*MsgUpdateCrossChainParamsBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1024,6 +1216,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdateCrossChainParams(ctx context.Context, req *MsgUpdateCrossChainParams) (*MsgUpdateCrossChainParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCrossChainParamsBatch(ctx context.Context, req *MsgUpdateCrossChainParamsBatch) (*MsgUpdateCrossChainParamsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCrossChainParamsBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1155,6 +1350,24 @@ func _Msg_UpdateCrossChainParams_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCrossChainParamsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCrossChainParamsBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCrossChainParamsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UpdateCrossChainParamsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCrossChainParamsBatch(ctx, req.(*MsgUpdateCrossChainParamsBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1187,6 +1400,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCrossChainParams",
 			Handler:    _Msg_UpdateCrossChainParams_Handler,
 		},
+		{
+			MethodName: "UpdateCrossChainParamsBatch",
+			Handler:    _Msg_UpdateCrossChainParamsBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1713,6 +1930,134 @@ func (m *MsgUpdateCrossChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCrossChainParamsBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCrossChainParamsBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCrossChainParamsBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DestChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		dAtA6 := make([]byte, len(m.Sequences)*10)
+		var j5 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1948,18 +2293,70 @@ func (m *MsgUpdateCrossChainParamsResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateCrossChainParamsBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *CrossChainParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovTx(uint64(m.DestChainId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgUpdateCrossChainParamsBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -3372,6 +3769,369 @@ func (m *MsgUpdateCrossChainParamsResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgUpdateCrossChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCrossChainParamsBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCrossChainParamsBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCrossChainParamsBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, CrossChainParamsUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCrossChainParamsBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCrossChainParamsBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCrossChainParamsBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -21,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
@@ -31,9 +32,10 @@ import (
 const ConsensusVersion = 2
 
 var (
-	_ module.EndBlockAppModule   = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.EndBlockAppModule           = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 type AppModule struct {
@@ -157,6 +159,35 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// DefaultMsgGasParams returns the default gas params of the group msgs. The msgs of a proposal are
+// charged when it is submitted, MsgExec is only charged its fixed gas.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgCreateGroup{}), 2.4e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupMembers{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupAdmin{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupMetadata{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgCreateGroupPolicy{}), 2.4e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgCreateGroupWithPolicy{}), 2.4e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupPolicyAdmin{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupPolicyDecisionPolicy{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgUpdateGroupPolicyMetadata{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithDynamicGas(
+			sdk.MsgTypeURL(&group.MsgSubmitProposal{}),
+			&gashubtypes.MsgGasParams_WrapperType{
+				WrapperType: &gashubtypes.MsgGasParams_WrapperGasParams{
+					FixedGas: 1.2e3,
+					MaxDepth: 2,
+				},
+			},
+		),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgWithdrawProposal{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgVote{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgExec{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&group.MsgLeaveGroup{}), 1.2e3),
+	}
+}
+
 // EndBlock implements the group module's EndBlock.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	EndBlocker(ctx, am.keeper)
@@ -20,12 +20,16 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	if params.InflationSchedule.ScheduleType == types.InflationScheduleBondedRatio {
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	} else {
+		minter.Inflation = params.InflationSchedule.InflationRate(uint64(ctx.BlockHeight()), totalStakingSupply)
+	}
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin := params.InflationSchedule.CapBlockProvision(minter.BlockProvision(params), totalStakingSupply)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -0,0 +1,6 @@
+package cli
+
+const (
+	FlagBlocks = "blocks"
+	FlagYears  = "years"
+)
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -24,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +118,56 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupplyProjection implements a command to return the supply of the
+// mint denom projected a number of blocks or years ahead.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection",
+		Short: "Query the supply of the mint denom projected a number of blocks or years ahead",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the supply of the mint denom projected with the current inflation schedule,
+either a number of blocks or a number of years ahead, assuming the bonded ratio stays the same.
+
+Example:
+$ %s query %s supply-projection --%s=10
+`,
+				version.AppName, types.ModuleName, FlagYears,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := cmd.Flags().GetUint64(FlagBlocks)
+			if err != nil {
+				return err
+			}
+			years, err := cmd.Flags().GetUint64(FlagYears)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SupplyProjection(cmd.Context(), &types.QuerySupplyProjectionRequest{
+				Blocks: blocks,
+				Years:  years,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlocks, 0, "Number of blocks to project the supply ahead")
+	cmd.Flags().Uint64(FlagYears, 0, "Number of years to project the supply ahead")
+	cmd.MarkFlagsMutuallyExclusive(FlagBlocks, FlagYears)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -46,7 +46,7 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","inflation_schedule":{"schedule_type":"INFLATION_SCHEDULE_TYPE_BONDED_RATIO","annual_rate":"0","halving_interval":"0","max_supply":"0","steps":[]}}`,
 		},
 		{
 			"text output",
@@ -57,6 +57,12 @@ goal_bonded: "0"
 inflation_max: "0"
 inflation_min: "0"
 inflation_rate_change: "0"
+inflation_schedule:
+  annual_rate: "0"
+  halving_interval: "0"
+  max_supply: "0"
+  schedule_type: INFLATION_SCHEDULE_TYPE_BONDED_RATIO
+  steps: []
 mint_denom: ""`,
 		},
 	}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,37 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection returns the supply of the mint denom projected a number of blocks or years ahead.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if (req.Blocks == 0) == (req.Years == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of blocks and years must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	maxBlocks := types.MaxProjectionYears * params.BlocksPerYear
+	blocks := req.Blocks
+	if req.Years != 0 {
+		if req.Years > types.MaxProjectionYears {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d years ahead", types.MaxProjectionYears)
+		}
+		blocks = req.Years * params.BlocksPerYear
+	}
+	if blocks > maxBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d blocks ahead", maxBlocks)
+	}
+
+	currentSupply := k.StakingTokenSupply(ctx)
+	projectedSupply, inflation := params.ProjectSupply(k.GetMinter(ctx), uint64(ctx.BlockHeight()), currentSupply, k.BondedRatio(ctx), blocks)
+
+	return &types.QuerySupplyProjectionResponse{
+		Height:          ctx.BlockHeight() + int64(blocks),
+		CurrentSupply:   currentSupply,
+		ProjectedSupply: projectedSupply,
+		Inflation:       inflation,
+	}, nil
+}
@@ -22,9 +22,10 @@ import (
 type MintTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	queryClient types.QueryClient
-	mintKeeper  keeper.Keeper
+	ctx           sdk.Context
+	queryClient   types.QueryClient
+	mintKeeper    keeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -38,6 +39,7 @@ func (suite *MintTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	suite.stakingKeeper = stakingKeeper
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
 
@@ -75,6 +77,38 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, suite.mintKeeper.GetMinter(suite.ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupplyProjection() {
+	params := types.DefaultParams()
+	params.InflationSchedule = types.NewFixedInflationSchedule(sdk.NewDecWithPrec(10, 2))
+	suite.Require().NoError(suite.mintKeeper.SetParams(suite.ctx, params))
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	supply := sdk.NewInt(1_000_000_000)
+	suite.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply).AnyTimes()
+	suite.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(sdk.NewDecWithPrec(5, 1)).AnyTimes()
+
+	_, err := suite.mintKeeper.SupplyProjection(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.mintKeeper.SupplyProjection(suite.ctx, &types.QuerySupplyProjectionRequest{})
+	suite.Require().Error(err)
+	_, err = suite.mintKeeper.SupplyProjection(suite.ctx, &types.QuerySupplyProjectionRequest{Blocks: 1, Years: 1})
+	suite.Require().Error(err)
+	_, err = suite.mintKeeper.SupplyProjection(suite.ctx, &types.QuerySupplyProjectionRequest{Years: types.MaxProjectionYears + 1})
+	suite.Require().Error(err)
+
+	res, err := suite.mintKeeper.SupplyProjection(suite.ctx, &types.QuerySupplyProjectionRequest{Years: 1})
+	suite.Require().NoError(err)
+	projected, inflation := params.ProjectSupply(suite.mintKeeper.GetMinter(suite.ctx), 10, supply, sdk.NewDecWithPrec(5, 1), params.BlocksPerYear)
+	suite.Require().Equal(int64(10+params.BlocksPerYear), res.Height)
+	suite.Require().Equal(supply, res.CurrentSupply)
+	suite.Require().Equal(projected, res.ProjectedSupply)
+	suite.Require().Equal(inflation, res.Inflation)
+
+	blocksRes, err := suite.mintKeeper.SupplyProjection(suite.ctx, &types.QuerySupplyProjectionRequest{Blocks: params.BlocksPerYear})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, blocksRes)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -94,6 +94,7 @@ func (s *IntegrationTestSuite) TestParams() {
 				InflationMin:        sdk.NewDecWithPrec(2, 2),
 				GoalBonded:          sdk.NewDecWithPrec(37, 2),
 				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				InflationSchedule:   types.DefaultInflationSchedule(),
 			},
 			expectErr: false,
 		},
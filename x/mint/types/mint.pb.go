@@ -26,6 +26,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationScheduleType defines the schedules of the inflation rate.
+type InflationScheduleType int32
+
+const (
+	// INFLATION_SCHEDULE_TYPE_BONDED_RATIO defines the inflation rate moving towards the goal bonded ratio, between
+	// the min and max inflation.
+	InflationScheduleBondedRatio InflationScheduleType = 0
+	// INFLATION_SCHEDULE_TYPE_FIXED defines a fixed annual inflation rate.
+	InflationScheduleFixed InflationScheduleType = 1
+	// INFLATION_SCHEDULE_TYPE_HALVING defines an annual inflation rate halved every halving interval of blocks.
+	InflationScheduleHalving InflationScheduleType = 2
+	// INFLATION_SCHEDULE_TYPE_CAPPED_SUPPLY defines an annual inflation rate decreasing with the supply left
+	// to mint, the supply never exceeds the max supply.
+	InflationScheduleCappedSupply InflationScheduleType = 3
+	// INFLATION_SCHEDULE_TYPE_PIECEWISE defines annual inflation rates by block height.
+	InflationSchedulePiecewise InflationScheduleType = 4
+)
+
+var InflationScheduleType_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_TYPE_BONDED_RATIO",
+	1: "INFLATION_SCHEDULE_TYPE_FIXED",
+	2: "INFLATION_SCHEDULE_TYPE_HALVING",
+	3: "INFLATION_SCHEDULE_TYPE_CAPPED_SUPPLY",
+	4: "INFLATION_SCHEDULE_TYPE_PIECEWISE",
+}
+
+var InflationScheduleType_value = map[string]int32{
+	"INFLATION_SCHEDULE_TYPE_BONDED_RATIO":  0,
+	"INFLATION_SCHEDULE_TYPE_FIXED":         1,
+	"INFLATION_SCHEDULE_TYPE_HALVING":       2,
+	"INFLATION_SCHEDULE_TYPE_CAPPED_SUPPLY": 3,
+	"INFLATION_SCHEDULE_TYPE_PIECEWISE":     4,
+}
+
+func (x InflationScheduleType) String() string {
+	return proto.EnumName(InflationScheduleType_name, int32(x))
+}
+
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -81,6 +123,8 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// schedule of the inflation rate
+	InflationSchedule InflationSchedule `protobuf:"bytes,7,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -129,42 +173,194 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationSchedule{}
+}
+
+// InflationSchedule defines the schedule of the inflation rate, the fields not used by the type of the schedule
+// must be left empty.
+type InflationSchedule struct {
+	// type of the schedule
+	ScheduleType InflationScheduleType `protobuf:"varint,1,opt,name=schedule_type,json=scheduleType,proto3,enum=cosmos.mint.v1beta1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// annual inflation rate of the fixed schedule, initial annual inflation rate of the halving schedule and annual
+	// inflation rate at zero supply of the capped supply schedule
+	AnnualRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_rate,json=annualRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_rate"`
+	// number of blocks between two halvings of the halving schedule
+	HalvingInterval uint64 `protobuf:"varint,3,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// max supply of the mint denom of the capped supply schedule
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// steps of the piecewise schedule, in increasing order of start height
+	Steps []InflationStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *InflationSchedule) Reset()         { *m = InflationSchedule{} }
+func (m *InflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedule) ProtoMessage()    {}
+func (*InflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedule.Merge(m, src)
+}
+func (m *InflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedule proto.InternalMessageInfo
+
+func (m *InflationSchedule) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return InflationScheduleBondedRatio
+}
+
+func (m *InflationSchedule) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *InflationSchedule) GetSteps() []InflationStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// InflationStep defines the annual inflation rate of a piecewise schedule from a block height.
+type InflationStep struct {
+	// height from which the rate applies
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// annual inflation rate
+	AnnualRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_rate,json=annualRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_rate"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationScheduleType", InflationScheduleType_name, InflationScheduleType_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationSchedule)(nil), "cosmos.mint.v1beta1.InflationSchedule")
+	proto.RegisterType((*InflationStep)(nil), "cosmos.mint.v1beta1.InflationStep")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x13, 0xad, 0x81, 0x8e, 0x2e, 0xba, 0xb3, 0x0a, 0x71, 0xc1, 0x74, 0xdd, 0xc3, 0xb2,
-	0x0a, 0x9b, 0x50, 0xbc, 0x89, 0xa7, 0xb6, 0xd7, 0x42, 0xc9, 0xcd, 0x82, 0x84, 0x37, 0xc9, 0x98,
-	0x0e, 0x4d, 0x66, 0xc2, 0xcc, 0xb4, 0xb4, 0x1f, 0x41, 0x4f, 0x1e, 0x3d, 0xfa, 0x11, 0x3c, 0xf8,
-	0x21, 0x7a, 0xb3, 0x78, 0x12, 0x0f, 0x45, 0xda, 0x83, 0x5f, 0x43, 0x32, 0x13, 0x52, 0xf1, 0xb0,
-	0xa7, 0x5c, 0xf2, 0xe7, 0x79, 0xde, 0xfc, 0x9e, 0x27, 0x21, 0x2f, 0xf2, 0x12, 0x2e, 0x0b, 0x2e,
-	0x83, 0x82, 0x32, 0x15, 0x2c, 0xfb, 0x31, 0x51, 0xd0, 0xd7, 0x37, 0x7e, 0x29, 0xb8, 0xe2, 0xf8,
-	0xcc, 0xf8, 0xbe, 0x96, 0x6a, 0xff, 0xfc, 0x71, 0xc6, 0x33, 0xae, 0xfd, 0xa0, 0xba, 0x32, 0xa3,
-	0xe7, 0x4f, 0xcd, 0x68, 0x64, 0x8c, 0xfa, 0x39, 0x63, 0x9d, 0x42, 0x41, 0x19, 0x0f, 0xf4, 0xd1,
-	0x48, 0x97, 0xdf, 0x6d, 0xe4, 0x8c, 0x29, 0x53, 0x44, 0xe0, 0x29, 0xea, 0x52, 0xf6, 0x3e, 0x07,
-	0x45, 0x39, 0x73, 0xed, 0x0b, 0xfb, 0xba, 0x3b, 0x78, 0xb3, 0xd9, 0xf5, 0xac, 0x5f, 0xbb, 0xde,
-	0x55, 0x46, 0xd5, 0x6c, 0x11, 0xfb, 0x09, 0x2f, 0x6a, 0x62, 0x7d, 0xba, 0x91, 0xe9, 0x3c, 0x50,
-	0xeb, 0x92, 0x48, 0x7f, 0x44, 0x92, 0x1f, 0xdf, 0x6e, 0x50, 0x1d, 0x38, 0x22, 0x49, 0x78, 0xc4,
-	0x61, 0x8a, 0x4e, 0x81, 0xb1, 0x05, 0xe4, 0x55, 0xad, 0x25, 0x95, 0x94, 0x33, 0xe9, 0xde, 0x69,
-	0x21, 0xe3, 0x91, 0xc1, 0x4e, 0x1a, 0xea, 0xe5, 0x87, 0x0e, 0x72, 0x26, 0x20, 0xa0, 0x90, 0xf8,
-	0x19, 0x42, 0xd5, 0x07, 0x8b, 0x52, 0xc2, 0x78, 0x61, 0x5e, 0x29, 0xec, 0x56, 0xca, 0xa8, 0x12,
-	0x70, 0x89, 0x9e, 0x34, 0x0d, 0x23, 0x01, 0x8a, 0x44, 0xc9, 0x0c, 0x58, 0x46, 0x5a, 0x29, 0x76,
-	0xd6, 0xa0, 0x43, 0x50, 0x64, 0xa8, 0xc1, 0x18, 0xd0, 0xc9, 0x31, 0xb1, 0x80, 0x95, 0x7b, 0xb7,
-	0x85, 0xa4, 0x07, 0x0d, 0x72, 0x0c, 0xab, 0xff, 0x22, 0x28, 0x73, 0x3b, 0xed, 0x46, 0x50, 0x86,
-	0xdf, 0xa1, 0xfb, 0x19, 0x87, 0x3c, 0x8a, 0x39, 0x4b, 0x49, 0xea, 0xde, 0x6b, 0x21, 0x00, 0x55,
-	0xc0, 0x81, 0xe6, 0xe1, 0x2b, 0xf4, 0x30, 0xce, 0x79, 0x32, 0x97, 0x51, 0x49, 0x44, 0xb4, 0x26,
-	0x20, 0x5c, 0xe7, 0xc2, 0xbe, 0xee, 0x84, 0x27, 0x46, 0x9e, 0x10, 0xf1, 0x96, 0x80, 0x78, 0xfd,
-	0xfc, 0xf3, 0x97, 0x9e, 0xf5, 0xf1, 0xcf, 0xd7, 0x97, 0xee, 0x3f, 0x01, 0x2b, 0xb3, 0x42, 0xe6,
-	0x07, 0x18, 0x0c, 0x37, 0x7b, 0xcf, 0xde, 0xee, 0x3d, 0xfb, 0xf7, 0xde, 0xb3, 0x3f, 0x1d, 0x3c,
-	0x6b, 0x7b, 0xf0, 0xac, 0x9f, 0x07, 0xcf, 0x9a, 0xbe, 0xb8, 0xb5, 0x66, 0x4d, 0xd1, 0x6d, 0x63,
-	0x47, 0x6f, 0xca, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x18, 0xb9, 0xae, 0xa4, 0x03,
-	0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x8d, 0x5b, 0x94, 0x69, 0xcb, 0xa6, 0xb3, 0x2c, 0x32, 0xd6, 0xd6, 0x75, 0x23,
+	0xa8, 0xba, 0x91, 0x36, 0xd1, 0x96, 0x1b, 0x02, 0xa4, 0x24, 0x76, 0x89, 0x51, 0x36, 0xb5, 0x9c,
+	0x2e, 0xd0, 0x45, 0x68, 0x98, 0x38, 0x43, 0x32, 0x5a, 0x7b, 0x6c, 0xd9, 0x93, 0x90, 0xdc, 0x38,
+	0xa2, 0x70, 0x81, 0x1b, 0x97, 0x48, 0x48, 0x5c, 0x38, 0xee, 0x81, 0x0f, 0xb1, 0x37, 0x56, 0x9c,
+	0x10, 0x87, 0x15, 0x6a, 0x0f, 0x7b, 0xe0, 0x4b, 0x20, 0x8f, 0xbd, 0x69, 0xd9, 0x34, 0x80, 0x50,
+	0xf6, 0x92, 0xc4, 0xff, 0x37, 0xf3, 0x7b, 0x6f, 0xe6, 0xfd, 0x5f, 0x0c, 0x34, 0x37, 0x88, 0xfd,
+	0x20, 0xae, 0xfa, 0x94, 0xf1, 0xea, 0xe8, 0x6e, 0x97, 0x70, 0x7c, 0x57, 0x3c, 0x54, 0xc2, 0x28,
+	0xe0, 0x01, 0xbc, 0x91, 0xc6, 0x2b, 0x42, 0xca, 0xe2, 0xea, 0x6b, 0xfd, 0xa0, 0x1f, 0x88, 0x78,
+	0x35, 0xf9, 0x95, 0x2e, 0x55, 0xdf, 0x48, 0x97, 0xa2, 0x34, 0x90, 0xed, 0x4b, 0x43, 0xdb, 0xd8,
+	0xa7, 0x2c, 0xa8, 0x8a, 0xcf, 0x54, 0x2a, 0xfd, 0x22, 0x81, 0xf5, 0x7b, 0x94, 0x71, 0x12, 0xc1,
+	0x07, 0xa0, 0x40, 0xd9, 0x17, 0x1e, 0xe6, 0x34, 0x60, 0x8a, 0xa4, 0x4b, 0x07, 0x85, 0xfa, 0xbb,
+	0x8f, 0x9f, 0xee, 0xe6, 0x7e, 0x7f, 0xba, 0xbb, 0xdf, 0xa7, 0x7c, 0x30, 0xec, 0x56, 0xdc, 0xc0,
+	0xcf, 0x88, 0xd9, 0xd7, 0x9d, 0xb8, 0xf7, 0xb0, 0xca, 0x27, 0x21, 0x89, 0x2b, 0x06, 0x71, 0x7f,
+	0xfd, 0xf9, 0x0e, 0xc8, 0x12, 0x1a, 0xc4, 0x75, 0x2e, 0x70, 0x90, 0x82, 0x6d, 0xcc, 0xd8, 0x10,
+	0x7b, 0x49, 0x59, 0x23, 0x1a, 0xd3, 0x80, 0xc5, 0xca, 0xb5, 0x15, 0xe4, 0x28, 0xa6, 0x58, 0x7b,
+	0x4e, 0x2d, 0xfd, 0x29, 0x83, 0x75, 0x1b, 0x47, 0xd8, 0x8f, 0xe1, 0x0e, 0x00, 0xc9, 0x85, 0xa1,
+	0x1e, 0x61, 0x81, 0x9f, 0x1e, 0xc9, 0x29, 0x24, 0x8a, 0x91, 0x08, 0x30, 0x04, 0x37, 0xe7, 0x15,
+	0xa2, 0x08, 0x73, 0x82, 0xdc, 0x01, 0x66, 0x7d, 0xb2, 0x92, 0xc2, 0x6e, 0xcc, 0xd1, 0x0e, 0xe6,
+	0xa4, 0x21, 0xc0, 0x10, 0x83, 0xad, 0x8b, 0x8c, 0x3e, 0x1e, 0x2b, 0xf9, 0x15, 0x64, 0xda, 0x9c,
+	0x23, 0xef, 0xe1, 0xf1, 0x0b, 0x29, 0x28, 0x53, 0xe4, 0xd5, 0xa6, 0xa0, 0x0c, 0x7e, 0x06, 0x36,
+	0xfa, 0x01, 0xf6, 0x50, 0x37, 0x60, 0x3d, 0xd2, 0x53, 0xd6, 0x56, 0x90, 0x00, 0x24, 0xc0, 0xba,
+	0xe0, 0xc1, 0x7d, 0x70, 0xbd, 0xeb, 0x05, 0xee, 0xc3, 0x18, 0x85, 0x24, 0x42, 0x13, 0x82, 0x23,
+	0x65, 0x5d, 0x97, 0x0e, 0x64, 0x67, 0x2b, 0x95, 0x6d, 0x12, 0x9d, 0x12, 0x1c, 0xc1, 0xcf, 0x01,
+	0xbc, 0x38, 0x69, 0xec, 0x0e, 0x48, 0x6f, 0xe8, 0x11, 0xe5, 0x15, 0x5d, 0x3a, 0xd8, 0x38, 0xdc,
+	0xaf, 0x5c, 0x31, 0x30, 0x15, 0xeb, 0xf9, 0xf2, 0x4e, 0xb6, 0xba, 0x5e, 0x48, 0xaa, 0xfe, 0xe9,
+	0xd9, 0xa3, 0xb2, 0xe4, 0x6c, 0xd3, 0x17, 0xa3, 0xef, 0xec, 0x7d, 0xff, 0xc3, 0x6e, 0x6e, 0xfa,
+	0xec, 0x51, 0x59, 0xb9, 0x74, 0x84, 0x71, 0x3a, 0xa4, 0xa9, 0xc5, 0x4a, 0xdf, 0xe4, 0xc1, 0xf6,
+	0x02, 0x16, 0x1e, 0x83, 0xad, 0xe7, 0x05, 0xa1, 0xe4, 0xc8, 0xc2, 0x7b, 0xaf, 0x1e, 0x96, 0xff,
+	0x5b, 0x55, 0x27, 0x93, 0x90, 0x38, 0x9b, 0xf1, 0xa5, 0xa7, 0xe4, 0xca, 0xb3, 0xf9, 0x49, 0x7c,
+	0xba, 0x12, 0x83, 0x82, 0x14, 0x98, 0xb8, 0x13, 0xde, 0x06, 0xc5, 0x01, 0xf6, 0x46, 0x94, 0xf5,
+	0x91, 0xf8, 0x2f, 0x18, 0x61, 0x4f, 0x58, 0x53, 0x76, 0xae, 0x67, 0xba, 0x95, 0xc9, 0xf0, 0x53,
+	0x00, 0x7c, 0x3c, 0x46, 0xf1, 0x30, 0x0c, 0xbd, 0xc9, 0xff, 0x30, 0x97, 0xc5, 0xf8, 0xa5, 0x42,
+	0x2c, 0xc6, 0x9d, 0x82, 0x8f, 0xc7, 0x1d, 0x81, 0x83, 0xef, 0x83, 0xb5, 0x98, 0x93, 0x30, 0x56,
+	0xd6, 0xf4, 0xfc, 0xc1, 0xc6, 0x61, 0xe9, 0x5f, 0xee, 0x8b, 0x93, 0xb0, 0x2e, 0x27, 0xb9, 0x9d,
+	0x74, 0x5b, 0xe9, 0x3b, 0x09, 0x6c, 0xfd, 0x2d, 0x0c, 0xf7, 0xc0, 0x66, 0xcc, 0x71, 0xc4, 0xd1,
+	0x80, 0xd0, 0xfe, 0x80, 0x8b, 0x46, 0xc8, 0xce, 0x86, 0xd0, 0x9a, 0x42, 0x7a, 0xc9, 0x77, 0x5b,
+	0xfe, 0x2a, 0x0f, 0x6e, 0x5e, 0xd9, 0x62, 0xf8, 0x21, 0x78, 0xd3, 0x6a, 0x1f, 0xb5, 0x6a, 0x27,
+	0xd6, 0x71, 0x1b, 0x75, 0x1a, 0x4d, 0xd3, 0xb8, 0xdf, 0x32, 0xd1, 0xc9, 0xa9, 0x6d, 0xa2, 0xfa,
+	0x71, 0xdb, 0x30, 0x0d, 0xe4, 0x24, 0xb1, 0x62, 0x4e, 0xd5, 0xa7, 0x33, 0xfd, 0xd6, 0xa2, 0x7b,
+	0xc5, 0xbc, 0x38, 0x89, 0x06, 0xdf, 0x03, 0x3b, 0xcb, 0x58, 0x47, 0xd6, 0x27, 0xa6, 0x51, 0x94,
+	0x54, 0x75, 0x3a, 0xd3, 0x5f, 0x5f, 0x80, 0x1c, 0xd1, 0x31, 0xe9, 0xc1, 0x1a, 0xd8, 0x5d, 0xb6,
+	0xbd, 0x59, 0x6b, 0x7d, 0x64, 0xb5, 0x3f, 0x28, 0x5e, 0x53, 0x6f, 0x4d, 0x67, 0xba, 0xb2, 0x00,
+	0x68, 0xa6, 0x06, 0x81, 0x2d, 0xf0, 0xd6, 0x32, 0x44, 0xa3, 0x66, 0xdb, 0xa6, 0x81, 0x3a, 0xf7,
+	0x6d, 0xbb, 0x75, 0x5a, 0xcc, 0xab, 0x7b, 0xd3, 0x99, 0xbe, 0xb3, 0x00, 0x6a, 0xe0, 0x30, 0x24,
+	0xbd, 0xcc, 0x09, 0x26, 0xd8, 0x5b, 0x46, 0xb3, 0x2d, 0xb3, 0x61, 0x7e, 0x6c, 0x75, 0xcc, 0xa2,
+	0xac, 0x6a, 0xd3, 0x99, 0xae, 0x2e, 0x90, 0x6c, 0x4a, 0x5c, 0xf2, 0x25, 0x8d, 0x89, 0x2a, 0x7f,
+	0xfd, 0xa3, 0x96, 0xab, 0x37, 0x1e, 0x9f, 0x69, 0xd2, 0x93, 0x33, 0x4d, 0xfa, 0xe3, 0x4c, 0x93,
+	0xbe, 0x3d, 0xd7, 0x72, 0x4f, 0xce, 0xb5, 0xdc, 0x6f, 0xe7, 0x5a, 0xee, 0xc1, 0xed, 0x7f, 0x6c,
+	0x6f, 0x36, 0xea, 0xa2, 0xcb, 0xdd, 0x75, 0xf1, 0xc2, 0x7c, 0xfb, 0xaf, 0x01, 0x00, 0x7a, 0x67,
+	0x15, 0x79, 0xab, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -230,6 +426,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.InflationSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -285,6 +491,111 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.AnnualRate.Size()
+		i -= size
+		if _, err := m.AnnualRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ScheduleType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualRate.Size()
+		i -= size
+		if _, err := m.AnnualRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -330,6 +641,47 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.InflationSchedule.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleType != 0 {
+		n += 1 + sovMint(uint64(m.ScheduleType))
+	}
+	l = m.AnnualRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.AnnualRate.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -673,6 +1025,332 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, InflationStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
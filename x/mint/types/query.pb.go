@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -269,6 +270,114 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QuerySupplyProjectionRequest is the request type for the Query/SupplyProjection RPC method, exactly one of blocks
+// and years must be set.
+type QuerySupplyProjectionRequest struct {
+	// blocks is the number of blocks to project the supply ahead
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// years is the number of years to project the supply ahead, with the blocks per year of the params
+	Years uint64 `protobuf:"varint,2,opt,name=years,proto3" json:"years,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QuerySupplyProjectionRequest) GetYears() uint64 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// height is the height the supply is projected at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// current_supply is the current supply of the mint denom
+	CurrentSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current_supply,json=currentSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_supply"`
+	// projected_supply is the projected supply of the mint denom at the height
+	ProjectedSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=projected_supply,json=projectedSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"projected_supply"`
+	// inflation is the projected annual inflation rate at the height
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -276,42 +385,53 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x85, 0xc8, 0x0b, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x92, 0x08,
-	0x97, 0x50, 0x20, 0xc8, 0x29, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x41, 0xa9, 0x85, 0xa5, 0xa9,
-	0xc5, 0x25, 0x4a, 0xa1, 0x5c, 0xc2, 0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x76,
-	0x5c, 0x6c, 0x05, 0x60, 0x11, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x2e,
-	0xd7, 0x83, 0x68, 0x72, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41,
-	0x50, 0x5d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x63, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x87, 0xd9,
-	0x97, 0xcd, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0x32, 0x90, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x95,
-	0xc7, 0xc9, 0x18, 0x64, 0xf0, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x8f, 0x43, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0x64, 0x88, 0x13, 0x10, 0xa6, 0x28, 0xc9, 0x71, 0xc9, 0x80, 0x2d,
-	0x73, 0xcc, 0xcb, 0x2b, 0x4d, 0xcc, 0x09, 0x28, 0xca, 0x2f, 0xcb, 0x2c, 0x06, 0x05, 0x22, 0xcc,
-	0x31, 0x8d, 0x8c, 0x5c, 0xb2, 0x38, 0x14, 0x40, 0x1d, 0x95, 0xc0, 0x25, 0x98, 0x08, 0x96, 0x8b,
-	0x2f, 0x80, 0x4b, 0x52, 0xe2, 0x38, 0x81, 0x44, 0x34, 0x9b, 0x8c, 0x8e, 0x32, 0x73, 0xb1, 0x82,
-	0xdd, 0x20, 0xd4, 0xc0, 0xc8, 0xc5, 0x06, 0x09, 0x51, 0x21, 0x75, 0xac, 0xc1, 0x8d, 0x19, 0x7d,
-	0x52, 0x1a, 0x84, 0x15, 0x42, 0x7c, 0xa2, 0xa4, 0xdc, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x59, 0x21,
-	0x69, 0x7d, 0x6c, 0x49, 0x07, 0x12, 0x6d, 0x42, 0x3d, 0x8c, 0x5c, 0x9c, 0xf0, 0x98, 0x11, 0xd2,
-	0xc2, 0x6d, 0x38, 0x7a, 0xbc, 0x4a, 0x69, 0x13, 0xa5, 0x16, 0xea, 0x16, 0x35, 0xb0, 0x5b, 0x14,
-	0x84, 0xe4, 0xb0, 0xba, 0x05, 0x1e, 0x7f, 0x42, 0x2b, 0x19, 0xb9, 0x04, 0xd0, 0xa3, 0x46, 0xc8,
-	0x10, 0xb7, 0x4d, 0x38, 0xe2, 0x59, 0xca, 0x88, 0x14, 0x2d, 0x50, 0x37, 0xea, 0x81, 0xdd, 0xa8,
-	0x21, 0xa4, 0x86, 0xd5, 0x8d, 0x18, 0x89, 0xc2, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xf1, 0x26, 0x90, 0x0a, 0x88, 0xc1, 0xe0, 0x74, 0x92, 0xc4, 0x06,
-	0xce, 0xaa, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xdf, 0xd1, 0xc0, 0x49, 0x04, 0x00,
-	0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x12, 0x41,
+	0x1c, 0x65, 0x29, 0x25, 0x61, 0xfc, 0x47, 0xa7, 0x88, 0x75, 0x5b, 0x96, 0x66, 0x4d, 0x10, 0x6b,
+	0xba, 0x1b, 0xe8, 0xdd, 0xa4, 0xd8, 0x4b, 0x13, 0x0f, 0x14, 0xe3, 0xc5, 0x0b, 0x2e, 0xdb, 0x71,
+	0x59, 0x59, 0x66, 0xb6, 0x3b, 0xb3, 0x8d, 0xdc, 0xd4, 0xb3, 0x07, 0x13, 0xbf, 0x84, 0xde, 0x3c,
+	0xf8, 0x21, 0x7a, 0x6c, 0xf4, 0x62, 0x3c, 0x34, 0x06, 0x4c, 0xbc, 0xea, 0x37, 0x30, 0x3b, 0x33,
+	0x20, 0xd2, 0xdd, 0x5a, 0x62, 0x2f, 0xc0, 0xef, 0xdf, 0x7b, 0xef, 0x37, 0xcc, 0xdb, 0x05, 0x65,
+	0x9b, 0xd0, 0x3e, 0xa1, 0x66, 0xdf, 0xc5, 0xcc, 0x3c, 0xac, 0x75, 0x10, 0xb3, 0x6a, 0xe6, 0x41,
+	0x88, 0x82, 0x81, 0xe1, 0x07, 0x84, 0x11, 0xb8, 0x2c, 0x1a, 0x8c, 0xa8, 0xc1, 0x90, 0x0d, 0x6a,
+	0xc1, 0x21, 0x0e, 0xe1, 0x75, 0x33, 0xfa, 0x25, 0x5a, 0xd5, 0x35, 0x87, 0x10, 0xc7, 0x43, 0xa6,
+	0xe5, 0xbb, 0xa6, 0x85, 0x31, 0x61, 0x16, 0x73, 0x09, 0xa6, 0xb2, 0xaa, 0xc5, 0x31, 0x71, 0x54,
+	0x51, 0x5f, 0xb2, 0xfa, 0x2e, 0x26, 0x26, 0xff, 0x94, 0xa9, 0x9b, 0x62, 0xa4, 0x2d, 0x98, 0xa4,
+	0x10, 0x1e, 0xe8, 0x05, 0x00, 0xf7, 0x22, 0x95, 0x4d, 0x2b, 0xb0, 0xfa, 0xb4, 0x85, 0x0e, 0x42,
+	0x44, 0x99, 0xfe, 0x08, 0x2c, 0xff, 0x95, 0xa5, 0x3e, 0xc1, 0x14, 0xc1, 0x7b, 0x20, 0xeb, 0xf3,
+	0xcc, 0x8a, 0xb2, 0xae, 0x54, 0x2f, 0xd5, 0x57, 0x8d, 0x98, 0xa5, 0x0c, 0x31, 0xd4, 0xc8, 0x1d,
+	0x9d, 0x94, 0x53, 0xef, 0x7e, 0x7c, 0xd8, 0x50, 0x5a, 0x72, 0x4a, 0xbf, 0x01, 0xae, 0x73, 0xd8,
+	0x5d, 0xfc, 0xd4, 0xe3, 0x3b, 0x8d, 0xf9, 0x7a, 0xa0, 0x38, 0x5b, 0x90, 0x94, 0x7b, 0x20, 0xe7,
+	0x8e, 0x93, 0x9c, 0xf5, 0x72, 0x63, 0x2b, 0x02, 0xfe, 0x7a, 0x52, 0xae, 0x38, 0x2e, 0xeb, 0x86,
+	0x1d, 0xc3, 0x26, 0x7d, 0xb9, 0x93, 0xfc, 0xda, 0xa4, 0xfb, 0x3d, 0x93, 0x0d, 0x7c, 0x44, 0x8d,
+	0x1d, 0x64, 0x0b, 0x09, 0x7f, 0x50, 0x74, 0x0d, 0xac, 0x71, 0xb2, 0x6d, 0x8c, 0x43, 0xcb, 0x6b,
+	0x06, 0xe4, 0xd0, 0xa5, 0xd1, 0xf9, 0x8e, 0xc5, 0xbc, 0x54, 0x40, 0x29, 0xa1, 0x41, 0x8a, 0x7a,
+	0x02, 0x96, 0x2c, 0x5e, 0x6b, 0xfb, 0x93, 0xe2, 0xff, 0x88, 0xcb, 0x5b, 0x33, 0x4c, 0xfa, 0x03,
+	0xa9, 0xf1, 0x61, 0xe8, 0xfb, 0xde, 0xa0, 0x19, 0x90, 0x67, 0xc8, 0x9e, 0x3a, 0x30, 0x58, 0x04,
+	0xd9, 0x8e, 0x47, 0xec, 0x9e, 0xa0, 0xcd, 0xb4, 0x64, 0x04, 0x0b, 0x60, 0x71, 0x80, 0xac, 0x80,
+	0xae, 0xa4, 0x79, 0x5a, 0x04, 0xfa, 0xcf, 0x34, 0x28, 0x25, 0xc0, 0xc9, 0x8d, 0x8a, 0x20, 0xdb,
+	0x45, 0xae, 0xd3, 0x65, 0x1c, 0x6f, 0xa1, 0x25, 0x23, 0xd8, 0x05, 0x57, 0xed, 0x30, 0x08, 0x10,
+	0x66, 0x6d, 0xca, 0x67, 0x39, 0x70, 0xae, 0xb1, 0x3d, 0xc7, 0x9a, 0xbb, 0x98, 0x7d, 0xfa, 0xb8,
+	0x09, 0x44, 0x3e, 0x8a, 0xc4, 0xd2, 0x57, 0x24, 0xb0, 0xd0, 0x04, 0x3d, 0x90, 0xf7, 0x85, 0x2e,
+	0xb4, 0x3f, 0xe6, 0x5a, 0xb8, 0x28, 0xae, 0x6b, 0x13, 0x68, 0xc9, 0xd6, 0x9e, 0xbe, 0x56, 0x99,
+	0xb9, 0x69, 0x76, 0x90, 0x3d, 0x45, 0x13, 0x73, 0xc9, 0xea, 0xbf, 0x32, 0x60, 0x91, 0x1f, 0x39,
+	0x7c, 0xa1, 0x80, 0xac, 0xb0, 0x04, 0xbc, 0x1d, 0xeb, 0x97, 0xd3, 0xfe, 0x53, 0xab, 0xff, 0x6e,
+	0x14, 0x7f, 0x9c, 0x7e, 0xeb, 0xd5, 0xe7, 0xef, 0x6f, 0xd3, 0x25, 0xb8, 0x6a, 0xc6, 0x3d, 0x16,
+	0x84, 0xef, 0xe0, 0x6b, 0x05, 0xe4, 0x26, 0xd6, 0x82, 0x1b, 0xc9, 0xe0, 0xb3, 0xc6, 0x54, 0xef,
+	0x9e, 0xab, 0x57, 0x6a, 0xa9, 0x70, 0x2d, 0xeb, 0x50, 0x8b, 0xd5, 0x32, 0x39, 0x1b, 0xf8, 0x5e,
+	0x01, 0xf9, 0x59, 0x6f, 0xc1, 0x5a, 0x32, 0x53, 0x82, 0x51, 0xd5, 0xfa, 0x3c, 0x23, 0x52, 0xa3,
+	0xc1, 0x35, 0x56, 0x61, 0x25, 0x56, 0xe3, 0x29, 0x57, 0x73, 0xad, 0xb3, 0xae, 0x39, 0x4b, 0x6b,
+	0x82, 0x61, 0xd5, 0xfa, 0x3c, 0x23, 0xe7, 0xd2, 0x2a, 0x3c, 0xd2, 0xf6, 0x27, 0x73, 0x8d, 0xfb,
+	0x47, 0x43, 0x4d, 0x39, 0x1e, 0x6a, 0xca, 0xb7, 0xa1, 0xa6, 0xbc, 0x19, 0x69, 0xa9, 0xe3, 0x91,
+	0x96, 0xfa, 0x32, 0xd2, 0x52, 0x8f, 0xef, 0x9c, 0x79, 0xa7, 0x9f, 0x0b, 0x60, 0x7e, 0xb5, 0x3b,
+	0x59, 0xfe, 0x5e, 0xd8, 0xfa, 0x3d, 0x00, 0x2e, 0x15, 0x18, 0xd3, 0xd1, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -332,6 +452,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the supply of the mint denom a number of blocks or years ahead with the current
+	// inflation schedule.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -369,6 +492,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -377,6 +509,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the supply of the mint denom a number of blocks or years ahead with the current
+	// inflation schedule.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -392,6 +527,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -451,6 +589,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -467,6 +623,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -640,6 +800,97 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProjectedSupply.Size()
+		i -= size
+		if _, err := m.ProjectedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentSupply.Size()
+		i -= size
+		if _, err := m.CurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -711,6 +962,39 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1400,265 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
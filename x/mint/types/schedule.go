@@ -0,0 +1,284 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxProjectionYears is the maximum number of years the supply can be projected ahead
+	MaxProjectionYears = 100
+
+	// projectionStepsPerYear is the number of steps per year of the projection of the schedules
+	// depending on the supply or the bonded ratio, the inflation rate is constant within a step
+	projectionStepsPerYear = 365
+
+	// maxHalvings is the number of halvings after which the inflation rate of the halving schedule
+	// is below the precision of sdk.Dec
+	maxHalvings = 60
+)
+
+// DefaultInflationSchedule returns the default inflation schedule, moving the inflation rate
+// towards the goal bonded ratio.
+func DefaultInflationSchedule() InflationSchedule {
+	return InflationSchedule{
+		ScheduleType: InflationScheduleBondedRatio,
+		AnnualRate:   math.LegacyZeroDec(),
+		MaxSupply:    math.ZeroInt(),
+	}
+}
+
+// NewFixedInflationSchedule returns a schedule with a fixed annual inflation rate.
+func NewFixedInflationSchedule(annualRate sdk.Dec) InflationSchedule {
+	schedule := DefaultInflationSchedule()
+	schedule.ScheduleType = InflationScheduleFixed
+	schedule.AnnualRate = annualRate
+	return schedule
+}
+
+// NewHalvingInflationSchedule returns a schedule with an annual inflation rate halved every
+// halving interval of blocks.
+func NewHalvingInflationSchedule(initialRate sdk.Dec, halvingInterval uint64) InflationSchedule {
+	schedule := DefaultInflationSchedule()
+	schedule.ScheduleType = InflationScheduleHalving
+	schedule.AnnualRate = initialRate
+	schedule.HalvingInterval = halvingInterval
+	return schedule
+}
+
+// NewCappedSupplyInflationSchedule returns a schedule with an annual inflation rate decreasing
+// linearly with the supply left to mint, from the given rate at zero supply to zero at the max supply.
+func NewCappedSupplyInflationSchedule(annualRate sdk.Dec, maxSupply math.Int) InflationSchedule {
+	schedule := DefaultInflationSchedule()
+	schedule.ScheduleType = InflationScheduleCappedSupply
+	schedule.AnnualRate = annualRate
+	schedule.MaxSupply = maxSupply
+	return schedule
+}
+
+// NewPiecewiseInflationSchedule returns a schedule with the annual inflation rates of the steps
+// from their start heights.
+func NewPiecewiseInflationSchedule(steps ...InflationStep) InflationSchedule {
+	schedule := DefaultInflationSchedule()
+	schedule.ScheduleType = InflationSchedulePiecewise
+	schedule.Steps = steps
+	return schedule
+}
+
+// NewInflationStep returns a step of a piecewise schedule.
+func NewInflationStep(startHeight uint64, annualRate sdk.Dec) InflationStep {
+	return InflationStep{
+		StartHeight: startHeight,
+		AnnualRate:  annualRate,
+	}
+}
+
+// Validate does the sanity check on the inflation schedule.
+func (s InflationSchedule) Validate() error {
+	usesRate, usesInterval, usesMaxSupply, usesSteps := false, false, false, false
+	switch s.ScheduleType {
+	case InflationScheduleBondedRatio:
+	case InflationScheduleFixed:
+		usesRate = true
+	case InflationScheduleHalving:
+		usesRate, usesInterval = true, true
+	case InflationScheduleCappedSupply:
+		usesRate, usesMaxSupply = true, true
+	case InflationSchedulePiecewise:
+		usesSteps = true
+	default:
+		return fmt.Errorf("unknown inflation schedule type: %d", s.ScheduleType)
+	}
+
+	if usesRate {
+		if err := validateAnnualRate(s.AnnualRate); err != nil {
+			return err
+		}
+	} else if !s.AnnualRate.IsNil() && !s.AnnualRate.IsZero() {
+		return fmt.Errorf("annual rate is not used by the %s schedule", s.ScheduleType)
+	}
+
+	if usesInterval {
+		if s.HalvingInterval == 0 {
+			return fmt.Errorf("halving interval must be positive: %d", s.HalvingInterval)
+		}
+	} else if s.HalvingInterval != 0 {
+		return fmt.Errorf("halving interval is not used by the %s schedule", s.ScheduleType)
+	}
+
+	if usesMaxSupply {
+		if s.MaxSupply.IsNil() || !s.MaxSupply.IsPositive() {
+			return fmt.Errorf("max supply must be positive: %s", s.MaxSupply)
+		}
+	} else if !s.MaxSupply.IsNil() && !s.MaxSupply.IsZero() {
+		return fmt.Errorf("max supply is not used by the %s schedule", s.ScheduleType)
+	}
+
+	if usesSteps {
+		if len(s.Steps) == 0 {
+			return fmt.Errorf("piecewise schedule must have at least one step")
+		}
+		for i, step := range s.Steps {
+			if i > 0 && step.StartHeight <= s.Steps[i-1].StartHeight {
+				return fmt.Errorf("start heights of the steps must be increasing, step %d starts at %d after %d",
+					i, step.StartHeight, s.Steps[i-1].StartHeight)
+			}
+			if err := validateAnnualRate(step.AnnualRate); err != nil {
+				return fmt.Errorf("step %d: %w", i, err)
+			}
+		}
+	} else if len(s.Steps) != 0 {
+		return fmt.Errorf("steps are not used by the %s schedule", s.ScheduleType)
+	}
+
+	return nil
+}
+
+// InflationRate returns the annual inflation rate of the schedule at the given height with the
+// given supply. The rate of the bonded ratio schedule depends on the minter and is computed by
+// the InflationCalculationFn instead, so it is zero.
+func (s InflationSchedule) InflationRate(height uint64, totalSupply math.Int) sdk.Dec {
+	switch s.ScheduleType {
+	case InflationScheduleFixed:
+		return s.AnnualRate
+	case InflationScheduleHalving:
+		halvings := height / s.HalvingInterval
+		if halvings > maxHalvings {
+			return math.LegacyZeroDec()
+		}
+		return s.AnnualRate.QuoInt64(int64(1) << halvings)
+	case InflationScheduleCappedSupply:
+		if totalSupply.GTE(s.MaxSupply) {
+			return math.LegacyZeroDec()
+		}
+		return s.AnnualRate.MulInt(s.MaxSupply.Sub(totalSupply)).QuoInt(s.MaxSupply)
+	case InflationSchedulePiecewise:
+		rate := math.LegacyZeroDec()
+		for _, step := range s.Steps {
+			if step.StartHeight > height {
+				break
+			}
+			rate = step.AnnualRate
+		}
+		return rate
+	default:
+		return math.LegacyZeroDec()
+	}
+}
+
+// CapBlockProvision returns the provision of a block, reduced so that the supply does not exceed
+// the max supply of the capped supply schedule.
+func (s InflationSchedule) CapBlockProvision(provision sdk.Coin, totalSupply math.Int) sdk.Coin {
+	if s.ScheduleType != InflationScheduleCappedSupply {
+		return provision
+	}
+
+	left := s.MaxSupply.Sub(totalSupply)
+	if !left.IsPositive() {
+		return sdk.NewCoin(provision.Denom, math.ZeroInt())
+	}
+	if provision.Amount.GT(left) {
+		return sdk.NewCoin(provision.Denom, left)
+	}
+	return provision
+}
+
+// blocksToNextChange returns the number of blocks after the given height until the inflation rate
+// of the schedule changes regardless of the supply, or zero if it never does.
+func (s InflationSchedule) blocksToNextChange(height uint64) uint64 {
+	switch s.ScheduleType {
+	case InflationScheduleHalving:
+		if height/s.HalvingInterval > maxHalvings {
+			return 0
+		}
+		return s.HalvingInterval - height%s.HalvingInterval
+	case InflationSchedulePiecewise:
+		for _, step := range s.Steps {
+			if step.StartHeight > height {
+				return step.StartHeight - height
+			}
+		}
+	}
+	return 0
+}
+
+// ProjectSupply projects the supply of the mint denom the given number of blocks after the given
+// height, assuming the bonded ratio stays the same. It returns the projected supply and the annual
+// inflation rate of the last projected block.
+//
+// The supply is compounded block by block, ignoring the rounding of the minted amounts. When the
+// inflation rate depends on the supply or the bonded ratio, it is only updated daily.
+func (p Params) ProjectSupply(minter Minter, height uint64, totalSupply math.Int, bondedRatio sdk.Dec, blocks uint64) (math.Int, sdk.Dec) {
+	schedule := p.InflationSchedule
+	blocksPerYear := math.LegacyNewDec(int64(p.BlocksPerYear))
+
+	stepSize := uint64(0)
+	if schedule.ScheduleType == InflationScheduleBondedRatio || schedule.ScheduleType == InflationScheduleCappedSupply {
+		stepSize = p.BlocksPerYear / projectionStepsPerYear
+		if stepSize == 0 {
+			stepSize = 1
+		}
+	}
+
+	var rateChangePerBlock sdk.Dec
+	if schedule.ScheduleType == InflationScheduleBondedRatio {
+		rateChangePerBlock = math.LegacyOneDec().Sub(bondedRatio.Quo(p.GoalBonded)).Mul(p.InflationRateChange).Quo(blocksPerYear)
+	}
+
+	supply := math.LegacyNewDecFromInt(totalSupply)
+	inflation := minter.Inflation
+	for projected := uint64(0); projected < blocks; {
+		// the next block mints with the inflation rate computed at its height
+		next := height + projected + 1
+		if schedule.ScheduleType == InflationScheduleBondedRatio {
+			minter.Inflation = inflation
+			inflation = minter.NextInflationRate(p, bondedRatio)
+		} else {
+			inflation = schedule.InflationRate(next, supply.TruncateInt())
+		}
+
+		n := blocks - projected
+		if stepSize != 0 && n > stepSize {
+			n = stepSize
+		}
+		if change := schedule.blocksToNextChange(next); change != 0 && n > change {
+			n = change
+		}
+
+		supply = supply.Mul(math.LegacyOneDec().Add(inflation.Quo(blocksPerYear)).Power(n))
+		if schedule.ScheduleType == InflationScheduleCappedSupply && supply.GT(math.LegacyNewDecFromInt(schedule.MaxSupply)) {
+			supply = math.LegacyNewDecFromInt(schedule.MaxSupply)
+		}
+
+		// the bonded ratio curve moves the rate by the same change at every block within the
+		// bounds, so it is moved by the rest of the step at once
+		if schedule.ScheduleType == InflationScheduleBondedRatio && n > 1 {
+			inflation = inflation.Add(rateChangePerBlock.MulInt64(int64(n - 1)))
+			if inflation.GT(p.InflationMax) {
+				inflation = p.InflationMax
+			}
+			if inflation.LT(p.InflationMin) {
+				inflation = p.InflationMin
+			}
+		}
+		projected += n
+	}
+
+	return supply.TruncateInt(), inflation
+}
+
+func validateAnnualRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("annual rate cannot be nil: %s", rate)
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("annual rate cannot be negative: %s", rate)
+	}
+	if rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("annual rate too large: %s", rate)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInflationScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule InflationSchedule
+		expErr   bool
+	}{
+		{"default", DefaultInflationSchedule(), false},
+		{"fixed", NewFixedInflationSchedule(sdk.NewDecWithPrec(5, 2)), false},
+		{"fixed negative rate", NewFixedInflationSchedule(sdk.NewDecWithPrec(-5, 2)), true},
+		{"fixed rate too large", NewFixedInflationSchedule(sdk.NewDecWithPrec(11, 1)), true},
+		{"halving", NewHalvingInflationSchedule(sdk.NewDecWithPrec(10, 2), 1000), false},
+		{"halving without interval", NewHalvingInflationSchedule(sdk.NewDecWithPrec(10, 2), 0), true},
+		{"capped supply", NewCappedSupplyInflationSchedule(sdk.NewDecWithPrec(10, 2), math.NewInt(1000)), false},
+		{"capped supply without max supply", NewCappedSupplyInflationSchedule(sdk.NewDecWithPrec(10, 2), math.ZeroInt()), true},
+		{
+			"piecewise",
+			NewPiecewiseInflationSchedule(NewInflationStep(0, sdk.NewDecWithPrec(10, 2)), NewInflationStep(100, sdk.NewDecWithPrec(5, 2))),
+			false,
+		},
+		{"piecewise without steps", NewPiecewiseInflationSchedule(), true},
+		{
+			"piecewise with unordered steps",
+			NewPiecewiseInflationSchedule(NewInflationStep(100, sdk.NewDecWithPrec(10, 2)), NewInflationStep(100, sdk.NewDecWithPrec(5, 2))),
+			true,
+		},
+		{
+			"piecewise with invalid step rate",
+			NewPiecewiseInflationSchedule(NewInflationStep(0, sdk.NewDecWithPrec(-1, 2))),
+			true,
+		},
+		{
+			"unused field",
+			InflationSchedule{ScheduleType: InflationScheduleFixed, AnnualRate: sdk.NewDecWithPrec(5, 2), HalvingInterval: 10},
+			true,
+		},
+		{
+			"unused rate",
+			InflationSchedule{ScheduleType: InflationScheduleBondedRatio, AnnualRate: sdk.NewDecWithPrec(5, 2)},
+			true,
+		},
+		{"unknown type", InflationSchedule{ScheduleType: 5}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInflationRate(t *testing.T) {
+	supply := math.NewInt(750)
+	tests := []struct {
+		name     string
+		schedule InflationSchedule
+		height   uint64
+		expRate  sdk.Dec
+	}{
+		{"bonded ratio", DefaultInflationSchedule(), 10, math.LegacyZeroDec()},
+		{"fixed", NewFixedInflationSchedule(sdk.NewDecWithPrec(5, 2)), 10, sdk.NewDecWithPrec(5, 2)},
+		{"halving before first halving", NewHalvingInflationSchedule(sdk.NewDecWithPrec(8, 2), 100), 99, sdk.NewDecWithPrec(8, 2)},
+		{"halving after first halving", NewHalvingInflationSchedule(sdk.NewDecWithPrec(8, 2), 100), 100, sdk.NewDecWithPrec(4, 2)},
+		{"halving after three halvings", NewHalvingInflationSchedule(sdk.NewDecWithPrec(8, 2), 100), 350, sdk.NewDecWithPrec(1, 2)},
+		{"halving after all halvings", NewHalvingInflationSchedule(sdk.NewDecWithPrec(8, 2), 1), 100, math.LegacyZeroDec()},
+		{"capped supply", NewCappedSupplyInflationSchedule(sdk.NewDecWithPrec(8, 2), math.NewInt(1000)), 10, sdk.NewDecWithPrec(2, 2)},
+		{"capped supply reached", NewCappedSupplyInflationSchedule(sdk.NewDecWithPrec(8, 2), math.NewInt(500)), 10, math.LegacyZeroDec()},
+		{
+			"piecewise before first step",
+			NewPiecewiseInflationSchedule(NewInflationStep(10, sdk.NewDecWithPrec(8, 2)), NewInflationStep(20, sdk.NewDecWithPrec(4, 2))),
+			5, math.LegacyZeroDec(),
+		},
+		{
+			"piecewise first step",
+			NewPiecewiseInflationSchedule(NewInflationStep(10, sdk.NewDecWithPrec(8, 2)), NewInflationStep(20, sdk.NewDecWithPrec(4, 2))),
+			19, sdk.NewDecWithPrec(8, 2),
+		},
+		{
+			"piecewise last step",
+			NewPiecewiseInflationSchedule(NewInflationStep(10, sdk.NewDecWithPrec(8, 2)), NewInflationStep(20, sdk.NewDecWithPrec(4, 2))),
+			20, sdk.NewDecWithPrec(4, 2),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRate, tc.schedule.InflationRate(tc.height, supply))
+		})
+	}
+}
+
+func TestCapBlockProvision(t *testing.T) {
+	schedule := NewCappedSupplyInflationSchedule(sdk.NewDecWithPrec(8, 2), math.NewInt(1000))
+	provision := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	require.Equal(t, provision, schedule.CapBlockProvision(provision, math.NewInt(900)))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), schedule.CapBlockProvision(provision, math.NewInt(950)))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), schedule.CapBlockProvision(provision, math.NewInt(1000)))
+	require.Equal(t, provision, NewFixedInflationSchedule(sdk.NewDecWithPrec(8, 2)).CapBlockProvision(provision, math.NewInt(1000)))
+}
+
+func TestProjectSupply(t *testing.T) {
+	supply := math.NewInt(1_000_000_000)
+	params := DefaultParams()
+	params.BlocksPerYear = 1000
+
+	// a fixed rate compounds the supply every block
+	params.InflationSchedule = NewFixedInflationSchedule(sdk.NewDecWithPrec(10, 2))
+	projected, inflation := params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyZeroDec(), params.BlocksPerYear)
+	require.Equal(t, math.NewInt(1_105_165_392), projected)
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), inflation)
+
+	// the projection of the halving schedule changes the rate at the halving height
+	params.InflationSchedule = NewHalvingInflationSchedule(sdk.NewDecWithPrec(10, 2), 500)
+	projected, inflation = params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyZeroDec(), 999)
+	halfYear := math.LegacyOneDec().Add(sdk.NewDecWithPrec(10, 2).QuoInt64(1000)).Power(499)
+	halvedHalfYear := math.LegacyOneDec().Add(sdk.NewDecWithPrec(5, 2).QuoInt64(1000)).Power(500)
+	require.Equal(t, math.LegacyNewDecFromInt(supply).Mul(halfYear).Mul(halvedHalfYear).TruncateInt(), projected)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), inflation)
+
+	// the projection of the capped supply schedule never exceeds the max supply
+	params.InflationSchedule = NewCappedSupplyInflationSchedule(math.LegacyOneDec(), math.NewInt(1_000_000_100))
+	projected, _ = params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyZeroDec(), 100*params.BlocksPerYear)
+	require.True(t, projected.LTE(math.NewInt(1_000_000_100)))
+	require.True(t, projected.GT(supply))
+
+	// the inflation of the bonded ratio schedule moves towards the bounds
+	params.InflationSchedule = DefaultInflationSchedule()
+	projected, inflation = params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyZeroDec(), 10*params.BlocksPerYear)
+	require.Equal(t, params.InflationMax, inflation)
+	require.True(t, projected.GT(supply))
+	_, inflation = params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyOneDec(), 10*params.BlocksPerYear)
+	require.Equal(t, params.InflationMin, inflation)
+
+	// nothing is projected without blocks
+	projected, inflation = params.ProjectSupply(DefaultInitialMinter(), 0, supply, math.LegacyZeroDec(), 0)
+	require.Equal(t, supply, projected)
+	require.Equal(t, DefaultInitialMinter().Inflation, inflation)
+}
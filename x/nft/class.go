@@ -0,0 +1,69 @@
+package nft
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRoyaltyBasisPoints is the maximum royalty of a class, in basis points
+const MaxRoyaltyBasisPoints = 10000
+
+// ValidateClass checks that the given class has no integrity issues
+func ValidateClass(class Class) error {
+	if len(class.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if class.Owner != "" {
+		if _, err := sdk.AccAddressFromHexUnsafe(class.Owner); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", class.Owner)
+		}
+	}
+
+	minters := make(map[string]bool, len(class.Minters))
+	for _, minter := range class.Minters {
+		addr, err := sdk.AccAddressFromHexUnsafe(minter)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", minter)
+		}
+		if minters[addr.String()] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate minter address (%s)", minter)
+		}
+		minters[addr.String()] = true
+	}
+
+	if class.RoyaltyBasisPoints > MaxRoyaltyBasisPoints {
+		return errors.Wrapf(ErrInvalidRoyalty, "royalty basis points %d is more than %d", class.RoyaltyBasisPoints, MaxRoyaltyBasisPoints)
+	}
+	if class.RoyaltyBasisPoints > 0 && class.RoyaltyRecipient == "" {
+		return errors.Wrap(ErrInvalidRoyalty, "royalty recipient is empty")
+	}
+	if class.RoyaltyRecipient != "" {
+		if _, err := sdk.AccAddressFromHexUnsafe(class.RoyaltyRecipient); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid royalty recipient address (%s)", class.RoyaltyRecipient)
+		}
+	}
+	return nil
+}
+
+// CanMint returns whether the given account is the owner or a minter of the class
+func (c Class) CanMint(account sdk.AccAddress) bool {
+	if owner, err := sdk.AccAddressFromHexUnsafe(c.Owner); err == nil && owner.Equals(account) {
+		return true
+	}
+	for _, minter := range c.Minters {
+		if addr, err := sdk.AccAddressFromHexUnsafe(minter); err == nil && addr.Equals(account) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoyaltyAmount returns the royalty of the sale of a nft of the class at the given price, rounded down
+func (c Class) RoyaltyAmount(salePrice sdk.Coin) sdk.Coin {
+	amount := salePrice.Amount.Mul(math.NewIntFromUint64(uint64(c.RoyaltyBasisPoints))).QuoRaw(MaxRoyaltyBasisPoints)
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
@@ -39,6 +39,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyaltyInfo(),
+		GetCmdQueryMirror(),
 	)
 	return nftQueryCmd
 }
@@ -268,3 +270,64 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyaltyInfo implements the query royalty info command.
+func GetCmdQueryRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [class-id] [sale-price]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty of the sale of an NFT of a class at a given price",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id> <sale-price>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), &nft.QueryRoyaltyInfoRequest{
+				ClassId:   args[0],
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMirror implements the query mirror command.
+func GetCmdQueryMirror() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mirror [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the mirror of an NFT escrowed by the module",
+		Example: fmt.Sprintf(`$ %s query %s mirror <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Mirror(cmd.Context(), &nft.QueryMirrorRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,10 +10,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName               = "name"
+	FlagSymbol             = "symbol"
+	FlagDescription        = "description"
+	FlagURI                = "uri"
+	FlagURIHash            = "uri-hash"
+	FlagMinters            = "minters"
+	FlagMaxSupply          = "max-supply"
+	FlagRoyaltyRecipient   = "royalty-recipient"
+	FlagRoyaltyBasisPoints = "royalty-basis-points"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +41,11 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
+		NewCmdMirrorNFT(),
 	)
 
 	return nftTxCmd
@@ -59,3 +79,207 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdCreateClass creates a CLI command for MsgCreateClass.
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a nft class owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --minters <minter>,<minter> --max-supply 1000 --royalty-recipient <recipient> --royalty-basis-points 250 --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    args[0],
+			}
+			if msg.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+				return err
+			}
+			if msg.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+				return err
+			}
+			if msg.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+				return err
+			}
+			if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+			if msg.Minters, err = cmd.Flags().GetStringSlice(FlagMinters); err != nil {
+				return err
+			}
+			if msg.MaxSupply, err = cmd.Flags().GetUint64(FlagMaxSupply); err != nil {
+				return err
+			}
+			if msg.RoyaltyRecipient, err = cmd.Flags().GetString(FlagRoyaltyRecipient); err != nil {
+				return err
+			}
+			if msg.RoyaltyBasisPoints, err = cmd.Flags().GetUint32(FlagRoyaltyBasisPoints); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().StringSlice(FlagMinters, []string{}, "The addresses which can mint nfts of the class besides the owner")
+	cmd.Flags().Uint64(FlagMaxSupply, 0, "The maximum number of nfts of the class, 0 meaning there is no limit")
+	cmd.Flags().String(FlagRoyaltyRecipient, "", "The address receiving the royalty of the sales of the nfts of the class")
+	cmd.Flags().Uint32(FlagRoyaltyBasisPoints, 0, "The royalty of the sales of the nfts of the class, in basis points")
+	return cmd
+}
+
+// NewCmdMint creates a CLI command for MsgMint.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a nft of a class owned by or mintable by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromHexUnsafe(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Receiver: receiver.String(),
+			}
+			if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	return cmd
+}
+
+// NewCmdBurn creates a CLI command for MsgBurn.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateNFT creates a CLI command for MsgUpdateNFT.
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft of a class owned by or mintable by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The new uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The new hash of the document pointed by uri")
+	return cmd
+}
+
+// NewCmdMirrorNFT creates a CLI command for MsgMirrorNFT.
+func NewCmdMirrorNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mirror [class-id] [nft-id] [dest-chain-id] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "escrow a nft and mirror it to the receiver on a destination chain",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mirror <class-id> <nft-id> <dest-chain-id> <receiver> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destChainID, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMirrorNFT{
+				Sender:      clientCtx.GetFromAddress().String(),
+				ClassId:     args[0],
+				Id:          args[1],
+				DestChainId: uint32(destChainID),
+				Receiver:    args[3],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -10,6 +10,11 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgCreateClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdateNFT{},
+		&MsgMirrorNFT{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,10 +6,15 @@ import (
 
 // x/nft module sentinel errors
 var (
-	ErrClassExists    = errors.Register(ModuleName, 3, "nft class already exists")
-	ErrClassNotExists = errors.Register(ModuleName, 4, "nft class does not exist")
-	ErrNFTExists      = errors.Register(ModuleName, 5, "nft already exists")
-	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
-	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
-	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrClassExists       = errors.Register(ModuleName, 3, "nft class already exists")
+	ErrClassNotExists    = errors.Register(ModuleName, 4, "nft class does not exist")
+	ErrNFTExists         = errors.Register(ModuleName, 5, "nft already exists")
+	ErrNFTNotExists      = errors.Register(ModuleName, 6, "nft does not exist")
+	ErrEmptyClassID      = errors.Register(ModuleName, 7, "empty class id")
+	ErrEmptyNFTID        = errors.Register(ModuleName, 8, "empty nft id")
+	ErrMaxSupply         = errors.Register(ModuleName, 9, "nft class max supply reached")
+	ErrInvalidRoyalty    = errors.Register(ModuleName, 10, "invalid nft class royalty")
+	ErrMirrorNotFound    = errors.Register(ModuleName, 11, "nft mirror not found")
+	ErrMirrorPackage     = errors.Register(ModuleName, 12, "invalid nft mirror package")
+	ErrChainNotSupported = errors.Register(ModuleName, 13, "chain is not supported")
 )
@@ -24,6 +24,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, mirror := range data.Mirrors {
+		k.setMirror(ctx, *mirror)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -58,5 +61,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Mirrors: k.GetMirrors(ctx),
 	}
 }
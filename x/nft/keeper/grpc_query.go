@@ -193,3 +193,50 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyInfo return the royalty of the sale of a nft of a class at a given price, same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+	return &nft.QueryRoyaltyInfoResponse{
+		Receiver:      class.RoyaltyRecipient,
+		RoyaltyAmount: class.RoyaltyAmount(r.SalePrice),
+	}, nil
+}
+
+// Mirror return the mirror of a nft escrowed by the module
+func (k Keeper) Mirror(goCtx context.Context, r *nft.QueryMirrorRequest) (*nft.QueryMirrorResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mirror, has := k.GetMirror(ctx, r.ClassId, r.Id)
+	if !has {
+		return nil, nft.ErrMirrorNotFound.Wrapf("not found mirror: %s/%s", r.ClassId, r.Id)
+	}
+	return &nft.QueryMirrorResponse{Mirror: &mirror}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,69 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	var req *nft.QueryRoyaltyInfoRequest
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse)
+	}{
+		{
+			"fail empty ClassId",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{
+					SalePrice: sdk.NewInt64Coin("stake", 1000),
+				}
+			},
+			nft.ErrEmptyClassID.Error(),
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"fail ClassId not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId:   "kitty1",
+					SalePrice: sdk.NewInt64Coin("stake", 1000),
+				}
+				err := s.nftKeeper.SaveClass(s.ctx, nft.Class{
+					Id:                 testClassID,
+					RoyaltyRecipient:   s.addrs[1].String(),
+					RoyaltyBasisPoints: 250,
+				})
+				require.NoError(err, "the error occurred on:%d", index)
+			},
+			"not found class",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"success",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId:   testClassID,
+					SalePrice: sdk.NewInt64Coin("stake", 1999),
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Equal(s.addrs[1].String(), res.Receiver, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 49), res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.RoyaltyInfo(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -11,20 +12,27 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+
+	// crossChainKeeper is optional, nfts can not be mirrored on other chains without it
+	crossChainKeeper nft.CrossChainKeeper
+	moduleAddress    sdk.AccAddress
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(key storetypes.StoreKey,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, crossChainKeeper nft.CrossChainKeeper,
 ) Keeper {
 	// ensure nft module account is set
-	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
+	addr := ak.GetModuleAddress(nft.ModuleName)
+	if addr == nil {
 		panic("the nft module account has not been set")
 	}
 
 	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
-		bk:       bk,
+		cdc:              cdc,
+		storeKey:         key,
+		bk:               bk,
+		crossChainKeeper: crossChainKeeper,
+		moduleAddress:    addr,
 	}
 }
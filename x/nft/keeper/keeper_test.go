@@ -38,6 +38,9 @@ type TestSuite struct {
 	addrs       []sdk.AccAddress
 	queryClient nft.QueryClient
 	nftKeeper   keeper.Keeper
+	msgServer   nft.MsgServer
+
+	crossChainKeeper *nfttestutil.MockCrossChainKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -56,12 +59,14 @@ func (s *TestSuite) SetupTest() {
 	accountKeeper := nfttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := nfttestutil.NewMockBankKeeper(ctrl)
 	accountKeeper.EXPECT().GetModuleAddress("nft").Return(s.addrs[0]).AnyTimes()
+	s.crossChainKeeper = nfttestutil.NewMockCrossChainKeeper(ctrl)
 
-	nftKeeper := keeper.NewKeeper(key, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(key, s.encCfg.Codec, accountKeeper, bankKeeper, s.crossChainKeeper)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	MirrorKey            = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -104,3 +105,18 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// mirrorStoreKey returns the byte representation of the nft mirror
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID>
+func mirrorStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(MirrorKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, MirrorKey)
+	copy(key[len(MirrorKey):], classIDBz)
+	copy(key[len(MirrorKey)+len(classIDBz):], Delimiter)
+	copy(key[len(MirrorKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
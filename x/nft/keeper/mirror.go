@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// RegisterCrossChainMirrorApp registers the nft mirror channel to the cross chain module
+func (k Keeper) RegisterCrossChainMirrorApp() error {
+	if k.crossChainKeeper == nil {
+		return sdkerrors.Wrap(nft.ErrChainNotSupported, "the nft keeper has no cross chain keeper")
+	}
+	return k.crossChainKeeper.RegisterChannel(nft.MirrorChannel, nft.MirrorChannelID, MirrorApp{keeper: k})
+}
+
+// MirrorApp is the cross chain application of the nft mirror channel
+type MirrorApp struct {
+	keeper Keeper
+}
+
+var _ sdk.CrossChainApplication = MirrorApp{}
+
+// ExecuteSynPackage releases a mirrored nft to the receiver, the destination chain is expected to
+// have burnt the mirror. The ack of the package tells the destination chain whether the release
+// succeeded, so that it can restore the mirror otherwise.
+func (app MirrorApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := nft.DeserializeReleaseSynPackage(payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: sdkerrors.Wrapf(nft.ErrMirrorPackage, "fail to deserialize release package: %s", err)}
+	}
+
+	ack := nft.MirrorAckPackage{
+		Status:  nft.MirrorAckStatusSuccess,
+		ClassId: pack.ClassId,
+		Id:      pack.Id,
+	}
+	releaseErr := app.keeper.releaseMirror(ctx, appCtx.SrcChainId, pack)
+	if releaseErr != nil {
+		ack.Status = nft.MirrorAckStatusFailed
+	}
+
+	ackPayload, err := ack.Serialize()
+	if err != nil {
+		return sdk.ExecuteResult{Err: sdkerrors.Wrapf(nft.ErrMirrorPackage, "fail to serialize ack package: %s", err)}
+	}
+	return sdk.ExecuteResult{Err: releaseErr, Payload: ackPayload}
+}
+
+// ExecuteAckPackage marks the nft as mirrored if the destination chain minted the mirror, and
+// refunds it to its owner otherwise.
+func (app MirrorApp) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := nft.DeserializeMirrorAckPackage(payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: sdkerrors.Wrapf(nft.ErrMirrorPackage, "fail to deserialize ack package: %s", err)}
+	}
+
+	mirror, err := app.keeper.getPendingMirror(ctx, appCtx.SrcChainId, pack.ClassId, pack.Id)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+
+	if pack.Status != nft.MirrorAckStatusSuccess {
+		return sdk.ExecuteResult{Err: app.keeper.refundMirror(ctx, mirror)}
+	}
+
+	mirror.Status = nft.MirrorStatus_MIRROR_STATUS_MIRRORED
+	app.keeper.setMirror(ctx, mirror)
+	return sdk.ExecuteResult{Err: ctx.EventManager().EmitTypedEvent(&nft.EventMirrorAck{
+		ClassId:     mirror.ClassId,
+		Id:          mirror.Id,
+		DestChainId: mirror.DestChainId,
+	})}
+}
+
+// ExecuteFailAckPackage refunds the nft to its owner, the payload is the payload of the mirror package.
+func (app MirrorApp) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := nft.DeserializeMirrorSynPackage(payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: sdkerrors.Wrapf(nft.ErrMirrorPackage, "fail to deserialize mirror package: %s", err)}
+	}
+
+	mirror, err := app.keeper.getPendingMirror(ctx, appCtx.SrcChainId, pack.ClassId, pack.Id)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+	return sdk.ExecuteResult{Err: app.keeper.refundMirror(ctx, mirror)}
+}
+
+// MirrorNFT escrows the nft owned by the sender in the module account and sends a package to
+// mirror it on the destination chain, it returns the sequence of the package.
+func (k Keeper) MirrorNFT(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string, destChainID sdk.ChainID, receiver common.Address) (uint64, error) {
+	if k.crossChainKeeper == nil || !k.crossChainKeeper.IsDestChainSupported(destChainID) {
+		return 0, sdkerrors.Wrapf(nft.ErrChainNotSupported, "destination chain (%d) is not supported", destChainID)
+	}
+
+	token, has := k.GetNFT(ctx, classID, nftID)
+	if !has {
+		return 0, sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	if !owner.Equals(sender) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+
+	pack := nft.MirrorSynPackage{
+		ClassId:  token.ClassId,
+		Id:       token.Id,
+		Uri:      token.Uri,
+		UriHash:  token.UriHash,
+		Receiver: receiver,
+	}
+	encodedPackage, err := pack.Serialize()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(nft.ErrMirrorPackage, "fail to serialize mirror package: %s", err)
+	}
+
+	if err := k.Transfer(ctx, classID, nftID, k.moduleAddress); err != nil {
+		return 0, err
+	}
+	k.setMirror(ctx, nft.Mirror{
+		ClassId:     classID,
+		Id:          nftID,
+		Owner:       sender.String(),
+		DestChainId: uint32(destChainID),
+		Receiver:    receiver.Hex(),
+		Status:      nft.MirrorStatus_MIRROR_STATUS_PENDING,
+	})
+
+	return k.crossChainKeeper.CreateRawIBCPackageWithFee(
+		ctx,
+		destChainID,
+		nft.MirrorChannelID,
+		sdk.SynCrossChainPackageType,
+		encodedPackage,
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+}
+
+// getPendingMirror returns the mirror of the nft sent to the given chain and not acknowledged yet
+func (k Keeper) getPendingMirror(ctx sdk.Context, destChainID sdk.ChainID, classID, nftID string) (nft.Mirror, error) {
+	mirror, has := k.GetMirror(ctx, classID, nftID)
+	if !has || mirror.DestChainId != uint32(destChainID) || mirror.Status != nft.MirrorStatus_MIRROR_STATUS_PENDING {
+		return nft.Mirror{}, sdkerrors.Wrapf(nft.ErrMirrorNotFound, "no pending mirror of nft %s/%s on chain %d", classID, nftID, destChainID)
+	}
+	return mirror, nil
+}
+
+// refundMirror returns the escrowed nft to the owner which mirrored it
+func (k Keeper) refundMirror(ctx sdk.Context, mirror nft.Mirror) error {
+	owner := sdk.MustAccAddressFromHex(mirror.Owner)
+	if err := k.Transfer(ctx, mirror.ClassId, mirror.Id, owner); err != nil {
+		return err
+	}
+	k.deleteMirror(ctx, mirror.ClassId, mirror.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventMirrorRefund{
+		ClassId: mirror.ClassId,
+		Id:      mirror.Id,
+		Owner:   mirror.Owner,
+	})
+}
+
+// releaseMirror returns the escrowed nft of a mirror acknowledged by the source chain of the
+// release package to the receiver of the package
+func (k Keeper) releaseMirror(ctx sdk.Context, srcChainID sdk.ChainID, pack *nft.ReleaseSynPackage) error {
+	mirror, has := k.GetMirror(ctx, pack.ClassId, pack.Id)
+	if !has || mirror.DestChainId != uint32(srcChainID) || mirror.Status != nft.MirrorStatus_MIRROR_STATUS_MIRRORED {
+		return sdkerrors.Wrapf(nft.ErrMirrorNotFound, "nft %s/%s is not mirrored on chain %d", pack.ClassId, pack.Id, srcChainID)
+	}
+
+	receiver := sdk.AccAddress(pack.Receiver.Bytes())
+	if err := k.Transfer(ctx, mirror.ClassId, mirror.Id, receiver); err != nil {
+		return err
+	}
+	k.deleteMirror(ctx, mirror.ClassId, mirror.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventMirrorRelease{
+		ClassId:    mirror.ClassId,
+		Id:         mirror.Id,
+		SrcChainId: uint32(srcChainID),
+		Receiver:   receiver.String(),
+	})
+}
+
+// GetMirror returns the mirror of the nft escrowed by the module
+func (k Keeper) GetMirror(ctx sdk.Context, classID, nftID string) (nft.Mirror, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(mirrorStoreKey(classID, nftID))
+	if len(bz) == 0 {
+		return nft.Mirror{}, false
+	}
+
+	var mirror nft.Mirror
+	k.cdc.MustUnmarshal(bz, &mirror)
+	return mirror, true
+}
+
+// GetMirrors returns all the mirrors of the nfts escrowed by the module
+func (k Keeper) GetMirrors(ctx sdk.Context) (mirrors []*nft.Mirror) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, MirrorKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var mirror nft.Mirror
+		k.cdc.MustUnmarshal(iterator.Value(), &mirror)
+		mirrors = append(mirrors, &mirror)
+	}
+	return
+}
+
+func (k Keeper) setMirror(ctx sdk.Context, mirror nft.Mirror) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(mirrorStoreKey(mirror.ClassId, mirror.Id), k.cdc.MustMarshal(&mirror))
+}
+
+func (k Keeper) deleteMirror(ctx sdk.Context, classID, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(mirrorStoreKey(classID, nftID))
+}
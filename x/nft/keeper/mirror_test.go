@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nfttestutil "github.com/cosmos/cosmos-sdk/x/nft/testutil"
+)
+
+const testDestChainID sdk.ChainID = 56
+
+// setupMirror registers the mirror app and mints the test nft to the second account
+func (s *TestSuite) setupMirror() sdk.CrossChainApplication {
+	var app sdk.CrossChainApplication
+	s.crossChainKeeper.EXPECT().RegisterChannel(nft.MirrorChannel, nft.MirrorChannelID, gomock.Any()).
+		DoAndReturn(func(_ string, _ sdk.ChannelID, registered sdk.CrossChainApplication) error {
+			app = registered
+			return nil
+		})
+	s.Require().NoError(s.nftKeeper.RegisterCrossChainMirrorApp())
+
+	s.crossChainKeeper.EXPECT().IsDestChainSupported(gomock.Any()).DoAndReturn(func(chainID sdk.ChainID) bool {
+		return chainID == testDestChainID
+	}).AnyTimes()
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[1]))
+	return app
+}
+
+// mirror mirrors the test nft owned by the second account and returns the sent package
+func (s *TestSuite) mirror(receiver common.Address) []byte {
+	var payload []byte
+	s.crossChainKeeper.EXPECT().CreateRawIBCPackageWithFee(gomock.Any(), testDestChainID, nft.MirrorChannelID,
+		sdk.SynCrossChainPackageType, gomock.Any(), big.NewInt(0), big.NewInt(0)).
+		DoAndReturn(func(_ sdk.Context, _ sdk.ChainID, _ sdk.ChannelID, _ sdk.CrossChainPackageType, packageLoad []byte, _, _ *big.Int) (uint64, error) {
+			payload = packageLoad
+			return 1, nil
+		})
+
+	res, err := s.msgServer.MirrorNFT(s.ctx, &nft.MsgMirrorNFT{
+		Sender:      s.addrs[1].String(),
+		ClassId:     testClassID,
+		Id:          testID,
+		DestChainId: uint32(testDestChainID),
+		Receiver:    receiver.Hex(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Sequence)
+	return payload
+}
+
+func (s *TestSuite) ackPayload(status uint8) []byte {
+	payload, err := nft.MirrorAckPackage{Status: status, ClassId: testClassID, Id: testID}.Serialize()
+	s.Require().NoError(err)
+	return payload
+}
+
+func (s *TestSuite) TestMirrorNFT() {
+	s.setupMirror()
+	receiver := common.BytesToAddress(s.addrs[2])
+
+	_, err := s.msgServer.MirrorNFT(s.ctx, &nft.MsgMirrorNFT{
+		Sender:      s.addrs[1].String(),
+		ClassId:     testClassID,
+		Id:          testID,
+		DestChainId: 97,
+		Receiver:    receiver.Hex(),
+	})
+	s.Require().ErrorIs(err, nft.ErrChainNotSupported)
+
+	_, err = s.msgServer.MirrorNFT(s.ctx, &nft.MsgMirrorNFT{
+		Sender:      s.addrs[2].String(),
+		ClassId:     testClassID,
+		Id:          testID,
+		DestChainId: uint32(testDestChainID),
+		Receiver:    receiver.Hex(),
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "unauthorized")
+
+	payload := s.mirror(receiver)
+	pack, err := nft.DeserializeMirrorSynPackage(payload)
+	s.Require().NoError(err)
+	s.Require().Equal(nft.MirrorSynPackage{
+		ClassId:  testClassID,
+		Id:       testID,
+		Uri:      ExpNFT.Uri,
+		UriHash:  ExpNFT.UriHash,
+		Receiver: receiver,
+	}, *pack)
+
+	// the nft is escrowed by the module until the mirror is acknowledged
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	mirror, has := s.nftKeeper.GetMirror(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(nft.Mirror{
+		ClassId:     testClassID,
+		Id:          testID,
+		Owner:       s.addrs[1].String(),
+		DestChainId: uint32(testDestChainID),
+		Receiver:    receiver.Hex(),
+		Status:      nft.MirrorStatus_MIRROR_STATUS_PENDING,
+	}, mirror)
+
+	res, err := s.queryClient.Mirror(s.ctx, &nft.QueryMirrorRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(mirror, *res.Mirror)
+}
+
+func (s *TestSuite) TestMirrorAckAndRelease() {
+	app := s.setupMirror()
+	s.mirror(common.BytesToAddress(s.addrs[1]))
+
+	// acks from another chain are ignored
+	result := app.ExecuteAckPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: 97}, s.ackPayload(nft.MirrorAckStatusSuccess))
+	s.Require().ErrorIs(result.Err, nft.ErrMirrorNotFound)
+
+	// the nft can not be released before the mirror is acknowledged
+	release, err := nft.ReleaseSynPackage{ClassId: testClassID, Id: testID, Receiver: common.BytesToAddress(s.addrs[2])}.Serialize()
+	s.Require().NoError(err)
+	result = app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: testDestChainID}, release)
+	s.Require().ErrorIs(result.Err, nft.ErrMirrorNotFound)
+	s.Require().Equal(s.ackPayload(nft.MirrorAckStatusFailed), result.Payload)
+
+	result = app.ExecuteAckPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: testDestChainID}, s.ackPayload(nft.MirrorAckStatusSuccess))
+	s.Require().NoError(result.Err)
+	mirror, has := s.nftKeeper.GetMirror(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(nft.MirrorStatus_MIRROR_STATUS_MIRRORED, mirror.Status)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the escrowed nft is exported with its mirror
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]*nft.Mirror{&mirror}, genesis.Mirrors)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	result = app.ExecuteSynPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: testDestChainID}, release)
+	s.Require().NoError(result.Err)
+	s.Require().Equal(s.ackPayload(nft.MirrorAckStatusSuccess), result.Payload)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	_, has = s.nftKeeper.GetMirror(s.ctx, testClassID, testID)
+	s.Require().False(has)
+}
+
+func (s *TestSuite) TestMirrorRefund() {
+	app := s.setupMirror()
+
+	testCases := []struct {
+		name    string
+		execute func(payload []byte) sdk.ExecuteResult
+	}{
+		{
+			name: "failed ack",
+			execute: func([]byte) sdk.ExecuteResult {
+				return app.ExecuteAckPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: testDestChainID}, s.ackPayload(nft.MirrorAckStatusFailed))
+			},
+		},
+		{
+			name: "fail ack",
+			execute: func(payload []byte) sdk.ExecuteResult {
+				return app.ExecuteFailAckPackage(s.ctx, &sdk.CrossChainAppContext{SrcChainId: testDestChainID}, payload)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			payload := s.mirror(common.BytesToAddress(s.addrs[2]))
+			s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+			result := tc.execute(payload)
+			s.Require().NoError(result.Err)
+			s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+			_, has := s.nftKeeper.GetMirror(s.ctx, testClassID, testID)
+			s.Require().False(has)
+
+			// a refunded mirror can not be refunded twice
+			result = tc.execute(payload)
+			s.Require().ErrorIs(result.Err, nft.ErrMirrorNotFound)
+		})
+	}
+}
+
+func (s *TestSuite) TestRegisterCrossChainMirrorAppWithoutCrossChain() {
+	accountKeeper := nfttestutil.NewMockAccountKeeper(gomock.NewController(s.T()))
+	accountKeeper.EXPECT().GetModuleAddress("nft").Return(s.addrs[0])
+
+	k := keeper.NewKeeper(nil, s.encCfg.Codec, accountKeeper, nil, nil)
+	s.Require().ErrorIs(k.RegisterCrossChainMirrorApp(), nft.ErrChainNotSupported)
+
+	_, err := k.MirrorNFT(s.ctx, s.addrs[1], testClassID, testID, testDestChainID, common.Address{})
+	s.Require().ErrorIs(err, nft.ErrChainNotSupported)
+}
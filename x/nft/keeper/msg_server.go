@@ -3,15 +3,29 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ nft.MsgServer = msgServer{}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromHexUnsafe(msg.Sender)
 	if err != nil {
@@ -40,3 +54,127 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SaveClass(ctx, msg.Class()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter, err := sdk.AccAddressFromHexUnsafe(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+	if !class.CanMint(minter) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner or a minter of class %s", minter, msg.ClassId)
+	}
+
+	receiver, err := sdk.AccAddressFromHexUnsafe(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromHexUnsafe(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+	if !class.CanMint(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner or a minter of class %s", sender, msg.ClassId)
+	}
+
+	token, has := k.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// MirrorNFT implements MirrorNFT method of the types.MsgServer.
+func (k msgServer) MirrorNFT(goCtx context.Context, msg *nft.MsgMirrorNFT) (*nft.MsgMirrorNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromHexUnsafe(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver := common.HexToAddress(msg.Receiver)
+	sequence, err := k.Keeper.MirrorNFT(ctx, sender, msg.ClassId, msg.Id, sdk.ChainID(msg.DestChainId), receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventMirror{
+		ClassId:     msg.ClassId,
+		Id:          msg.Id,
+		Sender:      msg.Sender,
+		DestChainId: msg.DestChainId,
+		Receiver:    receiver.Hex(),
+		Sequence:    sequence,
+	})
+	return &nft.MsgMirrorNFTResponse{Sequence: sequence}, nil
+}
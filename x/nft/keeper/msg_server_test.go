@@ -98,7 +98,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -108,3 +108,230 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestMsgCreateClass() {
+	testCases := []struct {
+		name   string
+		req    *nft.MsgCreateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "valid transaction",
+			req: &nft.MsgCreateClass{
+				Owner:              s.addrs[0].String(),
+				Id:                 testClassID,
+				Name:               testClassName,
+				Symbol:             testClassSymbol,
+				Minters:            []string{s.addrs[1].String()},
+				MaxSupply:          2,
+				RoyaltyRecipient:   s.addrs[0].String(),
+				RoyaltyBasisPoints: 250,
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "class already exists",
+			req: &nft.MsgCreateClass{
+				Owner: s.addrs[1].String(),
+				Id:    testClassID,
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.CreateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				class, has := s.nftKeeper.GetClass(s.ctx, tc.req.Id)
+				s.Require().True(has)
+				s.Require().Equal(tc.req.Class(), class)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgMint() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:     s.addrs[0].String(),
+		Id:        testClassID,
+		Minters:   []string{s.addrs[1].String()},
+		MaxSupply: 2,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgMint
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "class not exists",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				ClassId:  "kitty2",
+				Id:       testID,
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "unauthorized minter",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[2].String(),
+				ClassId:  testClassID,
+				Id:       testID,
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner or a minter of class %s", s.addrs[2].String(), testClassID),
+		},
+		{
+			name: "minted by owner",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				ClassId:  testClassID,
+				Id:       testID,
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "minted by minter",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[1].String(),
+				ClassId:  testClassID,
+				Id:       "kitty2",
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "max supply reached",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				ClassId:  testClassID,
+				Id:       "kitty3",
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: true,
+			errMsg: "nft class max supply reached",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Mint(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, tc.req.ClassId, tc.req.Id))
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:     s.addrs[0].String(),
+		Id:        testClassID,
+		MaxSupply: 1,
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+
+	// the class owner can not burn a nft it does not own
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), fmt.Sprintf("%s is not the owner of nft %s", s.addrs[0].String(), testID))
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Equal(uint64(0), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	// burning frees capacity under the max supply
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       "kitty2",
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestMsgUpdateNFT() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:   s.addrs[0].String(),
+		Id:      testClassID,
+		Minters: []string{s.addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Uri:      testURI,
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().NoError(err)
+
+	// the nft owner is neither the class owner nor a minter
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Sender:  s.addrs[2].String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "unauthorized")
+
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Sender:  s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      "kitty2",
+		Uri:     "new uri",
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "nft does not exist")
+
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Sender:  s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+		UriHash: "new uri hash",
+	})
+	s.Require().NoError(err)
+	token, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal("new uri", token.Uri)
+	s.Require().Equal("new uri hash", token.UriHash)
+}
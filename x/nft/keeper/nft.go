@@ -9,7 +9,8 @@ import (
 
 // Mint defines a method for minting a new nft
 func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
-	if !k.HasClass(ctx, token.ClassId) {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
 
@@ -17,10 +18,22 @@ func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) er
 		return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
+	if err := k.checkMaxSupply(ctx, class); err != nil {
+		return err
+	}
+
 	k.mintWithNoCheck(ctx, token, receiver)
 	return nil
 }
 
+// checkMaxSupply checks that a nft can be minted without exceeding the max supply of the class
+func (k Keeper) checkMaxSupply(ctx sdk.Context, class nft.Class) error {
+	if class.MaxSupply != 0 && k.GetTotalSupply(ctx, class.Id) >= class.MaxSupply {
+		return sdkerrors.Wrapf(nft.ErrMaxSupply, "class %s has reached its max supply %d", class.Id, class.MaxSupply)
+	}
+	return nil
+}
+
 // mintWithNoCheck defines a method for minting a new nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
@@ -11,17 +11,25 @@ func (k Keeper) BatchMint(ctx sdk.Context,
 	tokens []nft.NFT,
 	receiver sdk.AccAddress,
 ) error {
-	checked := make(map[string]bool, len(tokens))
+	classes := make(map[string]nft.Class, len(tokens))
 	for _, token := range tokens {
-		if !checked[token.ClassId] && !k.HasClass(ctx, token.ClassId) {
-			return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
+		class, checked := classes[token.ClassId]
+		if !checked {
+			var has bool
+			if class, has = k.GetClass(ctx, token.ClassId); !has {
+				return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
+			}
 		}
 
 		if k.HasNFT(ctx, token.ClassId, token.Id) {
 			return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 		}
 
-		checked[token.ClassId] = true
+		if err := k.checkMaxSupply(ctx, class); err != nil {
+			return err
+		}
+
+		classes[token.ClassId] = class
 		k.mintWithNoCheck(ctx, token, receiver)
 	}
 	return nil
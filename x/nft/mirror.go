@@ -0,0 +1,162 @@
+package nft
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MirrorChannel = "nftMirror"
+
+	MirrorChannelID sdk.ChannelID = 0x10
+)
+
+const (
+	// MirrorAckStatusSuccess is the status of the ack of a mirror or release package which succeeded
+	MirrorAckStatusSuccess uint8 = 0
+	// MirrorAckStatusFailed is the status of the ack of a mirror or release package which failed
+	MirrorAckStatusFailed uint8 = 1
+)
+
+// MirrorSynPackage is the payload of the package sent to mirror a nft on the destination chain
+type MirrorSynPackage struct {
+	ClassId  string
+	Id       string
+	Uri      string
+	UriHash  string
+	Receiver common.Address
+}
+
+// MirrorAckPackage is the payload of the ack of a mirror package, it is also the payload of
+// the ack sent for a release package
+type MirrorAckPackage struct {
+	Status  uint8
+	ClassId string
+	Id      string
+}
+
+// ReleaseSynPackage is the payload of the package sent by the destination chain to release a
+// mirrored nft to the receiver
+type ReleaseSynPackage struct {
+	ClassId  string
+	Id       string
+	Receiver common.Address
+}
+
+var (
+	mirrorSynPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "ClassId", Type: "string"},
+		{Name: "Id", Type: "string"},
+		{Name: "Uri", Type: "string"},
+		{Name: "UriHash", Type: "string"},
+		{Name: "Receiver", Type: "address"},
+	})
+
+	mirrorSynPackageArgs = abi.Arguments{
+		{Type: mirrorSynPackageType},
+	}
+
+	mirrorAckPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "Status", Type: "uint8"},
+		{Name: "ClassId", Type: "string"},
+		{Name: "Id", Type: "string"},
+	})
+
+	mirrorAckPackageArgs = abi.Arguments{
+		{Type: mirrorAckPackageType},
+	}
+
+	releaseSynPackageType, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "ClassId", Type: "string"},
+		{Name: "Id", Type: "string"},
+		{Name: "Receiver", Type: "address"},
+	})
+
+	releaseSynPackageArgs = abi.Arguments{
+		{Type: releaseSynPackageType},
+	}
+)
+
+func (p MirrorSynPackage) Serialize() ([]byte, error) {
+	return mirrorSynPackageArgs.Pack(&p)
+}
+
+// DeserializeMirrorSynPackage decodes a MirrorSynPackage from its cross-chain payload.
+func DeserializeMirrorSynPackage(serializedPackage []byte) (*MirrorSynPackage, error) {
+	unpacked, err := mirrorSynPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, ok := abi.ConvertType(unpacked[0], MirrorSynPackage{}).(MirrorSynPackage)
+	if !ok {
+		return nil, fmt.Errorf("invalid mirror syn package")
+	}
+	return &pack, nil
+}
+
+func (p MirrorAckPackage) Serialize() ([]byte, error) {
+	return mirrorAckPackageArgs.Pack(&p)
+}
+
+// DeserializeMirrorAckPackage decodes a MirrorAckPackage from its cross-chain payload.
+func DeserializeMirrorAckPackage(serializedPackage []byte) (*MirrorAckPackage, error) {
+	unpacked, err := mirrorAckPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, ok := abi.ConvertType(unpacked[0], MirrorAckPackage{}).(MirrorAckPackage)
+	if !ok {
+		return nil, fmt.Errorf("invalid mirror ack package")
+	}
+	return &pack, nil
+}
+
+func (p ReleaseSynPackage) Serialize() ([]byte, error) {
+	return releaseSynPackageArgs.Pack(&p)
+}
+
+// DeserializeReleaseSynPackage decodes a ReleaseSynPackage from its cross-chain payload.
+func DeserializeReleaseSynPackage(serializedPackage []byte) (*ReleaseSynPackage, error) {
+	unpacked, err := releaseSynPackageArgs.Unpack(serializedPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, ok := abi.ConvertType(unpacked[0], ReleaseSynPackage{}).(ReleaseSynPackage)
+	if !ok {
+		return nil, fmt.Errorf("invalid release syn package")
+	}
+	return &pack, nil
+}
+
+// ValidateMirror checks that the given mirror has no integrity issues
+func ValidateMirror(mirror Mirror) error {
+	if len(mirror.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(mirror.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	if _, err := sdk.AccAddressFromHexUnsafe(mirror.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", mirror.Owner)
+	}
+
+	if !common.IsHexAddress(mirror.Receiver) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mirror receiver address (%s)", mirror.Receiver)
+	}
+
+	if _, ok := MirrorStatus_name[int32(mirror.Status)]; !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mirror status %d", mirror.Status)
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 
@@ -29,9 +30,10 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                   = AppModule{}
+	_ module.AppModuleBasic              = AppModuleBasic{}
+	_ module.AppModuleSimulation         = AppModule{}
+	_ gashubtypes.HasDefaultMsgGasParams = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the nft module.
@@ -47,7 +49,7 @@ func (AppModuleBasic) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	nft.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	nft.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
@@ -152,6 +154,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// DefaultMsgGasParams returns the default gas params of the nft msgs.
+func (AppModule) DefaultMsgGasParams() []gashubtypes.MsgGasParams {
+	return []gashubtypes.MsgGasParams{
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgSend{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgCreateClass{}), 2.4e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgMint{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgBurn{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgUpdateNFT{}), 1.2e3),
+		*gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&nft.MsgMirrorNFT{}), 1.2e3),
+	}
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -192,8 +206,9 @@ type NftInputs struct {
 	Cdc      codec.Codec
 	Registry cdctypes.InterfaceRegistry
 
-	AccountKeeper nft.AccountKeeper
-	BankKeeper    nft.BankKeeper
+	AccountKeeper    nft.AccountKeeper
+	BankKeeper       nft.BankKeeper
+	CrossChainKeeper nft.CrossChainKeeper `optional:"true"`
 }
 
 type NftOutputs struct {
@@ -204,7 +219,7 @@ type NftOutputs struct {
 }
 
 func ProvideModule(in NftInputs) NftOutputs {
-	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper, in.CrossChainKeeper)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return NftOutputs{NFTKeeper: k, Module: m}
@@ -2,6 +2,7 @@ package nft
 
 import (
 	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,7 +13,14 @@ const (
 	TypeMsgSend = "send"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+	_ sdk.Msg = &MsgMirrorNFT{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -41,3 +49,135 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromHexUnsafe(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// Class returns the class created by the message.
+func (m MsgCreateClass) Class() Class {
+	return Class{
+		Id:                 m.Id,
+		Name:               m.Name,
+		Symbol:             m.Symbol,
+		Description:        m.Description,
+		Uri:                m.Uri,
+		UriHash:            m.UriHash,
+		Owner:              m.Owner,
+		Minters:            m.Minters,
+		MaxSupply:          m.MaxSupply,
+		RoyaltyRecipient:   m.RoyaltyRecipient,
+		RoyaltyBasisPoints: m.RoyaltyBasisPoints,
+	}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return ValidateClass(m.Class())
+}
+
+// GetSigners returns the expected signers for MsgCreateClass.
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromHexUnsafe(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMint) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromHexUnsafe(m.Minter)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	_, err = sdk.AccAddressFromHexUnsafe(m.Receiver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromHexUnsafe(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurn) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromHexUnsafe(m.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBurn.
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromHexUnsafe(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromHexUnsafe(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateNFT.
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromHexUnsafe(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMirrorNFT) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromHexUnsafe(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if !common.IsHexAddress(m.Receiver) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mirror receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMirrorNFT.
+func (m MsgMirrorNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromHexUnsafe(m.Sender)
+	return []sdk.AccAddress{signer}
+}
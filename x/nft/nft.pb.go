@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MirrorStatus defines the status of the mirror of a nft on a destination chain.
+type MirrorStatus int32
+
+const (
+	// MIRROR_STATUS_PENDING defines a mirror package sent and not acknowledged yet.
+	MirrorStatus_MIRROR_STATUS_PENDING MirrorStatus = 0
+	// MIRROR_STATUS_MIRRORED defines a mirror acknowledged by the destination chain.
+	MirrorStatus_MIRROR_STATUS_MIRRORED MirrorStatus = 1
+)
+
+var MirrorStatus_name = map[int32]string{
+	0: "MIRROR_STATUS_PENDING",
+	1: "MIRROR_STATUS_MIRRORED",
+}
+
+var MirrorStatus_value = map[string]int32{
+	"MIRROR_STATUS_PENDING":  0,
+	"MIRROR_STATUS_MIRRORED": 1,
+}
+
+func (x MirrorStatus) String() string {
+	return proto.EnumName(MirrorStatus_name, int32(x))
+}
+
+func (MirrorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -39,6 +68,16 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// owner is the address of the account managing the class, which can mint nfts of the class. Optional
+	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// minters are the addresses of the accounts which can mint nfts of the class besides the owner. Optional
+	Minters []string `protobuf:"bytes,9,rep,name=minters,proto3" json:"minters,omitempty"`
+	// max_supply is the maximum number of nfts of the class, 0 meaning there is no limit. Optional
+	MaxSupply uint64 `protobuf:"varint,10,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// royalty_recipient is the address receiving the royalty of the sales of the nfts of the class. Optional
+	RoyaltyRecipient string `protobuf:"bytes,11,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	// royalty_basis_points is the royalty of the sales of the nfts of the class, in basis points. Optional
+	RoyaltyBasisPoints uint32 `protobuf:"varint,12,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +162,41 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Class) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *Class) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *Class) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (m *Class) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -205,35 +279,144 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// Mirror defines a nft escrowed by the module while it is mirrored on a destination chain.
+type Mirror struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account which mirrored the nft, it is refunded if the mirror fails
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// dest_chain_id is the id of the destination chain of the mirror
+	DestChainId uint32 `protobuf:"varint,4,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// receiver is the hex address of the receiver of the mirror on the destination chain
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// status is the status of the mirror
+	Status MirrorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmos.nft.v1beta1.MirrorStatus" json:"status,omitempty"`
+}
+
+func (m *Mirror) Reset()         { *m = Mirror{} }
+func (m *Mirror) String() string { return proto.CompactTextString(m) }
+func (*Mirror) ProtoMessage()    {}
+func (*Mirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *Mirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Mirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Mirror.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Mirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mirror.Merge(m, src)
+}
+func (m *Mirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *Mirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mirror proto.InternalMessageInfo
+
+func (m *Mirror) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *Mirror) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Mirror) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Mirror) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *Mirror) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Mirror) GetStatus() MirrorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MirrorStatus_MIRROR_STATUS_PENDING
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MirrorStatus", MirrorStatus_name, MirrorStatus_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*Mirror)(nil), "cosmos.nft.v1beta1.Mirror")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0x9a, 0xae, 0xdd, 0xde, 0xfe, 0xa8, 0x58, 0x63, 0x72, 0x2b, 0xa8, 0xa2, 0x9e, 0x2a,
+	0xa4, 0x25, 0x6c, 0x5c, 0x38, 0x70, 0xd9, 0x9f, 0x02, 0x3d, 0x6c, 0x4c, 0xe9, 0xb8, 0x70, 0x89,
+	0x9c, 0xc4, 0x6b, 0x2d, 0x9a, 0x38, 0xb2, 0x9d, 0xb1, 0x7c, 0x02, 0xae, 0x7c, 0x18, 0x0e, 0x7c,
+	0x04, 0x8e, 0x13, 0x27, 0x2e, 0x48, 0x68, 0xfd, 0x22, 0x28, 0x8e, 0x5b, 0x0d, 0x31, 0x31, 0x38,
+	0xc5, 0xbf, 0x3f, 0xcf, 0xca, 0xf3, 0xef, 0x3d, 0x78, 0x14, 0x71, 0x99, 0x70, 0xe9, 0xa5, 0x17,
+	0xca, 0xbb, 0xdc, 0x0b, 0xa9, 0x22, 0x7b, 0xe5, 0xd9, 0xcd, 0x04, 0x57, 0x1c, 0xa1, 0x4a, 0x75,
+	0x4b, 0xc6, 0xa8, 0xdd, 0xce, 0x84, 0xf3, 0xc9, 0x8c, 0x7a, 0xda, 0x11, 0xe6, 0x17, 0x1e, 0x49,
+	0x8b, 0xca, 0xde, 0xed, 0x54, 0xf6, 0x40, 0x23, 0xcf, 0xd4, 0x6a, 0xd0, 0xff, 0x62, 0xc3, 0xca,
+	0xd1, 0x8c, 0x48, 0x89, 0xb6, 0xa0, 0xce, 0x62, 0x6c, 0x39, 0xd6, 0x60, 0xcd, 0xaf, 0xb3, 0x18,
+	0x21, 0x68, 0xa4, 0x24, 0xa1, 0xb8, 0xae, 0x19, 0x7d, 0x46, 0x3b, 0xd0, 0x94, 0x45, 0x12, 0xf2,
+	0x19, 0xb6, 0x35, 0x6b, 0x10, 0x72, 0x60, 0x3d, 0xa6, 0x32, 0x12, 0x2c, 0x53, 0x8c, 0xa7, 0xb8,
+	0xa1, 0xc5, 0xdb, 0x14, 0x6a, 0x83, 0x9d, 0x0b, 0x86, 0x57, 0xb4, 0x52, 0x1e, 0x51, 0x07, 0x56,
+	0x73, 0xc1, 0x82, 0x29, 0x91, 0x53, 0xdc, 0xd4, 0x74, 0x2b, 0x17, 0xec, 0x35, 0x91, 0x53, 0x34,
+	0x80, 0x46, 0x4c, 0x14, 0xc1, 0x2d, 0xc7, 0x1a, 0xac, 0xef, 0x6f, 0xbb, 0x55, 0x67, 0xee, 0xa2,
+	0x33, 0xf7, 0x20, 0x2d, 0x7c, 0xed, 0x40, 0x2e, 0xac, 0xf0, 0x0f, 0x29, 0x15, 0x78, 0xb5, 0xbc,
+	0xe1, 0x10, 0x7f, 0xfb, 0xbc, 0xbb, 0x6d, 0xfa, 0x3b, 0x88, 0x63, 0x41, 0xa5, 0x1c, 0x2b, 0xc1,
+	0xd2, 0x89, 0x5f, 0xd9, 0xd0, 0x3e, 0xb4, 0x12, 0x96, 0x2a, 0x2a, 0x24, 0x5e, 0x73, 0xec, 0xbf,
+	0x56, 0x2c, 0x8c, 0xe8, 0x31, 0x40, 0x42, 0xae, 0x02, 0x99, 0x67, 0xd9, 0xac, 0xc0, 0xe0, 0x58,
+	0x83, 0x86, 0xbf, 0x96, 0x90, 0xab, 0xb1, 0x26, 0xd0, 0x10, 0x1e, 0x08, 0x5e, 0x90, 0x99, 0x2a,
+	0x02, 0x41, 0x23, 0x96, 0x31, 0x9a, 0x2a, 0xbc, 0x7e, 0xcf, 0xef, 0xb4, 0x4d, 0x89, 0xbf, 0xa8,
+	0x40, 0x4f, 0x61, 0x7b, 0x71, 0x4d, 0x48, 0x24, 0x93, 0x41, 0xc6, 0x59, 0xaa, 0x24, 0xde, 0x70,
+	0xac, 0xc1, 0xa6, 0x8f, 0x8c, 0x76, 0x58, 0x4a, 0x67, 0x5a, 0xe9, 0x7f, 0xb4, 0xc0, 0x3e, 0x7d,
+	0x79, 0x5e, 0x3e, 0x64, 0x54, 0x26, 0x18, 0x2c, 0xe3, 0x6b, 0x69, 0x3c, 0x8a, 0x4d, 0xa6, 0xf5,
+	0x65, 0xa6, 0x26, 0x05, 0xfb, 0xee, 0x14, 0x1a, 0x77, 0xa7, 0x00, 0xf7, 0xa5, 0xd0, 0xff, 0x61,
+	0x41, 0xf3, 0x84, 0x09, 0xc1, 0xc5, 0xff, 0xfc, 0xcc, 0x32, 0x3b, 0xfb, 0xdf, 0xb2, 0xeb, 0xc3,
+	0x66, 0x4c, 0xa5, 0x0a, 0xa2, 0x29, 0x61, 0x69, 0x79, 0x7f, 0x43, 0x3f, 0x4d, 0x39, 0x66, 0xea,
+	0xa8, 0xe4, 0x46, 0x31, 0xea, 0xc2, 0xaa, 0xa0, 0x11, 0x65, 0x97, 0x54, 0x98, 0x59, 0x5b, 0x62,
+	0xf4, 0x1c, 0x9a, 0x52, 0x11, 0x95, 0x4b, 0x3d, 0x6e, 0x5b, 0xfb, 0x8e, 0xfb, 0xe7, 0x16, 0xb9,
+	0x55, 0x1b, 0x63, 0xed, 0xf3, 0x8d, 0xff, 0xc9, 0x10, 0x36, 0x6e, 0xf3, 0xa8, 0x03, 0x0f, 0x4f,
+	0x46, 0xbe, 0xff, 0xc6, 0x0f, 0xc6, 0xe7, 0x07, 0xe7, 0x6f, 0xc7, 0xc1, 0xd9, 0xf0, 0xf4, 0x78,
+	0x74, 0xfa, 0xaa, 0x5d, 0x43, 0x5d, 0xd8, 0xf9, 0x5d, 0xaa, 0xd0, 0xf0, 0xb8, 0x6d, 0x1d, 0xbe,
+	0xf8, 0x7a, 0xd3, 0xb3, 0xae, 0x6f, 0x7a, 0xd6, 0xcf, 0x9b, 0x9e, 0xf5, 0x69, 0xde, 0xab, 0x5d,
+	0xcf, 0x7b, 0xb5, 0xef, 0xf3, 0x5e, 0xed, 0x5d, 0x7f, 0xc2, 0xd4, 0x34, 0x0f, 0xdd, 0x88, 0x27,
+	0x66, 0x3d, 0xcd, 0x67, 0x57, 0xc6, 0xef, 0xbd, 0xab, 0x72, 0xf3, 0xc3, 0xa6, 0x7e, 0xf8, 0x67,
+	0xbf, 0x06, 0x00, 0x2e, 0x05, 0xb5, 0x4d, 0x1a, 0x04, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +439,39 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -376,6 +592,67 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Mirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Mirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -421,6 +698,26 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.RoyaltyBasisPoints))
+	}
 	return n
 }
 
@@ -453,6 +750,37 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *Mirror) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovNft(uint64(m.DestChainId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovNft(uint64(m.Status))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -716,6 +1044,140 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
+			}
+			m.RoyaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -951,6 +1413,222 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Mirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MirrorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,7 +6,9 @@ package nft
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -736,6 +738,217 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRoyaltyInfoRequest is the request type for the Query/RoyaltyInfo RPC method
+type QueryRoyaltyInfoRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// sale_price is the price of the sale of the nft
+	SalePrice types.Coin `protobuf:"bytes,2,opt,name=sale_price,json=salePrice,proto3" json:"sale_price"`
+}
+
+func (m *QueryRoyaltyInfoRequest) Reset()         { *m = QueryRoyaltyInfoRequest{} }
+func (m *QueryRoyaltyInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoRequest) ProtoMessage()    {}
+func (*QueryRoyaltyInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyInfoRequest) GetSalePrice() types.Coin {
+	if m != nil {
+		return m.SalePrice
+	}
+	return types.Coin{}
+}
+
+// QueryRoyaltyInfoResponse is the response type for the Query/RoyaltyInfo RPC method
+type QueryRoyaltyInfoResponse struct {
+	// receiver is the address receiving the royalty, it is empty if the class has no royalty
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// royalty_amount is the royalty of the sale
+	RoyaltyAmount types.Coin `protobuf:"bytes,2,opt,name=royalty_amount,json=royaltyAmount,proto3" json:"royalty_amount"`
+}
+
+func (m *QueryRoyaltyInfoResponse) Reset()         { *m = QueryRoyaltyInfoResponse{} }
+func (m *QueryRoyaltyInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoResponse) ProtoMessage()    {}
+func (*QueryRoyaltyInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoResponse) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyInfoResponse) GetRoyaltyAmount() types.Coin {
+	if m != nil {
+		return m.RoyaltyAmount
+	}
+	return types.Coin{}
+}
+
+// QueryMirrorRequest is the request type for the Query/Mirror RPC method
+type QueryMirrorRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMirrorRequest) Reset()         { *m = QueryMirrorRequest{} }
+func (m *QueryMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMirrorRequest) ProtoMessage()    {}
+func (*QueryMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{16}
+}
+func (m *QueryMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMirrorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMirrorRequest.Merge(m, src)
+}
+func (m *QueryMirrorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMirrorRequest proto.InternalMessageInfo
+
+func (m *QueryMirrorRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryMirrorRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryMirrorResponse is the response type for the Query/Mirror RPC method
+type QueryMirrorResponse struct {
+	// mirror is the mirror of the nft
+	Mirror *Mirror `protobuf:"bytes,1,opt,name=mirror,proto3" json:"mirror,omitempty"`
+}
+
+func (m *QueryMirrorResponse) Reset()         { *m = QueryMirrorResponse{} }
+func (m *QueryMirrorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMirrorResponse) ProtoMessage()    {}
+func (*QueryMirrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{17}
+}
+func (m *QueryMirrorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMirrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMirrorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMirrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMirrorResponse.Merge(m, src)
+}
+func (m *QueryMirrorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMirrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMirrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMirrorResponse proto.InternalMessageInfo
+
+func (m *QueryMirrorResponse) GetMirror() *Mirror {
+	if m != nil {
+		return m.Mirror
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -751,58 +964,74 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryRoyaltyInfoRequest)(nil), "cosmos.nft.v1beta1.QueryRoyaltyInfoRequest")
+	proto.RegisterType((*QueryRoyaltyInfoResponse)(nil), "cosmos.nft.v1beta1.QueryRoyaltyInfoResponse")
+	proto.RegisterType((*QueryMirrorRequest)(nil), "cosmos.nft.v1beta1.QueryMirrorRequest")
+	proto.RegisterType((*QueryMirrorResponse)(nil), "cosmos.nft.v1beta1.QueryMirrorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xbb, 0x49, 0xd3, 0xc0, 0x54, 0xe2, 0xb1, 0x54, 0x90, 0x1a, 0xb0, 0x22, 0xf7, 0x91,
-	0xb4, 0x55, 0x77, 0xfb, 0x90, 0x38, 0x15, 0x0e, 0x45, 0x04, 0x71, 0x29, 0x10, 0x7a, 0x42, 0x42,
-	0xc8, 0x49, 0x9c, 0x60, 0x91, 0xda, 0x69, 0xd7, 0x06, 0xaa, 0xaa, 0x07, 0x7a, 0x40, 0x54, 0x5c,
-	0x90, 0xe8, 0xe7, 0xe1, 0xcc, 0xb1, 0x12, 0x17, 0x8e, 0xa8, 0xe5, 0x83, 0x20, 0xef, 0x8e, 0x53,
-	0x9b, 0x3a, 0x76, 0x15, 0x71, 0xaa, 0xec, 0xfd, 0xcf, 0xfc, 0x7f, 0xde, 0x79, 0x34, 0xa0, 0x37,
-	0x5d, 0xb1, 0xe5, 0x0a, 0xee, 0xb4, 0x3d, 0xfe, 0x6e, 0xb9, 0x61, 0x79, 0xe6, 0x32, 0xdf, 0xf6,
-	0xad, 0x9d, 0x5d, 0xd6, 0xdb, 0x71, 0x3d, 0x97, 0x52, 0x75, 0xce, 0x9c, 0xb6, 0xc7, 0xf0, 0x5c,
-	0x9b, 0xc7, 0x98, 0x86, 0x29, 0x2c, 0x25, 0xee, 0x87, 0xf6, 0xcc, 0x8e, 0xed, 0x98, 0x9e, 0xed,
-	0x3a, 0x2a, 0x5e, 0xbb, 0xd3, 0x71, 0xdd, 0x4e, 0xd7, 0xe2, 0x66, 0xcf, 0xe6, 0xa6, 0xe3, 0xb8,
-	0x9e, 0x3c, 0x14, 0xe1, 0x69, 0x82, 0x7b, 0xe0, 0x24, 0x4f, 0x8d, 0x1a, 0xdc, 0x78, 0x1e, 0x64,
-	0x5f, 0x37, 0xbb, 0xa6, 0xd3, 0xb4, 0xea, 0xd6, 0xb6, 0x6f, 0x09, 0x8f, 0x4e, 0xc2, 0xa5, 0x66,
-	0xd7, 0x14, 0xe2, 0xb5, 0xdd, 0x2a, 0x91, 0x32, 0xa9, 0x5e, 0xae, 0x17, 0xe5, 0xf3, 0x93, 0x16,
-	0x9d, 0x80, 0x82, 0xfb, 0xde, 0xb1, 0x76, 0x4a, 0x39, 0xf9, 0x5e, 0x3d, 0x18, 0x0c, 0x26, 0xe2,
-	0x79, 0x44, 0xcf, 0x75, 0x84, 0x45, 0x6f, 0xc2, 0x98, 0xb9, 0xe5, 0xfa, 0x8e, 0x27, 0xd3, 0x8c,
-	0xd6, 0xf1, 0xc9, 0x78, 0x00, 0xd7, 0xa5, 0xfe, 0x69, 0x10, 0x7d, 0x01, 0xd7, 0x2b, 0x90, 0xb3,
-	0x5b, 0x68, 0x99, 0xb3, 0x5b, 0xc6, 0x3c, 0xd0, 0x68, 0x3c, 0xba, 0xf5, 0xd9, 0x48, 0x94, 0x8d,
-	0xa3, 0xf6, 0x85, 0xdf, 0xeb, 0x75, 0x77, 0xb3, 0xcd, 0x8c, 0x45, 0xbc, 0x94, 0x30, 0x20, 0xe3,
-	0x5b, 0xbe, 0x10, 0xb8, 0x26, 0xf5, 0x1b, 0xb5, 0x4d, 0x31, 0xec, 0x0d, 0xd2, 0x1a, 0xc0, 0x59,
-	0x65, 0x4b, 0xf9, 0x32, 0xa9, 0x8e, 0xaf, 0xcc, 0x32, 0x6c, 0x8d, 0xa0, 0x0d, 0x98, 0xea, 0x19,
-	0xac, 0x21, 0x7b, 0x66, 0x76, 0xc2, 0x72, 0xd5, 0x23, 0x91, 0xc6, 0x21, 0xc1, 0xab, 0x55, 0x34,
-	0xc8, 0xbe, 0x00, 0xa3, 0x4e, 0xdb, 0x13, 0x25, 0x52, 0xce, 0x57, 0xc7, 0x57, 0x6e, 0xb1, 0xf3,
-	0x2d, 0xc7, 0x36, 0x6a, 0x9b, 0x75, 0x29, 0xa2, 0x8f, 0x63, 0x28, 0x39, 0x89, 0x52, 0xc9, 0x44,
-	0x51, 0x4e, 0x31, 0x96, 0x35, 0xb8, 0x1a, 0xa2, 0x0c, 0x51, 0xe3, 0xfb, 0x67, 0xd7, 0xda, 0xff,
-	0x8e, 0x39, 0xc8, 0x3b, 0x6d, 0x55, 0x80, 0x94, 0xcf, 0x08, 0x34, 0x06, 0xc3, 0x7b, 0x78, 0x18,
-	0xa4, 0xbf, 0x40, 0xd5, 0x1f, 0x61, 0x9b, 0xa0, 0x1e, 0x0d, 0x39, 0x14, 0xa4, 0x00, 0x2d, 0x27,
-	0x93, 0x2c, 0x55, 0x84, 0xd2, 0x19, 0xaf, 0xb0, 0x79, 0xe4, 0x4b, 0xab, 0x6f, 0x1c, 0x2f, 0x2f,
-	0x19, 0xba, 0xbc, 0x47, 0x04, 0x27, 0xad, 0x9f, 0x1f, 0x41, 0x57, 0x41, 0x7d, 0x89, 0x15, 0x16,
-	0x39, 0x05, 0x35, 0x54, 0xfe, 0xb7, 0x4a, 0xaf, 0x7c, 0x2f, 0x42, 0x41, 0x62, 0xd1, 0x23, 0x02,
-	0x45, 0xdc, 0x02, 0xb4, 0x92, 0x84, 0x90, 0xb0, 0x6f, 0xb4, 0x6a, 0xb6, 0x50, 0x99, 0x1a, 0xf7,
-	0x0e, 0x7e, 0xfe, 0xf9, 0x96, 0x5b, 0xa2, 0x8c, 0x27, 0xec, 0xb5, 0x86, 0x12, 0xf3, 0x3d, 0x39,
-	0x52, 0xfb, 0x7c, 0x2f, 0xac, 0xf5, 0x3e, 0x3d, 0x24, 0x50, 0x90, 0xcb, 0x82, 0xce, 0x0c, 0xf4,
-	0x8a, 0x2e, 0x23, 0x6d, 0x36, 0x4b, 0x86, 0x40, 0xcb, 0x12, 0x68, 0x81, 0xce, 0x25, 0x01, 0x49,
-	0x8e, 0x08, 0x06, 0xdf, 0x0b, 0x58, 0x3e, 0x13, 0x18, 0x53, 0xbb, 0x85, 0x0e, 0x76, 0x89, 0x6d,
-	0x2b, 0xad, 0x92, 0xa9, 0x43, 0x9c, 0x45, 0x89, 0x53, 0xa1, 0x33, 0x49, 0x38, 0x42, 0x6a, 0xa3,
-	0xd7, 0xe2, 0xc3, 0x68, 0xb0, 0x27, 0xe8, 0xf4, 0xc0, 0xfc, 0x91, 0xa5, 0xa6, 0xcd, 0x64, 0xa8,
-	0x90, 0xa1, 0x2c, 0x19, 0x34, 0x5a, 0xe2, 0xc9, 0xff, 0x7b, 0x04, 0x3d, 0x20, 0x90, 0xdf, 0xa8,
-	0x6d, 0xd2, 0xa9, 0xb4, 0x84, 0xa1, 0xeb, 0x74, 0xba, 0x08, 0x4d, 0x97, 0xa4, 0xe9, 0x3c, 0xad,
-	0x0e, 0x32, 0x3d, 0x57, 0x86, 0x4f, 0x04, 0x0a, 0x72, 0x1e, 0x52, 0x5a, 0x22, 0xba, 0x3c, 0x52,
-	0x5a, 0x22, 0xb6, 0x33, 0x0c, 0x26, 0x51, 0xaa, 0x74, 0x36, 0x09, 0x05, 0x47, 0x2f, 0x5a, 0x84,
-	0x8f, 0x04, 0x8a, 0x38, 0xce, 0x29, 0x23, 0x13, 0x5f, 0x28, 0x29, 0x23, 0xf3, 0xcf, 0x66, 0x30,
-	0xa6, 0x24, 0xce, 0x5d, 0x7a, 0x3b, 0x05, 0x67, 0x7d, 0xed, 0xc7, 0x89, 0x4e, 0x8e, 0x4f, 0x74,
-	0xf2, 0xfb, 0x44, 0x27, 0x5f, 0x4f, 0xf5, 0x91, 0xe3, 0x53, 0x7d, 0xe4, 0xd7, 0xa9, 0x3e, 0xf2,
-	0xd2, 0xe8, 0xd8, 0xde, 0x1b, 0xbf, 0xc1, 0x9a, 0xee, 0x56, 0x98, 0x40, 0xfd, 0x59, 0x14, 0xad,
-	0xb7, 0xfc, 0x43, 0x90, 0xad, 0x31, 0x26, 0x7f, 0x4d, 0xac, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x46, 0x70, 0x1f, 0xeb, 0x08, 0x00, 0x00,
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xf9, 0xd5, 0xed, 0xab, 0x58, 0xd8, 0x69, 0xc4, 0xa6, 0x06, 0x4c, 0xe4, 0xdd,
+	0x26, 0xd9, 0x86, 0xd8, 0xdb, 0x54, 0xe2, 0x54, 0x8a, 0x28, 0x22, 0xa8, 0x07, 0x4a, 0x09, 0x3d,
+	0x21, 0xa1, 0xca, 0x49, 0x9c, 0x60, 0x91, 0x78, 0x52, 0xdb, 0x29, 0x44, 0x55, 0x91, 0xe8, 0x01,
+	0x51, 0x21, 0x21, 0x24, 0x7a, 0xe0, 0x4f, 0xea, 0xb1, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0x9c, 0xf8,
+	0x2b, 0x90, 0x67, 0x9e, 0x53, 0x5b, 0x71, 0xec, 0x28, 0xda, 0x53, 0x6b, 0xcf, 0xf7, 0xbd, 0xef,
+	0xc7, 0xf3, 0xc6, 0x5f, 0x07, 0xe4, 0x0e, 0x73, 0x86, 0xcc, 0xd1, 0xac, 0x9e, 0xab, 0x9d, 0x6d,
+	0xb7, 0x0d, 0x57, 0xdf, 0xd6, 0x4e, 0xc7, 0x86, 0x3d, 0x51, 0x47, 0x36, 0x73, 0x19, 0xa5, 0x62,
+	0x5d, 0xb5, 0x7a, 0xae, 0x8a, 0xeb, 0xd2, 0x16, 0xd6, 0xb4, 0x75, 0xc7, 0x10, 0xe2, 0x69, 0xe9,
+	0x48, 0xef, 0x9b, 0x96, 0xee, 0x9a, 0xcc, 0x12, 0xf5, 0xd2, 0xdb, 0x7d, 0xc6, 0xfa, 0x03, 0x43,
+	0xd3, 0x47, 0xa6, 0xa6, 0x5b, 0x16, 0x73, 0xf9, 0xa2, 0xe3, 0xaf, 0x46, 0xb8, 0x7b, 0x4e, 0x62,
+	0x55, 0x0e, 0xfa, 0xf8, 0xcb, 0x1d, 0x66, 0xfa, 0xbd, 0x0b, 0x7d, 0xd6, 0x67, 0xfc, 0x5f, 0xcd,
+	0xfb, 0x4f, 0xdc, 0x55, 0x9a, 0xb0, 0xfe, 0x85, 0xc7, 0xb4, 0xaf, 0x0f, 0x74, 0xab, 0x63, 0xb4,
+	0x8c, 0xd3, 0xb1, 0xe1, 0xb8, 0x74, 0x03, 0x1e, 0x75, 0x06, 0xba, 0xe3, 0x9c, 0x98, 0xdd, 0x22,
+	0x29, 0x91, 0xea, 0x6a, 0x6b, 0x85, 0x5f, 0x1f, 0x74, 0x69, 0x01, 0x72, 0xec, 0x3b, 0xcb, 0xb0,
+	0x8b, 0x69, 0x7e, 0x5f, 0x5c, 0x28, 0x2a, 0x14, 0xc2, 0x7d, 0x9c, 0x11, 0xb3, 0x1c, 0x83, 0xbe,
+	0x09, 0x79, 0x7d, 0xc8, 0xc6, 0x96, 0xcb, 0xdb, 0x64, 0x5b, 0x78, 0xa5, 0xec, 0xc1, 0x13, 0xae,
+	0xff, 0xdc, 0xab, 0x5e, 0xc0, 0xf5, 0x31, 0xa4, 0xcd, 0x2e, 0x5a, 0xa6, 0xcd, 0xae, 0xb2, 0x05,
+	0x34, 0x58, 0x8f, 0x6e, 0x53, 0x36, 0x12, 0x64, 0xd3, 0x50, 0xfb, 0xe5, 0x78, 0x34, 0x1a, 0x4c,
+	0x92, 0xcd, 0x94, 0x3a, 0xac, 0x87, 0x0a, 0x12, 0x9e, 0xe5, 0x17, 0x02, 0x6f, 0x70, 0xfd, 0x61,
+	0xf3, 0xd8, 0x59, 0x76, 0x07, 0x69, 0x13, 0xe0, 0xe1, 0x3c, 0x14, 0x33, 0x25, 0x52, 0x5d, 0x6b,
+	0x94, 0x55, 0x3c, 0x50, 0xde, 0x50, 0x55, 0x71, 0xd2, 0x70, 0xb4, 0xea, 0x91, 0xde, 0xf7, 0xc7,
+	0xd5, 0x0a, 0x54, 0x2a, 0x57, 0x04, 0x9e, 0x04, 0x68, 0x90, 0xbd, 0x06, 0x59, 0xab, 0xe7, 0x3a,
+	0x45, 0x52, 0xca, 0x54, 0xd7, 0x1a, 0x4f, 0xd5, 0xd9, 0x83, 0xaa, 0x1e, 0x36, 0x8f, 0x5b, 0x5c,
+	0x44, 0x3f, 0x0d, 0xa1, 0xa4, 0x39, 0x4a, 0x25, 0x11, 0x45, 0x38, 0x85, 0x58, 0x76, 0xe1, 0x75,
+	0x1f, 0x65, 0x89, 0x19, 0x7f, 0xf0, 0xb0, 0xad, 0xd3, 0xe7, 0x78, 0x01, 0x19, 0xab, 0x27, 0x06,
+	0x10, 0xf3, 0x18, 0x9e, 0x46, 0x51, 0x71, 0x1f, 0x3e, 0xf6, 0xda, 0x2f, 0x30, 0xf5, 0x4f, 0x80,
+	0x06, 0xf5, 0x68, 0xa8, 0x41, 0x8e, 0x0b, 0xd0, 0x72, 0x23, 0xca, 0x52, 0x54, 0x08, 0x9d, 0xf2,
+	0x35, 0x1e, 0x1e, 0x7e, 0xd3, 0x98, 0x1a, 0x87, 0xc7, 0x4b, 0x96, 0x1e, 0xef, 0x35, 0x81, 0x42,
+	0xb8, 0x3f, 0x82, 0xee, 0x80, 0x78, 0x12, 0xc3, 0x1f, 0x72, 0x0c, 0xaa, 0xaf, 0x7c, 0x75, 0x93,
+	0x76, 0xe1, 0x29, 0xa7, 0x6a, 0xb1, 0x89, 0x3e, 0x70, 0x27, 0x07, 0x56, 0x8f, 0x2d, 0x30, 0xf1,
+	0x3d, 0x00, 0x47, 0x1f, 0x18, 0x27, 0x23, 0xdb, 0xec, 0x18, 0x68, 0xbf, 0x11, 0xb2, 0x9f, 0x72,
+	0x33, 0xd3, 0xda, 0xcf, 0xde, 0xfc, 0xfd, 0x6e, 0xaa, 0xb5, 0xea, 0x95, 0x1c, 0x79, 0x15, 0xca,
+	0x0f, 0x50, 0x9c, 0x75, 0xc5, 0xfd, 0x90, 0xe0, 0x91, 0x6d, 0x74, 0x0c, 0xf3, 0x6c, 0x1a, 0x07,
+	0xd3, 0x6b, 0xda, 0x84, 0xc7, 0xb6, 0x28, 0x39, 0xc1, 0x37, 0x7a, 0x41, 0xef, 0xd7, 0xb0, 0xec,
+	0x23, 0xf1, 0xe6, 0x7f, 0x88, 0x47, 0xe6, 0x33, 0xd3, 0xb6, 0xd9, 0x32, 0x31, 0x76, 0x00, 0xeb,
+	0xa1, 0x06, 0xc8, 0xde, 0x80, 0xfc, 0x90, 0xdf, 0xc1, 0x83, 0x22, 0x45, 0x8d, 0x12, 0x6b, 0x50,
+	0xd9, 0xf8, 0x6f, 0x15, 0x72, 0xbc, 0x17, 0xbd, 0x26, 0xb0, 0x82, 0x39, 0x4c, 0x2b, 0x51, 0x95,
+	0x11, 0x89, 0x2f, 0x55, 0x93, 0x85, 0x02, 0x4e, 0x79, 0xff, 0xf2, 0xcf, 0x7f, 0x7f, 0x4f, 0xbf,
+	0xa4, 0xaa, 0x16, 0xf1, 0x3d, 0x6a, 0x0b, 0xb1, 0x76, 0xce, 0x43, 0xed, 0x42, 0x3b, 0xf7, 0x77,
+	0xe2, 0x82, 0x5e, 0x11, 0xc8, 0xf1, 0xb8, 0xa6, 0x9b, 0x73, 0xbd, 0x82, 0x9f, 0x03, 0xa9, 0x9c,
+	0x24, 0x43, 0xa0, 0x6d, 0x0e, 0x54, 0xa3, 0x2f, 0xa2, 0x80, 0x38, 0x47, 0x00, 0x43, 0x3b, 0xf7,
+	0x58, 0x7e, 0x26, 0x90, 0x17, 0xe9, 0x4e, 0xe7, 0xbb, 0x84, 0xbe, 0x17, 0x52, 0x25, 0x51, 0x87,
+	0x38, 0x75, 0x8e, 0x53, 0xa1, 0x9b, 0x51, 0x38, 0x0e, 0xd7, 0x06, 0xb7, 0x65, 0x0c, 0x59, 0x2f,
+	0xa9, 0xe9, 0xf3, 0xb9, 0xfd, 0x03, 0x9f, 0x15, 0x69, 0x33, 0x41, 0x85, 0x0c, 0x25, 0xce, 0x20,
+	0xd1, 0xa2, 0x16, 0xfd, 0x9b, 0xc1, 0xa1, 0x97, 0x04, 0x32, 0x87, 0xcd, 0x63, 0xfa, 0x2c, 0xae,
+	0xa1, 0xef, 0xfa, 0x3c, 0x5e, 0x84, 0xa6, 0x2f, 0xb9, 0xe9, 0x16, 0xad, 0xce, 0x33, 0x9d, 0x19,
+	0xc3, 0x4f, 0x04, 0x72, 0x3c, 0x91, 0x62, 0x8e, 0x44, 0x30, 0xbe, 0xa5, 0x72, 0x92, 0x0c, 0x51,
+	0x54, 0x8e, 0x52, 0xa5, 0xe5, 0x28, 0x14, 0x0c, 0xbf, 0xe0, 0x10, 0x7e, 0x24, 0xb0, 0x82, 0x81,
+	0x1a, 0xf3, 0xca, 0x84, 0x23, 0x5d, 0xaa, 0x26, 0x0b, 0x11, 0xe7, 0x19, 0xc7, 0x79, 0x87, 0xbe,
+	0x15, 0x83, 0x43, 0xff, 0x20, 0xb0, 0x16, 0x08, 0x32, 0x5a, 0x9b, 0xdb, 0x7e, 0x36, 0x64, 0xa5,
+	0xf7, 0x16, 0x13, 0x2f, 0xb2, 0x3d, 0x18, 0x71, 0xc1, 0xed, 0xf9, 0x95, 0x40, 0x5e, 0xc4, 0x4d,
+	0xcc, 0xeb, 0x12, 0x0a, 0x41, 0xa9, 0x92, 0xa8, 0x43, 0x96, 0x1d, 0xce, 0x52, 0xa7, 0xb5, 0x28,
+	0x16, 0x91, 0x6d, 0x33, 0x07, 0x67, 0x7f, 0xf7, 0xe6, 0x4e, 0x26, 0xb7, 0x77, 0x32, 0xf9, 0xe7,
+	0x4e, 0x26, 0xbf, 0xdd, 0xcb, 0xa9, 0xdb, 0x7b, 0x39, 0xf5, 0xd7, 0xbd, 0x9c, 0xfa, 0x4a, 0xe9,
+	0x9b, 0xee, 0x37, 0xe3, 0xb6, 0xda, 0x61, 0x43, 0xbf, 0xa1, 0xf8, 0x53, 0x77, 0xba, 0xdf, 0x6a,
+	0xdf, 0x7b, 0xdd, 0xdb, 0x79, 0xfe, 0xdb, 0x77, 0xe7, 0xff, 0x01, 0x00, 0xd0, 0x2f, 0x23, 0xaa,
+	0xcf, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -832,6 +1061,11 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty of the sale of a nft of a class at a given price, same as royaltyInfo in
+	// ERC2981
+	RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error)
+	// Mirror queries the mirror of a nft escrowed by the module
+	Mirror(ctx context.Context, in *QueryMirrorRequest, opts ...grpc.CallOption) (*QueryMirrorResponse, error)
 }
 
 type queryClient struct {
@@ -905,6 +1139,24 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error) {
+	out := new(QueryRoyaltyInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/RoyaltyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mirror(ctx context.Context, in *QueryMirrorRequest, opts ...grpc.CallOption) (*QueryMirrorResponse, error) {
+	out := new(QueryMirrorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Mirror", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -922,6 +1174,11 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty of the sale of a nft of a class at a given price, same as royaltyInfo in
+	// ERC2981
+	RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error)
+	// Mirror queries the mirror of a nft escrowed by the module
+	Mirror(context.Context, *QueryMirrorRequest) (*QueryMirrorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -949,6 +1206,12 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) RoyaltyInfo(ctx context.Context, req *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyInfo not implemented")
+}
+func (*UnimplementedQueryServer) Mirror(ctx context.Context, req *QueryMirrorRequest) (*QueryMirrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mirror not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1080,6 +1343,42 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoyaltyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/RoyaltyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyInfo(ctx, req.(*QueryRoyaltyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMirrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Mirror",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mirror(ctx, req.(*QueryMirrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1112,6 +1411,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "RoyaltyInfo",
+			Handler:    _Query_RoyaltyInfo_Handler,
+		},
+		{
+			MethodName: "Mirror",
+			Handler:    _Query_Mirror_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1626,61 +1933,213 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRoyaltyInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryRoyaltyInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.SalePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+func (m *QueryRoyaltyInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRoyaltyInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.RoyaltyAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMirrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryMirrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMirrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMirrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMirrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMirrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mirror != nil {
+		{
+			size, err := m.Mirror.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QueryOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryOwnerResponse) Size() (n int) {
@@ -1849,6 +2308,66 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRoyaltyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SalePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoyaltyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RoyaltyAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMirrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMirrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mirror != nil {
+		l = m.Mirror.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3229,6 +3748,436 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMirrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMirrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMirrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMirrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMirrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMirrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mirror == nil {
+				m.Mirror = &Mirror{}
+			}
+			if err := m.Mirror.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
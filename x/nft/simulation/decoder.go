@@ -38,6 +38,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.MirrorKey):
+			var mirrorA, mirrorB nft.Mirror
+			cdc.MustUnmarshal(kvA.Value, &mirrorA)
+			cdc.MustUnmarshal(kvB.Value, &mirrorB)
+			return fmt.Sprintf("%v\n%v", mirrorA, mirrorB)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
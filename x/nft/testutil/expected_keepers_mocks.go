@@ -5,6 +5,7 @@
 package testutil
 
 import (
+	big "math/big"
 	reflect "reflect"
 
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -99,3 +100,69 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
+
+// MockCrossChainKeeper is a mock of CrossChainKeeper interface.
+type MockCrossChainKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossChainKeeperMockRecorder
+}
+
+// MockCrossChainKeeperMockRecorder is the mock recorder for MockCrossChainKeeper.
+type MockCrossChainKeeperMockRecorder struct {
+	mock *MockCrossChainKeeper
+}
+
+// NewMockCrossChainKeeper creates a new mock instance.
+func NewMockCrossChainKeeper(ctrl *gomock.Controller) *MockCrossChainKeeper {
+	mock := &MockCrossChainKeeper{ctrl: ctrl}
+	mock.recorder = &MockCrossChainKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossChainKeeper) EXPECT() *MockCrossChainKeeperMockRecorder {
+	return m.recorder
+}
+
+// CreateRawIBCPackageWithFee mocks base method.
+func (m *MockCrossChainKeeper) CreateRawIBCPackageWithFee(ctx types.Context, destChainId types.ChainID, channelID types.ChannelID, packageType types.CrossChainPackageType, packageLoad []byte, relayerFee, ackRelayerFee *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRawIBCPackageWithFee", ctx, destChainId, channelID, packageType, packageLoad, relayerFee, ackRelayerFee)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRawIBCPackageWithFee indicates an expected call of CreateRawIBCPackageWithFee.
+func (mr *MockCrossChainKeeperMockRecorder) CreateRawIBCPackageWithFee(ctx, destChainId, channelID, packageType, packageLoad, relayerFee, ackRelayerFee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRawIBCPackageWithFee", reflect.TypeOf((*MockCrossChainKeeper)(nil).CreateRawIBCPackageWithFee), ctx, destChainId, channelID, packageType, packageLoad, relayerFee, ackRelayerFee)
+}
+
+// IsDestChainSupported mocks base method.
+func (m *MockCrossChainKeeper) IsDestChainSupported(chainID types.ChainID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDestChainSupported", chainID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDestChainSupported indicates an expected call of IsDestChainSupported.
+func (mr *MockCrossChainKeeperMockRecorder) IsDestChainSupported(chainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDestChainSupported", reflect.TypeOf((*MockCrossChainKeeper)(nil).IsDestChainSupported), chainID)
+}
+
+// RegisterChannel mocks base method.
+func (m *MockCrossChainKeeper) RegisterChannel(name string, id types.ChannelID, app types.CrossChainApplication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterChannel", name, id, app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterChannel indicates an expected call of RegisterChannel.
+func (mr *MockCrossChainKeeperMockRecorder) RegisterChannel(name, id, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterChannel", reflect.TypeOf((*MockCrossChainKeeper)(nil).RegisterChannel), name, id, app)
+}
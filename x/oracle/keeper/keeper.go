@@ -5,15 +5,14 @@ import (
 	"encoding/hex"
 
 	sdkerrors "cosmossdk.io/errors"
-	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/votepool"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	"github.com/willf/bitset"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -25,13 +24,20 @@ type Keeper struct {
 	CrossChainKeeper types.CrossChainKeeper
 	BankKeeper       types.BankKeeper
 
+	// DistributionKeeper is optional, the validator reward share of the relayer rewards is only
+	// allocated to the validators when it is set
+	DistributionKeeper types.DistributionKeeper
+
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 	authority        string
+
+	attestation *stakingkeeper.ValidatorAttestation
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, feeCollector, authority string,
 	crossChainKeeper types.CrossChainKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
+	distributionKeeper types.DistributionKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:              cdc,
@@ -42,6 +48,10 @@ func NewKeeper(
 		CrossChainKeeper: crossChainKeeper,
 		BankKeeper:       bankKeeper,
 		StakingKeeper:    stakingKeeper,
+
+		DistributionKeeper: distributionKeeper,
+
+		attestation: stakingkeeper.NewValidatorAttestation(stakingKeeper, stakingkeeper.DefaultAttestationThreshold),
 	}
 }
 
@@ -80,6 +90,12 @@ func (k Keeper) GetRelayerRewardShare(ctx sdk.Context) uint32 {
 	return params.RelayerRewardShare
 }
 
+// GetValidatorRewardShare returns the share of the relayer rewards allocated to the validators
+func (k Keeper) GetValidatorRewardShare(ctx sdk.Context) uint32 {
+	params := k.GetParams(ctx)
+	return params.ValidatorRewardShare
+}
+
 // IsRelayerValid returns true if the relayer is valid and allowed to send the claim message
 func (k Keeper) IsRelayerValid(ctx sdk.Context, relayer sdk.AccAddress, validators []stakingtypes.Validator, claimTimestamp uint64, claimSrcChain types.ClaimSrcChain) (bool, error) {
 	var validatorIndex int64 = -1
@@ -92,6 +108,19 @@ func (k Keeper) IsRelayerValid(ctx sdk.Context, relayer sdk.AccAddress, validato
 		}
 	}
 
+	// the previous relayer address of a validator is still accepted during the overlap window of its
+	// key rotation
+	if validatorIndex < 0 {
+		for index, validator := range validators {
+			prevKeys, found := k.StakingKeeper.GetValidatorPreviousKeys(ctx, validator.GetOperator())
+			if found && prevKeys.PrevRelayerAddress == relayer.String() {
+				validatorIndex = int64(index)
+				vldr = validator
+				break
+			}
+		}
+	}
+
 	if validatorIndex < 0 {
 		return false, sdkerrors.Wrapf(types.ErrNotRelayer, "sender(%s) is not a relayer", relayer.String())
 	}
@@ -160,44 +189,34 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrRelayerNotInTurn, "relayer(%s) is not in turn", claim.FromAddress)
 	}
 
-	validatorsBitSet := bitset.From(claim.VoteAddressSet)
-	if validatorsBitSet.Count() > uint(len(validators)) {
-		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrValidatorSet, "number of validator set is larger than validators")
+	msg := claim.GetBlsSignBytes()
+	signers, err := k.attestation.Verify(ctx, ctx.BlockHeight(), claim.VoteAddressSet, claim.AggSignature, msg[:], votepool.DST)
+	if err != nil {
+		return sdk.AccAddress{}, nil, attestationError(err)
 	}
 
-	signedRelayers := make([]sdk.AccAddress, 0, validatorsBitSet.Count())
-	votedPubKeys := make([]*bls.PublicKey, 0, validatorsBitSet.Count())
-	for index, val := range validators {
-		if !validatorsBitSet.Test(uint(index)) {
-			continue
-		}
-
+	signedRelayers := make([]sdk.AccAddress, 0, len(signers))
+	for _, val := range signers {
 		signedRelayers = append(signedRelayers, sdk.MustAccAddressFromHex(val.RelayerAddress))
-
-		votePubKey, err := bls.UnmarshalPublicKey(val.BlsKey)
-		if err != nil {
-			return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsPubKey, "BLS public key converts failed: %v", err)
-		}
-		votedPubKeys = append(votedPubKeys, votePubKey)
 	}
 
-	// The valid voted validators should be no less than 2/3 validators.
-	if len(votedPubKeys) <= len(validators)*2/3 {
-		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsVotesNotEnough, "not enough validators voted, need: %d, voted: %d", len(validators)*2/3, len(votedPubKeys))
-	}
-
-	// Verify the aggregated signature.
-	aggSig, err := bls.UnmarshalSignature(claim.AggSignature)
-	if err != nil {
-		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "BLS signature converts failed: %v", err)
-	}
+	return relayer, signedRelayers, nil
+}
 
-	msg := claim.GetBlsSignBytes()
-	if !aggSig.VerifyAggregated(votedPubKeys, msg[:], votepool.DST) {
-		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "signature verify failed")
+// attestationError converts the errors of the validator attestation into the errors of the oracle module,
+// whose codes are returned by the claims
+func attestationError(err error) error {
+	for _, e := range []struct{ attestationErr, oracleErr *sdkerrors.Error }{
+		{stakingtypes.ErrAttestationValidatorSet, types.ErrValidatorSet},
+		{stakingtypes.ErrAttestationBlsPubKey, types.ErrBlsPubKey},
+		{stakingtypes.ErrAttestationVotesNotEnough, types.ErrBlsVotesNotEnough},
+		{stakingtypes.ErrAttestationInvalidSignature, types.ErrInvalidBlsSignature},
+	} {
+		if e.attestationErr.Is(err) {
+			return sdkerrors.Wrap(e.oracleErr, err.Error())
+		}
 	}
-
-	return relayer, signedRelayers, nil
+	return err
 }
 
 // GetParams returns the current params
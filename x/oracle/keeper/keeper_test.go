@@ -43,6 +43,8 @@ type TestSuite struct {
 	crossChainKeeper *types.MockCrossChainKeeper
 	stakingKeeper    *types.MockStakingKeeper
 
+	distributionKeeper *types.MockDistributionKeeper
+
 	msgServer   types.MsgServer
 	queryClient types.QueryClient
 }
@@ -58,12 +60,14 @@ func (s *TestSuite) SetupTest() {
 	crossChainKeeper := types.NewMockCrossChainKeeper(ctrl)
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
+	distributionKeeper := types.NewMockDistributionKeeper(ctrl)
 
 	s.bankKeeper = bankKeeper
 	s.crossChainKeeper = crossChainKeeper
 	s.stakingKeeper = stakingKeeper
+	s.distributionKeeper = distributionKeeper
 
-	s.oracleKeeper = keeper.NewKeeper(encCfg.Codec, key, "fee", types.ModuleName, crossChainKeeper, bankKeeper, stakingKeeper)
+	s.oracleKeeper = keeper.NewKeeper(encCfg.Codec, key, "fee", types.ModuleName, crossChainKeeper, bankKeeper, stakingKeeper, distributionKeeper)
 
 	s.oracleKeeper.SetParams(s.ctx, types.DefaultParams())
 
@@ -162,6 +166,68 @@ func (s *TestSuite) TestProcessClaim() {
 	s.Require().Contains(err.Error(), "BLS signature converts failed")
 }
 
+func (s *TestSuite) TestCheckClaimDuringKeyRotationOverlap() {
+	s.oracleKeeper.SetParams(s.ctx, types.Params{
+		RelayerTimeout:     5,
+		RelayerRewardShare: 50,
+		RelayerInterval:    600,
+	})
+
+	newValidators, oldBlsKeys := createValidators(s.T())
+
+	// the first validator rotated its relayer address and BLS key
+	rotatedValidator := newValidators[0]
+	newBlsKey, _ := bls.GenerateBlsKey()
+	newValidators[0].BlsKey = newBlsKey.PublicKey().Marshal()
+	newValidators[0].RelayerAddress = sdk.AccAddress(newBlsKey.PublicKey().Marshal()[:20]).String()
+	prevKeys := stakingtypes.KeyRotationRecord{
+		ValidatorAddress:   rotatedValidator.OperatorAddress,
+		PrevRelayerAddress: rotatedValidator.RelayerAddress,
+		PrevBlsKey:         rotatedValidator.BlsKey,
+	}
+
+	s.crossChainKeeper.EXPECT().GetDestOpChainID().Return(sdk.ChainID(204)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestPolygonChainID().Return(sdk.ChainID(137)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestScrollChainID().Return(sdk.ChainID(534352)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestLineaChainID().Return(sdk.ChainID(59144)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestMantleChainID().Return(sdk.ChainID(5000)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestArbitrumChainID().Return(sdk.ChainID(42161)).AnyTimes()
+	s.crossChainKeeper.EXPECT().GetDestOptimismChainID().Return(sdk.ChainID(10)).AnyTimes()
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).Return(stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	}, true).AnyTimes()
+
+	// the claim is relayed by the previous relayer with votes signed by the previous BLS key
+	msgClaim := types.MsgClaim{
+		FromAddress: rotatedValidator.RelayerAddress,
+		SrcChainId:  1,
+		DestChainId: 2,
+		Sequence:    1,
+		Timestamp:   1992,
+		Payload:     []byte("test payload"),
+	}
+	valBitSet := bitset.New(256)
+	for i := range newValidators {
+		valBitSet.Set(uint(i))
+	}
+	blsSignBytes := msgClaim.GetBlsSignBytes()
+	msgClaim.VoteAddressSet = valBitSet.Bytes()
+	msgClaim.AggSignature = otestutil.GenerateBlsSig(oldBlsKeys, blsSignBytes[:])
+	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
+
+	// the previous keys are accepted during the overlap window
+	overlap := s.stakingKeeper.EXPECT().GetValidatorPreviousKeys(gomock.Any(), rotatedValidator.GetOperator()).Return(prevKeys, true).AnyTimes()
+	s.stakingKeeper.EXPECT().GetValidatorPreviousKeys(gomock.Any(), gomock.Any()).Return(stakingtypes.KeyRotationRecord{}, false).AnyTimes()
+	_, _, err := s.oracleKeeper.CheckClaim(s.ctx, &msgClaim)
+	s.Require().NoError(err)
+
+	// the previous keys are rejected after the overlap window
+	overlap.Return(stakingtypes.KeyRotationRecord{}, false)
+	_, _, err = s.oracleKeeper.CheckClaim(s.ctx, &msgClaim)
+	s.Require().ErrorIs(err, types.ErrNotRelayer)
+}
+
 func (s *TestSuite) TestKeeper_IsRelayerValid() {
 	s.oracleKeeper.SetParams(s.ctx, types.Params{
 		RelayerTimeout:     5,
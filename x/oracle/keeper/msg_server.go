@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/bsc/rlp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
 )
 
@@ -31,25 +32,7 @@ type msgServer struct {
 	Keeper
 }
 
-type MessagesType [][]byte
-
 var (
-	Uint8, _   = abi.NewType("uint8", "", nil)
-	Bytes, _   = abi.NewType("bytes", "", nil)
-	Uint256, _ = abi.NewType("uint256", "", nil)
-	Address, _ = abi.NewType("address", "", nil)
-
-	MessageTypeArgs = abi.Arguments{
-		{Name: "ChannelId", Type: Uint8},
-		{Name: "MsgBytes", Type: Bytes},
-		{Name: "RelayFee", Type: Uint256},
-		{Name: "AckRelayFee", Type: Uint256},
-		{Name: "Sender", Type: Address},
-	}
-
-	MessagesAbiDefinition = `[{ "name" : "method", "type": "function", "outputs": [{"type": "bytes[]"}]}]`
-	MessagesAbi, _        = abi.JSON(strings.NewReader(MessagesAbiDefinition))
-
 	AckMessagesAbiDefinition = `[{ "name" : "method", "type": "function", "inputs": [{"type": "bytes[]"}]}]`
 	AckMessagesAbi, _        = abi.JSON(strings.NewReader(AckMessagesAbiDefinition))
 )
@@ -160,6 +143,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 	totalDistributed, otherRelayerReward := sdkmath.ZeroInt(), sdkmath.ZeroInt()
 
 	relayerRewardShare := k.GetRelayerRewardShare(ctx)
+	validatorRewardShare := k.GetValidatorRewardShare(ctx)
 
 	// calculate the reward to distribute to each other relayer
 	if len(otherRelayers) > 0 {
@@ -169,11 +153,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 	bondDenom := k.StakingKeeper.BondDenom(ctx)
 	if otherRelayerReward.IsPositive() {
 		for _, signedRelayer := range otherRelayers {
-			err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx,
-				crosschaintypes.ModuleName,
-				signedRelayer,
-				sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: otherRelayerReward}},
-			)
+			err := k.sendRelayerReward(ctx, signedRelayer, sdk.Coin{Denom: bondDenom, Amount: otherRelayerReward}, validatorRewardShare)
 			if err != nil {
 				return err
 			}
@@ -183,11 +163,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 
 	remainingReward := relayerFee.Sub(totalDistributed)
 	if remainingReward.IsPositive() {
-		err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx,
-			crosschaintypes.ModuleName,
-			relayer,
-			sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: remainingReward}},
-		)
+		err := k.sendRelayerReward(ctx, relayer, sdk.Coin{Denom: bondDenom, Amount: remainingReward}, validatorRewardShare)
 		if err != nil {
 			return err
 		}
@@ -198,6 +174,30 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 	return nil
 }
 
+// sendRelayerReward sends the reward to the relayer. If the distribution keeper is set, the validator
+// reward share of it is allocated to the rewards of the validator of the relayer instead, it is then
+// subject to the commission of the validator and shared with its delegators.
+func (k Keeper) sendRelayerReward(ctx sdk.Context, relayer sdk.AccAddress, reward sdk.Coin, validatorRewardShare uint32) error {
+	if k.DistributionKeeper != nil && validatorRewardShare > 0 {
+		validator, found := k.StakingKeeper.GetValidatorByRelayerAddr(ctx, relayer)
+		validatorReward := reward.Amount.Mul(sdkmath.NewInt(int64(validatorRewardShare))).Quo(sdkmath.NewInt(100))
+		if found && validatorReward.IsPositive() {
+			validatorCoins := sdk.Coins{sdk.Coin{Denom: reward.Denom, Amount: validatorReward}}
+			err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, crosschaintypes.ModuleName, distrtypes.ModuleName, validatorCoins)
+			if err != nil {
+				return err
+			}
+			k.DistributionKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(validatorCoins...))
+			reward.Amount = reward.Amount.Sub(validatorReward)
+		}
+	}
+
+	if !reward.Amount.IsPositive() {
+		return nil
+	}
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, relayer, sdk.Coins{reward})
+}
+
 func (k Keeper) handleMultiMessagePackage(
 	ctx sdk.Context,
 	pack *types.Package,
@@ -216,7 +216,7 @@ func (k Keeper) handleMultiMessagePackage(
 		}
 	}()
 
-	messages, err := DecodeMultiMessage(pack.Payload[sdk.SynPackageHeaderLength+sdk.PackageTypeLength:])
+	messages, err := types.DecodeMultiMessage(pack.Payload[sdk.SynPackageHeaderLength+sdk.PackageTypeLength:])
 	if err != nil {
 		return true, sdk.ExecuteResult{
 			Err: err,
@@ -227,7 +227,7 @@ func (k Keeper) handleMultiMessagePackage(
 	result = sdk.ExecuteResult{}
 	ackMessages := make([][]byte, 0)
 	for i, message := range messages {
-		channelId, msgBytes, ackRelayFee, err := DecodeMessage(message)
+		channelId, msgBytes, ackRelayFee, err := types.DecodeMessage(message)
 		if err != nil {
 			return true, sdk.ExecuteResult{
 				Err: err,
@@ -408,57 +408,6 @@ func executeClaim(
 	return crash, result
 }
 
-func DecodeMultiMessage(multiMessagePayload []byte) (messages [][]byte, err error) {
-	out, err := MessagesAbi.Unpack("method", multiMessagePayload)
-	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "messages unpack failed, payload=%s", hex.EncodeToString(multiMessagePayload))
-	}
-
-	unpacked := abi.ConvertType(out[0], MessagesType{})
-	messages, ok := unpacked.(MessagesType)
-	if !ok {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "messages ConvertType failed, payload=%v", multiMessagePayload)
-	}
-
-	if len(messages) == 0 {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "empty messages, payload=%v", multiMessagePayload)
-	}
-
-	return messages, nil
-}
-
-func DecodeMessage(message []byte) (channelId uint8, msgBytes []byte, ackRelayFee *big.Int, err error) {
-	unpacked, err := MessageTypeArgs.Unpack(message)
-	if err != nil || len(unpacked) != 5 {
-		return 0, nil, nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "decode message error, message=%v, error: %s", message, err)
-	}
-
-	channelIdType := abi.ConvertType(unpacked[0], uint8(0))
-	msgBytesType := abi.ConvertType(unpacked[1], []byte{})
-	ackRelayFeeType := abi.ConvertType(unpacked[3], big.NewInt(0))
-
-	channelId, ok := channelIdType.(uint8)
-	if !ok {
-		return 0, nil, nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "decode channelId error, message=%v, error: %v", message, err)
-	}
-
-	msgBytes, ok = msgBytesType.([]byte)
-	if !ok {
-		return 0, nil, nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "decode msgBytes error, message=%v, error: %v", message, err)
-	}
-
-	ackRelayFee, ok = ackRelayFeeType.(*big.Int)
-	if !ok {
-		return 0, nil, nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "decode ackRelayFee error, message=%v, error: %v", message, err)
-	}
-
-	if len(ackRelayFee.Bytes()) > 32 {
-		return 0, nil, nil, sdkerrors.Wrapf(types.ErrInvalidMultiMessage, "ackRelayFee too large, ackRelayFee=%v ", ackRelayFee.Bytes())
-	}
-
-	return channelId, msgBytes, ackRelayFee, nil
-}
-
 func EncodeAckMessage(channelId uint8, ackRelayFee *big.Int, result []byte) (ackMessage []byte) {
 	resultPayloadLength := len(result)
 	ackMessage = make([]byte, ChannelIdLength+AckRelayFeeLength+resultPayloadLength)
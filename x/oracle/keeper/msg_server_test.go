@@ -13,6 +13,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/bsc/rlp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
 	"github.com/cosmos/cosmos-sdk/x/oracle/testutil"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
@@ -40,6 +42,47 @@ func (ta *DummyCrossChainApp) ExecuteFailAckPackage(ctx sdk.Context, header *sdk
 }
 
 func (s *TestSuite) TestClaim() {
+	msgClaim, _ := s.prepareClaim(big.NewInt(1))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := s.msgServer.Claim(s.ctx, &msgClaim)
+	s.Require().Nil(err, "process claim msg error")
+}
+
+func (s *TestSuite) TestClaimValidatorRewardShare() {
+	params := types.DefaultParams()
+	params.ValidatorRewardShare = 20
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+	msgClaim, validators := s.prepareClaim(big.NewInt(100))
+
+	// the relayer gets half of the fee and the other relayers share the other half, the validator
+	// reward share of each reward is allocated to the validator of the relayer, if it is found
+	s.stakingKeeper.EXPECT().GetValidatorByRelayerAddr(gomock.Any(), validators[0].GetRelayer()).Return(validators[0], true)
+	s.stakingKeeper.EXPECT().GetValidatorByRelayerAddr(gomock.Any(), validators[1].GetRelayer()).Return(validators[1], true)
+	s.stakingKeeper.EXPECT().GetValidatorByRelayerAddr(gomock.Any(), validators[2].GetRelayer()).Return(stakingtypes.Validator{}, false)
+
+	expectReward := func(idx int, relayerReward, validatorReward int64) {
+		relayerCoins := sdk.NewCoins(sdk.NewInt64Coin("azkme", relayerReward))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), crosschaintypes.ModuleName, validators[idx].GetRelayer(), relayerCoins).Return(nil)
+		if validatorReward == 0 {
+			return
+		}
+		validatorCoins := sdk.NewCoins(sdk.NewInt64Coin("azkme", validatorReward))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), crosschaintypes.ModuleName, distrtypes.ModuleName, validatorCoins).Return(nil)
+		s.distributionKeeper.EXPECT().AllocateTokensToValidator(gomock.Any(), validators[idx], sdk.NewDecCoinsFromCoins(validatorCoins...))
+	}
+	expectReward(0, 40, 10)
+	expectReward(1, 20, 5)
+	expectReward(2, 25, 0)
+
+	_, err := s.msgServer.Claim(s.ctx, &msgClaim)
+	s.Require().NoError(err)
+}
+
+// prepareClaim returns a claim of a package with the given relayer fee, signed by all the validators
+// and relayed by the first one, and the validators
+func (s *TestSuite) prepareClaim(relayerFee *big.Int) (types.MsgClaim, []stakingtypes.Validator) {
 	newValidators, blsKeys := createValidators(s.T())
 
 	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).Return(stakingtypes.HistoricalInfo{
@@ -62,7 +105,6 @@ func (s *TestSuite) TestClaim() {
 	s.crossChainKeeper.EXPECT().GetCrossChainApp(sdk.ChannelID(1)).Return(&DummyCrossChainApp{}).AnyTimes()
 	s.crossChainKeeper.EXPECT().IncrReceiveSequence(gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
 	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("azkme").AnyTimes()
-	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	validatorMap := make(map[string]int, 0)
 	for idx, validator := range newValidators {
@@ -72,7 +114,7 @@ func (s *TestSuite) TestClaim() {
 	payloadHeader := sdk.EncodePackageHeader(sdk.PackageHeader{
 		PackageType:   sdk.SynCrossChainPackageType,
 		Timestamp:     1992,
-		RelayerFee:    big.NewInt(1),
+		RelayerFee:    relayerFee,
 		AckRelayerFee: big.NewInt(1),
 	})
 
@@ -108,8 +150,7 @@ func (s *TestSuite) TestClaim() {
 	msgClaim.AggSignature = blsSig
 
 	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
-	_, err = s.msgServer.Claim(s.ctx, &msgClaim)
-	s.Require().Nil(err, "process claim msg error")
+	return msgClaim, newValidators
 }
 
 func (s *TestSuite) TestInvalidClaim() {
@@ -221,13 +262,13 @@ func (s *TestSuite) TestMultiMessageDecode() {
 		encb, err := hex.DecodeString(test.packed)
 		s.Require().Nilf(err, "invalid hex %s: %v", test.packed, err)
 
-		messages, err := keeper.DecodeMultiMessage(encb)
+		messages, err := types.DecodeMultiMessage(encb)
 		s.Require().Nilf(err, "test %d (%v) failed: %v", i, test.def, err)
 
 		for _, message := range messages {
 			fmt.Println("message", hex.EncodeToString(message))
 
-			channelId, msgBytes, ackRelayFee, err := keeper.DecodeMessage(message)
+			channelId, msgBytes, ackRelayFee, err := types.DecodeMessage(message)
 			s.Require().Nil(err, "unpack error")
 
 			fmt.Println(channelId, msgBytes, ackRelayFee)
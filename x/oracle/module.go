@@ -157,6 +157,8 @@ type OracleInputs struct {
 	BankKeeper       types.BankKeeper
 	CrossChainKeeper types.CrossChainKeeper
 	StakingKeeper    types.StakingKeeper
+
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
 }
 
 type OracleOutputs struct {
@@ -186,6 +188,7 @@ func ProvideModule(in OracleInputs) OracleOutputs {
 		in.CrossChainKeeper,
 		in.BankKeeper,
 		in.StakingKeeper,
+		in.DistributionKeeper,
 	)
 
 	m := NewAppModule(k)
@@ -3,6 +3,8 @@ package types
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -11,6 +13,9 @@ type StakingKeeper interface {
 	GetLastValidators(ctx sdk.Context) (validators []types.Validator)
 	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
 	BondDenom(ctx sdk.Context) (res string)
+	GetValidatorPreviousKeys(ctx sdk.Context, valAddr sdk.AccAddress) (types.KeyRotationRecord, bool)
+	PowerReduction(ctx sdk.Context) math.Int
+	GetValidatorByRelayerAddr(ctx sdk.Context, relayerAddr sdk.AccAddress) (types.Validator, bool)
 }
 
 type CrossChainKeeper interface {
@@ -34,4 +39,9 @@ type CrossChainKeeper interface {
 
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx sdk.Context, val types.ValidatorI, tokens sdk.DecCoins)
 }
@@ -34,6 +34,10 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("the relayer reward share should not be larger than 100, is %d", data.Params.RelayerRewardShare)
 	}
 
+	if data.Params.ValidatorRewardShare > 100 {
+		return fmt.Errorf("the validator reward share should not be larger than 100, is %d", data.Params.ValidatorRewardShare)
+	}
+
 	if data.Params.RelayerInterval <= 0 {
 		return fmt.Errorf("the relayer interval should be positive, is %d", data.Params.RelayerInterval)
 	}
@@ -31,6 +31,10 @@ type Params struct {
 	// Reward share for the relayer sends the claim message,
 	// the other relayers signed the bls message will share the reward evenly.
 	RelayerRewardShare uint32 `protobuf:"varint,3,opt,name=relayer_reward_share,json=relayerRewardShare,proto3" json:"relayer_reward_share,omitempty"`
+	// Share of the reward of each relayer allocated to the rewards of its validator through the
+	// distribution module, so that it is subject to the validator commission and shared with its
+	// delegators. The rest of the reward is sent to the relayer address, zero disables the routing.
+	ValidatorRewardShare uint32 `protobuf:"varint,4,opt,name=validator_reward_share,json=validatorRewardShare,proto3" json:"validator_reward_share,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +91,13 @@ func (m *Params) GetRelayerRewardShare() uint32 {
 	return 0
 }
 
+func (m *Params) GetValidatorRewardShare() uint32 {
+	if m != nil {
+		return m.ValidatorRewardShare
+	}
+	return 0
+}
+
 // RelayInterval holds start and end(exclusive) time of in-turn relayer, [start, end)
 type RelayInterval struct {
 	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -148,23 +159,24 @@ func init() {
 func init() { proto.RegisterFile("cosmos/oracle/v1/oracle.proto", fileDescriptor_3dec273964b5043c) }
 
 var fileDescriptor_3dec273964b5043c = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
+	// 272 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0xa5, 0x3e, 0x46,
-	0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x75, 0x2e, 0xfe, 0xa2, 0xd4, 0x9c, 0xc4,
-	0xca, 0xd4, 0xa2, 0xf8, 0x92, 0xcc, 0xdc, 0xd4, 0xfc, 0xd2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x96, 0x20, 0x3e, 0xa8, 0x70, 0x08, 0x44, 0x54, 0x48, 0x93, 0x4b, 0x00, 0xa6, 0x30, 0x33, 0xaf,
-	0x24, 0xb5, 0xa8, 0x2c, 0x31, 0x47, 0x82, 0x09, 0xac, 0x12, 0x66, 0x80, 0x27, 0x54, 0x58, 0xc8,
-	0x80, 0x4b, 0x04, 0xa6, 0xb4, 0x28, 0xb5, 0x3c, 0xb1, 0x28, 0x25, 0xbe, 0x38, 0x23, 0xb1, 0x28,
-	0x55, 0x82, 0x59, 0x81, 0x51, 0x83, 0x37, 0x48, 0x08, 0x2a, 0x17, 0x04, 0x96, 0x0a, 0x06, 0xc9,
-	0x28, 0x99, 0x73, 0xf1, 0x06, 0x81, 0x44, 0xe1, 0x46, 0x88, 0x70, 0xb1, 0x16, 0x97, 0x24, 0x16,
-	0xc1, 0x1c, 0x03, 0xe1, 0x08, 0x09, 0x70, 0x31, 0xa7, 0xe6, 0xa5, 0x40, 0xad, 0x05, 0x31, 0x9d,
-	0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x3e, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b,
-	0xbf, 0x02, 0x16, 0x58, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x90, 0x32, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x59, 0x7c, 0xef, 0x48, 0x4a, 0x01, 0x00, 0x00,
+	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0xa5, 0x23, 0x8c,
+	0x5c, 0x6c, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0xea, 0x5c, 0xfc, 0x45, 0xa9, 0x39, 0x89,
+	0x95, 0xa9, 0x45, 0xf1, 0x25, 0x99, 0xb9, 0xa9, 0xf9, 0xa5, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x2c, 0x41, 0x7c, 0x50, 0xe1, 0x10, 0x88, 0xa8, 0x90, 0x26, 0x97, 0x00, 0x4c, 0x61, 0x66, 0x5e,
+	0x49, 0x6a, 0x51, 0x59, 0x62, 0x8e, 0x04, 0x13, 0x58, 0x25, 0xcc, 0x00, 0x4f, 0xa8, 0xb0, 0x90,
+	0x01, 0x97, 0x08, 0x4c, 0x69, 0x51, 0x6a, 0x79, 0x62, 0x51, 0x4a, 0x7c, 0x71, 0x46, 0x62, 0x51,
+	0xaa, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x6f, 0x90, 0x10, 0x54, 0x2e, 0x08, 0x2c, 0x15, 0x0c, 0x92,
+	0x11, 0x32, 0xe1, 0x12, 0x2b, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x47, 0xd3, 0xc3, 0x02,
+	0xd6, 0x23, 0x02, 0x97, 0x45, 0xd2, 0xa5, 0x64, 0xce, 0xc5, 0x1b, 0x04, 0x32, 0x0b, 0x6e, 0xb1,
+	0x08, 0x17, 0x6b, 0x71, 0x49, 0x62, 0x11, 0xcc, 0x0b, 0x10, 0x8e, 0x90, 0x00, 0x17, 0x73, 0x6a,
+	0x5e, 0x0a, 0xd4, 0xb1, 0x20, 0xa6, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
+	0x31, 0x44, 0x69, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x43,
+	0x15, 0x42, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xc0, 0x82, 0xb8, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
+	0x89, 0x0d, 0x1c, 0xbe, 0xc6, 0x80, 0x01, 0x00, 0xb4, 0x9e, 0xfd, 0x69, 0x80, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +199,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorRewardShare != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ValidatorRewardShare))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RelayerRewardShare != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.RelayerRewardShare))
 		i--
@@ -264,6 +281,9 @@ func (m *Params) Size() (n int) {
 	if m.RelayerRewardShare != 0 {
 		n += 1 + sovOracle(uint64(m.RelayerRewardShare))
 	}
+	if m.ValidatorRewardShare != 0 {
+		n += 1 + sovOracle(uint64(m.ValidatorRewardShare))
+	}
 	return n
 }
 
@@ -374,6 +394,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardShare", wireType)
+			}
+			m.ValidatorRewardShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorRewardShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type MessagesType [][]byte
+
+var (
+	uint8Type, _   = abi.NewType("uint8", "", nil)
+	bytesType, _   = abi.NewType("bytes", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+
+	MessageTypeArgs = abi.Arguments{
+		{Name: "ChannelId", Type: uint8Type},
+		{Name: "MsgBytes", Type: bytesType},
+		{Name: "RelayFee", Type: uint256Type},
+		{Name: "AckRelayFee", Type: uint256Type},
+		{Name: "Sender", Type: addressType},
+	}
+
+	MessagesAbiDefinition = `[{ "name" : "method", "type": "function", "outputs": [{"type": "bytes[]"}]}]`
+	MessagesAbi, _        = abi.JSON(strings.NewReader(MessagesAbiDefinition))
+)
+
+// CrossChainMessage is a single message bundled in a multi-message package.
+type CrossChainMessage struct {
+	ChannelId   sdk.ChannelID
+	MsgBytes    []byte
+	RelayFee    *big.Int
+	AckRelayFee *big.Int
+	Sender      common.Address
+}
+
+// DecodeMultiMessage decodes the payload of a multi-message package into its messages.
+func DecodeMultiMessage(multiMessagePayload []byte) (messages [][]byte, err error) {
+	out, err := MessagesAbi.Unpack("method", multiMessagePayload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidMultiMessage, "messages unpack failed, payload=%s", hex.EncodeToString(multiMessagePayload))
+	}
+
+	unpacked := abi.ConvertType(out[0], MessagesType{})
+	messages, ok := unpacked.(MessagesType)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidMultiMessage, "messages ConvertType failed, payload=%v", multiMessagePayload)
+	}
+
+	if len(messages) == 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalidMultiMessage, "empty messages, payload=%v", multiMessagePayload)
+	}
+
+	return messages, nil
+}
+
+// DecodeCrossChainMessage decodes a single message of a multi-message package.
+func DecodeCrossChainMessage(message []byte) (CrossChainMessage, error) {
+	unpacked, err := MessageTypeArgs.Unpack(message)
+	if err != nil || len(unpacked) != 5 {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode message error, message=%v, error: %s", message, err)
+	}
+
+	channelId, ok := abi.ConvertType(unpacked[0], uint8(0)).(uint8)
+	if !ok {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode channelId error, message=%v", message)
+	}
+
+	msgBytes, ok := abi.ConvertType(unpacked[1], []byte{}).([]byte)
+	if !ok {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode msgBytes error, message=%v", message)
+	}
+
+	relayFee, ok := abi.ConvertType(unpacked[2], big.NewInt(0)).(*big.Int)
+	if !ok {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode relayFee error, message=%v", message)
+	}
+
+	ackRelayFee, ok := abi.ConvertType(unpacked[3], big.NewInt(0)).(*big.Int)
+	if !ok {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode ackRelayFee error, message=%v", message)
+	}
+
+	if len(ackRelayFee.Bytes()) > 32 {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "ackRelayFee too large, ackRelayFee=%v ", ackRelayFee.Bytes())
+	}
+
+	sender, ok := abi.ConvertType(unpacked[4], common.Address{}).(common.Address)
+	if !ok {
+		return CrossChainMessage{}, sdkerrors.Wrapf(ErrInvalidMultiMessage, "decode sender error, message=%v", message)
+	}
+
+	return CrossChainMessage{
+		ChannelId:   sdk.ChannelID(channelId),
+		MsgBytes:    msgBytes,
+		RelayFee:    relayFee,
+		AckRelayFee: ackRelayFee,
+		Sender:      sender,
+	}, nil
+}
+
+// DecodeMessage decodes a single message of a multi-message package and returns the fields
+// needed to execute it.
+func DecodeMessage(message []byte) (channelId uint8, msgBytes []byte, ackRelayFee *big.Int, err error) {
+	msg, err := DecodeCrossChainMessage(message)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return uint8(msg.ChannelId), msg.MsgBytes, msg.AckRelayFee, nil
+}
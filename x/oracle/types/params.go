@@ -8,13 +8,16 @@ const (
 	DefaultRelayerTimeout     uint64 = 40  // in s
 	DefaultRelayerRewardShare uint32 = 50  // in s
 	DefaultRealyerInterval    uint64 = 600 // in s
+
+	DefaultValidatorRewardShare uint32 = 0 // in percentage
 )
 
 func DefaultParams() Params {
 	return Params{
-		RelayerTimeout:     DefaultRelayerTimeout,
-		RelayerRewardShare: DefaultRelayerRewardShare,
-		RelayerInterval:    DefaultRealyerInterval,
+		RelayerTimeout:       DefaultRelayerTimeout,
+		RelayerRewardShare:   DefaultRelayerRewardShare,
+		RelayerInterval:      DefaultRealyerInterval,
+		ValidatorRewardShare: DefaultValidatorRewardShare,
 	}
 }
 
@@ -31,6 +34,10 @@ func (p *Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorRewardShare(p.ValidatorRewardShare); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -61,3 +68,11 @@ func validateRelayerInterval(interval uint64) error {
 
 	return nil
 }
+
+func validateValidatorRewardShare(share uint32) error {
+	if share > 100 {
+		return fmt.Errorf("the validator reward share should not be larger than 100")
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMaintenanceWindows(),
 	)
 
 	return slashingQueryCmd
@@ -139,3 +140,50 @@ $ <appd> query slashing params
 
 	return cmd
 }
+
+// GetCmdQueryMaintenanceWindows implements the command to query maintenance windows.
+func GetCmdQueryMaintenanceWindows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance-windows [validator-addr]",
+		Short: "Query maintenance windows of all validators, or of a validator",
+		Long: strings.TrimSpace(`maintenance windows of validators:
+
+$ <appd> query slashing maintenance-windows
+$ <appd> query slashing maintenance-windows 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMaintenanceWindowsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+				if err != nil {
+					return err
+				}
+				params.ValidatorAddress = valAddr.String()
+			}
+
+			res, err := queryClient.MaintenanceWindows(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "maintenance windows")
+
+	return cmd
+}
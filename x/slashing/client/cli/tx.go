@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -19,7 +21,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(
+		NewUnjailTxCmd(),
+		NewDeclareMaintenanceTxCmd(),
+	)
 	return slashingTxCmd
 }
 
@@ -50,3 +55,40 @@ $ <appd> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+// NewDeclareMaintenanceTxCmd returns a CLI command handler for creating a MsgDeclareMaintenance transaction.
+func NewDeclareMaintenanceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "declare-maintenance [start-height] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "declare a maintenance window of a validator, in which its missed blocks are counted at a reduced weight",
+		Long: `declare a maintenance window of a validator, starting at a block height and lasting a number of blocks:
+
+$ <appd> tx slashing declare-maintenance 120000 500 --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeclareMaintenance(valAddr, startHeight, duration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -38,6 +38,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, window := range data.MaintenanceWindows {
+		address, err := sdk.ConsAddressFromHex(window.ConsAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetMaintenanceWindow(ctx, address, window)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -67,5 +75,13 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	maintenanceWindows := make([]types.MaintenanceWindow, 0)
+	keeper.IterateMaintenanceWindows(ctx, func(_ sdk.ConsAddress, window types.MaintenanceWindow) (stop bool) {
+		maintenanceWindows = append(maintenanceWindows, window)
+		return false
+	})
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.MaintenanceWindows = maintenanceWindows
+	return genesis
 }
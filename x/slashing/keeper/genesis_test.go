@@ -26,11 +26,14 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	keeper.SetValidatorSigningInfo(ctx, consAddr1, info1)
 	keeper.SetValidatorSigningInfo(ctx, consAddr2, info2)
+	window := types.NewMaintenanceWindow(sdk.AccAddress(consAddr1), consAddr1, 200, 210)
+	keeper.SetMaintenanceWindow(ctx, consAddr1, window)
 	genesisState := keeper.ExportGenesis(ctx)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]types.MaintenanceWindow{window}, genesisState.MaintenanceWindows)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	keeper.Tombstone(ctx, consAddr1)
@@ -54,4 +57,5 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+	require.Equal([]types.MaintenanceWindow{window}, keeper.GetMaintenanceWindows(ctx, consAddr1))
 }
@@ -75,3 +75,46 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MaintenanceWindows returns the maintenance windows of all validators, or of a validator
+func (k Keeper) MaintenanceWindows(c context.Context, req *types.QueryMaintenanceWindowsRequest) (*types.QueryMaintenanceWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	windowsPrefix := types.MaintenanceWindowKeyPrefix
+	if req.ValidatorAddress != "" {
+		valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+		}
+		validator := k.sk.Validator(ctx, valAddr)
+		if validator == nil {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		windowsPrefix = types.MaintenanceWindowPrefixKey(consAddr)
+	}
+
+	var windows []types.MaintenanceWindow
+	windowStore := prefix.NewStore(store, windowsPrefix)
+	pageRes, err := query.Paginate(windowStore, req.Pagination, func(key []byte, value []byte) error {
+		var window types.MaintenanceWindow
+		err := k.cdc.Unmarshal(value, &window)
+		if err != nil {
+			return err
+		}
+		windows = append(windows, window)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMaintenanceWindowsResponse{Windows: windows, Pagination: pageRes}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -42,9 +43,10 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 	return nil
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
+// AfterValidatorRemoved deletes the address-pubkey relation and the maintenance windows when a validator is removed,
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.AccAddress) error {
 	h.k.deleteAddrPubkeyRelation(ctx, crypto.Address(consAddr))
+	h.k.deleteMaintenanceWindows(ctx, consAddr)
 	return nil
 }
 
@@ -59,6 +61,19 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.AccAddress) er
 	return h.k.AddPubkey(ctx, consPk)
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new consensus pubkey and moves the
+// signing info and the maintenance windows to the new consensus address. The relation of the old pubkey
+// is kept so that evidence of infractions committed with it can still be handled.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, _ sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	h.k.migrateValidatorSigningInfo(ctx, sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey))
+	h.k.migrateMaintenanceWindows(ctx, sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey))
+	return nil
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.AccAddress) error {
 	return nil
 }
@@ -14,8 +14,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
+	// fetch the validator public key, the signing info follows the validator across consensus pubkey rotations
+	consAddr := k.sk.ValidatorIdentifier(ctx, sdk.ConsAddress(addr))
 
 	// don't update missed blocks when validator's jailed
 	if k.sk.IsValidatorJailed(ctx, consAddr) {
@@ -37,7 +37,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// This counter just tracks the sum of the bit array
 	// That way we avoid needing to read/write the whole array each time
 	previous := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
-	missed := !signed
+	// the blocks missed during a maintenance window are counted at a reduced weight
+	missed := !signed && k.countMissedBlock(ctx, consAddr, height)
 	switch {
 	case !previous && missed:
 		// Array value has changed from not missed to missed, increment counter
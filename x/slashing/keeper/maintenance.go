@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// DeclareMaintenance declares a maintenance window of a bonded and unjailed validator, starting at
+// startHeight and lasting duration blocks. The window must be declared at least MinMaintenanceNotice
+// blocks ahead, must not overlap another window of the validator and is subject to the caps on the
+// windows per maintenance period and on the bonded validators in maintenance at once.
+func (k Keeper) DeclareMaintenance(ctx sdk.Context, valAddr sdk.AccAddress, startHeight, duration int64) error {
+	params := k.GetParams(ctx)
+	if params.MaxMaintenanceDuration == 0 {
+		return types.ErrMaintenanceDisabled
+	}
+	if duration <= 0 || duration > params.MaxMaintenanceDuration {
+		return types.ErrInvalidMaintenanceWindow.Wrapf("duration must be between 1 and %d blocks, is %d", params.MaxMaintenanceDuration, duration)
+	}
+
+	height := ctx.BlockHeight()
+	if startHeight < height+params.MinMaintenanceNotice {
+		return types.ErrInvalidMaintenanceWindow.Wrapf("must start at least %d blocks ahead, starts at %d", params.MinMaintenanceNotice, startHeight)
+	}
+	if startHeight > height+params.MaintenancePeriod {
+		return types.ErrInvalidMaintenanceWindow.Wrapf("must start within %d blocks, starts at %d", params.MaintenancePeriod, startHeight)
+	}
+
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+	if !validator.IsBonded() || validator.IsJailed() {
+		return types.ErrValidatorNotBonded
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	endHeight := startHeight + duration
+
+	// the windows starting within a maintenance period from the new window are the ones which can
+	// be in a same maintenance period as it
+	starts := []int64{startHeight}
+	for _, window := range k.GetMaintenanceWindows(ctx, consAddr) {
+		if window.Overlaps(startHeight, endHeight) {
+			return types.ErrMaintenanceWindowOverlap
+		}
+		if window.StartHeight > startHeight-params.MaintenancePeriod && window.StartHeight < startHeight+params.MaintenancePeriod {
+			starts = append(starts, window.StartHeight)
+		}
+	}
+	if maxStartsInPeriod(starts, startHeight, params.MaintenancePeriod) > int(params.MaxMaintenanceWindowsPerPeriod) {
+		return types.ErrTooManyMaintenanceWindows
+	}
+
+	if maxConcurrentMaintenance(k.getOverlappingMaintenanceWindows(ctx, startHeight, endHeight), startHeight) >= int(params.MaxConcurrentMaintenance) {
+		return types.ErrTooManyInMaintenance
+	}
+
+	k.SetMaintenanceWindow(ctx, consAddr, types.NewMaintenanceWindow(valAddr, consAddr, startHeight, endHeight))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeclareMaintenance,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprintf("%d", startHeight)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", endHeight)),
+		),
+	)
+	return nil
+}
+
+// maxStartsInPeriod returns the max number of starts within a period including the start height
+func maxStartsInPeriod(starts []int64, startHeight, period int64) int {
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	// a period including the start height can always be moved to begin at a start, without
+	// excluding any start from it
+	res := 0
+	for i, from := range starts {
+		if from > startHeight {
+			break
+		}
+		if from+period <= startHeight {
+			continue
+		}
+		count := 0
+		for _, start := range starts[i:] {
+			if start >= from+period {
+				break
+			}
+			count++
+		}
+		if count > res {
+			res = count
+		}
+	}
+	return res
+}
+
+// maxConcurrentMaintenance returns the max number of the given windows active at once from the
+// start height, the windows must overlap the maintenance window beginning at the start height
+func maxConcurrentMaintenance(windows []types.MaintenanceWindow, startHeight int64) int {
+	// the number of active windows only increases at the beginning of a window
+	heights := []int64{startHeight}
+	for _, window := range windows {
+		if window.StartHeight > startHeight {
+			heights = append(heights, window.StartHeight)
+		}
+	}
+
+	res := 0
+	for _, height := range heights {
+		count := 0
+		for _, window := range windows {
+			if window.IsActive(height) {
+				count++
+			}
+		}
+		if count > res {
+			res = count
+		}
+	}
+	return res
+}
+
+// getOverlappingMaintenanceWindows returns the maintenance windows of all the bonded validators
+// overlapping the window from startHeight to endHeight. The windows of the other validators do not
+// count towards the cap on the validators in maintenance at once.
+func (k Keeper) getOverlappingMaintenanceWindows(ctx sdk.Context, startHeight, endHeight int64) []types.MaintenanceWindow {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.MaintenanceWindowByEndPrefixKey(startHeight+1), sdk.PrefixEndBytes(types.MaintenanceWindowByEndKeyPrefix))
+	defer iter.Close()
+
+	var windows []types.MaintenanceWindow
+	for ; iter.Valid(); iter.Next() {
+		consAddr, windowStart := types.MaintenanceWindowByEndAddress(iter.Key())
+		if windowStart >= endHeight {
+			continue
+		}
+		window, found := k.GetMaintenanceWindow(ctx, consAddr, windowStart)
+		if !found {
+			continue
+		}
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator == nil || !validator.IsBonded() {
+			continue
+		}
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// countMissedBlock returns whether a block missed by a validator is counted in its missed blocks
+// counter. The blocks missed during a maintenance window are counted at the maintenance missed
+// block weight.
+func (k Keeper) countMissedBlock(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) bool {
+	window, found := k.GetActiveMaintenanceWindow(ctx, consAddr, height)
+	if !found {
+		return true
+	}
+
+	weight := k.GetParams(ctx).MaintenanceMissedBlockWeight
+	window.MissedBlocks++
+	counted := !weight.IsNil() && weight.MulInt64(window.MissedBlocks).TruncateInt64() > window.CountedMissedBlocks
+	if counted {
+		window.CountedMissedBlocks++
+	}
+	k.SetMaintenanceWindow(ctx, consAddr, window)
+
+	return counted
+}
+
+// PruneMaintenanceWindows deletes the maintenance windows which ended a maintenance period ago,
+// they are not taken into account by the caps on the maintenance windows any more
+func (k Keeper) PruneMaintenanceWindows(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - k.GetParams(ctx).MaintenancePeriod
+	if cutoff <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.MaintenanceWindowByEndKeyPrefix, types.MaintenanceWindowByEndPrefixKey(cutoff+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		consAddr, startHeight := types.MaintenanceWindowByEndAddress(iter.Key())
+		store.Delete(types.MaintenanceWindowKey(consAddr, startHeight))
+		store.Delete(iter.Key())
+	}
+}
+
+// GetMaintenanceWindow returns the maintenance window of a validator starting at a height
+func (k Keeper) GetMaintenanceWindow(ctx sdk.Context, consAddr sdk.ConsAddress, startHeight int64) (window types.MaintenanceWindow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MaintenanceWindowKey(consAddr, startHeight))
+	if bz == nil {
+		return window, false
+	}
+	k.cdc.MustUnmarshal(bz, &window)
+	return window, true
+}
+
+// GetActiveMaintenanceWindow returns the maintenance window of a validator including a height
+func (k Keeper) GetActiveMaintenanceWindow(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) (window types.MaintenanceWindow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	// the windows of a validator do not overlap, so only the last one starting at the height or
+	// before can include it
+	iter := store.ReverseIterator(types.MaintenanceWindowPrefixKey(consAddr), types.MaintenanceWindowKey(consAddr, height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return window, false
+	}
+
+	k.cdc.MustUnmarshal(iter.Value(), &window)
+	return window, window.IsActive(height)
+}
+
+// GetMaintenanceWindows returns the maintenance windows of a validator
+func (k Keeper) GetMaintenanceWindows(ctx sdk.Context, consAddr sdk.ConsAddress) []types.MaintenanceWindow {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MaintenanceWindowPrefixKey(consAddr))
+	defer iter.Close()
+
+	windows := make([]types.MaintenanceWindow, 0)
+	for ; iter.Valid(); iter.Next() {
+		var window types.MaintenanceWindow
+		k.cdc.MustUnmarshal(iter.Value(), &window)
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// SetMaintenanceWindow sets a maintenance window of a validator
+func (k Keeper) SetMaintenanceWindow(ctx sdk.Context, consAddr sdk.ConsAddress, window types.MaintenanceWindow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MaintenanceWindowKey(consAddr, window.StartHeight), k.cdc.MustMarshal(&window))
+	store.Set(types.MaintenanceWindowByEndKey(window.EndHeight, consAddr, window.StartHeight), []byte{})
+}
+
+// deleteMaintenanceWindows deletes the maintenance windows of a validator
+func (k Keeper) deleteMaintenanceWindows(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, window := range k.GetMaintenanceWindows(ctx, consAddr) {
+		store.Delete(types.MaintenanceWindowKey(consAddr, window.StartHeight))
+		store.Delete(types.MaintenanceWindowByEndKey(window.EndHeight, consAddr, window.StartHeight))
+	}
+}
+
+// IterateMaintenanceWindows iterates over the maintenance windows of all the validators
+func (k Keeper) IterateMaintenanceWindows(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, window types.MaintenanceWindow) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MaintenanceWindowKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var window types.MaintenanceWindow
+		k.cdc.MustUnmarshal(iter.Value(), &window)
+		address, err := sdk.ConsAddressFromHex(window.ConsAddress)
+		if err != nil {
+			panic(err)
+		}
+		if handler(address, window) {
+			break
+		}
+	}
+}
+
+// migrateMaintenanceWindows moves the maintenance windows of a validator from its rotated consensus
+// address to its new consensus address
+func (k Keeper) migrateMaintenanceWindows(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	windows := k.GetMaintenanceWindows(ctx, oldConsAddr)
+	k.deleteMaintenanceWindows(ctx, oldConsAddr)
+	for _, window := range windows {
+		window.ConsAddress = newConsAddr.String()
+		k.SetMaintenanceWindow(ctx, newConsAddr, window)
+	}
+}
@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// enableMaintenance enables the maintenance windows of at most 100 blocks, declared 10 blocks
+// ahead, 2 per period of 1000 blocks and for 2 validators at once
+func (s *KeeperTestSuite) enableMaintenance() {
+	params := s.slashingKeeper.GetParams(s.ctx)
+	params.MaxMaintenanceDuration = 100
+	params.MaintenanceMissedBlockWeight = sdk.NewDecWithPrec(25, 2)
+	params.MaintenancePeriod = 1000
+	params.MaxMaintenanceWindowsPerPeriod = 2
+	params.MaxConcurrentMaintenance = 2
+	params.MinMaintenanceNotice = 10
+	s.Require().NoError(s.slashingKeeper.SetParams(s.ctx, params))
+}
+
+// createValidator returns the addresses of a new bonded validator known by the mocked staking keeper
+func (s *KeeperTestSuite) createValidator() (sdk.AccAddress, sdk.ConsAddress) {
+	return s.createValidatorWithStatus(stakingtypes.Bonded, false)
+}
+
+// createValidatorWithStatus returns the addresses of a new validator with status and jailed known by the
+// mocked staking keeper
+func (s *KeeperTestSuite) createValidatorWithStatus(status stakingtypes.BondStatus, jailed bool) (sdk.AccAddress, sdk.ConsAddress) {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	val, err := stakingtypes.NewSimpleValidator(addr, pubKey, stakingtypes.Description{Moniker: "test"})
+	s.Require().NoError(err)
+	val.Status = status
+	val.Jailed = jailed
+
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), addr).Return(val).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val).AnyTimes()
+	return addr, consAddr
+}
+
+func (s *KeeperTestSuite) TestDeclareMaintenance() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	valAddr, consAddr := s.createValidator()
+
+	// disabled by default
+	err := keeper.DeclareMaintenance(ctx, valAddr, 200, 10)
+	require.ErrorIs(err, slashingtypes.ErrMaintenanceDisabled)
+
+	s.enableMaintenance()
+
+	testCases := []struct {
+		name        string
+		startHeight int64
+		duration    int64
+		expErr      error
+	}{
+		{"duration too long", 200, 101, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"notice too short", 109, 10, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"start too far ahead", 1101, 10, slashingtypes.ErrInvalidMaintenanceWindow},
+		{"valid window", 200, 100, nil},
+		{"overlapping window", 299, 10, slashingtypes.ErrMaintenanceWindowOverlap},
+		{"adjacent window", 300, 10, nil},
+		{"too many windows in a period", 1000, 10, slashingtypes.ErrTooManyMaintenanceWindows},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			err := keeper.DeclareMaintenance(ctx, valAddr, tc.startHeight, tc.duration)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	windows := keeper.GetMaintenanceWindows(ctx, consAddr)
+	require.Equal([]slashingtypes.MaintenanceWindow{
+		slashingtypes.NewMaintenanceWindow(valAddr, consAddr, 200, 300),
+		slashingtypes.NewMaintenanceWindow(valAddr, consAddr, 300, 310),
+	}, windows)
+
+	// a validator must exist
+	_, _, addr := testdata.KeyTestPubAddr()
+	s.stakingKeeper.EXPECT().Validator(ctx, addr).Return(nil)
+	err = keeper.DeclareMaintenance(ctx, addr, 200, 10)
+	require.ErrorIs(err, slashingtypes.ErrNoValidatorForAddress)
+
+	// the validator must be bonded and not jailed
+	unbondedAddr, _ := s.createValidatorWithStatus(stakingtypes.Unbonded, false)
+	err = keeper.DeclareMaintenance(ctx, unbondedAddr, 200, 10)
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotBonded)
+
+	jailedAddr, _ := s.createValidatorWithStatus(stakingtypes.Bonded, true)
+	err = keeper.DeclareMaintenance(ctx, jailedAddr, 200, 10)
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotBonded)
+}
+
+func (s *KeeperTestSuite) TestDeclareMaintenanceConcurrency() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	s.enableMaintenance()
+
+	valAddr1, _ := s.createValidator()
+	valAddr2, _ := s.createValidator()
+	valAddr3, _ := s.createValidator()
+
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr1, 200, 50))
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr2, 240, 50))
+
+	// two validators are in maintenance from 240 to 250
+	err := keeper.DeclareMaintenance(ctx, valAddr3, 150, 100)
+	require.ErrorIs(err, slashingtypes.ErrTooManyInMaintenance)
+
+	// a window overlapping the others only one at a time is accepted
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr3, 250, 50))
+
+	// the windows of the validators which are not bonded any more are not counted
+	unbondingAddr, unbondingConsAddr := s.createValidatorWithStatus(stakingtypes.Unbonding, false)
+	keeper.SetMaintenanceWindow(ctx, unbondingConsAddr, slashingtypes.NewMaintenanceWindow(unbondingAddr, unbondingConsAddr, 400, 450))
+	valAddr4, _ := s.createValidator()
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr3, 400, 50))
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr4, 420, 50))
+}
+
+func (s *KeeperTestSuite) TestMaintenanceMissedBlocks() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	s.enableMaintenance()
+
+	valAddr, consAddr := s.createValidator()
+	keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 1, 0, time.Unix(0, 0), false, 0))
+	require.NoError(keeper.DeclareMaintenance(ctx.WithBlockHeight(1), valAddr, 11, 8))
+
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr).AnyTimes()
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false).AnyTimes()
+
+	// one block out of four missed during the window is counted
+	for height := int64(11); height < 19; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), consAddr.Bytes(), 1, false)
+	}
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(found)
+	require.Equal(int64(2), info.MissedBlocksCounter)
+
+	window, found := keeper.GetMaintenanceWindow(ctx, consAddr, 11)
+	require.True(found)
+	require.Equal(int64(8), window.MissedBlocks)
+	require.Equal(int64(2), window.CountedMissedBlocks)
+
+	// the blocks missed after the window are all counted
+	keeper.HandleValidatorSignature(ctx.WithBlockHeight(19), consAddr.Bytes(), 1, false)
+	info, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(int64(3), info.MissedBlocksCounter)
+}
+
+func (s *KeeperTestSuite) TestPruneMaintenanceWindows() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	s.enableMaintenance()
+
+	valAddr, consAddr := s.createValidator()
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr, 200, 10))
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr, 500, 10))
+
+	// the windows are kept a maintenance period after their end
+	keeper.PruneMaintenanceWindows(ctx.WithBlockHeight(1209))
+	require.Len(keeper.GetMaintenanceWindows(ctx, consAddr), 2)
+
+	keeper.PruneMaintenanceWindows(ctx.WithBlockHeight(1210))
+	windows := keeper.GetMaintenanceWindows(ctx, consAddr)
+	require.Len(windows, 1)
+	require.Equal(int64(500), windows[0].StartHeight)
+
+	_, found := keeper.GetActiveMaintenanceWindow(ctx, consAddr, 505)
+	require.True(found)
+	_, found = keeper.GetActiveMaintenanceWindow(ctx, consAddr, 510)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestMaintenanceWindowsAfterValidatorRemoved() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	s.enableMaintenance()
+
+	valAddr, consAddr := s.createValidator()
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr, 200, 10))
+
+	require.NoError(keeper.Hooks().AfterValidatorRemoved(ctx, consAddr, valAddr))
+	require.Empty(keeper.GetMaintenanceWindows(ctx, consAddr))
+	_, found := keeper.GetActiveMaintenanceWindow(ctx, consAddr, 205)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestGRPCMaintenanceWindows() {
+	ctx, keeper, queryClient := s.ctx.WithBlockHeight(100), s.slashingKeeper, s.queryClient
+	require := s.Require()
+
+	s.enableMaintenance()
+
+	valAddr1, consAddr1 := s.createValidator()
+	valAddr2, consAddr2 := s.createValidator()
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr1, 200, 10))
+	require.NoError(keeper.DeclareMaintenance(ctx, valAddr2, 300, 10))
+
+	res, err := queryClient.MaintenanceWindows(gocontext.Background(), &slashingtypes.QueryMaintenanceWindowsRequest{})
+	require.NoError(err)
+	require.Len(res.Windows, 2)
+
+	res, err = queryClient.MaintenanceWindows(gocontext.Background(), &slashingtypes.QueryMaintenanceWindowsRequest{
+		ValidatorAddress: valAddr2.String(),
+	})
+	require.NoError(err)
+	require.Equal([]slashingtypes.MaintenanceWindow{slashingtypes.NewMaintenanceWindow(valAddr2, consAddr2, 300, 310)}, res.Windows)
+
+	res, err = queryClient.MaintenanceWindows(gocontext.Background(), &slashingtypes.QueryMaintenanceWindowsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Windows, 1)
+	require.Equal(uint64(2), res.Pagination.Total)
+	require.Contains([]string{consAddr1.String(), consAddr2.String()}, res.Windows[0].ConsAddress)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), addr).Return(nil)
+	_, err = queryClient.MaintenanceWindows(gocontext.Background(), &slashingtypes.QueryMaintenanceWindowsRequest{
+		ValidatorAddress: addr.String(),
+	})
+	require.Error(err)
+}
@@ -90,3 +90,18 @@ func (k msgServer) Impeach(goCtx context.Context, msg *types.MsgImpeach) (*types
 
 	return &types.MsgImpeachResponse{}, nil
 }
+
+// DeclareMaintenance defines a method for a validator to declare a maintenance window ahead of time.
+func (k msgServer) DeclareMaintenance(goCtx context.Context, msg *types.MsgDeclareMaintenance) (*types.MsgDeclareMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.DeclareMaintenance(ctx, valAddr, msg.StartHeight, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeclareMaintenanceResponse{}, nil
+}
@@ -20,6 +20,9 @@ func (s *KeeperTestSuite) TestParams() {
 	slashFractionDowntime, err := sdk.NewDecFromStr("0.0089")
 	require.NoError(err)
 
+	maintenanceMissedBlockWeight, err := sdk.NewDecFromStr("0.25")
+	require.NoError(err)
+
 	invalidVal, err := sdk.NewDecFromStr("-1")
 	require.NoError(err)
 
@@ -89,14 +92,36 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid max concurrent maintenance",
+			input: types.Params{
+				SignedBlocksWindow:             int64(750),
+				MinSignedPerWindow:             minSignedPerWindow,
+				DowntimeJailDuration:           time.Duration(10),
+				SlashFractionDoubleSign:        slashFractionDoubleSign,
+				SlashFractionDowntime:          slashFractionDowntime,
+				MaxMaintenanceDuration:         500,
+				MaintenanceMissedBlockWeight:   maintenanceMissedBlockWeight,
+				MaintenancePeriod:              10000,
+				MaxMaintenanceWindowsPerPeriod: 2,
+			},
+			expectErr: true,
+			expErrMsg: "max concurrent maintenance must be positive",
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:             int64(750),
+				MinSignedPerWindow:             minSignedPerWindow,
+				DowntimeJailDuration:           time.Duration(34800000000000),
+				SlashFractionDoubleSign:        slashFractionDoubleSign,
+				SlashFractionDowntime:          slashFractionDowntime,
+				MaxMaintenanceDuration:         500,
+				MaintenanceMissedBlockWeight:   maintenanceMissedBlockWeight,
+				MaintenancePeriod:              10000,
+				MaxMaintenanceWindowsPerPeriod: 2,
+				MaxConcurrentMaintenance:       3,
+				MinMaintenanceNotice:           50,
 			},
 			expectErr: false,
 		},
@@ -179,3 +179,25 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// migrateValidatorSigningInfo moves the signing info and the missed blocks of a validator from its rotated
+// consensus address to its new consensus address
+func (k Keeper) migrateValidatorSigningInfo(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	info, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	info.Address = newConsAddr.String()
+	k.SetValidatorSigningInfo(ctx, newConsAddr, info)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorSigningInfoKey(oldConsAddr))
+
+	k.clearValidatorMissedBlockBitArray(ctx, newConsAddr)
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+}
@@ -39,6 +39,15 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.MaintenanceWindowKeyPrefix):
+			var windowA, windowB types.MaintenanceWindow
+			cdc.MustUnmarshal(kvA.Value, &windowA)
+			cdc.MustUnmarshal(kvB.Value, &windowB)
+			return fmt.Sprintf("%v\n%v", windowA, windowB)
+
+		case bytes.Equal(kvA.Key[:1], types.MaintenanceWindowByEndKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
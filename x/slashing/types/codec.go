@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/slashing/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgImpeach{}, "cosmos-sdk/x/slashing/MsgImpeach")
+	legacy.RegisterAminoMsg(cdc, &MsgDeclareMaintenance{}, "cosmos-sdk/MsgDeclareMaintenance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -26,6 +27,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUnjail{},
 		&MsgUpdateParams{},
 		&MsgImpeach{},
+		&MsgDeclareMaintenance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
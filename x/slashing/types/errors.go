@@ -14,4 +14,10 @@ var (
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
 	ErrSignerNotGovModule           = sdkerrors.Register(ModuleName, 9, "signer is not gov module account")
+	ErrMaintenanceDisabled          = sdkerrors.Register(ModuleName, 10, "maintenance windows are disabled")
+	ErrInvalidMaintenanceWindow     = sdkerrors.Register(ModuleName, 11, "invalid maintenance window")
+	ErrMaintenanceWindowOverlap     = sdkerrors.Register(ModuleName, 12, "maintenance window overlaps an existing one of the validator")
+	ErrTooManyMaintenanceWindows    = sdkerrors.Register(ModuleName, 13, "too many maintenance windows in the maintenance period")
+	ErrTooManyInMaintenance         = sdkerrors.Register(ModuleName, 14, "too many validators in maintenance at once")
+	ErrValidatorNotBonded           = sdkerrors.Register(ModuleName, 15, "validator is not bonded or is jailed")
 )
@@ -5,6 +5,8 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeDeclareMaintenance = "declare_maintenance"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -12,6 +14,9 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyStartHeight  = "start_height"
+	AttributeKeyEndHeight    = "end_height"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
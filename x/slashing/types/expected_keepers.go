@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -56,6 +57,9 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool
+
+	// ValidatorIdentifier returns the current consensus address of a validator whose consensus pubkey was rotated
+	ValidatorIdentifier(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -72,4 +76,5 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) error        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.AccAddress, fraction sdk.Dec) error
+	AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error // Must be called when a validator's consensus pubkey is rotated
 }
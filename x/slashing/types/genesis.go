@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:             DefaultParams(),
+		SigningInfos:       []SigningInfo{},
+		MissedBlocks:       []ValidatorMissedBlocks{},
+		MaintenanceWindows: []MaintenanceWindow{},
 	}
 }
 
@@ -62,5 +63,15 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, window := range data.MaintenanceWindows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -27,12 +27,18 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><startHeight_Bytes>: MaintenanceWindow
+//
+// - 0x05<endHeight_Bytes><consAddrLen (1 Byte)><consAddress_Bytes><startHeight_Bytes>: []byte{}
 
 var (
 	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	MaintenanceWindowKeyPrefix            = []byte{0x04} // Prefix for maintenance windows
+	MaintenanceWindowByEndKeyPrefix       = []byte{0x05} // Prefix for maintenance windows by end height
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -66,3 +72,36 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// MaintenanceWindowPrefixKey - stored by *Consensus* address (not operator address)
+func MaintenanceWindowPrefixKey(v sdk.ConsAddress) []byte {
+	return append(MaintenanceWindowKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// MaintenanceWindowKey - stored by *Consensus* address (not operator address) and start height
+func MaintenanceWindowKey(v sdk.ConsAddress, startHeight int64) []byte {
+	return append(MaintenanceWindowPrefixKey(v), sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
+
+// MaintenanceWindowByEndPrefixKey gets the prefix of the maintenance windows ending at a height
+func MaintenanceWindowByEndPrefixKey(endHeight int64) []byte {
+	return append(MaintenanceWindowByEndKeyPrefix, sdk.Uint64ToBigEndian(uint64(endHeight))...)
+}
+
+// MaintenanceWindowByEndKey gets the key indexing a maintenance window by its end height
+func MaintenanceWindowByEndKey(endHeight int64, v sdk.ConsAddress, startHeight int64) []byte {
+	key := append(MaintenanceWindowByEndPrefixKey(endHeight), address.MustLengthPrefix(v.Bytes())...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
+
+// MaintenanceWindowByEndAddress - extract the consensus address and the start height from a
+// maintenance window by end height key
+func MaintenanceWindowByEndAddress(key []byte) (sdk.ConsAddress, int64) {
+	// Remove prefix, end height and address length.
+	kv.AssertKeyAtLeastLength(key, 11)
+	addrLen := int(key[9])
+	kv.AssertKeyLength(key, 10+addrLen+8)
+	addr := key[10 : 10+addrLen]
+
+	return sdk.ConsAddress(addr), int64(sdk.BigEndianToUint64(key[10+addrLen:]))
+}
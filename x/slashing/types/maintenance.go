@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMaintenanceWindow creates a new MaintenanceWindow instance
+func NewMaintenanceWindow(valAddr sdk.AccAddress, consAddr sdk.ConsAddress, startHeight, endHeight int64) MaintenanceWindow {
+	return MaintenanceWindow{
+		ValidatorAddress: valAddr.String(),
+		ConsAddress:      consAddr.String(),
+		StartHeight:      startHeight,
+		EndHeight:        endHeight,
+	}
+}
+
+// IsActive returns whether the maintenance window includes the given height
+func (w MaintenanceWindow) IsActive(height int64) bool {
+	return w.StartHeight <= height && height < w.EndHeight
+}
+
+// Overlaps returns whether the maintenance window overlaps the window from startHeight to endHeight
+func (w MaintenanceWindow) Overlaps(startHeight, endHeight int64) bool {
+	return w.StartHeight < endHeight && startHeight < w.EndHeight
+}
+
+// Validate performs a basic validation of the maintenance window
+func (w MaintenanceWindow) Validate() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(w.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.ConsAddressFromHex(w.ConsAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid consensus address: %s", err)
+	}
+	if w.StartHeight <= 0 || w.EndHeight <= w.StartHeight {
+		return fmt.Errorf("invalid maintenance window heights: [%d, %d)", w.StartHeight, w.EndHeight)
+	}
+	if w.MissedBlocks < 0 || w.MissedBlocks > w.EndHeight-w.StartHeight {
+		return fmt.Errorf("invalid maintenance window missed blocks: %d", w.MissedBlocks)
+	}
+	if w.CountedMissedBlocks < 0 || w.CountedMissedBlocks > w.MissedBlocks {
+		return fmt.Errorf("invalid maintenance window counted missed blocks: %d", w.CountedMissedBlocks)
+	}
+	return nil
+}
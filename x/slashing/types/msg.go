@@ -8,7 +8,8 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail             = "unjail"
+	TypeMsgDeclareMaintenance = "declare_maintenance"
 )
 
 // verify interface at compile time
@@ -16,6 +17,7 @@ var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgImpeach{}
+	_ sdk.Msg = &MsgDeclareMaintenance{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -109,3 +111,44 @@ func (msg MsgImpeach) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgDeclareMaintenance creates a new MsgDeclareMaintenance instance
+func NewMsgDeclareMaintenance(valAddr sdk.AccAddress, startHeight, duration int64) *MsgDeclareMaintenance {
+	return &MsgDeclareMaintenance{
+		ValidatorAddress: valAddr.String(),
+		StartHeight:      startHeight,
+		Duration:         duration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDeclareMaintenance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDeclareMaintenance) Type() string { return TypeMsgDeclareMaintenance }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDeclareMaintenance) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	return []sdk.AccAddress{valAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDeclareMaintenance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDeclareMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.StartHeight <= 0 {
+		return ErrInvalidMaintenanceWindow.Wrapf("start height must be positive: %d", msg.StartHeight)
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidMaintenanceWindow.Wrapf("duration must be positive: %d", msg.Duration)
+	}
+	return nil
+}
@@ -18,3 +18,36 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgDeclareMaintenance(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+	tests := []struct {
+		name        string
+		valAddr     sdk.AccAddress
+		startHeight int64
+		duration    int64
+		expectPass  bool
+	}{
+		{"valid", addr, 100, 10, true},
+		{"empty address", sdk.AccAddress{}, 100, 10, false},
+		{"zero start height", addr, 0, 10, false},
+		{"zero duration", addr, 100, 0, false},
+		{"negative duration", addr, 100, -1, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgDeclareMaintenance(tc.valAddr, tc.startHeight, tc.duration)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	msg := NewMsgDeclareMaintenance(addr, 100, 10)
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgDeclareMaintenance","value":{"duration":"10","start_height":"100","validator_address":"0x0000000000000000000000000000000061626364"}}`,
+		string(msg.GetSignBytes()),
+	)
+}
@@ -13,12 +13,20 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultMaxMaintenanceDuration         = int64(0)
+	DefaultMaintenancePeriod              = int64(100000)
+	DefaultMaxMaintenanceWindowsPerPeriod = uint32(2)
+	DefaultMaxConcurrentMaintenance       = uint32(1)
+	DefaultMinMaintenanceNotice           = int64(100)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	DefaultMaintenanceMissedBlockWeight = sdk.NewDecWithPrec(1, 1)
 )
 
 // NewParams creates a new Params object
@@ -37,13 +45,20 @@ func NewParams(
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow,
 		DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
 	)
+	params.MaxMaintenanceDuration = DefaultMaxMaintenanceDuration
+	params.MaintenanceMissedBlockWeight = DefaultMaintenanceMissedBlockWeight
+	params.MaintenancePeriod = DefaultMaintenancePeriod
+	params.MaxMaintenanceWindowsPerPeriod = DefaultMaxMaintenanceWindowsPerPeriod
+	params.MaxConcurrentMaintenance = DefaultMaxConcurrentMaintenance
+	params.MinMaintenanceNotice = DefaultMinMaintenanceNotice
+	return params
 }
 
 // Validate validates the params
@@ -63,6 +78,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateMaintenance(p); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -148,3 +166,40 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+// validateMaintenance validates the maintenance window params, which are not required to be set
+// when the maintenance windows are disabled
+func validateMaintenance(p Params) error {
+	if p.MaxMaintenanceDuration < 0 {
+		return fmt.Errorf("max maintenance duration cannot be negative: %d", p.MaxMaintenanceDuration)
+	}
+	if p.MaintenancePeriod < 0 {
+		return fmt.Errorf("maintenance period cannot be negative: %d", p.MaintenancePeriod)
+	}
+	if p.MinMaintenanceNotice < 0 {
+		return fmt.Errorf("min maintenance notice cannot be negative: %d", p.MinMaintenanceNotice)
+	}
+	weight := p.MaintenanceMissedBlockWeight
+	if !weight.IsNil() && (weight.IsNegative() || weight.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("maintenance missed block weight should be between zero and one: %s", weight)
+	}
+	if p.MaxMaintenanceDuration == 0 {
+		return nil
+	}
+
+	if weight.IsNil() {
+		return fmt.Errorf("maintenance missed block weight cannot be nil: %s", weight)
+	}
+	if p.MaintenancePeriod < p.MaxMaintenanceDuration {
+		return fmt.Errorf("maintenance period must not be shorter than the max maintenance duration: %d < %d",
+			p.MaintenancePeriod, p.MaxMaintenanceDuration)
+	}
+	if p.MaxMaintenanceWindowsPerPeriod == 0 {
+		return fmt.Errorf("max maintenance windows per period must be positive: %d", p.MaxMaintenanceWindowsPerPeriod)
+	}
+	if p.MaxConcurrentMaintenance == 0 {
+		return fmt.Errorf("max concurrent maintenance must be positive: %d", p.MaxConcurrentMaintenance)
+	}
+
+	return nil
+}
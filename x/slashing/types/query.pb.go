@@ -309,6 +309,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMaintenanceWindowsRequest is the request type for the Query/MaintenanceWindows RPC
+// method
+type QueryMaintenanceWindowsRequest struct {
+	// validator_address is the operator address of the validator to query the maintenance windows
+	// of, all the maintenance windows are returned if empty
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowsRequest) Reset()         { *m = QueryMaintenanceWindowsRequest{} }
+func (m *QueryMaintenanceWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowsRequest) ProtoMessage()    {}
+func (*QueryMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowsRequest.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowsRequest proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryMaintenanceWindowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMaintenanceWindowsResponse is the response type for the Query/MaintenanceWindows RPC
+// method
+type QueryMaintenanceWindowsResponse struct {
+	Windows    []MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMaintenanceWindowsResponse) Reset()         { *m = QueryMaintenanceWindowsResponse{} }
+func (m *QueryMaintenanceWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowsResponse) ProtoMessage()    {}
+func (*QueryMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowsResponse.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceWindowsResponse proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowsResponse) GetWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *QueryMaintenanceWindowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +426,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMaintenanceWindowsRequest)(nil), "cosmos.slashing.v1beta1.QueryMaintenanceWindowsRequest")
+	proto.RegisterType((*QueryMaintenanceWindowsResponse)(nil), "cosmos.slashing.v1beta1.QueryMaintenanceWindowsResponse")
 }
 
 func init() {
@@ -323,43 +435,49 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0x33, 0xfd, 0xfd, 0x5a, 0xe9, 0xb4, 0x48, 0x3b, 0x16, 0xda, 0x06, 0xd9, 0xe8, 0x0a,
+	0x69, 0x89, 0xcd, 0xae, 0x89, 0x4a, 0x05, 0xf1, 0x60, 0xc0, 0x16, 0x41, 0x51, 0x53, 0xa8, 0xe8,
+	0x25, 0xcc, 0x26, 0xd3, 0xed, 0x60, 0x32, 0xb3, 0xdd, 0xd9, 0xa4, 0x16, 0xd1, 0x83, 0x67, 0x0f,
+	0x82, 0x7f, 0x83, 0xe0, 0x4d, 0x2d, 0xde, 0xc4, 0x7b, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xc1,
+	0x7f, 0x43, 0x32, 0x33, 0x9b, 0x6c, 0xd8, 0x6e, 0x4d, 0xd4, 0x4b, 0xbb, 0xbc, 0x79, 0xdf, 0xf7,
+	0x3e, 0xef, 0xdb, 0x37, 0x53, 0x78, 0xae, 0xca, 0x45, 0x83, 0x0b, 0x5b, 0xd4, 0xb1, 0xd8, 0xa6,
+	0xcc, 0xb5, 0x5b, 0x05, 0x87, 0x04, 0xb8, 0x60, 0xef, 0x34, 0x89, 0xbf, 0x67, 0x79, 0x3e, 0x0f,
+	0x38, 0x9a, 0x57, 0x49, 0x56, 0x98, 0x64, 0xe9, 0xa4, 0x74, 0x4e, 0xab, 0x1d, 0x2c, 0x88, 0x52,
+	0xf4, 0xf4, 0x1e, 0x76, 0x29, 0xc3, 0x01, 0xe5, 0x4c, 0x15, 0x49, 0xcf, 0xb9, 0xdc, 0xe5, 0xf2,
+	0xd3, 0xee, 0x7e, 0xe9, 0xe8, 0x69, 0x97, 0x73, 0xb7, 0x4e, 0x6c, 0xec, 0x51, 0x1b, 0x33, 0xc6,
+	0x03, 0x29, 0x11, 0xfa, 0x34, 0x9b, 0x44, 0xd7, 0x23, 0x51, 0x79, 0x8b, 0x2a, 0xaf, 0xa2, 0xca,
+	0x6b, 0x5a, 0x75, 0x34, 0x8b, 0x1b, 0x94, 0x71, 0x5b, 0xfe, 0x54, 0x21, 0x73, 0x0e, 0xa2, 0x7b,
+	0x5d, 0xd6, 0xbb, 0xd8, 0xc7, 0x0d, 0x51, 0x26, 0x3b, 0x4d, 0x22, 0x02, 0xf3, 0x01, 0x3c, 0x35,
+	0x10, 0x15, 0x1e, 0x67, 0x82, 0xa0, 0x12, 0x9c, 0xf0, 0x64, 0x64, 0x01, 0x9c, 0x01, 0xcb, 0x53,
+	0xc5, 0x8c, 0x95, 0x60, 0x86, 0xa5, 0x84, 0xa5, 0xc9, 0x83, 0x6f, 0x99, 0xd4, 0x9b, 0x9f, 0xef,
+	0x72, 0xa0, 0xac, 0x95, 0xe6, 0x26, 0x9c, 0x97, 0xa5, 0x37, 0xa8, 0xcb, 0x28, 0x73, 0x6f, 0xb2,
+	0x2d, 0xae, 0xbb, 0xa2, 0xab, 0x70, 0xba, 0xca, 0x99, 0xa8, 0xe0, 0x5a, 0xcd, 0x27, 0x42, 0x35,
+	0x99, 0x2c, 0x2d, 0x7c, 0xfe, 0x90, 0x9f, 0xd3, 0x7d, 0xae, 0xab, 0x93, 0x8d, 0xc0, 0xa7, 0xcc,
+	0x2d, 0x4f, 0x75, 0xb3, 0x75, 0xc8, 0x7c, 0x06, 0x17, 0xe2, 0x75, 0x35, 0xb7, 0x03, 0x67, 0x5a,
+	0xb8, 0x5e, 0x11, 0xea, 0xa8, 0x42, 0xd9, 0x16, 0xd7, 0x13, 0xe4, 0x13, 0x27, 0xd8, 0xc4, 0x75,
+	0x5a, 0xc3, 0x01, 0xf7, 0x23, 0x05, 0xa3, 0xf3, 0x9c, 0x6c, 0xe1, 0x7a, 0xe4, 0xc8, 0x74, 0xe2,
+	0xfd, 0x43, 0x3b, 0xd1, 0x1a, 0x84, 0xfd, 0x15, 0xd0, 0x9d, 0xb3, 0x61, 0xe7, 0xee, 0xbe, 0x58,
+	0x6a, 0xc3, 0xfa, 0xee, 0xb9, 0x44, 0x6b, 0xcb, 0x11, 0xa5, 0xb9, 0x0f, 0xe0, 0xe2, 0x11, 0x4d,
+	0xf4, 0x94, 0xb7, 0xe0, 0xff, 0x7a, 0xb2, 0xff, 0xfe, 0x6a, 0x32, 0x59, 0x05, 0xad, 0x0f, 0x30,
+	0x8f, 0x49, 0xe6, 0xa5, 0xdf, 0x32, 0x2b, 0x94, 0x01, 0xe8, 0xb7, 0x00, 0x1a, 0x12, 0xfa, 0x36,
+	0xa6, 0x2c, 0x20, 0x0c, 0xb3, 0x2a, 0xb9, 0x4f, 0x59, 0x8d, 0xef, 0xf6, 0xfc, 0xb9, 0x01, 0x67,
+	0x5b, 0x21, 0xd4, 0xd0, 0x7f, 0xfd, 0x99, 0x9e, 0x44, 0xc7, 0xd1, 0xda, 0x11, 0xc8, 0x7f, 0x62,
+	0xf3, 0x47, 0x00, 0x33, 0x89, 0xc4, 0xda, 0xec, 0x3b, 0xf0, 0xc4, 0xae, 0x0a, 0x69, 0xbf, 0x73,
+	0x89, 0x7e, 0xc7, 0xaa, 0x44, 0xcd, 0x0e, 0xab, 0xfc, 0x33, 0xbf, 0x8b, 0xfb, 0xe3, 0x70, 0x5c,
+	0xd2, 0xa3, 0x17, 0x00, 0x4e, 0xa8, 0x8b, 0x88, 0xce, 0x27, 0xd2, 0xc5, 0x6f, 0x7f, 0x7a, 0x65,
+	0xb8, 0x64, 0xd5, 0xdb, 0x5c, 0x7a, 0xfe, 0xe5, 0xc7, 0xab, 0xb1, 0xb3, 0x28, 0x63, 0x27, 0x3d,
+	0x50, 0xea, 0xe6, 0xa3, 0xf7, 0x00, 0x4e, 0x45, 0x56, 0x0e, 0x5d, 0x38, 0xbe, 0x4d, 0xfc, 0x81,
+	0x48, 0x17, 0x46, 0x50, 0x68, 0xba, 0x6b, 0x92, 0x6e, 0x15, 0x5d, 0x4e, 0xa4, 0x8b, 0xbe, 0x0a,
+	0xc2, 0x7e, 0x12, 0x7d, 0x81, 0x9e, 0xa2, 0xd7, 0x00, 0x4e, 0x47, 0xca, 0x0a, 0x34, 0x3c, 0x42,
+	0xcf, 0xce, 0xe2, 0x28, 0x12, 0x8d, 0x6d, 0x49, 0xec, 0x65, 0x94, 0x1d, 0x0e, 0x1b, 0x7d, 0x02,
+	0x10, 0xc5, 0xb7, 0x15, 0xad, 0x1e, 0xdf, 0x3a, 0xf1, 0x46, 0xa6, 0xaf, 0x8c, 0x2e, 0xd4, 0xe4,
+	0x97, 0x24, 0xb9, 0x85, 0x56, 0x12, 0xc9, 0x1b, 0x7d, 0x71, 0x45, 0x6f, 0x7f, 0x69, 0xfd, 0xa0,
+	0x6d, 0x80, 0xc3, 0xb6, 0x01, 0xbe, 0xb7, 0x0d, 0xf0, 0xb2, 0x63, 0xa4, 0x0e, 0x3b, 0x46, 0xea,
+	0x6b, 0xc7, 0x48, 0x3d, 0xcc, 0xbb, 0x34, 0xd8, 0x6e, 0x3a, 0x56, 0x95, 0x37, 0xc2, 0x8a, 0xea,
+	0x57, 0x5e, 0xd4, 0x1e, 0xd9, 0x8f, 0xfb, 0xe5, 0x83, 0x3d, 0x8f, 0x08, 0x67, 0x42, 0xfe, 0x5b,
+	0xbb, 0xf8, 0x6b, 0x00, 0x24, 0xb8, 0x05, 0x9f, 0xcc, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +498,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators, or of a validator
+	MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +537,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error) {
+	out := new(QueryMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MaintenanceWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +554,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators, or of a validator
+	MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +571,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MaintenanceWindows(ctx context.Context, req *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindows not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +633,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MaintenanceWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindows(ctx, req.(*QueryMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +667,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MaintenanceWindows",
+			Handler:    _Query_MaintenanceWindows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +879,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMaintenanceWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1057,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMaintenanceWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaintenanceWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1603,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMaintenanceWindowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, MaintenanceWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
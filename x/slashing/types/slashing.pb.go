@@ -132,6 +132,23 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// max_maintenance_duration is the max number of blocks of a maintenance window, 0 disables the
+	// maintenance windows.
+	MaxMaintenanceDuration int64 `protobuf:"varint,6,opt,name=max_maintenance_duration,json=maxMaintenanceDuration,proto3" json:"max_maintenance_duration,omitempty"`
+	// maintenance_missed_block_weight is the weight of the blocks missed during a maintenance window
+	// in the missed blocks counter.
+	MaintenanceMissedBlockWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maintenance_missed_block_weight,json=maintenanceMissedBlockWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_missed_block_weight"`
+	// maintenance_period is the number of blocks of the period in which the maintenance windows of a
+	// validator are capped.
+	MaintenancePeriod int64 `protobuf:"varint,8,opt,name=maintenance_period,json=maintenancePeriod,proto3" json:"maintenance_period,omitempty"`
+	// max_maintenance_windows_per_period is the max number of maintenance windows of a validator
+	// starting within any maintenance period.
+	MaxMaintenanceWindowsPerPeriod uint32 `protobuf:"varint,9,opt,name=max_maintenance_windows_per_period,json=maxMaintenanceWindowsPerPeriod,proto3" json:"max_maintenance_windows_per_period,omitempty"`
+	// max_concurrent_maintenance is the max number of validators in maintenance at once.
+	MaxConcurrentMaintenance uint32 `protobuf:"varint,10,opt,name=max_concurrent_maintenance,json=maxConcurrentMaintenance,proto3" json:"max_concurrent_maintenance,omitempty"`
+	// min_maintenance_notice is the min number of blocks between the declaration of a maintenance
+	// window and its start.
+	MinMaintenanceNotice int64 `protobuf:"varint,11,opt,name=min_maintenance_notice,json=minMaintenanceNotice,proto3" json:"min_maintenance_notice,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,9 +198,138 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxMaintenanceDuration() int64 {
+	if m != nil {
+		return m.MaxMaintenanceDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenancePeriod() int64 {
+	if m != nil {
+		return m.MaintenancePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceWindowsPerPeriod() uint32 {
+	if m != nil {
+		return m.MaxMaintenanceWindowsPerPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConcurrentMaintenance() uint32 {
+	if m != nil {
+		return m.MaxConcurrentMaintenance
+	}
+	return 0
+}
+
+func (m *Params) GetMinMaintenanceNotice() int64 {
+	if m != nil {
+		return m.MinMaintenanceNotice
+	}
+	return 0
+}
+
+// MaintenanceWindow defines a maintenance window declared by a validator, during which its missed
+// blocks are counted at a reduced weight.
+type MaintenanceWindow struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// cons_address is the consensus address of the validator.
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// start_height is the first block height of the window.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the block height at which the window ends, exclusive.
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// missed_blocks is the number of blocks missed by the validator during the window.
+	MissedBlocks int64 `protobuf:"varint,5,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// counted_missed_blocks is the number of blocks missed during the window which are counted in the
+	// missed blocks counter.
+	CountedMissedBlocks int64 `protobuf:"varint,6,opt,name=counted_missed_blocks,json=countedMissedBlocks,proto3" json:"counted_missed_blocks,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetCountedMissedBlocks() int64 {
+	if m != nil {
+		return m.CountedMissedBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*MaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MaintenanceWindow")
 }
 
 func init() {
@@ -191,46 +337,60 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x6f, 0x23, 0x45,
+	0x18, 0xf6, 0xda, 0xb9, 0x7c, 0x8c, 0x1d, 0x09, 0x0f, 0xbe, 0x64, 0xcf, 0x3a, 0xd6, 0x3e, 0x23,
+	0x9d, 0xac, 0x93, 0x6c, 0x73, 0x3e, 0x0a, 0x74, 0xd0, 0x9c, 0x2f, 0x20, 0x38, 0x08, 0x58, 0x0e,
+	0x10, 0x89, 0x82, 0xd5, 0x78, 0x67, 0xbc, 0x1e, 0xb2, 0x3b, 0x63, 0xed, 0x8c, 0x63, 0xc3, 0x4f,
+	0xa0, 0x4a, 0x99, 0x32, 0x65, 0xe8, 0x22, 0x44, 0xc9, 0x0f, 0x48, 0x19, 0x51, 0x21, 0x8a, 0x80,
+	0x9c, 0x22, 0xfc, 0x0c, 0xb4, 0x33, 0xb3, 0xf6, 0x26, 0x16, 0x70, 0x4a, 0x93, 0x8f, 0xf7, 0x79,
+	0xde, 0xe7, 0xfd, 0xd8, 0x67, 0x5e, 0xf0, 0xd8, 0xe3, 0x22, 0xe4, 0xa2, 0x25, 0x02, 0x24, 0x86,
+	0x94, 0xf9, 0xad, 0xc3, 0xa7, 0x7d, 0x22, 0xd1, 0xd3, 0x79, 0xa0, 0x39, 0x8a, 0xb8, 0xe4, 0x70,
+	0x5b, 0xf3, 0x9a, 0xf3, 0xb0, 0xe1, 0x95, 0x4b, 0x3e, 0xf7, 0xb9, 0xe2, 0xb4, 0xe2, 0xbf, 0x34,
+	0xbd, 0xec, 0xf8, 0x9c, 0xfb, 0x01, 0x69, 0xa9, 0xff, 0xfa, 0xe3, 0x41, 0x0b, 0x8f, 0x23, 0x24,
+	0x29, 0x67, 0x06, 0xaf, 0xdc, 0xc6, 0x25, 0x0d, 0x89, 0x90, 0x28, 0x1c, 0x19, 0xc2, 0x03, 0x5d,
+	0xcf, 0xd5, 0xca, 0xa6, 0xb8, 0x86, 0x8a, 0x28, 0xa4, 0x8c, 0xb7, 0xd4, 0x4f, 0x1d, 0xaa, 0xfd,
+	0x9a, 0x05, 0xa5, 0xaf, 0x51, 0x40, 0x31, 0x92, 0x3c, 0xda, 0xa3, 0x3e, 0xa3, 0xcc, 0xff, 0x84,
+	0x0d, 0x38, 0x6c, 0x83, 0x35, 0x84, 0x71, 0x44, 0x84, 0xb0, 0xad, 0xaa, 0x55, 0xdf, 0xe8, 0xd8,
+	0xbf, 0xfd, 0xd2, 0x28, 0x19, 0xb9, 0x17, 0x1a, 0xd9, 0x93, 0x11, 0x65, 0x7e, 0x2f, 0x21, 0xc2,
+	0x47, 0xa0, 0x20, 0x24, 0x8a, 0xa4, 0x3b, 0x24, 0xd4, 0x1f, 0x4a, 0x3b, 0x5b, 0xb5, 0xea, 0xb9,
+	0x5e, 0x5e, 0xc5, 0x3e, 0x56, 0xa1, 0x98, 0x42, 0x19, 0x26, 0x53, 0x97, 0x0f, 0x06, 0x82, 0x48,
+	0x3b, 0xa7, 0x29, 0x2a, 0xf6, 0x85, 0x0a, 0xc1, 0xcf, 0x40, 0xe1, 0x3b, 0x44, 0x03, 0x82, 0xdd,
+	0x31, 0x93, 0x34, 0xb0, 0x57, 0xaa, 0x56, 0x3d, 0xdf, 0x2e, 0x37, 0xf5, 0xe0, 0xcd, 0x64, 0xf0,
+	0xe6, 0x97, 0xc9, 0xe0, 0x9d, 0xcd, 0xf3, 0xcb, 0x4a, 0xe6, 0xe8, 0xcf, 0x8a, 0x75, 0x7a, 0x7d,
+	0xf6, 0xc4, 0xea, 0xe5, 0x75, 0xfa, 0x57, 0x71, 0x36, 0x74, 0x00, 0x90, 0x3c, 0xec, 0x0b, 0xc9,
+	0x19, 0xc1, 0xf6, 0xbd, 0xaa, 0x55, 0x5f, 0xef, 0xa5, 0x22, 0xb0, 0x0d, 0xee, 0x87, 0x54, 0x08,
+	0x82, 0xdd, 0x7e, 0xc0, 0xbd, 0x03, 0xe1, 0x7a, 0x7c, 0xcc, 0x24, 0x89, 0xec, 0x55, 0xd5, 0xd9,
+	0x9b, 0x1a, 0xec, 0x28, 0xec, 0xa5, 0x86, 0x9e, 0xaf, 0x1f, 0x9f, 0x54, 0x32, 0x7f, 0x9f, 0x54,
+	0xac, 0xda, 0x4f, 0x6b, 0x60, 0xb5, 0x8b, 0x22, 0x14, 0x0a, 0xf8, 0x0e, 0x28, 0x09, 0xea, 0xb3,
+	0x85, 0xd0, 0x84, 0x32, 0xcc, 0x27, 0x6a, 0x7b, 0xb9, 0x1e, 0xd4, 0x98, 0xd6, 0xd9, 0x57, 0x08,
+	0x1c, 0xc4, 0xa5, 0x99, 0x6b, 0xb2, 0x46, 0x24, 0x4a, 0x52, 0xe2, 0xbd, 0x15, 0x3a, 0xcf, 0xe2,
+	0xa9, 0xfe, 0xb8, 0xac, 0x3c, 0xf6, 0xa9, 0x1c, 0x8e, 0xfb, 0x4d, 0x8f, 0x87, 0xe6, 0x73, 0x9a,
+	0x5f, 0x0d, 0x81, 0x0f, 0x5a, 0xf2, 0xfb, 0x11, 0x11, 0xcd, 0x1d, 0xe2, 0xe9, 0xd9, 0x61, 0x48,
+	0xd9, 0x9e, 0x12, 0xec, 0x92, 0xc8, 0xd4, 0xf9, 0x16, 0x6c, 0x61, 0x3e, 0x61, 0xb1, 0x51, 0xdc,
+	0x78, 0x35, 0x6e, 0x62, 0x29, 0xb5, 0xfd, 0x7c, 0xfb, 0xc1, 0xd2, 0x6a, 0x77, 0x0c, 0x41, 0x6f,
+	0xf6, 0x78, 0xbe, 0xd9, 0x52, 0xa2, 0xf3, 0x0a, 0xd1, 0x20, 0x21, 0xc1, 0x11, 0x28, 0x2b, 0x73,
+	0xbb, 0x83, 0x08, 0x79, 0x71, 0xc4, 0xc5, 0x7c, 0xdc, 0x0f, 0x88, 0x9a, 0xcc, 0x5e, 0xb9, 0xfb,
+	0x30, 0xdb, 0x4a, 0xf6, 0x23, 0xa3, 0xba, 0xa3, 0x44, 0xe3, 0xe1, 0xe0, 0x01, 0xd8, 0x5e, 0xaa,
+	0xa8, 0x1b, 0xb3, 0xef, 0xdd, 0xbd, 0xdc, 0xfd, 0x5b, 0xe5, 0xb4, 0x22, 0x7c, 0x0f, 0xd8, 0x21,
+	0x9a, 0xba, 0x21, 0xa2, 0x4c, 0x12, 0x86, 0x98, 0x47, 0x16, 0x0b, 0xd4, 0x26, 0xd9, 0x0a, 0xd1,
+	0x74, 0x77, 0x01, 0xcf, 0x17, 0xf3, 0x03, 0xa8, 0xa4, 0xb3, 0xd2, 0x3e, 0x73, 0x27, 0xfa, 0x89,
+	0xac, 0xdd, 0xbd, 0xdd, 0x87, 0x29, 0xed, 0xdd, 0x85, 0x4b, 0xf7, 0xf5, 0x43, 0x6b, 0x00, 0x98,
+	0xae, 0x3d, 0x22, 0x11, 0xe5, 0xd8, 0x5e, 0x57, 0xfd, 0x16, 0x53, 0x48, 0x57, 0x01, 0xf0, 0x15,
+	0xa8, 0xdd, 0x1e, 0x52, 0x9b, 0x51, 0x28, 0x63, 0x9a, 0xf4, 0x8d, 0xaa, 0x55, 0xdf, 0xec, 0x39,
+	0x37, 0xc7, 0xd5, 0x2e, 0x13, 0x5d, 0x12, 0x19, 0xad, 0x0f, 0x40, 0x39, 0xd6, 0xf2, 0x38, 0xf3,
+	0xc6, 0x51, 0x44, 0x98, 0x4c, 0xcb, 0xda, 0x40, 0x69, 0xc4, 0x2b, 0x7d, 0x39, 0x27, 0xa4, 0xd4,
+	0xe0, 0xbb, 0x60, 0x2b, 0x7e, 0x15, 0xe9, 0x4e, 0x18, 0x97, 0xd4, 0x23, 0x76, 0x5e, 0x35, 0x5f,
+	0x0a, 0x29, 0x4b, 0xf1, 0x3f, 0x57, 0xd8, 0xf3, 0x47, 0x3f, 0x5e, 0x9f, 0x3d, 0x79, 0x98, 0x5a,
+	0xd6, 0x74, 0x71, 0x98, 0xf5, 0x03, 0xad, 0xfd, 0x9c, 0x05, 0xc5, 0xa5, 0xb6, 0xe1, 0x87, 0xa0,
+	0x78, 0x98, 0xdc, 0x3f, 0xf7, 0x75, 0x2f, 0xde, 0x1b, 0xf3, 0x14, 0x13, 0x87, 0xef, 0x83, 0x82,
+	0xc7, 0x99, 0x98, 0x2b, 0x64, 0xff, 0x47, 0x21, 0x1f, 0xb3, 0x5f, 0xfc, 0xcb, 0xdd, 0xcc, 0x2d,
+	0xdf, 0xcd, 0xb7, 0x00, 0x20, 0x0c, 0x27, 0x84, 0x15, 0x45, 0xd8, 0x20, 0x0c, 0x1b, 0xf8, 0x6d,
+	0xb0, 0x79, 0xe3, 0x8a, 0xa9, 0x67, 0x90, 0xeb, 0x15, 0xd2, 0xd7, 0x2b, 0x3e, 0x75, 0xfa, 0xb8,
+	0x61, 0xf7, 0x26, 0xd9, 0x9c, 0x3a, 0x03, 0xa6, 0xbc, 0x24, 0x3a, 0x9f, 0x9e, 0xce, 0x1c, 0xeb,
+	0x7c, 0xe6, 0x58, 0x17, 0x33, 0xc7, 0xfa, 0x6b, 0xe6, 0x58, 0x47, 0x57, 0x4e, 0xe6, 0xe2, 0xca,
+	0xc9, 0xfc, 0x7e, 0xe5, 0x64, 0xbe, 0x69, 0xfc, 0xa7, 0x5f, 0x53, 0x9f, 0x40, 0x59, 0xb7, 0xbf,
+	0xaa, 0x0e, 0xcc, 0xb3, 0x7f, 0x06, 0x00, 0x14, 0xba, 0xff, 0x59, 0x3b, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -306,6 +466,63 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.MaxMaintenanceDuration != that1.MaxMaintenanceDuration {
+		return false
+	}
+	if !this.MaintenanceMissedBlockWeight.Equal(that1.MaintenanceMissedBlockWeight) {
+		return false
+	}
+	if this.MaintenancePeriod != that1.MaintenancePeriod {
+		return false
+	}
+	if this.MaxMaintenanceWindowsPerPeriod != that1.MaxMaintenanceWindowsPerPeriod {
+		return false
+	}
+	if this.MaxConcurrentMaintenance != that1.MaxConcurrentMaintenance {
+		return false
+	}
+	if this.MinMaintenanceNotice != that1.MinMaintenanceNotice {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if this.CountedMissedBlocks != that1.CountedMissedBlocks {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -391,6 +608,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinMaintenanceNotice != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MinMaintenanceNotice))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxConcurrentMaintenance != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxConcurrentMaintenance))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxMaintenanceWindowsPerPeriod != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMaintenanceWindowsPerPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaintenancePeriod != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaintenancePeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaintenanceMissedBlockWeight.Size()
+		i -= size
+		if _, err := m.MaintenanceMissedBlockWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxMaintenanceDuration != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMaintenanceDuration))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -437,6 +689,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CountedMissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.CountedMissedBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -492,6 +801,52 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaxMaintenanceDuration != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMaintenanceDuration))
+	}
+	l = m.MaintenanceMissedBlockWeight.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaintenancePeriod != 0 {
+		n += 1 + sovSlashing(uint64(m.MaintenancePeriod))
+	}
+	if m.MaxMaintenanceWindowsPerPeriod != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMaintenanceWindowsPerPeriod))
+	}
+	if m.MaxConcurrentMaintenance != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxConcurrentMaintenance))
+	}
+	if m.MinMaintenanceNotice != 0 {
+		n += 1 + sovSlashing(uint64(m.MinMaintenanceNotice))
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.EndHeight))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	if m.CountedMissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.CountedMissedBlocks))
+	}
 	return n
 }
 
@@ -873,6 +1228,324 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceDuration", wireType)
+			}
+			m.MaxMaintenanceDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMissedBlockWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMissedBlockWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenancePeriod", wireType)
+			}
+			m.MaintenancePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenancePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceWindowsPerPeriod", wireType)
+			}
+			m.MaxMaintenanceWindowsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceWindowsPerPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentMaintenance", wireType)
+			}
+			m.MaxConcurrentMaintenance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrentMaintenance |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMaintenanceNotice", wireType)
+			}
+			m.MinMaintenanceNotice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMaintenanceNotice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountedMissedBlocks", wireType)
+			}
+			m.CountedMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountedMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -281,6 +281,86 @@ func (m *MsgImpeachResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgImpeachResponse proto.InternalMessageInfo
 
+// MsgDeclareMaintenance defines the Msg/DeclareMaintenance request type
+type MsgDeclareMaintenance struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_height is the first block height of the maintenance window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// duration is the number of blocks of the maintenance window.
+	Duration int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MsgDeclareMaintenance) Reset()         { *m = MsgDeclareMaintenance{} }
+func (m *MsgDeclareMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclareMaintenance) ProtoMessage()    {}
+func (*MsgDeclareMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{6}
+}
+func (m *MsgDeclareMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclareMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclareMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeclareMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclareMaintenance.Merge(m, src)
+}
+func (m *MsgDeclareMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclareMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclareMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeclareMaintenance proto.InternalMessageInfo
+
+// MsgDeclareMaintenanceResponse defines the Msg/DeclareMaintenance response type.
+type MsgDeclareMaintenanceResponse struct {
+}
+
+func (m *MsgDeclareMaintenanceResponse) Reset()         { *m = MsgDeclareMaintenanceResponse{} }
+func (m *MsgDeclareMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclareMaintenanceResponse) ProtoMessage()    {}
+func (*MsgDeclareMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{7}
+}
+func (m *MsgDeclareMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclareMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclareMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeclareMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclareMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgDeclareMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclareMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclareMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeclareMaintenanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
@@ -288,46 +368,55 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgImpeach)(nil), "cosmos.slashing.v1beta1.MsgImpeach")
 	proto.RegisterType((*MsgImpeachResponse)(nil), "cosmos.slashing.v1beta1.MsgImpeachResponse")
+	proto.RegisterType((*MsgDeclareMaintenance)(nil), "cosmos.slashing.v1beta1.MsgDeclareMaintenance")
+	proto.RegisterType((*MsgDeclareMaintenanceResponse)(nil), "cosmos.slashing.v1beta1.MsgDeclareMaintenanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x05, 0x52, 0xe5, 0xf8, 0x19, 0x13, 0xa9, 0xa9, 0x07, 0x3b, 0x32, 0x02, 0x45,
-	0x81, 0xd8, 0xb4, 0x54, 0x08, 0x65, 0x23, 0x12, 0x03, 0x42, 0x91, 0x50, 0x10, 0x12, 0xa2, 0x43,
-	0x75, 0x89, 0x8d, 0xe3, 0x12, 0xfb, 0x2c, 0xbf, 0x6b, 0xd5, 0x6e, 0x88, 0x09, 0x31, 0x31, 0x30,
-	0x30, 0xa1, 0x88, 0xa9, 0x1b, 0x19, 0xf8, 0x1f, 0xe8, 0x58, 0x31, 0x31, 0x55, 0x28, 0x19, 0x82,
-	0xf8, 0x2b, 0xd0, 0xf9, 0xce, 0x4e, 0x13, 0x35, 0x69, 0x97, 0xc4, 0xf7, 0xee, 0xf3, 0xbe, 0xef,
-	0x7d, 0xef, 0xdd, 0xe1, 0x72, 0x87, 0x42, 0x40, 0xc1, 0x86, 0x1e, 0x81, 0xae, 0x1f, 0x7a, 0xf6,
-	0xee, 0x5a, 0xdb, 0x65, 0x64, 0xcd, 0x66, 0x7b, 0x56, 0x14, 0x53, 0x46, 0xd5, 0x15, 0x41, 0x58,
-	0x29, 0x61, 0x49, 0x42, 0x2b, 0x7a, 0xd4, 0xa3, 0x09, 0x63, 0xf3, 0x2f, 0x81, 0x6b, 0x77, 0xe6,
-	0x09, 0x66, 0xf9, 0x82, 0x5b, 0x15, 0xdc, 0x96, 0x10, 0x90, 0x35, 0xc4, 0x96, 0xac, 0x68, 0x07,
-	0xc0, 0xb3, 0xf9, 0x9f, 0xdc, 0x28, 0x90, 0xc0, 0x0f, 0xa9, 0x9d, 0xfc, 0x8a, 0x90, 0xf9, 0x15,
-	0xe1, 0x7c, 0x13, 0xbc, 0x97, 0xe1, 0x36, 0xf1, 0x7b, 0xea, 0x26, 0xbe, 0xb6, 0x4b, 0x7a, 0xbe,
-	0x43, 0x18, 0x8d, 0xb7, 0x88, 0xe3, 0xc4, 0x25, 0x54, 0x46, 0x95, 0x7c, 0x63, 0xe3, 0xdf, 0xb1,
-	0xb1, 0xcc, 0xd7, 0x2e, 0xc0, 0xaf, 0x1f, 0xb5, 0xa2, 0x2c, 0xf7, 0x58, 0x44, 0x5e, 0xb0, 0xd8,
-	0x0f, 0xbd, 0x6f, 0xe3, 0x41, 0x35, 0x65, 0x0e, 0xc6, 0x83, 0x2a, 0x6a, 0x5d, 0xcd, 0xb4, 0x38,
-	0x58, 0xdf, 0xf8, 0xd0, 0x37, 0x94, 0x2f, 0x7d, 0x03, 0xbd, 0x1f, 0x0f, 0xaa, 0x33, 0x75, 0x3e,
-	0x8e, 0x07, 0x55, 0xa9, 0x5a, 0x03, 0xe7, 0xad, 0x9d, 0xb5, 0x64, 0xde, 0xc4, 0x85, 0x6c, 0xd1,
-	0x72, 0x21, 0xa2, 0x21, 0xb8, 0xe6, 0x4f, 0x84, 0xaf, 0xf3, 0x68, 0xe4, 0x10, 0xe6, 0x3e, 0x27,
-	0x31, 0x09, 0x40, 0x7d, 0x88, 0xf3, 0x64, 0x87, 0x75, 0x69, 0xec, 0xb3, 0x7d, 0xd9, 0x76, 0x69,
-	0x5e, 0xaf, 0xad, 0x09, 0xaa, 0x36, 0x70, 0x2e, 0x4a, 0x14, 0x4a, 0x4b, 0x65, 0x54, 0xb9, 0xbc,
-	0x6e, 0x58, 0x73, 0x06, 0x66, 0x89, 0x42, 0x8d, 0xfc, 0xe1, 0xb1, 0xa1, 0x08, 0x87, 0x32, 0xb3,
-	0xfe, 0x88, 0x5b, 0x9a, 0x68, 0x72, 0x37, 0xb7, 0x4f, 0xb8, 0xd9, 0x9b, 0x4c, 0x73, 0xa6, 0x6b,
-	0x73, 0x15, 0xaf, 0xcc, 0x84, 0x32, 0x93, 0x9f, 0x11, 0xc6, 0x4d, 0xf0, 0x9e, 0x06, 0x91, 0x4b,
-	0x3a, 0x5d, 0xf5, 0x1e, 0xbe, 0xf8, 0x26, 0xa6, 0xc1, 0x99, 0xd6, 0x12, 0x4a, 0x7d, 0x82, 0x0b,
-	0xd3, 0x27, 0xec, 0x82, 0x30, 0xb8, 0x28, 0xf5, 0xc6, 0xd4, 0xc0, 0x5c, 0x80, 0x7a, 0x81, 0xcf,
-	0xec, 0x6f, 0xdf, 0x50, 0xb8, 0xc1, 0x44, 0xd9, 0x2c, 0x62, 0x75, 0xd2, 0x55, 0xda, 0xec, 0xfa,
-	0xf7, 0x25, 0x7c, 0xa1, 0x09, 0x9e, 0xfa, 0x0a, 0xe7, 0xe4, 0x5d, 0x32, 0xe7, 0x9e, 0x63, 0x36,
-	0x4f, 0xad, 0x7a, 0x36, 0x93, 0x56, 0x50, 0xb7, 0xf1, 0x95, 0xa9, 0x79, 0x57, 0x16, 0xe6, 0x9e,
-	0x20, 0xb5, 0xfb, 0xe7, 0x25, 0xb3, 0x5a, 0x9b, 0x78, 0x39, 0x3d, 0xf6, 0x5b, 0x8b, 0x92, 0x25,
-	0xa4, 0xdd, 0x3d, 0x07, 0x94, 0x8a, 0x6b, 0x97, 0xde, 0xf1, 0xbb, 0xd3, 0x78, 0x76, 0x30, 0xd4,
-	0xd1, 0xe1, 0x50, 0x47, 0x47, 0x43, 0x1d, 0xfd, 0x19, 0xea, 0xe8, 0xd3, 0x48, 0x57, 0x8e, 0x46,
-	0xba, 0xf2, 0x7b, 0xa4, 0x2b, 0xaf, 0x6b, 0x9e, 0xcf, 0xba, 0x3b, 0x6d, 0xab, 0x43, 0x03, 0xf9,
-	0xb8, 0xed, 0xd3, 0x2f, 0x14, 0xdb, 0x8f, 0x5c, 0x68, 0xe7, 0x92, 0xd7, 0xfc, 0xe0, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x17, 0x2b, 0xa9, 0x8f, 0x04, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x77, 0x8c, 0xb6, 0x66, 0x5a, 0x7f, 0x64, 0xac, 0x34, 0x5d, 0x70, 0x37, 0xae, 0x28,
+	0x25, 0xda, 0x5d, 0x5b, 0x4b, 0x91, 0x5c, 0xc4, 0xa0, 0xa0, 0x48, 0x40, 0x22, 0x82, 0xd8, 0x43,
+	0x99, 0x66, 0xc7, 0xdd, 0xad, 0xd9, 0x9d, 0x65, 0x67, 0x5a, 0x5a, 0xf0, 0x20, 0x9e, 0x8a, 0x27,
+	0x0f, 0x1e, 0x3c, 0x49, 0xf1, 0xd4, 0x8b, 0x90, 0x83, 0xff, 0x83, 0x3d, 0x16, 0x4f, 0x9e, 0x8a,
+	0xa4, 0x87, 0x88, 0x7f, 0x85, 0xcc, 0xee, 0xec, 0xa6, 0x69, 0x93, 0xb4, 0xbd, 0x24, 0x3b, 0x6f,
+	0x3e, 0xef, 0xbd, 0xef, 0x77, 0xe7, 0xcd, 0xc2, 0x52, 0x83, 0x32, 0x9f, 0x32, 0x8b, 0x35, 0x31,
+	0x73, 0xbd, 0xc0, 0xb1, 0xd6, 0x66, 0x97, 0x09, 0xc7, 0xb3, 0x16, 0x5f, 0x37, 0xc3, 0x88, 0x72,
+	0x8a, 0x26, 0x13, 0xc2, 0x4c, 0x09, 0x53, 0x12, 0xea, 0x84, 0x43, 0x1d, 0x1a, 0x33, 0x96, 0x78,
+	0x4a, 0x70, 0xf5, 0xd6, 0xa0, 0x82, 0x59, 0x7e, 0xc2, 0x4d, 0x25, 0xdc, 0x52, 0x52, 0x40, 0xf6,
+	0x48, 0xb6, 0x64, 0x47, 0xcb, 0x67, 0x22, 0x5b, 0xfc, 0xc9, 0x8d, 0x02, 0xf6, 0xbd, 0x80, 0x5a,
+	0xf1, 0x6f, 0x12, 0x32, 0xbe, 0x02, 0x98, 0xaf, 0x31, 0xe7, 0x65, 0xb0, 0x82, 0xbd, 0x26, 0x5a,
+	0x84, 0x17, 0xd7, 0x70, 0xd3, 0xb3, 0x31, 0xa7, 0xd1, 0x12, 0xb6, 0xed, 0xa8, 0x08, 0x4a, 0x60,
+	0x3a, 0x5f, 0x9d, 0xff, 0xb7, 0xa7, 0x8f, 0x8a, 0x35, 0x61, 0xec, 0xd7, 0x8f, 0x99, 0x09, 0xd9,
+	0xee, 0x61, 0x12, 0x79, 0xc1, 0x23, 0x2f, 0x70, 0xbe, 0x75, 0x5a, 0xe5, 0x94, 0xd9, 0xee, 0xb4,
+	0xca, 0xa0, 0x7e, 0x21, 0xab, 0x25, 0xc0, 0xca, 0xfc, 0xe6, 0x96, 0xae, 0x7c, 0xd9, 0xd2, 0xc1,
+	0x87, 0x4e, 0xab, 0x7c, 0xa8, 0xcf, 0xc7, 0x4e, 0xab, 0x2c, 0xab, 0xce, 0x30, 0xfb, 0xad, 0x95,
+	0x49, 0x32, 0xae, 0xc0, 0x42, 0xb6, 0xa8, 0x13, 0x16, 0xd2, 0x80, 0x11, 0xe3, 0x27, 0x80, 0x97,
+	0x44, 0x34, 0xb4, 0x31, 0x27, 0xcf, 0x71, 0x84, 0x7d, 0x86, 0x16, 0x60, 0x1e, 0xaf, 0x72, 0x97,
+	0x46, 0x1e, 0xdf, 0x90, 0xb2, 0x8b, 0x83, 0xb4, 0xd6, 0xbb, 0x28, 0xaa, 0xc2, 0x91, 0x30, 0xae,
+	0x50, 0x3c, 0x53, 0x02, 0xd3, 0x63, 0x73, 0xba, 0x39, 0xe0, 0xc0, 0xcc, 0xa4, 0x51, 0x35, 0xbf,
+	0xb3, 0xa7, 0x2b, 0x89, 0x43, 0x99, 0x59, 0xb9, 0x2f, 0x2c, 0x75, 0x6b, 0x0a, 0x37, 0x37, 0x0f,
+	0xb8, 0x59, 0xef, 0x9e, 0xe6, 0x21, 0xd5, 0xc6, 0x14, 0x9c, 0x3c, 0x14, 0xca, 0x4c, 0x7e, 0x06,
+	0x10, 0xd6, 0x98, 0xf3, 0xd4, 0x0f, 0x09, 0x6e, 0xb8, 0xe8, 0x0e, 0x3c, 0xfb, 0x26, 0xa2, 0xfe,
+	0xb1, 0xd6, 0x62, 0x0a, 0x3d, 0x86, 0x85, 0xde, 0x37, 0x4c, 0x58, 0x62, 0x70, 0x58, 0xea, 0xe5,
+	0x9e, 0x03, 0x23, 0x8c, 0x55, 0x0a, 0xe2, 0xcc, 0xfe, 0x6e, 0xe9, 0x8a, 0x30, 0x18, 0x57, 0x36,
+	0x26, 0x20, 0xea, 0xaa, 0xca, 0xc4, 0xee, 0x01, 0x78, 0xb5, 0xc6, 0x9c, 0x47, 0xa4, 0xd1, 0xc4,
+	0x11, 0xa9, 0x61, 0x2f, 0xe0, 0x24, 0xc0, 0x41, 0x83, 0xf4, 0x57, 0x02, 0x4e, 0xab, 0x04, 0x5d,
+	0x87, 0xe3, 0x8c, 0xe3, 0x88, 0x2f, 0xb9, 0xc4, 0x73, 0x5c, 0x1e, 0x7b, 0xc9, 0xd5, 0xc7, 0xe2,
+	0xd8, 0x93, 0x38, 0x84, 0x54, 0x78, 0xde, 0x5e, 0x8d, 0x30, 0xf7, 0x68, 0x50, 0xcc, 0xc5, 0xdb,
+	0xd9, 0xba, 0xf2, 0x60, 0x53, 0x9a, 0x38, 0x2a, 0x46, 0x9c, 0x56, 0xa9, 0x77, 0xf6, 0x8e, 0xda,
+	0x30, 0x74, 0x78, 0xad, 0xef, 0x46, 0xfa, 0x06, 0xe6, 0xbe, 0xe7, 0x60, 0xae, 0xc6, 0x1c, 0xf4,
+	0x0a, 0x8e, 0xc8, 0xdb, 0x64, 0x0c, 0x9c, 0xa4, 0x6c, 0xa2, 0xd5, 0xf2, 0xf1, 0x4c, 0xda, 0x01,
+	0xad, 0xc0, 0xf1, 0x9e, 0x89, 0x9f, 0x1e, 0x9a, 0x7b, 0x80, 0x54, 0xef, 0x9e, 0x94, 0xcc, 0x7a,
+	0x2d, 0xc2, 0xd1, 0x74, 0xf0, 0x6e, 0x0c, 0x4b, 0x96, 0x90, 0x7a, 0xfb, 0x04, 0x50, 0x56, 0xfc,
+	0x1d, 0x44, 0x7d, 0x06, 0xc5, 0x1c, 0x56, 0xe2, 0x28, 0xaf, 0x2e, 0x9c, 0x8e, 0x4f, 0xbb, 0xab,
+	0xe7, 0xde, 0x8b, 0xbb, 0x5b, 0x7d, 0xb6, 0xdd, 0xd6, 0xc0, 0x4e, 0x5b, 0x03, 0xbb, 0x6d, 0x0d,
+	0xfc, 0x69, 0x6b, 0xe0, 0xd3, 0xbe, 0xa6, 0xec, 0xee, 0x6b, 0xca, 0xef, 0x7d, 0x4d, 0x79, 0x3d,
+	0xe3, 0x78, 0xdc, 0x5d, 0x5d, 0x36, 0x1b, 0xd4, 0x97, 0x1f, 0x57, 0xab, 0xff, 0x85, 0xe6, 0x1b,
+	0x21, 0x61, 0xcb, 0x23, 0xf1, 0xd7, 0xf4, 0xde, 0xff, 0x01, 0x00, 0x1e, 0x71, 0x10, 0xf6, 0x0f,
+	0x06, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -444,6 +533,57 @@ func (this *MsgImpeachResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgDeclareMaintenance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeclareMaintenance)
+	if !ok {
+		that2, ok := that.(MsgDeclareMaintenance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (this *MsgDeclareMaintenanceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeclareMaintenanceResponse)
+	if !ok {
+		that2, ok := that.(MsgDeclareMaintenanceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -468,6 +608,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// Impeach defines a method for removing an existing validator after gov proposal passes.
 	Impeach(ctx context.Context, in *MsgImpeach, opts ...grpc.CallOption) (*MsgImpeachResponse, error)
+	// DeclareMaintenance defines a method for a validator to declare a maintenance window ahead of
+	// time, during which its missed blocks are counted at a reduced weight.
+	DeclareMaintenance(ctx context.Context, in *MsgDeclareMaintenance, opts ...grpc.CallOption) (*MsgDeclareMaintenanceResponse, error)
 }
 
 type msgClient struct {
@@ -505,6 +648,15 @@ func (c *msgClient) Impeach(ctx context.Context, in *MsgImpeach, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) DeclareMaintenance(ctx context.Context, in *MsgDeclareMaintenance, opts ...grpc.CallOption) (*MsgDeclareMaintenanceResponse, error) {
+	out := new(MsgDeclareMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/DeclareMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -518,6 +670,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// Impeach defines a method for removing an existing validator after gov proposal passes.
 	Impeach(context.Context, *MsgImpeach) (*MsgImpeachResponse, error)
+	// DeclareMaintenance defines a method for a validator to declare a maintenance window ahead of
+	// time, during which its missed blocks are counted at a reduced weight.
+	DeclareMaintenance(context.Context, *MsgDeclareMaintenance) (*MsgDeclareMaintenanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -533,6 +688,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) Impeach(ctx context.Context, req *MsgImpeach) (*MsgImpeachResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Impeach not implemented")
 }
+func (*UnimplementedMsgServer) DeclareMaintenance(ctx context.Context, req *MsgDeclareMaintenance) (*MsgDeclareMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclareMaintenance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -592,6 +750,24 @@ func _Msg_Impeach_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeclareMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclareMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclareMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/DeclareMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclareMaintenance(ctx, req.(*MsgDeclareMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -608,6 +784,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Impeach",
 			Handler:    _Msg_Impeach_Handler,
 		},
+		{
+			MethodName: "DeclareMaintenance",
+			Handler:    _Msg_DeclareMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -789,6 +969,69 @@ func (m *MsgImpeachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDeclareMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclareMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclareMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclareMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclareMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclareMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -872,6 +1115,34 @@ func (m *MsgImpeachResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDeclareMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgDeclareMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1339,6 +1610,176 @@ func (m *MsgImpeachResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDeclareMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeclareMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeclareMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeclareMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeclareMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeclareMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
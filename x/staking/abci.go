@@ -23,5 +23,7 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.ApplyMatureKeyRotations(ctx)
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/stretchr/testify/require"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"cosmossdk.io/math"
@@ -25,6 +26,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -143,3 +145,194 @@ func TestStakingMsgs(t *testing.T) {
 	// balance should be the same because bonding not yet complete
 	require.True(t, sdk.Coins{genCoin.Sub(bondCoin)}.IsEqual(bankKeeper.GetAllBalances(ctxCheck, addr2)))
 }
+
+func TestCreateValidatorSelf(t *testing.T) {
+	genTokens := sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction)
+	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, genTokens)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, bondTokens)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
+
+	accs := []simtestutil.GenesisAccount{
+		{GenesisAccount: &authtypes.BaseAccount{Address: addr1.String()}, Coins: sdk.Coins{genCoin}},
+		{GenesisAccount: &authtypes.BaseAccount{Address: addr2.String()}, Coins: sdk.Coins{genCoin}},
+	}
+
+	var (
+		bk bankKeeper.Keeper
+		sk *stakingKeeper.Keeper
+	)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = accs
+
+	app, err := simtestutil.SetupWithConfiguration(testutil.AppConfig, startupCfg, &bk, &sk)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: sdktestutil.DefaultChainId, Height: 10})
+	msgServer := stakingKeeper.NewMsgServerImpl(sk)
+
+	newMsg := func(addr sdk.AccAddress, value sdk.Coin) *types.MsgCreateValidatorSelf {
+		blsSecretKey, _ := bls.GenerateBlsKey()
+		blsPubKey := hex.EncodeToString(blsSecretKey.PublicKey().Marshal())
+		blsProofBuf, _ := blsSecretKey.Sign(tmhash.Sum(blsSecretKey.PublicKey().Marshal()), votepool.DST)
+		blsProofBts, _ := blsProofBuf.Marshal()
+		msg, err := types.NewMsgCreateValidatorSelf(
+			addr, ed25519.GenPrivKey().PubKey(),
+			value, types.NewDescription("self_moniker", "", "", "", ""), commissionRates, sdk.OneInt(),
+			addr, addr, blsPubKey, hex.EncodeToString(blsProofBts),
+		)
+		require.NoError(t, err)
+		require.NoError(t, msg.ValidateBasic())
+		return msg
+	}
+
+	// disabled by default
+	_, err = msgServer.CreateValidatorSelf(ctx, newMsg(addr1, bondCoin))
+	require.ErrorIs(t, err, types.ErrSelfCreateValidatorDisabled)
+
+	params := sk.GetParams(ctx)
+	params.SelfCreateValidator = types.SelfCreateValidatorParams{
+		Enabled:     true,
+		MinSelfBond: bondTokens,
+		Deposit:     depositCoins,
+		AllowList:   []string{addr1.String()},
+		EpochLength: 100,
+		MaxPerEpoch: 1,
+	}
+	require.NoError(t, sk.SetParams(ctx, params))
+
+	_, err = msgServer.CreateValidatorSelf(ctx, newMsg(addr2, bondCoin))
+	require.ErrorIs(t, err, types.ErrSelfCreateValidatorNotAllowed)
+
+	_, err = msgServer.CreateValidatorSelf(ctx, newMsg(addr1, sdk.NewCoin(sdk.DefaultBondDenom, bondTokens.SubRaw(1))))
+	require.ErrorIs(t, err, types.ErrSelfBondTooLow)
+
+	// the self bond is delegated and the deposit escrowed
+	_, err = msgServer.CreateValidatorSelf(ctx, newMsg(addr1, bondCoin))
+	require.NoError(t, err)
+	require.True(t, sdk.NewCoins(genCoin.Sub(bondCoin)).Sub(depositCoins...).IsEqual(bk.GetAllBalances(ctx, addr1)))
+	deposit, found := sk.GetValidatorDeposit(ctx, addr1)
+	require.True(t, found)
+	require.True(t, depositCoins.IsEqual(deposit.Amount))
+	validator, found := sk.GetValidator(ctx, addr1)
+	require.True(t, found)
+	require.Equal(t, types.Unbonded, validator.Status)
+
+	// the number of new validators is capped per epoch
+	params.SelfCreateValidator.AllowList = nil
+	require.NoError(t, sk.SetParams(ctx, params))
+	_, err = msgServer.CreateValidatorSelf(ctx, newMsg(addr2, bondCoin))
+	require.ErrorIs(t, err, types.ErrSelfCreateValidatorCapReached)
+	_, err = msgServer.CreateValidatorSelf(ctx.WithBlockHeight(100), newMsg(addr2, bondCoin))
+	require.NoError(t, err)
+
+	// the deposit is refunded when the validator is bonded
+	_, err = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	validator, _ = sk.GetValidator(ctx, addr1)
+	require.Equal(t, types.Bonded, validator.Status)
+	require.True(t, sdk.NewCoins(genCoin.Sub(bondCoin)).IsEqual(bk.GetAllBalances(ctx, addr1)))
+	_, found = sk.GetValidatorDeposit(ctx, addr1)
+	require.False(t, found)
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	genTokens := sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction)
+	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, genTokens)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, bondTokens)
+
+	accs := []simtestutil.GenesisAccount{
+		{GenesisAccount: &authtypes.BaseAccount{Address: addr1.String()}, Coins: sdk.Coins{genCoin}},
+	}
+
+	var (
+		bk bankKeeper.Keeper
+		sk *stakingKeeper.Keeper
+		lk slashingkeeper.Keeper
+	)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = accs
+
+	app, err := simtestutil.SetupWithConfiguration(testutil.AppConfig, startupCfg, &bk, &sk, &lk)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: sdktestutil.DefaultChainId, Height: 10})
+	msgServer := stakingKeeper.NewMsgServerImpl(sk)
+
+	params := sk.GetParams(ctx)
+	params.SelfCreateValidator.Enabled = true
+	require.NoError(t, sk.SetParams(ctx, params))
+
+	blsSecretKey, _ := bls.GenerateBlsKey()
+	blsPubKey := hex.EncodeToString(blsSecretKey.PublicKey().Marshal())
+	blsProofBuf, _ := blsSecretKey.Sign(tmhash.Sum(blsSecretKey.PublicKey().Marshal()), votepool.DST)
+	blsProofBts, _ := blsProofBuf.Marshal()
+	oldPk := ed25519.GenPrivKey().PubKey()
+	createMsg, err := types.NewMsgCreateValidatorSelf(
+		addr1, oldPk, bondCoin, types.NewDescription("rotate_moniker", "", "", "", ""), commissionRates, sdk.OneInt(),
+		addr1, addr1, blsPubKey, hex.EncodeToString(blsProofBts),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidatorSelf(ctx, createMsg)
+	require.NoError(t, err)
+	_, err = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// the consensus pubkey of a bonded validator is rotated
+	ctx = ctx.WithBlockHeight(11)
+	newPk := ed25519.GenPrivKey().PubKey()
+	rotateMsg, err := types.NewMsgRotateConsPubKey(addr1, newPk)
+	require.NoError(t, err)
+	require.NoError(t, rotateMsg.ValidateBasic())
+	_, err = msgServer.RotateConsPubKey(ctx, rotateMsg)
+	require.NoError(t, err)
+
+	balance := genCoin.Sub(bondCoin).Sub(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultConsPubKeyRotationFeeAmount))
+	require.True(t, sdk.NewCoins(balance).IsEqual(bk.GetAllBalances(ctx, addr1)))
+	validator, _ := sk.GetValidator(ctx, addr1)
+	require.True(t, validator.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey).Equals(newPk))
+
+	// the validator set update swaps the keys
+	updates, err := sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(newPk)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, oldTmPk, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, newTmPk, updates[1].PubKey)
+	require.Equal(t, int64(10), updates[1].Power)
+
+	// the rotation is recorded and the old consensus address still resolves to the validator
+	history := sk.GetValidatorConsPubKeyRotationHistory(ctx, addr1)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(11), history[0].Height)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultConsPubKeyRotationFeeAmount), history[0].Fee)
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(oldPk), sdk.GetConsAddress(newPk)
+	require.Equal(t, newConsAddr, sk.ValidatorIdentifier(ctx, oldConsAddr))
+	validator, found := sk.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, addr1.String(), validator.OperatorAddress)
+
+	// the signing info moved to the new consensus address
+	_, found = lk.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(t, found)
+	info, found := lk.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	_, err = lk.GetPubkey(ctx, oldPk.Address())
+	require.NoError(t, err)
+
+	// the rotated pubkey cannot be reused, and rotations are rate limited
+	rotateMsg, err = types.NewMsgRotateConsPubKey(addr1, oldPk)
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(ctx.WithBlockHeight(12), rotateMsg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+	rotateMsg, err = types.NewMsgRotateConsPubKey(addr1, ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(ctx.WithBlockHeight(12), rotateMsg)
+	require.ErrorIs(t, err, types.ErrKeyRotationTooFrequent)
+}
@@ -39,6 +39,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryPendingKeyRotations(),
+		GetCmdQueryValidatorConsPubKeyRotations(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +746,97 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryPendingKeyRotations implements the pending key rotations query command.
+func GetCmdQueryPendingKeyRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-key-rotations [validator-addr]",
+		Short: "Query the key rotations of validators that are not active yet",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending rotations of the relayer address, challenger address and BLS key of all
+validators, or of a single validator.
+
+Example:
+$ %s query staking pending-key-rotations
+$ %s query staking pending-key-rotations 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingKeyRotationsRequest{}
+			if len(args) == 1 {
+				valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = valAddr.String()
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingKeyRotations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending key rotations")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorConsPubKeyRotations implements the consensus pubkey rotations query command.
+func GetCmdQueryValidatorConsPubKeyRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cons-pubkey-rotations [validator-addr]",
+		Short: "Query the consensus pubkey rotations of a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the history of the consensus pubkey rotations of a validator.
+
+Example:
+$ %s query staking cons-pubkey-rotations 0x91D7deA99716Cbb247E81F1cfB692009164a967E
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorConsPubKeyRotations(cmd.Context(), &types.QueryValidatorConsPubKeyRotationsRequest{ValidatorAddr: valAddr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -48,7 +48,9 @@ func NewTxCmd() *cobra.Command {
 
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
+		NewCreateValidatorSelfCmd(),
 		NewEditValidatorCmd(),
+		NewRotateConsPubKeyCmd(),
 		NewDelegateCmd(),
 
 		NewUnbondCmd(),
@@ -172,6 +174,124 @@ modify the related configrations as you need, where you can get the pubkey using
 	return cmd
 }
 
+// NewCreateValidatorSelfCmd returns a CLI command handler for creating a MsgCreateValidatorSelf transaction.
+func NewCreateValidatorSelfCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-validator-self [path/to/create_validator.json]",
+		Short: "create new validator initialized with a self-delegation, without a governance proposal",
+		Args:  cobra.ExactArgs(1),
+		Long: `Create a new validator initialized with a self-delegation by submitting a JSON file with the new validator details.
+The creation of validators by their operator must be enabled in the staking params, and the anti-spam deposit defined
+in the params is escrowed until the validator is bonded for the first time.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %s tx staking create-validator-self path/to/create_validator.json --from keyname
+
+Where create_validator.json contains:
+
+{
+	"description": {
+		"moniker": "${NODE_NAME}",
+		"identity": "",
+		"website": "",
+		"security_contact": "",
+		"details": ""
+	},
+	"commission": {
+		"rate": "0.070000000000000000",
+		"max_rate": "1.000000000000000000",
+		"max_change_rate": "0.010000000000000000"
+	},
+	"min_self_delegation": "1000000000000000000000",
+	"validator_address": "${VALIDATOR_ADDR}",
+	"pubkey": {
+		"@type": "/cosmos.crypto.ed25519.PubKey",
+		"key": "${VALIDATOR_NODE_PUB_KEY}"
+	},
+	"value": {
+		"denom": "azkme",
+		"amount": "1000000000000000000000"
+	},
+	"relayer_address": "${RELAYER_ADDR}",
+	"challenger_address": "${CHALLENGER_ADDR}",
+	"bls_key": "${VALIDATOR_BLS}",
+	"bls_proof": "${VALIDATOR_BLS_PROOF}"
+}
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateValidatorSelf{}
+			if err := clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			if !valAddr.Equals(clientCtx.GetFromAddress()) {
+				return fmt.Errorf("the from address should be the validator address: %s", valAddr.String())
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// NewRotateConsPubKeyCmd returns a CLI command handler for creating a MsgRotateConsPubKey transaction.
+func NewRotateConsPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "rotate the consensus pubkey of a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: `Rotate the consensus pubkey of the validator operated by the from address. The rotation fee defined
+in the staking params is paid by the validator operator.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+$ %s tx staking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"${VALIDATOR_NODE_PUB_KEY}"}' --from keyname
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(clientCtx.GetFromAddress(), pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 // NewEditValidatorCmd returns a CLI command handler for creating a MsgEditValidator transaction.
 func NewEditValidatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus pubkey rotations of a validator, oldest first
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.AccAddress) (history []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		history = append(history, k.mustUnmarshalConsPubKeyRotationHistory(iterator.Value()))
+	}
+	return history
+}
+
+// GetLastConsPubKeyRotation returns the last consensus pubkey rotation of a validator
+func (k Keeper) GetLastConsPubKeyRotation(ctx sdk.Context, valAddr sdk.AccAddress) (history types.ConsPubKeyRotationHistory, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return history, false
+	}
+	return k.mustUnmarshalConsPubKeyRotationHistory(iterator.Value()), true
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus pubkey rotations of all validators
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) (history []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		history = append(history, k.mustUnmarshalConsPubKeyRotationHistory(iterator.Value()))
+	}
+	return history
+}
+
+// SetConsPubKeyRotationHistory records a consensus pubkey rotation of a validator. The rotated consensus
+// address keeps resolving to the validator, and is mapped to the consensus address which replaced it.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := sdk.AccAddressFromHexUnsafe(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	oldPk, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+	newPk, err := history.NewConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPk)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height), k.cdc.MustMarshal(&history))
+	store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), valAddr)
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), sdk.GetConsAddress(newPk))
+	return nil
+}
+
+// ValidatorIdentifier returns the current consensus address of the validator which used the given
+// consensus address. The address is returned as is if it was never rotated.
+func (k Keeper) ValidatorIdentifier(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+	for {
+		bz := store.Get(types.GetOldToNewConsAddrKey(consAddr))
+		if bz == nil {
+			return consAddr
+		}
+		consAddr = bz
+	}
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The validator set update sent to
+// CometBFT at the end of the block removes the old pubkey and adds the new one.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey, fee sdk.Coin) error {
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(validator.GetOperator(), oldPubKey, newPubKey, ctx.BlockHeight(), fee)
+	if err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBlockConsPubKeyRotationKey(ctx.BlockHeight(), validator.GetOperator()), []byte{})
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, validator.GetOperator(), oldPubKey, newPubKey); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, sdk.GetConsAddress(oldPubKey).String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(newPubKey).String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveValidatorConsPubKeyRotations removes the consensus pubkey rotations of a validator and the
+// indexes of its rotated consensus addresses
+func (k Keeper) RemoveValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, history := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		oldPk, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		oldConsAddr := sdk.GetConsAddress(oldPk)
+		store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+		store.Delete(types.GetOldToNewConsAddrKey(oldConsAddr))
+		store.Delete(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height))
+		store.Delete(types.GetBlockConsPubKeyRotationKey(history.Height, valAddr))
+	}
+}
+
+// dequeueBlockConsPubKeyRotations returns the consensus pubkey rotations of the current block
+// and removes them from the block index
+func (k Keeper) dequeueBlockConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetBlockConsPubKeyRotationPrefix(ctx.BlockHeight())
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		valAddr := sdk.AccAddress(iterator.Key()[len(prefix)+1:])
+		bz := store.Get(types.GetConsPubKeyRotationHistoryKey(valAddr, ctx.BlockHeight()))
+		if bz != nil {
+			rotations = append(rotations, k.mustUnmarshalConsPubKeyRotationHistory(bz))
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return rotations
+}
+
+// applyConsPubKeyRotations swaps the consensus pubkeys rotated in the current block in the validator
+// set updates. The validators in lastBonded were part of the validator set known by CometBFT.
+func (k Keeper) applyConsPubKeyRotations(
+	ctx sdk.Context, updates []abci.ValidatorUpdate, rotations []types.ConsPubKeyRotationHistory,
+	lastBonded map[string]bool, powerReduction math.Int,
+) ([]abci.ValidatorUpdate, error) {
+	for _, rotation := range rotations {
+		// validators which were not part of the validator set are added with their new pubkey if bonded
+		if !lastBonded[rotation.OperatorAddress] {
+			continue
+		}
+
+		oldPk, err := rotation.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+		if err != nil {
+			return nil, err
+		}
+		newPk, err := rotation.NewConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		newTmPk, err := cryptocodec.ToTmProtoPublicKey(newPk)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for i, update := range updates {
+			if update.PubKey.Compare(newTmPk) != 0 {
+				continue
+			}
+			found = true
+			if update.Power == 0 {
+				// the validator left the validator set, which only knows its old pubkey
+				updates[i].PubKey = oldTmPk
+			} else {
+				updates = append(updates, abci.ValidatorUpdate{
+					PubKey:         oldTmPk,
+					Power:          0,
+					RelayerAddress: update.RelayerAddress,
+					BlsKey:         update.BlsKey,
+				})
+			}
+			break
+		}
+		if found {
+			continue
+		}
+
+		// the power of the validator did not change, replace its pubkey
+		validator := k.mustGetValidator(ctx, sdk.MustAccAddressFromHex(rotation.OperatorAddress))
+		zero := validator.ABCIValidatorUpdateZero()
+		zero.PubKey = oldTmPk
+		updates = append(updates, zero, validator.ABCIValidatorUpdate(powerReduction))
+	}
+	return updates, nil
+}
+
+func (k Keeper) mustUnmarshalConsPubKeyRotationHistory(bz []byte) types.ConsPubKeyRotationHistory {
+	var history types.ConsPubKeyRotationHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history
+}
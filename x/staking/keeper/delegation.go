@@ -774,7 +774,9 @@ func (k Keeper) Unbond(
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
-		k.RemoveValidator(ctx, validator.GetOperator())
+		if err := k.RemoveValidator(ctx, validator.GetOperator()); err != nil {
+			return amount, err
+		}
 	}
 
 	return amount, nil
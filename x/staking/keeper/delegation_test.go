@@ -397,7 +397,7 @@ func (s *KeeperTestSuite) TestUndelegateFromUnbondedValidator() {
 
 	// unbond the validator
 	ctx = ctx.WithBlockTime(validator.UnbondingTime)
-	keeper.UnbondAllMatureValidators(ctx)
+	require.NoError(keeper.UnbondAllMatureValidators(ctx))
 
 	// Make sure validator is still in state because there is still an outstanding delegation
 	validator, found = keeper.GetValidator(ctx, addrVals[0])
@@ -476,7 +476,7 @@ func (s *KeeperTestSuite) TestUnbondingAllDelegationFromValidator() {
 
 	// unbond the validator
 	ctx = ctx.WithBlockTime(validator.UnbondingTime)
-	keeper.UnbondAllMatureValidators(ctx)
+	require.NoError(keeper.UnbondAllMatureValidators(ctx))
 
 	// validator should now be deleted from state
 	_, found = keeper.GetValidator(ctx, addrVals[0])
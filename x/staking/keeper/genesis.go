@@ -104,6 +104,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, rotation := range data.PendingKeyRotations {
+		k.SetPendingKeyRotation(ctx, rotation)
+	}
+
+	for _, record := range data.KeyRotationRecords {
+		k.SetKeyRotationRecord(ctx, record)
+	}
+
+	for _, deposit := range data.ValidatorDeposits {
+		k.SetValidatorDeposit(ctx, deposit)
+	}
+
+	for _, history := range data.ConsPubkeyRotationHistory {
+		if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -198,13 +216,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		PendingKeyRotations:       k.GetAllPendingKeyRotations(ctx),
+		KeyRotationRecords:        k.GetAllKeyRotationRecords(ctx),
+		ValidatorDeposits:         k.GetAllValidatorDeposits(ctx),
+		ConsPubkeyRotationHistory: k.GetAllConsPubKeyRotationHistory(ctx),
 	}
 }
@@ -464,6 +464,66 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// PendingKeyRotations queries the key rotations of validators that are not active yet
+func (k Querier) PendingKeyRotations(c context.Context, req *types.QueryPendingKeyRotationsRequest) (*types.QueryPendingKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		rotations := []types.KeyRotation{}
+		if rotation, found := k.GetPendingKeyRotation(ctx, valAddr); found {
+			rotations = append(rotations, rotation)
+		}
+		return &types.QueryPendingKeyRotationsResponse{Rotations: rotations}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	rotationStore := prefix.NewStore(store, types.PendingKeyRotationKey)
+
+	var rotations []types.KeyRotation
+	pageRes, err := query.Paginate(rotationStore, req.Pagination, func(key []byte, value []byte) error {
+		var rotation types.KeyRotation
+		if err := k.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// ValidatorConsPubKeyRotations queries the consensus pubkey rotation history of a validator
+func (k Querier) ValidatorConsPubKeyRotations(c context.Context, req *types.QueryValidatorConsPubKeyRotationsRequest) (*types.QueryValidatorConsPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	valAddr, err := sdk.AccAddressFromHexUnsafe(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	history := k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	if history == nil {
+		history = []types.ConsPubKeyRotationHistory{}
+	}
+	return &types.QueryValidatorConsPubKeyRotationsResponse{History: history}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromHexUnsafe(req.DelegatorAddr)
 	if err != nil {
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetPendingKeyRotation gets the pending key rotation of a validator
+func (k Keeper) GetPendingKeyRotation(ctx sdk.Context, valAddr sdk.AccAddress) (rotation types.KeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingKeyRotationKey(valAddr))
+	if bz == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true
+}
+
+// SetPendingKeyRotation sets the pending key rotation of a validator and inserts it into the key rotation queue
+func (k Keeper) SetPendingKeyRotation(ctx sdk.Context, rotation types.KeyRotation) {
+	valAddr := sdk.MustAccAddressFromHex(rotation.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingKeyRotationKey(valAddr), k.cdc.MustMarshal(&rotation))
+	store.Set(types.GetKeyRotationQueueKey(rotation.ActivationHeight, valAddr), []byte{})
+}
+
+// RemovePendingKeyRotation removes the pending key rotation of a validator and its key rotation queue entry
+func (k Keeper) RemovePendingKeyRotation(ctx sdk.Context, rotation types.KeyRotation) {
+	valAddr := sdk.MustAccAddressFromHex(rotation.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingKeyRotationKey(valAddr))
+	store.Delete(types.GetKeyRotationQueueKey(rotation.ActivationHeight, valAddr))
+}
+
+// IteratePendingKeyRotations iterates through the pending key rotations of all validators
+func (k Keeper) IteratePendingKeyRotations(ctx sdk.Context, cb func(rotation types.KeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.KeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllPendingKeyRotations returns the pending key rotations of all validators
+func (k Keeper) GetAllPendingKeyRotations(ctx sdk.Context) (rotations []types.KeyRotation) {
+	k.IteratePendingKeyRotations(ctx, func(rotation types.KeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+	return rotations
+}
+
+// GetKeyRotationRecord gets the record of the last key rotation of a validator
+func (k Keeper) GetKeyRotationRecord(ctx sdk.Context, valAddr sdk.AccAddress) (record types.KeyRotationRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyRotationRecordKey(valAddr))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetKeyRotationRecord sets the record of the last key rotation of a validator
+func (k Keeper) SetKeyRotationRecord(ctx sdk.Context, record types.KeyRotationRecord) {
+	valAddr := sdk.MustAccAddressFromHex(record.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyRotationRecordKey(valAddr), k.cdc.MustMarshal(&record))
+}
+
+// GetAllKeyRotationRecords returns the records of the last key rotation of all validators
+func (k Keeper) GetAllKeyRotationRecords(ctx sdk.Context) (records []types.KeyRotationRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRotationRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.KeyRotationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetValidatorPreviousKeys returns the keys a validator used before its last key rotation if they are
+// still accepted, i.e. the overlap window of the rotation has not ended yet.
+func (k Keeper) GetValidatorPreviousKeys(ctx sdk.Context, valAddr sdk.AccAddress) (types.KeyRotationRecord, bool) {
+	record, found := k.GetKeyRotationRecord(ctx, valAddr)
+	if !found || ctx.BlockHeight() >= record.OverlapEndHeight {
+		return types.KeyRotationRecord{}, false
+	}
+	return record, true
+}
+
+// RotateValidatorKeys queues the rotation of the relayer address, challenger address or BLS key of a
+// validator, which takes effect after the key rotation delay. A validator can have a single pending
+// rotation and must wait for the key rotation cooldown after the activation of its last rotation.
+func (k Keeper) RotateValidatorKeys(ctx sdk.Context, rotation types.KeyRotation) error {
+	valAddr, err := sdk.AccAddressFromHexUnsafe(rotation.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetPendingKeyRotation(ctx, valAddr); found {
+		return types.ErrKeyRotationPending
+	}
+
+	params := k.GetParams(ctx)
+	if record, found := k.GetKeyRotationRecord(ctx, valAddr); found {
+		if nextHeight := record.ActivationHeight + int64(params.KeyRotationCooldown); ctx.BlockHeight() < nextHeight {
+			return types.ErrKeyRotationTooFrequent.Wrapf("next rotation allowed at height %d", nextHeight)
+		}
+	}
+
+	if err := k.checkKeysNotPendingRotation(ctx, rotation.RelayerAddress, rotation.ChallengerAddress, rotation.BlsKey); err != nil {
+		return err
+	}
+
+	rotation.SubmitHeight = ctx.BlockHeight()
+	rotation.ActivationHeight = ctx.BlockHeight() + int64(params.KeyRotationDelay)
+	if params.KeyRotationDelay == 0 {
+		return k.applyKeyRotation(ctx, rotation)
+	}
+
+	k.SetPendingKeyRotation(ctx, rotation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueKeyRotation,
+			sdk.NewAttribute(types.AttributeKeyValidator, rotation.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRelayerAddress, rotation.RelayerAddress),
+			sdk.NewAttribute(types.AttributeKeyChallengerAddress, rotation.ChallengerAddress),
+			sdk.NewAttribute(types.AttributeKeyBlsKey, hex.EncodeToString(rotation.BlsKey)),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprintf("%d", rotation.ActivationHeight)),
+		),
+	)
+
+	return nil
+}
+
+// ApplyMatureKeyRotations applies all the key rotations of the queue activating at the current height
+// or before. Rotations whose keys have been taken by another validator in the meantime are cancelled.
+func (k Keeper) ApplyMatureKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyRotationQueueKey, types.GetKeyRotationQueueHeightKey(ctx.BlockHeight()+1))
+	defer iterator.Close()
+
+	var rotations []types.KeyRotation
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.AccAddress(iterator.Key()[len(types.GetKeyRotationQueueHeightKey(0))+1:])
+		if rotation, found := k.GetPendingKeyRotation(ctx, valAddr); found {
+			rotations = append(rotations, rotation)
+		}
+	}
+
+	for _, rotation := range rotations {
+		k.RemovePendingKeyRotation(ctx, rotation)
+		if err := k.applyKeyRotation(ctx, rotation); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCancelKeyRotation,
+					sdk.NewAttribute(types.AttributeKeyValidator, rotation.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+		}
+	}
+}
+
+// RemoveValidatorKeyRotations removes the pending key rotation and the key rotation record of a validator
+func (k Keeper) RemoveValidatorKeyRotations(ctx sdk.Context, valAddr sdk.AccAddress) {
+	if rotation, found := k.GetPendingKeyRotation(ctx, valAddr); found {
+		k.RemovePendingKeyRotation(ctx, rotation)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyRotationRecordKey(valAddr))
+}
+
+// applyKeyRotation replaces the keys of a validator and keeps the previous ones in the key rotation
+// record of the validator.
+func (k Keeper) applyKeyRotation(ctx sdk.Context, rotation types.KeyRotation) error {
+	valAddr, err := sdk.AccAddressFromHexUnsafe(rotation.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	record := types.KeyRotationRecord{
+		ValidatorAddress:      validator.OperatorAddress,
+		PrevRelayerAddress:    validator.RelayerAddress,
+		PrevChallengerAddress: validator.ChallengerAddress,
+		PrevBlsKey:            validator.BlsKey,
+		ActivationHeight:      ctx.BlockHeight(),
+		OverlapEndHeight:      ctx.BlockHeight() + int64(k.GetParams(ctx).KeyRotationOverlap),
+	}
+
+	if len(rotation.RelayerAddress) != 0 {
+		relayerAddr := sdk.MustAccAddressFromHex(rotation.RelayerAddress)
+		if tmpValidator, found := k.GetValidatorByRelayerAddr(ctx, relayerAddr); found && tmpValidator.OperatorAddress != validator.OperatorAddress {
+			return types.ErrValidatorRelayerAddressExists
+		}
+	}
+	if len(rotation.ChallengerAddress) != 0 {
+		challengerAddr := sdk.MustAccAddressFromHex(rotation.ChallengerAddress)
+		if tmpValidator, found := k.GetValidatorByChallengerAddr(ctx, challengerAddr); found && tmpValidator.OperatorAddress != validator.OperatorAddress {
+			return types.ErrValidatorChallengerAddressExists
+		}
+	}
+	if len(rotation.BlsKey) != 0 {
+		if tmpValidator, found := k.GetValidatorByBlsKey(ctx, rotation.BlsKey); found && tmpValidator.OperatorAddress != validator.OperatorAddress {
+			return types.ErrValidatorBlsKeyExists
+		}
+	}
+
+	if len(rotation.RelayerAddress) != 0 {
+		k.DeleteValidatorByRelayerAddress(ctx, validator)
+		validator.RelayerAddress = rotation.RelayerAddress
+		if err := k.SetValidatorByRelayerAddress(ctx, validator); err != nil {
+			return err
+		}
+	}
+	if len(rotation.ChallengerAddress) != 0 {
+		k.DeleteValidatorByChallengerAddress(ctx, validator)
+		validator.ChallengerAddress = rotation.ChallengerAddress
+		if err := k.SetValidatorByChallengerAddress(ctx, validator); err != nil {
+			return err
+		}
+	}
+	if len(rotation.BlsKey) != 0 {
+		k.DeleteValidatorByBlsKey(ctx, validator)
+		validator.BlsKey = rotation.BlsKey
+		if err := k.SetValidatorByBlsKey(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	k.SetValidator(ctx, validator)
+	k.SetKeyRotationRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActivateKeyRotation,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRelayerAddress, validator.RelayerAddress),
+			sdk.NewAttribute(types.AttributeKeyChallengerAddress, validator.ChallengerAddress),
+			sdk.NewAttribute(types.AttributeKeyBlsKey, hex.EncodeToString(validator.BlsKey)),
+		),
+	)
+
+	return nil
+}
+
+// checkKeysNotPendingRotation checks that none of the given keys is the new key of a pending key rotation.
+func (k Keeper) checkKeysNotPendingRotation(ctx sdk.Context, relayerAddr, challengerAddr string, blsKey []byte) (err error) {
+	k.IteratePendingKeyRotations(ctx, func(rotation types.KeyRotation) bool {
+		switch {
+		case len(relayerAddr) != 0 && rotation.RelayerAddress == relayerAddr:
+			err = types.ErrValidatorRelayerAddressExists
+		case len(challengerAddr) != 0 && rotation.ChallengerAddress == challengerAddr:
+			err = types.ErrValidatorChallengerAddressExists
+		case len(blsKey) != 0 && bytes.Equal(rotation.BlsKey, blsKey):
+			err = types.ErrValidatorBlsKeyExists
+		}
+		return err != nil
+	})
+	return err
+}
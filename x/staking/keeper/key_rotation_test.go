@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestKeyRotation() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.stakingKeeper
+	require := s.Require()
+
+	params := stakingtypes.DefaultParams()
+	params.KeyRotationDelay = 10
+	params.KeyRotationCooldown = 50
+	params.KeyRotationOverlap = 5
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddrs := []sdk.AccAddress{sdk.AccAddress(PKs[0].Address()), sdk.AccAddress(PKs[1].Address())}
+	for _, valAddr := range valAddrs {
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+		keeper.SetValidator(ctx, validator)
+		require.NoError(keeper.SetValidatorByRelayerAddress(ctx, validator))
+		require.NoError(keeper.SetValidatorByChallengerAddress(ctx, validator))
+	}
+	oldRelayer := valAddrs[0]
+	newRelayer := sdk.AccAddress(PKs[2].Address())
+	newChallenger := sdk.AccAddress(PKs[3].Address())
+
+	// the rotation is queued, the keys are not replaced yet
+	_, err := s.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		valAddrs[0], stakingtypes.Description{}, nil, nil, newRelayer, newChallenger, "", "",
+	))
+	require.NoError(err)
+	validator, _ := keeper.GetValidator(ctx, valAddrs[0])
+	require.Equal(oldRelayer.String(), validator.RelayerAddress)
+
+	res, err := s.queryClient.PendingKeyRotations(ctx, &stakingtypes.QueryPendingKeyRotationsRequest{})
+	require.NoError(err)
+	require.Len(res.Rotations, 1)
+	require.Equal(newRelayer.String(), res.Rotations[0].RelayerAddress)
+	require.Equal(int64(110), res.Rotations[0].ActivationHeight)
+
+	// a single rotation can be pending
+	_, err = s.msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		valAddrs[0], stakingtypes.Description{}, nil, nil, sdk.AccAddress(PKs[4].Address()), nil, "", "",
+	))
+	require.ErrorIs(err, stakingtypes.ErrKeyRotationPending)
+
+	// the keys of a pending rotation are reserved
+	err = keeper.RotateValidatorKeys(ctx, stakingtypes.KeyRotation{ValidatorAddress: valAddrs[1].String(), RelayerAddress: newRelayer.String()})
+	require.ErrorIs(err, stakingtypes.ErrValidatorRelayerAddressExists)
+
+	keeper.ApplyMatureKeyRotations(ctx.WithBlockHeight(109))
+	_, found := keeper.GetPendingKeyRotation(ctx, valAddrs[0])
+	require.True(found)
+
+	ctx = ctx.WithBlockHeight(110)
+	keeper.ApplyMatureKeyRotations(ctx)
+	_, found = keeper.GetPendingKeyRotation(ctx, valAddrs[0])
+	require.False(found)
+	validator, found = keeper.GetValidatorByRelayerAddr(ctx, newRelayer)
+	require.True(found)
+	require.Equal(valAddrs[0].String(), validator.OperatorAddress)
+	require.Equal(newChallenger.String(), validator.ChallengerAddress)
+	_, found = keeper.GetValidatorByRelayerAddr(ctx, oldRelayer)
+	require.False(found)
+
+	// the previous keys are accepted during the overlap window
+	prevKeys, found := keeper.GetValidatorPreviousKeys(ctx.WithBlockHeight(114), valAddrs[0])
+	require.True(found)
+	require.Equal(oldRelayer.String(), prevKeys.PrevRelayerAddress)
+	_, found = keeper.GetValidatorPreviousKeys(ctx.WithBlockHeight(115), valAddrs[0])
+	require.False(found)
+
+	// the next rotation is allowed after the cooldown
+	rotation := stakingtypes.KeyRotation{ValidatorAddress: valAddrs[0].String(), BlsKey: bytes.Repeat([]byte{1}, sdk.BLSPubKeyLength)}
+	err = keeper.RotateValidatorKeys(ctx.WithBlockHeight(159), rotation)
+	require.ErrorIs(err, stakingtypes.ErrKeyRotationTooFrequent)
+
+	// rotations take effect immediately without delay
+	params.KeyRotationDelay = 0
+	require.NoError(keeper.SetParams(ctx, params))
+	require.NoError(keeper.RotateValidatorKeys(ctx.WithBlockHeight(160), rotation))
+	validator, found = keeper.GetValidatorByBlsKey(ctx, rotation.BlsKey)
+	require.True(found)
+	require.Equal(valAddrs[0].String(), validator.OperatorAddress)
+}
@@ -18,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -38,119 +39,128 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
-	if err != nil {
-		return nil, err
-	}
-
 	delAddr, err := sdk.AccAddressFromHexUnsafe(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	// For genesis block, the signer should be the self delegator itself,
-	// for other blocks, the signer should be the gov module account.
-	govModuleAddr := k.authKeeper.GetModuleAddress(govtypes.ModuleName)
+	// for other blocks, the signer should be the gov module account,
+	// which must be granted the self delegation.
+	var grantee sdk.AccAddress
 	if ctx.BlockHeight() == 0 {
 		signers := msg.GetSigners()
 		if len(signers) != 1 || !signers[0].Equals(delAddr) {
 			return nil, types.ErrInvalidSigner
 		}
 	} else {
+		govModuleAddr := k.authKeeper.GetModuleAddress(govtypes.ModuleName)
 		signers := msg.GetSigners()
 		if len(signers) != 1 || !signers[0].Equals(govModuleAddr) {
 			return nil, types.ErrInvalidSigner
 		}
+		grantee = govModuleAddr
+	}
+
+	if err := k.createValidator(ctx, msg, grantee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateValidatorResponse{}, nil
+}
+
+// createValidator performs the checks and the store operations shared by the creation of a validator
+// by governance and by its operator. The self delegation must be authorized to the grantee, if any.
+func (k msgServer) createValidator(ctx sdk.Context, msg *types.MsgCreateValidator, grantee sdk.AccAddress) error {
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	delAddr, err := sdk.AccAddressFromHexUnsafe(msg.DelegatorAddress)
+	if err != nil {
+		return err
 	}
 
 	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
-		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+		return sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
 	// check to see if the operator address has been registered before
 	if _, found := k.GetValidator(ctx, valAddr); found {
-		return nil, types.ErrValidatorOwnerExists
+		return types.ErrValidatorOwnerExists
 	}
 
 	// check to see if the pubkey has been registered before
 	pk, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
 	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
-		return nil, types.ErrValidatorPubKeyExists
+		return types.ErrValidatorPubKeyExists
 	}
 
 	// check to see if the relayer address has been registered before
 	relayerAddr, err := sdk.AccAddressFromHexUnsafe(msg.RelayerAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if _, found := k.GetValidatorByRelayerAddr(ctx, relayerAddr); found {
-		return nil, types.ErrValidatorRelayerAddressExists
+		return types.ErrValidatorRelayerAddressExists
 	}
 
 	// check to see if the challenger address has been registered before
 	challengerAddr, err := sdk.AccAddressFromHexUnsafe(msg.ChallengerAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if _, found := k.GetValidatorByChallengerAddr(ctx, challengerAddr); found {
-		return nil, types.ErrValidatorChallengerAddressExists
+		return types.ErrValidatorChallengerAddressExists
 	}
 
 	// check to see if the bls pubkey has been registered before
 	blsPk, err := hex.DecodeString(msg.BlsKey)
 	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
-		return nil, types.ErrValidatorInvalidBlsKey
+		return types.ErrValidatorInvalidBlsKey
 	}
 	if _, found := k.GetValidatorByBlsKey(ctx, blsPk); found {
-		return nil, types.ErrValidatorBlsKeyExists
+		return types.ErrValidatorBlsKeyExists
+	}
+
+	// check to see if the keys are reserved by a pending key rotation of another validator
+	if err := k.checkKeysNotPendingRotation(ctx, relayerAddr.String(), challengerAddr.String(), blsPk); err != nil {
+		return err
 	}
 
 	// check to see if the bls proof is signed from operator
 	blsProof, err := hex.DecodeString(msg.BlsProof)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
+		return sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 	}
 	err = k.CheckBlsProof(blsPk, blsProof)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
+		return sdkerrors.Wrap(types.ErrValidatorInvalidBlsProof, err.Error())
 	}
 
 	bondDenom := k.BondDenom(ctx)
 	if msg.Value.Denom != bondDenom {
-		return nil, sdkerrors.Wrapf(
+		return sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Value.Denom, bondDenom,
 		)
 	}
 
 	if _, err := msg.Description.EnsureLength(); err != nil {
-		return nil, err
+		return err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, sdkerrors.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := checkPubKeyType(ctx, pk); err != nil {
+		return err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description, delAddr, relayerAddr, challengerAddr, blsPk)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	commission := types.NewCommissionWithTime(
@@ -159,12 +169,12 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	)
 
 	if msg.MinSelfDelegation.LT(k.MinSelfDelegation(ctx)) {
-		return nil, types.ErrInvalidMinSelfDelegation
+		return types.ErrInvalidMinSelfDelegation
 	}
 
 	validator, err = validator.SetInitialCommission(commission)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
@@ -179,14 +189,14 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	// call the after-creation hook
 	if err := k.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()); err != nil {
-		return nil, err
+		return err
 	}
 
-	// check the delegate staking authorization from the delegator to the gov module account
-	if ctx.BlockHeight() != 0 {
-		err = k.CheckStakeAuthorization(ctx, govModuleAddr, delAddr, types.NewMsgDelegate(delAddr, valAddr, msg.Value))
+	// check the delegate staking authorization from the delegator to the grantee
+	if grantee != nil {
+		err = k.CheckStakeAuthorization(ctx, grantee, delAddr, types.NewMsgDelegate(delAddr, valAddr, msg.Value))
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
@@ -195,13 +205,13 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	// NOTE source will always be from a wallet which are unbonded
 	_, err = k.Keeper.Delegate(ctx, delAddr, msg.Value.Amount, types.Unbonded, validator, true)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// the validator should self delegate enough coins to be an active validator when creating
 	selfDelAmount, _ := k.GetSelfDelegation(ctx, valAddr)
 	if selfDelAmount.LT(validator.MinSelfDelegation) {
-		return nil, types.ErrNotEnoughDelegationShares
+		return types.ErrNotEnoughDelegationShares
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -217,7 +227,48 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		),
 	})
 
-	return &types.MsgCreateValidatorResponse{}, nil
+	return nil
+}
+
+// CreateValidatorSelf defines a method for creating a new validator signed by its operator
+func (k msgServer) CreateValidatorSelf(goCtx context.Context, msg *types.MsgCreateValidatorSelf) (*types.MsgCreateValidatorSelfResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx).SelfCreateValidator
+	if !params.Enabled {
+		return nil, types.ErrSelfCreateValidatorDisabled
+	}
+
+	if !params.IsAllowed(valAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrSelfCreateValidatorNotAllowed, "operator %s is not in the allow list", valAddr)
+	}
+
+	if !params.MinSelfBond.IsNil() && msg.Value.Amount.LT(params.MinSelfBond) {
+		return nil, sdkerrors.Wrapf(types.ErrSelfBondTooLow, "got %s, expected at least %s", msg.Value.Amount, params.MinSelfBond)
+	}
+
+	if params.MaxPerEpoch > 0 && k.GetSelfCreatedValidators(ctx, params.EpochLength) >= params.MaxPerEpoch {
+		return nil, sdkerrors.Wrapf(types.ErrSelfCreateValidatorCapReached, "cap is %d", params.MaxPerEpoch)
+	}
+
+	if err := k.createValidator(ctx, msg.ToMsgCreateValidator(), nil); err != nil {
+		return nil, err
+	}
+
+	if err := k.EscrowValidatorDeposit(ctx, valAddr, params.Deposit); err != nil {
+		return nil, err
+	}
+
+	if params.MaxPerEpoch > 0 {
+		k.IncrementSelfCreatedValidators(ctx, params.EpochLength)
+	}
+
+	return &types.MsgCreateValidatorSelfResponse{}, nil
 }
 
 // EditValidator defines a method for editing an existing validator
@@ -267,7 +318,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.MinSelfDelegation = *msg.MinSelfDelegation
 	}
 
-	// replace relayer address
+	// rotate relayer address, challenger address and bls pubkey, which are replaced only after the
+	// key rotation delay
+	rotation := types.KeyRotation{ValidatorAddress: validator.OperatorAddress}
+
 	if len(msg.RelayerAddress) != 0 {
 		relayerAddr, err := sdk.AccAddressFromHexUnsafe(msg.RelayerAddress)
 		if err != nil {
@@ -278,13 +332,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 				return nil, types.ErrValidatorRelayerAddressExists
 			}
 		} else {
-			k.DeleteValidatorByRelayerAddress(ctx, validator)
-			validator.RelayerAddress = relayerAddr.String()
-			k.SetValidatorByRelayerAddress(ctx, validator)
+			rotation.RelayerAddress = relayerAddr.String()
 		}
 	}
 
-	// replace challenger address
 	if len(msg.ChallengerAddress) != 0 {
 		challengerAddr, err := sdk.AccAddressFromHexUnsafe(msg.ChallengerAddress)
 		if err != nil {
@@ -295,13 +346,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 				return nil, types.ErrValidatorChallengerAddressExists
 			}
 		} else {
-			k.DeleteValidatorByChallengerAddress(ctx, validator)
-			validator.ChallengerAddress = challengerAddr.String()
-			k.SetValidatorByChallengerAddress(ctx, validator)
+			rotation.ChallengerAddress = challengerAddr.String()
 		}
 	}
 
-	// replace bls pubkey
 	if len(msg.BlsKey) != 0 && len(msg.BlsProof) != 0 {
 		blsPk, err := hex.DecodeString(msg.BlsKey)
 		if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
@@ -322,14 +370,19 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 				return nil, types.ErrValidatorBlsKeyExists
 			}
 		} else {
-			k.DeleteValidatorByBlsKey(ctx, validator)
-			validator.BlsKey = blsPk
-			k.SetValidatorByBlsKey(ctx, validator)
+			rotation.BlsKey = blsPk
 		}
 	}
 
 	k.SetValidator(ctx, validator)
 
+	if !rotation.IsEmpty() {
+		if err := k.RotateValidatorKeys(ctx, rotation); err != nil {
+			return nil, err
+		}
+		validator = k.mustGetValidator(ctx, valAddr)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditValidator,
@@ -664,3 +717,73 @@ func (ms msgServer) CheckBlsProof(blsPk, sig []byte) error {
 	}
 	return nil
 }
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	// the consensus addresses used by validators, including the rotated ones, cannot be reused
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, types.ErrValidatorPubKeyExists
+	}
+
+	if err := checkPubKeyType(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	// a single rotation can take place per block, the cooldown is shared with the other keys
+	params := k.GetParams(ctx)
+	if last, found := k.GetLastConsPubKeyRotation(ctx, valAddr); found {
+		if ctx.BlockHeight() == last.Height || uint64(ctx.BlockHeight()-last.Height) < params.KeyRotationCooldown {
+			return nil, sdkerrors.Wrapf(types.ErrKeyRotationTooFrequent, "last consensus pubkey rotation at height %d", last.Height)
+		}
+	}
+
+	fee := sdk.NewCoin(params.BondDenom, sdk.ZeroInt())
+	if params.HasConsPubKeyRotationFee() {
+		fee = params.ConsPubkeyRotationFee
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, valAddr, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk, fee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// checkPubKeyType checks that the type of a consensus pubkey is supported by the consensus params
+func checkPubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Validator == nil {
+		return nil
+	}
+
+	pkType := pk.Type()
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pkType == keyType {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
+}
@@ -32,7 +32,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	}
 
 	// unbond all mature validators from the unbonding queue
-	k.UnbondAllMatureValidators(ctx)
+	if err := k.UnbondAllMatureValidators(ctx); err != nil {
+		panic(err)
+	}
 
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
@@ -127,6 +129,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations of the block, which have to be
+	// applied to the validators already known by CometBFT.
+	rotations := k.dequeueBlockConsPubKeyRotations(ctx)
+	lastBonded := make(map[string]bool, len(last))
+	for valAddrStr := range last {
+		lastBonded[valAddrStr] = true
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -211,6 +221,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	updates, err = k.applyConsPubKeyRotations(ctx, updates, rotations, lastBonded, powerReduction)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -308,6 +323,11 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 	// delete from queue if present
 	k.DeleteValidatorQueue(ctx, validator)
 
+	// the deposit of a validator created by its operator is refunded on its first activation
+	if err := k.refundValidatorDeposit(ctx, validator.GetOperator()); err != nil {
+		return validator, err
+	}
+
 	// trigger hook
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
@@ -238,11 +238,11 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
-func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
+func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) error {
 	// first retrieve the old validator record
 	validator, found := k.GetValidator(ctx, address)
 	if !found {
-		return
+		return nil
 	}
 
 	if !validator.IsUnbonded() {
@@ -258,6 +258,12 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 		panic(err)
 	}
 
+	// the deposit of a validator removed before it was ever bonded is forfeited, it is burnt before
+	// the validator record is deleted so that nothing is removed if the burn fails
+	if err := k.burnValidatorDeposit(ctx, address); err != nil {
+		return err
+	}
+
 	// delete the old validator record
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetValidatorKey(address))
@@ -267,10 +273,14 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 	store.Delete(types.GetValidatorByBlsKey(validator.BlsKey))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.RemoveValidatorKeyRotations(ctx, address)
+	k.RemoveValidatorConsPubKeyRotations(ctx, address)
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
 	}
+
+	return nil
 }
 
 // get groups of validators
@@ -521,7 +531,7 @@ func (k Keeper) ValidatorQueueIterator(ctx sdk.Context, endTime time.Time, endHe
 
 // UnbondAllMatureValidators unbonds all the mature unbonding validators that
 // have finished their unbonding period.
-func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
+func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) error {
 	blockTime := ctx.BlockTime()
 	blockHeight := ctx.BlockHeight()
 
@@ -569,7 +579,9 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 					val = k.UnbondingToUnbonded(ctx, val)
 
 					if val.GetDelegatorShares().IsZero() {
-						k.RemoveValidator(ctx, val.GetOperator())
+						if err := k.RemoveValidator(ctx, val.GetOperator()); err != nil {
+							return err
+						}
 					} else {
 						// remove unbonding ids
 						val.UnbondingIds = []uint64{}
@@ -581,6 +593,8 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 			}
 		}
 	}
+
+	return nil
 }
 
 func (k Keeper) IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool {
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/willf/bitset"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// blsKeyCacheHeights is the number of heights whose deserialised validator BLS keys are cached
+const blsKeyCacheHeights = 4
+
+// AttestationThresholdType defines how the votes of the validators attesting data are counted
+type AttestationThresholdType int
+
+const (
+	// AttestationThresholdByCount counts one vote per validator
+	AttestationThresholdByCount AttestationThresholdType = iota
+	// AttestationThresholdByVotingPower weights the vote of each validator by its consensus power
+	AttestationThresholdByVotingPower
+)
+
+// AttestationThreshold is the fraction of the votes of a validator set the signers of an attestation must
+// exceed: the votes of the signers must be greater than Numerator / Denominator of the total votes,
+// rounded down.
+type AttestationThreshold struct {
+	Type        AttestationThresholdType
+	Numerator   uint64
+	Denominator uint64
+}
+
+// DefaultAttestationThreshold requires the signatures of more than 2/3 of the validators
+var DefaultAttestationThreshold = AttestationThreshold{
+	Type:        AttestationThresholdByCount,
+	Numerator:   2,
+	Denominator: 3,
+}
+
+// Validate validates the attestation threshold
+func (t AttestationThreshold) Validate() error {
+	if t.Type != AttestationThresholdByCount && t.Type != AttestationThresholdByVotingPower {
+		return fmt.Errorf("invalid attestation threshold type %d", t.Type)
+	}
+	if t.Denominator == 0 || t.Numerator > t.Denominator {
+		return fmt.Errorf("invalid attestation threshold %d/%d", t.Numerator, t.Denominator)
+	}
+	return nil
+}
+
+// AttestationStakingKeeper defines the staking keeper used by ValidatorAttestation, implemented by Keeper
+type AttestationStakingKeeper interface {
+	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
+	GetValidatorPreviousKeys(ctx sdk.Context, valAddr sdk.AccAddress) (types.KeyRotationRecord, bool)
+	PowerReduction(ctx sdk.Context) math.Int
+}
+
+// ValidatorAttestation verifies the data attested by the validators with an aggregated BLS signature.
+// The signers are selected by a bitset over the historical validator set of a height, and their votes
+// must exceed a threshold.
+type ValidatorAttestation struct {
+	sk        AttestationStakingKeeper
+	threshold AttestationThreshold
+	keyCache  *blsKeyCache
+}
+
+// NewValidatorAttestation returns a ValidatorAttestation verifying attestations against the validator
+// sets of sk with threshold.
+func NewValidatorAttestation(sk AttestationStakingKeeper, threshold AttestationThreshold) *ValidatorAttestation {
+	if err := threshold.Validate(); err != nil {
+		panic(err)
+	}
+	return &ValidatorAttestation{
+		sk:        sk,
+		threshold: threshold,
+		keyCache:  newBLSKeyCache(),
+	}
+}
+
+// Verify verifies that aggSig is the BLS signature of msg with the domain separation tag dst, aggregated
+// from the signatures of the validators of the historical validator set of height selected by
+// voteAddressSet, and that their votes exceed the threshold. It returns the validators which signed.
+//
+// The signatures may have been collected before a BLS key rotation of some signers, so their previous
+// BLS keys are still accepted during the overlap window of the rotation. Each signer in the overlap
+// window may have signed with either key, so the combinations of their keys are tried.
+func (va *ValidatorAttestation) Verify(
+	ctx sdk.Context, height int64, voteAddressSet []uint64, aggSig, msg, dst []byte,
+) ([]types.Validator, error) {
+	historicalInfo, ok := va.sk.GetHistoricalInfo(ctx, height)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrAttestationValidatorSet, "get historical validators at height %d failed", height)
+	}
+	validators := historicalInfo.Valset
+
+	validatorsBitSet := bitset.From(voteAddressSet)
+	if validatorsBitSet.Count() > uint(len(validators)) {
+		return nil, errors.Wrapf(types.ErrAttestationValidatorSet, "number of validator set is larger than validators")
+	}
+
+	signers := make([]types.Validator, 0, validatorsBitSet.Count())
+	for index, val := range validators {
+		if validatorsBitSet.Test(uint(index)) {
+			signers = append(signers, val)
+		}
+	}
+
+	if err := va.checkThreshold(ctx, validators, signers); err != nil {
+		return nil, err
+	}
+
+	sig, err := bls.UnmarshalSignature(aggSig)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrAttestationInvalidSignature, "BLS signature converts failed: %v", err)
+	}
+
+	pubKeys, err := va.signerPubKeys(height, signers)
+	if err != nil {
+		return nil, err
+	}
+	if sig.VerifyAggregated(pubKeys, msg, dst) {
+		return signers, nil
+	}
+
+	prevPubKeys, err := va.signerPrevPubKeys(ctx, height, signers)
+	if err != nil {
+		return nil, err
+	}
+	// rotating are the indexes of the signers which have a previous key
+	var rotating []int
+	for i, prevPubKey := range prevPubKeys {
+		if prevPubKey != nil {
+			rotating = append(rotating, i)
+		}
+	}
+
+	keys := make([]*bls.PublicKey, len(pubKeys))
+	// the first combination, with the current keys only, was verified above
+	for _, usePrev := range prevKeyCombinations(len(rotating))[1:] {
+		copy(keys, pubKeys)
+		for j, i := range rotating {
+			if usePrev.Test(uint(j)) {
+				keys[i] = prevPubKeys[i]
+			}
+		}
+		if sig.VerifyAggregated(keys, msg, dst) {
+			return signers, nil
+		}
+	}
+	return nil, errors.Wrapf(types.ErrAttestationInvalidSignature, "signature verify failed")
+}
+
+// maxMixedKeyRotations is the maximum number of signers in the overlap window of a BLS key rotation
+// for which every combination of their previous and current keys is tried, as each combination costs
+// a signature verification. Above it, the signers must all use either their current or previous keys.
+const maxMixedKeyRotations = 4
+
+// prevKeyCombinations returns the combinations of previous and current keys of n signers in the overlap
+// window of a BLS key rotation, as the bitsets of the signers using their previous key. The first
+// combination uses the current keys only.
+func prevKeyCombinations(n int) []*bitset.BitSet {
+	if n > maxMixedKeyRotations {
+		return []*bitset.BitSet{bitset.New(uint(n)), bitset.New(uint(n)).Complement()}
+	}
+	combinations := make([]*bitset.BitSet, 0, 1<<n)
+	for mask := uint64(0); mask < 1<<n; mask++ {
+		combinations = append(combinations, bitset.From([]uint64{mask}))
+	}
+	return combinations
+}
+
+// checkThreshold checks that the votes of signers exceed the threshold of the votes of validators
+func (va *ValidatorAttestation) checkThreshold(ctx sdk.Context, validators, signers []types.Validator) error {
+	var total, voted math.Int
+	switch va.threshold.Type {
+	case AttestationThresholdByVotingPower:
+		powerReduction := va.sk.PowerReduction(ctx)
+		total, voted = math.ZeroInt(), math.ZeroInt()
+		for _, val := range validators {
+			total = total.AddRaw(val.ConsensusPower(powerReduction))
+		}
+		for _, val := range signers {
+			voted = voted.AddRaw(val.ConsensusPower(powerReduction))
+		}
+	default:
+		total, voted = math.NewInt(int64(len(validators))), math.NewInt(int64(len(signers)))
+	}
+
+	threshold := total.Mul(math.NewIntFromUint64(va.threshold.Numerator)).Quo(math.NewIntFromUint64(va.threshold.Denominator))
+	if voted.LTE(threshold) {
+		return errors.Wrapf(types.ErrAttestationVotesNotEnough, "not enough validators voted, need more than: %s, voted: %s", threshold, voted)
+	}
+	return nil
+}
+
+// signerPubKeys returns the BLS public keys of the signers
+func (va *ValidatorAttestation) signerPubKeys(height int64, signers []types.Validator) ([]*bls.PublicKey, error) {
+	pubKeys := make([]*bls.PublicKey, len(signers))
+	for i, val := range signers {
+		pubKey, err := va.keyCache.get(height, val.BlsKey)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrAttestationBlsPubKey, "BLS public key converts failed: %v", err)
+		}
+		pubKeys[i] = pubKey
+	}
+	return pubKeys, nil
+}
+
+// signerPrevPubKeys returns the previous BLS public keys of the signers whose key rotation is in its
+// overlap window. The previous key of the other signers is nil.
+func (va *ValidatorAttestation) signerPrevPubKeys(
+	ctx sdk.Context, height int64, signers []types.Validator,
+) ([]*bls.PublicKey, error) {
+	prevPubKeys := make([]*bls.PublicKey, len(signers))
+	for i, val := range signers {
+		prevKeys, found := va.sk.GetValidatorPreviousKeys(ctx, val.GetOperator())
+		if !found || len(prevKeys.PrevBlsKey) == 0 || bytes.Equal(prevKeys.PrevBlsKey, val.BlsKey) {
+			continue
+		}
+		pubKey, err := va.keyCache.get(height, prevKeys.PrevBlsKey)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrAttestationBlsPubKey, "BLS public key converts failed: %v", err)
+		}
+		prevPubKeys[i] = pubKey
+	}
+	return prevPubKeys, nil
+}
+
+// blsKeyCache caches the deserialised validator BLS keys of the latest heights. The keys are looked up by
+// their bytes, so a cached height never returns a key which is not the one requested.
+type blsKeyCache struct {
+	mtx  sync.Mutex
+	keys map[int64]map[string]*bls.PublicKey
+}
+
+func newBLSKeyCache() *blsKeyCache {
+	return &blsKeyCache{keys: make(map[int64]map[string]*bls.PublicKey)}
+}
+
+// get returns the deserialised BLS key of blsKey, caching it for height
+func (c *blsKeyCache) get(height int64, blsKey []byte) (*bls.PublicKey, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	heightKeys, ok := c.keys[height]
+	if ok {
+		if pubKey, ok := heightKeys[string(blsKey)]; ok {
+			return pubKey, nil
+		}
+	}
+
+	pubKey, err := bls.UnmarshalPublicKey(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		c.evict()
+		heightKeys = make(map[string]*bls.PublicKey)
+		c.keys[height] = heightKeys
+	}
+	heightKeys[string(blsKey)] = pubKey
+	return pubKey, nil
+}
+
+// evict removes the lowest heights until a new height can be cached
+func (c *blsKeyCache) evict() {
+	if len(c.keys) < blsKeyCacheHeights {
+		return
+	}
+	heights := make([]int64, 0, len(c.keys))
+	for height := range c.keys {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for _, height := range heights[:len(heights)-blsKeyCacheHeights+1] {
+		delete(c.keys, height)
+	}
+}
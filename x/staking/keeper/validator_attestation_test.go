@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/cometbft/cometbft/votepool"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setAttestationValidators sets the historical validator set of height to validators with the powers
+// 40, 30, 20 and 10, in that order, and returns their BLS keys.
+func (s *KeeperTestSuite) setAttestationValidators(height int64) []*bls.PrivateKey {
+	ctx, stakingKeeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(4)
+	validators := make([]stakingtypes.Validator, len(addrVals))
+	blsKeys := make([]*bls.PrivateKey, len(addrVals))
+	for i, valAddr := range addrVals {
+		blsKey, err := bls.GenerateBlsKey()
+		require.NoError(err)
+		blsKeys[i] = blsKey
+
+		validators[i] = testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validators[i].Status = stakingtypes.Bonded
+		validators[i].Tokens = stakingKeeper.TokensFromConsensusPower(ctx, int64(40-10*i))
+		validators[i].BlsKey = blsKey.PublicKey().Marshal()
+	}
+
+	hi := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), validators, stakingKeeper.PowerReduction(ctx))
+	stakingKeeper.SetHistoricalInfo(ctx, height, &hi)
+	return blsKeys
+}
+
+func aggregateBlsSignature(s *KeeperTestSuite, blsKeys []*bls.PrivateKey, msg []byte) []byte {
+	signatures := make(bls.Signatures, 0, len(blsKeys))
+	for _, blsKey := range blsKeys {
+		sig, err := blsKey.Sign(msg, votepool.DST)
+		s.Require().NoError(err)
+		signatures = append(signatures, sig)
+	}
+	aggSig, err := signatures.Aggregate().Marshal()
+	s.Require().NoError(err)
+	return aggSig
+}
+
+func (s *KeeperTestSuite) TestValidatorAttestationVerify() {
+	ctx := s.ctx
+	require := s.Require()
+
+	height := ctx.BlockHeight()
+	blsKeys := s.setAttestationValidators(height)
+	msg := []byte("attested data")
+
+	byCount := keeper.NewValidatorAttestation(s.stakingKeeper, keeper.DefaultAttestationThreshold)
+	byPower := keeper.NewValidatorAttestation(s.stakingKeeper, keeper.AttestationThreshold{
+		Type:        keeper.AttestationThresholdByVotingPower,
+		Numerator:   2,
+		Denominator: 3,
+	})
+
+	testCases := []struct {
+		name        string
+		attestation *keeper.ValidatorAttestation
+		height      int64
+		bitset      uint64
+		signers     []*bls.PrivateKey
+		expErr      error
+	}{
+		{
+			name:        "3 of 4 validators by count",
+			attestation: byCount,
+			height:      height,
+			bitset:      0b1110,
+			signers:     blsKeys[1:],
+		},
+		{
+			name:        "2 of 4 validators by count",
+			attestation: byCount,
+			height:      height,
+			bitset:      0b0011,
+			signers:     blsKeys[:2],
+			expErr:      stakingtypes.ErrAttestationVotesNotEnough,
+		},
+		{
+			name:        "70% of voting power",
+			attestation: byPower,
+			height:      height,
+			bitset:      0b0011,
+			signers:     blsKeys[:2],
+		},
+		{
+			name:        "60% of voting power",
+			attestation: byPower,
+			height:      height,
+			bitset:      0b1110,
+			signers:     blsKeys[1:],
+			expErr:      stakingtypes.ErrAttestationVotesNotEnough,
+		},
+		{
+			name:        "signature of other validators",
+			attestation: byCount,
+			height:      height,
+			bitset:      0b1110,
+			signers:     blsKeys[:3],
+			expErr:      stakingtypes.ErrAttestationInvalidSignature,
+		},
+		{
+			name:        "unknown height",
+			attestation: byCount,
+			height:      height + 1,
+			bitset:      0b1110,
+			signers:     blsKeys[1:],
+			expErr:      stakingtypes.ErrAttestationValidatorSet,
+		},
+		{
+			name:        "more signers than validators",
+			attestation: byCount,
+			height:      height,
+			bitset:      0b11111,
+			signers:     blsKeys,
+			expErr:      stakingtypes.ErrAttestationValidatorSet,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			aggSig := aggregateBlsSignature(s, tc.signers, msg)
+			signers, err := tc.attestation.Verify(ctx, tc.height, []uint64{tc.bitset}, aggSig, msg, votepool.DST)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+				return
+			}
+			require.NoError(err)
+			require.Len(signers, len(tc.signers))
+			for i, signer := range signers {
+				require.Equal(tc.signers[i].PublicKey().Marshal(), signer.BlsKey)
+			}
+		})
+	}
+
+	// the signature is bound to the message and the domain
+	aggSig := aggregateBlsSignature(s, blsKeys[1:], msg)
+	_, err := byCount.Verify(ctx, height, []uint64{0b1110}, aggSig, []byte("other data"), votepool.DST)
+	require.ErrorIs(err, stakingtypes.ErrAttestationInvalidSignature)
+	_, err = byCount.Verify(ctx, height, []uint64{0b1110}, aggSig, msg, []byte("OTHER_DST"))
+	require.ErrorIs(err, stakingtypes.ErrAttestationInvalidSignature)
+}
+
+func (s *KeeperTestSuite) TestValidatorAttestationPreviousBlsKey() {
+	ctx, stakingKeeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	height := ctx.BlockHeight()
+	blsKeys := s.setAttestationValidators(height)
+	hi, found := stakingKeeper.GetHistoricalInfo(ctx, height)
+	require.True(found)
+	msg := []byte("attested data")
+
+	// the second validator rotated its BLS key after signing
+	prevBlsKey, err := bls.GenerateBlsKey()
+	require.NoError(err)
+	stakingKeeper.SetKeyRotationRecord(ctx, stakingtypes.KeyRotationRecord{
+		ValidatorAddress: hi.Valset[1].OperatorAddress,
+		PrevBlsKey:       prevBlsKey.PublicKey().Marshal(),
+		ActivationHeight: height,
+		OverlapEndHeight: height + 10,
+	})
+	aggSig := aggregateBlsSignature(s, []*bls.PrivateKey{blsKeys[0], prevBlsKey, blsKeys[2]}, msg)
+
+	attestation := keeper.NewValidatorAttestation(stakingKeeper, keeper.DefaultAttestationThreshold)
+	signers, err := attestation.Verify(ctx, height, []uint64{0b0111}, aggSig, msg, votepool.DST)
+	require.NoError(err)
+	require.Equal(hi.Valset[:3], signers)
+
+	// the previous key is no longer accepted after the overlap window
+	_, err = attestation.Verify(ctx.WithBlockHeight(height+10), height, []uint64{0b0111}, aggSig, msg, votepool.DST)
+	require.ErrorIs(err, stakingtypes.ErrAttestationInvalidSignature)
+
+	// the third validator rotated its BLS key too, but signed with its current key
+	prevBlsKey2, err := bls.GenerateBlsKey()
+	require.NoError(err)
+	stakingKeeper.SetKeyRotationRecord(ctx, stakingtypes.KeyRotationRecord{
+		ValidatorAddress: hi.Valset[2].OperatorAddress,
+		PrevBlsKey:       prevBlsKey2.PublicKey().Marshal(),
+		ActivationHeight: height,
+		OverlapEndHeight: height + 10,
+	})
+	signers, err = attestation.Verify(ctx, height, []uint64{0b0111}, aggSig, msg, votepool.DST)
+	require.NoError(err)
+	require.Equal(hi.Valset[:3], signers)
+
+	// and the other way around
+	aggSig = aggregateBlsSignature(s, []*bls.PrivateKey{blsKeys[0], blsKeys[1], prevBlsKey2}, msg)
+	signers, err = attestation.Verify(ctx, height, []uint64{0b0111}, aggSig, msg, votepool.DST)
+	require.NoError(err)
+	require.Equal(hi.Valset[:3], signers)
+
+	// a key which is neither the current nor the previous one is rejected
+	otherBlsKey, err := bls.GenerateBlsKey()
+	require.NoError(err)
+	aggSig = aggregateBlsSignature(s, []*bls.PrivateKey{blsKeys[0], prevBlsKey, otherBlsKey}, msg)
+	_, err = attestation.Verify(ctx, height, []uint64{0b0111}, aggSig, msg, votepool.DST)
+	require.ErrorIs(err, stakingtypes.ErrAttestationInvalidSignature)
+}
+
+func (s *KeeperTestSuite) TestAttestationThresholdValidate() {
+	require := s.Require()
+
+	require.NoError(keeper.DefaultAttestationThreshold.Validate())
+	require.NoError(keeper.AttestationThreshold{Type: keeper.AttestationThresholdByVotingPower, Numerator: 1, Denominator: 1}.Validate())
+	require.Error(keeper.AttestationThreshold{Type: keeper.AttestationThresholdByCount, Numerator: 1, Denominator: 0}.Validate())
+	require.Error(keeper.AttestationThreshold{Type: keeper.AttestationThresholdByCount, Numerator: 3, Denominator: 2}.Validate())
+	require.Error(keeper.AttestationThreshold{Type: 2, Numerator: 2, Denominator: 3}.Validate())
+	require.Panics(func() {
+		keeper.NewValidatorAttestation(s.stakingKeeper, keeper.AttestationThreshold{})
+	})
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorDeposit gets the deposit escrowed for a validator created by its operator
+func (k Keeper) GetValidatorDeposit(ctx sdk.Context, valAddr sdk.AccAddress) (deposit types.ValidatorDeposit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorDepositKey(valAddr))
+	if bz == nil {
+		return deposit, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetValidatorDeposit sets the deposit escrowed for a validator created by its operator
+func (k Keeper) SetValidatorDeposit(ctx sdk.Context, deposit types.ValidatorDeposit) {
+	valAddr := sdk.MustAccAddressFromHex(deposit.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorDepositKey(valAddr), k.cdc.MustMarshal(&deposit))
+}
+
+// RemoveValidatorDeposit removes the deposit escrowed for a validator created by its operator
+func (k Keeper) RemoveValidatorDeposit(ctx sdk.Context, valAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorDepositKey(valAddr))
+}
+
+// GetAllValidatorDeposits returns the deposits escrowed for all validators
+func (k Keeper) GetAllValidatorDeposits(ctx sdk.Context) (deposits []types.ValidatorDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorDepositKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.ValidatorDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// EscrowValidatorDeposit moves the deposit from the validator operator to the validator deposit pool
+func (k Keeper) EscrowValidatorDeposit(ctx sdk.Context, valAddr sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, valAddr, types.ValidatorDepositPoolName, amount); err != nil {
+		return err
+	}
+
+	k.SetValidatorDeposit(ctx, types.ValidatorDeposit{ValidatorAddress: valAddr.String(), Amount: amount})
+	return nil
+}
+
+// refundValidatorDeposit returns the deposit of a validator to its operator, if any
+func (k Keeper) refundValidatorDeposit(ctx sdk.Context, valAddr sdk.AccAddress) error {
+	deposit, found := k.GetValidatorDeposit(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	k.RemoveValidatorDeposit(ctx, valAddr)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ValidatorDepositPoolName, valAddr, deposit.Amount)
+}
+
+// burnValidatorDeposit burns the deposit of a validator, if any
+func (k Keeper) burnValidatorDeposit(ctx sdk.Context, valAddr sdk.AccAddress) error {
+	deposit, found := k.GetValidatorDeposit(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ValidatorDepositPoolName, deposit.Amount); err != nil {
+		return err
+	}
+
+	k.RemoveValidatorDeposit(ctx, valAddr)
+	return nil
+}
+
+// GetSelfCreatedValidators returns the number of validators created by their operator in the current epoch
+func (k Keeper) GetSelfCreatedValidators(ctx sdk.Context, epochLength uint64) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SelfCreatedValidatorsKey)
+	if bz == nil || binary.BigEndian.Uint64(bz[:8]) != currentEpoch(ctx, epochLength) {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(bz[8:])
+}
+
+// IncrementSelfCreatedValidators increments the number of validators created by their operator in the current epoch
+func (k Keeper) IncrementSelfCreatedValidators(ctx sdk.Context, epochLength uint64) {
+	count := k.GetSelfCreatedValidators(ctx, epochLength) + 1
+
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz[:8], currentEpoch(ctx, epochLength))
+	binary.BigEndian.PutUint32(bz[8:], count)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SelfCreatedValidatorsKey, bz)
+}
+
+func currentEpoch(ctx sdk.Context, epochLength uint64) uint64 {
+	if epochLength == 0 {
+		return 0
+	}
+	return uint64(ctx.BlockHeight()) / epochLength
+}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -173,13 +174,43 @@ func (s *KeeperTestSuite) TestValidatorBasics() {
 	require.PanicsWithValue("attempting to remove a validator which still contains tokens",
 		func() { keeper.RemoveValidator(ctx, validators[1].GetOperator()) })
 
-	validators[1].Tokens = math.ZeroInt()                    // ...remove all tokens
-	keeper.SetValidator(ctx, validators[1])                  // ...set the validator
-	keeper.RemoveValidator(ctx, validators[1].GetOperator()) // Now it can be removed.
+	validators[1].Tokens = math.ZeroInt()                                     // ...remove all tokens
+	keeper.SetValidator(ctx, validators[1])                                   // ...set the validator
+	require.NoError(keeper.RemoveValidator(ctx, validators[1].GetOperator())) // Now it can be removed.
 	_, found = keeper.GetValidator(ctx, sdk.AccAddress(PKs[1].Address().Bytes()))
 	require.False(found)
 }
 
+func (s *KeeperTestSuite) TestRemoveValidatorDepositBurnFailure() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.AccAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	keeper.SetValidatorDeposit(ctx, stakingtypes.ValidatorDeposit{ValidatorAddress: valAddr.String(), Amount: deposit})
+
+	// the validator is kept when its deposit can not be burnt
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ValidatorDepositPoolName, deposit).Return(sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(keeper.RemoveValidator(ctx, valAddr), sdkerrors.ErrInsufficientFunds)
+
+	_, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	_, found = keeper.GetValidatorDeposit(ctx, valAddr)
+	require.True(found)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ValidatorDepositPoolName, deposit).Return(nil)
+	require.NoError(keeper.RemoveValidator(ctx, valAddr))
+
+	_, found = keeper.GetValidator(ctx, valAddr)
+	require.False(found)
+	_, found = keeper.GetValidatorDeposit(ctx, valAddr)
+	require.False(found)
+}
+
 func (s *KeeperTestSuite) TestUpdateValidatorByPowerIndex() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -392,18 +423,18 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 	// check unbonding mature validators
 	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
 	require.PanicsWithValue("validator in the unbonding queue was not found", func() {
-		keeper.UnbondAllMatureValidators(ctx)
+		require.NoError(keeper.UnbondAllMatureValidators(ctx))
 	})
 
 	keeper.SetValidator(ctx, validator)
 	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
 	require.PanicsWithValue("unexpected validator in unbonding queue; status was not unbonding", func() {
-		keeper.UnbondAllMatureValidators(ctx)
+		require.NoError(keeper.UnbondAllMatureValidators(ctx))
 	})
 
 	validator.Status = stakingtypes.Unbonding
 	keeper.SetValidator(ctx, validator)
-	keeper.UnbondAllMatureValidators(ctx)
+	require.NoError(keeper.UnbondAllMatureValidators(ctx))
 	validator, found := keeper.GetValidator(ctx, valAddr)
 	require.False(found)
 
@@ -412,7 +443,7 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 	validator, _ = validator.AddTokensFromDel(addTokens)
 	validator.Status = stakingtypes.Unbonding
 	keeper.SetValidator(ctx, validator)
-	keeper.UnbondAllMatureValidators(ctx)
+	require.NoError(keeper.UnbondAllMatureValidators(ctx))
 	validator, found = keeper.GetValidator(ctx, valAddr)
 	require.True(found)
 	require.Equal(stakingtypes.Unbonded, validator.Status)
@@ -36,21 +36,40 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"cons_pubkey_rotation_history": [],
 	"delegations": [],
 	"exported": false,
+	"key_rotation_records": [],
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"cons_pubkey_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
 		"historical_entries": 10000,
+		"key_rotation_cooldown": "28800",
+		"key_rotation_delay": "600",
+		"key_rotation_overlap": "100",
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
 		"min_self_delegation": "1",
+		"self_create_validator": {
+			"allow_list": [],
+			"deposit": [],
+			"enabled": false,
+			"epoch_length": "0",
+			"max_per_epoch": 0,
+			"min_self_bond": "0"
+		},
 		"unbonding_time": "1814400s"
 	},
+	"pending_key_rotations": [],
 	"redelegations": [],
 	"unbonding_delegations": [],
+	"validator_deposits": [],
 	"validators": []
 }`
 
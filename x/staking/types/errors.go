@@ -59,4 +59,14 @@ var (
 	ErrInvalidSigner                    = errors.Register(ModuleName, 49, "invalid signer")
 	ErrInvalidMinSelfDelegation         = errors.Register(ModuleName, 50, "invalid minimum self delegation, must no less than the chain level minimum self delegation")
 	ErrValidatorInvalidBlsProof         = errors.Register(ModuleName, 51, "validator bls proof is invalid")
+	ErrKeyRotationPending               = errors.Register(ModuleName, 52, "validator already has a pending key rotation")
+	ErrKeyRotationTooFrequent           = errors.Register(ModuleName, 53, "validator keys were rotated too recently")
+	ErrSelfCreateValidatorDisabled      = errors.Register(ModuleName, 54, "creation of validators by their operator is disabled")
+	ErrSelfCreateValidatorNotAllowed    = errors.Register(ModuleName, 55, "operator is not allowed to create a validator")
+	ErrSelfBondTooLow                   = errors.Register(ModuleName, 56, "self bond is lower than the minimum self bond")
+	ErrSelfCreateValidatorCapReached    = errors.Register(ModuleName, 57, "maximum number of new validators reached for this epoch")
+	ErrAttestationValidatorSet          = errors.Register(ModuleName, 58, "attestation validator set is invalid")
+	ErrAttestationBlsPubKey             = errors.Register(ModuleName, 59, "attestation BLS public key is invalid")
+	ErrAttestationVotesNotEnough        = errors.Register(ModuleName, 60, "attestation votes are not enough")
+	ErrAttestationInvalidSignature      = errors.Register(ModuleName, 61, "attestation BLS signature is invalid")
 )
@@ -10,6 +10,10 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeQueueKeyRotation          = "queue_key_rotation"
+	EventTypeActivateKeyRotation       = "activate_key_rotation"
+	EventTypeCancelKeyRotation         = "cancel_key_rotation"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -24,4 +28,8 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyActivationHeight  = "activation_height"
+	AttributeKeyReason            = "reason"
+	AttributeKeyOldConsAddress    = "old_cons_address"
+	AttributeKeyNewConsAddress    = "new_cons_address"
 )
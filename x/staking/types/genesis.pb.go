@@ -45,6 +45,14 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// pending_key_rotations defines the key rotations of validators that are not active yet.
+	PendingKeyRotations []KeyRotation `protobuf:"bytes,9,rep,name=pending_key_rotations,json=pendingKeyRotations,proto3" json:"pending_key_rotations"`
+	// key_rotation_records defines the last key rotation of validators.
+	KeyRotationRecords []KeyRotationRecord `protobuf:"bytes,10,rep,name=key_rotation_records,json=keyRotationRecords,proto3" json:"key_rotation_records"`
+	// validator_deposits defines the deposits of the validators created by their operator which are not bonded yet.
+	ValidatorDeposits []ValidatorDeposit `protobuf:"bytes,11,rep,name=validator_deposits,json=validatorDeposits,proto3" json:"validator_deposits"`
+	// cons_pubkey_rotation_history defines the consensus pubkey rotations of all validators.
+	ConsPubkeyRotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=cons_pubkey_rotation_history,json=consPubkeyRotationHistory,proto3" json:"cons_pubkey_rotation_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +137,34 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetPendingKeyRotations() []KeyRotation {
+	if m != nil {
+		return m.PendingKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyRotationRecords() []KeyRotationRecord {
+	if m != nil {
+		return m.KeyRotationRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorDeposits() []ValidatorDeposit {
+	if m != nil {
+		return m.ValidatorDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubkeyRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotationHistory
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +216,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x77, 0x45, 0xa0, 0x4c, 0xd1, 0xc8, 0x50, 0xcc, 0x42, 0xcc, 0xb6, 0x22, 0x31, 0x15,
+	0x65, 0x37, 0xc0, 0xcd, 0x1b, 0x95, 0xf8, 0x23, 0x92, 0x48, 0x16, 0xf1, 0x60, 0x62, 0x36, 0xb3,
+	0xdd, 0x71, 0xd9, 0x6c, 0xbb, 0xb3, 0x99, 0x37, 0x45, 0x7a, 0xf0, 0xee, 0xd1, 0x3f, 0x81, 0xa3,
+	0x47, 0x0f, 0xfe, 0x11, 0x78, 0x23, 0x9e, 0x8c, 0x07, 0x62, 0xe0, 0xa0, 0x7f, 0x86, 0xe9, 0xcc,
+	0xb6, 0x0c, 0xb4, 0xab, 0x5e, 0xda, 0x6e, 0xdf, 0xf7, 0x7d, 0x3e, 0x6f, 0x92, 0xd9, 0x87, 0x96,
+	0x9a, 0x0c, 0xda, 0x0c, 0x5c, 0x10, 0x24, 0x89, 0xd3, 0xc8, 0xdd, 0x5f, 0x0d, 0xa8, 0x20, 0xab,
+	0x6e, 0x44, 0x53, 0x0a, 0x31, 0x38, 0x19, 0x67, 0x82, 0xe1, 0x9b, 0x2a, 0xe5, 0xe4, 0x29, 0x27,
+	0x4f, 0x2d, 0x54, 0x22, 0x16, 0x31, 0x19, 0x71, 0x7b, 0xbf, 0x54, 0x7a, 0xa1, 0x88, 0xd9, 0xef,
+	0x56, 0xa9, 0x79, 0x95, 0xf2, 0x55, 0x7b, 0x2e, 0x50, 0xa5, 0x19, 0xd2, 0x8e, 0x53, 0xe6, 0xca,
+	0x4f, 0xf5, 0xd7, 0xe2, 0xd7, 0x12, 0x9a, 0x7e, 0xa2, 0x66, 0xda, 0x11, 0x44, 0x50, 0xbc, 0x81,
+	0x26, 0x32, 0xc2, 0x49, 0x1b, 0x2c, 0xb3, 0x66, 0xd6, 0xcb, 0x6b, 0xb6, 0x33, 0x7a, 0x46, 0x67,
+	0x5b, 0xa6, 0x1a, 0x53, 0x47, 0x27, 0x55, 0xe3, 0xd3, 0xaf, 0xcf, 0xcb, 0xa6, 0x97, 0x37, 0xe2,
+	0x37, 0xe8, 0x46, 0x8b, 0x80, 0xf0, 0x05, 0x13, 0xa4, 0xe5, 0x67, 0xec, 0x1d, 0xe5, 0xd6, 0x95,
+	0x9a, 0x59, 0x9f, 0x6e, 0xac, 0xf7, 0xc2, 0x3f, 0x4e, 0xaa, 0x77, 0xa3, 0x58, 0xec, 0x75, 0x02,
+	0xa7, 0xc9, 0xda, 0xf9, 0x84, 0xf9, 0xd7, 0x0a, 0x84, 0x89, 0x2b, 0xba, 0x19, 0x05, 0xe7, 0x59,
+	0x2a, 0x14, 0xf6, 0x7a, 0x0f, 0xf6, 0xb2, 0xc7, 0xda, 0xee, 0xa1, 0x70, 0x8c, 0xe6, 0x24, 0x7e,
+	0x9f, 0xb4, 0xe2, 0x90, 0x08, 0xc6, 0x95, 0x02, 0xac, 0xb1, 0xda, 0x58, 0xbd, 0xbc, 0xb6, 0x5c,
+	0x34, 0xf0, 0x16, 0x01, 0xf1, 0xaa, 0xdf, 0x23, 0x51, 0xfa, 0xf0, 0xb3, 0xad, 0xa1, 0x32, 0xe0,
+	0x2d, 0x84, 0x06, 0x16, 0xb0, 0xae, 0x4a, 0xfe, 0xed, 0x22, 0xfe, 0xa0, 0x59, 0xc7, 0x6a, 0xfd,
+	0xf8, 0x05, 0x2a, 0x87, 0xb4, 0x45, 0x23, 0x22, 0x62, 0x96, 0x82, 0x35, 0x2e, 0x71, 0x8b, 0x45,
+	0xb8, 0xcd, 0x41, 0x54, 0xe7, 0xe9, 0x04, 0x9c, 0xa0, 0xb9, 0x4e, 0x1a, 0xb0, 0x34, 0x8c, 0xd3,
+	0xc8, 0xd7, 0xd1, 0x13, 0x12, 0x7d, 0xbf, 0x08, 0xbd, 0xdb, 0x6f, 0x1a, 0xed, 0xa8, 0x74, 0x86,
+	0xeb, 0x80, 0x77, 0xd1, 0x35, 0x4e, 0x75, 0xc9, 0xa4, 0x94, 0x2c, 0x15, 0x49, 0x3c, 0x1a, 0x8e,
+	0xa4, 0x5f, 0xa4, 0xe0, 0x05, 0x54, 0xa2, 0x07, 0x19, 0xe3, 0x82, 0x86, 0x56, 0xa9, 0x66, 0xd6,
+	0x4b, 0xde, 0xe0, 0x19, 0x07, 0x68, 0x2e, 0xa3, 0xea, 0x74, 0x09, 0xed, 0xfa, 0x9c, 0x89, 0x5c,
+	0x3d, 0x25, 0xd5, 0x77, 0x8a, 0xd4, 0xcf, 0x69, 0xd7, 0x63, 0x62, 0xc8, 0x3c, 0x9b, 0xc3, 0xb4,
+	0x32, 0xe0, 0xb7, 0xa8, 0xa2, 0xb3, 0x7d, 0x4e, 0x9b, 0x8c, 0x87, 0x60, 0x21, 0xa9, 0xb8, 0xf7,
+	0x1f, 0x0a, 0x4f, 0x76, 0xe8, 0x22, 0x9c, 0x5c, 0xae, 0x02, 0x0e, 0x10, 0x3e, 0xbf, 0xb0, 0x21,
+	0xcd, 0x18, 0xc4, 0x02, 0xac, 0xb2, 0xb4, 0xd4, 0xff, 0x79, 0xa5, 0x36, 0x55, 0x83, 0x2e, 0x99,
+	0xd9, 0xbf, 0x54, 0x04, 0xfc, 0x1e, 0xdd, 0x6a, 0xb2, 0x14, 0xfc, 0xac, 0x13, 0x5c, 0x38, 0xd3,
+	0x5e, 0x0c, 0x82, 0xf1, 0xae, 0x35, 0x2d, 0x6d, 0xab, 0x45, 0xb6, 0x47, 0x2c, 0x85, 0xed, 0x4e,
+	0xa0, 0x1d, 0xed, 0xa9, 0x6a, 0xd4, 0xb5, 0xf3, 0x4d, 0x95, 0x4a, 0x86, 0x52, 0x8b, 0x7b, 0x08,
+	0x0f, 0xbf, 0x63, 0x78, 0x0d, 0x4d, 0x92, 0x30, 0xe4, 0x14, 0xd4, 0x46, 0x99, 0x6a, 0x58, 0xdf,
+	0xbe, 0xac, 0x54, 0xf2, 0x11, 0x36, 0x54, 0x65, 0x47, 0xf0, 0x38, 0x8d, 0xbc, 0x7e, 0x10, 0x57,
+	0xd0, 0xf8, 0xf9, 0xda, 0x18, 0xf3, 0xd4, 0xc3, 0xc3, 0xd2, 0x87, 0xc3, 0xaa, 0xf1, 0xfb, 0xb0,
+	0x6a, 0x34, 0x1e, 0x1f, 0x9d, 0xda, 0xe6, 0xf1, 0xa9, 0x6d, 0xfe, 0x3c, 0xb5, 0xcd, 0x8f, 0x67,
+	0xb6, 0x71, 0x7c, 0x66, 0x1b, 0xdf, 0xcf, 0x6c, 0xe3, 0xf5, 0x83, 0xbf, 0x6e, 0x96, 0x83, 0xc1,
+	0xee, 0x94, 0x3b, 0x26, 0x98, 0x90, 0x4b, 0x70, 0xfd, 0xcf, 0x00, 0x75, 0x4f, 0xc2, 0x95, 0xae,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +279,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorDeposits) > 0 {
+		for iNdEx := len(m.ValidatorDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.KeyRotationRecords) > 0 {
+		for iNdEx := len(m.KeyRotationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingKeyRotations) > 0 {
+		for iNdEx := len(m.PendingKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +527,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.PendingKeyRotations) > 0 {
+		for _, e := range m.PendingKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotationRecords) > 0 {
+		for _, e := range m.KeyRotationRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorDeposits) > 0 {
+		for _, e := range m.ValidatorDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +861,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingKeyRotations = append(m.PendingKeyRotations, KeyRotation{})
+			if err := m.PendingKeyRotations[len(m.PendingKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationRecords = append(m.KeyRotationRecords, KeyRotationRecord{})
+			if err := m.KeyRotationRecords[len(m.KeyRotationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDeposits = append(m.ValidatorDeposits, ValidatorDeposit{})
+			if err := m.ValidatorDeposits[len(m.ValidatorDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistory = append(m.ConsPubkeyRotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotationHistory[len(m.ConsPubkeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,72 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsEmpty returns true if the key rotation does not replace any key of the validator.
+func (r KeyRotation) IsEmpty() bool {
+	return len(r.RelayerAddress) == 0 && len(r.ChallengerAddress) == 0 && len(r.BlsKey) == 0
+}
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory instance.
+func NewConsPubKeyRotationHistory(
+	valAddr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, fee sdk.Coin, //nolint:interfacer
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: valAddr.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Fee:             fee,
+	}, nil
+}
+
+// OldConsPubKey returns the consensus pubkey replaced by the rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+	return pk, nil
+}
+
+// NewConsPubKey returns the consensus pubkey set by the rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryValidatorConsPubKeyRotationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range r.History {
+		if err := r.History[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
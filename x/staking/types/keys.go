@@ -38,6 +38,11 @@ var (
 	ValidatorsByRelayerAddrKey    = []byte{0x24} // prefix for each key to a validator index, by relayer address
 	ValidatorsByChallengerAddrKey = []byte{0x25} // prefix for each key to a validator index, by challenger address
 	ValidatorsByBlsKey            = []byte{0x26} // prefix for each key to a validator index, by relayer/challenger's bls pubkey
+	PendingKeyRotationKey         = []byte{0x27} // prefix for each key to a pending key rotation, by validator operator
+	KeyRotationRecordKey          = []byte{0x28} // prefix for each key to the last key rotation record, by validator operator
+	ValidatorDepositKey           = []byte{0x29} // prefix for each key to the deposit of a validator created by its operator
+	ConsPubKeyRotationHistoryKey  = []byte{0x2A} // prefix for each key to a consensus pubkey rotation, by validator operator and height
+	OldToNewConsAddrKey           = []byte{0x2B} // prefix for each key to the consensus address which replaced a rotated consensus address
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -53,11 +58,16 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	KeyRotationQueueKey  = []byte{0x44} // prefix for the heights in key rotation queue
+
+	BlockConsPubKeyRotationKey = []byte{0x45} // prefix for each key to a consensus pubkey rotation, by height and validator operator
 
 	HistoricalInfoKey   = []byte{0x50} // prefix for the historical info
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	SelfCreatedValidatorsKey = []byte{0x71} // key for the number of validators created by their operator in the current epoch
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -404,3 +414,61 @@ func GetREDsByDelToValDstIndexKey(delAddr, valDstAddr sdk.AccAddress) []byte {
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetPendingKeyRotationKey creates the key for the pending key rotation of a validator
+// VALUE: staking/KeyRotation
+func GetPendingKeyRotationKey(operatorAddr sdk.AccAddress) []byte {
+	return append(PendingKeyRotationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryPrefix creates the prefix for the consensus pubkey rotations of a validator
+func GetConsPubKeyRotationHistoryPrefix(operatorAddr sdk.AccAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey creates the key for a consensus pubkey rotation of a validator
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(operatorAddr sdk.AccAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationHistoryPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationPrefix creates the prefix for the consensus pubkey rotations of a block
+func GetBlockConsPubKeyRotationPrefix(height int64) []byte {
+	return append(BlockConsPubKeyRotationKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationKey creates the key for a consensus pubkey rotation of a block
+// VALUE: none (key rearrangement used)
+func GetBlockConsPubKeyRotationKey(height int64, operatorAddr sdk.AccAddress) []byte {
+	return append(GetBlockConsPubKeyRotationPrefix(height), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetOldToNewConsAddrKey creates the key for the consensus address which replaced a rotated consensus address
+// VALUE: sdk.ConsAddress
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetValidatorDepositKey creates the key for the deposit of a validator created by its operator
+// VALUE: staking/ValidatorDeposit
+func GetValidatorDepositKey(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorDepositKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetKeyRotationRecordKey creates the key for the last key rotation record of a validator
+// VALUE: staking/KeyRotationRecord
+func GetKeyRotationRecordKey(operatorAddr sdk.AccAddress) []byte {
+	return append(KeyRotationRecordKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetKeyRotationQueueHeightKey returns the prefix for the key rotations activating at the given height
+func GetKeyRotationQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(KeyRotationQueueKey, bz...)
+}
+
+// GetKeyRotationQueueKey returns the key for the key rotation of a validator activating at the given height
+func GetKeyRotationQueueKey(height int64, operatorAddr sdk.AccAddress) []byte {
+	return append(GetKeyRotationQueueHeightKey(height), address.MustLengthPrefix(operatorAddr)...)
+}
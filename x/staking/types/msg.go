@@ -14,6 +14,8 @@ const (
 	TypeMsgCancelUnbondingDelegation = "cancel_unbond"
 	TypeMsgEditValidator             = "edit_validator"
 	TypeMsgCreateValidator           = "create_validator"
+	TypeMsgCreateValidatorSelf       = "create_validator_self"
+	TypeMsgRotateConsPubKey          = "rotate_cons_pubkey"
 	TypeMsgDelegate                  = "delegate"
 	TypeMsgBeginRedelegate           = "begin_redelegate"
 	TypeMsgUpdateParams              = "update_params"
@@ -23,12 +25,16 @@ var (
 	_ sdk.Msg                            = &MsgCreateValidator{}
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
 	_ sdk.Msg                            = &MsgCreateValidator{}
+	_ sdk.Msg                            = &MsgCreateValidatorSelf{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidatorSelf)(nil)
 	_ sdk.Msg                            = &MsgEditValidator{}
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -150,6 +156,82 @@ func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
 }
 
+// NewMsgCreateValidatorSelf creates a new MsgCreateValidatorSelf instance.
+// The validator operator is the self delegator.
+func NewMsgCreateValidatorSelf(
+	valAddr sdk.AccAddress, pubKey cryptotypes.PubKey, //nolint:interfacer
+	selfDelegation sdk.Coin, description Description, commission CommissionRates, minSelfDelegation math.Int,
+	relayerAddr, challengerAddr sdk.AccAddress, blsKey, blsProof string,
+) (*MsgCreateValidatorSelf, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgCreateValidatorSelf{
+		Description:       description,
+		ValidatorAddress:  valAddr.String(),
+		Pubkey:            pkAny,
+		Value:             selfDelegation,
+		Commission:        commission,
+		MinSelfDelegation: minSelfDelegation,
+		RelayerAddress:    relayerAddr.String(),
+		ChallengerAddress: challengerAddr.String(),
+		BlsKey:            blsKey,
+		BlsProof:          blsProof,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateValidatorSelf) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateValidatorSelf) Type() string { return TypeMsgCreateValidatorSelf }
+
+// GetSigners implements the sdk.Msg interface. The validator operator must sign the msg.
+func (msg MsgCreateValidatorSelf) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	return []sdk.AccAddress{valAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateValidatorSelf) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateValidatorSelf) ValidateBasic() error {
+	return msg.ToMsgCreateValidator().ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateValidatorSelf) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+// ToMsgCreateValidator returns the MsgCreateValidator equivalent to the msg, the validator
+// operator being both the self delegator and the signer.
+func (msg MsgCreateValidatorSelf) ToMsgCreateValidator() *MsgCreateValidator {
+	return &MsgCreateValidator{
+		Description:       msg.Description,
+		Commission:        msg.Commission,
+		MinSelfDelegation: msg.MinSelfDelegation,
+		DelegatorAddress:  msg.ValidatorAddress,
+		ValidatorAddress:  msg.ValidatorAddress,
+		Pubkey:            msg.Pubkey,
+		Value:             msg.Value,
+		From:              msg.ValidatorAddress,
+		RelayerAddress:    msg.RelayerAddress,
+		ChallengerAddress: msg.ChallengerAddress,
+		BlsKey:            msg.BlsKey,
+		BlsProof:          msg.BlsProof,
+	}
+}
+
 // NewMsgEditValidator creates a new MsgEditValidator instance
 //
 //nolint:interfacer
@@ -462,3 +544,55 @@ func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(valAddr sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) { //nolint:interfacer
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Type() string { return TypeMsgRotateConsPubKey }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	return []sdk.AccAddress{valAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
@@ -30,8 +30,21 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultKeyRotationDelay is the default number of blocks after which a key rotation takes effect
+	DefaultKeyRotationDelay uint64 = 600
+
+	// DefaultKeyRotationCooldown is the default minimum number of blocks between two key rotations
+	DefaultKeyRotationCooldown uint64 = 28800
+
+	// DefaultKeyRotationOverlap is the default number of blocks during which the previous keys are still accepted
+	DefaultKeyRotationOverlap uint64 = 100
 )
 
+// DefaultConsPubKeyRotationFeeAmount is the default amount of bond denom paid by a validator to rotate
+// its consensus pubkey
+var DefaultConsPubKeyRotationFeeAmount = math.NewInt(1000000)
+
 // DefaultMinCommissionRate is set to 0%
 var DefaultMinCommissionRate = math.LegacyZeroDec()
 
@@ -53,7 +66,7 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultUnbondingTime,
 		DefaultMaxValidators,
 		DefaultMaxEntries,
@@ -62,6 +75,21 @@ func DefaultParams() Params {
 		DefaultMinCommissionRate,
 		DefaultMinSelfDelegation,
 	)
+	params.KeyRotationDelay = DefaultKeyRotationDelay
+	params.KeyRotationCooldown = DefaultKeyRotationCooldown
+	params.KeyRotationOverlap = DefaultKeyRotationOverlap
+	params.SelfCreateValidator = DefaultSelfCreateValidatorParams()
+	params.ConsPubkeyRotationFee = sdk.NewCoin(params.BondDenom, DefaultConsPubKeyRotationFeeAmount)
+	return params
+}
+
+// DefaultSelfCreateValidatorParams returns the default parameters of the creation of validators by
+// their operator, which is disabled.
+func DefaultSelfCreateValidatorParams() SelfCreateValidatorParams {
+	return SelfCreateValidatorParams{
+		Enabled:     false,
+		MinSelfBond: math.ZeroInt(),
+	}
 }
 
 // String returns a human readable string representation of the parameters.
@@ -120,9 +148,68 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.KeyRotationOverlap > p.KeyRotationCooldown {
+		return fmt.Errorf("key rotation overlap cannot be longer than the key rotation cooldown: %d > %d", p.KeyRotationOverlap, p.KeyRotationCooldown)
+	}
+
+	if err := p.SelfCreateValidator.Validate(); err != nil {
+		return err
+	}
+
+	if p.HasConsPubKeyRotationFee() {
+		if err := p.ConsPubkeyRotationFee.Validate(); err != nil {
+			return fmt.Errorf("invalid consensus pubkey rotation fee: %w", err)
+		}
+		if p.ConsPubkeyRotationFee.Denom != p.BondDenom {
+			return fmt.Errorf("consensus pubkey rotation fee denom must be the bond denom %s, is %s", p.BondDenom, p.ConsPubkeyRotationFee.Denom)
+		}
+	}
+
+	return nil
+}
+
+// HasConsPubKeyRotationFee returns true if the rotation of a consensus pubkey is charged a fee.
+func (p Params) HasConsPubKeyRotationFee() bool {
+	return !p.ConsPubkeyRotationFee.Amount.IsNil() && !p.ConsPubkeyRotationFee.IsZero()
+}
+
+// Validate validates the parameters of the creation of validators by their operator.
+func (p SelfCreateValidatorParams) Validate() error {
+	if !p.MinSelfBond.IsNil() && p.MinSelfBond.IsNegative() {
+		return fmt.Errorf("minimum self bond cannot be negative: %s", p.MinSelfBond)
+	}
+
+	if err := p.Deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid validator deposit: %w", err)
+	}
+
+	for _, addr := range p.AllowList {
+		if _, err := sdk.AccAddressFromHexUnsafe(addr); err != nil {
+			return fmt.Errorf("invalid allow list address %s: %w", addr, err)
+		}
+	}
+
+	if p.MaxPerEpoch > 0 && p.EpochLength == 0 {
+		return fmt.Errorf("epoch length must be positive when the number of new validators per epoch is capped")
+	}
+
 	return nil
 }
 
+// IsAllowed returns true if the operator is allowed to create a validator.
+func (p SelfCreateValidatorParams) IsAllowed(operator sdk.AccAddress) bool {
+	if len(p.AllowList) == 0 {
+		return true
+	}
+	for _, addr := range p.AllowList {
+		allowed, err := sdk.AccAddressFromHexUnsafe(addr)
+		if err == nil && allowed.Equals(operator) {
+			return true
+		}
+	}
+	return false
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -35,4 +36,16 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// the consensus pubkey rotation fee is paid in bond denom
+	params = types.DefaultParams()
+	params.ConsPubkeyRotationFee = sdk.NewInt64Coin("soup", 1000)
+	require.Error(t, params.Validate())
+
+	params.BondDenom = "soup"
+	require.NoError(t, params.Validate())
+
+	// no denom is required without a fee
+	params.ConsPubkeyRotationFee = sdk.Coin{}
+	require.NoError(t, params.Validate())
 }
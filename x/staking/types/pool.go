@@ -9,9 +9,12 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - ValidatorDepositPool -> "validator_deposit_pool"
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName        = "not_bonded_tokens_pool"
+	BondedPoolName           = "bonded_tokens_pool"
+	ValidatorDepositPoolName = "validator_deposit_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
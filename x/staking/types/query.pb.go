@@ -1391,6 +1391,214 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPendingKeyRotationsRequest is request type for the Query/PendingKeyRotations RPC method.
+type QueryPendingKeyRotationsRequest struct {
+	// validator_addr defines the validator address to query for, all validators if empty.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingKeyRotationsRequest) Reset()         { *m = QueryPendingKeyRotationsRequest{} }
+func (m *QueryPendingKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryPendingKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryPendingKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryPendingKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingKeyRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingKeyRotationsRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryPendingKeyRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingKeyRotationsResponse is response type for the Query/PendingKeyRotations RPC method.
+type QueryPendingKeyRotationsResponse struct {
+	// rotations defines the pending key rotations.
+	Rotations []KeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingKeyRotationsResponse) Reset()         { *m = QueryPendingKeyRotationsResponse{} }
+func (m *QueryPendingKeyRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingKeyRotationsResponse) ProtoMessage()    {}
+func (*QueryPendingKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryPendingKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryPendingKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingKeyRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingKeyRotationsResponse) GetRotations() []KeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPendingKeyRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorConsPubKeyRotationsRequest is request type for the Query/ValidatorConsPubKeyRotations RPC method.
+type QueryValidatorConsPubKeyRotationsRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) Reset() {
+	*m = QueryValidatorConsPubKeyRotationsRequest{}
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorConsPubKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryValidatorConsPubKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorConsPubKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorConsPubKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorConsPubKeyRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorConsPubKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorConsPubKeyRotationsResponse is response type for the Query/ValidatorConsPubKeyRotations RPC method.
+type QueryValidatorConsPubKeyRotationsResponse struct {
+	// history defines the consensus pubkey rotations of the validator, oldest first.
+	History []ConsPubKeyRotationHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) Reset() {
+	*m = QueryValidatorConsPubKeyRotationsResponse{}
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorConsPubKeyRotationsResponse) ProtoMessage() {}
+func (*QueryValidatorConsPubKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorConsPubKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorConsPubKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorConsPubKeyRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorConsPubKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) GetHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1420,6 +1628,10 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingKeyRotationsRequest)(nil), "cosmos.staking.v1beta1.QueryPendingKeyRotationsRequest")
+	proto.RegisterType((*QueryPendingKeyRotationsResponse)(nil), "cosmos.staking.v1beta1.QueryPendingKeyRotationsResponse")
+	proto.RegisterType((*QueryValidatorConsPubKeyRotationsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorConsPubKeyRotationsRequest")
+	proto.RegisterType((*QueryValidatorConsPubKeyRotationsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorConsPubKeyRotationsResponse")
 }
 
 func init() {
@@ -1427,93 +1639,103 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6f, 0x14, 0x65,
-	0x1b, 0xdf, 0xb7, 0xf4, 0x6b, 0xbe, 0x3e, 0x04, 0x02, 0xef, 0x2e, 0xb5, 0x0c, 0xb8, 0xbb, 0x4c,
-	0x08, 0xf6, 0x40, 0x77, 0x6c, 0x8b, 0x50, 0x31, 0x0a, 0x5b, 0x09, 0x8a, 0x10, 0x2c, 0x6b, 0x6c,
-	0xf0, 0x94, 0x66, 0xda, 0x19, 0x66, 0x27, 0x6c, 0x67, 0x96, 0x79, 0xa7, 0x0d, 0xa4, 0x69, 0x4c,
-	0xbc, 0x30, 0x5c, 0x19, 0x13, 0xef, 0x0d, 0x17, 0x5e, 0x18, 0xc5, 0xa4, 0x17, 0x98, 0xe8, 0x0d,
-	0x97, 0x86, 0x0b, 0x63, 0x88, 0x06, 0xa3, 0x37, 0x68, 0x5a, 0x13, 0xbd, 0xf1, 0x3f, 0x30, 0xc6,
-	0xec, 0xcc, 0x33, 0xa7, 0xce, 0x71, 0xb7, 0xdb, 0xa4, 0xdc, 0xc0, 0xee, 0x3b, 0xcf, 0xe1, 0xf7,
-	0x7b, 0x0e, 0xef, 0x3c, 0xcf, 0x16, 0xf8, 0x05, 0x9d, 0x2d, 0xea, 0x4c, 0x60, 0xa6, 0x78, 0x5d,
-	0xd5, 0x14, 0x61, 0x79, 0x7c, 0x5e, 0x36, 0xc5, 0x71, 0xe1, 0xc6, 0x92, 0x6c, 0xdc, 0xaa, 0x34,
-	0x0d, 0xdd, 0xd4, 0xe9, 0x80, 0x2d, 0x53, 0x41, 0x99, 0x0a, 0xca, 0x70, 0x23, 0xa8, 0x3b, 0x2f,
-	0x32, 0xd9, 0x56, 0x70, 0xd5, 0x9b, 0xa2, 0xa2, 0x6a, 0xa2, 0xa9, 0xea, 0x9a, 0x6d, 0x83, 0x2b,
-	0x28, 0xba, 0xa2, 0x5b, 0x1f, 0x85, 0xd6, 0x27, 0x3c, 0x3d, 0xac, 0xe8, 0xba, 0xd2, 0x90, 0x05,
-	0xb1, 0xa9, 0x0a, 0xa2, 0xa6, 0xe9, 0xa6, 0xa5, 0xc2, 0xf0, 0xe9, 0xd1, 0x18, 0x6c, 0x0e, 0x0e,
-	0x5b, 0xea, 0xa0, 0x2d, 0x35, 0x67, 0x1b, 0x47, 0xa8, 0xf6, 0xa3, 0x43, 0x68, 0xc0, 0xc1, 0xe6,
-	0x67, 0xc5, 0xed, 0x17, 0x17, 0x55, 0x4d, 0x17, 0xac, 0x7f, 0xed, 0x23, 0xfe, 0x26, 0x0c, 0x5c,
-	0x69, 0x49, 0xcc, 0x8a, 0x0d, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x35, 0xf9, 0xc6, 0x92, 0xcc, 0x4c,
-	0x3a, 0x00, 0x7d, 0xcc, 0x14, 0xcd, 0x25, 0x36, 0x48, 0xca, 0x64, 0xa8, 0xbf, 0x86, 0xdf, 0xe8,
-	0x79, 0x00, 0x8f, 0xea, 0x60, 0x4f, 0x99, 0x0c, 0xed, 0x9e, 0x38, 0x56, 0x41, 0x10, 0xad, 0xb8,
-	0x54, 0x6c, 0x97, 0x08, 0xbd, 0x32, 0x23, 0x2a, 0x32, 0xda, 0xac, 0xf9, 0x34, 0xf9, 0x35, 0x02,
-	0x4f, 0x85, 0x5c, 0xb3, 0xa6, 0xae, 0x31, 0x99, 0x5e, 0x02, 0x58, 0x76, 0x4f, 0x07, 0x49, 0x79,
-	0xd7, 0xd0, 0xee, 0x89, 0x23, 0x95, 0xe8, 0x9c, 0x54, 0x5c, 0xfd, 0xe9, 0xfe, 0x07, 0x8f, 0x4b,
-	0xb9, 0xcf, 0xff, 0x5c, 0x1b, 0x21, 0x35, 0x9f, 0x3e, 0x7d, 0x25, 0x02, 0xf1, 0x33, 0xa9, 0x88,
-	0x6d, 0x28, 0x01, 0xc8, 0x57, 0xe1, 0x40, 0x10, 0xb1, 0x13, 0xab, 0x33, 0xb0, 0xd7, 0xf5, 0x37,
-	0x27, 0x4a, 0x92, 0x61, 0xc7, 0x6c, 0x7a, 0xf0, 0xc7, 0x7b, 0x63, 0x05, 0x74, 0x54, 0x95, 0x24,
-	0x43, 0x66, 0xec, 0x0d, 0xd3, 0x50, 0x35, 0xa5, 0xb6, 0xc7, 0x95, 0x6f, 0x9d, 0xf3, 0xd2, 0xe6,
-	0x34, 0xb8, 0xa1, 0x78, 0x0d, 0xfa, 0x5d, 0x51, 0xcb, 0x6a, 0xbb, 0x91, 0xf0, 0xd4, 0xf9, 0x2f,
-	0x09, 0x94, 0x83, 0x6e, 0xce, 0xc9, 0x0d, 0x59, 0xb1, 0x2b, 0xb0, 0x5b, 0x5c, 0xba, 0x56, 0x20,
-	0x7f, 0x13, 0x38, 0x92, 0x80, 0x16, 0xe3, 0xf3, 0x3e, 0x14, 0x24, 0xf7, 0x78, 0xce, 0xc0, 0x63,
-	0xa7, 0x68, 0x46, 0xe2, 0x42, 0xe5, 0x99, 0x72, 0x2c, 0x4d, 0x97, 0x5b, 0x31, 0xfb, 0xe2, 0xb7,
-	0x52, 0x3e, 0xfc, 0x8c, 0xd9, 0xa1, 0xcc, 0x4b, 0xe1, 0x27, 0xdd, 0xab, 0xae, 0x7b, 0x04, 0x86,
-	0x83, 0x7c, 0xdf, 0xd4, 0xe6, 0x75, 0x4d, 0x52, 0x35, 0x65, 0x27, 0xa7, 0xe9, 0x31, 0x81, 0x91,
-	0x2c, 0xb0, 0x31, 0x5f, 0x0a, 0xe4, 0x97, 0x9c, 0xe7, 0xa1, 0x74, 0x8d, 0xc6, 0xa5, 0x2b, 0xc2,
-	0xa4, 0xbf, 0xc6, 0xa9, 0x6b, 0x72, 0x1b, 0xf2, 0xf2, 0x19, 0xc1, 0xe6, 0xf4, 0xd7, 0x85, 0x9b,
-	0x04, 0x2c, 0x89, 0xcc, 0x49, 0x70, 0xe5, 0xad, 0x24, 0x84, 0xb3, 0xd8, 0xd3, 0x56, 0x16, 0x4f,
-	0xff, 0xff, 0xf6, 0x9d, 0x52, 0xee, 0xaf, 0x3b, 0xa5, 0x1c, 0xbf, 0x8c, 0xd7, 0x69, 0xb8, 0x7a,
-	0xe9, 0x3b, 0x90, 0x8f, 0xe8, 0x11, 0xbc, 0x4d, 0xda, 0x68, 0x91, 0x1a, 0x0d, 0x37, 0x00, 0xff,
-	0x15, 0x81, 0x92, 0xe5, 0x38, 0x22, 0x47, 0x3b, 0x31, 0x4e, 0x06, 0xde, 0x81, 0x91, 0x70, 0x31,
-	0x60, 0x97, 0xa1, 0xcf, 0xae, 0x28, 0x8c, 0x51, 0xa7, 0x75, 0x89, 0x56, 0xf8, 0xaf, 0x9d, 0x8b,
-	0xf7, 0x9c, 0xc3, 0x2a, 0xba, 0xa3, 0xb7, 0x16, 0xa4, 0x2e, 0x75, 0xb4, 0x2f, 0x56, 0x3f, 0x3b,
-	0x57, 0x70, 0x34, 0x6e, 0x8c, 0x56, 0xbd, 0x6b, 0x57, 0xb0, 0x2f, 0x74, 0xdb, 0x7b, 0xd7, 0xde,
-	0x77, 0xee, 0x5a, 0x97, 0x58, 0xca, 0x5d, 0xbb, 0xd3, 0x32, 0xe3, 0xde, 0xba, 0x29, 0x04, 0x9e,
-	0xd8, 0x5b, 0xf7, 0x7e, 0x0f, 0x1c, 0xb4, 0x08, 0xd6, 0x64, 0x69, 0x5b, 0x32, 0x42, 0x99, 0xb1,
-	0x30, 0xd7, 0xe6, 0xa5, 0xb2, 0x8f, 0x19, 0x0b, 0xb3, 0x9b, 0xde, 0xa2, 0x54, 0x62, 0xe6, 0x66,
-	0x3b, 0xbb, 0xd2, 0xec, 0x48, 0xcc, 0x9c, 0x4d, 0x78, 0x1b, 0xf7, 0x76, 0xa1, 0x42, 0x1e, 0x11,
-	0xe0, 0xa2, 0x02, 0x88, 0x15, 0xa1, 0xc1, 0x80, 0x21, 0x27, 0xb4, 0xed, 0xf1, 0xb8, 0xa2, 0xf0,
-	0x9b, 0x8b, 0x6a, 0xdc, 0x03, 0x86, 0xbc, 0xdd, 0x63, 0x52, 0x29, 0x58, 0xf9, 0xe1, 0xdd, 0x65,
-	0x07, 0x36, 0xec, 0xb7, 0xa1, 0x57, 0xc0, 0x93, 0xb3, 0xf7, 0xdc, 0x25, 0x50, 0x8c, 0xc1, 0xbe,
-	0x13, 0xdf, 0xf0, 0x8b, 0xb1, 0x05, 0xb2, 0x2d, 0x5b, 0xd5, 0x09, 0xec, 0xb3, 0x57, 0x55, 0x66,
-	0xea, 0x86, 0xba, 0x20, 0x36, 0x2e, 0x68, 0xd7, 0x74, 0xdf, 0x1a, 0x5d, 0x97, 0x55, 0xa5, 0x6e,
-	0x5a, 0x6e, 0x76, 0xd5, 0xf0, 0x1b, 0xff, 0x16, 0x1c, 0x8a, 0xd4, 0x42, 0x80, 0xa7, 0xa1, 0xb7,
-	0xae, 0x32, 0x13, 0xb1, 0x1d, 0x8b, 0xc3, 0xb6, 0x49, 0xdb, 0xd2, 0xe1, 0x29, 0xec, 0xb3, 0x4c,
-	0xcf, 0xe8, 0x7a, 0x03, 0x61, 0xf0, 0x33, 0xb0, 0xdf, 0x77, 0x86, 0x4e, 0x5e, 0x80, 0xde, 0xa6,
-	0xae, 0x37, 0xd0, 0xc9, 0xe1, 0x38, 0x27, 0x2d, 0x1d, 0x3f, 0x77, 0x4b, 0x89, 0x2f, 0x00, 0xb5,
-	0x2d, 0x8a, 0x86, 0xb8, 0xe8, 0x74, 0x1e, 0x7f, 0x15, 0xf2, 0x81, 0x53, 0xf4, 0x54, 0x85, 0xbe,
-	0xa6, 0x75, 0x82, 0xbe, 0x8a, 0xb1, 0xbe, 0x2c, 0xa9, 0xc0, 0x0c, 0x65, 0x2b, 0x4e, 0xac, 0x0d,
-	0xc0, 0xff, 0x2c, 0xd3, 0xf4, 0x53, 0x02, 0xe0, 0x35, 0x0f, 0xad, 0xc4, 0xd9, 0x8a, 0xfe, 0x61,
-	0x83, 0x13, 0x32, 0xcb, 0xe3, 0x84, 0x2b, 0xdc, 0x6e, 0x01, 0xf9, 0xe0, 0xa7, 0x3f, 0x3e, 0xe9,
-	0x39, 0x4a, 0x79, 0x21, 0xe6, 0x27, 0x1a, 0x5f, 0xe3, 0xdd, 0x25, 0xd0, 0xef, 0xda, 0xa1, 0x63,
-	0xd9, 0xfc, 0x39, 0xf0, 0x2a, 0x59, 0xc5, 0x11, 0xdd, 0x59, 0x0f, 0xdd, 0x73, 0x74, 0x32, 0x1d,
-	0x9d, 0xb0, 0x12, 0xec, 0xb3, 0x55, 0xfa, 0x2b, 0x81, 0x42, 0xd4, 0x8e, 0x4d, 0xa7, 0xb2, 0x41,
-	0x09, 0x4f, 0x4c, 0xdc, 0xf3, 0x1d, 0x68, 0x22, 0x9f, 0x4b, 0x1e, 0x9f, 0x2a, 0x3d, 0xd3, 0x01,
-	0x1f, 0xc1, 0xf7, 0xba, 0xa3, 0xff, 0x12, 0x78, 0x3a, 0x71, 0x31, 0xa5, 0xd5, 0x6c, 0x50, 0x13,
-	0xe6, 0x43, 0x6e, 0x7a, 0x2b, 0x26, 0x90, 0xf6, 0xac, 0x47, 0xfb, 0x22, 0xbd, 0xd0, 0x09, 0x6d,
-	0x6f, 0xc0, 0xf3, 0x07, 0xe0, 0x7b, 0x02, 0xe0, 0xf9, 0x4b, 0x69, 0x96, 0xd0, 0xe6, 0x96, 0xd2,
-	0x2c, 0xe1, 0x11, 0x9e, 0x7f, 0xcf, 0xe3, 0x51, 0xa3, 0x33, 0x5b, 0x4c, 0x9f, 0xb0, 0x12, 0x7c,
-	0xa9, 0xac, 0xd2, 0x7f, 0x08, 0xe4, 0x23, 0xe2, 0x48, 0x4f, 0x25, 0xe2, 0x8c, 0x5f, 0x4d, 0xb9,
-	0xa9, 0xf6, 0x15, 0x91, 0xa9, 0xe1, 0x31, 0x55, 0xa8, 0xdc, 0x6d, 0xa6, 0x91, 0xe9, 0xa4, 0x3f,
-	0x10, 0x28, 0x44, 0xed, 0x62, 0x29, 0xad, 0x9a, 0xb0, 0x76, 0xa6, 0xb4, 0x6a, 0xd2, 0xe2, 0xc7,
-	0x57, 0xbd, 0x08, 0x9c, 0xa4, 0x27, 0xe2, 0x22, 0x90, 0x98, 0xcf, 0x56, 0x7f, 0x26, 0xae, 0x30,
-	0x29, 0xfd, 0x99, 0x65, 0x7f, 0x4b, 0xe9, 0xcf, 0x4c, 0x1b, 0x54, 0xc6, 0xfe, 0x74, 0xe9, 0x65,
-	0x4c, 0x28, 0xa3, 0xdf, 0x11, 0xd8, 0x13, 0x98, 0xd0, 0xe9, 0x78, 0x22, 0xda, 0xa8, 0x75, 0x88,
-	0x9b, 0x68, 0x47, 0x05, 0x09, 0x5d, 0xf6, 0x08, 0xbd, 0x4c, 0xab, 0x9d, 0x10, 0x32, 0x02, 0xb0,
-	0x1f, 0x11, 0xc8, 0x47, 0xcc, 0xb6, 0x29, 0x9d, 0x19, 0x3f, 0xc4, 0x73, 0x53, 0xed, 0x2b, 0x22,
-	0xb5, 0x8b, 0x1e, 0xb5, 0xb3, 0xf4, 0xa5, 0x4e, 0xa8, 0xf9, 0x5e, 0xe6, 0x1b, 0x04, 0x68, 0xd8,
-	0x19, 0x3d, 0xd9, 0x26, 0x3a, 0x87, 0xd5, 0xa9, 0xb6, 0xf5, 0x90, 0xd4, 0xbb, 0x1e, 0xa9, 0x2b,
-	0xf4, 0xf5, 0xad, 0x91, 0x0a, 0xcf, 0x00, 0xdf, 0x10, 0xd8, 0x1b, 0x1c, 0x26, 0x69, 0x72, 0x51,
-	0x45, 0x4e, 0xbb, 0xdc, 0x64, 0x5b, 0x3a, 0xc8, 0xec, 0x45, 0x8f, 0xd9, 0x04, 0x7d, 0x36, 0x8e,
-	0x59, 0xdd, 0x55, 0x9e, 0x53, 0xb5, 0x6b, 0xba, 0xb0, 0x62, 0x0f, 0xd2, 0xab, 0xf4, 0x43, 0x02,
-	0xbd, 0xad, 0x11, 0x95, 0x0e, 0x25, 0x3a, 0xf7, 0x4d, 0xc3, 0xdc, 0x70, 0x06, 0x49, 0x04, 0x37,
-	0xec, 0x81, 0x2b, 0xd2, 0xc3, 0x71, 0xe0, 0x5a, 0x13, 0x31, 0xfd, 0x88, 0x40, 0x9f, 0x3d, 0xbf,
-	0xd2, 0x91, 0x64, 0x07, 0xfe, 0x91, 0x99, 0x1b, 0xcd, 0x24, 0x8b, 0x70, 0x46, 0x3d, 0x38, 0x65,
-	0x5a, 0x8c, 0x85, 0x63, 0x4f, 0xd1, 0xe7, 0x1f, 0xac, 0x17, 0xc9, 0xc3, 0xf5, 0x22, 0xf9, 0x7d,
-	0xbd, 0x48, 0x3e, 0xde, 0x28, 0xe6, 0x1e, 0x6e, 0x14, 0x73, 0xbf, 0x6c, 0x14, 0x73, 0x6f, 0x1f,
-	0x57, 0x54, 0xb3, 0xbe, 0x34, 0x5f, 0x59, 0xd0, 0x17, 0x1d, 0x1b, 0xf6, 0x7f, 0x63, 0x4c, 0xba,
-	0x2e, 0xdc, 0x74, 0x0d, 0x9a, 0xb7, 0x9a, 0x32, 0x9b, 0xef, 0xb3, 0xfe, 0x56, 0x38, 0xf9, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb1, 0x80, 0x02, 0x3a, 0x1d, 0x00, 0x00,
+	// 1527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x14, 0xd5,
+	0x17, 0xef, 0x2d, 0xfd, 0xf7, 0x6f, 0x0f, 0x81, 0xc0, 0xdd, 0x52, 0xca, 0x50, 0xb7, 0xcb, 0x48,
+	0xb4, 0x14, 0xba, 0x63, 0x0b, 0x42, 0xc5, 0x28, 0x6c, 0x21, 0x28, 0x42, 0xb0, 0xac, 0xb1, 0xc1,
+	0xaf, 0x6c, 0x66, 0x3b, 0xc3, 0xec, 0xa4, 0xed, 0xdc, 0x65, 0xee, 0x2c, 0xa1, 0x21, 0xc4, 0x44,
+	0x13, 0xc3, 0x93, 0x31, 0xf1, 0xdd, 0xf0, 0xe0, 0x83, 0x41, 0x4c, 0x78, 0xc0, 0x44, 0x5f, 0x78,
+	0x32, 0x86, 0x07, 0x63, 0x88, 0x06, 0xa3, 0x2f, 0x68, 0x5a, 0x13, 0x7d, 0xf1, 0xd1, 0x27, 0x8d,
+	0x31, 0x3b, 0x73, 0xe6, 0xab, 0xf3, 0xb1, 0xb3, 0xed, 0x36, 0x29, 0x2f, 0xd0, 0xde, 0xb9, 0xe7,
+	0x9c, 0xdf, 0xef, 0x7c, 0xdc, 0x99, 0xdf, 0x4d, 0x41, 0x9c, 0x65, 0x7c, 0x81, 0x71, 0x89, 0x5b,
+	0xf2, 0x9c, 0x6e, 0x68, 0xd2, 0xe5, 0xf1, 0xaa, 0x6a, 0xc9, 0xe3, 0xd2, 0xa5, 0x86, 0x6a, 0x2e,
+	0x16, 0xeb, 0x26, 0xb3, 0x18, 0x1d, 0x70, 0xf6, 0x14, 0x71, 0x4f, 0x11, 0xf7, 0x08, 0xa3, 0x68,
+	0x5b, 0x95, 0xb9, 0xea, 0x18, 0x78, 0xe6, 0x75, 0x59, 0xd3, 0x0d, 0xd9, 0xd2, 0x99, 0xe1, 0xf8,
+	0x10, 0xfa, 0x35, 0xa6, 0x31, 0xfb, 0x47, 0xa9, 0xf9, 0x13, 0xae, 0x0e, 0x69, 0x8c, 0x69, 0xf3,
+	0xaa, 0x24, 0xd7, 0x75, 0x49, 0x36, 0x0c, 0x66, 0xd9, 0x26, 0x1c, 0x9f, 0xee, 0x4d, 0xc0, 0xe6,
+	0xe2, 0x70, 0x76, 0xed, 0x72, 0x76, 0x55, 0x1c, 0xe7, 0x08, 0xd5, 0x79, 0xb4, 0x1b, 0x1d, 0xb8,
+	0xd8, 0x82, 0xac, 0x84, 0xed, 0xf2, 0x82, 0x6e, 0x30, 0xc9, 0xfe, 0xd7, 0x59, 0x12, 0xaf, 0xc0,
+	0xc0, 0xf9, 0xe6, 0x8e, 0x19, 0x79, 0x5e, 0x57, 0x64, 0x8b, 0x99, 0xbc, 0xac, 0x5e, 0x6a, 0xa8,
+	0xdc, 0xa2, 0x03, 0xd0, 0xcb, 0x2d, 0xd9, 0x6a, 0xf0, 0x41, 0x52, 0x20, 0x23, 0x7d, 0x65, 0xfc,
+	0x8d, 0x9e, 0x02, 0xf0, 0xa9, 0x0e, 0x76, 0x17, 0xc8, 0xc8, 0xe6, 0x89, 0x27, 0x8b, 0x08, 0xa2,
+	0x99, 0x97, 0xa2, 0x13, 0x12, 0xa1, 0x17, 0xa7, 0x65, 0x4d, 0x45, 0x9f, 0xe5, 0x80, 0xa5, 0x78,
+	0x9b, 0xc0, 0xce, 0x48, 0x68, 0x5e, 0x67, 0x06, 0x57, 0xe9, 0x59, 0x80, 0xcb, 0xde, 0xea, 0x20,
+	0x29, 0x6c, 0x1a, 0xd9, 0x3c, 0xb1, 0xa7, 0x18, 0x5f, 0x93, 0xa2, 0x67, 0x3f, 0xd5, 0x77, 0xef,
+	0xe1, 0x70, 0xd7, 0xa7, 0xbf, 0xdf, 0x1e, 0x25, 0xe5, 0x80, 0x3d, 0x7d, 0x31, 0x06, 0xf1, 0x53,
+	0x2d, 0x11, 0x3b, 0x50, 0x42, 0x90, 0x2f, 0xc0, 0x8e, 0x30, 0x62, 0x37, 0x57, 0xc7, 0x60, 0xab,
+	0x17, 0xaf, 0x22, 0x2b, 0x8a, 0xe9, 0xe4, 0x6c, 0x6a, 0xf0, 0xfb, 0x3b, 0x63, 0xfd, 0x18, 0xa8,
+	0xa4, 0x28, 0xa6, 0xca, 0xf9, 0xab, 0x96, 0xa9, 0x1b, 0x5a, 0x79, 0x8b, 0xb7, 0xbf, 0xb9, 0x2e,
+	0x2a, 0x2b, 0xcb, 0xe0, 0xa5, 0xe2, 0x65, 0xe8, 0xf3, 0xb6, 0xda, 0x5e, 0xdb, 0xcd, 0x84, 0x6f,
+	0x2e, 0x7e, 0x46, 0xa0, 0x10, 0x0e, 0x73, 0x52, 0x9d, 0x57, 0x35, 0xa7, 0x03, 0x3b, 0xc5, 0xa5,
+	0x63, 0x0d, 0xf2, 0x27, 0x81, 0x3d, 0x29, 0x68, 0x31, 0x3f, 0xef, 0x40, 0xbf, 0xe2, 0x2d, 0x57,
+	0x4c, 0x5c, 0x76, 0x9b, 0x66, 0x34, 0x29, 0x55, 0xbe, 0x2b, 0xd7, 0xd3, 0x54, 0xa1, 0x99, 0xb3,
+	0x9b, 0xbf, 0x0c, 0xe7, 0xa2, 0xcf, 0xb8, 0x93, 0xca, 0x9c, 0x12, 0x7d, 0xd2, 0xb9, 0xee, 0xba,
+	0x43, 0x60, 0x5f, 0x98, 0xef, 0x6b, 0x46, 0x95, 0x19, 0x8a, 0x6e, 0x68, 0x1b, 0xb9, 0x4c, 0x0f,
+	0x09, 0x8c, 0x66, 0x81, 0x8d, 0xf5, 0xd2, 0x20, 0xd7, 0x70, 0x9f, 0x47, 0xca, 0xb5, 0x3f, 0xa9,
+	0x5c, 0x31, 0x2e, 0x83, 0x3d, 0x4e, 0x3d, 0x97, 0xeb, 0x50, 0x97, 0x4f, 0x08, 0x0e, 0x67, 0xb0,
+	0x2f, 0xbc, 0x22, 0x60, 0x4b, 0x64, 0x2e, 0x82, 0xb7, 0xdf, 0x2e, 0x42, 0xb4, 0x8a, 0xdd, 0x6d,
+	0x55, 0xf1, 0xe8, 0x63, 0xd7, 0x6f, 0x0c, 0x77, 0xfd, 0x71, 0x63, 0xb8, 0x4b, 0xbc, 0x0c, 0x3b,
+	0x23, 0x28, 0x31, 0xe7, 0x6f, 0x42, 0x2e, 0x66, 0x46, 0xf0, 0x34, 0x69, 0x63, 0x44, 0xca, 0x34,
+	0x3a, 0x00, 0xe2, 0xe7, 0x04, 0x86, 0xed, 0xc0, 0x31, 0x35, 0xda, 0x88, 0x79, 0x32, 0xa1, 0x90,
+	0x0c, 0x17, 0x13, 0x76, 0x0e, 0x7a, 0x9d, 0x8e, 0xc2, 0x1c, 0xad, 0xb6, 0x2f, 0xd1, 0x8b, 0xf8,
+	0x85, 0x7b, 0xf0, 0x9e, 0x74, 0x59, 0xc5, 0x4f, 0xf4, 0xda, 0x92, 0xd4, 0xa1, 0x89, 0x0e, 0xe4,
+	0xea, 0x47, 0xf7, 0x08, 0x8e, 0xc7, 0x8d, 0xd9, 0xaa, 0x75, 0xec, 0x08, 0x0e, 0xa4, 0x6e, 0x7d,
+	0xcf, 0xda, 0xbb, 0xee, 0x59, 0xeb, 0x11, 0x6b, 0x71, 0xd6, 0x6e, 0xb4, 0xca, 0x78, 0xa7, 0x6e,
+	0x0b, 0x02, 0x8f, 0xec, 0xa9, 0x7b, 0xb7, 0x1b, 0x76, 0xd9, 0x04, 0xcb, 0xaa, 0xb2, 0x2e, 0x15,
+	0xa1, 0xdc, 0x9c, 0xad, 0xb4, 0x79, 0xa8, 0x6c, 0xe3, 0xe6, 0xec, 0xcc, 0x8a, 0xb7, 0x28, 0x55,
+	0xb8, 0xb5, 0xd2, 0xcf, 0xa6, 0x56, 0x7e, 0x14, 0x6e, 0xcd, 0xa4, 0xbc, 0x8d, 0x7b, 0x3a, 0xd0,
+	0x21, 0x0f, 0x08, 0x08, 0x71, 0x09, 0xc4, 0x8e, 0x30, 0x60, 0xc0, 0x54, 0x53, 0xc6, 0xf6, 0x40,
+	0x52, 0x53, 0x04, 0xdd, 0xc5, 0x0d, 0xee, 0x0e, 0x53, 0x5d, 0xef, 0xcf, 0xa4, 0xe1, 0x70, 0xe7,
+	0x47, 0xb5, 0xcb, 0x06, 0x1c, 0xd8, 0xaf, 0x22, 0xaf, 0x80, 0x47, 0x47, 0xf7, 0xdc, 0x22, 0x90,
+	0x4f, 0xc0, 0xbe, 0x11, 0xdf, 0xf0, 0x0b, 0x89, 0x0d, 0xb2, 0x2e, 0xaa, 0xea, 0x10, 0xce, 0xd9,
+	0x4b, 0x3a, 0xb7, 0x98, 0xa9, 0xcf, 0xca, 0xf3, 0xa7, 0x8d, 0x8b, 0x2c, 0x20, 0xa3, 0x6b, 0xaa,
+	0xae, 0xd5, 0x2c, 0x3b, 0xcc, 0xa6, 0x32, 0xfe, 0x26, 0xbe, 0x0e, 0xbb, 0x63, 0xad, 0x10, 0xe0,
+	0x51, 0xe8, 0xa9, 0xe9, 0xdc, 0x1a, 0x24, 0xe1, 0xd6, 0x5b, 0x89, 0x6d, 0x85, 0xb5, 0x6d, 0x23,
+	0x52, 0xd8, 0x66, 0xbb, 0x9e, 0x66, 0x6c, 0x1e, 0x61, 0x88, 0xd3, 0xb0, 0x3d, 0xb0, 0x86, 0x41,
+	0x9e, 0x83, 0x9e, 0x3a, 0x63, 0xf3, 0x18, 0x64, 0x28, 0x29, 0x48, 0xd3, 0x26, 0xc8, 0xdd, 0x36,
+	0x12, 0xfb, 0x81, 0x3a, 0x1e, 0x65, 0x53, 0x5e, 0x70, 0x27, 0x4f, 0xbc, 0x00, 0xb9, 0xd0, 0x2a,
+	0x46, 0x2a, 0x41, 0x6f, 0xdd, 0x5e, 0xc1, 0x58, 0xf9, 0xc4, 0x58, 0xf6, 0xae, 0xd0, 0x37, 0x94,
+	0x63, 0x28, 0xde, 0x74, 0xe7, 0x7e, 0x5a, 0xb5, 0xdf, 0x39, 0x67, 0xd4, 0xc5, 0xb2, 0x7b, 0x7b,
+	0xb2, 0xe1, 0x44, 0x91, 0x37, 0xed, 0xb1, 0x60, 0xbd, 0x69, 0xef, 0x33, 0xdd, 0x45, 0x1c, 0xf6,
+	0x27, 0x92, 0xf2, 0x12, 0x70, 0x10, 0x6a, 0x43, 0xcf, 0x41, 0xe7, 0xa6, 0x7d, 0x0e, 0x46, 0xc2,
+	0x7a, 0xee, 0x04, 0x33, 0xf8, 0x74, 0xa3, 0xba, 0x1e, 0x09, 0x17, 0xdf, 0x8b, 0x88, 0xde, 0xd8,
+	0x68, 0x98, 0xb1, 0x19, 0xf8, 0x7f, 0xcd, 0xee, 0xf8, 0x45, 0xcc, 0xd7, 0x78, 0x52, 0xbe, 0xa2,
+	0x5e, 0x9c, 0x51, 0x59, 0x0c, 0x66, 0xcf, 0x75, 0x36, 0xf1, 0xd7, 0x2e, 0xf8, 0x9f, 0x8d, 0x82,
+	0x7e, 0x4c, 0x00, 0xfc, 0x83, 0x99, 0x16, 0x93, 0xfc, 0xc7, 0x5f, 0x9a, 0x09, 0x52, 0xe6, 0xfd,
+	0xa8, 0x9e, 0xa4, 0xeb, 0x4d, 0x24, 0xef, 0xfe, 0xf0, 0xdb, 0x47, 0xdd, 0x7b, 0xa9, 0x28, 0x25,
+	0x5c, 0xff, 0x05, 0x0e, 0xf5, 0x5b, 0x04, 0xfa, 0x3c, 0x3f, 0x74, 0x2c, 0x5b, 0x3c, 0x17, 0x5e,
+	0x31, 0xeb, 0x76, 0x44, 0x77, 0xdc, 0x47, 0xf7, 0x0c, 0x3d, 0xd8, 0x1a, 0x9d, 0x74, 0x35, 0xdc,
+	0x0d, 0xd7, 0xe8, 0xcf, 0x04, 0xfa, 0xe3, 0xee, 0x6f, 0xe8, 0x64, 0x36, 0x28, 0xd1, 0xaf, 0x71,
+	0xe1, 0xd9, 0x55, 0x58, 0x22, 0x9f, 0xb3, 0x3e, 0x9f, 0x12, 0x3d, 0xb6, 0x0a, 0x3e, 0x52, 0xe0,
+	0x53, 0x8a, 0xfe, 0x4b, 0xe0, 0xf1, 0xd4, 0x4b, 0x0f, 0x5a, 0xca, 0x06, 0x35, 0x45, 0x7b, 0x08,
+	0x53, 0x6b, 0x71, 0x81, 0xb4, 0x67, 0x7c, 0xda, 0x67, 0xe8, 0xe9, 0xd5, 0xd0, 0xf6, 0xc5, 0x43,
+	0x30, 0x01, 0xdf, 0x12, 0x00, 0x3f, 0x5e, 0x8b, 0x61, 0x89, 0xdc, 0x0a, 0x08, 0x52, 0xe6, 0xfd,
+	0xc8, 0xe3, 0x6d, 0x9f, 0x47, 0x99, 0x4e, 0xaf, 0xb1, 0x7c, 0xd2, 0xd5, 0xf0, 0x07, 0xcb, 0x35,
+	0xfa, 0x0f, 0x81, 0x5c, 0x4c, 0x1e, 0xe9, 0x91, 0x54, 0x9c, 0xc9, 0xd7, 0x1e, 0xc2, 0x64, 0xfb,
+	0x86, 0xc8, 0xd4, 0xf4, 0x99, 0x6a, 0x54, 0xed, 0x34, 0xd3, 0xd8, 0x72, 0xd2, 0xef, 0x08, 0xf4,
+	0xc7, 0xe9, 0xfc, 0x16, 0xa3, 0x9a, 0x72, 0xa5, 0xd1, 0x62, 0x54, 0xd3, 0x2e, 0x15, 0xc4, 0x92,
+	0x9f, 0x81, 0xc3, 0xf4, 0x50, 0x52, 0x06, 0x52, 0xeb, 0xd9, 0x9c, 0xcf, 0x54, 0x79, 0xdc, 0x62,
+	0x3e, 0xb3, 0xdc, 0x0d, 0xb4, 0x98, 0xcf, 0x4c, 0xea, 0x3c, 0xe3, 0x7c, 0x7a, 0xf4, 0x32, 0x16,
+	0x94, 0xd3, 0x6f, 0x08, 0x6c, 0x09, 0xa9, 0x3f, 0x3a, 0x9e, 0x8a, 0x36, 0x4e, 0x6a, 0x0b, 0x13,
+	0xed, 0x98, 0x20, 0xa1, 0x73, 0x3e, 0xa1, 0x13, 0xb4, 0xb4, 0x1a, 0x42, 0x66, 0x08, 0xf6, 0x03,
+	0x02, 0xb9, 0x18, 0xdd, 0xd4, 0x62, 0x32, 0x93, 0x05, 0xa2, 0x30, 0xd9, 0xbe, 0x21, 0x52, 0x3b,
+	0xe3, 0x53, 0x3b, 0x4e, 0x5f, 0x58, 0x0d, 0xb5, 0xc0, 0xcb, 0x7c, 0x99, 0x00, 0x8d, 0x06, 0xa3,
+	0x87, 0xdb, 0x44, 0xe7, 0xb2, 0x3a, 0xd2, 0xb6, 0x1d, 0x92, 0x7a, 0xcb, 0x27, 0x75, 0x9e, 0xbe,
+	0xb2, 0x36, 0x52, 0xd1, 0x6f, 0x80, 0x2f, 0x09, 0x6c, 0x0d, 0x0b, 0x15, 0x9a, 0xde, 0x54, 0xb1,
+	0x4a, 0x4a, 0x38, 0xd8, 0x96, 0x0d, 0x32, 0x7b, 0xde, 0x67, 0x36, 0x41, 0x9f, 0x4e, 0x62, 0x56,
+	0xf3, 0x8c, 0x2b, 0xba, 0x71, 0x91, 0x49, 0x57, 0x1d, 0x91, 0x76, 0x8d, 0xbe, 0x4f, 0xa0, 0xa7,
+	0x29, 0x7f, 0xe8, 0x48, 0x6a, 0xf0, 0x80, 0xd2, 0x12, 0xf6, 0x65, 0xd8, 0x89, 0xe0, 0xf6, 0xf9,
+	0xe0, 0xf2, 0x74, 0x28, 0x09, 0x5c, 0x53, 0x6d, 0xd1, 0x0f, 0x08, 0xf4, 0x3a, 0xda, 0x88, 0x8e,
+	0xa6, 0x07, 0x08, 0xca, 0x31, 0x61, 0x7f, 0xa6, 0xbd, 0x08, 0x67, 0xbf, 0x0f, 0xa7, 0x40, 0xf3,
+	0x89, 0x70, 0x1c, 0x14, 0x5f, 0x13, 0xc8, 0xc5, 0x88, 0x9b, 0x16, 0x23, 0x99, 0xac, 0xdd, 0x84,
+	0xc9, 0xf6, 0x0d, 0x11, 0xf7, 0x51, 0x1f, 0xb7, 0x44, 0xc7, 0x12, 0x71, 0x3b, 0x1e, 0x2a, 0x73,
+	0xea, 0x62, 0xc5, 0x57, 0x4d, 0x7f, 0x13, 0x18, 0x4a, 0x93, 0x1e, 0xf4, 0x78, 0xb6, 0xef, 0xaf,
+	0x64, 0x8d, 0x24, 0x94, 0xd6, 0xe0, 0xa1, 0x63, 0x1f, 0x70, 0xb3, 0xcc, 0xe0, 0x95, 0x7a, 0xa3,
+	0x1a, 0x62, 0x3f, 0x75, 0xea, 0xde, 0x52, 0x9e, 0xdc, 0x5f, 0xca, 0x93, 0x5f, 0x97, 0xf2, 0xe4,
+	0xc3, 0xe5, 0x7c, 0xd7, 0xfd, 0xe5, 0x7c, 0xd7, 0x4f, 0xcb, 0xf9, 0xae, 0x37, 0x0e, 0x68, 0xba,
+	0x55, 0x6b, 0x54, 0x8b, 0xb3, 0x6c, 0xc1, 0x0d, 0xe7, 0xfc, 0x37, 0xc6, 0x95, 0x39, 0xe9, 0x8a,
+	0x17, 0xdb, 0x5a, 0xac, 0xab, 0xbc, 0xda, 0x6b, 0xff, 0x31, 0xc1, 0xc1, 0xff, 0x06, 0x00, 0x26,
+	0x48, 0x1d, 0x85, 0x5b, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1581,6 +1803,10 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingKeyRotations queries the key rotations of validators that are not active yet.
+	PendingKeyRotations(ctx context.Context, in *QueryPendingKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPendingKeyRotationsResponse, error)
+	// ValidatorConsPubKeyRotations queries the consensus pubkey rotation history of a validator.
+	ValidatorConsPubKeyRotations(ctx context.Context, in *QueryValidatorConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyRotationsResponse, error)
 }
 
 type queryClient struct {
@@ -1717,6 +1943,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingKeyRotations(ctx context.Context, in *QueryPendingKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPendingKeyRotationsResponse, error) {
+	out := new(QueryPendingKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/PendingKeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorConsPubKeyRotations(ctx context.Context, in *QueryValidatorConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyRotationsResponse, error) {
+	out := new(QueryValidatorConsPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorConsPubKeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1772,6 +2016,10 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingKeyRotations queries the key rotations of validators that are not active yet.
+	PendingKeyRotations(context.Context, *QueryPendingKeyRotationsRequest) (*QueryPendingKeyRotationsResponse, error)
+	// ValidatorConsPubKeyRotations queries the consensus pubkey rotation history of a validator.
+	ValidatorConsPubKeyRotations(context.Context, *QueryValidatorConsPubKeyRotationsRequest) (*QueryValidatorConsPubKeyRotationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1820,6 +2068,12 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PendingKeyRotations(ctx context.Context, req *QueryPendingKeyRotationsRequest) (*QueryPendingKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingKeyRotations not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorConsPubKeyRotations(ctx context.Context, req *QueryValidatorConsPubKeyRotationsRequest) (*QueryValidatorConsPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorConsPubKeyRotations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2077,6 +2331,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/PendingKeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingKeyRotations(ctx, req.(*QueryPendingKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorConsPubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorConsPubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorConsPubKeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorConsPubKeyRotations(ctx, req.(*QueryValidatorConsPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2137,6 +2427,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingKeyRotations",
+			Handler:    _Query_PendingKeyRotations_Handler,
+		},
+		{
+			MethodName: "ValidatorConsPubKeyRotations",
+			Handler:    _Query_ValidatorConsPubKeyRotations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3243,69 +3541,227 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPendingKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPendingKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -3689,6 +4145,70 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsPubKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsPubKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6605,6 +7125,410 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingKeyRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingKeyRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingKeyRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingKeyRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, KeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsPubKeyRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsPubKeyRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsPubKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsPubKeyRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsPubKeyRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsPubKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ConsPubKeyRotationHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -932,6 +932,20 @@ type Params struct {
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 	// min_self_delegation defines the minimum self delegation for validators.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// key_rotation_delay is the number of blocks after which a rotation of the relayer address, challenger
+	// address or BLS key of a validator takes effect. Rotations take effect immediately if it is zero.
+	KeyRotationDelay uint64 `protobuf:"varint,8,opt,name=key_rotation_delay,json=keyRotationDelay,proto3" json:"key_rotation_delay,omitempty"`
+	// key_rotation_cooldown is the minimum number of blocks between the activations of two key rotations
+	// of a validator.
+	KeyRotationCooldown uint64 `protobuf:"varint,9,opt,name=key_rotation_cooldown,json=keyRotationCooldown,proto3" json:"key_rotation_cooldown,omitempty"`
+	// key_rotation_overlap is the number of blocks after the activation of a key rotation during which
+	// the previous keys of the validator are still accepted.
+	KeyRotationOverlap uint64 `protobuf:"varint,10,opt,name=key_rotation_overlap,json=keyRotationOverlap,proto3" json:"key_rotation_overlap,omitempty"`
+	// self_create_validator defines the parameters of the creation of validators by their operator
+	// without a governance proposal.
+	SelfCreateValidator SelfCreateValidatorParams `protobuf:"bytes,11,opt,name=self_create_validator,json=selfCreateValidator,proto3" json:"self_create_validator"`
+	// cons_pubkey_rotation_fee defines the fee paid by a validator to rotate its consensus pubkey.
+	ConsPubkeyRotationFee types2.Coin `protobuf:"bytes,12,opt,name=cons_pubkey_rotation_fee,json=consPubkeyRotationFee,proto3" json:"cons_pubkey_rotation_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1001,6 +1015,414 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationDelay() uint64 {
+	if m != nil {
+		return m.KeyRotationDelay
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationCooldown() uint64 {
+	if m != nil {
+		return m.KeyRotationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationOverlap() uint64 {
+	if m != nil {
+		return m.KeyRotationOverlap
+	}
+	return 0
+}
+
+func (m *Params) GetSelfCreateValidator() SelfCreateValidatorParams {
+	if m != nil {
+		return m.SelfCreateValidator
+	}
+	return SelfCreateValidatorParams{}
+}
+
+func (m *Params) GetConsPubkeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.ConsPubkeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+// SelfCreateValidatorParams defines the parameters of the creation of validators by their operator
+// without a governance proposal.
+type SelfCreateValidatorParams struct {
+	// enabled defines whether validators can be created by their operator.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_self_bond defines the minimum amount an operator has to self delegate when creating a validator.
+	MinSelfBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_self_bond,json=minSelfBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_bond"`
+	// deposit defines the anti-spam deposit escrowed when a validator is created by its operator. The deposit
+	// is refunded when the validator is bonded for the first time, and burned if it is removed before.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// allow_list defines the operator addresses allowed to create a validator. Any operator is allowed if it
+	// is empty.
+	AllowList []string `protobuf:"bytes,4,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// epoch_length defines the length in blocks of the epochs used to cap the number of new validators.
+	EpochLength uint64 `protobuf:"varint,5,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// max_per_epoch defines the maximum number of validators created by their operator per epoch. The number
+	// of new validators is not capped if it is zero.
+	MaxPerEpoch uint32 `protobuf:"varint,6,opt,name=max_per_epoch,json=maxPerEpoch,proto3" json:"max_per_epoch,omitempty"`
+}
+
+func (m *SelfCreateValidatorParams) Reset()         { *m = SelfCreateValidatorParams{} }
+func (m *SelfCreateValidatorParams) String() string { return proto.CompactTextString(m) }
+func (*SelfCreateValidatorParams) ProtoMessage()    {}
+func (*SelfCreateValidatorParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *SelfCreateValidatorParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelfCreateValidatorParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelfCreateValidatorParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SelfCreateValidatorParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfCreateValidatorParams.Merge(m, src)
+}
+func (m *SelfCreateValidatorParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelfCreateValidatorParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfCreateValidatorParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfCreateValidatorParams proto.InternalMessageInfo
+
+func (m *SelfCreateValidatorParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SelfCreateValidatorParams) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *SelfCreateValidatorParams) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *SelfCreateValidatorParams) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *SelfCreateValidatorParams) GetMaxPerEpoch() uint32 {
+	if m != nil {
+		return m.MaxPerEpoch
+	}
+	return 0
+}
+
+// ValidatorDeposit defines the deposit escrowed for a validator created by its operator.
+type ValidatorDeposit struct {
+	// validator_address defines the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount defines the escrowed deposit.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ValidatorDeposit) Reset()         { *m = ValidatorDeposit{} }
+func (m *ValidatorDeposit) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDeposit) ProtoMessage()    {}
+func (*ValidatorDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *ValidatorDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDeposit.Merge(m, src)
+}
+func (m *ValidatorDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDeposit proto.InternalMessageInfo
+
+func (m *ValidatorDeposit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// KeyRotation defines a pending rotation of the relayer address, challenger address or BLS key of a
+// validator. Empty fields are left unchanged.
+type KeyRotation struct {
+	// validator_address defines the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// relayer_address defines the new relayer address of the validator.
+	RelayerAddress string `protobuf:"bytes,2,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// challenger_address defines the new challenger address of the validator.
+	ChallengerAddress string `protobuf:"bytes,3,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// bls_key defines the new bls pubkey of the validator.
+	BlsKey []byte `protobuf:"bytes,4,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// submit_height defines the height at which the rotation was submitted.
+	SubmitHeight int64 `protobuf:"varint,5,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// activation_height defines the height at which the rotation takes effect.
+	ActivationHeight int64 `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *KeyRotation) Reset()         { *m = KeyRotation{} }
+func (m *KeyRotation) String() string { return proto.CompactTextString(m) }
+func (*KeyRotation) ProtoMessage()    {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
+}
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
+func (m *KeyRotation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
+	}
+	return ""
+}
+
+func (m *KeyRotation) GetBlsKey() []byte {
+	if m != nil {
+		return m.BlsKey
+	}
+	return nil
+}
+
+func (m *KeyRotation) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *KeyRotation) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// KeyRotationRecord defines the keys a validator used before its last key rotation.
+type KeyRotationRecord struct {
+	// validator_address defines the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// prev_relayer_address defines the relayer address before the rotation.
+	PrevRelayerAddress string `protobuf:"bytes,2,opt,name=prev_relayer_address,json=prevRelayerAddress,proto3" json:"prev_relayer_address,omitempty"`
+	// prev_challenger_address defines the challenger address before the rotation.
+	PrevChallengerAddress string `protobuf:"bytes,3,opt,name=prev_challenger_address,json=prevChallengerAddress,proto3" json:"prev_challenger_address,omitempty"`
+	// prev_bls_key defines the bls pubkey before the rotation.
+	PrevBlsKey []byte `protobuf:"bytes,4,opt,name=prev_bls_key,json=prevBlsKey,proto3" json:"prev_bls_key,omitempty"`
+	// activation_height defines the height at which the rotation took effect.
+	ActivationHeight int64 `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// overlap_end_height defines the height from which the previous keys are no longer accepted.
+	OverlapEndHeight int64 `protobuf:"varint,6,opt,name=overlap_end_height,json=overlapEndHeight,proto3" json:"overlap_end_height,omitempty"`
+}
+
+func (m *KeyRotationRecord) Reset()         { *m = KeyRotationRecord{} }
+func (m *KeyRotationRecord) String() string { return proto.CompactTextString(m) }
+func (*KeyRotationRecord) ProtoMessage()    {}
+func (*KeyRotationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
+}
+func (m *KeyRotationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationRecord.Merge(m, src)
+}
+func (m *KeyRotationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationRecord proto.InternalMessageInfo
+
+func (m *KeyRotationRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *KeyRotationRecord) GetPrevRelayerAddress() string {
+	if m != nil {
+		return m.PrevRelayerAddress
+	}
+	return ""
+}
+
+func (m *KeyRotationRecord) GetPrevChallengerAddress() string {
+	if m != nil {
+		return m.PrevChallengerAddress
+	}
+	return ""
+}
+
+func (m *KeyRotationRecord) GetPrevBlsKey() []byte {
+	if m != nil {
+		return m.PrevBlsKey
+	}
+	return nil
+}
+
+func (m *KeyRotationRecord) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *KeyRotationRecord) GetOverlapEndHeight() int64 {
+	if m != nil {
+		return m.OverlapEndHeight
+	}
+	return 0
+}
+
+// ConsPubKeyRotationHistory defines a rotation of the consensus pubkey of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey defines the consensus pubkey before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey defines the consensus pubkey after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height defines the height at which the rotation took place.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// fee defines the fee paid for the rotation.
+	Fee types2.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1011,7 +1433,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1488,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1536,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1590,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1629,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1684,11 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*SelfCreateValidatorParams)(nil), "cosmos.staking.v1beta1.SelfCreateValidatorParams")
+	proto.RegisterType((*ValidatorDeposit)(nil), "cosmos.staking.v1beta1.ValidatorDeposit")
+	proto.RegisterType((*KeyRotation)(nil), "cosmos.staking.v1beta1.KeyRotation")
+	proto.RegisterType((*KeyRotationRecord)(nil), "cosmos.staking.v1beta1.KeyRotationRecord")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1274,130 +1701,161 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x25, 0x3e, 0x52, 0x22, 0x39, 0x76, 0x6c, 0x9a, 0x6e, 0x45, 0x86, 0x49,
-	0x13, 0xc5, 0x88, 0xa9, 0xda, 0x05, 0x7a, 0x50, 0x83, 0x16, 0xa2, 0x28, 0xdb, 0x4c, 0x1c, 0x89,
-	0x58, 0x4a, 0x4a, 0xd3, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xd4, 0x56, 0xfb, 0x43, 0xec, 0x0c, 0x1d,
-	0x11, 0xe8, 0xa1, 0x28, 0x50, 0xc0, 0xd0, 0xa1, 0x08, 0xd0, 0x4b, 0x2e, 0x06, 0x0c, 0x34, 0x87,
-	0x1e, 0x52, 0x20, 0x87, 0xa0, 0xd7, 0xa2, 0x87, 0x02, 0x69, 0x2f, 0x35, 0x72, 0x2a, 0x8a, 0x42,
-	0x2d, 0xec, 0x43, 0x8a, 0x9e, 0x8a, 0xde, 0x5b, 0x14, 0x33, 0x3b, 0xfb, 0x43, 0x52, 0xb4, 0x24,
-	0x43, 0x09, 0x02, 0xe4, 0x22, 0x71, 0x66, 0xde, 0xfb, 0xf6, 0xbd, 0xef, 0xfd, 0xcc, 0x0f, 0xbc,
-	0xa4, 0xbb, 0xd4, 0x76, 0xe9, 0x32, 0x65, 0x78, 0xdf, 0x74, 0x7a, 0xcb, 0xf7, 0x6e, 0x74, 0x09,
-	0xc3, 0x37, 0x82, 0x71, 0xbd, 0xef, 0xb9, 0xcc, 0x45, 0x97, 0x7c, 0xa9, 0x7a, 0x30, 0x2b, 0xa5,
-	0xca, 0x17, 0x7b, 0x6e, 0xcf, 0x15, 0x22, 0xcb, 0xfc, 0x97, 0x2f, 0x5d, 0xbe, 0xd2, 0x73, 0xdd,
-	0x9e, 0x45, 0x96, 0xc5, 0xa8, 0x3b, 0xd8, 0x5d, 0xc6, 0xce, 0x50, 0x2e, 0x2d, 0x8e, 0x2f, 0x19,
-	0x03, 0x0f, 0x33, 0xd3, 0x75, 0xe4, 0x7a, 0x65, 0x7c, 0x9d, 0x99, 0x36, 0xa1, 0x0c, 0xdb, 0xfd,
-	0x00, 0xdb, 0xb7, 0x44, 0xf3, 0x3f, 0x2a, 0xcd, 0x92, 0xd8, 0xd2, 0x95, 0x2e, 0xa6, 0x24, 0xf4,
-	0x43, 0x77, 0xcd, 0x00, 0xbb, 0x88, 0x6d, 0xd3, 0x71, 0x97, 0xc5, 0x5f, 0x39, 0xf5, 0x35, 0x46,
-	0x1c, 0x83, 0x78, 0xb6, 0xe9, 0xb0, 0x65, 0x36, 0xec, 0x13, 0xea, 0xff, 0x95, 0xab, 0x57, 0x63,
-	0xab, 0xb8, 0xab, 0x9b, 0xf1, 0xc5, 0xda, 0x2f, 0x15, 0x58, 0xb8, 0x63, 0x52, 0xe6, 0x7a, 0xa6,
-	0x8e, 0xad, 0x96, 0xb3, 0xeb, 0xa2, 0xef, 0x40, 0x7a, 0x8f, 0x60, 0x83, 0x78, 0x25, 0xa5, 0xaa,
-	0x2c, 0x65, 0x6f, 0x96, 0xea, 0x11, 0x40, 0xdd, 0xd7, 0xbd, 0x23, 0xd6, 0x1b, 0x99, 0x4f, 0x8e,
-	0x2a, 0x89, 0x5f, 0x7f, 0xf6, 0xd1, 0x35, 0x45, 0x95, 0x2a, 0xa8, 0x09, 0xe9, 0x7b, 0xd8, 0xa2,
-	0x84, 0x95, 0x92, 0xd5, 0x99, 0xa5, 0xec, 0xcd, 0x17, 0xea, 0xc7, 0x73, 0x5e, 0xdf, 0xc1, 0x96,
-	0x69, 0x60, 0xe6, 0x8e, 0xa2, 0xf8, 0xba, 0xb5, 0x0f, 0x93, 0x90, 0x5f, 0x73, 0x6d, 0xdb, 0xa4,
-	0xd4, 0x74, 0x1d, 0x15, 0x33, 0x42, 0x51, 0x1b, 0x52, 0x1e, 0x66, 0x44, 0x18, 0x95, 0x69, 0xbc,
-	0xce, 0x95, 0xfe, 0x7a, 0x54, 0x79, 0xb9, 0x67, 0xb2, 0xbd, 0x41, 0xb7, 0xae, 0xbb, 0xb6, 0xa4,
-	0x51, 0xfe, 0xbb, 0x4e, 0x8d, 0x7d, 0xe9, 0x69, 0x93, 0xe8, 0x9f, 0x7e, 0x7c, 0x1d, 0xa4, 0x21,
-	0x4d, 0xa2, 0xab, 0x02, 0x09, 0xbd, 0x0d, 0x73, 0x36, 0x3e, 0xd0, 0x04, 0x6a, 0xf2, 0x1c, 0x50,
-	0x67, 0x6d, 0x7c, 0xc0, 0x6d, 0x45, 0x06, 0xe4, 0x39, 0xb0, 0xbe, 0x87, 0x9d, 0x1e, 0xf1, 0xf1,
-	0x67, 0xce, 0x01, 0x7f, 0xde, 0xc6, 0x07, 0x6b, 0x02, 0x93, 0x7f, 0x65, 0x65, 0xee, 0xfd, 0x87,
-	0x95, 0xc4, 0x3f, 0x1f, 0x56, 0x94, 0xda, 0x1f, 0x14, 0x80, 0x88, 0x2e, 0x84, 0xa1, 0xa0, 0x87,
-	0x23, 0xf1, 0x79, 0x2a, 0x43, 0xf9, 0xca, 0xb4, 0x68, 0x8c, 0x91, 0xdd, 0x98, 0xe7, 0x86, 0x3e,
-	0x3a, 0xaa, 0x28, 0x7e, 0x5c, 0xf2, 0xfa, 0x58, 0x30, 0xde, 0x80, 0xec, 0xa0, 0x6f, 0x60, 0x46,
-	0x34, 0x9e, 0xd9, 0x82, 0xbd, 0xec, 0xcd, 0x72, 0xdd, 0x4f, 0xfb, 0x7a, 0x90, 0xf6, 0xf5, 0xad,
-	0x20, 0xed, 0x7d, 0xc0, 0xf7, 0xfe, 0x1e, 0x00, 0x82, 0xaf, 0xcd, 0xd7, 0x63, 0x7e, 0x7c, 0xa8,
-	0x40, 0xb6, 0x49, 0xa8, 0xee, 0x99, 0x7d, 0x5e, 0x4c, 0xa8, 0x04, 0xb3, 0xb6, 0xeb, 0x98, 0xfb,
-	0x32, 0x15, 0x33, 0x6a, 0x30, 0x44, 0x65, 0x98, 0x33, 0x0d, 0xe2, 0x30, 0x93, 0x0d, 0xfd, 0xd0,
-	0xa9, 0xe1, 0x98, 0x6b, 0xbd, 0x4b, 0xba, 0xd4, 0x0c, 0x58, 0x57, 0x83, 0x21, 0x7a, 0x15, 0x0a,
-	0x94, 0xe8, 0x03, 0xcf, 0x64, 0x43, 0x4d, 0x77, 0x1d, 0x86, 0x75, 0x56, 0x4a, 0x09, 0x91, 0x7c,
-	0x30, 0xbf, 0xe6, 0x4f, 0x73, 0x10, 0x83, 0x30, 0x6c, 0x5a, 0xb4, 0xf4, 0x9c, 0x0f, 0x22, 0x87,
-	0x31, 0x73, 0x3f, 0xc8, 0x40, 0x26, 0x4c, 0x63, 0xb4, 0x06, 0x05, 0xb7, 0x4f, 0x3c, 0xfe, 0x5b,
-	0xc3, 0x86, 0xe1, 0x11, 0x4a, 0x65, 0xae, 0x96, 0x3e, 0xfd, 0xf8, 0xfa, 0x45, 0x49, 0xfc, 0xaa,
-	0xbf, 0xd2, 0x61, 0x9e, 0xe9, 0xf4, 0xd4, 0x7c, 0xa0, 0x21, 0xa7, 0xd1, 0x3b, 0x3c, 0x74, 0x0e,
-	0x25, 0x0e, 0x1d, 0x50, 0xad, 0x3f, 0xe8, 0xee, 0x93, 0xa1, 0x24, 0xf7, 0xe2, 0x04, 0xb9, 0xab,
-	0xce, 0xb0, 0x51, 0xfa, 0x53, 0x04, 0xad, 0x7b, 0xc3, 0x3e, 0x73, 0xeb, 0xed, 0x41, 0xf7, 0x4d,
-	0x32, 0xe4, 0x21, 0x93, 0x38, 0x6d, 0x01, 0x83, 0x2e, 0x41, 0xfa, 0xc7, 0xd8, 0xb4, 0x88, 0x21,
-	0x58, 0x99, 0x53, 0xe5, 0x08, 0xad, 0x40, 0x9a, 0x32, 0xcc, 0x06, 0x54, 0x50, 0xb1, 0x70, 0xb3,
-	0x36, 0x2d, 0x47, 0x1a, 0xae, 0x63, 0x74, 0x84, 0xa4, 0x2a, 0x35, 0xd0, 0x16, 0xa4, 0x99, 0xbb,
-	0x4f, 0x1c, 0x49, 0xd2, 0x99, 0xf2, 0xbb, 0xe5, 0xb0, 0x58, 0x7e, 0xb7, 0x1c, 0xa6, 0x4a, 0x2c,
-	0xd4, 0x83, 0x82, 0x41, 0x2c, 0xd2, 0x13, 0x54, 0xd2, 0x3d, 0xec, 0x11, 0x5a, 0x4a, 0x9f, 0x43,
-	0xfd, 0xe4, 0x43, 0xd4, 0x8e, 0x00, 0x45, 0x6d, 0xc8, 0x1a, 0x51, 0xba, 0x95, 0x66, 0x05, 0xd1,
-	0x2f, 0x4e, 0xf3, 0x3f, 0x96, 0x99, 0xf1, 0x9e, 0x15, 0x87, 0xe0, 0x19, 0x36, 0x70, 0xba, 0xae,
-	0x63, 0x98, 0x4e, 0x4f, 0xdb, 0x23, 0x66, 0x6f, 0x8f, 0x95, 0xe6, 0xaa, 0xca, 0xd2, 0x8c, 0x9a,
-	0x0f, 0xe7, 0xef, 0x88, 0x69, 0xd4, 0x86, 0x85, 0x48, 0x54, 0x54, 0x51, 0xe6, 0xac, 0x55, 0x34,
-	0x1f, 0x02, 0x70, 0x11, 0xf4, 0x16, 0x40, 0x54, 0xa7, 0x25, 0x10, 0x68, 0xb5, 0x93, 0x2b, 0x3e,
-	0xee, 0x4c, 0x0c, 0x00, 0x59, 0x70, 0xc1, 0x36, 0x1d, 0x8d, 0x12, 0x6b, 0x57, 0x93, 0xcc, 0x71,
-	0xdc, 0xec, 0x39, 0x44, 0xba, 0x68, 0x9b, 0x4e, 0x87, 0x58, 0xbb, 0xcd, 0x10, 0x16, 0xbd, 0x0e,
-	0x57, 0x23, 0x3a, 0x5c, 0x47, 0xdb, 0x73, 0x2d, 0x43, 0xf3, 0xc8, 0xae, 0xa6, 0xbb, 0x03, 0x87,
-	0x95, 0x72, 0x82, 0xc4, 0xcb, 0xa1, 0xc8, 0xa6, 0x73, 0xc7, 0xb5, 0x0c, 0x95, 0xec, 0xae, 0xf1,
-	0x65, 0xf4, 0x22, 0x44, 0x5c, 0x68, 0xa6, 0x41, 0x4b, 0xf3, 0xd5, 0x99, 0xa5, 0x94, 0x9a, 0x0b,
-	0x27, 0x5b, 0x06, 0x45, 0x0d, 0x5e, 0xfe, 0xbe, 0x33, 0x61, 0x85, 0x2e, 0x9c, 0x50, 0xa1, 0x0b,
-	0xd4, 0x37, 0x33, 0x28, 0xd0, 0x55, 0xc8, 0x7b, 0xc4, 0xc2, 0x43, 0x12, 0x15, 0x79, 0xfe, 0x24,
-	0x08, 0xa9, 0x10, 0x40, 0xdc, 0x06, 0xa4, 0xef, 0x61, 0xcb, 0x22, 0x4e, 0x2f, 0x86, 0x52, 0x38,
-	0x01, 0xa5, 0x18, 0xe9, 0x04, 0x40, 0x97, 0x61, 0xb6, 0x6b, 0x51, 0x8d, 0xf7, 0x88, 0x62, 0x55,
-	0x59, 0xca, 0xa9, 0xe9, 0xae, 0x45, 0xdf, 0x24, 0xc3, 0x95, 0xdc, 0xfd, 0x87, 0x95, 0x84, 0x6c,
-	0x53, 0x89, 0x5a, 0x1b, 0x72, 0x3b, 0x38, 0x70, 0x80, 0x50, 0xf4, 0x6d, 0xc8, 0xe0, 0x60, 0x50,
-	0x52, 0xaa, 0x33, 0x4f, 0xfd, 0x6c, 0x24, 0xea, 0x37, 0xbe, 0x9f, 0xfe, 0xad, 0xaa, 0xd4, 0x3e,
-	0x50, 0x20, 0xdd, 0xdc, 0x69, 0x63, 0xd3, 0x43, 0xeb, 0x50, 0x8c, 0x6a, 0xf5, 0xb4, 0x6d, 0x2f,
-	0x2a, 0xef, 0xc0, 0x95, 0x75, 0x28, 0xde, 0x0b, 0x3a, 0x69, 0x08, 0x93, 0x3c, 0x09, 0x26, 0x54,
-	0x91, 0xf3, 0x63, 0x8e, 0xbf, 0x01, 0xb3, 0xbe, 0x95, 0x14, 0x7d, 0x0f, 0x9e, 0xeb, 0xf3, 0x1f,
-	0xc2, 0xdf, 0xec, 0xcd, 0xc5, 0xa9, 0x35, 0x2e, 0xe4, 0xe3, 0x15, 0xe1, 0xeb, 0xd5, 0xfe, 0xab,
-	0x00, 0x34, 0x77, 0x76, 0xb6, 0x3c, 0xb3, 0x6f, 0x11, 0x76, 0x5e, 0x6e, 0xdf, 0x85, 0xe7, 0x23,
-	0xb7, 0xa9, 0xa7, 0x9f, 0xda, 0xf5, 0x0b, 0xa1, 0x5a, 0xc7, 0xd3, 0x8f, 0x45, 0x33, 0x28, 0x0b,
-	0xd1, 0x66, 0x4e, 0x8d, 0xd6, 0xa4, 0xec, 0x78, 0x2e, 0xbf, 0x0f, 0xd9, 0xc8, 0x7d, 0x8a, 0x5a,
-	0x30, 0xc7, 0xe4, 0x6f, 0x49, 0x69, 0x6d, 0x3a, 0xa5, 0x81, 0x5a, 0x9c, 0xd6, 0x50, 0xbd, 0xf6,
-	0x3f, 0xce, 0x6c, 0xd4, 0x07, 0xbe, 0x54, 0x09, 0xc5, 0x37, 0x38, 0xb9, 0x01, 0x9d, 0xc7, 0x01,
-	0x4e, 0x62, 0x8d, 0x51, 0x7b, 0x3f, 0x09, 0x17, 0xb6, 0x83, 0x3e, 0xf5, 0xa5, 0x65, 0x62, 0x1b,
-	0x66, 0x89, 0xc3, 0x3c, 0x53, 0x50, 0xc1, 0x03, 0xfe, 0xcd, 0x69, 0x01, 0x3f, 0xc6, 0x97, 0x75,
-	0x87, 0x79, 0xc3, 0x78, 0xf8, 0x03, 0xac, 0x31, 0x2a, 0x7e, 0x3f, 0x03, 0xa5, 0x69, 0xea, 0xe8,
-	0x15, 0xc8, 0xeb, 0x1e, 0x11, 0x13, 0xc1, 0xd6, 0xaa, 0x88, 0x5d, 0x61, 0x21, 0x98, 0x96, 0x3b,
-	0xab, 0x0a, 0xfc, 0xbc, 0xca, 0xb3, 0x8b, 0x8b, 0x3e, 0xdb, 0x01, 0x75, 0x21, 0x42, 0x10, 0x7b,
-	0x2b, 0x81, 0xbc, 0xe9, 0x98, 0xcc, 0xc4, 0x96, 0xd6, 0xc5, 0x16, 0x76, 0xf4, 0x67, 0x39, 0xd2,
-	0x4f, 0x6e, 0x84, 0x0b, 0x12, 0xb4, 0xe1, 0x63, 0xa2, 0x1d, 0x98, 0x0d, 0xe0, 0x53, 0xe7, 0x00,
-	0x1f, 0x80, 0xa1, 0x17, 0x20, 0x17, 0xdf, 0x1f, 0xc5, 0x71, 0x2d, 0xa5, 0x66, 0x63, 0xdb, 0xe3,
-	0x49, 0x1b, 0x70, 0xfa, 0xa9, 0x1b, 0x70, 0xec, 0x54, 0xfc, 0xbb, 0x19, 0x28, 0xaa, 0xc4, 0xf8,
-	0x0a, 0x06, 0xef, 0x87, 0x00, 0x7e, 0x81, 0xf3, 0xe6, 0xfb, 0x0c, 0xf1, 0x9b, 0x6c, 0x18, 0x19,
-	0x1f, 0xaf, 0x49, 0xd9, 0x17, 0x19, 0xc1, 0x3f, 0x27, 0x21, 0x17, 0x8f, 0xe0, 0x57, 0x60, 0xb7,
-	0x43, 0x1b, 0x51, 0x7b, 0x4b, 0x89, 0xf6, 0xf6, 0xea, 0xb4, 0xf6, 0x36, 0x91, 0xdb, 0xa7, 0xe8,
-	0x6b, 0x3f, 0x4f, 0x41, 0xba, 0x8d, 0x3d, 0x6c, 0x53, 0xb4, 0x39, 0x71, 0xec, 0xf7, 0xaf, 0xe6,
-	0x57, 0x26, 0xd2, 0xbb, 0x29, 0xdf, 0x94, 0xfc, 0xec, 0x7e, 0x7f, 0xda, 0xa9, 0xff, 0x1b, 0xb0,
-	0x60, 0xe3, 0x03, 0x2d, 0x74, 0xca, 0xa7, 0x73, 0x5e, 0xbc, 0x16, 0x84, 0xb7, 0x53, 0x8a, 0x2a,
-	0x90, 0xe5, 0x62, 0x51, 0x0f, 0xe7, 0x32, 0x60, 0xe3, 0x83, 0x75, 0x7f, 0x06, 0x5d, 0x07, 0xb4,
-	0x17, 0x3e, 0x04, 0x69, 0x11, 0x19, 0x5c, 0xae, 0x18, 0xad, 0x04, 0xe2, 0x5f, 0x07, 0xe0, 0x56,
-	0x68, 0x06, 0x71, 0x5c, 0x5b, 0xde, 0x91, 0x33, 0x7c, 0xa6, 0xc9, 0x27, 0xd0, 0x4f, 0xfc, 0xcb,
-	0xc3, 0xd8, 0x3b, 0x84, 0xbc, 0xc6, 0xdd, 0x3d, 0x5b, 0x51, 0xfc, 0xe7, 0xa8, 0x52, 0x1e, 0x62,
-	0xdb, 0x5a, 0xa9, 0x1d, 0x03, 0x59, 0x13, 0x97, 0x89, 0xd1, 0xf7, 0x8b, 0x69, 0x57, 0x97, 0xd9,
-	0xcf, 0xe5, 0xea, 0xb2, 0xb2, 0x14, 0x54, 0xce, 0xe1, 0x67, 0x1f, 0x5d, 0xbb, 0x1a, 0xc3, 0x38,
-	0x08, 0xdf, 0x23, 0xfd, 0xe0, 0xd7, 0x7e, 0xa3, 0x00, 0x8a, 0x14, 0x55, 0x42, 0xfb, 0xfc, 0x92,
-	0xce, 0x2f, 0x6e, 0x31, 0x2b, 0x95, 0xa7, 0x5f, 0xdc, 0x22, 0xfd, 0x91, 0x8b, 0x5b, 0xac, 0x5c,
-	0xbf, 0x1b, 0x6d, 0x22, 0x49, 0x99, 0x5b, 0x12, 0xab, 0x8b, 0x29, 0x89, 0xdd, 0x00, 0xcd, 0x11,
-	0x88, 0x40, 0x29, 0xec, 0x04, 0x89, 0xda, 0x91, 0x02, 0x57, 0x26, 0xf2, 0x3d, 0x34, 0x5b, 0x07,
-	0xe4, 0xc5, 0x16, 0x45, 0xce, 0x0c, 0xa5, 0xf9, 0xcf, 0x56, 0x3e, 0x45, 0x6f, 0x62, 0xe3, 0xf8,
-	0x9c, 0x76, 0xc4, 0x95, 0x94, 0x68, 0x75, 0x7f, 0x54, 0xe0, 0x62, 0xdc, 0xa2, 0xd0, 0xb7, 0x0e,
-	0xe4, 0xe2, 0xb6, 0x48, 0xaf, 0x5e, 0x3a, 0x8d, 0x57, 0x71, 0x87, 0x46, 0x40, 0xb8, 0x2f, 0x41,
-	0x5d, 0xf9, 0xaf, 0xa3, 0x37, 0x4e, 0xcd, 0x52, 0x60, 0xd8, 0xb1, 0xcd, 0x26, 0x25, 0x82, 0xf5,
-	0x8b, 0x24, 0xa4, 0xda, 0xae, 0x6b, 0xa1, 0x9f, 0x29, 0x50, 0x74, 0x5c, 0xa6, 0xf1, 0x6a, 0x24,
-	0x86, 0x26, 0x5f, 0x68, 0xfc, 0x7e, 0xbd, 0x73, 0x36, 0xf6, 0xfe, 0x75, 0x54, 0x99, 0x84, 0x1a,
-	0xa5, 0x54, 0xbe, 0x10, 0x3a, 0x2e, 0x6b, 0x08, 0xa1, 0x2d, 0xff, 0x11, 0xe7, 0x5d, 0x98, 0x1f,
-	0xfd, 0xbe, 0xdf, 0xe4, 0xd5, 0x33, 0x7f, 0x7f, 0xfe, 0xc4, 0x6f, 0xe7, 0xba, 0xb1, 0x0f, 0xaf,
-	0xcc, 0xf1, 0xc0, 0xfe, 0x9b, 0x07, 0xf7, 0x1d, 0x28, 0x84, 0x0d, 0x70, 0x5b, 0xbc, 0x37, 0xf2,
-	0xd3, 0xf0, 0xac, 0xff, 0xf4, 0x18, 0xdc, 0x5b, 0xaa, 0xf1, 0xd7, 0x6d, 0xdc, 0xd5, 0xcd, 0xfa,
-	0x98, 0xce, 0x08, 0xe3, 0x52, 0xf7, 0xda, 0x6f, 0x15, 0x80, 0xe8, 0x3d, 0x0c, 0xbd, 0x06, 0x97,
-	0x1b, 0x9b, 0x1b, 0x4d, 0xad, 0xb3, 0xb5, 0xba, 0xb5, 0xdd, 0xd1, 0xb6, 0x37, 0x3a, 0xed, 0xf5,
-	0xb5, 0xd6, 0xad, 0xd6, 0x7a, 0xb3, 0x90, 0x28, 0xe7, 0x0f, 0x1f, 0x54, 0xb3, 0xdb, 0x0e, 0xed,
-	0x13, 0xdd, 0xdc, 0x35, 0x89, 0x81, 0x5e, 0x86, 0x8b, 0xa3, 0xd2, 0x7c, 0xb4, 0xde, 0x2c, 0x28,
-	0xe5, 0xdc, 0xe1, 0x83, 0xea, 0x9c, 0x7f, 0x00, 0x26, 0x06, 0x5a, 0x82, 0xe7, 0x27, 0xe5, 0x5a,
-	0x1b, 0xb7, 0x0b, 0xc9, 0xf2, 0xfc, 0xe1, 0x83, 0x6a, 0x26, 0x3c, 0x29, 0xa3, 0x1a, 0xa0, 0xb8,
-	0xa4, 0xc4, 0x9b, 0x29, 0xc3, 0xe1, 0x83, 0x6a, 0xda, 0x0f, 0x4b, 0x39, 0x75, 0xff, 0x57, 0x8b,
-	0x89, 0x6b, 0x3f, 0x02, 0x68, 0x39, 0xbb, 0x1e, 0xd6, 0x45, 0x42, 0x96, 0xe1, 0x52, 0x6b, 0xe3,
-	0x96, 0xba, 0xba, 0xb6, 0xd5, 0xda, 0xdc, 0x18, 0x35, 0x7b, 0x6c, 0xad, 0xb9, 0xb9, 0xdd, 0xb8,
-	0xbb, 0xae, 0x75, 0x5a, 0xb7, 0x37, 0x0a, 0x0a, 0xba, 0x0c, 0x17, 0x46, 0xd6, 0xde, 0xde, 0xd8,
-	0x6a, 0xbd, 0xb5, 0x5e, 0x48, 0x36, 0x6e, 0x7d, 0xf2, 0x78, 0x51, 0x79, 0xf4, 0x78, 0x51, 0xf9,
-	0xc7, 0xe3, 0x45, 0xe5, 0xbd, 0x27, 0x8b, 0x89, 0x47, 0x4f, 0x16, 0x13, 0x7f, 0x79, 0xb2, 0x98,
-	0xf8, 0xc1, 0x6b, 0x4f, 0x0d, 0x78, 0xd4, 0x29, 0x45, 0xe8, 0xbb, 0x69, 0xb1, 0x0b, 0x7e, 0xeb,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xb4, 0xf5, 0xa9, 0xd8, 0x19, 0x00, 0x00,
+	// 2459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x6c, 0x63, 0x47,
+	0x19, 0xcf, 0xb3, 0xbd, 0x4e, 0xf2, 0xd9, 0x89, 0xed, 0x49, 0xb2, 0xeb, 0x4d, 0x21, 0x71, 0xdd,
+	0xd2, 0xa6, 0x4b, 0xd7, 0xe9, 0x06, 0x51, 0xa4, 0x50, 0x81, 0xd6, 0x76, 0xb6, 0xeb, 0x76, 0x9b,
+	0x58, 0x2f, 0x7f, 0x4a, 0x41, 0xd5, 0xe3, 0xf9, 0xbd, 0x89, 0xfd, 0x9a, 0xe7, 0x79, 0xd6, 0x9b,
+	0x71, 0xb2, 0x96, 0x38, 0x20, 0x4e, 0xcb, 0x1e, 0x50, 0x25, 0x2e, 0xbd, 0xac, 0x54, 0x89, 0x1e,
+	0x10, 0x2a, 0x52, 0x0f, 0x15, 0x57, 0x84, 0x10, 0x52, 0x81, 0x03, 0x55, 0x4f, 0x08, 0xa1, 0x14,
+	0xed, 0x1e, 0x8a, 0xf6, 0x84, 0x90, 0x38, 0x82, 0xd0, 0xcc, 0x9b, 0xf7, 0xc7, 0x4e, 0xbc, 0xf9,
+	0x83, 0x17, 0x55, 0xea, 0x25, 0xf1, 0xcc, 0x7c, 0xdf, 0x6f, 0xe6, 0xfb, 0xcd, 0x37, 0xf3, 0x7d,
+	0xf3, 0x3d, 0x78, 0xda, 0x70, 0x68, 0xdb, 0xa1, 0xcb, 0x94, 0xe9, 0x7b, 0x16, 0x69, 0x2e, 0xef,
+	0x5f, 0x6b, 0x60, 0xa6, 0x5f, 0xf3, 0xdb, 0xa5, 0x8e, 0xeb, 0x30, 0x07, 0x5d, 0xf4, 0xa4, 0x4a,
+	0x7e, 0xaf, 0x94, 0x9a, 0x9f, 0x6d, 0x3a, 0x4d, 0x47, 0x88, 0x2c, 0xf3, 0x5f, 0x9e, 0xf4, 0xfc,
+	0xe5, 0xa6, 0xe3, 0x34, 0x6d, 0xbc, 0x2c, 0x5a, 0x8d, 0xee, 0xee, 0xb2, 0x4e, 0x7a, 0x72, 0x68,
+	0x61, 0x70, 0xc8, 0xec, 0xba, 0x3a, 0xb3, 0x1c, 0x22, 0xc7, 0x17, 0x07, 0xc7, 0x99, 0xd5, 0xc6,
+	0x94, 0xe9, 0xed, 0x8e, 0x8f, 0xed, 0xad, 0x44, 0xf3, 0x26, 0x95, 0xcb, 0x92, 0xd8, 0xd2, 0x94,
+	0x86, 0x4e, 0x71, 0x60, 0x87, 0xe1, 0x58, 0x3e, 0x76, 0x4e, 0x6f, 0x5b, 0xc4, 0x59, 0x16, 0x7f,
+	0x65, 0xd7, 0x97, 0x18, 0x26, 0x26, 0x76, 0xdb, 0x16, 0x61, 0xcb, 0xac, 0xd7, 0xc1, 0xd4, 0xfb,
+	0x2b, 0x47, 0x9f, 0x88, 0x8c, 0xea, 0x0d, 0xc3, 0x8a, 0x0e, 0x16, 0x7f, 0xaa, 0xc0, 0xf4, 0x4d,
+	0x8b, 0x32, 0xc7, 0xb5, 0x0c, 0xdd, 0xae, 0x91, 0x5d, 0x07, 0x7d, 0x13, 0x92, 0x2d, 0xac, 0x9b,
+	0xd8, 0xcd, 0x2b, 0x05, 0x65, 0x29, 0xb5, 0x92, 0x2f, 0x85, 0x00, 0x25, 0x4f, 0xf7, 0xa6, 0x18,
+	0x2f, 0x4f, 0x7e, 0x74, 0xb8, 0x38, 0xf6, 0xf3, 0xcf, 0x3e, 0xb8, 0xa2, 0xa8, 0x52, 0x05, 0x55,
+	0x21, 0xb9, 0xaf, 0xdb, 0x14, 0xb3, 0x7c, 0xac, 0x10, 0x5f, 0x4a, 0xad, 0x3c, 0x59, 0x3a, 0x9e,
+	0xf3, 0xd2, 0x8e, 0x6e, 0x5b, 0xa6, 0xce, 0x9c, 0x7e, 0x14, 0x4f, 0xb7, 0xf8, 0x7e, 0x0c, 0x32,
+	0x15, 0xa7, 0xdd, 0xb6, 0x28, 0xb5, 0x1c, 0xa2, 0xea, 0x0c, 0x53, 0x54, 0x87, 0x84, 0xab, 0x33,
+	0x2c, 0x16, 0x35, 0x59, 0x7e, 0x89, 0x2b, 0xfd, 0xe5, 0x70, 0xf1, 0x99, 0xa6, 0xc5, 0x5a, 0xdd,
+	0x46, 0xc9, 0x70, 0xda, 0x92, 0x46, 0xf9, 0xef, 0x2a, 0x35, 0xf7, 0xa4, 0xa5, 0x55, 0x6c, 0x7c,
+	0xf2, 0xe1, 0x55, 0x90, 0x0b, 0xa9, 0x62, 0x43, 0x15, 0x48, 0xe8, 0x75, 0x98, 0x68, 0xeb, 0xb7,
+	0x35, 0x81, 0x1a, 0x1b, 0x01, 0xea, 0x78, 0x5b, 0xbf, 0xcd, 0xd7, 0x8a, 0x4c, 0xc8, 0x70, 0x60,
+	0xa3, 0xa5, 0x93, 0x26, 0xf6, 0xf0, 0xe3, 0x23, 0xc0, 0x9f, 0x6a, 0xeb, 0xb7, 0x2b, 0x02, 0x93,
+	0xcf, 0xb2, 0x3a, 0xf1, 0xce, 0xbb, 0x8b, 0x63, 0x7f, 0x7f, 0x77, 0x51, 0x29, 0xfe, 0x4e, 0x01,
+	0x08, 0xe9, 0x42, 0x3a, 0x64, 0x8d, 0xa0, 0x25, 0xa6, 0xa7, 0x72, 0x2b, 0x9f, 0x1d, 0xb6, 0x1b,
+	0x03, 0x64, 0x97, 0xa7, 0xf8, 0x42, 0x3f, 0x3e, 0x5c, 0x54, 0xbc, 0x7d, 0xc9, 0x18, 0x03, 0x9b,
+	0xf1, 0x0a, 0xa4, 0xba, 0x1d, 0x53, 0x67, 0x58, 0xe3, 0x9e, 0x2d, 0xd8, 0x4b, 0xad, 0xcc, 0x97,
+	0x3c, 0xb7, 0x2f, 0xf9, 0x6e, 0x5f, 0xda, 0xf2, 0xdd, 0xde, 0x03, 0x7c, 0xfb, 0x53, 0x1f, 0x10,
+	0x3c, 0x6d, 0x3e, 0x1e, 0xb1, 0xe3, 0x7d, 0x05, 0x52, 0x55, 0x4c, 0x0d, 0xd7, 0xea, 0xf0, 0xc3,
+	0x84, 0xf2, 0x30, 0xde, 0x76, 0x88, 0xb5, 0x27, 0x5d, 0x71, 0x52, 0xf5, 0x9b, 0x68, 0x1e, 0x26,
+	0x2c, 0x13, 0x13, 0x66, 0xb1, 0x9e, 0xb7, 0x75, 0x6a, 0xd0, 0xe6, 0x5a, 0x07, 0xb8, 0x41, 0x2d,
+	0x9f, 0x75, 0xd5, 0x6f, 0xa2, 0xe7, 0x20, 0x4b, 0xb1, 0xd1, 0x75, 0x2d, 0xd6, 0xd3, 0x0c, 0x87,
+	0x30, 0xdd, 0x60, 0xf9, 0x84, 0x10, 0xc9, 0xf8, 0xfd, 0x15, 0xaf, 0x9b, 0x83, 0x98, 0x98, 0xe9,
+	0x96, 0x4d, 0xf3, 0x17, 0x3c, 0x10, 0xd9, 0x8c, 0x2c, 0xf7, 0xbd, 0x49, 0x98, 0x0c, 0xdc, 0x18,
+	0x55, 0x20, 0xeb, 0x74, 0xb0, 0xcb, 0x7f, 0x6b, 0xba, 0x69, 0xba, 0x98, 0x52, 0xe9, 0xab, 0xf9,
+	0x4f, 0x3e, 0xbc, 0x3a, 0x2b, 0x89, 0xbf, 0xee, 0x8d, 0x6c, 0x32, 0xd7, 0x22, 0x4d, 0x35, 0xe3,
+	0x6b, 0xc8, 0x6e, 0xf4, 0x06, 0xdf, 0x3a, 0x42, 0x31, 0xa1, 0x5d, 0xaa, 0x75, 0xba, 0x8d, 0x3d,
+	0xdc, 0x93, 0xe4, 0xce, 0x1e, 0x21, 0xf7, 0x3a, 0xe9, 0x95, 0xf3, 0x7f, 0x08, 0xa1, 0x0d, 0xb7,
+	0xd7, 0x61, 0x4e, 0xa9, 0xde, 0x6d, 0xbc, 0x8a, 0x7b, 0x6a, 0x26, 0xc0, 0xa9, 0x0b, 0x18, 0x74,
+	0x11, 0x92, 0x6f, 0xe9, 0x96, 0x8d, 0x4d, 0xc1, 0xca, 0x84, 0x2a, 0x5b, 0x68, 0x15, 0x92, 0x94,
+	0xe9, 0xac, 0x4b, 0x05, 0x15, 0xd3, 0x2b, 0xc5, 0x61, 0x3e, 0x52, 0x76, 0x88, 0xb9, 0x29, 0x24,
+	0x55, 0xa9, 0x81, 0xb6, 0x20, 0xc9, 0x9c, 0x3d, 0x4c, 0x24, 0x49, 0x67, 0xf2, 0xef, 0x1a, 0x61,
+	0x11, 0xff, 0xae, 0x11, 0xa6, 0x4a, 0x2c, 0xd4, 0x84, 0xac, 0x89, 0x6d, 0xdc, 0x14, 0x54, 0xd2,
+	0x96, 0xee, 0x62, 0x9a, 0x4f, 0x8e, 0xe0, 0xfc, 0x64, 0x02, 0xd4, 0x4d, 0x01, 0x8a, 0xea, 0x90,
+	0x32, 0x43, 0x77, 0xcb, 0x8f, 0x0b, 0xa2, 0x9f, 0x1a, 0x66, 0x7f, 0xc4, 0x33, 0xa3, 0x77, 0x56,
+	0x14, 0x82, 0x7b, 0x58, 0x97, 0x34, 0x1c, 0x62, 0x5a, 0xa4, 0xa9, 0xb5, 0xb0, 0xd5, 0x6c, 0xb1,
+	0xfc, 0x44, 0x41, 0x59, 0x8a, 0xab, 0x99, 0xa0, 0xff, 0xa6, 0xe8, 0x46, 0x75, 0x98, 0x0e, 0x45,
+	0xc5, 0x29, 0x9a, 0x3c, 0xeb, 0x29, 0x9a, 0x0a, 0x00, 0xb8, 0x08, 0x7a, 0x0d, 0x20, 0x3c, 0xa7,
+	0x79, 0x10, 0x68, 0xc5, 0x93, 0x4f, 0x7c, 0xd4, 0x98, 0x08, 0x00, 0xb2, 0x61, 0xa6, 0x6d, 0x11,
+	0x8d, 0x62, 0x7b, 0x57, 0x93, 0xcc, 0x71, 0xdc, 0xd4, 0x08, 0x76, 0x3a, 0xd7, 0xb6, 0xc8, 0x26,
+	0xb6, 0x77, 0xab, 0x01, 0x2c, 0x7a, 0x09, 0x9e, 0x08, 0xe9, 0x70, 0x88, 0xd6, 0x72, 0x6c, 0x53,
+	0x73, 0xf1, 0xae, 0x66, 0x38, 0x5d, 0xc2, 0xf2, 0x69, 0x41, 0xe2, 0xa5, 0x40, 0x64, 0x83, 0xdc,
+	0x74, 0x6c, 0x53, 0xc5, 0xbb, 0x15, 0x3e, 0x8c, 0x9e, 0x82, 0x90, 0x0b, 0xcd, 0x32, 0x69, 0x7e,
+	0xaa, 0x10, 0x5f, 0x4a, 0xa8, 0xe9, 0xa0, 0xb3, 0x66, 0x52, 0x54, 0x86, 0xac, 0x6f, 0x4c, 0x70,
+	0x42, 0xa7, 0x4f, 0x38, 0xa1, 0xd3, 0xd4, 0x5b, 0xa6, 0x7f, 0x40, 0xaf, 0x43, 0xc6, 0xc5, 0xb6,
+	0xde, 0xc3, 0xe1, 0x21, 0xcf, 0x9c, 0x04, 0x21, 0x15, 0x7c, 0x88, 0x97, 0x01, 0x19, 0x2d, 0xdd,
+	0xb6, 0x31, 0x69, 0x46, 0x50, 0xb2, 0x27, 0xa0, 0xe4, 0x42, 0x1d, 0x1f, 0xe8, 0x12, 0x8c, 0x37,
+	0x6c, 0xaa, 0xf1, 0x3b, 0x22, 0x57, 0x50, 0x96, 0xd2, 0x6a, 0xb2, 0x61, 0xd3, 0x57, 0x71, 0x6f,
+	0x35, 0x7d, 0xe7, 0xdd, 0xc5, 0x31, 0x79, 0x4d, 0x8d, 0x15, 0xeb, 0x90, 0xde, 0xd1, 0x7d, 0x03,
+	0x30, 0x45, 0x2f, 0xc2, 0xa4, 0xee, 0x37, 0xf2, 0x4a, 0x21, 0xfe, 0xc8, 0x69, 0x43, 0x51, 0xef,
+	0xe2, 0xfb, 0xe1, 0x5f, 0x0b, 0x4a, 0xf1, 0x3d, 0x05, 0x92, 0xd5, 0x9d, 0xba, 0x6e, 0xb9, 0x68,
+	0x0d, 0x72, 0xe1, 0x59, 0x3d, 0xed, 0xb5, 0x17, 0x1e, 0x6f, 0xdf, 0x94, 0x35, 0xc8, 0xed, 0xfb,
+	0x37, 0x69, 0x00, 0x13, 0x3b, 0x09, 0x26, 0x50, 0x91, 0xfd, 0x03, 0x86, 0xbf, 0x02, 0xe3, 0xde,
+	0x2a, 0x29, 0xfa, 0x36, 0x5c, 0xe8, 0xf0, 0x1f, 0xc2, 0xde, 0xd4, 0xca, 0xc2, 0xd0, 0x33, 0x2e,
+	0xe4, 0xa3, 0x27, 0xc2, 0xd3, 0x2b, 0xfe, 0x5b, 0x01, 0xa8, 0xee, 0xec, 0x6c, 0xb9, 0x56, 0xc7,
+	0xc6, 0x6c, 0x54, 0x66, 0xdf, 0x82, 0xb9, 0xd0, 0x6c, 0xea, 0x1a, 0xa7, 0x36, 0x7d, 0x26, 0x50,
+	0xdb, 0x74, 0x8d, 0x63, 0xd1, 0x4c, 0xca, 0x02, 0xb4, 0xf8, 0xa9, 0xd1, 0xaa, 0x94, 0x1d, 0xcf,
+	0xe5, 0x77, 0x20, 0x15, 0x9a, 0x4f, 0x51, 0x0d, 0x26, 0x98, 0xfc, 0x2d, 0x29, 0x2d, 0x0e, 0xa7,
+	0xd4, 0x57, 0x8b, 0xd2, 0x1a, 0xa8, 0x17, 0xff, 0xc3, 0x99, 0x0d, 0xef, 0x81, 0xcf, 0x95, 0x43,
+	0xf1, 0x00, 0x27, 0x03, 0xd0, 0x28, 0x12, 0x38, 0x89, 0x35, 0x40, 0xed, 0x9d, 0x18, 0xcc, 0x6c,
+	0xfb, 0xf7, 0xd4, 0xe7, 0x96, 0x89, 0x6d, 0x18, 0xc7, 0x84, 0xb9, 0x96, 0xa0, 0x82, 0x6f, 0xf8,
+	0x0b, 0xc3, 0x36, 0xfc, 0x18, 0x5b, 0xd6, 0x08, 0x73, 0x7b, 0xd1, 0xed, 0xf7, 0xb1, 0x06, 0xa8,
+	0xf8, 0x4d, 0x1c, 0xf2, 0xc3, 0xd4, 0xd1, 0xb3, 0x90, 0x31, 0x5c, 0x2c, 0x3a, 0xfc, 0xd0, 0xaa,
+	0x88, 0xa8, 0x30, 0xed, 0x77, 0xcb, 0xc8, 0xaa, 0x02, 0xcf, 0x57, 0xb9, 0x77, 0x71, 0xd1, 0xf3,
+	0x25, 0xa8, 0xd3, 0x21, 0x82, 0x88, 0xad, 0x18, 0x32, 0x16, 0xb1, 0x98, 0xa5, 0xdb, 0x5a, 0x43,
+	0xb7, 0x75, 0x62, 0x9c, 0x27, 0xa5, 0x3f, 0x1a, 0x08, 0xa7, 0x25, 0x68, 0xd9, 0xc3, 0x44, 0x3b,
+	0x30, 0xee, 0xc3, 0x27, 0x46, 0x00, 0xef, 0x83, 0xa1, 0x27, 0x21, 0x1d, 0x8d, 0x8f, 0x22, 0x5d,
+	0x4b, 0xa8, 0xa9, 0x48, 0x78, 0x3c, 0x29, 0x00, 0x27, 0x1f, 0x19, 0x80, 0x23, 0x59, 0xf1, 0xaf,
+	0xe3, 0x90, 0x53, 0xb1, 0xf9, 0x05, 0xdc, 0xbc, 0xef, 0x01, 0x78, 0x07, 0x9c, 0x5f, 0xbe, 0xf9,
+	0xc4, 0x08, 0x2e, 0x8c, 0x49, 0x0f, 0xaf, 0x4a, 0xd9, 0xff, 0x73, 0x07, 0xff, 0x14, 0x83, 0x74,
+	0x74, 0x07, 0xbf, 0x00, 0xd1, 0x0e, 0xad, 0x87, 0xd7, 0x5b, 0x42, 0x5c, 0x6f, 0xcf, 0x0d, 0xbb,
+	0xde, 0x8e, 0xf8, 0xf6, 0x29, 0xee, 0xb5, 0x87, 0x49, 0x48, 0xd6, 0x75, 0x57, 0x6f, 0x53, 0xb4,
+	0x71, 0x24, 0xed, 0xf7, 0x9e, 0xe6, 0x97, 0x8f, 0xb8, 0x77, 0x55, 0xd6, 0x94, 0x3c, 0xef, 0x7e,
+	0x67, 0x58, 0xd6, 0xff, 0x15, 0x98, 0xe6, 0xc5, 0x86, 0xc0, 0x28, 0x8f, 0xce, 0x29, 0x51, 0x2d,
+	0x08, 0x5e, 0xa7, 0x14, 0x2d, 0x42, 0x8a, 0x8b, 0x85, 0x77, 0x38, 0x97, 0x81, 0xb6, 0x7e, 0x7b,
+	0xcd, 0xeb, 0x41, 0x57, 0x01, 0xb5, 0x82, 0x42, 0x90, 0x16, 0x92, 0xc1, 0xe5, 0x72, 0xe1, 0x88,
+	0x2f, 0xfe, 0x65, 0x00, 0xbe, 0x0a, 0xcd, 0xc4, 0xc4, 0x69, 0xcb, 0x37, 0xf2, 0x24, 0xef, 0xa9,
+	0xf2, 0x0e, 0xf4, 0x03, 0xef, 0xf1, 0x30, 0x50, 0x87, 0x90, 0xcf, 0xb8, 0x5b, 0x67, 0x3b, 0x14,
+	0xff, 0x3c, 0x5c, 0x9c, 0xef, 0xe9, 0x6d, 0x7b, 0xb5, 0x78, 0x0c, 0x64, 0x51, 0x3c, 0x26, 0xfa,
+	0xeb, 0x17, 0xc3, 0x9e, 0x2e, 0xe3, 0x8f, 0xe7, 0xe9, 0xf2, 0x3c, 0xa0, 0x3d, 0xdc, 0xd3, 0x5c,
+	0x87, 0x89, 0x36, 0x9f, 0x51, 0xef, 0x89, 0x67, 0x5f, 0x42, 0xcd, 0xee, 0xe1, 0x9e, 0x2a, 0x07,
+	0xaa, 0xbc, 0x1f, 0xad, 0xc0, 0x5c, 0x9f, 0xb4, 0xe1, 0x38, 0xb6, 0xe9, 0x1c, 0x10, 0xf1, 0xfc,
+	0x4b, 0xa8, 0x33, 0x11, 0x85, 0x8a, 0x1c, 0x42, 0x2f, 0xc0, 0x6c, 0x9f, 0x8e, 0xb3, 0x8f, 0x5d,
+	0x5b, 0xef, 0x88, 0x37, 0x5e, 0x42, 0x45, 0x11, 0x95, 0x0d, 0x6f, 0x04, 0x75, 0x60, 0x4e, 0x58,
+	0x2f, 0x6e, 0x57, 0x1c, 0x7a, 0x87, 0x78, 0xbe, 0xa5, 0x56, 0xae, 0x0d, 0xf3, 0x6e, 0x6e, 0x5a,
+	0x45, 0xe8, 0x04, 0xbe, 0xe3, 0x39, 0x6e, 0xd4, 0xcb, 0x67, 0xe8, 0x51, 0x29, 0xf4, 0x26, 0xe4,
+	0x0d, 0x87, 0xf8, 0x55, 0x8b, 0x70, 0xad, 0xbb, 0x18, 0xe7, 0xd3, 0xd2, 0xc5, 0xe5, 0xa4, 0xbc,
+	0xb4, 0x19, 0x79, 0x88, 0x5a, 0x7d, 0x4f, 0xd0, 0x39, 0x8e, 0xe2, 0xd5, 0x2c, 0x7c, 0xa3, 0x6e,
+	0x60, 0xbc, 0xba, 0xe4, 0x5f, 0x4f, 0x77, 0x3f, 0xfb, 0xe0, 0xca, 0x13, 0x91, 0x8d, 0xba, 0x1d,
+	0x14, 0x7d, 0xbd, 0x85, 0x16, 0x7f, 0x1c, 0x87, 0xcb, 0x43, 0xcd, 0xe0, 0x85, 0x1d, 0x4c, 0xf4,
+	0x06, 0xaf, 0x83, 0x28, 0xa2, 0x0e, 0xe2, 0x37, 0xd1, 0xf7, 0x61, 0x2a, 0x70, 0x1a, 0xee, 0xc8,
+	0xf9, 0xd8, 0x08, 0xdc, 0x25, 0x25, 0xdd, 0x85, 0x17, 0x4f, 0xd0, 0x5b, 0xbc, 0xa8, 0xd4, 0x71,
+	0xa8, 0xc5, 0x64, 0x0e, 0xf5, 0x08, 0x46, 0xbe, 0xce, 0xa7, 0xfd, 0xc5, 0xa7, 0x8b, 0x4b, 0xa7,
+	0x98, 0x96, 0x2b, 0x50, 0x79, 0x01, 0xc9, 0x09, 0xd0, 0x37, 0x00, 0x74, 0xdb, 0x76, 0x0e, 0x34,
+	0xdb, 0x12, 0xc1, 0xe8, 0xa4, 0x67, 0x1e, 0x97, 0xbd, 0x65, 0x79, 0x81, 0x06, 0x77, 0x1c, 0xa3,
+	0xa5, 0xf1, 0xc7, 0x26, 0x6b, 0xf9, 0x81, 0x46, 0xf4, 0xdd, 0x12, 0x5d, 0xa8, 0x08, 0xfc, 0x72,
+	0xd1, 0x3a, 0xd8, 0xd5, 0x44, 0xb7, 0x38, 0xd6, 0x53, 0x2a, 0xbf, 0x60, 0xea, 0xd8, 0x5d, 0xe3,
+	0x5d, 0xab, 0x09, 0x11, 0x4a, 0x7e, 0xab, 0x40, 0x36, 0xd8, 0x81, 0xaa, 0x5c, 0xda, 0xb1, 0x19,
+	0xa9, 0x72, 0xe6, 0x8c, 0xb4, 0x05, 0x49, 0xbd, 0x2d, 0x22, 0x5b, 0xec, 0x31, 0x91, 0x29, 0xf1,
+	0x8b, 0x7f, 0x8c, 0x41, 0xea, 0xd5, 0xd0, 0x1d, 0x47, 0x65, 0xc0, 0x31, 0xb5, 0x84, 0xd8, 0x48,
+	0x6a, 0x09, 0xf1, 0xff, 0xa9, 0x96, 0x90, 0x88, 0xd6, 0x12, 0x78, 0x65, 0x85, 0x76, 0x1b, 0x6d,
+	0x8b, 0xf9, 0x69, 0xdb, 0x05, 0x91, 0x46, 0xa4, 0xbd, 0x4e, 0x99, 0xb4, 0x7d, 0x15, 0x72, 0xba,
+	0xc1, 0xac, 0xfd, 0xbe, 0xfc, 0xce, 0xcb, 0x37, 0xb2, 0xe1, 0x80, 0x27, 0x5c, 0xfc, 0x57, 0x0c,
+	0x72, 0x11, 0x36, 0x55, 0x6c, 0x38, 0xae, 0x39, 0x2a, 0x4e, 0x5f, 0x81, 0xd9, 0x8e, 0x8b, 0xf7,
+	0xb5, 0xb3, 0x12, 0x8b, 0xb8, 0x96, 0xda, 0x4f, 0x6e, 0x1d, 0x2e, 0x09, 0xac, 0x73, 0x30, 0x3c,
+	0xc7, 0x15, 0x2b, 0x47, 0x58, 0x2e, 0x40, 0x5a, 0x20, 0xf6, 0x53, 0x0d, 0xbc, 0xaf, 0xec, 0xd1,
+	0x7d, 0x2c, 0x93, 0x17, 0x8e, 0x67, 0x92, 0x07, 0x1e, 0x19, 0x09, 0x34, 0x4c, 0xcc, 0x01, 0xde,
+	0xe5, 0xc8, 0x1a, 0x31, 0x25, 0xef, 0x0f, 0x63, 0x70, 0xb9, 0xe2, 0xdd, 0xad, 0x11, 0xfa, 0xbd,
+	0x8f, 0x3f, 0xbd, 0xd1, 0x94, 0xaf, 0x77, 0x20, 0xc3, 0x73, 0xce, 0x48, 0x1c, 0x38, 0x67, 0xf5,
+	0x7a, 0xca, 0xb1, 0xcd, 0x4a, 0x10, 0x07, 0x38, 0x2e, 0xc1, 0x07, 0x7d, 0xb8, 0xf1, 0xf3, 0xe1,
+	0x12, 0x7c, 0x10, 0xc1, 0xbd, 0xc8, 0x3f, 0x75, 0x09, 0xd2, 0x12, 0x82, 0x34, 0xd9, 0x42, 0x2f,
+	0x42, 0x9c, 0x87, 0xad, 0x0b, 0x67, 0x08, 0x5b, 0x5c, 0x61, 0x75, 0xe2, 0x8e, 0x9f, 0xf1, 0xfd,
+	0x52, 0x01, 0x14, 0xa6, 0x08, 0x2a, 0xa6, 0x1d, 0x87, 0x50, 0x51, 0xa2, 0x8d, 0xe4, 0x23, 0xca,
+	0xa3, 0x4b, 0xb4, 0xa1, 0x7e, 0x74, 0xa2, 0x08, 0x00, 0xfa, 0x56, 0xf8, 0x5c, 0x8c, 0x9d, 0x61,
+	0xad, 0xbe, 0x52, 0x90, 0xf3, 0x8f, 0x15, 0x0f, 0x15, 0xb8, 0x7c, 0x24, 0xb3, 0x0d, 0x96, 0x6d,
+	0x00, 0x72, 0x23, 0x83, 0x22, 0x3b, 0xec, 0xc9, 0xe5, 0x9f, 0x2f, 0x51, 0xce, 0xb9, 0x83, 0xa3,
+	0x8f, 0xeb, 0xed, 0x2b, 0x23, 0xd1, 0xef, 0x15, 0x98, 0x8d, 0xae, 0x28, 0xb0, 0x6d, 0x13, 0xd2,
+	0xd1, 0xb5, 0x48, 0xab, 0x9e, 0x3e, 0x8d, 0x55, 0x51, 0x83, 0xfa, 0x40, 0xb8, 0x2d, 0x7e, 0x06,
+	0xed, 0x05, 0xa7, 0x6b, 0xa7, 0x66, 0xc9, 0x5f, 0xd8, 0xb1, 0xcf, 0x8a, 0x84, 0xd8, 0xac, 0x9f,
+	0xc4, 0x20, 0x51, 0x77, 0x1c, 0x1b, 0xfd, 0x48, 0x81, 0x1c, 0x71, 0x98, 0x48, 0x57, 0xb0, 0xa9,
+	0xc9, 0x6f, 0x31, 0xde, 0xb1, 0xdd, 0x39, 0x1b, 0x7b, 0x0f, 0x0f, 0x17, 0x8f, 0x42, 0xf5, 0x53,
+	0x2a, 0xbf, 0x05, 0x12, 0x87, 0x95, 0x85, 0xd0, 0x96, 0x90, 0x41, 0x07, 0x30, 0xd5, 0x3f, 0xbf,
+	0x77, 0xd7, 0xaa, 0x67, 0x9e, 0x7f, 0xea, 0xc4, 0xb9, 0xd3, 0x8d, 0xc8, 0xc4, 0xab, 0x13, 0x7c,
+	0x63, 0xff, 0xc1, 0x37, 0xf7, 0x8d, 0x48, 0x96, 0xb1, 0x2d, 0xbe, 0x2c, 0xf2, 0xba, 0xd7, 0xb8,
+	0xf7, 0x91, 0xd1, 0xaf, 0x50, 0x16, 0xa2, 0xdf, 0xb1, 0xf9, 0x87, 0xf0, 0xd2, 0x80, 0x4e, 0x1f,
+	0xe3, 0x52, 0xf7, 0xca, 0xaf, 0x14, 0x80, 0xf0, 0xcb, 0x17, 0x7a, 0x1e, 0x2e, 0x95, 0x37, 0xd6,
+	0xab, 0xda, 0xe6, 0xd6, 0xf5, 0xad, 0xed, 0x4d, 0x6d, 0x7b, 0x7d, 0xb3, 0xbe, 0x56, 0xa9, 0xdd,
+	0xa8, 0xad, 0x55, 0xb3, 0x63, 0xf3, 0x99, 0xbb, 0xf7, 0x0a, 0xa9, 0x6d, 0x42, 0x3b, 0xd8, 0xb0,
+	0x76, 0x2d, 0x6c, 0xa2, 0x67, 0x60, 0xb6, 0x5f, 0x9a, 0xb7, 0xd6, 0xaa, 0x59, 0x65, 0x3e, 0x7d,
+	0xf7, 0x5e, 0x61, 0xc2, 0x2b, 0x75, 0x61, 0x13, 0x2d, 0xc1, 0xdc, 0x51, 0xb9, 0xda, 0xfa, 0xcb,
+	0xd9, 0xd8, 0xfc, 0xd4, 0xdd, 0x7b, 0x85, 0xc9, 0xa0, 0x26, 0x86, 0x8a, 0x80, 0xa2, 0x92, 0x12,
+	0x2f, 0x3e, 0x0f, 0x77, 0xef, 0x15, 0x92, 0xde, 0xb6, 0xcc, 0x27, 0xee, 0xfc, 0x6c, 0x61, 0xec,
+	0xca, 0x9b, 0x00, 0x35, 0xb2, 0xeb, 0xf2, 0xa0, 0xe1, 0x10, 0x34, 0x0f, 0x17, 0x6b, 0xeb, 0x37,
+	0xd4, 0xeb, 0x95, 0xad, 0xda, 0xc6, 0x7a, 0xff, 0xb2, 0x07, 0xc6, 0xaa, 0x1b, 0xdb, 0xe5, 0x5b,
+	0x6b, 0xda, 0x66, 0xed, 0xe5, 0xf5, 0xac, 0x82, 0x2e, 0xc1, 0x4c, 0xdf, 0xd8, 0xeb, 0xeb, 0x5b,
+	0xb5, 0xd7, 0xd6, 0xb2, 0xb1, 0xf2, 0x8d, 0x8f, 0xee, 0x2f, 0x28, 0x1f, 0xdf, 0x5f, 0x50, 0xfe,
+	0x76, 0x7f, 0x41, 0x79, 0xfb, 0xc1, 0xc2, 0xd8, 0xc7, 0x0f, 0x16, 0xc6, 0xfe, 0xfc, 0x60, 0x61,
+	0xec, 0xbb, 0xcf, 0x3f, 0x72, 0xc3, 0xc3, 0x74, 0x5d, 0x6c, 0x7d, 0x23, 0x29, 0x6e, 0xee, 0xaf,
+	0xfd, 0x77, 0x00, 0x29, 0xb9, 0x4d, 0xe0, 0xc2, 0x21, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1406,700 +1864,788 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11090 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x24, 0xc7,
-		0x75, 0xde, 0xf4, 0x31, 0x7d, 0xbc, 0xbe, 0x6a, 0x72, 0x06, 0xbb, 0xb3, 0xbd, 0xc4, 0xcc, 0xa0,
-		0x40, 0x00, 0x8b, 0x05, 0x30, 0x0b, 0x2c, 0xb0, 0x0b, 0x60, 0x96, 0x24, 0xd8, 0xdd, 0xd3, 0x3b,
-		0xdb, 0xbb, 0x73, 0xb1, 0xba, 0x67, 0x71, 0xc8, 0x62, 0xa9, 0xa6, 0x3a, 0x67, 0xa6, 0xb0, 0xdd,
-		0x55, 0xcd, 0xaa, 0xea, 0xdd, 0x19, 0x84, 0xc2, 0x01, 0x89, 0x96, 0x4c, 0xad, 0x65, 0x99, 0xb2,
-		0x1c, 0x12, 0x75, 0xac, 0x4c, 0xea, 0xa4, 0x2e, 0x5b, 0x97, 0x75, 0x58, 0x61, 0x59, 0x72, 0x58,
-		0xb2, 0xa4, 0x50, 0xd8, 0xb4, 0x7e, 0xd8, 0x0a, 0x85, 0x0c, 0x4b, 0xa4, 0xc2, 0xa2, 0x25, 0xca,
-		0x3a, 0x4c, 0x85, 0xed, 0x60, 0x48, 0xe1, 0xc8, 0xab, 0x8e, 0x3e, 0xa6, 0x7a, 0x96, 0x00, 0x2c,
-		0x87, 0xfe, 0xec, 0x76, 0x65, 0xbe, 0xf7, 0x55, 0xe6, 0xcb, 0x97, 0x2f, 0xdf, 0x7b, 0x99, 0x95,
-		0x03, 0xbf, 0x5f, 0x85, 0xa5, 0x7d, 0xcb, 0xda, 0xef, 0xe0, 0x0b, 0x3d, 0xdb, 0x72, 0xad, 0xdd,
-		0xfe, 0xde, 0x85, 0x36, 0x76, 0x74, 0xdb, 0xe8, 0xb9, 0x96, 0xbd, 0x4c, 0xcb, 0x50, 0x89, 0x51,
-		0x2c, 0x0b, 0x0a, 0x79, 0x03, 0x66, 0xae, 0x1a, 0x1d, 0xbc, 0xea, 0x11, 0x36, 0xb1, 0x8b, 0x5e,
-		0x80, 0xe4, 0x9e, 0xd1, 0xc1, 0xf3, 0xb1, 0xa5, 0xc4, 0xb9, 0xdc, 0xc5, 0xf7, 0x2e, 0x0f, 0x30,
-		0x2d, 0x87, 0x39, 0xb6, 0x49, 0xb1, 0x42, 0x39, 0xe4, 0xbf, 0x4e, 0xc2, 0xec, 0x88, 0x5a, 0x84,
-		0x20, 0x69, 0x6a, 0x5d, 0x82, 0x18, 0x3b, 0x97, 0x55, 0xe8, 0x6f, 0x34, 0x0f, 0xe9, 0x9e, 0xa6,
-		0xdf, 0xd2, 0xf6, 0xf1, 0x7c, 0x9c, 0x16, 0x8b, 0x47, 0xb4, 0x00, 0xd0, 0xc6, 0x3d, 0x6c, 0xb6,
-		0xb1, 0xa9, 0x1f, 0xcd, 0x27, 0x96, 0x12, 0xe7, 0xb2, 0x4a, 0xa0, 0x04, 0x3d, 0x01, 0x33, 0xbd,
-		0xfe, 0x6e, 0xc7, 0xd0, 0xd5, 0x00, 0x19, 0x2c, 0x25, 0xce, 0x4d, 0x2b, 0x12, 0xab, 0x58, 0xf5,
-		0x89, 0x1f, 0x83, 0xd2, 0x1d, 0xac, 0xdd, 0x0a, 0x92, 0xe6, 0x28, 0x69, 0x91, 0x14, 0x07, 0x08,
-		0x6b, 0x90, 0xef, 0x62, 0xc7, 0xd1, 0xf6, 0xb1, 0xea, 0x1e, 0xf5, 0xf0, 0x7c, 0x92, 0xf6, 0x7e,
-		0x69, 0xa8, 0xf7, 0x83, 0x3d, 0xcf, 0x71, 0xae, 0xd6, 0x51, 0x0f, 0xa3, 0x0a, 0x64, 0xb1, 0xd9,
-		0xef, 0x32, 0x84, 0xe9, 0x31, 0xf2, 0xab, 0x9b, 0xfd, 0xee, 0x20, 0x4a, 0x86, 0xb0, 0x71, 0x88,
-		0xb4, 0x83, 0xed, 0xdb, 0x86, 0x8e, 0xe7, 0x53, 0x14, 0xe0, 0xb1, 0x21, 0x80, 0x26, 0xab, 0x1f,
-		0xc4, 0x10, 0x7c, 0xa8, 0x06, 0x59, 0x7c, 0xe8, 0x62, 0xd3, 0x31, 0x2c, 0x73, 0x3e, 0x4d, 0x41,
-		0x1e, 0x19, 0x31, 0x8a, 0xb8, 0xd3, 0x1e, 0x84, 0xf0, 0xf9, 0xd0, 0x65, 0x48, 0x5b, 0x3d, 0xd7,
-		0xb0, 0x4c, 0x67, 0x3e, 0xb3, 0x14, 0x3b, 0x97, 0xbb, 0xf8, 0x9e, 0x91, 0x8a, 0xb0, 0xc5, 0x68,
-		0x14, 0x41, 0x8c, 0x1a, 0x20, 0x39, 0x56, 0xdf, 0xd6, 0xb1, 0xaa, 0x5b, 0x6d, 0xac, 0x1a, 0xe6,
-		0x9e, 0x35, 0x9f, 0xa5, 0x00, 0x8b, 0xc3, 0x1d, 0xa1, 0x84, 0x35, 0xab, 0x8d, 0x1b, 0xe6, 0x9e,
-		0xa5, 0x14, 0x9d, 0xd0, 0x33, 0x3a, 0x05, 0x29, 0xe7, 0xc8, 0x74, 0xb5, 0xc3, 0xf9, 0x3c, 0xd5,
-		0x10, 0xfe, 0x44, 0x54, 0x07, 0xb7, 0x0d, 0xf2, 0xba, 0xf9, 0x02, 0x53, 0x1d, 0xfe, 0x28, 0xff,
-		0x7c, 0x0a, 0x4a, 0x93, 0x28, 0xdf, 0x15, 0x98, 0xde, 0x23, 0xfd, 0x9f, 0x8f, 0x9f, 0x44, 0x3a,
-		0x8c, 0x27, 0x2c, 0xde, 0xd4, 0x7d, 0x8a, 0xb7, 0x02, 0x39, 0x13, 0x3b, 0x2e, 0x6e, 0x33, 0x5d,
-		0x49, 0x4c, 0xa8, 0x6d, 0xc0, 0x98, 0x86, 0x95, 0x2d, 0x79, 0x5f, 0xca, 0xf6, 0x0a, 0x94, 0xbc,
-		0x26, 0xa9, 0xb6, 0x66, 0xee, 0x0b, 0xad, 0xbd, 0x10, 0xd5, 0x92, 0xe5, 0xba, 0xe0, 0x53, 0x08,
-		0x9b, 0x52, 0xc4, 0xa1, 0x67, 0xb4, 0x0a, 0x60, 0x99, 0xd8, 0xda, 0x53, 0xdb, 0x58, 0xef, 0xcc,
-		0x67, 0xc6, 0x48, 0x69, 0x8b, 0x90, 0x0c, 0x49, 0xc9, 0x62, 0xa5, 0x7a, 0x07, 0xbd, 0xe8, 0x2b,
-		0x61, 0x7a, 0x8c, 0x0e, 0x6d, 0xb0, 0xe9, 0x37, 0xa4, 0x87, 0x3b, 0x50, 0xb4, 0x31, 0x99, 0x11,
-		0xb8, 0xcd, 0x7b, 0x96, 0xa5, 0x8d, 0x58, 0x8e, 0xec, 0x99, 0xc2, 0xd9, 0x58, 0xc7, 0x0a, 0x76,
-		0xf0, 0x11, 0x3d, 0x0c, 0x5e, 0x81, 0x4a, 0xd5, 0x0a, 0xa8, 0x7d, 0xca, 0x8b, 0xc2, 0x4d, 0xad,
-		0x8b, 0xcb, 0x6f, 0x40, 0x31, 0x2c, 0x1e, 0x34, 0x07, 0xd3, 0x8e, 0xab, 0xd9, 0x2e, 0xd5, 0xc2,
-		0x69, 0x85, 0x3d, 0x20, 0x09, 0x12, 0xd8, 0x6c, 0x53, 0xfb, 0x37, 0xad, 0x90, 0x9f, 0xe8, 0x83,
-		0x7e, 0x87, 0x13, 0xb4, 0xc3, 0x8f, 0x0e, 0x8f, 0x68, 0x08, 0x79, 0xb0, 0xdf, 0xe5, 0xe7, 0xa1,
-		0x10, 0xea, 0xc0, 0xa4, 0xaf, 0x96, 0xbf, 0x1a, 0x1e, 0x18, 0x09, 0x8d, 0x5e, 0x81, 0xb9, 0xbe,
-		0x69, 0x98, 0x2e, 0xb6, 0x7b, 0x36, 0x26, 0x1a, 0xcb, 0x5e, 0x35, 0xff, 0x87, 0xe9, 0x31, 0x3a,
-		0xb7, 0x13, 0xa4, 0x66, 0x28, 0xca, 0x6c, 0x7f, 0xb8, 0xf0, 0x7c, 0x36, 0xf3, 0xf9, 0xb4, 0xf4,
-		0xe6, 0x9b, 0x6f, 0xbe, 0x19, 0x97, 0x7f, 0x39, 0x05, 0x73, 0xa3, 0xe6, 0xcc, 0xc8, 0xe9, 0x7b,
-		0x0a, 0x52, 0x66, 0xbf, 0xbb, 0x8b, 0x6d, 0x2a, 0xa4, 0x69, 0x85, 0x3f, 0xa1, 0x0a, 0x4c, 0x77,
-		0xb4, 0x5d, 0xdc, 0x99, 0x4f, 0x2e, 0xc5, 0xce, 0x15, 0x2f, 0x3e, 0x31, 0xd1, 0xac, 0x5c, 0x5e,
-		0x27, 0x2c, 0x0a, 0xe3, 0x44, 0x1f, 0x80, 0x24, 0x37, 0xde, 0x04, 0xe1, 0xfc, 0x64, 0x08, 0x64,
-		0x2e, 0x29, 0x94, 0x0f, 0x9d, 0x85, 0x2c, 0xf9, 0x9f, 0xe9, 0x46, 0x8a, 0xb6, 0x39, 0x43, 0x0a,
-		0x88, 0x5e, 0xa0, 0x32, 0x64, 0xe8, 0x34, 0x69, 0x63, 0xb1, 0xe8, 0x79, 0xcf, 0x44, 0xb1, 0xda,
-		0x78, 0x4f, 0xeb, 0x77, 0x5c, 0xf5, 0xb6, 0xd6, 0xe9, 0x63, 0xaa, 0xf0, 0x59, 0x25, 0xcf, 0x0b,
-		0x6f, 0x92, 0x32, 0xb4, 0x08, 0x39, 0x36, 0xab, 0x0c, 0xb3, 0x8d, 0x0f, 0xa9, 0x5d, 0x9d, 0x56,
-		0xd8, 0x44, 0x6b, 0x90, 0x12, 0xf2, 0xfa, 0xd7, 0x1d, 0xcb, 0x14, 0xaa, 0x49, 0x5f, 0x41, 0x0a,
-		0xe8, 0xeb, 0x9f, 0x1f, 0x34, 0xe9, 0x0f, 0x8e, 0xee, 0xde, 0xd0, 0x5c, 0x7a, 0x0c, 0x4a, 0x94,
-		0xe2, 0x59, 0x3e, 0xf4, 0x5a, 0x67, 0x7e, 0x66, 0x29, 0x76, 0x2e, 0xa3, 0x14, 0x59, 0xf1, 0x16,
-		0x2f, 0x95, 0x7f, 0x26, 0x0e, 0x49, 0x6a, 0x58, 0x4a, 0x90, 0x6b, 0xbd, 0xba, 0x5d, 0x57, 0x57,
-		0xb7, 0x76, 0xaa, 0xeb, 0x75, 0x29, 0x86, 0x8a, 0x00, 0xb4, 0xe0, 0xea, 0xfa, 0x56, 0xa5, 0x25,
-		0xc5, 0xbd, 0xe7, 0xc6, 0x66, 0xeb, 0xf2, 0x73, 0x52, 0xc2, 0x63, 0xd8, 0x61, 0x05, 0xc9, 0x20,
-		0xc1, 0xb3, 0x17, 0xa5, 0x69, 0x24, 0x41, 0x9e, 0x01, 0x34, 0x5e, 0xa9, 0xaf, 0x5e, 0x7e, 0x4e,
-		0x4a, 0x85, 0x4b, 0x9e, 0xbd, 0x28, 0xa5, 0x51, 0x01, 0xb2, 0xb4, 0xa4, 0xba, 0xb5, 0xb5, 0x2e,
-		0x65, 0x3c, 0xcc, 0x66, 0x4b, 0x69, 0x6c, 0xae, 0x49, 0x59, 0x0f, 0x73, 0x4d, 0xd9, 0xda, 0xd9,
-		0x96, 0xc0, 0x43, 0xd8, 0xa8, 0x37, 0x9b, 0x95, 0xb5, 0xba, 0x94, 0xf3, 0x28, 0xaa, 0xaf, 0xb6,
-		0xea, 0x4d, 0x29, 0x1f, 0x6a, 0xd6, 0xb3, 0x17, 0xa5, 0x82, 0xf7, 0x8a, 0xfa, 0xe6, 0xce, 0x86,
-		0x54, 0x44, 0x33, 0x50, 0x60, 0xaf, 0x10, 0x8d, 0x28, 0x0d, 0x14, 0x5d, 0x7e, 0x4e, 0x92, 0xfc,
-		0x86, 0x30, 0x94, 0x99, 0x50, 0xc1, 0xe5, 0xe7, 0x24, 0x24, 0xd7, 0x60, 0x9a, 0xaa, 0x21, 0x42,
-		0x50, 0x5c, 0xaf, 0x54, 0xeb, 0xeb, 0xea, 0xd6, 0x76, 0xab, 0xb1, 0xb5, 0x59, 0x59, 0x97, 0x62,
-		0x7e, 0x99, 0x52, 0xff, 0xd0, 0x4e, 0x43, 0xa9, 0xaf, 0x4a, 0xf1, 0x60, 0xd9, 0x76, 0xbd, 0xd2,
-		0xaa, 0xaf, 0x4a, 0x09, 0x59, 0x87, 0xb9, 0x51, 0x06, 0x75, 0xe4, 0x14, 0x0a, 0xe8, 0x42, 0x7c,
-		0x8c, 0x2e, 0x50, 0xac, 0x41, 0x5d, 0x90, 0x3f, 0x17, 0x87, 0xd9, 0x11, 0x8b, 0xca, 0xc8, 0x97,
-		0xbc, 0x04, 0xd3, 0x4c, 0x97, 0xd9, 0x32, 0xfb, 0xf8, 0xc8, 0xd5, 0x89, 0x6a, 0xf6, 0xd0, 0x52,
-		0x4b, 0xf9, 0x82, 0x4e, 0x48, 0x62, 0x8c, 0x13, 0x42, 0x20, 0x86, 0x14, 0xf6, 0x2b, 0x87, 0x8c,
-		0x3f, 0x5b, 0x1f, 0x2f, 0x4f, 0xb2, 0x3e, 0xd2, 0xb2, 0x93, 0x2d, 0x02, 0xd3, 0x23, 0x16, 0x81,
-		0x2b, 0x30, 0x33, 0x04, 0x34, 0xb1, 0x31, 0xfe, 0x68, 0x0c, 0xe6, 0xc7, 0x09, 0x27, 0xc2, 0x24,
-		0xc6, 0x43, 0x26, 0xf1, 0xca, 0xa0, 0x04, 0x1f, 0x1a, 0x3f, 0x08, 0x43, 0x63, 0xfd, 0x83, 0x31,
-		0x38, 0x35, 0xda, 0xd9, 0x1c, 0xd9, 0x86, 0x0f, 0x40, 0xaa, 0x8b, 0xdd, 0x03, 0x4b, 0xb8, 0x55,
-		0x8f, 0x8e, 0x58, 0xac, 0x49, 0xf5, 0xe0, 0x60, 0x73, 0xae, 0xe0, 0x6a, 0x9f, 0x18, 0xe7, 0x31,
-		0xb2, 0xd6, 0x0c, 0xb5, 0xf4, 0x1b, 0xe2, 0xf0, 0xc0, 0x48, 0xf0, 0x91, 0x0d, 0x7d, 0x10, 0xc0,
-		0x30, 0x7b, 0x7d, 0x97, 0xb9, 0x4e, 0xcc, 0x12, 0x67, 0x69, 0x09, 0x35, 0x5e, 0xc4, 0xca, 0xf6,
-		0x5d, 0xaf, 0x3e, 0x41, 0xeb, 0x81, 0x15, 0x51, 0x82, 0x17, 0xfc, 0x86, 0x26, 0x69, 0x43, 0x17,
-		0xc6, 0xf4, 0x74, 0x48, 0x31, 0x9f, 0x06, 0x49, 0xef, 0x18, 0xd8, 0x74, 0x55, 0xc7, 0xb5, 0xb1,
-		0xd6, 0x35, 0xcc, 0x7d, 0xba, 0xd4, 0x64, 0x56, 0xa6, 0xf7, 0xb4, 0x8e, 0x83, 0x95, 0x12, 0xab,
-		0x6e, 0x8a, 0x5a, 0xc2, 0x41, 0x15, 0xc8, 0x0e, 0x70, 0xa4, 0x42, 0x1c, 0xac, 0xda, 0xe3, 0x90,
-		0xbf, 0x39, 0x0b, 0xb9, 0x80, 0x6b, 0x8e, 0x1e, 0x82, 0xfc, 0xeb, 0xda, 0x6d, 0x4d, 0x15, 0xe1,
-		0x16, 0x93, 0x44, 0x8e, 0x94, 0x6d, 0xf3, 0x90, 0xeb, 0x69, 0x98, 0xa3, 0x24, 0x56, 0xdf, 0xc5,
-		0xb6, 0xaa, 0x77, 0x34, 0xc7, 0xa1, 0x42, 0xcb, 0x50, 0x52, 0x44, 0xea, 0xb6, 0x48, 0x55, 0x4d,
-		0xd4, 0xa0, 0x4b, 0x30, 0x4b, 0x39, 0xba, 0xfd, 0x8e, 0x6b, 0xf4, 0x3a, 0x58, 0x25, 0x01, 0xa0,
-		0x43, 0x97, 0x1c, 0xaf, 0x65, 0x33, 0x84, 0x62, 0x83, 0x13, 0x90, 0x16, 0x39, 0x68, 0x15, 0x1e,
-		0xa4, 0x6c, 0xfb, 0xd8, 0xc4, 0xb6, 0xe6, 0x62, 0x15, 0x7f, 0xa4, 0xaf, 0x75, 0x1c, 0x55, 0x33,
-		0xdb, 0xea, 0x81, 0xe6, 0x1c, 0xcc, 0xcf, 0x11, 0x80, 0x6a, 0x7c, 0x3e, 0xa6, 0x9c, 0x21, 0x84,
-		0x6b, 0x9c, 0xae, 0x4e, 0xc9, 0x2a, 0x66, 0xfb, 0x9a, 0xe6, 0x1c, 0xa0, 0x15, 0x38, 0x45, 0x51,
-		0x1c, 0xd7, 0x36, 0xcc, 0x7d, 0x55, 0x3f, 0xc0, 0xfa, 0x2d, 0xb5, 0xef, 0xee, 0xbd, 0x30, 0x7f,
-		0x36, 0xf8, 0x7e, 0xda, 0xc2, 0x26, 0xa5, 0xa9, 0x11, 0x92, 0x1d, 0x77, 0xef, 0x05, 0xd4, 0x84,
-		0x3c, 0x19, 0x8c, 0xae, 0xf1, 0x06, 0x56, 0xf7, 0x2c, 0x9b, 0xae, 0xa1, 0xc5, 0x11, 0xa6, 0x29,
-		0x20, 0xc1, 0xe5, 0x2d, 0xce, 0xb0, 0x61, 0xb5, 0xf1, 0xca, 0x74, 0x73, 0xbb, 0x5e, 0x5f, 0x55,
-		0x72, 0x02, 0xe5, 0xaa, 0x65, 0x13, 0x85, 0xda, 0xb7, 0x3c, 0x01, 0xe7, 0x98, 0x42, 0xed, 0x5b,
-		0x42, 0xbc, 0x97, 0x60, 0x56, 0xd7, 0x59, 0x9f, 0x0d, 0x5d, 0xe5, 0x61, 0x9a, 0x33, 0x2f, 0x85,
-		0x84, 0xa5, 0xeb, 0x6b, 0x8c, 0x80, 0xeb, 0xb8, 0x83, 0x5e, 0x84, 0x07, 0x7c, 0x61, 0x05, 0x19,
-		0x67, 0x86, 0x7a, 0x39, 0xc8, 0x7a, 0x09, 0x66, 0x7b, 0x47, 0xc3, 0x8c, 0x28, 0xf4, 0xc6, 0xde,
-		0xd1, 0x20, 0xdb, 0xf3, 0x30, 0xd7, 0x3b, 0xe8, 0x0d, 0xf3, 0x9d, 0x0f, 0xf2, 0xa1, 0xde, 0x41,
-		0x6f, 0x90, 0xf1, 0x11, 0x1a, 0xb3, 0xdb, 0x58, 0xd7, 0x5c, 0xdc, 0x9e, 0x3f, 0x1d, 0x24, 0x0f,
-		0x54, 0xa0, 0x65, 0x90, 0x74, 0x5d, 0xc5, 0xa6, 0xb6, 0xdb, 0xc1, 0xaa, 0x66, 0x63, 0x53, 0x73,
-		0xe6, 0x17, 0x29, 0x71, 0xd2, 0xb5, 0xfb, 0x58, 0x29, 0xea, 0x7a, 0x9d, 0x56, 0x56, 0x68, 0x1d,
-		0x3a, 0x0f, 0x33, 0xd6, 0xee, 0xeb, 0x3a, 0xd3, 0x48, 0xb5, 0x67, 0xe3, 0x3d, 0xe3, 0x70, 0xfe,
-		0xbd, 0x54, 0xbc, 0x25, 0x52, 0x41, 0xf5, 0x71, 0x9b, 0x16, 0xa3, 0xc7, 0x41, 0xd2, 0x9d, 0x03,
-		0xcd, 0xee, 0x51, 0x93, 0xec, 0xf4, 0x34, 0x1d, 0xcf, 0x3f, 0xc2, 0x48, 0x59, 0xf9, 0xa6, 0x28,
-		0x26, 0x33, 0xc2, 0xb9, 0x63, 0xec, 0xb9, 0x02, 0xf1, 0x31, 0x36, 0x23, 0x68, 0x19, 0x47, 0x3b,
-		0x07, 0x12, 0x91, 0x44, 0xe8, 0xc5, 0xe7, 0x28, 0x59, 0xb1, 0x77, 0xd0, 0x0b, 0xbe, 0xf7, 0x61,
-		0x28, 0x10, 0x4a, 0xff, 0xa5, 0x8f, 0x33, 0xc7, 0xad, 0x77, 0x10, 0x78, 0xe3, 0x73, 0x70, 0x8a,
-		0x10, 0x75, 0xb1, 0xab, 0xb5, 0x35, 0x57, 0x0b, 0x50, 0x3f, 0x49, 0xa9, 0x89, 0xd8, 0x37, 0x78,
-		0x65, 0xa8, 0x9d, 0x76, 0x7f, 0xf7, 0xc8, 0x53, 0xac, 0xa7, 0x58, 0x3b, 0x49, 0x99, 0x50, 0xad,
-		0x77, 0xcc, 0x39, 0x97, 0x57, 0x20, 0x1f, 0xd4, 0x7b, 0x94, 0x05, 0xa6, 0xf9, 0x52, 0x8c, 0x38,
-		0x41, 0xb5, 0xad, 0x55, 0xe2, 0xbe, 0xbc, 0x56, 0x97, 0xe2, 0xc4, 0x8d, 0x5a, 0x6f, 0xb4, 0xea,
-		0xaa, 0xb2, 0xb3, 0xd9, 0x6a, 0x6c, 0xd4, 0xa5, 0x44, 0xc0, 0xb1, 0xbf, 0x9e, 0xcc, 0x3c, 0x2a,
-		0x3d, 0x26, 0xff, 0x42, 0x02, 0x8a, 0xe1, 0x48, 0x0d, 0xbd, 0x0f, 0x4e, 0x8b, 0x84, 0x8b, 0x83,
-		0x5d, 0xf5, 0x8e, 0x61, 0xd3, 0x09, 0xd9, 0xd5, 0xd8, 0xe2, 0xe8, 0xe9, 0xcf, 0x1c, 0xa7, 0x6a,
-		0x62, 0xf7, 0x65, 0xc3, 0x26, 0xd3, 0xad, 0xab, 0xb9, 0x68, 0x1d, 0x16, 0x4d, 0x4b, 0x75, 0x5c,
-		0xcd, 0x6c, 0x6b, 0x76, 0x5b, 0xf5, 0x53, 0x5d, 0xaa, 0xa6, 0xeb, 0xd8, 0x71, 0x2c, 0xb6, 0x10,
-		0x7a, 0x28, 0xef, 0x31, 0xad, 0x26, 0x27, 0xf6, 0x57, 0x88, 0x0a, 0x27, 0x1d, 0x50, 0xdf, 0xc4,
-		0x38, 0xf5, 0x3d, 0x0b, 0xd9, 0xae, 0xd6, 0x53, 0xb1, 0xe9, 0xda, 0x47, 0xd4, 0x3f, 0xcf, 0x28,
-		0x99, 0xae, 0xd6, 0xab, 0x93, 0x67, 0x74, 0x13, 0x1e, 0xf5, 0x49, 0xd5, 0x0e, 0xde, 0xd7, 0xf4,
-		0x23, 0x95, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xd7, 0x31, 0x74, 0xd7, 0xa1, 0xf6,
-		0x81, 0xd9, 0x38, 0xd9, 0xe7, 0x58, 0xa7, 0x0c, 0xd7, 0x1d, 0xcb, 0xa4, 0x3e, 0x78, 0x4d, 0x50,
-		0xbf, 0x2b, 0xe1, 0xd7, 0xf5, 0x64, 0x26, 0x29, 0x4d, 0x5f, 0x4f, 0x66, 0xa6, 0xa5, 0xd4, 0xf5,
-		0x64, 0x26, 0x25, 0xa5, 0xaf, 0x27, 0x33, 0x19, 0x29, 0x7b, 0x3d, 0x99, 0xc9, 0x4a, 0x20, 0xff,
-		0x6c, 0x06, 0xf2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x63, 0xd4, 0x7a, 0x3e, 0x7c,
-		0x6c, 0x1c, 0xb1, 0x5c, 0x23, 0x8b, 0xe6, 0x4a, 0x8a, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e, 0x0b,
-		0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x8c, 0xc2, 0x9f, 0xd0, 0x1a, 0xa4, 0x5e, 0x77, 0x28, 0x76, 0x8a,
-		0x62, 0xbf, 0xf7, 0x78, 0xec, 0xeb, 0x4d, 0x0a, 0x9e, 0xbd, 0xde, 0x54, 0x37, 0xb7, 0x94, 0x8d,
-		0xca, 0xba, 0xc2, 0xd9, 0xd1, 0x19, 0x48, 0x76, 0xb4, 0x37, 0x8e, 0xc2, 0xcb, 0x2b, 0x2d, 0x42,
-		0xcb, 0x50, 0xea, 0x9b, 0xb7, 0xb1, 0x6d, 0xec, 0x19, 0x64, 0xa8, 0x08, 0x55, 0x29, 0x48, 0x55,
-		0xf4, 0x6b, 0xd7, 0x09, 0xfd, 0x84, 0xea, 0x71, 0x06, 0x92, 0x77, 0xb0, 0x76, 0x2b, 0xbc, 0x08,
-		0xd2, 0x22, 0x74, 0x0e, 0xf2, 0x6d, 0xbc, 0xdb, 0xdf, 0x57, 0x6d, 0xdc, 0xd6, 0x74, 0x37, 0x6c,
-		0xfa, 0x73, 0xb4, 0x4a, 0xa1, 0x35, 0xe8, 0x06, 0x64, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0x67, 0xa8,
-		0x08, 0x9e, 0x3a, 0x5e, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0xab, 0x90, 0x72, 0x35,
-		0x7b, 0x1f, 0xbb, 0xd4, 0xf2, 0x17, 0x47, 0x24, 0x3f, 0x46, 0x20, 0xb5, 0x28, 0x07, 0x8d, 0x69,
-		0x39, 0xf7, 0x3b, 0x68, 0x65, 0x2e, 0xc0, 0x34, 0x55, 0x0f, 0x04, 0xc0, 0x15, 0x44, 0x9a, 0x42,
-		0x19, 0x48, 0xd6, 0xb6, 0x14, 0x62, 0x69, 0x24, 0xc8, 0xb3, 0x52, 0x75, 0xbb, 0x51, 0xaf, 0xd5,
-		0xa5, 0xb8, 0x7c, 0x09, 0x52, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x46, 0x5d, 0x9a, 0xe2, 0x8f, 0x1c,
-		0x23, 0x26, 0x6a, 0x77, 0x36, 0xaa, 0x75, 0x45, 0x8a, 0xcb, 0x3b, 0x50, 0x1a, 0x90, 0x13, 0x7a,
-		0x00, 0x66, 0x94, 0x7a, 0xab, 0xbe, 0x49, 0xe2, 0x2c, 0x75, 0x67, 0xf3, 0xc6, 0xe6, 0xd6, 0xcb,
-		0x9b, 0xd2, 0x54, 0xb8, 0x58, 0x98, 0xb4, 0x18, 0x9a, 0x03, 0xc9, 0x2f, 0x6e, 0x6e, 0xed, 0x28,
-		0xb4, 0x35, 0xdf, 0x18, 0x07, 0x69, 0x50, 0x6a, 0xe8, 0x34, 0xcc, 0xb6, 0x2a, 0xca, 0x5a, 0xbd,
-		0xa5, 0xb2, 0xd8, 0xd1, 0x83, 0x9e, 0x03, 0x29, 0x58, 0x71, 0xb5, 0x41, 0x43, 0xe3, 0x45, 0x38,
-		0x1b, 0x2c, 0xad, 0xbf, 0xd2, 0xaa, 0x6f, 0x36, 0xe9, 0xcb, 0x2b, 0x9b, 0x6b, 0xc4, 0xbe, 0x0e,
-		0xe0, 0x89, 0x68, 0x35, 0x41, 0x9a, 0x1a, 0xc6, 0xab, 0xaf, 0xaf, 0x4a, 0xc9, 0xc1, 0xe2, 0xad,
-		0xcd, 0xfa, 0xd6, 0x55, 0x69, 0x7a, 0xf0, 0xed, 0x34, 0x82, 0x4d, 0xa1, 0x32, 0x9c, 0x1a, 0x2c,
-		0x55, 0xeb, 0x9b, 0x2d, 0xe5, 0x55, 0x29, 0x3d, 0xf8, 0xe2, 0x66, 0x5d, 0xb9, 0xd9, 0xa8, 0xd5,
-		0xa5, 0x0c, 0x3a, 0x05, 0x28, 0xdc, 0xa2, 0xd6, 0xb5, 0xad, 0x55, 0x29, 0x3b, 0x64, 0x51, 0x64,
-		0x07, 0xf2, 0xc1, 0x30, 0xf2, 0xdd, 0xc9, 0x25, 0x7d, 0x22, 0x0e, 0xb9, 0x40, 0x58, 0x48, 0xfc,
-		0x79, 0xad, 0xd3, 0xb1, 0xee, 0xa8, 0x5a, 0xc7, 0xd0, 0x1c, 0x6e, 0x6f, 0x80, 0x16, 0x55, 0x48,
-		0xc9, 0xa4, 0xf3, 0x7b, 0x72, 0x0b, 0x9f, 0xfa, 0x9b, 0x68, 0xe1, 0xa7, 0xa5, 0x94, 0xfc, 0xdd,
-		0x31, 0x90, 0x06, 0xe3, 0xbd, 0x81, 0xee, 0xc7, 0xc6, 0x75, 0xff, 0x5d, 0x19, 0xbb, 0xef, 0x8a,
-		0x41, 0x31, 0x1c, 0xe4, 0x0d, 0x34, 0xef, 0xa1, 0xff, 0xa7, 0xcd, 0xfb, 0xbd, 0x38, 0x14, 0x42,
-		0xa1, 0xdd, 0xa4, 0xad, 0xfb, 0x08, 0xcc, 0x18, 0x6d, 0xdc, 0xed, 0x59, 0x2e, 0x36, 0xf5, 0x23,
-		0xb5, 0x83, 0x6f, 0xe3, 0xce, 0xbc, 0x4c, 0x8d, 0xf2, 0x85, 0xe3, 0x83, 0xc7, 0xe5, 0x86, 0xcf,
-		0xb7, 0x4e, 0xd8, 0x56, 0x66, 0x1b, 0xab, 0xf5, 0x8d, 0xed, 0xad, 0x56, 0x7d, 0xb3, 0xf6, 0xaa,
-		0xb0, 0x2e, 0x8a, 0x64, 0x0c, 0x90, 0xbd, 0x83, 0x46, 0x7b, 0x1b, 0xa4, 0xc1, 0x46, 0x11, 0x5b,
-		0x31, 0xa2, 0x59, 0xd2, 0x14, 0x9a, 0x85, 0xd2, 0xe6, 0x96, 0xda, 0x6c, 0xac, 0xd6, 0xd5, 0xfa,
-		0xd5, 0xab, 0xf5, 0x5a, 0xab, 0xc9, 0xd2, 0x81, 0x1e, 0x75, 0x4b, 0x8a, 0x07, 0x45, 0xfc, 0x1d,
-		0x09, 0x98, 0x1d, 0xd1, 0x12, 0x54, 0xe1, 0x81, 0x3c, 0xcb, 0x2d, 0x3c, 0x35, 0x49, 0xeb, 0x97,
-		0x89, 0x2b, 0xbd, 0xad, 0xd9, 0x2e, 0x8f, 0xfb, 0x1f, 0x07, 0x22, 0x25, 0xd3, 0x25, 0x2b, 0xbb,
-		0xcd, 0xd3, 0xac, 0x2c, 0xba, 0x2f, 0xf9, 0xe5, 0x2c, 0xd3, 0xfa, 0x24, 0xa0, 0x9e, 0xe5, 0x18,
-		0xae, 0x71, 0x1b, 0xab, 0x86, 0x29, 0x72, 0xb2, 0x24, 0xda, 0x4f, 0x2a, 0x92, 0xa8, 0x69, 0x98,
-		0xae, 0x47, 0x6d, 0xe2, 0x7d, 0x6d, 0x80, 0x9a, 0x78, 0x1e, 0x09, 0x45, 0x12, 0x35, 0x1e, 0xf5,
-		0x43, 0x90, 0x6f, 0x5b, 0x7d, 0x12, 0x02, 0x31, 0x3a, 0x62, 0x2d, 0x62, 0x4a, 0x8e, 0x95, 0x79,
-		0x24, 0x3c, 0xb8, 0xf5, 0x93, 0xc1, 0x79, 0x25, 0xc7, 0xca, 0x18, 0xc9, 0x63, 0x50, 0xd2, 0xf6,
-		0xf7, 0x6d, 0x02, 0x2e, 0x80, 0x58, 0xb8, 0x5e, 0xf4, 0x8a, 0x29, 0x61, 0xf9, 0x3a, 0x64, 0x84,
-		0x1c, 0x88, 0x07, 0x4b, 0x24, 0xa1, 0xf6, 0x58, 0x0e, 0x2a, 0x7e, 0x2e, 0xab, 0x64, 0x4c, 0x51,
-		0xf9, 0x10, 0xe4, 0x0d, 0x47, 0xf5, 0xf7, 0xb6, 0xe2, 0x4b, 0xf1, 0x73, 0x19, 0x25, 0x67, 0x38,
-		0xde, 0xbe, 0x80, 0xfc, 0x83, 0x71, 0x28, 0x86, 0x77, 0xed, 0xd0, 0x2a, 0x64, 0x3a, 0x96, 0xae,
-		0x51, 0xd5, 0x62, 0x5b, 0xc6, 0xe7, 0x22, 0x36, 0xfa, 0x96, 0xd7, 0x39, 0xbd, 0xe2, 0x71, 0x96,
-		0xff, 0x7d, 0x0c, 0x32, 0xa2, 0x18, 0x9d, 0x82, 0x64, 0x4f, 0x73, 0x0f, 0x28, 0xdc, 0x74, 0x35,
-		0x2e, 0xc5, 0x14, 0xfa, 0x4c, 0xca, 0x9d, 0x9e, 0x66, 0x52, 0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c,
-		0x6b, 0x07, 0x6b, 0x6d, 0x9a, 0x0b, 0xb0, 0xba, 0x5d, 0x6c, 0xba, 0x8e, 0x18, 0x57, 0x5e, 0x5e,
-		0xe3, 0xc5, 0xe8, 0x09, 0x98, 0x71, 0x6d, 0xcd, 0xe8, 0x84, 0x68, 0x93, 0x94, 0x56, 0x12, 0x15,
-		0x1e, 0xf1, 0x0a, 0x9c, 0x11, 0xb8, 0x6d, 0xec, 0x6a, 0xfa, 0x01, 0x6e, 0xfb, 0x4c, 0x29, 0x9a,
-		0xf3, 0x3b, 0xcd, 0x09, 0x56, 0x79, 0xbd, 0xe0, 0x95, 0x3f, 0x13, 0x87, 0x19, 0x91, 0xbd, 0x68,
-		0x7b, 0xc2, 0xda, 0x00, 0xd0, 0x4c, 0xd3, 0x72, 0x83, 0xe2, 0x1a, 0x56, 0xe5, 0x21, 0xbe, 0xe5,
-		0x8a, 0xc7, 0xa4, 0x04, 0x00, 0xca, 0x7f, 0x1c, 0x03, 0xf0, 0xab, 0xc6, 0xca, 0x6d, 0x11, 0x72,
-		0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2, 0xab, 0x46, 0x87, 0xa6, 0x25, 0x77,
-		0xf1, 0xbe, 0x61, 0xf2, 0xfd, 0x14, 0xf6, 0x20, 0xd2, 0x92, 0x49, 0x7f, 0x7b, 0x4a, 0x81, 0x8c,
-		0x83, 0xbb, 0x9a, 0xe9, 0x1a, 0x3a, 0xdf, 0x21, 0xb9, 0x7c, 0xa2, 0xc6, 0x2f, 0x37, 0x39, 0xb7,
-		0xe2, 0xe1, 0xc8, 0xe7, 0x20, 0x23, 0x4a, 0x89, 0xe3, 0xb7, 0xb9, 0xb5, 0x59, 0x97, 0xa6, 0x50,
-		0x1a, 0x12, 0xcd, 0x7a, 0x4b, 0x8a, 0x91, 0xb0, 0xb3, 0xb2, 0xde, 0xa8, 0x34, 0xa5, 0x78, 0xf5,
-		0xef, 0xc2, 0xac, 0x6e, 0x75, 0x07, 0x5f, 0x58, 0x95, 0x06, 0x52, 0x7e, 0xce, 0xb5, 0xd8, 0x6b,
-		0x4f, 0x71, 0xa2, 0x7d, 0xab, 0xa3, 0x99, 0xfb, 0xcb, 0x96, 0xbd, 0xef, 0x1f, 0x8b, 0x20, 0xd1,
-		0x81, 0x13, 0x38, 0x1c, 0xd1, 0xdb, 0xfd, 0xdf, 0xb1, 0xd8, 0xf7, 0xc4, 0x13, 0x6b, 0xdb, 0xd5,
-		0x1f, 0x8e, 0x97, 0xd7, 0x18, 0xe3, 0xb6, 0xe8, 0x8e, 0x82, 0xf7, 0x3a, 0x58, 0x27, 0x8d, 0x87,
-		0x3f, 0x79, 0x02, 0xe6, 0xf6, 0xad, 0x7d, 0x8b, 0x22, 0x5d, 0x20, 0xbf, 0xf8, 0xb9, 0x8a, 0xac,
-		0x57, 0x5a, 0x8e, 0x3c, 0x84, 0xb1, 0xb2, 0x09, 0xb3, 0x9c, 0x58, 0xa5, 0xdb, 0xb7, 0x2c, 0xb9,
-		0x80, 0x8e, 0xcd, 0x6c, 0xcf, 0xff, 0xc4, 0x1f, 0x50, 0xaf, 0x44, 0x99, 0xe1, 0xac, 0xa4, 0x8e,
-		0xe5, 0x1f, 0x56, 0x14, 0x78, 0x20, 0x84, 0xc7, 0x6c, 0x04, 0xb6, 0x23, 0x10, 0x7f, 0x85, 0x23,
-		0xce, 0x06, 0x10, 0x9b, 0x9c, 0x75, 0xa5, 0x06, 0x85, 0x93, 0x60, 0xfd, 0x2a, 0xc7, 0xca, 0xe3,
-		0x20, 0xc8, 0x1a, 0x94, 0x28, 0x88, 0xde, 0x77, 0x5c, 0xab, 0x4b, 0x0d, 0xf0, 0xf1, 0x30, 0xff,
-		0xee, 0x0f, 0xd8, 0xa4, 0x2d, 0x12, 0xb6, 0x9a, 0xc7, 0xb5, 0xb2, 0x02, 0x74, 0xc7, 0xba, 0x8d,
-		0xf5, 0x4e, 0x04, 0xc2, 0xaf, 0xf1, 0x86, 0x78, 0xf4, 0x2b, 0x37, 0x61, 0x8e, 0xfc, 0xa6, 0xf6,
-		0x31, 0xd8, 0x92, 0xe8, 0x34, 0xf8, 0xfc, 0x7f, 0xfc, 0x28, 0xb3, 0x0b, 0xb3, 0x1e, 0x40, 0xa0,
-		0x4d, 0x81, 0x51, 0xdc, 0xc7, 0xae, 0x8b, 0x6d, 0x47, 0xd5, 0x3a, 0xa3, 0x9a, 0x17, 0xc8, 0x23,
-		0xce, 0x7f, 0xfb, 0x17, 0xc2, 0xa3, 0xb8, 0xc6, 0x38, 0x2b, 0x9d, 0xce, 0xca, 0x0e, 0x9c, 0x1e,
-		0xa1, 0x15, 0x13, 0x60, 0x7e, 0x07, 0xc7, 0x9c, 0x1b, 0xd2, 0x0c, 0x02, 0xbb, 0x0d, 0xa2, 0xdc,
-		0x1b, 0xcb, 0x09, 0x30, 0xbf, 0x93, 0x63, 0x22, 0xce, 0x2b, 0x86, 0x94, 0x20, 0x5e, 0x87, 0x99,
-		0xdb, 0xd8, 0xde, 0xb5, 0x1c, 0x9e, 0xbb, 0x9d, 0x00, 0xee, 0xbb, 0x38, 0x5c, 0x89, 0x33, 0xd2,
-		0x64, 0x2e, 0xc1, 0x7a, 0x11, 0x32, 0x7b, 0x9a, 0x8e, 0x27, 0x80, 0xb8, 0xc7, 0x21, 0xd2, 0x84,
-		0x9e, 0xb0, 0x56, 0x20, 0xbf, 0x6f, 0xf1, 0x25, 0x32, 0x9a, 0xfd, 0xbb, 0x39, 0x7b, 0x4e, 0xf0,
-		0x70, 0x88, 0x9e, 0xd5, 0xeb, 0x77, 0xc8, 0xfa, 0x19, 0x0d, 0xf1, 0x4f, 0x05, 0x84, 0xe0, 0xe1,
-		0x10, 0x27, 0x10, 0xeb, 0x27, 0x05, 0x84, 0x13, 0x90, 0xe7, 0x4b, 0x90, 0xb3, 0xcc, 0xce, 0x91,
-		0x65, 0x4e, 0xd2, 0x88, 0x4f, 0x71, 0x04, 0xe0, 0x2c, 0x04, 0xe0, 0x0a, 0x64, 0x27, 0x1d, 0x88,
-		0xef, 0xff, 0x82, 0x98, 0x1e, 0x62, 0x04, 0xd6, 0xa0, 0x24, 0x0c, 0x94, 0x61, 0x99, 0x13, 0x40,
-		0xfc, 0x00, 0x87, 0x28, 0x06, 0xd8, 0x78, 0x37, 0x5c, 0xec, 0xb8, 0xfb, 0x78, 0x12, 0x90, 0x1f,
-		0x14, 0xdd, 0xe0, 0x2c, 0x5c, 0x94, 0xbb, 0xd8, 0xd4, 0x0f, 0x26, 0x43, 0xf8, 0xb4, 0x10, 0xa5,
-		0xe0, 0x21, 0x10, 0x35, 0x28, 0x74, 0x35, 0xdb, 0x39, 0xd0, 0x3a, 0x13, 0x0d, 0xc7, 0x0f, 0x71,
-		0x8c, 0xbc, 0xc7, 0xc4, 0x25, 0xd2, 0x37, 0x4f, 0x02, 0xf3, 0xc3, 0x42, 0x22, 0x01, 0x36, 0x3e,
-		0xf5, 0x1c, 0x97, 0x26, 0xba, 0x4f, 0x82, 0xf6, 0x23, 0x62, 0xea, 0x31, 0xde, 0x8d, 0x20, 0xe2,
-		0x15, 0xc8, 0x3a, 0xc6, 0x1b, 0x13, 0xc1, 0xfc, 0xa8, 0x18, 0x69, 0xca, 0x40, 0x98, 0x5f, 0x85,
-		0x33, 0x23, 0x97, 0x89, 0x09, 0xc0, 0x7e, 0x8c, 0x83, 0x9d, 0x1a, 0xb1, 0x54, 0x70, 0x93, 0x70,
-		0x52, 0xc8, 0x7f, 0x26, 0x4c, 0x02, 0x1e, 0xc0, 0xda, 0x26, 0x41, 0x8b, 0xa3, 0xed, 0x9d, 0x4c,
-		0x6a, 0xff, 0x5c, 0x48, 0x8d, 0xf1, 0x86, 0xa4, 0xd6, 0x82, 0x53, 0x1c, 0xf1, 0x64, 0xe3, 0xfa,
-		0xe3, 0xc2, 0xb0, 0x32, 0xee, 0x9d, 0xf0, 0xe8, 0x7e, 0x05, 0x94, 0x3d, 0x71, 0x0a, 0xef, 0xd8,
-		0x51, 0xbb, 0x5a, 0x6f, 0x02, 0xe4, 0x9f, 0xe0, 0xc8, 0xc2, 0xe2, 0x7b, 0xee, 0xb5, 0xb3, 0xa1,
-		0xf5, 0x08, 0xf8, 0x2b, 0x30, 0x2f, 0xc0, 0xfb, 0xa6, 0x8d, 0x75, 0x6b, 0xdf, 0x34, 0xde, 0xc0,
-		0xed, 0x09, 0xa0, 0x7f, 0x72, 0x60, 0xa8, 0x76, 0x02, 0xec, 0x04, 0xb9, 0x01, 0x92, 0xe7, 0xab,
-		0xa8, 0x46, 0xb7, 0x67, 0xd9, 0x6e, 0x04, 0xe2, 0x4f, 0x89, 0x91, 0xf2, 0xf8, 0x1a, 0x94, 0x6d,
-		0xa5, 0x0e, 0xec, 0xf4, 0xc7, 0xa4, 0x2a, 0xf9, 0xd3, 0x1c, 0xa8, 0xe0, 0x73, 0x71, 0xc3, 0xa1,
-		0x5b, 0xdd, 0x9e, 0x66, 0x4f, 0x62, 0xff, 0xfe, 0x85, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x02, 0x84, 0x9f, 0x11, 0x86, 0x43, 0xf0, 0x70, 0x08, 0xe1, 0x30, 0x4c,
-		0x00, 0xf1, 0xb3, 0x02, 0x42, 0xf0, 0x10, 0x88, 0x0f, 0xf9, 0x0b, 0xad, 0x8d, 0xf7, 0x0d, 0xc7,
-		0xb5, 0x99, 0x4b, 0x7e, 0x3c, 0xd4, 0xcf, 0x7d, 0x21, 0xec, 0x84, 0x29, 0x01, 0x56, 0x62, 0x89,
-		0xf8, 0xd6, 0x07, 0x0d, 0xd9, 0xa2, 0x1b, 0xf6, 0xf3, 0xc2, 0x12, 0x05, 0xd8, 0x48, 0xdb, 0x02,
-		0x1e, 0x22, 0x11, 0xbb, 0x4e, 0x02, 0x95, 0x09, 0xe0, 0xfe, 0xe5, 0x40, 0xe3, 0x9a, 0x82, 0x97,
-		0x60, 0x06, 0xfc, 0x9f, 0xbe, 0x79, 0x0b, 0x1f, 0x4d, 0xa4, 0x9d, 0xbf, 0x30, 0xe0, 0xff, 0xec,
-		0x30, 0x4e, 0x66, 0x43, 0x4a, 0x03, 0xfe, 0x14, 0x8a, 0x3a, 0xeb, 0x37, 0xff, 0x35, 0x5f, 0xe4,
-		0xfd, 0x0d, 0xbb, 0x53, 0x2b, 0xeb, 0x44, 0xc9, 0xc3, 0x4e, 0x4f, 0x34, 0xd8, 0x47, 0xbf, 0xe8,
-		0xe9, 0x79, 0xc8, 0xe7, 0x59, 0xb9, 0x0a, 0x85, 0x90, 0xc3, 0x13, 0x0d, 0xf5, 0xf7, 0x38, 0x54,
-		0x3e, 0xe8, 0xef, 0xac, 0x5c, 0x82, 0x24, 0x71, 0x5e, 0xa2, 0xd9, 0xbf, 0x8e, 0xb3, 0x53, 0xf2,
-		0x95, 0xf7, 0x43, 0x46, 0x38, 0x2d, 0xd1, 0xac, 0x5f, 0xcf, 0x59, 0x3d, 0x16, 0xc2, 0x2e, 0x1c,
-		0x96, 0x68, 0xf6, 0xbf, 0x2f, 0xd8, 0x05, 0x0b, 0x61, 0x9f, 0x5c, 0x84, 0xbf, 0xf4, 0x0f, 0x92,
-		0x7c, 0xd1, 0x11, 0xb2, 0xbb, 0x02, 0x69, 0xee, 0xa9, 0x44, 0x73, 0x7f, 0x03, 0x7f, 0xb9, 0xe0,
-		0x58, 0x79, 0x1e, 0xa6, 0x27, 0x14, 0xf8, 0x3f, 0xe4, 0xac, 0x8c, 0x7e, 0xa5, 0x06, 0xb9, 0x80,
-		0x77, 0x12, 0xcd, 0xfe, 0x4d, 0x9c, 0x3d, 0xc8, 0x45, 0x9a, 0xce, 0xbd, 0x93, 0x68, 0x80, 0x7f,
-		0x24, 0x9a, 0xce, 0x39, 0x88, 0xd8, 0x84, 0x63, 0x12, 0xcd, 0xfd, 0x71, 0x21, 0x75, 0xc1, 0xb2,
-		0xf2, 0x12, 0x64, 0xbd, 0xc5, 0x26, 0x9a, 0xff, 0x9b, 0x39, 0xbf, 0xcf, 0x43, 0x24, 0x10, 0x58,
-		0xec, 0xa2, 0x21, 0xfe, 0xb1, 0x90, 0x40, 0x80, 0x8b, 0x4c, 0xa3, 0x41, 0x07, 0x26, 0x1a, 0xe9,
-		0x5b, 0xc4, 0x34, 0x1a, 0xf0, 0x5f, 0xc8, 0x68, 0x52, 0x9b, 0x1f, 0x0d, 0xf1, 0x4f, 0xc4, 0x68,
-		0x52, 0x7a, 0xd2, 0x8c, 0x41, 0x8f, 0x20, 0x1a, 0xe3, 0xdb, 0x44, 0x33, 0x06, 0x1c, 0x82, 0x95,
-		0x6d, 0x40, 0xc3, 0xde, 0x40, 0x34, 0xde, 0x27, 0x38, 0xde, 0xcc, 0x90, 0x33, 0xb0, 0xf2, 0x32,
-		0x9c, 0x1a, 0xed, 0x09, 0x44, 0xa3, 0x7e, 0xfb, 0x17, 0x07, 0x62, 0xb7, 0xa0, 0x23, 0xb0, 0xd2,
-		0xf2, 0x97, 0x94, 0xa0, 0x17, 0x10, 0x0d, 0xfb, 0x1d, 0x5f, 0x0c, 0x1b, 0xee, 0xa0, 0x13, 0xb0,
-		0x52, 0x01, 0xf0, 0x17, 0xe0, 0x68, 0xac, 0xef, 0xe2, 0x58, 0x01, 0x26, 0x32, 0x35, 0xf8, 0xfa,
-		0x1b, 0xcd, 0x7f, 0x4f, 0x4c, 0x0d, 0xce, 0x41, 0xa6, 0x86, 0x58, 0x7a, 0xa3, 0xb9, 0xbf, 0x5b,
-		0x4c, 0x0d, 0xc1, 0x42, 0x34, 0x3b, 0xb0, 0xba, 0x45, 0x23, 0x7c, 0x4a, 0x68, 0x76, 0x80, 0x6b,
-		0x65, 0x13, 0x66, 0x86, 0x16, 0xc4, 0x68, 0xa8, 0xef, 0xe1, 0x50, 0xd2, 0xe0, 0x7a, 0x18, 0x5c,
-		0xbc, 0xf8, 0x62, 0x18, 0x8d, 0xf6, 0xbd, 0x03, 0x8b, 0x17, 0x5f, 0x0b, 0x57, 0xae, 0x40, 0xc6,
-		0xec, 0x77, 0x3a, 0x64, 0xf2, 0xa0, 0xe3, 0xcf, 0xe7, 0xce, 0xff, 0xf7, 0x2f, 0x71, 0xe9, 0x08,
-		0x86, 0x95, 0x4b, 0x30, 0x8d, 0xbb, 0xbb, 0xb8, 0x1d, 0xc5, 0xf9, 0x47, 0x5f, 0x12, 0x06, 0x93,
-		0x50, 0xaf, 0xbc, 0x04, 0xc0, 0x52, 0x23, 0x74, 0xe3, 0x3c, 0x82, 0xf7, 0x8f, 0xbf, 0xc4, 0x0f,
-		0xc4, 0xf9, 0x2c, 0x3e, 0x00, 0x3b, 0x5e, 0x77, 0x3c, 0xc0, 0x17, 0xc2, 0x00, 0x74, 0x44, 0x5e,
-		0x84, 0xf4, 0xeb, 0x8e, 0x65, 0xba, 0xda, 0x7e, 0x14, 0xf7, 0x9f, 0x70, 0x6e, 0x41, 0x4f, 0x04,
-		0xd6, 0xb5, 0x6c, 0xec, 0x6a, 0xfb, 0x4e, 0x14, 0xef, 0xff, 0xe0, 0xbc, 0x1e, 0x03, 0x61, 0xd6,
-		0x35, 0xc7, 0x9d, 0xa4, 0xdf, 0x7f, 0x2a, 0x98, 0x05, 0x03, 0x69, 0x34, 0xf9, 0x7d, 0x0b, 0x1f,
-		0x45, 0xf1, 0xfe, 0x99, 0x68, 0x34, 0xa7, 0x5f, 0x79, 0x3f, 0x64, 0xc9, 0x4f, 0x76, 0xca, 0x35,
-		0x82, 0xf9, 0xcf, 0x39, 0xb3, 0xcf, 0x41, 0xde, 0xec, 0xb8, 0x6d, 0xd7, 0x88, 0x16, 0xf6, 0x5f,
-		0xf0, 0x91, 0x16, 0xf4, 0x2b, 0x15, 0xc8, 0x39, 0x6e, 0xbb, 0xdd, 0xe7, 0xfe, 0x69, 0x04, 0xfb,
-		0xff, 0xfc, 0x92, 0x97, 0xb2, 0xf0, 0x78, 0xc8, 0x68, 0xdf, 0xb9, 0xe5, 0xf6, 0x2c, 0xba, 0xdf,
-		0x12, 0x85, 0xf0, 0x45, 0x8e, 0x10, 0x60, 0x59, 0xa9, 0x41, 0x9e, 0xf4, 0xc5, 0xc6, 0x3d, 0x4c,
-		0x37, 0xc7, 0x22, 0x20, 0xfe, 0x92, 0x0b, 0x20, 0xc4, 0x54, 0xfd, 0xaa, 0x5f, 0xfb, 0xec, 0x42,
-		0xec, 0x33, 0x9f, 0x5d, 0x88, 0xfd, 0xde, 0x67, 0x17, 0x62, 0x1f, 0xff, 0xdc, 0xc2, 0xd4, 0x67,
-		0x3e, 0xb7, 0x30, 0xf5, 0xdb, 0x9f, 0x5b, 0x98, 0x1a, 0x9d, 0x25, 0x86, 0x35, 0x6b, 0xcd, 0x62,
-		0xf9, 0xe1, 0xd7, 0x1e, 0xd9, 0x37, 0xdc, 0x83, 0xfe, 0xee, 0xb2, 0x6e, 0x75, 0x2f, 0xe8, 0x96,
-		0xd3, 0xb5, 0x9c, 0x0b, 0xe1, 0xbc, 0x2e, 0xfd, 0x05, 0x7f, 0x15, 0x23, 0x31, 0x73, 0x38, 0x9d,
-		0xab, 0x99, 0x47, 0xe3, 0x3e, 0xa6, 0xbb, 0x0c, 0x89, 0x8a, 0x79, 0x84, 0xce, 0x30, 0x03, 0xa7,
-		0xf6, 0xed, 0x0e, 0x3f, 0x6a, 0x99, 0x26, 0xcf, 0x3b, 0x76, 0x07, 0xcd, 0xf9, 0xe7, 0xa1, 0x63,
-		0xe7, 0xf2, 0xfc, 0x90, 0x73, 0xf5, 0x9b, 0x62, 0x27, 0xeb, 0x49, 0xa6, 0x62, 0x1e, 0xd1, 0x8e,
-		0x6c, 0xc7, 0x5e, 0x7b, 0x32, 0x32, 0xcf, 0x7d, 0xcb, 0xb4, 0xee, 0x98, 0xa4, 0xd9, 0xbd, 0x5d,
-		0x91, 0xe3, 0x5e, 0x18, 0xcc, 0x71, 0xbf, 0x8c, 0x3b, 0x9d, 0x1b, 0x84, 0xae, 0x45, 0x58, 0x76,
-		0x53, 0xec, 0x54, 0x3f, 0x7c, 0x4b, 0x1c, 0x16, 0x86, 0xd2, 0xd9, 0x5c, 0x09, 0xc6, 0x09, 0x61,
-		0x05, 0x32, 0xab, 0x42, 0xb7, 0xe6, 0x21, 0xed, 0x60, 0xdd, 0x32, 0xdb, 0x0e, 0x15, 0x44, 0x42,
-		0x11, 0x8f, 0x44, 0x10, 0xa6, 0x66, 0x5a, 0x0e, 0x3f, 0xac, 0xcc, 0x1e, 0xaa, 0xdf, 0x79, 0x42,
-		0x41, 0x14, 0xc4, 0x9b, 0x84, 0x34, 0x9e, 0x99, 0x50, 0x1a, 0xa2, 0x13, 0xa1, 0xcc, 0xff, 0xa4,
-		0x52, 0xf9, 0xb6, 0x38, 0x2c, 0x0e, 0x4a, 0x85, 0xcc, 0x2c, 0xc7, 0xd5, 0xba, 0xbd, 0x71, 0x62,
-		0xb9, 0x02, 0xd9, 0x96, 0xa0, 0x39, 0xb1, 0x5c, 0xee, 0x9d, 0x50, 0x2e, 0x45, 0xef, 0x55, 0x42,
-		0x30, 0x17, 0x27, 0x14, 0x8c, 0xd7, 0x8f, 0xfb, 0x92, 0xcc, 0xff, 0x49, 0xc1, 0x19, 0x36, 0x9d,
-		0x54, 0x36, 0x95, 0xd8, 0x03, 0x97, 0x49, 0x3e, 0x58, 0x15, 0xbd, 0x4f, 0x22, 0xdf, 0x80, 0xd9,
-		0x06, 0xb1, 0x16, 0x24, 0x0a, 0xf2, 0x77, 0x78, 0x46, 0x9e, 0xe7, 0x5e, 0x0a, 0x39, 0xfc, 0x7c,
-		0x7f, 0x2b, 0x58, 0x24, 0x7f, 0x4d, 0x0c, 0xa4, 0xa6, 0xae, 0x75, 0x34, 0xfb, 0xcb, 0x85, 0x42,
-		0xcf, 0x03, 0xb0, 0xe3, 0x1e, 0xde, 0x87, 0x7b, 0xc5, 0x8b, 0xf3, 0xcb, 0xc1, 0xce, 0x2d, 0xb3,
-		0x37, 0xd1, 0x13, 0x54, 0x59, 0x4a, 0x4b, 0x7e, 0x9e, 0x7f, 0x05, 0xc0, 0xaf, 0x40, 0x67, 0xe1,
-		0x74, 0xb3, 0x56, 0x59, 0xaf, 0x28, 0xe2, 0x90, 0x50, 0x73, 0xbb, 0x5e, 0x6b, 0x5c, 0x6d, 0xd4,
-		0x57, 0xa5, 0x29, 0x74, 0x0a, 0x50, 0xb0, 0xd2, 0x3b, 0xd4, 0xf4, 0x00, 0xcc, 0x04, 0xcb, 0xd9,
-		0x57, 0x2a, 0x71, 0xe2, 0x29, 0x1a, 0xdd, 0x5e, 0x07, 0xd3, 0x9d, 0x47, 0xd5, 0x10, 0x52, 0x8b,
-		0x76, 0x42, 0x7e, 0xfd, 0x3f, 0xb1, 0x2f, 0x17, 0x66, 0x7d, 0x76, 0x4f, 0xe6, 0x2b, 0xeb, 0x30,
-		0xa3, 0xe9, 0x3a, 0xee, 0x85, 0x20, 0x23, 0x4c, 0x35, 0x01, 0xa4, 0x7b, 0xa9, 0x9c, 0xd3, 0x47,
-		0x7b, 0x1e, 0x52, 0x0e, 0xed, 0x7d, 0x14, 0xc4, 0x6f, 0x70, 0x08, 0x4e, 0xbe, 0x62, 0xc2, 0x0c,
-		0xf1, 0xfc, 0x34, 0x1b, 0x07, 0x9a, 0x71, 0x7c, 0x9e, 0xe1, 0x5f, 0xfd, 0xd4, 0xd3, 0x74, 0x67,
-		0xf5, 0xa1, 0xf0, 0xb0, 0x8c, 0x50, 0x27, 0x45, 0xe2, 0xd8, 0x7e, 0x43, 0x31, 0x14, 0xc5, 0xfb,
-		0x78, 0x83, 0x8f, 0x7f, 0xd9, 0x2f, 0xf2, 0x97, 0x2d, 0x8c, 0xd2, 0x81, 0xc0, 0x9b, 0x0a, 0x1c,
-		0x95, 0x55, 0x54, 0xeb, 0xe3, 0xe6, 0xf4, 0x6b, 0x4f, 0x0c, 0xaf, 0x4e, 0xec, 0xbf, 0xa7, 0x28,
-		0xf2, 0x95, 0xe0, 0x6b, 0xbc, 0xb9, 0xf7, 0x3b, 0x09, 0x98, 0xd1, 0xba, 0x86, 0x69, 0x5d, 0xa0,
-		0xff, 0xf2, 0x39, 0x37, 0x4d, 0x1f, 0x26, 0xd8, 0x94, 0xbc, 0xcc, 0xa6, 0x42, 0xb4, 0xc6, 0xfc,
-		0xf9, 0x37, 0xfe, 0xc0, 0xb4, 0x3f, 0x5d, 0x56, 0x36, 0x40, 0x12, 0x87, 0x78, 0xb1, 0xa9, 0x5b,
-		0xed, 0x89, 0xb2, 0x14, 0x7f, 0x21, 0x30, 0x44, 0x7e, 0xab, 0xce, 0x59, 0x57, 0xde, 0x07, 0x19,
-		0x0f, 0x26, 0xca, 0x33, 0x11, 0x20, 0x1e, 0x07, 0xf1, 0x4b, 0xd8, 0xcc, 0x9c, 0xc4, 0x0b, 0xfd,
-		0xa2, 0xe0, 0x67, 0x33, 0x74, 0x93, 0xf4, 0x66, 0x0d, 0x8a, 0x6d, 0xcb, 0x74, 0x55, 0xab, 0x6b,
-		0xb8, 0xb8, 0xdb, 0x73, 0x23, 0xfd, 0xba, 0xbf, 0x64, 0x20, 0x19, 0xa5, 0x40, 0xf8, 0xb6, 0x04,
-		0xdb, 0x7d, 0x0d, 0xae, 0xd3, 0xbe, 0xc5, 0x6d, 0xb1, 0x7b, 0xc8, 0x06, 0xd1, 0x1b, 0xdc, 0x3f,
-		0x4d, 0xc0, 0x02, 0x27, 0xde, 0xd5, 0x1c, 0x7c, 0xe1, 0xf6, 0x33, 0xbb, 0xd8, 0xd5, 0x9e, 0xb9,
-		0xa0, 0x5b, 0x86, 0x58, 0x88, 0x67, 0xb9, 0xad, 0x25, 0xf5, 0xcb, 0xbc, 0xbe, 0x3c, 0x72, 0xb7,
-		0xba, 0x3c, 0xde, 0x46, 0x97, 0x87, 0x55, 0x48, 0x7e, 0x0d, 0x92, 0x35, 0xcb, 0x30, 0xc9, 0xd2,
-		0xd4, 0xc6, 0xa6, 0xd5, 0xe5, 0xd6, 0x92, 0x3d, 0xa0, 0xcb, 0x90, 0xd2, 0xba, 0x56, 0xdf, 0x74,
-		0x99, 0xa5, 0xac, 0x2e, 0xfc, 0xda, 0x5b, 0x8b, 0x53, 0xbf, 0xf3, 0xd6, 0x62, 0xa2, 0x61, 0xba,
-		0xbf, 0xf5, 0xd3, 0x4f, 0x01, 0x47, 0x6f, 0x98, 0xee, 0xa7, 0xff, 0xf0, 0xc7, 0xcf, 0xc7, 0x14,
-		0x4e, 0xbd, 0x92, 0xfc, 0xfc, 0x27, 0x17, 0x63, 0xf2, 0x2b, 0x90, 0x5e, 0xc5, 0xfa, 0x31, 0xf0,
-		0xcf, 0x0c, 0xc0, 0x9f, 0x11, 0xf0, 0xab, 0x58, 0x0f, 0xc0, 0xaf, 0x62, 0x7d, 0x00, 0xf9, 0x79,
-		0xc8, 0x34, 0x4c, 0x97, 0x7d, 0x01, 0xf4, 0x04, 0x24, 0x0c, 0x93, 0x1d, 0x2a, 0x0f, 0x20, 0x0c,
-		0x35, 0x50, 0x21, 0x54, 0x84, 0x71, 0x15, 0xeb, 0x1e, 0x63, 0x1b, 0xeb, 0x83, 0x8c, 0xc3, 0xaf,
-		0x26, 0x54, 0xd5, 0xd5, 0xdf, 0xfe, 0xfd, 0x85, 0xa9, 0x37, 0x3f, 0xbb, 0x30, 0x35, 0x76, 0xe8,
-		0xe5, 0xe8, 0xa1, 0xf7, 0x46, 0xfc, 0x07, 0x92, 0xf0, 0x20, 0xfd, 0x30, 0xd4, 0xee, 0x1a, 0xa6,
-		0x7b, 0x41, 0xb7, 0x8f, 0x7a, 0xae, 0x45, 0xe6, 0xaf, 0xb5, 0xc7, 0x07, 0x7c, 0xc6, 0xaf, 0x5e,
-		0x66, 0xd5, 0xa3, 0x87, 0x5b, 0xde, 0x83, 0xe9, 0x6d, 0xc2, 0x47, 0x44, 0xec, 0x5a, 0xae, 0xd6,
-		0xe1, 0x4e, 0x07, 0x7b, 0x20, 0xa5, 0xec, 0x63, 0xd2, 0x38, 0x2b, 0x35, 0xc4, 0x77, 0xa4, 0x1d,
-		0xac, 0xed, 0xb1, 0x6f, 0x72, 0x12, 0xd4, 0x5b, 0xcd, 0x90, 0x02, 0xfa, 0xf9, 0xcd, 0x1c, 0x4c,
-		0x6b, 0x7d, 0x76, 0x6e, 0x26, 0x41, 0xdc, 0x58, 0xfa, 0x20, 0xdf, 0x80, 0x34, 0xdf, 0x3e, 0x47,
-		0x12, 0x24, 0x6e, 0xe1, 0x23, 0xfa, 0x9e, 0xbc, 0x42, 0x7e, 0xa2, 0x65, 0x98, 0xa6, 0x8d, 0xe7,
-		0x1f, 0x1b, 0xce, 0x2f, 0x0f, 0xb5, 0x7e, 0x99, 0x36, 0x52, 0x61, 0x64, 0xf2, 0x75, 0xc8, 0xac,
-		0x5a, 0x44, 0x0b, 0xc3, 0x68, 0x59, 0x86, 0x46, 0xdb, 0xdc, 0xeb, 0x73, 0xad, 0x50, 0xd8, 0x03,
-		0x3a, 0x05, 0x29, 0xf6, 0x8d, 0x16, 0x3f, 0xfb, 0xc3, 0x9f, 0xe4, 0x1a, 0xa4, 0x29, 0xf6, 0x56,
-		0x8f, 0xac, 0xf8, 0xde, 0xb1, 0xf5, 0x2c, 0xff, 0x62, 0x97, 0xc3, 0xc7, 0xfd, 0xc6, 0x22, 0x48,
-		0xb6, 0x35, 0x57, 0xe3, 0xfd, 0xa6, 0xbf, 0xe5, 0x0f, 0x40, 0x86, 0x83, 0x38, 0xe8, 0x22, 0x24,
-		0xac, 0x9e, 0xc3, 0x4f, 0xef, 0x94, 0xc7, 0x75, 0x65, 0xab, 0x57, 0x4d, 0x12, 0x9d, 0x51, 0x08,
-		0x71, 0x75, 0x73, 0xac, 0x5a, 0x3c, 0x17, 0x52, 0x8b, 0x2e, 0x76, 0x77, 0xf7, 0x5c, 0xff, 0x07,
-		0x1b, 0xce, 0x21, 0x55, 0xf0, 0x14, 0xe5, 0x5e, 0x1c, 0x16, 0x02, 0xb5, 0xb7, 0xb1, 0xed, 0x18,
-		0x96, 0xc9, 0xb4, 0x89, 0x6b, 0x0a, 0x0a, 0x34, 0x90, 0xd7, 0x8f, 0x51, 0x95, 0xf7, 0x43, 0xa2,
-		0xd2, 0xeb, 0xa1, 0x32, 0x64, 0xe8, 0xb3, 0x6e, 0x31, 0x5d, 0x49, 0x2a, 0xde, 0x33, 0xa9, 0x73,
-		0xac, 0x3d, 0xf7, 0x8e, 0x66, 0x7b, 0x9f, 0x30, 0x8b, 0x67, 0xf9, 0x45, 0xc8, 0xd6, 0x2c, 0xd3,
-		0xc1, 0xa6, 0xd3, 0xa7, 0xae, 0xec, 0x6e, 0xc7, 0xd2, 0x6f, 0x71, 0x04, 0xf6, 0x40, 0x84, 0xad,
-		0xf5, 0x7a, 0x94, 0x33, 0xa9, 0x90, 0x9f, 0x6c, 0xbe, 0x56, 0xb7, 0xc6, 0x8a, 0xe7, 0xd2, 0xc9,
-		0xc4, 0xc3, 0x3b, 0xe8, 0xfb, 0xa4, 0x31, 0x78, 0xcf, 0xf0, 0x44, 0xba, 0x85, 0x8f, 0x9c, 0x93,
-		0xce, 0xa3, 0x57, 0x20, 0xbb, 0x4d, 0x6f, 0x17, 0xb9, 0x81, 0x8f, 0x50, 0x19, 0xd2, 0xb8, 0x7d,
-		0xf1, 0xd2, 0xa5, 0x67, 0x5e, 0x64, 0x5a, 0x7e, 0x6d, 0x4a, 0x11, 0x05, 0x68, 0x01, 0xb2, 0x0e,
-		0xd6, 0x7b, 0x17, 0x2f, 0x5d, 0xbe, 0xf5, 0x0c, 0x53, 0xab, 0x6b, 0x53, 0x8a, 0x5f, 0xb4, 0x92,
-		0x21, 0x3d, 0xfe, 0xfc, 0xa7, 0x16, 0x63, 0xd5, 0x69, 0x48, 0x38, 0xfd, 0xee, 0x3b, 0xa6, 0x1b,
-		0xdf, 0x3a, 0x0d, 0x4b, 0x81, 0x5a, 0xb6, 0xb8, 0xdc, 0xd6, 0x3a, 0x46, 0x5b, 0xf3, 0xef, 0x84,
-		0x91, 0x02, 0xfd, 0xa7, 0x14, 0x63, 0x56, 0x8d, 0x63, 0xa5, 0x28, 0xff, 0x64, 0x0c, 0xf2, 0x37,
-		0x05, 0x72, 0x13, 0xbb, 0xe8, 0x0a, 0x80, 0xf7, 0x26, 0x31, 0x55, 0xce, 0x2e, 0x0f, 0xbe, 0x6b,
-		0xd9, 0xe3, 0x51, 0x02, 0xe4, 0xe8, 0x79, 0xaa, 0x80, 0x3d, 0xcb, 0xe1, 0x9f, 0xb3, 0x46, 0xb0,
-		0x7a, 0xc4, 0xe8, 0x49, 0x40, 0xd4, 0xaa, 0xa9, 0xb7, 0x2d, 0xd7, 0x30, 0xf7, 0xd5, 0x9e, 0x75,
-		0x87, 0x5f, 0x12, 0x90, 0x50, 0x24, 0x5a, 0x73, 0x93, 0x56, 0x6c, 0x93, 0x72, 0xd2, 0xe8, 0xac,
-		0x87, 0x42, 0xa2, 0x32, 0xad, 0xdd, 0xb6, 0xb1, 0xe3, 0x70, 0xc3, 0x25, 0x1e, 0xd1, 0x15, 0x48,
-		0xf7, 0xfa, 0xbb, 0xaa, 0xb0, 0x12, 0xb9, 0x8b, 0xef, 0x19, 0x35, 0xe7, 0x85, 0x6e, 0xf0, 0x59,
-		0x9f, 0xea, 0xf5, 0x77, 0x89, 0xa6, 0x3c, 0x04, 0xf9, 0x11, 0x8d, 0xc9, 0xdd, 0xf6, 0xdb, 0x41,
-		0x2f, 0xb4, 0xe1, 0x3d, 0x50, 0x7b, 0xb6, 0x61, 0xd9, 0x86, 0x7b, 0x44, 0x4f, 0xdd, 0x25, 0x14,
-		0x49, 0x54, 0x6c, 0xf3, 0x72, 0xf9, 0x16, 0x94, 0x9a, 0xd4, 0x5b, 0xf7, 0x5b, 0x7e, 0xc9, 0x6f,
-		0x5f, 0x2c, 0xba, 0x7d, 0x63, 0x5b, 0x16, 0x1f, 0x6a, 0x59, 0x75, 0x63, 0xac, 0x66, 0x3e, 0x7b,
-		0x32, 0xcd, 0x0c, 0xaf, 0x6e, 0x3f, 0x52, 0x0e, 0x4d, 0x4a, 0xee, 0xf5, 0x04, 0x4c, 0xd6, 0xa4,
-		0x4a, 0x19, 0x15, 0x88, 0x97, 0x8f, 0x5f, 0x44, 0xcb, 0x11, 0xa6, 0xb3, 0x1c, 0x39, 0x7d, 0xe4,
-		0x17, 0xa1, 0xb0, 0xad, 0xd9, 0x6e, 0x13, 0xbb, 0xd7, 0xb0, 0xd6, 0xc6, 0x76, 0x78, 0x95, 0x2d,
-		0x88, 0x55, 0x16, 0x41, 0x92, 0x2e, 0xa5, 0x6c, 0x95, 0xa1, 0xbf, 0xe5, 0x03, 0x48, 0xd2, 0x63,
-		0xb7, 0xde, 0x0a, 0xcc, 0x39, 0xd8, 0x0a, 0x4c, 0xec, 0xe7, 0x91, 0x8b, 0x1d, 0x91, 0x2b, 0xa2,
-		0x0f, 0xe8, 0x39, 0xb1, 0x8e, 0x26, 0x8e, 0x5f, 0x47, 0xb9, 0x12, 0xf2, 0xd5, 0xb4, 0x03, 0xe9,
-		0x2a, 0x31, 0xbf, 0x8d, 0x55, 0xaf, 0x21, 0x31, 0xbf, 0x21, 0x68, 0x03, 0x4a, 0x3d, 0xcd, 0x76,
-		0xe9, 0x67, 0x78, 0x07, 0xb4, 0x17, 0x5c, 0xcf, 0x17, 0x87, 0x67, 0x5d, 0xa8, 0xb3, 0xfc, 0x2d,
-		0x85, 0x5e, 0xb0, 0x50, 0xfe, 0x6f, 0x49, 0x48, 0x71, 0x61, 0xbc, 0x1f, 0xd2, 0x5c, 0xac, 0x5c,
-		0x33, 0x1f, 0x5c, 0x1e, 0x5e, 0x8c, 0x96, 0xbd, 0x45, 0x83, 0xe3, 0x09, 0x1e, 0xf4, 0x28, 0x64,
-		0xf4, 0x03, 0xcd, 0x30, 0x55, 0xa3, 0xcd, 0x1d, 0xc0, 0xdc, 0x67, 0xdf, 0x5a, 0x4c, 0xd7, 0x48,
-		0x59, 0x63, 0x55, 0x49, 0xd3, 0xca, 0x46, 0x9b, 0xac, 0xfc, 0x07, 0xd8, 0xd8, 0x3f, 0x70, 0xf9,
-		0xec, 0xe2, 0x4f, 0xe8, 0x05, 0x48, 0x12, 0x85, 0xe0, 0x1f, 0x69, 0x97, 0x87, 0x7c, 0x79, 0x2f,
-		0x4f, 0x52, 0xcd, 0x90, 0x17, 0x7f, 0xfc, 0xbf, 0x2e, 0xc6, 0x14, 0xca, 0x81, 0x6a, 0x50, 0xe8,
-		0x68, 0x8e, 0xab, 0xd2, 0x55, 0x8b, 0xbc, 0x7e, 0x9a, 0x42, 0x9c, 0x19, 0x16, 0x08, 0x17, 0x2c,
-		0x6f, 0x7a, 0x8e, 0x70, 0xb1, 0xa2, 0x36, 0x3a, 0x07, 0x12, 0x05, 0xd1, 0xad, 0x6e, 0xd7, 0x70,
-		0x99, 0x2f, 0x95, 0xa2, 0x72, 0x2f, 0x92, 0xf2, 0x1a, 0x2d, 0xa6, 0x1e, 0xd5, 0x59, 0xc8, 0xd2,
-		0xcf, 0x42, 0x29, 0x09, 0x3b, 0xeb, 0x9d, 0x21, 0x05, 0xb4, 0xf2, 0x31, 0x28, 0xf9, 0xb6, 0x91,
-		0x91, 0x64, 0x18, 0x8a, 0x5f, 0x4c, 0x09, 0x9f, 0x86, 0x39, 0x13, 0x1f, 0xd2, 0xd3, 0xe7, 0x21,
-		0xea, 0x2c, 0xa5, 0x46, 0xa4, 0xee, 0x66, 0x98, 0xe3, 0x11, 0x28, 0xea, 0x42, 0xf8, 0x8c, 0x16,
-		0x28, 0x6d, 0xc1, 0x2b, 0xa5, 0x64, 0x67, 0x20, 0xa3, 0xf5, 0x7a, 0x8c, 0x20, 0xc7, 0x6d, 0x63,
-		0xaf, 0x47, 0xab, 0xce, 0xc3, 0x0c, 0xed, 0xa3, 0x8d, 0x9d, 0x7e, 0xc7, 0xe5, 0x20, 0x79, 0x4a,
-		0x53, 0x22, 0x15, 0x0a, 0x2b, 0xa7, 0xb4, 0x0f, 0x43, 0x01, 0xdf, 0x36, 0xda, 0xd8, 0xd4, 0x31,
-		0xa3, 0x2b, 0x50, 0xba, 0xbc, 0x28, 0xa4, 0x44, 0x8f, 0x83, 0x67, 0xf3, 0x54, 0x61, 0x8f, 0x8b,
-		0x0c, 0x4f, 0x94, 0x57, 0x58, 0xb1, 0x3c, 0x0f, 0xc9, 0x55, 0xcd, 0xd5, 0x88, 0x53, 0xe1, 0x1e,
-		0xb2, 0x45, 0x26, 0xaf, 0x90, 0x9f, 0xf2, 0xe7, 0xe3, 0x90, 0xbc, 0x69, 0xb9, 0x18, 0x3d, 0x1b,
-		0x70, 0xf8, 0x8a, 0xa3, 0xf4, 0xb9, 0x69, 0xec, 0x9b, 0xb8, 0xbd, 0xe1, 0xec, 0x07, 0xee, 0x70,
-		0xf1, 0xd5, 0x29, 0x1e, 0x52, 0xa7, 0x39, 0x98, 0xb6, 0xad, 0xbe, 0xd9, 0x16, 0xa7, 0xa4, 0xe9,
-		0x03, 0xaa, 0x43, 0xc6, 0xd3, 0x92, 0x64, 0x94, 0x96, 0x94, 0x88, 0x96, 0x10, 0x1d, 0xe6, 0x05,
-		0x4a, 0x7a, 0x97, 0x2b, 0x4b, 0x15, 0xb2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x99, 0xc2, 0xfa, 0x6c,
-		0x64, 0x21, 0xf1, 0xc6, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xc9, 0xab, 0xe0, 0xd2, 0x0b, 0xa9, 0x15,
-		0xbf, 0x4f, 0x26, 0x4d, 0xfb, 0xe5, 0xab, 0x15, 0xbb, 0x53, 0xe6, 0x3d, 0x90, 0x75, 0x8c, 0x7d,
-		0x53, 0x73, 0xfb, 0x36, 0xe6, 0x9a, 0xe7, 0x17, 0xc8, 0xbf, 0x14, 0x83, 0x14, 0xd3, 0xe4, 0x80,
-		0xdc, 0x62, 0xa3, 0xe5, 0x16, 0x1f, 0x27, 0xb7, 0xc4, 0xfd, 0xcb, 0xad, 0x02, 0xe0, 0x35, 0xc6,
-		0xe1, 0xd7, 0x7c, 0x8c, 0xf0, 0x16, 0x58, 0x13, 0x9b, 0xc6, 0x3e, 0x9f, 0xa8, 0x01, 0x26, 0xf9,
-		0xbf, 0xc4, 0x88, 0xe3, 0xca, 0xeb, 0x51, 0x05, 0x0a, 0xa2, 0x5d, 0xea, 0x5e, 0x47, 0xdb, 0xe7,
-		0xba, 0xf3, 0xe0, 0xd8, 0xc6, 0x5d, 0xed, 0x68, 0xfb, 0x4a, 0x8e, 0xb7, 0x87, 0x3c, 0x8c, 0x1e,
-		0x87, 0xf8, 0x98, 0x71, 0x08, 0x0d, 0x7c, 0xe2, 0xfe, 0x06, 0x3e, 0x34, 0x44, 0xc9, 0xc1, 0x21,
-		0xfa, 0xa9, 0x38, 0x0d, 0x5e, 0x7a, 0x96, 0xa3, 0x75, 0xde, 0x8d, 0x19, 0x71, 0x16, 0xb2, 0x3d,
-		0xab, 0xa3, 0xb2, 0x1a, 0xf6, 0xf5, 0x40, 0xa6, 0x67, 0x75, 0x94, 0xa1, 0x61, 0x9f, 0x7e, 0x9b,
-		0xa6, 0x4b, 0xea, 0x6d, 0x90, 0x5a, 0x7a, 0x50, 0x6a, 0x36, 0xe4, 0x99, 0x28, 0xf8, 0x5a, 0xf6,
-		0x34, 0x91, 0x01, 0x5d, 0x1c, 0x63, 0xc3, 0x6b, 0x2f, 0x6b, 0x36, 0xa3, 0x54, 0x38, 0x1d, 0xe1,
-		0x60, 0xa6, 0x7f, 0x54, 0xd4, 0x1b, 0x54, 0x4b, 0x85, 0xd3, 0xc9, 0xdf, 0x1a, 0x03, 0x58, 0x27,
-		0x92, 0xa5, 0xfd, 0x25, 0xab, 0x90, 0x43, 0x9b, 0xa0, 0x86, 0xde, 0xbc, 0x30, 0x6e, 0xd0, 0xf8,
-		0xfb, 0xf3, 0x4e, 0xb0, 0xdd, 0x35, 0x28, 0xf8, 0xca, 0xe8, 0x60, 0xd1, 0x98, 0x85, 0x63, 0x3c,
-		0xea, 0x26, 0x76, 0x95, 0xfc, 0xed, 0xc0, 0x93, 0xfc, 0x6f, 0x62, 0x90, 0xa5, 0x6d, 0xda, 0xc0,
-		0xae, 0x16, 0x1a, 0xc3, 0xd8, 0xfd, 0x8f, 0xe1, 0x83, 0x00, 0x0c, 0xc6, 0x31, 0xde, 0xc0, 0x5c,
-		0xb3, 0xb2, 0xb4, 0xa4, 0x69, 0xbc, 0x81, 0xd1, 0x65, 0x4f, 0xe0, 0x89, 0xe3, 0x05, 0x2e, 0x3c,
-		0x6e, 0x2e, 0xf6, 0xd3, 0x90, 0xa6, 0xd7, 0xe2, 0x1d, 0x3a, 0xdc, 0x89, 0x4e, 0x99, 0xfd, 0x6e,
-		0xeb, 0xd0, 0x91, 0x5f, 0x87, 0x74, 0xeb, 0x90, 0xe5, 0x42, 0xce, 0x42, 0xd6, 0xb6, 0x2c, 0xbe,
-		0x26, 0x33, 0x5f, 0x28, 0x43, 0x0a, 0xe8, 0x12, 0x24, 0xe2, 0xff, 0xb8, 0x1f, 0xff, 0xfb, 0x09,
-		0x8c, 0xc4, 0x64, 0x09, 0x8c, 0x0f, 0x40, 0x4a, 0xc1, 0xb7, 0xb1, 0xd6, 0x19, 0x6b, 0x15, 0x43,
-		0xda, 0x17, 0x1f, 0xd0, 0xbe, 0xf3, 0xff, 0x39, 0x06, 0xb9, 0x80, 0x7d, 0x41, 0xcf, 0xc0, 0x03,
-		0xd5, 0xf5, 0xad, 0xda, 0x0d, 0xb5, 0xb1, 0xaa, 0x5e, 0x5d, 0xaf, 0xac, 0xf9, 0x1f, 0xd8, 0x95,
-		0x4f, 0xdd, 0xbd, 0xb7, 0x84, 0x02, 0xb4, 0x3b, 0x26, 0xdd, 0xcc, 0x41, 0x17, 0x60, 0x2e, 0xcc,
-		0x52, 0xa9, 0x36, 0xeb, 0x9b, 0x2d, 0x29, 0x56, 0x7e, 0xe0, 0xee, 0xbd, 0xa5, 0x99, 0x00, 0x47,
-		0x65, 0xd7, 0xc1, 0xa6, 0x3b, 0xcc, 0x50, 0xdb, 0xda, 0xd8, 0x68, 0xb4, 0xa4, 0xf8, 0x10, 0x03,
-		0x37, 0xf8, 0x8f, 0xc3, 0x4c, 0x98, 0x61, 0xb3, 0xb1, 0x2e, 0x25, 0xca, 0xe8, 0xee, 0xbd, 0xa5,
-		0x62, 0x80, 0x7a, 0xd3, 0xe8, 0x94, 0x33, 0x1f, 0xfb, 0xde, 0x85, 0xa9, 0x4f, 0x7f, 0xdf, 0x42,
-		0x8c, 0xf4, 0xac, 0x10, 0xb2, 0x31, 0xe8, 0x49, 0x38, 0xdd, 0x6c, 0xac, 0x6d, 0xd6, 0x57, 0xd5,
-		0x8d, 0xe6, 0xda, 0xc0, 0x37, 0xd3, 0xe5, 0xd2, 0xdd, 0x7b, 0x4b, 0x39, 0xde, 0xa5, 0x71, 0xd4,
-		0xdb, 0x4a, 0xfd, 0xe6, 0x56, 0xab, 0x2e, 0xc5, 0x18, 0xf5, 0xb6, 0x8d, 0x6f, 0x5b, 0x2e, 0xbb,
-		0x91, 0xf3, 0x69, 0x38, 0x33, 0x82, 0xda, 0xeb, 0xd8, 0xcc, 0xdd, 0x7b, 0x4b, 0x85, 0x6d, 0x1b,
-		0xb3, 0xf9, 0x47, 0x39, 0x96, 0x61, 0x7e, 0x98, 0x63, 0x6b, 0x7b, 0xab, 0x59, 0x59, 0x97, 0x96,
-		0xca, 0xd2, 0xdd, 0x7b, 0x4b, 0x79, 0x61, 0x4c, 0x09, 0xbd, 0xdf, 0xb3, 0x77, 0x2a, 0x5a, 0xfa,
-		0xfa, 0x74, 0x28, 0x17, 0xc8, 0xe2, 0x90, 0x9e, 0x66, 0x6b, 0xdd, 0x93, 0x86, 0x4b, 0x11, 0xbb,
-		0xb9, 0xf2, 0x9b, 0x71, 0x28, 0x79, 0xce, 0xf8, 0x36, 0x7d, 0x03, 0x7a, 0x36, 0x98, 0xc7, 0xc9,
-		0x8d, 0x5d, 0x06, 0x19, 0xb5, 0x48, 0xf3, 0xbc, 0x0f, 0x32, 0xc2, 0xa9, 0xe3, 0xe6, 0x66, 0x69,
-		0x98, 0xaf, 0xce, 0x29, 0x38, 0xab, 0xc7, 0x81, 0x5e, 0x82, 0xac, 0x67, 0x7c, 0xbc, 0x5b, 0xab,
-		0xc6, 0x5b, 0x2b, 0xce, 0xef, 0xf3, 0xa0, 0x17, 0xfd, 0xb0, 0x23, 0x39, 0x2e, 0x90, 0xb9, 0xc9,
-		0x08, 0x38, 0xb3, 0xa0, 0x97, 0x1b, 0x7c, 0xda, 0xf1, 0xde, 0xd3, 0x1b, 0x3f, 0x0e, 0x55, 0x16,
-		0x89, 0xb1, 0xf9, 0x9b, 0xe9, 0x6a, 0x87, 0x55, 0x1a, 0x8c, 0x9d, 0x86, 0x34, 0xa9, 0xdc, 0xe7,
-		0xdf, 0x94, 0x27, 0x94, 0x54, 0x57, 0x3b, 0x5c, 0xd3, 0x9c, 0xeb, 0xc9, 0x4c, 0x42, 0x4a, 0xca,
-		0x3f, 0x14, 0x83, 0x62, 0xb8, 0x8f, 0xe8, 0x09, 0x40, 0x84, 0x43, 0xdb, 0xc7, 0x2a, 0x31, 0x51,
-		0x54, 0x58, 0x02, 0xb7, 0xd4, 0xd5, 0x0e, 0x2b, 0xfb, 0x78, 0xb3, 0xdf, 0xa5, 0x0d, 0x70, 0xd0,
-		0x06, 0x48, 0x82, 0x58, 0x8c, 0x13, 0x17, 0xe6, 0x99, 0xe1, 0x3b, 0x2c, 0x39, 0x01, 0x5b, 0xe8,
-		0x3e, 0x41, 0x16, 0xba, 0x22, 0xc3, 0xf3, 0x76, 0xe3, 0x43, 0x5d, 0x49, 0x84, 0xbb, 0x22, 0xbf,
-		0x04, 0xa5, 0x01, 0x79, 0x22, 0x19, 0x0a, 0x3c, 0xab, 0x40, 0x77, 0x3a, 0x99, 0x7f, 0x9d, 0x55,
-		0x72, 0x2c, 0x7b, 0x40, 0x77, 0x7e, 0x57, 0x32, 0x3f, 0xf7, 0xc9, 0xc5, 0x18, 0x4d, 0xb8, 0x3f,
-		0x01, 0x85, 0x90, 0x44, 0x45, 0xa6, 0x2f, 0xe6, 0x67, 0xfa, 0x7c, 0xe2, 0xd7, 0x20, 0x4f, 0x0c,
-		0x2d, 0x6e, 0x73, 0xda, 0x47, 0xa1, 0xc4, 0x16, 0x82, 0x41, 0x59, 0x33, 0x4f, 0x6c, 0x43, 0x08,
-		0x5c, 0x16, 0xae, 0x59, 0x58, 0xec, 0x39, 0x41, 0xb5, 0xa6, 0x39, 0xd5, 0x0f, 0x7d, 0xfa, 0xb3,
-		0x0b, 0xb1, 0x77, 0x66, 0x22, 0xfe, 0x6a, 0x0b, 0xce, 0x06, 0x2a, 0xb5, 0x5d, 0xdd, 0x08, 0x65,
-		0x2d, 0x4a, 0x01, 0x25, 0x23, 0x95, 0x51, 0xd9, 0x87, 0x63, 0x73, 0x20, 0xc7, 0x27, 0xdc, 0xca,
-		0xc7, 0x5b, 0x84, 0xe8, 0xc4, 0xc8, 0xe8, 0x1c, 0xe7, 0x2f, 0x65, 0x21, 0xad, 0xe0, 0x8f, 0xf4,
-		0xb1, 0xe3, 0xa2, 0x8b, 0x90, 0xc4, 0xfa, 0x81, 0x35, 0x2a, 0xa5, 0x44, 0x3a, 0xb7, 0xcc, 0xe9,
-		0xea, 0xfa, 0x81, 0x75, 0x6d, 0x4a, 0xa1, 0xb4, 0xe8, 0x12, 0x4c, 0xef, 0x75, 0xfa, 0x3c, 0xcf,
-		0x31, 0x60, 0x2c, 0x82, 0x4c, 0x57, 0x09, 0xd1, 0xb5, 0x29, 0x85, 0x51, 0x93, 0x57, 0xd1, 0x7b,
-		0x82, 0x13, 0xc7, 0xbf, 0xaa, 0x61, 0xee, 0xd1, 0x57, 0x11, 0x5a, 0x54, 0x05, 0x30, 0x4c, 0xc3,
-		0x55, 0x69, 0x0e, 0x80, 0x7b, 0x92, 0x0f, 0x8d, 0xe7, 0x34, 0x5c, 0x9a, 0x35, 0xb8, 0x36, 0xa5,
-		0x64, 0x0d, 0xf1, 0x40, 0x9a, 0xfb, 0x91, 0x3e, 0xb6, 0x8f, 0xb8, 0x03, 0x39, 0xb6, 0xb9, 0x1f,
-		0x22, 0x44, 0xa4, 0xb9, 0x94, 0x1a, 0xd5, 0x21, 0x47, 0x3f, 0x90, 0x65, 0xf3, 0x97, 0xdf, 0x4c,
-		0x2b, 0x8f, 0x63, 0xae, 0x12, 0x52, 0x3a, 0xa5, 0xaf, 0x4d, 0x29, 0xb0, 0xeb, 0x3d, 0x11, 0x23,
-		0xc9, 0x6e, 0x2e, 0x73, 0x0f, 0xf9, 0x7d, 0x9c, 0x8b, 0xe3, 0x30, 0xe8, 0xf5, 0x65, 0xad, 0xc3,
-		0x6b, 0x53, 0x4a, 0x5a, 0x67, 0x3f, 0x49, 0xff, 0xdb, 0xb8, 0x63, 0xdc, 0xc6, 0x36, 0xe1, 0xcf,
-		0x1e, 0xdf, 0xff, 0x55, 0x46, 0x49, 0x11, 0xb2, 0x6d, 0xf1, 0x40, 0x0c, 0x2d, 0x36, 0xdb, 0xbc,
-		0x1b, 0x30, 0x6c, 0xa7, 0x43, 0xe3, 0x6c, 0xb6, 0x45, 0x27, 0x32, 0x98, 0xff, 0x46, 0x2f, 0x78,
-		0x1e, 0x6e, 0x6e, 0xd8, 0xa9, 0x0c, 0x75, 0x80, 0xe5, 0x3a, 0xa6, 0x84, 0xa7, 0x8b, 0x36, 0xa1,
-		0xd8, 0x31, 0x1c, 0x57, 0x75, 0x4c, 0xad, 0xe7, 0x1c, 0x58, 0xae, 0x43, 0x93, 0x06, 0xb9, 0x8b,
-		0x8f, 0x8c, 0x43, 0x58, 0x37, 0x1c, 0xb7, 0x29, 0x88, 0xaf, 0x4d, 0x29, 0x85, 0x4e, 0xb0, 0x80,
-		0xe0, 0x59, 0x7b, 0x7b, 0xd8, 0xf6, 0x00, 0x69, 0x72, 0xe1, 0x18, 0xbc, 0x2d, 0x42, 0x2d, 0xf8,
-		0x09, 0x9e, 0x15, 0x2c, 0x40, 0x5f, 0x01, 0xb3, 0x1d, 0x4b, 0x6b, 0x7b, 0x70, 0xaa, 0x7e, 0xd0,
-		0x37, 0x6f, 0xd1, 0x4c, 0x44, 0xee, 0xe2, 0xe3, 0x63, 0x1b, 0x69, 0x69, 0x6d, 0x01, 0x51, 0x23,
-		0x0c, 0xd7, 0xa6, 0x94, 0x99, 0xce, 0x60, 0x21, 0xfa, 0x30, 0xcc, 0x69, 0xbd, 0x5e, 0xe7, 0x68,
-		0x10, 0xbd, 0x44, 0xd1, 0xcf, 0x8f, 0x43, 0xaf, 0x10, 0x9e, 0x41, 0x78, 0xa4, 0x0d, 0x95, 0xa2,
-		0x16, 0x48, 0x3d, 0x1b, 0xd3, 0x6f, 0x6a, 0x7a, 0xdc, 0x55, 0xa1, 0x37, 0x0e, 0xe5, 0x2e, 0x3e,
-		0x36, 0x0e, 0x7b, 0x9b, 0xd1, 0x0b, 0xcf, 0xe6, 0xda, 0x94, 0x52, 0xea, 0x85, 0x8b, 0x18, 0xaa,
-		0xa5, 0x63, 0x7a, 0x21, 0x1a, 0x47, 0x9d, 0x89, 0x42, 0xa5, 0xf4, 0x61, 0xd4, 0x50, 0x11, 0xd5,
-		0x41, 0xf7, 0x40, 0x65, 0xf3, 0x10, 0x45, 0xe8, 0xa0, 0x7b, 0x20, 0xa6, 0x62, 0x06, 0xf3, 0xdf,
-		0xd5, 0x34, 0x3f, 0x54, 0xc7, 0x6f, 0x9c, 0x79, 0x0c, 0x72, 0x01, 0xcb, 0x84, 0xe6, 0x21, 0xcd,
-		0x0f, 0x19, 0x88, 0xc3, 0x78, 0xfc, 0x51, 0x2e, 0x42, 0x3e, 0x68, 0x8d, 0xe4, 0x8f, 0xc7, 0x3c,
-		0x4e, 0xfa, 0xa9, 0xfe, 0x7c, 0x38, 0x77, 0x99, 0xf5, 0xd3, 0x92, 0x0f, 0x8b, 0x65, 0x48, 0xd4,
-		0xb3, 0xed, 0xac, 0x3c, 0x2d, 0xe4, 0xab, 0x20, 0x5a, 0x84, 0x5c, 0xef, 0x62, 0xcf, 0x23, 0x49,
-		0x50, 0x12, 0xe8, 0x5d, 0xec, 0x09, 0x82, 0x87, 0x20, 0x4f, 0xfa, 0xa6, 0x06, 0x3d, 0x95, 0xac,
-		0x92, 0x23, 0x65, 0x9c, 0x44, 0xfe, 0xcd, 0x38, 0x48, 0x83, 0x16, 0xcc, 0x4b, 0x6a, 0xc6, 0x4e,
-		0x9c, 0xd4, 0x3c, 0x33, 0x98, 0x4e, 0xf5, 0x33, 0xa8, 0xeb, 0x20, 0xf9, 0x89, 0x40, 0xb6, 0x92,
-		0x8c, 0xf7, 0xbc, 0x06, 0x5c, 0x44, 0xa5, 0xa4, 0x0f, 0xf8, 0x8c, 0x57, 0x43, 0x9b, 0x3f, 0xe2,
-		0x26, 0xfd, 0xc1, 0x41, 0xf5, 0x1c, 0x8e, 0x9d, 0x5e, 0x5b, 0x73, 0xb1, 0x48, 0xcc, 0x04, 0xf6,
-		0x81, 0x1e, 0x85, 0x92, 0xd6, 0xeb, 0xa9, 0x8e, 0xab, 0xb9, 0x98, 0xfb, 0x05, 0xd3, 0x2c, 0x3f,
-		0xa9, 0xf5, 0x7a, 0x4d, 0x52, 0xca, 0xfc, 0x82, 0x47, 0xa0, 0x48, 0x8c, 0xba, 0xa1, 0x75, 0x54,
-		0x1e, 0x6a, 0xa5, 0x98, 0xfb, 0xc0, 0x4b, 0xaf, 0xd1, 0x42, 0xb9, 0xed, 0x8d, 0x38, 0xd5, 0x1c,
-		0x2f, 0xce, 0x8b, 0x05, 0xe2, 0x3c, 0xc4, 0xaf, 0x50, 0x60, 0xf2, 0x11, 0xd7, 0x4e, 0x8c, 0x4e,
-		0x2f, 0xcf, 0xd1, 0x98, 0xf0, 0x36, 0xcb, 0xb8, 0x64, 0x14, 0xf6, 0x20, 0x7f, 0x34, 0x0e, 0x33,
-		0x43, 0xa6, 0x7f, 0x64, 0xde, 0xdd, 0x0f, 0x70, 0xe3, 0x27, 0x0a, 0x70, 0x6f, 0x84, 0xf3, 0xca,
-		0x81, 0xa5, 0xf3, 0xec, 0x90, 0x90, 0x99, 0xe1, 0x25, 0x0a, 0xcd, 0x41, 0x02, 0xa9, 0x67, 0xaa,
-		0xe6, 0x3b, 0x30, 0xb7, 0x7b, 0xf4, 0x86, 0x66, 0xba, 0x86, 0x89, 0xd5, 0xa1, 0x51, 0x1b, 0x5e,
-		0x8b, 0x37, 0x0c, 0x67, 0x17, 0x1f, 0x68, 0xb7, 0x0d, 0x4b, 0x34, 0x6b, 0xd6, 0xe3, 0xf7, 0xb3,
-		0xcb, 0xb2, 0x02, 0xc5, 0xf0, 0xda, 0x85, 0x8a, 0x10, 0x77, 0x0f, 0x79, 0xff, 0xe3, 0xee, 0x21,
-		0x7a, 0x9a, 0x27, 0xa2, 0xe2, 0x34, 0x11, 0x35, 0xfc, 0x22, 0xce, 0xe7, 0x67, 0xa1, 0x64, 0xd9,
-		0x9b, 0x0d, 0xde, 0x7a, 0x36, 0x88, 0x2a, 0x3f, 0x0e, 0xa5, 0x81, 0x05, 0x6b, 0x5c, 0x00, 0x2e,
-		0x97, 0xa0, 0x10, 0x5a, 0x9d, 0xe4, 0x53, 0x30, 0x37, 0x6a, 0xb1, 0x91, 0x0f, 0xbc, 0xf2, 0xd0,
-		0xa2, 0x81, 0x2e, 0x41, 0xc6, 0x5b, 0x6d, 0x46, 0xa4, 0x41, 0x68, 0x2f, 0x04, 0xb1, 0xe2, 0x91,
-		0x86, 0xb2, 0xe9, 0xf1, 0x50, 0x36, 0x5d, 0xfe, 0x2a, 0x98, 0x1f, 0xb7, 0x92, 0x0c, 0x74, 0x23,
-		0xe9, 0x69, 0xe1, 0x29, 0x48, 0xf1, 0x6b, 0x13, 0xe3, 0x74, 0xff, 0x88, 0x3f, 0x11, 0xed, 0x64,
-		0xab, 0x4a, 0x82, 0x6d, 0x2b, 0xd1, 0x07, 0x59, 0x85, 0x33, 0x63, 0x57, 0x93, 0xf1, 0x3b, 0x51,
-		0x0c, 0x88, 0xef, 0x44, 0xe9, 0xa2, 0x39, 0x0e, 0xed, 0xab, 0x38, 0x6d, 0xc1, 0x9e, 0xe4, 0x4f,
-		0x24, 0xe0, 0xd4, 0xe8, 0x35, 0x05, 0x2d, 0x41, 0x9e, 0x38, 0xee, 0x6e, 0xd8, 0xc7, 0x87, 0xae,
-		0x76, 0xd8, 0xe2, 0x0e, 0x3e, 0xcf, 0xe4, 0xc7, 0xbd, 0x4c, 0x3e, 0xda, 0x81, 0x99, 0x8e, 0xa5,
-		0x6b, 0x1d, 0x35, 0xa0, 0xf1, 0x5c, 0xd9, 0x1f, 0x1e, 0x12, 0x76, 0x9d, 0x5d, 0xa6, 0xde, 0x1e,
-		0x52, 0xfa, 0x12, 0xc5, 0x58, 0xf7, 0x34, 0x1f, 0xad, 0x42, 0xae, 0xeb, 0x2b, 0xf2, 0x09, 0x94,
-		0x3d, 0xc8, 0x16, 0x18, 0x92, 0xe9, 0x91, 0xfb, 0x4e, 0xa9, 0x13, 0x9b, 0xe8, 0x71, 0x5b, 0x38,
-		0xe9, 0xb1, 0x5b, 0x38, 0xa3, 0xf6, 0x4b, 0x32, 0xa3, 0xf7, 0x4b, 0x3e, 0x16, 0x1c, 0x9a, 0xf0,
-		0x2a, 0x3c, 0xb4, 0x85, 0x82, 0x9a, 0x30, 0xc7, 0xf9, 0xdb, 0x21, 0xd9, 0xc7, 0x27, 0x35, 0x34,
-		0x48, 0xb0, 0x8f, 0x17, 0x7b, 0xe2, 0xfe, 0xc4, 0x2e, 0x6c, 0x69, 0x32, 0x60, 0x4b, 0xff, 0x3f,
-		0x1b, 0x8a, 0x27, 0x7c, 0x33, 0xc5, 0xfd, 0x18, 0xe2, 0x6f, 0xd8, 0xac, 0x48, 0x9c, 0x3f, 0xe0,
-		0x8f, 0xf2, 0xb7, 0x02, 0x64, 0x14, 0xec, 0xf4, 0xc8, 0x2a, 0x8b, 0xaa, 0x90, 0xc5, 0x87, 0x3a,
-		0xee, 0xb9, 0xfe, 0xa6, 0xea, 0xa8, 0xd0, 0x83, 0x51, 0xd7, 0x05, 0x25, 0xf1, 0xfb, 0x3d, 0x36,
-		0xf4, 0x2c, 0x0f, 0xed, 0xc6, 0x47, 0x69, 0x9c, 0x3d, 0x18, 0xdb, 0x5d, 0x16, 0xb1, 0x5d, 0x62,
-		0xac, 0xab, 0xcf, 0xb8, 0x06, 0x82, 0xbb, 0x67, 0x79, 0x70, 0x97, 0x8c, 0x78, 0x59, 0x28, 0xba,
-		0xab, 0x85, 0xa2, 0xbb, 0x54, 0x44, 0x37, 0xc7, 0x84, 0x77, 0x97, 0x45, 0x78, 0x97, 0x8e, 0x68,
-		0xf1, 0x40, 0x7c, 0x77, 0x35, 0x1c, 0xdf, 0x65, 0xc6, 0x58, 0x1b, 0xc1, 0x3d, 0x36, 0xc0, 0x7b,
-		0x7f, 0x20, 0xc0, 0xcb, 0x8e, 0xf5, 0x6c, 0x19, 0xc8, 0x88, 0x08, 0xaf, 0x16, 0x8a, 0xf0, 0x20,
-		0x42, 0x06, 0x63, 0x42, 0xbc, 0x0f, 0x06, 0x43, 0xbc, 0xdc, 0xd8, 0x28, 0x91, 0x8f, 0xf7, 0xa8,
-		0x18, 0xef, 0x45, 0x2f, 0xc6, 0xcb, 0x8f, 0x0d, 0x52, 0x79, 0x1f, 0x06, 0x83, 0xbc, 0xad, 0xa1,
-		0x20, 0xaf, 0xc0, 0xff, 0xa8, 0xc9, 0x38, 0x88, 0x88, 0x28, 0x6f, 0x6b, 0x28, 0xca, 0x2b, 0x46,
-		0x00, 0x46, 0x84, 0x79, 0x7f, 0x67, 0x74, 0x98, 0x37, 0x3e, 0x10, 0xe3, 0xcd, 0x9c, 0x2c, 0xce,
-		0x53, 0xc7, 0xc4, 0x79, 0x2c, 0x16, 0x7b, 0x62, 0x2c, 0xfc, 0xc4, 0x81, 0xde, 0xce, 0x88, 0x40,
-		0x8f, 0x85, 0x64, 0xe7, 0xc6, 0x82, 0x4f, 0x10, 0xe9, 0xed, 0x8c, 0x88, 0xf4, 0x50, 0x24, 0x6c,
-		0x64, 0xa8, 0xf7, 0xc1, 0x60, 0xa8, 0x37, 0x1b, 0xa5, 0x8b, 0x11, 0xb1, 0xde, 0xb4, 0x94, 0x92,
-		0x1f, 0x27, 0x9e, 0xf6, 0x80, 0xa5, 0x23, 0xee, 0x0a, 0xb6, 0x6d, 0xcb, 0x16, 0x27, 0x89, 0xe9,
-		0x83, 0x7c, 0x8e, 0xf8, 0xfe, 0xbe, 0x55, 0x3b, 0x26, 0x2e, 0xa4, 0x6e, 0x61, 0xc0, 0x92, 0xc9,
-		0x3f, 0x17, 0xf3, 0x79, 0xa9, 0xcb, 0x1c, 0x8c, 0x1b, 0xb2, 0x3c, 0x6e, 0x08, 0x44, 0x8b, 0xf1,
-		0x70, 0xb4, 0xb8, 0x08, 0x39, 0xe2, 0xee, 0x0d, 0x04, 0x82, 0x5a, 0xcf, 0x0b, 0x04, 0xc5, 0x11,
-		0x0a, 0x16, 0x53, 0xf2, 0x55, 0x8c, 0xed, 0x5c, 0x95, 0xbc, 0xe3, 0x24, 0x2c, 0x84, 0x41, 0x4f,
-		0xc1, 0x6c, 0x80, 0xd6, 0x73, 0x23, 0x59, 0x54, 0x24, 0x79, 0xd4, 0x15, 0xee, 0x4f, 0xfe, 0xdb,
-		0x98, 0x2f, 0x21, 0x3f, 0x82, 0x1c, 0x15, 0xec, 0xc5, 0xde, 0xa6, 0x60, 0x2f, 0x7e, 0xdf, 0xc1,
-		0x5e, 0xd0, 0x2d, 0x4e, 0x84, 0xdd, 0xe2, 0xff, 0x15, 0xf3, 0xc7, 0xc4, 0x0b, 0xdd, 0x74, 0xab,
-		0x8d, 0xb9, 0xa3, 0x4a, 0x7f, 0x13, 0x1f, 0xa6, 0x63, 0xed, 0x73, 0x77, 0x94, 0xfc, 0x24, 0x54,
-		0xde, 0xd2, 0x93, 0xe5, 0x2b, 0x8b, 0xe7, 0xe3, 0x4e, 0x07, 0xcf, 0x3b, 0xf3, 0x43, 0xc0, 0x29,
-		0xff, 0x10, 0xb0, 0xf7, 0xad, 0x5e, 0x3a, 0xf0, 0xad, 0x1e, 0x7a, 0x01, 0xb2, 0x34, 0x83, 0xab,
-		0x5a, 0x3d, 0xf1, 0x47, 0x74, 0xce, 0x8e, 0x3f, 0x00, 0xec, 0xd0, 0xa3, 0x89, 0xec, 0xd0, 0xb0,
-		0xef, 0xa0, 0x64, 0x07, 0xb7, 0x01, 0x49, 0xeb, 0xd9, 0x65, 0xf0, 0xc0, 0x3f, 0xf4, 0x14, 0x05,
-		0xf2, 0x87, 0x01, 0x0d, 0x2f, 0x33, 0xe8, 0x1a, 0xa4, 0xf0, 0x6d, 0x7a, 0x09, 0x21, 0x3b, 0x58,
-		0x79, 0x6a, 0xd8, 0x13, 0x26, 0xd5, 0xd5, 0x79, 0x22, 0xe4, 0x3f, 0x7a, 0x6b, 0x51, 0x62, 0xd4,
-		0x4f, 0x7a, 0xdf, 0x35, 0x28, 0x9c, 0x5f, 0xfe, 0xdd, 0x38, 0x71, 0x44, 0x42, 0x4b, 0xd0, 0x48,
-		0xd9, 0x8e, 0xda, 0x12, 0x9d, 0x4c, 0xde, 0x0b, 0x00, 0xfb, 0x9a, 0xa3, 0xde, 0xd1, 0x4c, 0x17,
-		0xb7, 0xb9, 0xd0, 0x03, 0x25, 0xa8, 0x0c, 0x19, 0xf2, 0xd4, 0x77, 0x70, 0x9b, 0x47, 0xed, 0xde,
-		0x73, 0xa0, 0x9f, 0xe9, 0x2f, 0xaf, 0x9f, 0x61, 0x29, 0x67, 0x06, 0xa4, 0x1c, 0x88, 0x65, 0xb2,
-		0xc1, 0x58, 0x86, 0x1d, 0x84, 0xe6, 0xe7, 0x31, 0x81, 0xb5, 0x4d, 0x3c, 0xa3, 0x87, 0xa1, 0xd0,
-		0xc5, 0xdd, 0x9e, 0x65, 0x75, 0x54, 0x66, 0x6e, 0xd8, 0x5f, 0x7d, 0xc8, 0xf3, 0xc2, 0x3a, 0xb5,
-		0x3a, 0x5f, 0x17, 0xf7, 0xe7, 0x9f, 0x1f, 0xb3, 0xfe, 0xad, 0x13, 0x30, 0xbd, 0x26, 0x7b, 0xd0,
-		0xc9, 0x40, 0xcd, 0xe0, 0x29, 0x99, 0x3e, 0x35, 0x0b, 0x42, 0xa1, 0x27, 0xb5, 0x1f, 0xfe, 0x69,
-		0x1a, 0x56, 0xec, 0xa0, 0x57, 0xe1, 0xf4, 0x80, 0x6d, 0xf3, 0xa0, 0xe3, 0x93, 0x9a, 0xb8, 0x07,
-		0xc2, 0x26, 0x4e, 0x40, 0xfb, 0xc2, 0x4a, 0x7c, 0x99, 0xb3, 0xae, 0x01, 0xc5, 0xb0, 0xcf, 0x34,
-		0x72, 0xf8, 0xe9, 0x5f, 0x19, 0x72, 0x35, 0xc3, 0x54, 0x43, 0xd9, 0xa7, 0x3c, 0x2b, 0xe4, 0x39,
-		0xad, 0x6d, 0x78, 0x60, 0xa4, 0xef, 0x84, 0x9e, 0x87, 0xac, 0xef, 0x76, 0x31, 0xa9, 0x1e, 0x93,
-		0x9d, 0xf0, 0x69, 0xe5, 0x5f, 0x8c, 0xf9, 0x90, 0xe1, 0x7c, 0x47, 0x1d, 0x52, 0xec, 0x98, 0x1f,
-		0x3f, 0x3c, 0xf4, 0xd4, 0x64, 0x5e, 0xd7, 0x32, 0x3b, 0x03, 0xa8, 0x70, 0x66, 0xf9, 0xc3, 0x90,
-		0x62, 0x25, 0x28, 0x07, 0x69, 0xff, 0x9e, 0x60, 0x80, 0x54, 0xa5, 0x56, 0xab, 0x6f, 0x8b, 0x4b,
-		0x3f, 0xab, 0x5b, 0x4a, 0x4b, 0x8a, 0x93, 0x62, 0xa5, 0x7e, 0xbd, 0x5e, 0x6b, 0x49, 0x09, 0x34,
-		0x03, 0x05, 0xf6, 0x5b, 0xbd, 0xba, 0xa5, 0x6c, 0x54, 0x5a, 0x52, 0x32, 0x50, 0xd4, 0xac, 0x6f,
-		0xae, 0xd6, 0x15, 0x69, 0x5a, 0x7e, 0x06, 0xce, 0x8c, 0xf5, 0xd3, 0xfc, 0x64, 0x46, 0x2c, 0x90,
-		0xcc, 0x90, 0x3f, 0x11, 0x87, 0xf2, 0x78, 0xe7, 0x0b, 0x5d, 0x1f, 0xe8, 0xf8, 0xc5, 0x13, 0x78,
-		0x6e, 0x03, 0xbd, 0x47, 0x8f, 0x40, 0xd1, 0xc6, 0x7b, 0xd8, 0xd5, 0x0f, 0x98, 0x33, 0xc8, 0x96,
-		0xcc, 0x82, 0x52, 0xe0, 0xa5, 0x94, 0xc9, 0x61, 0x64, 0xaf, 0x63, 0xdd, 0x55, 0x99, 0x2d, 0x72,
-		0xf8, 0x1f, 0x42, 0x2d, 0xb0, 0xd2, 0x26, 0x2b, 0x94, 0xbf, 0xea, 0x44, 0xb2, 0xcc, 0xc2, 0xb4,
-		0x52, 0x6f, 0x29, 0xaf, 0x4a, 0x09, 0x84, 0xa0, 0x48, 0x7f, 0xaa, 0xcd, 0xcd, 0xca, 0x76, 0xf3,
-		0xda, 0x16, 0x91, 0xe5, 0x2c, 0x94, 0x84, 0x2c, 0x45, 0xe1, 0xb4, 0xfc, 0x04, 0x9c, 0x1e, 0xe3,
-		0x39, 0x8e, 0x38, 0x77, 0xf9, 0xa9, 0x58, 0x90, 0x3a, 0xec, 0xfd, 0x6d, 0x41, 0xca, 0x71, 0x35,
-		0xb7, 0xef, 0x70, 0x21, 0x3e, 0x3f, 0xa9, 0x2b, 0xb9, 0x2c, 0x7e, 0x34, 0x29, 0xbb, 0xc2, 0x61,
-		0xe4, 0x4b, 0x50, 0x0c, 0xd7, 0x8c, 0x97, 0x81, 0xaf, 0x44, 0x71, 0xd9, 0x0e, 0x98, 0x22, 0x11,
-		0x7b, 0x1f, 0xe3, 0x4e, 0xc4, 0x7d, 0xeb, 0x1b, 0xb2, 0x71, 0x89, 0xc1, 0x45, 0xa4, 0x0c, 0x19,
-		0x9b, 0xe3, 0xf2, 0xcc, 0x84, 0xf7, 0x2c, 0xbf, 0x0a, 0x10, 0x48, 0xb9, 0x7a, 0xa7, 0xe6, 0x62,
-		0xc1, 0x53, 0x73, 0x97, 0x60, 0xfa, 0xb6, 0xc5, 0xec, 0xd4, 0xe8, 0xc9, 0x7a, 0xd3, 0x72, 0x71,
-		0x20, 0xbf, 0xc2, 0xa8, 0x65, 0x03, 0xd0, 0x70, 0xda, 0x6b, 0xcc, 0x2b, 0xde, 0x1f, 0x7e, 0xc5,
-		0x43, 0x63, 0x13, 0x68, 0xa3, 0x5f, 0xf5, 0x06, 0x4c, 0x53, 0x0b, 0x37, 0xf2, 0x33, 0xaa, 0xaf,
-		0x04, 0xd0, 0x5c, 0xd7, 0x36, 0x76, 0xfb, 0xfe, 0x0b, 0x16, 0x47, 0x5b, 0xc8, 0x8a, 0xa0, 0xab,
-		0xbe, 0x87, 0x9b, 0xca, 0x39, 0x9f, 0x35, 0x60, 0x2e, 0x03, 0x80, 0xf2, 0x26, 0x14, 0xc3, 0xbc,
-		0xa3, 0x3f, 0x0b, 0xf3, 0xaf, 0x57, 0xc8, 0x0a, 0x97, 0xcd, 0x73, 0xf8, 0xf8, 0x95, 0x27, 0xf4,
-		0x41, 0xbe, 0x1b, 0x83, 0x4c, 0xeb, 0x90, 0xcf, 0x9d, 0x63, 0x0e, 0xae, 0xfa, 0xdf, 0xc6, 0x79,
-		0xf9, 0x50, 0x96, 0x72, 0x4e, 0x78, 0x89, 0xec, 0x0f, 0x7a, 0xd6, 0x21, 0x39, 0x69, 0x8c, 0x2e,
-		0x12, 0xfa, 0xdc, 0x22, 0x5e, 0x99, 0xec, 0x3b, 0x94, 0x39, 0x98, 0x0e, 0x7e, 0x43, 0xc2, 0x1e,
-		0xe4, 0x76, 0xe0, 0xe8, 0x06, 0x5b, 0xaa, 0x82, 0x1f, 0xac, 0xc4, 0x4e, 0xfc, 0xc1, 0x8a, 0xf7,
-		0x96, 0x78, 0xf0, 0x2d, 0xb7, 0x21, 0x23, 0x94, 0x02, 0x7d, 0x20, 0x78, 0x3e, 0x47, 0x6c, 0x43,
-		0x8d, 0x5d, 0xb0, 0x39, 0x7c, 0xe0, 0x78, 0xce, 0x79, 0x98, 0xe1, 0xc7, 0x1a, 0xfd, 0x58, 0x86,
-		0xff, 0x55, 0x86, 0x12, 0xab, 0x58, 0x17, 0x81, 0x8c, 0xfc, 0xfd, 0x31, 0x90, 0x06, 0xb5, 0xf2,
-		0xdd, 0x6c, 0x00, 0x31, 0xc4, 0x44, 0xfb, 0x03, 0xd7, 0xa2, 0xb3, 0x91, 0x2f, 0x90, 0x52, 0xff,
-		0x62, 0xf4, 0x8f, 0xc6, 0x21, 0x17, 0x48, 0x5b, 0xa2, 0xe7, 0x42, 0xc7, 0x6c, 0x97, 0x8e, 0x4b,
-		0x71, 0x06, 0xce, 0xd9, 0x86, 0x3a, 0x16, 0x3f, 0x79, 0xc7, 0xde, 0xfe, 0x0f, 0x21, 0x46, 0x7f,
-		0x51, 0x35, 0x3d, 0xe6, 0x8b, 0xaa, 0xaf, 0x8d, 0x41, 0xc6, 0x73, 0x17, 0x4e, 0xba, 0x61, 0x71,
-		0x0a, 0x52, 0x7c, 0x45, 0x64, 0x3b, 0x16, 0xfc, 0x69, 0x64, 0xba, 0xb7, 0x0c, 0x19, 0xf1, 0xb7,
-		0xb4, 0x78, 0xf0, 0xeb, 0x3d, 0x9f, 0x7f, 0x11, 0x72, 0x81, 0xbd, 0x23, 0x62, 0x27, 0x36, 0xeb,
-		0x2f, 0x4b, 0x53, 0xe5, 0xf4, 0xdd, 0x7b, 0x4b, 0x89, 0x4d, 0x7c, 0x87, 0xcc, 0x30, 0xa5, 0x5e,
-		0xbb, 0x56, 0xaf, 0xdd, 0x90, 0x62, 0xe5, 0xdc, 0xdd, 0x7b, 0x4b, 0x69, 0x05, 0xd3, 0xa4, 0xdb,
-		0xf9, 0x1b, 0x50, 0x1a, 0x18, 0x98, 0xf0, 0x9a, 0x82, 0xa0, 0xb8, 0xba, 0xb3, 0xbd, 0xde, 0xa8,
-		0x55, 0x5a, 0x75, 0x95, 0x1d, 0x39, 0x44, 0xa7, 0x61, 0x76, 0xbd, 0xb1, 0x76, 0xad, 0xa5, 0xd6,
-		0xd6, 0x1b, 0xf5, 0xcd, 0x96, 0x5a, 0x69, 0xb5, 0x2a, 0xb5, 0x1b, 0x52, 0xfc, 0xe2, 0x6f, 0xe6,
-		0xa0, 0x54, 0xa9, 0xd6, 0x1a, 0xc4, 0x27, 0x30, 0xf8, 0xcd, 0xf6, 0x35, 0x48, 0xd2, 0xf4, 0xc3,
-		0xb1, 0xc7, 0x69, 0xca, 0xc7, 0x67, 0x64, 0xd1, 0x55, 0x98, 0xa6, 0x99, 0x09, 0x74, 0xfc, 0xf9,
-		0x9a, 0x72, 0x44, 0x8a, 0x96, 0x34, 0x86, 0x4e, 0xa7, 0x63, 0x0f, 0xdc, 0x94, 0x8f, 0xcf, 0xd8,
-		0x22, 0x05, 0xb2, 0x7e, 0x64, 0x13, 0x7d, 0x00, 0xa5, 0x3c, 0x81, 0x75, 0x44, 0xeb, 0x90, 0x16,
-		0xc1, 0x68, 0xd4, 0x91, 0x98, 0x72, 0x64, 0x4a, 0x95, 0x88, 0x8b, 0xad, 0xf2, 0xc7, 0x9f, 0xef,
-		0x29, 0x47, 0xe4, 0x87, 0x51, 0xc3, 0xfb, 0xd0, 0x21, 0xe2, 0x98, 0x4b, 0x39, 0x2a, 0x45, 0x4a,
-		0x84, 0xe6, 0xa7, 0x63, 0xa2, 0x4f, 0x2d, 0x95, 0x27, 0x48, 0x7d, 0xa3, 0x1d, 0x80, 0x40, 0x8a,
-		0x60, 0x82, 0xe3, 0x48, 0xe5, 0x49, 0x52, 0xda, 0x68, 0x0b, 0x32, 0x5e, 0xc4, 0x16, 0x79, 0x38,
-		0xa8, 0x1c, 0x9d, 0x5b, 0x46, 0x1f, 0x86, 0x42, 0x38, 0x52, 0x99, 0xec, 0xc8, 0x4f, 0x79, 0xc2,
-		0xa4, 0x31, 0xc1, 0x0f, 0x87, 0x2d, 0x93, 0x1d, 0x01, 0x2a, 0x4f, 0x98, 0x43, 0x46, 0xaf, 0xc3,
-		0xcc, 0x70, 0x58, 0x31, 0xf9, 0x89, 0xa0, 0xf2, 0x09, 0xb2, 0xca, 0xa8, 0x0b, 0x68, 0x44, 0x38,
-		0x72, 0x82, 0x03, 0x42, 0xe5, 0x93, 0x24, 0x99, 0x51, 0x1b, 0x4a, 0x83, 0x3e, 0xfe, 0xa4, 0x07,
-		0x86, 0xca, 0x13, 0x27, 0x9c, 0xd9, 0x5b, 0xc2, 0xb1, 0xc1, 0xa4, 0x07, 0x88, 0xca, 0x13, 0xe7,
-		0x9f, 0xa9, 0xde, 0x0a, 0xf7, 0x3e, 0xf2, 0x40, 0x51, 0x39, 0x3a, 0x0f, 0x5d, 0xad, 0x8c, 0x3d,
-		0x54, 0xfa, 0xd8, 0xb1, 0x87, 0x4a, 0xfd, 0x63, 0xa2, 0xde, 0x41, 0xd2, 0xdf, 0x7e, 0x16, 0xde,
-		0xcb, 0x6f, 0x80, 0x70, 0x5c, 0xed, 0x96, 0x61, 0xee, 0x7b, 0x57, 0x7a, 0xf0, 0x67, 0x7e, 0xa2,
-		0xf4, 0x14, 0xbf, 0x65, 0x42, 0x94, 0x46, 0x5c, 0xec, 0x31, 0xf6, 0xb2, 0xb2, 0xa8, 0x93, 0xdf,
-		0xd1, 0xe7, 0x45, 0x8f, 0xb9, 0x34, 0x24, 0xe2, 0x6a, 0x92, 0x11, 0x97, 0x8a, 0x44, 0x9c, 0x7c,
-		0x3d, 0xee, 0x90, 0xad, 0xfc, 0x2d, 0x31, 0x28, 0x5e, 0x33, 0x1c, 0xd7, 0xb2, 0x0d, 0x5d, 0xeb,
-		0xd0, 0x25, 0xe8, 0xca, 0xa4, 0x5f, 0xe6, 0x54, 0xb3, 0xc4, 0xbb, 0xe1, 0x37, 0x91, 0xf0, 0xc3,
-		0x34, 0xab, 0x90, 0xba, 0xad, 0x75, 0xd8, 0x77, 0x31, 0xc1, 0x3b, 0x83, 0x06, 0x65, 0x1e, 0x70,
-		0xbb, 0x82, 0x28, 0x8c, 0x57, 0xfe, 0x51, 0x7a, 0x72, 0xbe, 0xdb, 0x35, 0x1c, 0xf6, 0xe7, 0xf3,
-		0x5d, 0xec, 0xa0, 0x6d, 0x48, 0xda, 0x9a, 0xcb, 0xa3, 0xa4, 0xea, 0xfb, 0xf8, 0xfd, 0x21, 0x8f,
-		0x46, 0xdf, 0x02, 0xb2, 0x3c, 0x7c, 0xc5, 0x08, 0x45, 0x42, 0x2f, 0x43, 0xa6, 0xab, 0x1d, 0xaa,
-		0x14, 0x35, 0xfe, 0x36, 0xa0, 0xa6, 0xbb, 0xda, 0x21, 0x69, 0x2b, 0x99, 0x92, 0x04, 0x58, 0x3f,
-		0xd0, 0xcc, 0x7d, 0xcc, 0xf0, 0x13, 0x6f, 0x03, 0x7e, 0xa1, 0xab, 0x1d, 0xd6, 0x28, 0x26, 0x79,
-		0xcb, 0x4a, 0xe6, 0x13, 0x9f, 0x5c, 0x9c, 0xa2, 0x07, 0xc0, 0x7f, 0x25, 0xc6, 0x03, 0x62, 0x2a,
-		0x2e, 0xa4, 0x81, 0xa4, 0x7b, 0x4f, 0xf4, 0xf5, 0x62, 0x43, 0xe2, 0xb1, 0x71, 0xa3, 0x31, 0x20,
-		0xec, 0x6a, 0x81, 0x34, 0xf4, 0x33, 0x6f, 0x2d, 0xc6, 0xd8, 0xb8, 0x94, 0xf4, 0x81, 0xc1, 0xb8,
-		0x0e, 0x39, 0x96, 0x07, 0x54, 0xa9, 0x23, 0x1c, 0x8f, 0x74, 0x84, 0x0b, 0xc2, 0x11, 0x66, 0x80,
-		0xc0, 0xb8, 0x49, 0x7d, 0xa0, 0x1f, 0x3f, 0x1a, 0x83, 0xdc, 0x6a, 0xe0, 0x6e, 0xb0, 0x79, 0x48,
-		0x77, 0x2d, 0xd3, 0xb8, 0x85, 0x6d, 0x6f, 0x57, 0x89, 0x3d, 0x12, 0x87, 0x95, 0xfd, 0x89, 0x29,
-		0xf7, 0x48, 0xdc, 0x9b, 0x21, 0x9e, 0x09, 0xd7, 0x1d, 0xbc, 0xeb, 0x18, 0x42, 0xea, 0x8a, 0x78,
-		0x44, 0x8f, 0x83, 0xe4, 0x60, 0xbd, 0x6f, 0x1b, 0xee, 0x91, 0xaa, 0x5b, 0xa6, 0xab, 0xe9, 0x2e,
-		0xcf, 0xfd, 0x96, 0x44, 0x79, 0x8d, 0x15, 0x13, 0x90, 0x36, 0x76, 0x35, 0xa3, 0xc3, 0xce, 0xc8,
-		0x65, 0x15, 0xf1, 0x18, 0x68, 0xee, 0xf7, 0x65, 0x83, 0x81, 0x66, 0x0d, 0x24, 0xab, 0x87, 0xed,
-		0xd0, 0x47, 0x8a, 0x4c, 0x57, 0xe7, 0x7f, 0xeb, 0xa7, 0x9f, 0x9a, 0xe3, 0x82, 0xe7, 0x27, 0x16,
-		0xd8, 0x75, 0xd8, 0x4a, 0x49, 0x70, 0x88, 0xaf, 0x17, 0x5f, 0x0d, 0xed, 0x25, 0xf5, 0x77, 0xfd,
-		0x4b, 0x12, 0xe6, 0x86, 0x84, 0x5b, 0x31, 0x8f, 0xaa, 0xf3, 0xbf, 0xe1, 0x43, 0xfb, 0x81, 0xe8,
-		0x0d, 0x7c, 0x14, 0xdc, 0x58, 0xa2, 0x30, 0x24, 0x1e, 0x78, 0x5d, 0x33, 0x3a, 0xe2, 0x2f, 0xf2,
-		0x29, 0xfc, 0x09, 0xad, 0x78, 0x89, 0xa4, 0x24, 0x0d, 0xae, 0xe4, 0x71, 0x3a, 0x52, 0xb5, 0xcc,
-		0x76, 0x38, 0x67, 0x84, 0x5a, 0x90, 0x72, 0xad, 0x5b, 0xd8, 0xe4, 0x42, 0x3a, 0x91, 0x7e, 0x0f,
-		0xdf, 0x18, 0xc4, 0xb1, 0xd0, 0x3e, 0x48, 0x6d, 0xdc, 0xc1, 0xfb, 0xec, 0x13, 0xbb, 0x03, 0xcd,
-		0xc6, 0xec, 0xb3, 0xdb, 0x2f, 0x77, 0xfe, 0x94, 0x3c, 0xd4, 0x26, 0x05, 0x45, 0xdb, 0xe1, 0xdb,
-		0xe9, 0xd2, 0xfc, 0x64, 0xc2, 0x98, 0xfe, 0x07, 0x34, 0x33, 0x68, 0xb3, 0x42, 0xb7, 0xd9, 0x3d,
-		0x0e, 0x52, 0xdf, 0xdc, 0xb5, 0x4c, 0xfa, 0x47, 0xae, 0x78, 0xb8, 0x96, 0x61, 0x7b, 0x8f, 0x5e,
-		0x39, 0xdf, 0x7b, 0xdc, 0x86, 0xa2, 0x4f, 0x4a, 0x67, 0x51, 0xf6, 0xa4, 0xb3, 0xa8, 0xe0, 0x01,
-		0x10, 0x12, 0xb4, 0x01, 0xe0, 0xcf, 0x53, 0xef, 0x84, 0x43, 0xe4, 0x8c, 0x0f, 0x76, 0x26, 0x00,
-		0x80, 0x3a, 0x30, 0xdb, 0x35, 0x4c, 0xd5, 0xc1, 0x9d, 0x3d, 0x95, 0x4b, 0x8e, 0xe0, 0xe6, 0xde,
-		0x86, 0x91, 0x9e, 0xe9, 0x1a, 0x66, 0x13, 0x77, 0xf6, 0x56, 0x3d, 0x58, 0xf4, 0x3e, 0x38, 0xeb,
-		0x8b, 0xc3, 0x32, 0xd5, 0x03, 0xab, 0xd3, 0x56, 0x6d, 0xbc, 0xa7, 0xea, 0xf4, 0xc2, 0xaa, 0x3c,
-		0x15, 0xe2, 0x69, 0x8f, 0x64, 0xcb, 0xbc, 0x66, 0x75, 0xda, 0x0a, 0xde, 0xab, 0x91, 0x6a, 0xf4,
-		0x30, 0xf8, 0xb2, 0x50, 0x8d, 0xb6, 0x33, 0x5f, 0x58, 0x4a, 0x9c, 0x4b, 0x2a, 0x79, 0xaf, 0xb0,
-		0xd1, 0x76, 0x50, 0x95, 0x4c, 0x7f, 0xd6, 0x99, 0xd0, 0xb7, 0xf0, 0xc7, 0xcd, 0xd0, 0xa2, 0xc3,
-		0x9a, 0x29, 0x26, 0x68, 0x05, 0x4a, 0x36, 0xee, 0x68, 0x47, 0x81, 0x33, 0x49, 0xa5, 0x28, 0x08,
-		0xce, 0x20, 0x20, 0xd6, 0x00, 0xe9, 0x07, 0x5a, 0xa7, 0x83, 0xd9, 0x1f, 0xf7, 0xe0, 0x28, 0x52,
-		0x04, 0xca, 0x8c, 0xcf, 0x23, 0x80, 0x4e, 0x43, 0x7a, 0xb7, 0xe3, 0xd0, 0xbc, 0xd4, 0x0c, 0x0d,
-		0xda, 0x53, 0xbb, 0x1d, 0xe7, 0x06, 0x3e, 0x5a, 0xc9, 0x7f, 0xec, 0x93, 0x8b, 0x53, 0xdc, 0x4c,
-		0x4d, 0xc9, 0xdb, 0xf4, 0x2e, 0x19, 0xce, 0x84, 0x1d, 0x74, 0x19, 0xb2, 0x9a, 0x78, 0x60, 0x5f,
-		0x21, 0x1d, 0xf3, 0x5a, 0x9f, 0x94, 0x19, 0xbe, 0x37, 0x7f, 0x77, 0x29, 0x26, 0x7f, 0x5f, 0x0c,
-		0x52, 0xab, 0x37, 0xb7, 0x35, 0xc3, 0x46, 0x75, 0x98, 0xf1, 0xe7, 0xea, 0xa4, 0x66, 0xcf, 0x9f,
-		0xde, 0xa2, 0x2b, 0xf5, 0x71, 0x9f, 0x78, 0x1f, 0x0b, 0x33, 0xf8, 0xf1, 0xf7, 0x40, 0xc7, 0xaf,
-		0x43, 0x9a, 0xb5, 0xd2, 0x41, 0x2f, 0xc1, 0x74, 0x8f, 0xfc, 0xe0, 0x5b, 0x37, 0x0b, 0x63, 0xe7,
-		0x38, 0xa5, 0x0f, 0xce, 0x08, 0xc6, 0x27, 0xff, 0x55, 0x0c, 0x60, 0xf5, 0xe6, 0xcd, 0x96, 0x6d,
-		0xf4, 0x3a, 0xd8, 0x7d, 0xbb, 0xba, 0xbd, 0x0e, 0x0f, 0x04, 0x3e, 0x26, 0xb6, 0xf5, 0x89, 0xbb,
-		0x3e, 0xeb, 0x7f, 0x4e, 0x6c, 0xeb, 0x23, 0xd1, 0xda, 0x8e, 0xeb, 0xa1, 0x25, 0x26, 0x46, 0x5b,
-		0x75, 0xdc, 0xd1, 0xb2, 0x7c, 0x05, 0x72, 0x7e, 0xf7, 0x1d, 0xd4, 0x80, 0x8c, 0xcb, 0x7f, 0x73,
-		0x91, 0xca, 0xe3, 0x45, 0x2a, 0xd8, 0x82, 0x62, 0xf5, 0xd8, 0xe5, 0xbf, 0x26, 0x92, 0xf5, 0xed,
-		0xc0, 0xdf, 0x28, 0x85, 0x22, 0x0b, 0x1c, 0x5f, 0x80, 0xde, 0x0e, 0x07, 0x8e, 0x63, 0x0d, 0x88,
-		0xf6, 0x63, 0x71, 0x98, 0xdd, 0x11, 0x76, 0xea, 0x6f, 0xac, 0x24, 0x76, 0x20, 0x8d, 0x4d, 0xd7,
-		0x36, 0xb0, 0xd8, 0xaf, 0x7d, 0x7a, 0xdc, 0x80, 0x8f, 0xe8, 0x0b, 0xfd, 0x93, 0xee, 0xc1, 0xe1,
-		0x17, 0x58, 0x03, 0xa2, 0xf8, 0xe5, 0x04, 0xcc, 0x8f, 0x63, 0x47, 0x8f, 0x41, 0x49, 0xb7, 0x31,
-		0x2d, 0x50, 0x43, 0xfb, 0x0b, 0x45, 0x51, 0xcc, 0x57, 0x56, 0x05, 0x88, 0xbf, 0x4a, 0xb4, 0x8b,
-		0x90, 0xde, 0x9f, 0x83, 0x5a, 0xf4, 0x11, 0xe8, 0xda, 0x8a, 0xa1, 0x24, 0xbe, 0x89, 0xd8, 0xd5,
-		0x3a, 0x9a, 0xa9, 0xdf, 0x8f, 0x4b, 0x3f, 0xbc, 0x10, 0x8a, 0x0f, 0x2d, 0xaa, 0x0c, 0x13, 0xdd,
-		0x84, 0xb4, 0x80, 0x4f, 0xbe, 0x0d, 0xf0, 0x02, 0x0c, 0x3d, 0x04, 0xf9, 0xe0, 0xfa, 0x48, 0xdd,
-		0xb5, 0xa4, 0x92, 0x0b, 0x2c, 0x8f, 0x51, 0x0b, 0x70, 0xea, 0xd8, 0x05, 0x38, 0xe0, 0x15, 0xff,
-		0xeb, 0x04, 0xcc, 0x28, 0xb8, 0xfd, 0xb7, 0x70, 0xf0, 0xbe, 0x02, 0x80, 0x4d, 0x70, 0x62, 0x7c,
-		0xef, 0x63, 0xfc, 0x86, 0x0d, 0x46, 0x96, 0xe1, 0xad, 0x3a, 0xee, 0xbb, 0x39, 0x82, 0xff, 0x21,
-		0x0e, 0xf9, 0xe0, 0x08, 0xfe, 0x2d, 0x58, 0xed, 0xd0, 0xa6, 0x6f, 0xde, 0xd8, 0xd7, 0x0b, 0x8f,
-		0x8f, 0x33, 0x6f, 0x43, 0xba, 0x3d, 0x81, 0x5d, 0xfb, 0xba, 0x24, 0xa4, 0xf8, 0xf9, 0xbe, 0xad,
-		0x21, 0xb7, 0x3f, 0x16, 0xf5, 0x11, 0x7a, 0x41, 0x7c, 0x84, 0x3e, 0xd2, 0xeb, 0x7f, 0x04, 0x8a,
-		0x5d, 0xed, 0x50, 0x0d, 0x1d, 0x1a, 0x8c, 0x9d, 0x2b, 0xd0, 0x6c, 0x81, 0x7f, 0xa2, 0x1e, 0x2d,
-		0x42, 0x8e, 0x90, 0xf9, 0x36, 0x9c, 0xd0, 0x40, 0x57, 0x3b, 0xac, 0xb3, 0x12, 0xf4, 0x14, 0xa0,
-		0x03, 0x2f, 0x11, 0xa4, 0xfa, 0xc2, 0x20, 0x74, 0x33, 0x7e, 0x8d, 0x20, 0x7f, 0x10, 0x80, 0xb4,
-		0x42, 0x65, 0xd7, 0xcc, 0xf2, 0xbb, 0x7f, 0x49, 0xc9, 0x2a, 0xbd, 0x6a, 0xf6, 0xab, 0x59, 0xf0,
-		0x30, 0x90, 0x87, 0xe0, 0x61, 0xdc, 0xfa, 0xc9, 0x26, 0xc5, 0x5f, 0xbc, 0xb5, 0x58, 0x3e, 0xd2,
-		0xba, 0x9d, 0x15, 0x79, 0x04, 0xa4, 0x4c, 0x83, 0x89, 0x70, 0xfe, 0x62, 0x5c, 0xe8, 0x92, 0x7e,
-		0x47, 0x42, 0x97, 0x95, 0x73, 0x62, 0xe6, 0xdc, 0xfd, 0xc3, 0x1f, 0x3f, 0x7f, 0x36, 0x80, 0x71,
-		0xe8, 0xe5, 0x23, 0xd9, 0xe0, 0xcb, 0x3f, 0x16, 0x03, 0xe4, 0x33, 0x7a, 0x1f, 0x21, 0x6c, 0xd0,
-		0xa3, 0xe9, 0xa2, 0x95, 0xb1, 0xe3, 0x03, 0x37, 0x9f, 0x3f, 0x14, 0xb8, 0x05, 0xa6, 0xeb, 0x07,
-		0xfc, 0x45, 0x44, 0x5c, 0x70, 0x30, 0xe2, 0x3a, 0xe3, 0xe5, 0x9a, 0x65, 0x84, 0x20, 0x04, 0x93,
-		0x67, 0x09, 0xa6, 0xe4, 0xb7, 0x62, 0x70, 0x66, 0x48, 0xdf, 0xbd, 0x66, 0xeb, 0x80, 0xec, 0x40,
-		0x25, 0xd5, 0x19, 0xb1, 0x45, 0x7e, 0x7f, 0xd3, 0x67, 0xc6, 0x1e, 0x5a, 0x38, 0xde, 0xa1, 0x15,
-		0x91, 0x5f, 0x6c, 0xfc, 0xeb, 0x31, 0x98, 0x0b, 0xb6, 0xc8, 0xeb, 0x5b, 0x13, 0xf2, 0xc1, 0xb6,
-		0xf0, 0x5e, 0xbd, 0x77, 0x92, 0x5e, 0x05, 0x3b, 0x14, 0x02, 0x21, 0x7d, 0x11, 0xf3, 0x8a, 0x65,
-		0x47, 0x9f, 0x99, 0x58, 0x4a, 0xde, 0x56, 0xd2, 0x28, 0x63, 0x93, 0xa4, 0x83, 0xf5, 0x4d, 0x71,
-		0x48, 0x6e, 0x5b, 0x56, 0x07, 0x7d, 0x6d, 0x0c, 0x66, 0x4c, 0xcb, 0x55, 0xc9, 0x6c, 0xc4, 0x6d,
-		0x95, 0x67, 0x68, 0x98, 0xbd, 0xbe, 0x79, 0x32, 0xe9, 0xfd, 0xd1, 0x5b, 0x8b, 0xc3, 0x50, 0xa3,
-		0x6e, 0xa2, 0x2e, 0x99, 0x96, 0x5b, 0xa5, 0x44, 0x2d, 0x96, 0xc4, 0xb9, 0x03, 0x85, 0xf0, 0xfb,
-		0x99, 0x91, 0x57, 0x4e, 0xfc, 0xfe, 0x42, 0xe4, 0xbb, 0xf3, 0xbb, 0x81, 0x17, 0xb3, 0xfb, 0x60,
-		0xff, 0x8c, 0x0c, 0xee, 0xab, 0x20, 0xdd, 0x1c, 0x3c, 0xd0, 0x58, 0x87, 0xf4, 0x49, 0xcf, 0x46,
-		0x06, 0x25, 0xce, 0x79, 0xcf, 0xff, 0x4c, 0x0c, 0xc0, 0xcf, 0x87, 0xa1, 0x27, 0xe1, 0x74, 0x75,
-		0x6b, 0x73, 0x55, 0x6d, 0xb6, 0x2a, 0xad, 0x9d, 0x66, 0xf8, 0xaf, 0x0f, 0x88, 0x2b, 0x77, 0x9c,
-		0x1e, 0xd6, 0x8d, 0x3d, 0x03, 0xb7, 0xd1, 0xa3, 0x30, 0x17, 0xa6, 0x26, 0x4f, 0xf5, 0x55, 0x29,
-		0x56, 0xce, 0xdf, 0xbd, 0xb7, 0x94, 0x61, 0x0e, 0x30, 0x6e, 0xa3, 0x73, 0xf0, 0xc0, 0x30, 0x5d,
-		0x63, 0x73, 0x4d, 0x8a, 0x97, 0x0b, 0x77, 0xef, 0x2d, 0x65, 0x3d, 0x4f, 0x19, 0xc9, 0x80, 0x82,
-		0x94, 0x1c, 0x2f, 0x51, 0x86, 0xbb, 0xf7, 0x96, 0x52, 0x6c, 0x58, 0xca, 0xc9, 0x8f, 0x7d, 0xef,
-		0xc2, 0xd4, 0xf9, 0xaf, 0x04, 0x68, 0x98, 0x7b, 0xb6, 0x46, 0xff, 0x04, 0x37, 0x2a, 0xc3, 0xa9,
-		0xc6, 0xe6, 0x55, 0xa5, 0x52, 0x6b, 0x35, 0xb6, 0x36, 0x07, 0xfe, 0x68, 0x42, 0xb8, 0x6e, 0x75,
-		0x6b, 0xa7, 0xba, 0x5e, 0x57, 0x9b, 0x8d, 0xb5, 0x4d, 0xb6, 0x49, 0x1f, 0xaa, 0x7b, 0x79, 0xb3,
-		0xd5, 0xd8, 0xa8, 0x4b, 0xf1, 0xea, 0xd5, 0xb1, 0xbb, 0x3a, 0x4f, 0x1e, 0x3b, 0xe0, 0xbe, 0xa5,
-		0x0c, 0x6d, 0xed, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x24, 0x72, 0x83, 0x88, 0x9d,
+		// 12498 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x69, 0x90, 0x1c, 0xc7,
+		0x75, 0x20, 0x8c, 0xbe, 0xbb, 0x5f, 0xf7, 0x4c, 0xd7, 0xe4, 0x0c, 0x80, 0x46, 0x43, 0x04, 0x86,
+		0x45, 0x91, 0x04, 0x41, 0x72, 0x48, 0x82, 0x04, 0x48, 0x0c, 0x75, 0xf5, 0x05, 0x60, 0x80, 0xb9,
+		0x54, 0x3d, 0x03, 0x1e, 0xb6, 0x54, 0xaa, 0xe9, 0xce, 0x99, 0x69, 0xa2, 0xbb, 0xaa, 0xd5, 0x55,
+		0x0d, 0x60, 0x18, 0x8e, 0x2f, 0x68, 0xeb, 0xb3, 0x4d, 0xd3, 0xc7, 0x27, 0x1f, 0x61, 0xd3, 0x07,
+		0x6c, 0xc9, 0xa7, 0x6c, 0xcb, 0xdf, 0xe7, 0xeb, 0xf3, 0xda, 0xbb, 0xb1, 0x5e, 0xdb, 0xbb, 0xf6,
+		0xda, 0x5e, 0xc7, 0xae, 0xec, 0x88, 0xdd, 0x75, 0x38, 0xbc, 0xf4, 0xae, 0xe4, 0x10, 0x29, 0x5a,
+		0x3e, 0x97, 0x8e, 0xdd, 0x0d, 0x85, 0xad, 0x8d, 0x97, 0x47, 0x1d, 0x7d, 0x4c, 0xf5, 0x40, 0xa0,
+		0x82, 0x1b, 0xfe, 0x03, 0x4c, 0x66, 0xbe, 0xf7, 0x2a, 0xf3, 0xe5, 0xcb, 0x97, 0xef, 0xbd, 0x7c,
+		0x99, 0x0d, 0x7f, 0xf5, 0x3c, 0xcc, 0xef, 0x58, 0xd6, 0x4e, 0x9b, 0x3e, 0xd2, 0xed, 0x59, 0x8e,
+		0xb5, 0xd5, 0xdf, 0x7e, 0xa4, 0x49, 0xed, 0x46, 0xaf, 0xd5, 0x75, 0xac, 0xde, 0x02, 0xab, 0x23,
+		0x79, 0x0e, 0xb1, 0x20, 0x21, 0xd4, 0x15, 0x98, 0xb9, 0xd0, 0x6a, 0xd3, 0xaa, 0x0b, 0x58, 0xa7,
+		0x0e, 0x79, 0x0a, 0xe2, 0xdb, 0xad, 0x36, 0x2d, 0x44, 0xe6, 0x63, 0xa7, 0xb2, 0x67, 0xde, 0xbd,
+		0x30, 0x80, 0xb4, 0x10, 0xc4, 0x58, 0xc7, 0x6a, 0x8d, 0x61, 0xa8, 0xaf, 0x26, 0x60, 0x76, 0x44,
+		0x2b, 0x21, 0x10, 0x37, 0x8d, 0x0e, 0x52, 0x8c, 0x9c, 0xca, 0x68, 0xec, 0x6f, 0x52, 0x80, 0x54,
+		0xd7, 0x68, 0x5c, 0x33, 0x76, 0x68, 0x21, 0xca, 0xaa, 0x65, 0x91, 0x9c, 0x00, 0x68, 0xd2, 0x2e,
+		0x35, 0x9b, 0xd4, 0x6c, 0xec, 0x15, 0x62, 0xf3, 0xb1, 0x53, 0x19, 0xcd, 0x57, 0x43, 0x1e, 0x84,
+		0x99, 0x6e, 0x7f, 0xab, 0xdd, 0x6a, 0xe8, 0x3e, 0x30, 0x98, 0x8f, 0x9d, 0x4a, 0x68, 0x0a, 0x6f,
+		0xa8, 0x7a, 0xc0, 0xf7, 0x43, 0xfe, 0x06, 0x35, 0xae, 0xf9, 0x41, 0xb3, 0x0c, 0x74, 0x1a, 0xab,
+		0x7d, 0x80, 0x15, 0xc8, 0x75, 0xa8, 0x6d, 0x1b, 0x3b, 0x54, 0x77, 0xf6, 0xba, 0xb4, 0x10, 0x67,
+		0xa3, 0x9f, 0x1f, 0x1a, 0xfd, 0xe0, 0xc8, 0xb3, 0x02, 0x6b, 0x63, 0xaf, 0x4b, 0x49, 0x09, 0x32,
+		0xd4, 0xec, 0x77, 0x38, 0x85, 0xc4, 0x18, 0xfe, 0xd5, 0xcc, 0x7e, 0x67, 0x90, 0x4a, 0x1a, 0xd1,
+		0x04, 0x89, 0x94, 0x4d, 0x7b, 0xd7, 0x5b, 0x0d, 0x5a, 0x48, 0x32, 0x02, 0xf7, 0x0f, 0x11, 0xa8,
+		0xf3, 0xf6, 0x41, 0x1a, 0x12, 0x8f, 0x54, 0x20, 0x43, 0x6f, 0x3a, 0xd4, 0xb4, 0x5b, 0x96, 0x59,
+		0x48, 0x31, 0x22, 0xf7, 0x8e, 0x98, 0x45, 0xda, 0x6e, 0x0e, 0x92, 0xf0, 0xf0, 0xc8, 0x39, 0x48,
+		0x59, 0x5d, 0xa7, 0x65, 0x99, 0x76, 0x21, 0x3d, 0x1f, 0x39, 0x95, 0x3d, 0xf3, 0xae, 0x91, 0x82,
+		0xb0, 0xc6, 0x61, 0x34, 0x09, 0x4c, 0x96, 0x40, 0xb1, 0xad, 0x7e, 0xaf, 0x41, 0xf5, 0x86, 0xd5,
+		0xa4, 0x7a, 0xcb, 0xdc, 0xb6, 0x0a, 0x19, 0x46, 0xe0, 0xe4, 0xf0, 0x40, 0x18, 0x60, 0xc5, 0x6a,
+		0xd2, 0x25, 0x73, 0xdb, 0xd2, 0xa6, 0xed, 0x40, 0x99, 0x1c, 0x81, 0xa4, 0xbd, 0x67, 0x3a, 0xc6,
+		0xcd, 0x42, 0x8e, 0x49, 0x88, 0x28, 0x91, 0x33, 0x90, 0xa2, 0xcd, 0x16, 0x7e, 0xae, 0x30, 0x3d,
+		0x1f, 0x39, 0x35, 0x7d, 0xa6, 0x30, 0xcc, 0x63, 0xde, 0xae, 0x49, 0x40, 0xf5, 0xd7, 0x92, 0x90,
+		0x9f, 0x44, 0x2c, 0x9f, 0x86, 0xc4, 0x36, 0x72, 0xa6, 0x10, 0x3d, 0x08, 0xdf, 0x38, 0x4e, 0x90,
+		0xf1, 0xc9, 0xdb, 0x64, 0x7c, 0x09, 0xb2, 0x26, 0xb5, 0x1d, 0xda, 0xe4, 0x52, 0x14, 0x9b, 0x50,
+		0x0e, 0x81, 0x23, 0x0d, 0x8b, 0x61, 0xfc, 0xb6, 0xc4, 0xf0, 0x59, 0xc8, 0xbb, 0x5d, 0xd2, 0x7b,
+		0x86, 0xb9, 0x23, 0xe5, 0xf9, 0x91, 0xb0, 0x9e, 0x2c, 0xd4, 0x24, 0x9e, 0x86, 0x68, 0xda, 0x34,
+		0x0d, 0x94, 0x49, 0x15, 0xc0, 0x32, 0xa9, 0xb5, 0xad, 0x37, 0x69, 0xa3, 0x5d, 0x48, 0x8f, 0xe1,
+		0xd2, 0x1a, 0x82, 0x0c, 0x71, 0xc9, 0xe2, 0xb5, 0x8d, 0x36, 0x39, 0xef, 0x89, 0x67, 0x6a, 0x8c,
+		0x74, 0xad, 0xf0, 0x85, 0x39, 0x24, 0xa1, 0x9b, 0x30, 0xdd, 0xa3, 0xb8, 0x56, 0x68, 0x53, 0x8c,
+		0x2c, 0xc3, 0x3a, 0xb1, 0x10, 0x3a, 0x32, 0x4d, 0xa0, 0xf1, 0x81, 0x4d, 0xf5, 0xfc, 0x45, 0x72,
+		0x0f, 0xb8, 0x15, 0x3a, 0x13, 0x2b, 0x60, 0x9a, 0x2b, 0x27, 0x2b, 0x57, 0x8d, 0x0e, 0x2d, 0xbe,
+		0x08, 0xd3, 0x41, 0xf6, 0x90, 0x39, 0x48, 0xd8, 0x8e, 0xd1, 0x73, 0x98, 0x14, 0x26, 0x34, 0x5e,
+		0x20, 0x0a, 0xc4, 0xa8, 0xd9, 0x64, 0x9a, 0x31, 0xa1, 0xe1, 0x9f, 0xe4, 0x03, 0xde, 0x80, 0x63,
+		0x6c, 0xc0, 0xf7, 0x0d, 0xcf, 0x68, 0x80, 0xf2, 0xe0, 0xb8, 0x8b, 0x4f, 0xc2, 0x54, 0x60, 0x00,
+		0x93, 0x7e, 0x5a, 0xfd, 0x83, 0x38, 0x1c, 0x1e, 0x49, 0x9b, 0x3c, 0x0b, 0x73, 0x7d, 0xb3, 0x65,
+		0x3a, 0xb4, 0xd7, 0xed, 0x51, 0x14, 0x59, 0xfe, 0xad, 0xc2, 0xeb, 0xa9, 0x31, 0x42, 0xb7, 0xe9,
+		0x87, 0xe6, 0x54, 0xb4, 0xd9, 0xfe, 0x70, 0x25, 0x79, 0x0e, 0xb2, 0x28, 0x1f, 0x46, 0xcf, 0x60,
+		0x04, 0xf9, 0x6a, 0x3c, 0x33, 0xd9, 0x90, 0x17, 0xaa, 0x1e, 0x66, 0x39, 0xf6, 0x72, 0x24, 0xaa,
+		0xf9, 0x69, 0x91, 0x27, 0x21, 0xbd, 0x4d, 0x0d, 0xa7, 0xdf, 0xa3, 0x76, 0xe1, 0x0c, 0x63, 0xe5,
+		0xf1, 0xe1, 0x45, 0xca, 0x01, 0xea, 0xd4, 0xd1, 0x5c, 0x60, 0xd2, 0x81, 0xdc, 0x75, 0xda, 0x6b,
+		0x6d, 0xb7, 0x1a, 0xbc, 0x53, 0x31, 0xa6, 0x7c, 0x9e, 0x9a, 0xb0, 0x53, 0x57, 0x7d, 0xa8, 0x75,
+		0xc7, 0x70, 0xe8, 0x22, 0x6c, 0xae, 0x5e, 0xad, 0x69, 0x4b, 0x17, 0x96, 0x6a, 0x55, 0xde, 0xcd,
+		0x00, 0xf9, 0xe2, 0xf7, 0x44, 0x20, 0xeb, 0x1b, 0x09, 0xaa, 0x43, 0xb3, 0xdf, 0xd9, 0xa2, 0x3d,
+		0x31, 0x5f, 0xa2, 0x44, 0x8e, 0x43, 0x66, 0xbb, 0xdf, 0x6e, 0x73, 0xa1, 0xe3, 0x7b, 0x69, 0x1a,
+		0x2b, 0x50, 0xe0, 0x50, 0xc7, 0x09, 0x35, 0xc2, 0x74, 0x1c, 0xfe, 0x4d, 0x8a, 0x90, 0x96, 0x42,
+		0x59, 0x48, 0xcc, 0x47, 0x4e, 0xa5, 0x35, 0xb7, 0xcc, 0xdb, 0xba, 0xd4, 0x70, 0x68, 0xb3, 0x90,
+		0x94, 0x6d, 0xbc, 0x7c, 0x39, 0x9e, 0x8e, 0x2b, 0x09, 0xf5, 0x09, 0x98, 0x19, 0x1a, 0x0a, 0xc9,
+		0x43, 0xb6, 0x5a, 0xab, 0x2c, 0x97, 0xb4, 0xd2, 0xc6, 0xd2, 0xda, 0xaa, 0x72, 0x88, 0x4c, 0x83,
+		0x6f, 0x74, 0x4a, 0xe4, 0x74, 0x26, 0xfd, 0x46, 0x4a, 0x79, 0xe9, 0xa5, 0x97, 0x5e, 0x8a, 0xaa,
+		0xbf, 0x99, 0x84, 0xb9, 0x51, 0x4a, 0x70, 0xa4, 0x3e, 0xf6, 0x06, 0x1d, 0x0b, 0x0c, 0xba, 0x04,
+		0x89, 0xb6, 0xb1, 0x45, 0xdb, 0x85, 0x38, 0x9b, 0x84, 0x07, 0x27, 0x52, 0xb3, 0x0b, 0xcb, 0x88,
+		0xa2, 0x71, 0x4c, 0xf2, 0x3e, 0xc1, 0x9a, 0x04, 0xa3, 0x70, 0x7a, 0x32, 0x0a, 0xa8, 0x1c, 0x05,
+		0x1b, 0x8f, 0x43, 0x06, 0xff, 0xe7, 0x7c, 0x4f, 0x72, 0xbe, 0x63, 0x05, 0xe3, 0x7b, 0x11, 0xd2,
+		0x4c, 0xef, 0x35, 0xa9, 0x3b, 0x27, 0xb2, 0x8c, 0x9a, 0xa2, 0x49, 0xb7, 0x8d, 0x7e, 0xdb, 0xd1,
+		0xaf, 0x1b, 0xed, 0x3e, 0x65, 0x1a, 0x2c, 0xa3, 0xe5, 0x44, 0xe5, 0x55, 0xac, 0x23, 0x27, 0x21,
+		0xcb, 0xd5, 0x64, 0xcb, 0x6c, 0xd2, 0x9b, 0x6c, 0x0b, 0x4d, 0x68, 0x5c, 0x73, 0x2e, 0x61, 0x0d,
+		0x7e, 0xfe, 0x05, 0xdb, 0x32, 0xa5, 0xae, 0x61, 0x9f, 0xc0, 0x0a, 0xf6, 0xf9, 0x27, 0x07, 0x77,
+		0xef, 0xbb, 0x46, 0x0f, 0x6f, 0x48, 0x39, 0xde, 0x0f, 0x79, 0x06, 0xf1, 0xb8, 0x58, 0xca, 0x46,
+		0xbb, 0x30, 0xc3, 0xc4, 0x60, 0x9a, 0x57, 0xaf, 0x89, 0x5a, 0xf5, 0x57, 0xa2, 0x10, 0x67, 0x3b,
+		0x45, 0x1e, 0xb2, 0x1b, 0xcf, 0xad, 0xd7, 0xf4, 0xea, 0xda, 0x66, 0x79, 0xb9, 0xa6, 0x44, 0x70,
+		0xea, 0x59, 0xc5, 0x85, 0xe5, 0xb5, 0xd2, 0x86, 0x12, 0x75, 0xcb, 0x4b, 0xab, 0x1b, 0xe7, 0x9e,
+		0x50, 0x62, 0x2e, 0xc2, 0x26, 0xaf, 0x88, 0xfb, 0x01, 0x1e, 0x3f, 0xa3, 0x24, 0x88, 0x02, 0x39,
+		0x4e, 0x60, 0xe9, 0xd9, 0x5a, 0xf5, 0xdc, 0x13, 0x4a, 0x32, 0x58, 0xf3, 0xf8, 0x19, 0x25, 0x45,
+		0xa6, 0x20, 0xc3, 0x6a, 0xca, 0x6b, 0x6b, 0xcb, 0x4a, 0xda, 0xa5, 0x59, 0xdf, 0xd0, 0x96, 0x56,
+		0x2f, 0x2a, 0x19, 0x97, 0xe6, 0x45, 0x6d, 0x6d, 0x73, 0x5d, 0x01, 0x97, 0xc2, 0x4a, 0xad, 0x5e,
+		0x2f, 0x5d, 0xac, 0x29, 0x59, 0x17, 0xa2, 0xfc, 0xdc, 0x46, 0xad, 0xae, 0xe4, 0x02, 0xdd, 0x7a,
+		0xfc, 0x8c, 0x32, 0xe5, 0x7e, 0xa2, 0xb6, 0xba, 0xb9, 0xa2, 0x4c, 0x93, 0x19, 0x98, 0xe2, 0x9f,
+		0x90, 0x9d, 0xc8, 0x0f, 0x54, 0x9d, 0x7b, 0x42, 0x51, 0xbc, 0x8e, 0x70, 0x2a, 0x33, 0x81, 0x8a,
+		0x73, 0x4f, 0x28, 0x44, 0xad, 0x40, 0x82, 0x89, 0x21, 0x21, 0x30, 0xbd, 0x5c, 0x2a, 0xd7, 0x96,
+		0xf5, 0xb5, 0x75, 0x5c, 0x34, 0xa5, 0x65, 0x25, 0xe2, 0xd5, 0x69, 0xb5, 0xf5, 0x5a, 0x69, 0xa3,
+		0x56, 0x55, 0x62, 0xfe, 0xba, 0x0f, 0x6e, 0x2e, 0x69, 0xb5, 0xaa, 0x12, 0x55, 0x1b, 0x30, 0x37,
+		0x6a, 0x87, 0x1c, 0xb9, 0x84, 0x7c, 0xb2, 0x10, 0x1d, 0x23, 0x0b, 0x8c, 0xd6, 0xa0, 0x2c, 0xa8,
+		0x9f, 0x8b, 0xc2, 0xec, 0x08, 0x2b, 0x61, 0xe4, 0x47, 0xde, 0x0f, 0x09, 0x2e, 0xcb, 0x5c, 0x53,
+		0x3f, 0x30, 0xd2, 0xdc, 0x60, 0x92, 0x3d, 0x64, 0x3b, 0x31, 0x3c, 0xbf, 0xbd, 0x19, 0x1b, 0x63,
+		0x6f, 0x22, 0x89, 0x21, 0x81, 0xfd, 0xd0, 0xd0, 0x6e, 0xce, 0x0d, 0x9e, 0x73, 0x93, 0x18, 0x3c,
+		0xac, 0xee, 0x60, 0xbb, 0x7a, 0x62, 0xc4, 0xae, 0xfe, 0x34, 0xcc, 0x0c, 0x11, 0x9a, 0x78, 0x77,
+		0xfd, 0x58, 0x04, 0x0a, 0xe3, 0x98, 0x13, 0xa2, 0x12, 0xa3, 0x01, 0x95, 0xf8, 0xf4, 0x20, 0x07,
+		0xef, 0x1e, 0x3f, 0x09, 0x43, 0x73, 0xfd, 0x53, 0x11, 0x38, 0x32, 0xda, 0xaf, 0x18, 0xd9, 0x87,
+		0xf7, 0x41, 0xb2, 0x43, 0x9d, 0x5d, 0x4b, 0xda, 0xc9, 0xf7, 0x8d, 0xb0, 0xbe, 0xb0, 0x79, 0x70,
+		0xb2, 0x05, 0x16, 0x39, 0x3f, 0xd8, 0xd7, 0x93, 0xe3, 0xbc, 0x9c, 0xa1, 0x9e, 0x7e, 0x4b, 0x14,
+		0x0e, 0x8f, 0x24, 0x3e, 0xb2, 0xa3, 0x77, 0x01, 0xb4, 0xcc, 0x6e, 0xdf, 0xe1, 0xb6, 0x30, 0xd7,
+		0xc4, 0x19, 0x56, 0xc3, 0x94, 0x17, 0x6a, 0xd9, 0xbe, 0xe3, 0xb6, 0xf3, 0x5d, 0x12, 0x78, 0x15,
+		0x03, 0x78, 0xca, 0xeb, 0x68, 0x9c, 0x75, 0xf4, 0xc4, 0x98, 0x91, 0x0e, 0x09, 0xe6, 0xa3, 0xa0,
+		0x34, 0xda, 0x2d, 0x6a, 0x3a, 0xba, 0xed, 0xf4, 0xa8, 0xd1, 0x69, 0x99, 0x3b, 0x7c, 0xb7, 0x5d,
+		0x4c, 0x6c, 0x1b, 0x6d, 0x9b, 0x6a, 0x79, 0xde, 0x5c, 0x97, 0xad, 0x88, 0xc1, 0x04, 0xa8, 0xe7,
+		0xc3, 0x48, 0x06, 0x30, 0x78, 0xb3, 0x8b, 0xa1, 0x7e, 0x5f, 0x06, 0xb2, 0x3e, 0x2f, 0x8c, 0xdc,
+		0x0d, 0xb9, 0x17, 0x8c, 0xeb, 0x86, 0x2e, 0x3d, 0x6b, 0xce, 0x89, 0x2c, 0xd6, 0xad, 0xf3, 0x2a,
+		0xf2, 0x28, 0xcc, 0x31, 0x10, 0xab, 0xef, 0xd0, 0x9e, 0xde, 0x68, 0x1b, 0xb6, 0xcd, 0x98, 0x96,
+		0x66, 0xa0, 0x04, 0xdb, 0xd6, 0xb0, 0xa9, 0x22, 0x5b, 0xc8, 0x59, 0x98, 0x65, 0x18, 0x9d, 0x7e,
+		0xdb, 0x69, 0x75, 0xdb, 0x54, 0x47, 0x5f, 0xdf, 0x2e, 0x80, 0xbf, 0x67, 0x33, 0x08, 0xb1, 0x22,
+		0x00, 0xb0, 0x47, 0x36, 0xa9, 0xc2, 0x5d, 0x0c, 0x6d, 0x87, 0x9a, 0xb4, 0x67, 0x38, 0x54, 0xa7,
+		0x1f, 0xed, 0x1b, 0x6d, 0x5b, 0x37, 0xcc, 0xa6, 0xbe, 0x6b, 0xd8, 0xbb, 0x85, 0x39, 0x24, 0x50,
+		0x8e, 0x16, 0x22, 0xda, 0x31, 0x04, 0xbc, 0x28, 0xe0, 0x6a, 0x0c, 0xac, 0x64, 0x36, 0x2f, 0x19,
+		0xf6, 0x2e, 0x59, 0x84, 0x23, 0x8c, 0x8a, 0xed, 0xf4, 0x5a, 0xe6, 0x8e, 0xde, 0xd8, 0xa5, 0x8d,
+		0x6b, 0x7a, 0xdf, 0xd9, 0x7e, 0xaa, 0x70, 0xdc, 0xff, 0x7d, 0xd6, 0xc3, 0x3a, 0x83, 0xa9, 0x20,
+		0xc8, 0xa6, 0xb3, 0xfd, 0x14, 0xa9, 0x43, 0x0e, 0x27, 0xa3, 0xd3, 0x7a, 0x91, 0xea, 0xdb, 0x56,
+		0x8f, 0xed, 0xa1, 0xd3, 0x23, 0x54, 0x93, 0x8f, 0x83, 0x0b, 0x6b, 0x02, 0x61, 0xc5, 0x6a, 0xd2,
+		0xc5, 0x44, 0x7d, 0xbd, 0x56, 0xab, 0x6a, 0x59, 0x49, 0xe5, 0x82, 0xd5, 0x43, 0x81, 0xda, 0xb1,
+		0x5c, 0x06, 0x67, 0xb9, 0x40, 0xed, 0x58, 0x92, 0xbd, 0x67, 0x61, 0xb6, 0xd1, 0xe0, 0x63, 0x6e,
+		0x35, 0x74, 0xe1, 0x91, 0xdb, 0x05, 0x25, 0xc0, 0xac, 0x46, 0xe3, 0x22, 0x07, 0x10, 0x32, 0x6e,
+		0x93, 0xf3, 0x70, 0xd8, 0x63, 0x96, 0x1f, 0x71, 0x66, 0x68, 0x94, 0x83, 0xa8, 0x67, 0x61, 0xb6,
+		0xbb, 0x37, 0x8c, 0x48, 0x02, 0x5f, 0xec, 0xee, 0x0d, 0xa2, 0xdd, 0xcb, 0xa2, 0x2c, 0x3d, 0xda,
+		0x60, 0xa6, 0xde, 0x51, 0x3f, 0xb4, 0xaf, 0x81, 0x2c, 0x80, 0xd2, 0x68, 0xe8, 0xd4, 0x34, 0xb6,
+		0xda, 0x54, 0x37, 0x7a, 0xd4, 0x34, 0xec, 0xc2, 0x49, 0x06, 0x1c, 0x77, 0x7a, 0x7d, 0xaa, 0x4d,
+		0x37, 0x1a, 0x35, 0xd6, 0x58, 0x62, 0x6d, 0xe4, 0x34, 0xcc, 0x58, 0x5b, 0x2f, 0x34, 0xb8, 0x60,
+		0xe9, 0xdd, 0x1e, 0xdd, 0x6e, 0xdd, 0x2c, 0xbc, 0x9b, 0x71, 0x29, 0x8f, 0x0d, 0x4c, 0xac, 0xd6,
+		0x59, 0x35, 0x79, 0x00, 0x94, 0x86, 0xbd, 0x6b, 0xf4, 0xba, 0x4c, 0xb3, 0xda, 0x5d, 0xa3, 0x41,
+		0x0b, 0xf7, 0x72, 0x50, 0x5e, 0xbf, 0x2a, 0xab, 0x51, 0xb0, 0xed, 0x1b, 0xad, 0x6d, 0x47, 0x52,
+		0xbc, 0x9f, 0x0b, 0x36, 0xab, 0x13, 0xd4, 0x4e, 0x81, 0xd2, 0xdd, 0xed, 0x06, 0x3f, 0x7c, 0x8a,
+		0x81, 0x4d, 0x77, 0x77, 0xbb, 0xfe, 0xef, 0xde, 0x03, 0x53, 0xdd, 0x5d, 0xff, 0x47, 0x1f, 0xe0,
+		0xf6, 0x57, 0x77, 0xd7, 0xf7, 0xc5, 0x27, 0xe0, 0x08, 0x02, 0x75, 0xa8, 0x63, 0x34, 0x0d, 0xc7,
+		0xf0, 0x41, 0x3f, 0xc4, 0xa0, 0xe7, 0xba, 0xbb, 0xdd, 0x15, 0xd1, 0x18, 0xe8, 0x67, 0xaf, 0xbf,
+		0xb5, 0xe7, 0xca, 0xc7, 0xc3, 0xbc, 0x9f, 0x58, 0x27, 0x25, 0xe4, 0xb6, 0xdd, 0x8f, 0xb7, 0xcd,
+		0xd9, 0x52, 0x17, 0x21, 0xe7, 0x97, 0x7b, 0x92, 0x01, 0x2e, 0xf9, 0x4a, 0x04, 0x8d, 0xa0, 0xca,
+		0x5a, 0x15, 0xcd, 0x97, 0xe7, 0x6b, 0x4a, 0x14, 0xcd, 0xa8, 0xe5, 0xa5, 0x8d, 0x9a, 0xae, 0x6d,
+		0xae, 0x6e, 0x2c, 0xad, 0xd4, 0x94, 0x98, 0xcf, 0xb0, 0xbf, 0x1c, 0x4f, 0x9f, 0x56, 0x1e, 0xbc,
+		0x1c, 0x4f, 0xdf, 0xa7, 0xdc, 0xaf, 0xbe, 0x15, 0x83, 0xe9, 0xa0, 0x03, 0x4e, 0xde, 0x03, 0x47,
+		0x65, 0x84, 0xcd, 0xa6, 0x8e, 0x7e, 0xa3, 0xd5, 0x63, 0xcb, 0xb2, 0x63, 0xf0, 0x2d, 0xd2, 0x15,
+		0xbf, 0x39, 0x01, 0x55, 0xa7, 0xce, 0x33, 0xad, 0x1e, 0x2e, 0xba, 0x8e, 0xe1, 0x90, 0x65, 0x38,
+		0x69, 0x5a, 0xba, 0xed, 0x18, 0x66, 0xd3, 0xe8, 0x35, 0x75, 0x2f, 0xb6, 0xa9, 0x1b, 0x8d, 0x06,
+		0xb5, 0x6d, 0x8b, 0x6f, 0x87, 0x2e, 0x95, 0x77, 0x99, 0x56, 0x5d, 0x00, 0x7b, 0xfb, 0x44, 0x49,
+		0x80, 0x0e, 0x48, 0x7f, 0x6c, 0x9c, 0xf4, 0x1f, 0x87, 0x4c, 0xc7, 0xe8, 0xea, 0xd4, 0x74, 0x7a,
+		0x7b, 0xcc, 0x4a, 0x4f, 0x6b, 0xe9, 0x8e, 0xd1, 0xad, 0x61, 0x99, 0x5c, 0x85, 0xfb, 0x3c, 0x50,
+		0xbd, 0x4d, 0x77, 0x8c, 0xc6, 0x9e, 0xce, 0x4c, 0x72, 0x16, 0x0d, 0xd2, 0x1b, 0x96, 0xb9, 0xdd,
+		0x6e, 0x35, 0x1c, 0xbb, 0x90, 0x75, 0x35, 0x9d, 0xea, 0x61, 0x2c, 0x33, 0x84, 0xcb, 0xb6, 0x65,
+		0x32, 0x4b, 0xbc, 0x22, 0xa1, 0x03, 0x02, 0x92, 0x7b, 0x47, 0x08, 0x48, 0x70, 0x92, 0xe3, 0x4a,
+		0xe2, 0x72, 0x3c, 0x9d, 0x50, 0x92, 0x97, 0xe3, 0xe9, 0xa4, 0x92, 0xba, 0x1c, 0x4f, 0xa7, 0x95,
+		0xcc, 0xe5, 0x78, 0x3a, 0xa3, 0x80, 0xfa, 0x73, 0x00, 0x39, 0xbf, 0x63, 0x81, 0x7e, 0x5a, 0x83,
+		0x6d, 0xad, 0x11, 0xa6, 0x7c, 0xef, 0xd9, 0xd7, 0x0d, 0x59, 0xa8, 0xe0, 0x9e, 0xbb, 0x98, 0xe4,
+		0x56, 0xbc, 0xc6, 0x31, 0xd1, 0xde, 0xc1, 0xe5, 0x44, 0xb9, 0xd5, 0x94, 0xd6, 0x44, 0x89, 0x5c,
+		0x84, 0xe4, 0x0b, 0x36, 0xa3, 0x9d, 0x64, 0xb4, 0xdf, 0xbd, 0x3f, 0xed, 0xcb, 0x75, 0x46, 0x3c,
+		0x73, 0xb9, 0xae, 0xaf, 0xae, 0x69, 0x2b, 0xa5, 0x65, 0x4d, 0xa0, 0x93, 0x63, 0x10, 0x6f, 0x1b,
+		0x2f, 0xee, 0x05, 0x77, 0x67, 0x56, 0x45, 0x16, 0x20, 0xdf, 0x37, 0xb9, 0x57, 0x8e, 0x73, 0x8c,
+		0x50, 0x79, 0x3f, 0xd4, 0xb4, 0xd7, 0xba, 0x8c, 0xf0, 0x13, 0xca, 0xd5, 0x31, 0x88, 0x63, 0xf8,
+		0x39, 0xb8, 0x87, 0xb2, 0x2a, 0x72, 0x0a, 0x72, 0x4d, 0xba, 0xd5, 0xdf, 0xd1, 0x7b, 0xb4, 0x69,
+		0x34, 0x9c, 0xe0, 0xce, 0x91, 0x65, 0x4d, 0x1a, 0x6b, 0x21, 0x57, 0x20, 0x83, 0x73, 0x64, 0xb2,
+		0x39, 0x9e, 0x61, 0x2c, 0x78, 0x78, 0x7f, 0x16, 0x88, 0x29, 0x96, 0x48, 0x9a, 0x87, 0x4f, 0x2e,
+		0x41, 0xca, 0x31, 0x7a, 0x3b, 0xd4, 0xb1, 0x0b, 0xb3, 0xf3, 0xb1, 0x53, 0xd3, 0x67, 0x16, 0x26,
+		0x21, 0xb5, 0xc1, 0x50, 0x98, 0x4f, 0x2c, 0xd1, 0xc9, 0x33, 0xa0, 0x88, 0xa0, 0xab, 0x2e, 0x1c,
+		0x5a, 0xbb, 0x30, 0xc7, 0x04, 0xf0, 0xa1, 0xfd, 0x49, 0x8a, 0x98, 0x6d, 0x95, 0x23, 0x69, 0x79,
+		0x1a, 0x28, 0x07, 0xd7, 0xc5, 0xe1, 0x77, 0xc4, 0xba, 0x28, 0x3e, 0x0f, 0xd3, 0xc1, 0x5e, 0xfb,
+		0x63, 0xd3, 0xb1, 0x09, 0x63, 0xd3, 0xe8, 0x29, 0x48, 0xdf, 0x09, 0x77, 0x0b, 0x5e, 0x50, 0x1f,
+		0x81, 0x04, 0x5b, 0x0e, 0x04, 0x40, 0x2c, 0x08, 0xe5, 0x10, 0x49, 0x43, 0xbc, 0xb2, 0xa6, 0xa1,
+		0x62, 0x56, 0x20, 0xc7, 0x6b, 0xf5, 0xf5, 0xa5, 0x5a, 0xa5, 0xa6, 0x44, 0xd5, 0xb3, 0x90, 0xe4,
+		0x32, 0x8e, 0x4a, 0xdb, 0x95, 0x72, 0xe5, 0x90, 0x28, 0x0a, 0x1a, 0x11, 0xd9, 0xba, 0xb9, 0x52,
+		0xae, 0x69, 0x4a, 0x54, 0xdd, 0x84, 0xfc, 0x80, 0x5c, 0x90, 0xc3, 0x30, 0xa3, 0xd5, 0x36, 0x6a,
+		0xab, 0xe8, 0x96, 0xea, 0x9b, 0xab, 0x57, 0x56, 0xd7, 0x9e, 0xc1, 0x98, 0x4e, 0xa0, 0x5a, 0xee,
+		0x00, 0x11, 0x32, 0x07, 0x8a, 0x57, 0x5d, 0x5f, 0xdb, 0xd4, 0x58, 0x6f, 0xbe, 0x2d, 0x0a, 0xca,
+		0xa0, 0x90, 0x90, 0xa3, 0x30, 0xbb, 0x51, 0xd2, 0x2e, 0xd6, 0x36, 0x74, 0xee, 0x6a, 0xbb, 0xa4,
+		0xe7, 0x40, 0xf1, 0x37, 0x5c, 0x58, 0x62, 0x91, 0x84, 0x93, 0x70, 0xdc, 0x5f, 0x5b, 0x7b, 0x76,
+		0xa3, 0xb6, 0x5a, 0x67, 0x1f, 0x2f, 0xad, 0x5e, 0xc4, 0xed, 0x68, 0x80, 0x9e, 0x74, 0xee, 0x63,
+		0xd8, 0xd5, 0x20, 0xbd, 0xda, 0x72, 0x55, 0x89, 0x0f, 0x56, 0xaf, 0xad, 0xd6, 0xd6, 0x2e, 0x28,
+		0x89, 0xc1, 0xaf, 0x33, 0x87, 0x3f, 0x49, 0x8a, 0x70, 0x64, 0xb0, 0x56, 0xaf, 0xad, 0x6e, 0x68,
+		0xcf, 0x29, 0xa9, 0xc1, 0x0f, 0xd7, 0x6b, 0xda, 0xd5, 0xa5, 0x4a, 0x4d, 0x49, 0x93, 0x23, 0x40,
+		0x82, 0x3d, 0xda, 0xb8, 0xb4, 0x56, 0x55, 0x32, 0xa3, 0x34, 0x28, 0x51, 0x66, 0xd5, 0x4f, 0x47,
+		0x20, 0xe7, 0x77, 0xbe, 0x03, 0x42, 0x1e, 0x79, 0xa7, 0x29, 0x7f, 0xf5, 0x0f, 0xa3, 0x90, 0xf5,
+		0x79, 0xe1, 0xe8, 0x3e, 0x19, 0xed, 0xb6, 0x75, 0x43, 0x37, 0xda, 0x2d, 0xc3, 0x16, 0xfa, 0x19,
+		0x58, 0x55, 0x09, 0x6b, 0x26, 0xd5, 0x87, 0x93, 0x6f, 0xa5, 0xc9, 0xdb, 0xde, 0x4a, 0x53, 0xef,
+		0xc0, 0xad, 0x34, 0xa1, 0x24, 0xd5, 0xaf, 0x8f, 0x82, 0x32, 0xe8, 0x97, 0x0f, 0xf0, 0x2d, 0x32,
+		0x8e, 0x6f, 0xfe, 0xf1, 0x45, 0x0f, 0x32, 0xbe, 0xc1, 0x5d, 0x26, 0x36, 0x76, 0x97, 0xf9, 0xaa,
+		0xc8, 0xd5, 0x7f, 0x8c, 0xc0, 0x74, 0xd0, 0xdf, 0x0f, 0x0c, 0x4d, 0x3d, 0xc8, 0xd0, 0x82, 0xac,
+		0xbb, 0x7b, 0x1c, 0xeb, 0xbe, 0x2a, 0xe3, 0xfa, 0xfe, 0x18, 0x4c, 0x05, 0xc2, 0x03, 0x93, 0xf6,
+		0xee, 0xa3, 0x30, 0xd3, 0x6a, 0xd2, 0x4e, 0xd7, 0x72, 0xf0, 0x70, 0x5a, 0x6f, 0xd3, 0xeb, 0xb4,
+		0xcd, 0xd8, 0x30, 0x3d, 0xe2, 0x00, 0x2e, 0xf0, 0x85, 0x85, 0x25, 0x0f, 0x6f, 0x19, 0xd1, 0x16,
+		0x67, 0x97, 0xaa, 0xb5, 0x95, 0xf5, 0xb5, 0x8d, 0xda, 0x6a, 0xe5, 0x39, 0xa9, 0x72, 0x35, 0xa5,
+		0x35, 0x00, 0x16, 0x60, 0xf8, 0x3d, 0xef, 0x0c, 0xbf, 0x64, 0x1d, 0x94, 0xc1, 0xd1, 0xa0, 0xe6,
+		0x1d, 0x31, 0x1e, 0xe5, 0x10, 0x99, 0x85, 0xfc, 0xea, 0x9a, 0x5e, 0x5f, 0xaa, 0xd6, 0xf4, 0xda,
+		0x85, 0x0b, 0xb5, 0xca, 0x46, 0x9d, 0xc7, 0xa2, 0x5d, 0xe8, 0x0d, 0x25, 0xea, 0x9f, 0x9b, 0x1f,
+		0x88, 0xc1, 0xec, 0x88, 0x9e, 0x90, 0x92, 0x88, 0x22, 0xf1, 0xc0, 0xd6, 0xc3, 0x93, 0xf4, 0x7e,
+		0x01, 0x1d, 0xc0, 0x75, 0xa3, 0xe7, 0x88, 0xa0, 0xd3, 0x03, 0x80, 0xec, 0x35, 0x1d, 0xb4, 0x0b,
+		0x7b, 0x22, 0xc6, 0xcf, 0x43, 0x4b, 0x79, 0xaf, 0x9e, 0x87, 0xf9, 0x1f, 0x02, 0xd2, 0xb5, 0xec,
+		0x96, 0xd3, 0xba, 0x8e, 0x67, 0xe5, 0xf2, 0x40, 0x00, 0x43, 0x4d, 0x71, 0x4d, 0x91, 0x2d, 0x4b,
+		0xa6, 0xe3, 0x42, 0x9b, 0x74, 0xc7, 0x18, 0x80, 0x46, 0xbb, 0x35, 0xa6, 0x29, 0xb2, 0xc5, 0x85,
+		0xbe, 0x1b, 0x72, 0x4d, 0xab, 0x8f, 0x8e, 0x3b, 0x87, 0x43, 0xdd, 0x19, 0xd1, 0xb2, 0xbc, 0xce,
+		0x05, 0x11, 0x91, 0x15, 0xef, 0x24, 0x22, 0xa7, 0x65, 0x79, 0x1d, 0x07, 0xb9, 0x1f, 0xf2, 0xc6,
+		0xce, 0x4e, 0x0f, 0x89, 0x4b, 0x42, 0x3c, 0x56, 0x34, 0xed, 0x56, 0x33, 0xc0, 0xe2, 0x65, 0x48,
+		0x4b, 0x3e, 0xa0, 0xe3, 0x84, 0x9c, 0xd0, 0xbb, 0x3c, 0x00, 0x1a, 0xc5, 0xc3, 0x09, 0x53, 0x36,
+		0xde, 0x0d, 0xb9, 0x96, 0xad, 0x7b, 0x27, 0xe5, 0xd1, 0xf9, 0xe8, 0xa9, 0xb4, 0x96, 0x6d, 0xd9,
+		0xee, 0xc1, 0x99, 0xfa, 0x6d, 0x00, 0xe0, 0x09, 0x1b, 0xf9, 0xd6, 0x08, 0x4c, 0xf3, 0x9d, 0xa0,
+		0xdb, 0xa3, 0x36, 0x35, 0x1b, 0xd2, 0x9f, 0x78, 0x60, 0x1f, 0x11, 0xe5, 0xd6, 0xe5, 0xba, 0x40,
+		0x28, 0x9f, 0x7f, 0x39, 0x12, 0x79, 0x35, 0x12, 0x7f, 0x35, 0x12, 0xf9, 0xd1, 0xc8, 0x14, 0x49,
+		0xd7, 0x9e, 0x5d, 0x5f, 0x5e, 0xaa, 0x2c, 0x6d, 0x14, 0x3e, 0x9f, 0x62, 0xe5, 0xa5, 0x15, 0x51,
+		0x7e, 0x3d, 0x15, 0x6c, 0x7f, 0x23, 0xa5, 0x4d, 0x6d, 0xfb, 0x29, 0x91, 0x6d, 0xff, 0xf1, 0x7a,
+		0x74, 0x9c, 0xef, 0xe1, 0xf5, 0xa3, 0x26, 0x0e, 0xd5, 0xcb, 0xf7, 0xb0, 0x2e, 0x24, 0x59, 0x17,
+		0xb2, 0x24, 0x59, 0x59, 0x5e, 0xab, 0xd7, 0xaa, 0xac, 0x03, 0x19, 0x12, 0x5f, 0x5b, 0xaf, 0xad,
+		0x16, 0x5e, 0x4f, 0xf9, 0xce, 0xe0, 0xbf, 0x2b, 0x02, 0x47, 0xe5, 0xe1, 0x9b, 0xd8, 0x08, 0xa9,
+		0xd9, 0xb0, 0x9a, 0x18, 0x17, 0xe4, 0xc6, 0xe5, 0x63, 0xfb, 0x7d, 0x56, 0x13, 0xa8, 0x8c, 0x0d,
+		0x35, 0x81, 0x58, 0xbe, 0x7f, 0x88, 0x0d, 0xa5, 0xd5, 0xaa, 0xe8, 0x45, 0x96, 0x24, 0xd7, 0x4b,
+		0x95, 0x2b, 0xb5, 0x2a, 0xf6, 0xe3, 0x70, 0x6f, 0x14, 0x3e, 0xb9, 0x09, 0x79, 0x0c, 0xbf, 0xa1,
+		0x24, 0xb4, 0x9a, 0xfc, 0x1c, 0x34, 0x3e, 0xee, 0x00, 0xcd, 0xeb, 0x0b, 0xc6, 0xe3, 0xae, 0xba,
+		0x18, 0xe5, 0x7b, 0x7c, 0x9d, 0xc8, 0x90, 0xf8, 0xea, 0xda, 0x6a, 0x4d, 0x76, 0x80, 0x9d, 0x19,
+		0x3e, 0x87, 0x1d, 0x98, 0xee, 0x07, 0x90, 0xc8, 0x4d, 0x50, 0x64, 0xfc, 0xc0, 0x65, 0x43, 0x62,
+		0xdc, 0xe9, 0x9f, 0xf7, 0x69, 0x11, 0x85, 0x70, 0x19, 0x30, 0xef, 0xfb, 0xf6, 0x1c, 0xc9, 0x2f,
+		0xd7, 0x56, 0x2f, 0x6e, 0x5c, 0xd2, 0xd7, 0xb5, 0x1a, 0x3b, 0xc4, 0x29, 0x7c, 0x3e, 0xa5, 0xe5,
+		0x3b, 0x41, 0x14, 0xf2, 0x75, 0x90, 0xe5, 0xc6, 0x08, 0x8f, 0x56, 0x70, 0x77, 0xf3, 0xbe, 0xfd,
+		0x3e, 0xca, 0x6c, 0x11, 0x06, 0x5d, 0x7e, 0x9c, 0x7d, 0x2f, 0x26, 0xe7, 0xfd, 0x28, 0x21, 0xcb,
+		0xb5, 0x8b, 0xa5, 0xca, 0x73, 0x7a, 0xb9, 0x56, 0xdf, 0x40, 0x55, 0xb5, 0xa6, 0x71, 0x21, 0x04,
+		0x92, 0x28, 0x2d, 0x2f, 0xaf, 0x3d, 0x83, 0x63, 0x87, 0x17, 0x5c, 0x02, 0xea, 0xd7, 0xc2, 0x54,
+		0x40, 0x92, 0xd1, 0x30, 0x65, 0x06, 0x2d, 0x76, 0xba, 0x5e, 0x5b, 0xad, 0xf8, 0x0d, 0xe9, 0x1c,
+		0xb8, 0x92, 0xab, 0x44, 0xb0, 0x24, 0xe5, 0x5a, 0x89, 0xa2, 0x86, 0x14, 0x9f, 0x76, 0x4f, 0x92,
+		0x62, 0xea, 0x93, 0x90, 0x96, 0xf2, 0x89, 0xe6, 0x31, 0xb3, 0x72, 0x07, 0x8c, 0xf3, 0x34, 0x30,
+		0xe1, 0x54, 0x22, 0xe8, 0x8a, 0x70, 0xa1, 0x55, 0xa2, 0xea, 0x55, 0x38, 0x3c, 0x52, 0xc2, 0xc8,
+		0x3d, 0x70, 0x52, 0x9e, 0x5e, 0x71, 0xc3, 0x5b, 0xaf, 0xad, 0x56, 0xd6, 0xaa, 0xe8, 0xaa, 0x78,
+		0x34, 0x01, 0x84, 0xa8, 0xf1, 0x5e, 0x4a, 0x31, 0x54, 0xa2, 0x6a, 0x05, 0xa6, 0x83, 0xd2, 0x42,
+		0x8e, 0xc3, 0xd1, 0xcd, 0x8d, 0x0b, 0x4f, 0xe9, 0x57, 0x4b, 0xcb, 0x4b, 0xd5, 0xd2, 0x80, 0x53,
+		0x02, 0x20, 0x44, 0x46, 0x89, 0x62, 0x47, 0x51, 0x94, 0x94, 0x98, 0x5a, 0x87, 0xfc, 0xc0, 0xbc,
+		0x93, 0x77, 0x41, 0x41, 0xf8, 0x07, 0xa3, 0xfa, 0x33, 0x0b, 0x83, 0x92, 0xc0, 0x3d, 0xa5, 0x6a,
+		0x6d, 0x79, 0x69, 0x65, 0x69, 0x83, 0xf5, 0xec, 0x12, 0x80, 0x37, 0xaf, 0xb8, 0x11, 0x5d, 0xae,
+		0xaf, 0xad, 0xea, 0x17, 0xd0, 0xcd, 0xda, 0xf0, 0x91, 0xca, 0x00, 0x9f, 0x47, 0x25, 0x82, 0xde,
+		0xc0, 0xf0, 0x64, 0x2b, 0xd1, 0xd3, 0x49, 0xdc, 0x86, 0xbe, 0x90, 0x3a, 0x9d, 0x4c, 0x7f, 0x21,
+		0xa5, 0xbc, 0x89, 0xff, 0xbf, 0x99, 0x52, 0xfe, 0x02, 0xff, 0xff, 0xd6, 0x55, 0xe5, 0xe3, 0xab,
+		0xa7, 0x93, 0xe9, 0x8f, 0xaf, 0x2a, 0xdf, 0xb9, 0x7a, 0x39, 0x99, 0x7e, 0x3d, 0xa5, 0xbc, 0x91,
+		0x52, 0xff, 0x31, 0x0a, 0xc4, 0x93, 0x2e, 0xd7, 0x23, 0x7e, 0x16, 0xd2, 0xae, 0x8b, 0xcd, 0xb3,
+		0xf5, 0xde, 0xb3, 0x8f, 0x50, 0x4a, 0x34, 0x5f, 0xd5, 0x80, 0xcb, 0xed, 0x52, 0x23, 0x25, 0xc8,
+		0x77, 0x5a, 0x66, 0xab, 0xd3, 0xef, 0xe8, 0xd2, 0x9d, 0x8d, 0x87, 0xb8, 0xb3, 0xd3, 0x02, 0x41,
+		0x94, 0x19, 0x09, 0xe3, 0x66, 0x80, 0x44, 0x22, 0x94, 0x04, 0x47, 0x10, 0xe5, 0xe2, 0x37, 0x47,
+		0xa0, 0x30, 0xae, 0xb3, 0xb7, 0xe5, 0x69, 0xdf, 0xae, 0xbd, 0xac, 0xfe, 0x54, 0x14, 0xa6, 0x83,
+		0xd9, 0x6a, 0xa4, 0x0a, 0xe9, 0xb6, 0x25, 0x32, 0x41, 0x38, 0xf3, 0x4f, 0x85, 0x24, 0xb8, 0x2d,
+		0x2c, 0x0b, 0x78, 0xcd, 0xc5, 0x2c, 0xfe, 0xfb, 0x08, 0xa4, 0x65, 0x35, 0x39, 0x02, 0xf1, 0xae,
+		0xe1, 0xec, 0x32, 0x72, 0x89, 0x72, 0x54, 0x89, 0x68, 0xac, 0x8c, 0xf5, 0x76, 0xd7, 0xe0, 0x59,
+		0x30, 0xa2, 0x1e, 0xcb, 0x68, 0x67, 0xb4, 0xa9, 0xd1, 0x64, 0x07, 0x23, 0x56, 0xa7, 0x43, 0x4d,
+		0xc7, 0x96, 0x76, 0x86, 0xa8, 0xaf, 0x88, 0x6a, 0x4c, 0x9a, 0x74, 0x7a, 0x46, 0xab, 0x1d, 0x80,
+		0x8d, 0x33, 0x58, 0x45, 0x36, 0xb8, 0xc0, 0x8b, 0x70, 0x4c, 0xd2, 0x6d, 0x52, 0xc7, 0x68, 0xec,
+		0xd2, 0xa6, 0x87, 0x94, 0x64, 0x07, 0xa0, 0x47, 0x05, 0x40, 0x55, 0xb4, 0x4b, 0x5c, 0xf5, 0x33,
+		0x51, 0x98, 0x91, 0x47, 0x39, 0x4d, 0x97, 0x59, 0x2b, 0x00, 0x86, 0x69, 0x5a, 0x8e, 0x9f, 0x5d,
+		0xc3, 0xa6, 0xd5, 0x10, 0xde, 0x42, 0xc9, 0x45, 0xd2, 0x7c, 0x04, 0x8a, 0x7f, 0x11, 0x01, 0xf0,
+		0x9a, 0xc6, 0xf2, 0xed, 0x24, 0x64, 0x45, 0x2e, 0x22, 0x4b, 0x68, 0xe5, 0xe1, 0x15, 0xe0, 0x55,
+		0x78, 0xe8, 0x83, 0x91, 0x97, 0x2d, 0xba, 0xd3, 0x32, 0x45, 0x72, 0x09, 0x2f, 0xc8, 0x33, 0xda,
+		0xb8, 0x97, 0x7c, 0xa5, 0x41, 0xda, 0xa6, 0x1d, 0xc3, 0x74, 0x5a, 0x0d, 0x21, 0xc4, 0xe7, 0x0e,
+		0xd4, 0xf9, 0x85, 0xba, 0xc0, 0xd6, 0x5c, 0x3a, 0xea, 0x29, 0x48, 0xcb, 0x5a, 0x57, 0x71, 0x1d,
+		0x22, 0x29, 0x88, 0xd5, 0x6b, 0xa8, 0xba, 0x99, 0x16, 0x59, 0x2a, 0xd5, 0x95, 0xe8, 0xe9, 0xef,
+		0x8a, 0x42, 0x4a, 0xae, 0xaa, 0x59, 0xc8, 0xd7, 0xaa, 0x4b, 0x03, 0x3a, 0x70, 0x16, 0xa6, 0x65,
+		0xe5, 0xba, 0xb6, 0xb6, 0xb1, 0x76, 0x46, 0xf9, 0x7c, 0x6a, 0xa8, 0xf2, 0x71, 0xe5, 0xf5, 0x14,
+		0x99, 0x81, 0x9c, 0xac, 0x3c, 0xf3, 0xe8, 0x99, 0xc7, 0x95, 0x37, 0x06, 0xab, 0x9e, 0x50, 0xbe,
+		0xc0, 0xa2, 0x1b, 0xb2, 0xea, 0x31, 0x7d, 0x03, 0x35, 0xd7, 0xda, 0xea, 0xf2, 0x73, 0x4a, 0xc4,
+		0xdf, 0x70, 0xc6, 0xd7, 0x10, 0x25, 0x77, 0xc1, 0x51, 0xd9, 0x70, 0xfe, 0xfc, 0xf9, 0xf3, 0x4f,
+		0xfa, 0x1a, 0x6f, 0x7d, 0x7b, 0x72, 0xb0, 0xf9, 0x29, 0x5f, 0xf3, 0x0f, 0x0f, 0x37, 0x9f, 0xf7,
+		0x35, 0xff, 0xc8, 0xb7, 0x27, 0xc9, 0x2c, 0x64, 0x65, 0xf3, 0x4a, 0xe9, 0x59, 0xe5, 0xcb, 0x5f,
+		0xfe, 0xf2, 0x97, 0x53, 0xe5, 0xff, 0x0b, 0x66, 0x1b, 0x56, 0x67, 0x70, 0x16, 0xca, 0xca, 0xc0,
+		0xa1, 0xb0, 0x7d, 0x29, 0xf2, 0xfc, 0xc3, 0x02, 0x68, 0xc7, 0x6a, 0x1b, 0xe6, 0xce, 0x82, 0xd5,
+		0xdb, 0xf1, 0x72, 0xa4, 0xd1, 0x86, 0xb3, 0x7d, 0x99, 0xd2, 0xdd, 0xad, 0xff, 0x19, 0x89, 0xfc,
+		0x68, 0x34, 0x76, 0x71, 0xbd, 0xfc, 0x33, 0xd1, 0xe2, 0x45, 0x8e, 0xb8, 0x2e, 0xe7, 0x58, 0xa3,
+		0xdb, 0x6d, 0xda, 0xc0, 0x89, 0x80, 0xbf, 0x7c, 0x10, 0xe6, 0x76, 0xac, 0x1d, 0x8b, 0x51, 0x7a,
+		0x04, 0xff, 0xe2, 0x9d, 0x20, 0x19, 0xb7, 0xb6, 0x18, 0x9a, 0x91, 0xbd, 0xb8, 0x0a, 0xb3, 0x02,
+		0x58, 0x67, 0x26, 0x25, 0x3f, 0xb7, 0x22, 0xfb, 0xe6, 0x3e, 0x14, 0x7e, 0xe1, 0xcf, 0x99, 0xbb,
+		0xae, 0xcd, 0x08, 0x54, 0x6c, 0xe3, 0x47, 0x5b, 0x8b, 0x1a, 0x1c, 0x0e, 0xd0, 0xe3, 0x86, 0x3c,
+		0xed, 0x85, 0x50, 0xfc, 0x6d, 0x41, 0x71, 0xd6, 0x47, 0xb1, 0x2e, 0x50, 0x17, 0x2b, 0x30, 0x75,
+		0x10, 0x5a, 0xbf, 0x23, 0x68, 0xe5, 0xa8, 0x9f, 0xc8, 0x45, 0xc8, 0x33, 0x22, 0x8d, 0xbe, 0xed,
+		0x58, 0x1d, 0xe6, 0x25, 0xed, 0x4f, 0xe6, 0xdf, 0xfe, 0x39, 0xd7, 0x64, 0xd3, 0x88, 0x56, 0x71,
+		0xb1, 0x16, 0x17, 0x81, 0x19, 0xc8, 0x98, 0xd0, 0x17, 0x42, 0xe1, 0x77, 0x45, 0x47, 0x5c, 0xf8,
+		0xc5, 0xab, 0x30, 0x87, 0x7f, 0x33, 0x27, 0xc6, 0xdf, 0x93, 0xf0, 0x44, 0x89, 0xc2, 0x1f, 0x7e,
+		0x8c, 0x2b, 0xcb, 0x59, 0x97, 0x80, 0xaf, 0x4f, 0xbe, 0x59, 0xdc, 0xa1, 0x8e, 0x43, 0x7b, 0xb6,
+		0x6e, 0xb4, 0x47, 0x75, 0xcf, 0x77, 0xd2, 0x5c, 0xf8, 0xfe, 0x2f, 0x06, 0x67, 0xf1, 0x22, 0xc7,
+		0x2c, 0xb5, 0xdb, 0x8b, 0x9b, 0x70, 0x74, 0x84, 0x54, 0x4c, 0x40, 0xf3, 0x07, 0x04, 0xcd, 0xb9,
+		0x21, 0xc9, 0x40, 0xb2, 0xeb, 0x20, 0xeb, 0xdd, 0xb9, 0x9c, 0x80, 0xe6, 0x0f, 0x0a, 0x9a, 0x44,
+		0xe0, 0xca, 0x29, 0x45, 0x8a, 0x97, 0x61, 0xe6, 0x3a, 0xed, 0x6d, 0x59, 0xb6, 0x38, 0xdd, 0x9f,
+		0x80, 0xdc, 0x0f, 0x09, 0x72, 0x79, 0x81, 0xc8, 0x8e, 0xfb, 0x91, 0xd6, 0x79, 0x48, 0x6f, 0x1b,
+		0x0d, 0x3a, 0x01, 0x89, 0x5b, 0x82, 0x44, 0x0a, 0xe1, 0x11, 0xb5, 0x04, 0xb9, 0x1d, 0x4b, 0xf8,
+		0xb1, 0xe1, 0xe8, 0x3f, 0x2c, 0xd0, 0xb3, 0x12, 0x47, 0x90, 0xe8, 0x5a, 0xdd, 0x7e, 0x1b, 0x9d,
+		0xdc, 0x70, 0x12, 0x3f, 0x22, 0x49, 0x48, 0x1c, 0x41, 0xe2, 0x00, 0x6c, 0xfd, 0x84, 0x24, 0x61,
+		0xfb, 0xf8, 0xf9, 0x7e, 0x4c, 0xfa, 0x6b, 0xef, 0x59, 0xe6, 0x24, 0x9d, 0xf8, 0xa4, 0xa0, 0x00,
+		0x02, 0x05, 0x09, 0x3c, 0x0d, 0x99, 0x49, 0x27, 0xe2, 0x27, 0xbe, 0x28, 0x97, 0x87, 0x9c, 0x81,
+		0x8b, 0x90, 0x97, 0x0a, 0x0a, 0x4f, 0x6f, 0xc2, 0x49, 0xfc, 0xa4, 0x20, 0x31, 0xed, 0x43, 0x13,
+		0xc3, 0x70, 0xa8, 0xed, 0xec, 0xd0, 0x49, 0x88, 0xfc, 0x94, 0x1c, 0x86, 0x40, 0x11, 0xac, 0xdc,
+		0xa2, 0x66, 0x63, 0x77, 0x32, 0x0a, 0x9f, 0x92, 0xac, 0x94, 0x38, 0x48, 0xa2, 0x02, 0x53, 0x1d,
+		0xa3, 0x67, 0xef, 0x1a, 0xed, 0x89, 0xa6, 0xe3, 0xa7, 0x05, 0x8d, 0x9c, 0x8b, 0x24, 0x38, 0xd2,
+		0x37, 0x0f, 0x42, 0xe6, 0x67, 0x24, 0x47, 0xfa, 0x66, 0x80, 0xd0, 0x3a, 0xcc, 0xd9, 0x0e, 0xcb,
+		0xa1, 0x38, 0x08, 0xb5, 0x9f, 0x95, 0x4b, 0x8f, 0xe3, 0xae, 0xf8, 0x29, 0x3e, 0x0d, 0x19, 0xbb,
+		0xf5, 0xe2, 0x44, 0x64, 0x3e, 0x2d, 0x67, 0x9a, 0x21, 0x20, 0xf2, 0x73, 0x70, 0x6c, 0xe4, 0x36,
+		0x31, 0x01, 0xb1, 0x9f, 0x13, 0xc4, 0x8e, 0x8c, 0xd8, 0x2a, 0x84, 0x4a, 0x38, 0x28, 0xc9, 0xff,
+		0x57, 0xaa, 0x04, 0x3a, 0x40, 0x6b, 0x1d, 0x23, 0x8b, 0xb6, 0xb1, 0x7d, 0x30, 0xae, 0xfd, 0x7f,
+		0x92, 0x6b, 0x1c, 0x37, 0xc0, 0xb5, 0x0d, 0x38, 0x22, 0x28, 0x1e, 0x6c, 0x5e, 0x7f, 0x5e, 0x2a,
+		0x56, 0x8e, 0xbd, 0x19, 0x9c, 0xdd, 0xaf, 0x81, 0xa2, 0xcb, 0x4e, 0x19, 0xc2, 0xb2, 0x75, 0xcc,
+		0x1c, 0x08, 0xa7, 0xfc, 0x0b, 0x82, 0xb2, 0xd4, 0xf8, 0x6e, 0x0c, 0xcc, 0x5e, 0x31, 0xba, 0x48,
+		0xfc, 0x59, 0x28, 0x48, 0xe2, 0x7d, 0xb3, 0x47, 0x1b, 0xd6, 0x8e, 0xd9, 0x7a, 0x91, 0x36, 0x27,
+		0x20, 0xfd, 0x8b, 0x03, 0x53, 0xb5, 0xe9, 0x43, 0x47, 0xca, 0x4b, 0xa0, 0xb8, 0xb6, 0x8a, 0xde,
+		0xea, 0x74, 0xad, 0x9e, 0x13, 0x42, 0xf1, 0x97, 0xe4, 0x4c, 0xb9, 0x78, 0x4b, 0x0c, 0x6d, 0xb1,
+		0x06, 0x3c, 0x3f, 0x78, 0x52, 0x91, 0xfc, 0x65, 0x41, 0x68, 0xca, 0xc3, 0x12, 0x8a, 0xa3, 0x61,
+		0x75, 0xba, 0x46, 0x6f, 0x12, 0xfd, 0xf7, 0xff, 0x4b, 0xc5, 0x21, 0x50, 0x84, 0xe2, 0x40, 0x8b,
+		0x0e, 0x77, 0xfb, 0x09, 0x28, 0xfc, 0x8a, 0x54, 0x1c, 0x12, 0x47, 0x90, 0x90, 0x06, 0xc3, 0x04,
+		0x24, 0xfe, 0x99, 0x24, 0x21, 0x71, 0x90, 0xc4, 0x07, 0xbd, 0x8d, 0xb6, 0x47, 0x77, 0x5a, 0xb6,
+		0x23, 0x32, 0xf8, 0xf7, 0x27, 0xf5, 0xab, 0x5f, 0x0c, 0x1a, 0x61, 0x9a, 0x0f, 0x15, 0x35, 0x91,
+		0x88, 0x4a, 0xb1, 0xb8, 0x6a, 0x78, 0xc7, 0x7e, 0x4d, 0x6a, 0x22, 0x1f, 0x1a, 0xf6, 0xcd, 0x67,
+		0x21, 0x22, 0xdb, 0x1b, 0xe8, 0xbd, 0x4d, 0x40, 0xee, 0x9f, 0x0f, 0x74, 0xae, 0x2e, 0x71, 0x91,
+		0xa6, 0xcf, 0xfe, 0xe9, 0x9b, 0xd7, 0xe8, 0xde, 0x44, 0xd2, 0xf9, 0x2f, 0x06, 0xec, 0x9f, 0x4d,
+		0x8e, 0xc9, 0x75, 0x48, 0x7e, 0xc0, 0x9e, 0x22, 0x61, 0xd7, 0x7b, 0x0a, 0x5f, 0xff, 0x96, 0x18,
+		0x6f, 0xd0, 0x9c, 0x5a, 0x5c, 0x06, 0x45, 0xd4, 0x78, 0x06, 0x6c, 0x28, 0xb1, 0x8f, 0xbd, 0xe5,
+		0xca, 0x79, 0xc0, 0xe6, 0x59, 0xbc, 0x00, 0x53, 0x01, 0x83, 0x27, 0x9c, 0xd4, 0xff, 0x2d, 0x48,
+		0xe5, 0xfc, 0xf6, 0xce, 0xe2, 0x59, 0x88, 0xa3, 0xf1, 0x12, 0x8e, 0xfe, 0x8d, 0x02, 0x9d, 0x81,
+		0x2f, 0xbe, 0x17, 0xd2, 0xd2, 0x68, 0x09, 0x47, 0xfd, 0x26, 0x81, 0xea, 0xa2, 0x20, 0xba, 0x34,
+		0x58, 0xc2, 0xd1, 0xbf, 0x59, 0xa2, 0x4b, 0x14, 0x44, 0x9f, 0x9c, 0x85, 0xbf, 0xf1, 0xad, 0x71,
+		0x8e, 0x2e, 0x51, 0x16, 0x31, 0x3f, 0x99, 0x5b, 0x2a, 0xe1, 0xd8, 0xdf, 0x22, 0x3e, 0x2e, 0x31,
+		0x16, 0x9f, 0x84, 0xc4, 0x84, 0x0c, 0xff, 0x76, 0x81, 0xca, 0xe1, 0x17, 0x2b, 0x90, 0xf5, 0x59,
+		0x27, 0xe1, 0xe8, 0xdf, 0x21, 0xd0, 0xfd, 0x58, 0xd8, 0x75, 0x61, 0x9d, 0x84, 0x13, 0xf8, 0x7f,
+		0x64, 0xd7, 0x05, 0x06, 0xb2, 0x4d, 0x1a, 0x26, 0xe1, 0xd8, 0x1f, 0x97, 0x5c, 0x97, 0x28, 0x8b,
+		0xef, 0x87, 0x8c, 0xbb, 0xd9, 0x84, 0xe3, 0x7f, 0xa7, 0xc0, 0xf7, 0x70, 0x90, 0x03, 0x7d, 0xf3,
+		0x00, 0x24, 0xbe, 0x4b, 0x72, 0xc0, 0x87, 0x85, 0xcb, 0x68, 0xd0, 0x80, 0x09, 0xa7, 0xf4, 0xdd,
+		0x72, 0x19, 0x0d, 0xd8, 0x2f, 0x38, 0x9b, 0x4c, 0xe7, 0x87, 0x93, 0xf8, 0x1e, 0x39, 0x9b, 0x0c,
+		0x1e, 0xbb, 0x31, 0x68, 0x11, 0x84, 0xd3, 0xf8, 0x3e, 0xd9, 0x8d, 0x01, 0x83, 0x60, 0x71, 0x1d,
+		0xc8, 0xb0, 0x35, 0x10, 0x4e, 0xef, 0x55, 0x41, 0x6f, 0x66, 0xc8, 0x18, 0x58, 0x7c, 0x06, 0x8e,
+		0x8c, 0xb6, 0x04, 0xc2, 0xa9, 0x7e, 0xff, 0x5b, 0x03, 0xbe, 0x9b, 0xdf, 0x10, 0x58, 0xdc, 0x80,
+		0xb9, 0x51, 0x56, 0x40, 0x38, 0xd9, 0x1f, 0x78, 0x2b, 0xa8, 0xb8, 0xfd, 0x46, 0xc0, 0x62, 0x09,
+		0xc0, 0xdb, 0x80, 0xc3, 0x69, 0xfd, 0x90, 0xa0, 0xe5, 0x43, 0xc2, 0xa5, 0x21, 0xf6, 0xdf, 0x70,
+		0xfc, 0x5b, 0x72, 0x69, 0x08, 0x0c, 0x5c, 0x1a, 0x72, 0xeb, 0x0d, 0xc7, 0xfe, 0x61, 0xb9, 0x34,
+		0x24, 0x0a, 0x4a, 0xb6, 0x6f, 0x77, 0x0b, 0xa7, 0xf0, 0x49, 0x29, 0xd9, 0x3e, 0xac, 0xc5, 0x55,
+		0x98, 0x19, 0xda, 0x10, 0xc3, 0x49, 0xfd, 0xa8, 0x20, 0xa5, 0x0c, 0xee, 0x87, 0xfe, 0xcd, 0x4b,
+		0x6c, 0x86, 0xe1, 0xd4, 0x7e, 0x6c, 0x60, 0xf3, 0x12, 0x7b, 0xe1, 0xe2, 0xd3, 0x90, 0x36, 0xfb,
+		0xed, 0x36, 0x2e, 0x1e, 0xb2, 0xff, 0x0d, 0xae, 0xc2, 0x17, 0xbe, 0x24, 0xb8, 0x23, 0x11, 0x16,
+		0xcf, 0x42, 0x82, 0x76, 0xb6, 0x68, 0x33, 0x0c, 0xf3, 0xcd, 0x2f, 0x49, 0x85, 0x89, 0xd0, 0x8b,
+		0xef, 0x07, 0xe0, 0xa1, 0x11, 0x96, 0x1b, 0x19, 0x82, 0xfb, 0x17, 0x5f, 0x12, 0x57, 0x26, 0x3c,
+		0x14, 0x8f, 0x00, 0xbf, 0x80, 0xb1, 0x3f, 0x81, 0x2f, 0x06, 0x09, 0xb0, 0x19, 0x39, 0x0f, 0x29,
+		0x3c, 0xfe, 0x72, 0x8c, 0x9d, 0x30, 0xec, 0xbf, 0x14, 0xd8, 0x12, 0x1e, 0x19, 0xd6, 0xb1, 0x7a,
+		0xd4, 0x31, 0x76, 0xec, 0x30, 0xdc, 0xbf, 0x12, 0xb8, 0x2e, 0x02, 0x22, 0x37, 0x0c, 0xdb, 0x99,
+		0x64, 0xdc, 0x7f, 0x2d, 0x91, 0x25, 0x02, 0x76, 0x1a, 0xff, 0xbe, 0x46, 0xf7, 0xc2, 0x70, 0xff,
+		0x46, 0x76, 0x5a, 0xc0, 0x2f, 0xbe, 0x17, 0x32, 0xf8, 0x27, 0xbf, 0x07, 0x15, 0x82, 0xfc, 0xb7,
+		0x02, 0xd9, 0xc3, 0xc0, 0x2f, 0xdb, 0x4e, 0xd3, 0x69, 0x85, 0x33, 0xfb, 0xef, 0xc4, 0x4c, 0x4b,
+		0xf8, 0xc5, 0x12, 0x64, 0x6d, 0xa7, 0xd9, 0xec, 0x0b, 0xfb, 0x34, 0x04, 0xfd, 0xbf, 0x7f, 0xc9,
+		0x0d, 0x59, 0xb8, 0x38, 0x38, 0xdb, 0x37, 0xae, 0x39, 0x5d, 0x8b, 0x25, 0x45, 0x84, 0x51, 0x78,
+		0x4b, 0x50, 0xf0, 0xa1, 0x2c, 0x56, 0x20, 0x87, 0x63, 0x91, 0xa7, 0xcd, 0x61, 0x24, 0xfe, 0x5e,
+		0x30, 0x20, 0x80, 0x54, 0xfe, 0xc8, 0xef, 0x7e, 0xf6, 0x44, 0xe4, 0x33, 0x9f, 0x3d, 0x11, 0xf9,
+		0xaf, 0x9f, 0x3d, 0x11, 0xf9, 0xf8, 0xe7, 0x4e, 0x1c, 0xfa, 0xcc, 0xe7, 0x4e, 0x1c, 0xfa, 0xe3,
+		0xcf, 0x9d, 0x38, 0x34, 0x3a, 0x4a, 0x0c, 0x17, 0xad, 0x8b, 0x16, 0x8f, 0x0f, 0x3f, 0x7f, 0xef,
+		0x4e, 0xcb, 0xd9, 0xed, 0x6f, 0x2d, 0x34, 0xac, 0xce, 0x23, 0x0d, 0xcb, 0xee, 0x58, 0xf6, 0x23,
+		0xc1, 0xb8, 0x2e, 0xfb, 0x0b, 0xfe, 0x21, 0x02, 0xc7, 0x38, 0x19, 0x2f, 0x9c, 0x6b, 0x98, 0x7b,
+		0xe3, 0x5e, 0xd6, 0x38, 0x07, 0xb1, 0x92, 0xb9, 0x47, 0x8e, 0x71, 0x05, 0xa7, 0xf7, 0x7b, 0x6d,
+		0x71, 0x19, 0x27, 0x85, 0xe5, 0xcd, 0x5e, 0x3b, 0x98, 0xf5, 0x99, 0x13, 0x59, 0x9f, 0xe5, 0xef,
+		0x88, 0x1c, 0x6c, 0x24, 0xe9, 0x92, 0xb9, 0xc7, 0x06, 0xb2, 0x1e, 0x79, 0xfe, 0xa1, 0xd0, 0x38,
+		0xf7, 0x35, 0xd3, 0xba, 0x61, 0x62, 0xb7, 0xbb, 0x5b, 0x32, 0xc6, 0x7d, 0x62, 0x30, 0xc6, 0xfd,
+		0x0c, 0x6d, 0xb7, 0xaf, 0x20, 0x1c, 0x1e, 0x09, 0xdb, 0x5b, 0x49, 0x7e, 0xef, 0x13, 0xbe, 0x3b,
+		0x0a, 0x27, 0x86, 0xc2, 0xd9, 0x42, 0x08, 0xc6, 0x31, 0x61, 0x11, 0xd2, 0x55, 0x29, 0x5b, 0x05,
+		0x7c, 0xd7, 0xa2, 0x61, 0x99, 0x4d, 0x9e, 0xd7, 0x18, 0xd3, 0x64, 0x11, 0x19, 0x61, 0x1a, 0xa6,
+		0x65, 0x8b, 0xeb, 0x6c, 0xbc, 0x50, 0xfe, 0xc1, 0x03, 0x32, 0x62, 0x4a, 0x7e, 0x49, 0x72, 0xe3,
+		0xb1, 0x09, 0xb9, 0x21, 0x07, 0x11, 0x88, 0xfc, 0x4f, 0xca, 0x95, 0xef, 0x8b, 0xc2, 0xc9, 0x41,
+		0xae, 0xe0, 0xca, 0xb2, 0x1d, 0xa3, 0xd3, 0x1d, 0xc7, 0x96, 0xa7, 0x21, 0xb3, 0x21, 0x61, 0x0e,
+		0xcc, 0x97, 0x5b, 0x07, 0xe4, 0xcb, 0xb4, 0xfb, 0x29, 0xc9, 0x98, 0x33, 0x13, 0x32, 0xc6, 0x1d,
+		0xc7, 0x6d, 0x71, 0xe6, 0x7f, 0x25, 0xe1, 0x18, 0x5f, 0x4e, 0x3a, 0x5f, 0x4a, 0xbc, 0x20, 0x78,
+		0x92, 0xf3, 0x37, 0x85, 0x9f, 0x93, 0xa8, 0x57, 0x60, 0x76, 0x09, 0xb5, 0x05, 0x7a, 0x41, 0xde,
+		0x09, 0xcf, 0xc8, 0x1b, 0x7f, 0xf3, 0x01, 0x83, 0x5f, 0x1c, 0xfa, 0xf9, 0xab, 0xd4, 0xaf, 0x8f,
+		0x80, 0x52, 0x6f, 0x18, 0x6d, 0xa3, 0xf7, 0x95, 0x92, 0x22, 0x4f, 0x02, 0xf0, 0xc4, 0x1c, 0xf7,
+		0xad, 0x0e, 0x3c, 0x87, 0xf6, 0x0f, 0x6e, 0x81, 0x7f, 0x89, 0xe5, 0xc8, 0x67, 0x18, 0x2c, 0xfe,
+		0x79, 0xfa, 0x59, 0x00, 0xaf, 0x01, 0x33, 0x1c, 0xea, 0x95, 0xd2, 0x72, 0x49, 0x93, 0xa9, 0x17,
+		0xf5, 0xf5, 0x5a, 0x85, 0x5f, 0x9d, 0x3f, 0x84, 0x49, 0x04, 0xfe, 0x46, 0x37, 0x8f, 0xfb, 0x30,
+		0xcc, 0xf8, 0xeb, 0xf9, 0x3d, 0xe6, 0x28, 0x5a, 0x8a, 0xad, 0x4e, 0xb7, 0x4d, 0xd9, 0x71, 0xac,
+		0xde, 0x92, 0x5c, 0x0b, 0x37, 0x42, 0x7e, 0xef, 0x3f, 0xf1, 0xbb, 0xad, 0xb3, 0x1e, 0xba, 0xcb,
+		0xf3, 0xc5, 0x65, 0x98, 0xc1, 0x7b, 0x36, 0xdd, 0x00, 0xc9, 0x10, 0x55, 0x8d, 0x04, 0xd9, 0x01,
+		0xb3, 0xc0, 0xf4, 0xa8, 0x3d, 0x09, 0x49, 0x9b, 0x8d, 0x3e, 0x8c, 0xc4, 0xef, 0x0b, 0x12, 0x02,
+		0x7c, 0xd1, 0x84, 0x19, 0xfe, 0x94, 0x03, 0xf5, 0x75, 0x63, 0xff, 0x38, 0xc3, 0xbf, 0xfc, 0xa5,
+		0x47, 0xd9, 0x71, 0xf3, 0xdd, 0xc1, 0x69, 0x19, 0x21, 0x4e, 0x9a, 0x22, 0x68, 0x7b, 0x1d, 0xa5,
+		0x30, 0x2d, 0xbf, 0x27, 0x3a, 0xbc, 0xff, 0xc7, 0x7e, 0x5d, 0x7c, 0xec, 0xc4, 0x28, 0x19, 0xf0,
+		0x7d, 0x69, 0x4a, 0x50, 0xe5, 0x0d, 0xe5, 0xda, 0xb8, 0x35, 0xfd, 0xfc, 0x83, 0xc3, 0xbb, 0x13,
+		0xff, 0xef, 0x61, 0x46, 0xf9, 0x69, 0xff, 0x67, 0xdc, 0xb5, 0xf7, 0x27, 0x31, 0x98, 0xc1, 0x0b,
+		0xa2, 0xd6, 0x23, 0xec, 0x5f, 0xb1, 0xe6, 0x12, 0xac, 0x30, 0xc1, 0xa1, 0xe4, 0x39, 0xbe, 0x14,
+		0xc2, 0x25, 0xe6, 0x6f, 0xbf, 0xed, 0x27, 0x13, 0xde, 0x72, 0x59, 0x5c, 0x19, 0x4e, 0xd0, 0x0a,
+		0xa7, 0xf1, 0x77, 0x92, 0xc6, 0x60, 0xd6, 0xd5, 0xe2, 0x7b, 0xf0, 0xa4, 0x4f, 0x90, 0x09, 0xb3,
+		0x4c, 0x24, 0x11, 0x17, 0x03, 0xed, 0x12, 0xbe, 0x32, 0x27, 0xb1, 0x42, 0xdf, 0x92, 0xf8, 0x7c,
+		0x85, 0x62, 0x1a, 0xe3, 0xe2, 0x45, 0x98, 0x6e, 0x5a, 0xa6, 0xa3, 0x5b, 0x9d, 0x96, 0x43, 0x3b,
+		0x5d, 0x27, 0xd4, 0xae, 0xfb, 0x7b, 0x4e, 0x24, 0xad, 0x4d, 0x21, 0xde, 0x9a, 0x44, 0xbb, 0xad,
+		0xc9, 0xb5, 0x9b, 0xd7, 0x84, 0x2e, 0x76, 0x6e, 0xf2, 0x49, 0x74, 0x27, 0xf7, 0xaf, 0x63, 0x70,
+		0x42, 0x00, 0x6f, 0x19, 0x36, 0x7d, 0xe4, 0xfa, 0x63, 0x5b, 0xd4, 0x31, 0x1e, 0x7b, 0xa4, 0x61,
+		0xb5, 0xe4, 0x46, 0x3c, 0xcb, 0xdb, 0x17, 0xb0, 0x7d, 0x41, 0xb4, 0x17, 0x47, 0x9e, 0x56, 0x17,
+		0xc7, 0xeb, 0xe8, 0xe2, 0xb0, 0x08, 0xa9, 0xcf, 0x43, 0xbc, 0x62, 0xb5, 0xd8, 0x8d, 0x95, 0x26,
+		0x35, 0xad, 0x8e, 0xd0, 0x96, 0xbc, 0x40, 0xce, 0x41, 0xd2, 0xe8, 0x58, 0x7d, 0xd3, 0xe1, 0x9a,
+		0xb2, 0x7c, 0xe2, 0x77, 0x5f, 0x3b, 0x79, 0xe8, 0x4f, 0x5e, 0x3b, 0x19, 0x5b, 0x32, 0x9d, 0x3f,
+		0xfa, 0xe5, 0x87, 0x41, 0x50, 0x5f, 0x32, 0x9d, 0x4f, 0xbd, 0xfe, 0xf3, 0xa7, 0x23, 0x9a, 0x80,
+		0x5e, 0x8c, 0xbf, 0xf1, 0x89, 0x93, 0x11, 0xf5, 0x59, 0x48, 0x55, 0x69, 0x63, 0x1f, 0xf2, 0x8f,
+		0x0d, 0x90, 0x3f, 0x26, 0xc9, 0x57, 0x69, 0xc3, 0x47, 0xbe, 0x4a, 0x1b, 0x03, 0x94, 0x9f, 0x84,
+		0xf4, 0x92, 0xe9, 0xf0, 0x3b, 0xe2, 0x0f, 0x42, 0xac, 0x65, 0xf2, 0x0b, 0x87, 0x3e, 0x0a, 0x43,
+		0x1d, 0xd4, 0x10, 0x0a, 0x11, 0xab, 0xb4, 0xe1, 0x22, 0x36, 0x69, 0xa3, 0x10, 0x09, 0xfb, 0x34,
+		0x42, 0x95, 0xab, 0x7f, 0xfc, 0xdf, 0x4e, 0x1c, 0x7a, 0xe9, 0xb3, 0x27, 0x0e, 0x8d, 0x9d, 0x7a,
+		0x35, 0x7c, 0xea, 0xdd, 0x19, 0xff, 0xc9, 0x38, 0xdc, 0xc5, 0x9e, 0x0e, 0xe9, 0x75, 0x5a, 0xa6,
+		0xf3, 0x48, 0xa3, 0xb7, 0xd7, 0x75, 0x2c, 0x5c, 0xbf, 0xd6, 0xb6, 0x98, 0xf0, 0x19, 0xaf, 0x79,
+		0x81, 0x37, 0x8f, 0x9e, 0x6e, 0x75, 0x1b, 0x12, 0xeb, 0x88, 0x87, 0x2c, 0x76, 0x2c, 0xc7, 0x68,
+		0x0b, 0xa3, 0x83, 0x17, 0xb0, 0x96, 0x3f, 0x37, 0x12, 0xe5, 0xb5, 0x2d, 0xf9, 0xd2, 0x48, 0x9b,
+		0x1a, 0xdb, 0xfc, 0xd6, 0x76, 0x8c, 0x59, 0xab, 0x69, 0xac, 0x60, 0x17, 0xb4, 0xe7, 0x20, 0x61,
+		0xf4, 0x79, 0x32, 0x51, 0x0c, 0xcd, 0x58, 0x56, 0x50, 0xaf, 0x40, 0x4a, 0x1c, 0x9f, 0x63, 0x36,
+		0xcd, 0x35, 0xba, 0xc7, 0xbe, 0x93, 0xd3, 0xf0, 0x4f, 0xb2, 0x00, 0x09, 0xd6, 0x79, 0x91, 0x82,
+		0x55, 0x58, 0x18, 0xea, 0xfd, 0x02, 0xeb, 0xa4, 0xc6, 0xc1, 0xd4, 0xcb, 0x90, 0xae, 0x5a, 0x28,
+		0x85, 0x41, 0x6a, 0x19, 0x4e, 0x8d, 0xf5, 0xb9, 0xdb, 0x77, 0xe4, 0xed, 0x29, 0x56, 0xc0, 0x4b,
+		0x83, 0xfc, 0x16, 0xbf, 0x48, 0x88, 0x12, 0x25, 0xb5, 0x02, 0x29, 0x46, 0x7b, 0xad, 0xeb, 0x3e,
+		0x8d, 0x13, 0xf1, 0x3d, 0x8d, 0x23, 0xc8, 0x47, 0xbd, 0xce, 0x12, 0x88, 0xe3, 0x15, 0x5f, 0x31,
+		0x6e, 0xf6, 0xb7, 0xfa, 0x3e, 0x48, 0x0b, 0x22, 0x36, 0x39, 0x03, 0x31, 0xab, 0x2b, 0xd3, 0xef,
+		0x8a, 0xe3, 0x86, 0xb2, 0xd6, 0x2d, 0xc7, 0x51, 0x66, 0x34, 0x04, 0x2e, 0xaf, 0x8e, 0x15, 0x8b,
+		0x27, 0x02, 0x62, 0xd1, 0xa1, 0xce, 0xd6, 0xb6, 0xe3, 0xfd, 0xc1, 0xa7, 0x73, 0x48, 0x14, 0x5c,
+		0x41, 0xb9, 0x15, 0x85, 0x13, 0xbe, 0xd6, 0xeb, 0xb4, 0x87, 0xf1, 0x23, 0x2e, 0x4d, 0x42, 0x52,
+		0x88, 0xaf, 0x83, 0xa2, 0x7d, 0x8c, 0xa8, 0xbc, 0x17, 0x62, 0xa5, 0x6e, 0x17, 0x1f, 0xb2, 0x61,
+		0xe5, 0x86, 0xc5, 0x65, 0x25, 0xae, 0xb9, 0x65, 0x6c, 0xb3, 0xad, 0x6d, 0xe7, 0x86, 0xd1, 0x73,
+		0x1f, 0xb9, 0x91, 0x65, 0xf5, 0x3c, 0x64, 0x2a, 0x96, 0x69, 0x53, 0xd3, 0xee, 0x33, 0x53, 0x76,
+		0xab, 0x6d, 0x35, 0xae, 0x09, 0x0a, 0xbc, 0x80, 0xcc, 0x36, 0xba, 0x5d, 0x86, 0x19, 0xd7, 0xf0,
+		0x4f, 0xbe, 0x5e, 0xcb, 0x6b, 0x63, 0xd9, 0x73, 0xf6, 0x60, 0xec, 0x11, 0x03, 0xf4, 0x6c, 0xd2,
+		0x08, 0xbc, 0x6b, 0x78, 0x21, 0x5d, 0xa3, 0x7b, 0xf6, 0x41, 0xd7, 0xd1, 0xb3, 0x90, 0x59, 0x67,
+		0x4f, 0x0d, 0x5e, 0xa1, 0x7b, 0xa4, 0x88, 0x99, 0x88, 0x67, 0xce, 0x9e, 0x7d, 0xec, 0x3c, 0x97,
+		0xf2, 0x4b, 0x87, 0x34, 0x59, 0x41, 0x4e, 0x40, 0xc6, 0xa6, 0x8d, 0xee, 0x99, 0xb3, 0xe7, 0xae,
+		0x3d, 0xc6, 0xc5, 0xea, 0xd2, 0x21, 0xcd, 0xab, 0x5a, 0x4c, 0xe3, 0x88, 0xdf, 0xf8, 0xe4, 0xc9,
+		0x48, 0x39, 0x01, 0x31, 0xbb, 0xdf, 0x79, 0xdb, 0x64, 0xe3, 0x7b, 0x13, 0x30, 0xef, 0x6b, 0xe5,
+		0x9b, 0x8b, 0x48, 0xe2, 0x76, 0x1f, 0x88, 0x54, 0x7c, 0xe3, 0x67, 0x10, 0x63, 0x76, 0x8d, 0x7d,
+		0xb9, 0xa8, 0xfe, 0x62, 0x04, 0x72, 0x57, 0x25, 0x65, 0x4c, 0xdf, 0x7f, 0x1a, 0xc0, 0xfd, 0x92,
+		0x5c, 0x2a, 0xc7, 0x17, 0x06, 0xbf, 0xb5, 0xe0, 0xe2, 0x68, 0x3e, 0x70, 0xcc, 0xd9, 0xec, 0xf6,
+		0xac, 0xae, 0x65, 0x8b, 0x07, 0x4f, 0x42, 0x50, 0x5d, 0x60, 0xbc, 0x2c, 0xc1, 0xb4, 0x9a, 0x7e,
+		0xdd, 0x72, 0x30, 0x33, 0xa4, 0x6b, 0xdd, 0x10, 0xcf, 0x48, 0xc5, 0x34, 0x85, 0xb5, 0x5c, 0x65,
+		0x0d, 0xeb, 0x58, 0x8f, 0x9d, 0xce, 0xb8, 0x54, 0xd0, 0x2b, 0x33, 0x9a, 0xcd, 0x1e, 0xb5, 0x6d,
+		0xa1, 0xb8, 0x64, 0x11, 0x5f, 0x59, 0xe9, 0xf6, 0xb7, 0x74, 0xa9, 0x25, 0xf0, 0x9d, 0x9a, 0x11,
+		0x6b, 0x5e, 0xca, 0x86, 0x58, 0xf5, 0xc9, 0x6e, 0x7f, 0x0b, 0x25, 0xe5, 0x6e, 0xc8, 0x8d, 0xe8,
+		0x4c, 0xf6, 0xba, 0xd7, 0x0f, 0xf6, 0xba, 0xa5, 0x18, 0x81, 0xde, 0xed, 0xb5, 0xac, 0x5e, 0xcb,
+		0xd9, 0x63, 0xa9, 0x88, 0x31, 0x4d, 0x91, 0x0d, 0xeb, 0xa2, 0x5e, 0xbd, 0x06, 0xf9, 0x3a, 0xb3,
+		0xd6, 0xbd, 0x9e, 0x9f, 0xf5, 0xfa, 0x17, 0x09, 0xef, 0xdf, 0xd8, 0x9e, 0x45, 0x87, 0x7a, 0x56,
+		0x5e, 0x19, 0x2b, 0x99, 0x8f, 0x1f, 0x4c, 0x32, 0x83, 0xbb, 0xdb, 0xcf, 0x16, 0xe1, 0x5d, 0x83,
+		0x8d, 0x01, 0x95, 0x35, 0xa9, 0x50, 0x86, 0x39, 0xe2, 0xc5, 0xfd, 0x37, 0xd1, 0x62, 0x88, 0xea,
+		0x2c, 0x86, 0x2e, 0x1f, 0xf5, 0x3c, 0x4c, 0xe1, 0x05, 0x97, 0x3a, 0x75, 0x2e, 0x51, 0xa3, 0x49,
+		0x7b, 0xc1, 0x5d, 0x76, 0x4a, 0xee, 0xb2, 0x04, 0xe2, 0x6c, 0x2b, 0xe5, 0xbb, 0x0c, 0xfb, 0x5b,
+		0xdd, 0x85, 0x38, 0xa2, 0x7a, 0x3b, 0xb0, 0xc0, 0x60, 0x05, 0xac, 0xdd, 0xda, 0x73, 0x44, 0xd2,
+		0x72, 0x4e, 0xe3, 0x05, 0xf2, 0x84, 0xdc, 0x47, 0x63, 0xfb, 0xef, 0xa3, 0x42, 0x08, 0xc5, 0x6e,
+		0xda, 0x86, 0x54, 0x19, 0xd5, 0xef, 0x52, 0xd5, 0xed, 0x48, 0xc4, 0xeb, 0x08, 0x59, 0x81, 0x7c,
+		0xd7, 0xe8, 0x39, 0xec, 0x89, 0x86, 0x5d, 0x36, 0x0a, 0x21, 0xe7, 0x27, 0x87, 0x57, 0x5d, 0x60,
+		0xb0, 0xe2, 0x2b, 0x53, 0x5d, 0x7f, 0xa5, 0xfa, 0xf9, 0x38, 0x24, 0x05, 0x33, 0xde, 0x0b, 0x29,
+		0xc1, 0x56, 0x21, 0x99, 0x77, 0x2d, 0x0c, 0x6f, 0x46, 0x0b, 0xee, 0xa6, 0x21, 0xe8, 0x49, 0x1c,
+		0x72, 0x1f, 0xa4, 0x1b, 0xbb, 0x46, 0xcb, 0xd4, 0x5b, 0x4d, 0x61, 0x00, 0x66, 0x3f, 0xfb, 0xda,
+		0xc9, 0x54, 0x05, 0xeb, 0x96, 0xaa, 0x5a, 0x8a, 0x35, 0x2e, 0x35, 0x71, 0xe7, 0xdf, 0xa5, 0xad,
+		0x9d, 0x5d, 0x47, 0xac, 0x2e, 0x51, 0xc2, 0x67, 0x6d, 0x51, 0x20, 0xc4, 0x33, 0x3e, 0xc5, 0x21,
+		0x5b, 0xde, 0x8d, 0x93, 0x94, 0xd3, 0xf8, 0xe1, 0x8f, 0xff, 0xd9, 0xc9, 0x88, 0xc6, 0x30, 0x48,
+		0x05, 0xa6, 0xda, 0x86, 0xed, 0xe8, 0x6c, 0xd7, 0xc2, 0xcf, 0x27, 0x18, 0x89, 0x63, 0xc3, 0x0c,
+		0x11, 0x8c, 0x15, 0x5d, 0xcf, 0x22, 0x16, 0xaf, 0x6a, 0xe2, 0xf3, 0x24, 0x8c, 0x08, 0xe6, 0x51,
+		0xb7, 0x1c, 0x6e, 0x4b, 0x25, 0x19, 0xdf, 0xa7, 0xb1, 0xbe, 0xc2, 0xaa, 0x99, 0x45, 0x75, 0x1c,
+		0x32, 0xec, 0xc5, 0x11, 0x06, 0xc2, 0x2f, 0x64, 0xa5, 0xb1, 0x82, 0x35, 0xde, 0x0f, 0x79, 0x4f,
+		0x37, 0x72, 0x90, 0x34, 0xa7, 0xe2, 0x55, 0x33, 0xc0, 0x47, 0x61, 0xce, 0xa4, 0x37, 0x1d, 0x7d,
+		0x10, 0x3a, 0xc3, 0xa0, 0x09, 0xb6, 0x5d, 0x0d, 0x62, 0xdc, 0x0b, 0xd3, 0x0d, 0xc9, 0x7c, 0x0e,
+		0x0b, 0x0c, 0x76, 0xca, 0xad, 0x65, 0x60, 0xc7, 0x20, 0x6d, 0x74, 0xbb, 0x1c, 0x20, 0x2b, 0x74,
+		0x63, 0xb7, 0xcb, 0x9a, 0x4e, 0xc3, 0x0c, 0x1b, 0x63, 0x8f, 0xda, 0x78, 0x8b, 0x80, 0xc3, 0xe4,
+		0x18, 0x4c, 0x1e, 0x1b, 0x34, 0x5e, 0xcf, 0x60, 0xef, 0x81, 0x29, 0x7a, 0x1d, 0x6f, 0xc3, 0x35,
+		0x28, 0x87, 0x9b, 0x62, 0x70, 0x39, 0x59, 0xc9, 0x80, 0x1e, 0x00, 0x57, 0xe7, 0xe9, 0x52, 0x1f,
+		0x4f, 0x73, 0x7a, 0xb2, 0xbe, 0xc4, 0xab, 0xd5, 0x02, 0xc4, 0xab, 0x86, 0x63, 0xa0, 0x51, 0xe1,
+		0xdc, 0xe4, 0x9b, 0x4c, 0x4e, 0xc3, 0x3f, 0xd5, 0x37, 0xa2, 0x10, 0xbf, 0x6a, 0x39, 0x94, 0x3c,
+		0xee, 0x33, 0xf8, 0xa6, 0x47, 0xc9, 0x73, 0xbd, 0xb5, 0x63, 0xd2, 0xe6, 0x8a, 0xbd, 0xe3, 0x7b,
+		0xe5, 0xcf, 0x13, 0xa7, 0x68, 0x40, 0x9c, 0xe6, 0x20, 0xd1, 0xb3, 0xfa, 0x66, 0x53, 0xa6, 0x8e,
+		0xb3, 0x02, 0xa9, 0x41, 0xda, 0x95, 0x92, 0x78, 0x98, 0x94, 0xe4, 0x51, 0x4a, 0x50, 0x86, 0x45,
+		0x85, 0x96, 0xda, 0x12, 0xc2, 0x52, 0x86, 0x8c, 0xab, 0xbc, 0x0a, 0x89, 0x03, 0x08, 0xac, 0x87,
+		0x86, 0x1b, 0x89, 0x3b, 0xf7, 0x2e, 0xf3, 0xb8, 0xc4, 0x29, 0x6e, 0x83, 0xe0, 0x5e, 0x40, 0xac,
+		0xc4, 0x8b, 0x83, 0x29, 0x36, 0x2e, 0x4f, 0xac, 0xf8, 0xab, 0x83, 0xef, 0xc2, 0xb4, 0xb3, 0x1d,
+		0x93, 0x5d, 0x8b, 0x10, 0x92, 0xe7, 0x55, 0xa8, 0xbf, 0x11, 0x81, 0x24, 0x97, 0x64, 0x1f, 0xdf,
+		0x22, 0xa3, 0xf9, 0x16, 0x1d, 0xc7, 0xb7, 0xd8, 0xed, 0xf3, 0xad, 0x04, 0xe0, 0x76, 0xc6, 0x16,
+		0x0f, 0xc1, 0x8d, 0xb0, 0x16, 0x78, 0x17, 0xeb, 0xad, 0x1d, 0xb1, 0x50, 0x7d, 0x48, 0xea, 0x7f,
+		0x89, 0x40, 0xc6, 0x6d, 0x27, 0x25, 0x98, 0x92, 0xfd, 0xd2, 0xb7, 0xdb, 0xc6, 0x8e, 0x90, 0x9d,
+		0xbb, 0xc6, 0x76, 0xee, 0x42, 0xdb, 0xd8, 0xd1, 0xb2, 0xa2, 0x3f, 0x58, 0x18, 0x3d, 0x0f, 0xd1,
+		0x31, 0xf3, 0x10, 0x98, 0xf8, 0xd8, 0xed, 0x4d, 0x7c, 0x60, 0x8a, 0xe2, 0x83, 0x53, 0xf4, 0x4b,
+		0x51, 0xe6, 0xbc, 0x74, 0x2d, 0xdb, 0x68, 0x7f, 0x35, 0x56, 0xc4, 0x71, 0xc8, 0x74, 0xad, 0xb6,
+		0xce, 0x5b, 0xf8, 0x95, 0x8a, 0x74, 0xd7, 0x6a, 0x6b, 0x43, 0xd3, 0x9e, 0xb8, 0x43, 0xcb, 0x25,
+		0x79, 0x07, 0xb8, 0x96, 0x1a, 0xe4, 0x5a, 0x0f, 0x72, 0x9c, 0x15, 0x62, 0x2f, 0x7b, 0x14, 0x79,
+		0x80, 0x7f, 0x15, 0x22, 0xc3, 0x7b, 0x2f, 0xef, 0x36, 0x87, 0xd4, 0x92, 0xbb, 0x2e, 0x06, 0x57,
+		0xfd, 0x85, 0xe8, 0x38, 0x0c, 0x2e, 0x76, 0x9a, 0x80, 0x53, 0xbf, 0x37, 0x02, 0xb0, 0x8c, 0x9c,
+		0x65, 0xe3, 0xc5, 0x5d, 0xc8, 0x66, 0x5d, 0xd0, 0x03, 0x5f, 0x3e, 0x31, 0x6e, 0xd2, 0xc4, 0xf7,
+		0x73, 0xb6, 0xbf, 0xdf, 0x15, 0x98, 0xf2, 0x84, 0xd1, 0xa6, 0xb2, 0x33, 0x27, 0xf6, 0xb1, 0xa8,
+		0xf1, 0x22, 0x54, 0xee, 0xba, 0xaf, 0xa4, 0xfe, 0x56, 0x04, 0x32, 0xac, 0x4f, 0xf8, 0xfe, 0x55,
+		0x60, 0x0e, 0x23, 0xb7, 0x3f, 0x87, 0x77, 0x01, 0x70, 0x32, 0x78, 0x08, 0x2f, 0x24, 0x2b, 0xc3,
+		0x6a, 0xf0, 0x68, 0x1d, 0x63, 0x4b, 0x62, 0xd8, 0xb1, 0xfd, 0x19, 0x2e, 0x2d, 0x6e, 0xc1, 0xf6,
+		0xa3, 0x90, 0x62, 0x57, 0x75, 0x6f, 0xda, 0xc2, 0x88, 0xc6, 0xd7, 0x12, 0x37, 0x6e, 0xda, 0xea,
+		0x0b, 0x90, 0xda, 0xb8, 0xc9, 0x63, 0x21, 0xc7, 0x21, 0xd3, 0xb3, 0x2c, 0xb1, 0x27, 0x73, 0x5b,
+		0x28, 0x8d, 0x15, 0x6c, 0x0b, 0x92, 0xfe, 0x7f, 0xd4, 0xf3, 0xff, 0xbd, 0x00, 0x46, 0x6c, 0xb2,
+		0x00, 0xc6, 0xfb, 0x20, 0xa9, 0xd1, 0xeb, 0xd4, 0x68, 0x8f, 0xd5, 0x8a, 0x01, 0xe9, 0x8b, 0x0e,
+		0x48, 0xdf, 0xe9, 0xff, 0x1c, 0x81, 0xac, 0x4f, 0xbf, 0x90, 0xc7, 0xe0, 0x70, 0x79, 0x79, 0xad,
+		0x72, 0x45, 0x5f, 0xaa, 0xea, 0x17, 0x96, 0x4b, 0xbe, 0x7b, 0x8c, 0xc5, 0x23, 0xaf, 0xdc, 0x9a,
+		0x27, 0x3e, 0xd8, 0x4d, 0x93, 0x1d, 0xe6, 0x90, 0x47, 0x60, 0x2e, 0x88, 0x52, 0x2a, 0xd7, 0xf1,
+		0x4a, 0x7c, 0xa4, 0x78, 0xf8, 0x95, 0x5b, 0xf3, 0x33, 0x3e, 0x8c, 0xd2, 0x96, 0x4d, 0x4d, 0x67,
+		0x18, 0xa1, 0xb2, 0xb6, 0xb2, 0x82, 0xd7, 0x48, 0x87, 0x10, 0x84, 0xc2, 0x7f, 0x00, 0x66, 0x82,
+		0x08, 0xab, 0x4b, 0xcb, 0x4a, 0xac, 0x48, 0x5e, 0xb9, 0x35, 0x3f, 0xed, 0x83, 0x5e, 0x6d, 0xb5,
+		0x8b, 0xe9, 0x97, 0x7f, 0xec, 0xc4, 0xa1, 0x4f, 0xfd, 0xf8, 0x89, 0x08, 0x8e, 0x6c, 0x2a, 0xa0,
+		0x63, 0xc8, 0x43, 0x70, 0xb4, 0xbe, 0x74, 0x71, 0xb5, 0x56, 0xd5, 0x57, 0xea, 0x17, 0x07, 0x6e,
+		0xa2, 0x16, 0xf3, 0xaf, 0xdc, 0x9a, 0xcf, 0x8a, 0x21, 0x8d, 0x83, 0x5e, 0xd7, 0x6a, 0x57, 0xd7,
+		0x36, 0x6a, 0x4a, 0x84, 0x43, 0xaf, 0xf7, 0xe8, 0x75, 0xcb, 0xe1, 0xcf, 0xf3, 0x3f, 0x0a, 0xc7,
+		0x46, 0x40, 0xbb, 0x03, 0x9b, 0x79, 0xe5, 0xd6, 0xfc, 0xd4, 0x7a, 0x8f, 0xf2, 0xf5, 0xc7, 0x30,
+		0x16, 0xa0, 0x30, 0x8c, 0xb1, 0xb6, 0xbe, 0x56, 0x2f, 0x2d, 0x2b, 0xf3, 0x45, 0xe5, 0x95, 0x5b,
+		0xf3, 0x39, 0xa9, 0x4c, 0x11, 0xde, 0x1b, 0xd9, 0xdb, 0xe5, 0x2d, 0x7d, 0x53, 0x0a, 0xee, 0x1a,
+		0x6c, 0x7c, 0xa4, 0x6b, 0xf4, 0x8c, 0xce, 0x41, 0xdd, 0xa5, 0x90, 0xd3, 0x5c, 0xf5, 0xa5, 0x28,
+		0xe4, 0x5d, 0x63, 0x7c, 0x9d, 0x7d, 0x81, 0x3c, 0xee, 0x8f, 0xe3, 0x64, 0xc7, 0x6e, 0x83, 0x1c,
+		0x5a, 0x86, 0x79, 0x30, 0x8e, 0x2f, 0x8c, 0x3a, 0xa1, 0x6e, 0xe6, 0x87, 0xf1, 0x6a, 0x02, 0x42,
+		0xa0, 0xba, 0x18, 0xe4, 0xfd, 0x90, 0x71, 0x95, 0x8f, 0xfb, 0xae, 0xe9, 0x78, 0x6d, 0x25, 0xf0,
+		0x3d, 0x1c, 0x7c, 0x6a, 0x54, 0xba, 0x1d, 0xf1, 0x71, 0x8e, 0xcc, 0x55, 0x0e, 0x20, 0x90, 0x25,
+		0xbc, 0xba, 0x24, 0x96, 0x9d, 0x18, 0x3d, 0x7b, 0x0d, 0xee, 0xa6, 0xce, 0x3d, 0x31, 0xbe, 0x7e,
+		0xd3, 0x1d, 0xe3, 0x66, 0x19, 0xcb, 0xa8, 0x68, 0xb0, 0x71, 0x47, 0x3c, 0x83, 0x13, 0xd3, 0x92,
+		0x1d, 0xe3, 0xe6, 0x45, 0xc3, 0xbe, 0x1c, 0x4f, 0xc7, 0x94, 0xb8, 0xfa, 0xd3, 0x11, 0x98, 0x0e,
+		0x8e, 0x91, 0x3c, 0x08, 0x04, 0x31, 0xf0, 0xb8, 0x04, 0x55, 0x14, 0x63, 0x96, 0xa4, 0x8b, 0x57,
+		0x67, 0x4b, 0x3b, 0x74, 0xb5, 0xdf, 0x61, 0x1d, 0xb0, 0x09, 0x9e, 0xad, 0x08, 0x60, 0x39, 0x4f,
+		0x82, 0x99, 0xc7, 0x86, 0x9f, 0xad, 0x17, 0x00, 0x7c, 0xa3, 0x7b, 0x15, 0x37, 0xba, 0x69, 0x4e,
+		0x4f, 0xb6, 0x04, 0x87, 0x12, 0x0b, 0x0e, 0x45, 0x7d, 0x3f, 0xe4, 0x07, 0xf8, 0x49, 0x54, 0x98,
+		0x12, 0x51, 0x05, 0x76, 0xd2, 0xc9, 0xed, 0xeb, 0x8c, 0x96, 0xe5, 0xd1, 0x03, 0x76, 0xf2, 0xbb,
+		0x98, 0xfe, 0x55, 0x0c, 0x65, 0x61, 0xc0, 0xfd, 0x41, 0x98, 0x0a, 0x70, 0x54, 0x46, 0xfa, 0x22,
+		0x5e, 0xa4, 0xcf, 0x03, 0x7e, 0x1e, 0x72, 0xa8, 0x68, 0x69, 0x53, 0xc0, 0xde, 0x07, 0x79, 0xbe,
+		0x11, 0x0c, 0xf2, 0x9a, 0x5b, 0x62, 0x2b, 0x92, 0xe1, 0x2a, 0x4c, 0x79, 0x70, 0x1e, 0xdb, 0xb3,
+		0x12, 0xea, 0xa2, 0x61, 0x97, 0x3f, 0xf8, 0xa9, 0xcf, 0x9e, 0x88, 0xbc, 0x3d, 0x0b, 0xf1, 0x77,
+		0x36, 0xe0, 0xb8, 0xaf, 0xd1, 0xd8, 0x6a, 0xb4, 0x02, 0x51, 0x8b, 0xbc, 0xd7, 0xb8, 0x80, 0x8d,
+		0x61, 0xd1, 0x87, 0x7d, 0x63, 0x20, 0xfb, 0x07, 0xdc, 0x8a, 0xfb, 0x6b, 0x84, 0xf0, 0xc0, 0xc8,
+		0xe8, 0x18, 0xe7, 0x6f, 0x64, 0x20, 0xa5, 0xd1, 0x8f, 0xf6, 0xa9, 0x8d, 0x97, 0xad, 0xe3, 0xb4,
+		0xb1, 0x6b, 0x8d, 0x0a, 0x29, 0xe1, 0xe0, 0x16, 0x04, 0x5c, 0xad, 0xb1, 0x6b, 0x5d, 0x3a, 0xa4,
+		0x31, 0x58, 0x72, 0x16, 0x12, 0xdb, 0xed, 0xbe, 0x88, 0x73, 0x0c, 0x28, 0x0b, 0x3f, 0xd2, 0x05,
+		0x04, 0xba, 0x74, 0x48, 0xe3, 0xd0, 0xf8, 0x29, 0xf6, 0xa3, 0x21, 0xb1, 0xfd, 0x3f, 0x85, 0x37,
+		0x6c, 0xf1, 0x53, 0x08, 0x4b, 0xca, 0x00, 0x2d, 0xb3, 0xe5, 0xe8, 0x2c, 0x06, 0x50, 0x48, 0x0c,
+		0x6b, 0x89, 0x20, 0x66, 0xcb, 0x61, 0x51, 0x03, 0x8c, 0xc4, 0xb6, 0x64, 0x01, 0xbb, 0xfb, 0xd1,
+		0x3e, 0xed, 0xed, 0x15, 0x92, 0xfb, 0x77, 0xf7, 0x83, 0x08, 0x84, 0xdd, 0x65, 0xd0, 0xa4, 0x06,
+		0x59, 0x76, 0x6b, 0x98, 0xaf, 0x5f, 0xf1, 0xca, 0x94, 0x3a, 0x0e, 0xb9, 0x8c, 0xa0, 0x6c, 0x49,
+		0x5f, 0x3a, 0xa4, 0xc1, 0x96, 0x5b, 0x42, 0x25, 0xc9, 0xdf, 0xb6, 0x75, 0x6e, 0x16, 0xd2, 0xc3,
+		0x6a, 0xca, 0x4f, 0x83, 0x3d, 0x70, 0xbb, 0x71, 0x13, 0xa3, 0xcc, 0x0d, 0xfe, 0x27, 0x8e, 0xbf,
+		0x49, 0xdb, 0x2d, 0x7c, 0x3a, 0xd8, 0xb9, 0x59, 0xc8, 0xec, 0x3f, 0xfe, 0x2a, 0x87, 0x64, 0x14,
+		0x32, 0x4d, 0x59, 0x40, 0x45, 0x4b, 0xcd, 0xa6, 0x18, 0x06, 0x0c, 0xeb, 0xe9, 0xc0, 0x3c, 0x9b,
+		0x4d, 0x39, 0x88, 0x34, 0x15, 0x7f, 0x93, 0xa7, 0x5c, 0x0b, 0x37, 0x3b, 0x6c, 0x54, 0x06, 0x06,
+		0xc0, 0x63, 0x1d, 0x87, 0xa4, 0xa5, 0x4b, 0x56, 0x61, 0xba, 0xdd, 0xb2, 0x1d, 0xdd, 0x36, 0x8d,
+		0xae, 0xbd, 0x6b, 0x39, 0xf2, 0xdd, 0xcb, 0x7b, 0xc7, 0x51, 0x58, 0x6e, 0xd9, 0x4e, 0x5d, 0x02,
+		0x5f, 0x3a, 0xa4, 0x4d, 0xb5, 0xfd, 0x15, 0x48, 0xcf, 0xda, 0xde, 0xa6, 0x3d, 0x97, 0x60, 0x61,
+		0x6a, 0x7f, 0x7a, 0x6b, 0x08, 0x2d, 0xf1, 0x91, 0x9e, 0xe5, 0xaf, 0x20, 0x5f, 0x03, 0xb3, 0x6d,
+		0xcb, 0x68, 0xba, 0xe4, 0xf4, 0xc6, 0x6e, 0xdf, 0xbc, 0xc6, 0x22, 0x11, 0xf8, 0xd4, 0xf9, 0xb8,
+		0x4e, 0x5a, 0x46, 0x53, 0x92, 0xa8, 0x20, 0xc2, 0xa5, 0x43, 0xda, 0x4c, 0x7b, 0xb0, 0x92, 0x7c,
+		0x18, 0xe6, 0x8c, 0x6e, 0xb7, 0xbd, 0x37, 0x48, 0x3d, 0xcf, 0xa8, 0x9f, 0x1e, 0x47, 0xbd, 0x84,
+		0x38, 0x83, 0xe4, 0x89, 0x31, 0x54, 0x4b, 0x36, 0x30, 0x86, 0x42, 0xd9, 0x9d, 0x9a, 0xae, 0x30,
+		0x55, 0xd8, 0xa3, 0x92, 0xf8, 0xcb, 0x42, 0x63, 0x68, 0xaf, 0x73, 0x78, 0x69, 0xd9, 0x5c, 0x3a,
+		0x84, 0xe1, 0x96, 0x40, 0x15, 0xa7, 0x6a, 0x35, 0x28, 0x7b, 0x6b, 0x57, 0x50, 0x9d, 0x09, 0xa3,
+		0xca, 0xe0, 0x83, 0x54, 0x03, 0x55, 0x4c, 0x06, 0x9d, 0x5d, 0x9d, 0xaf, 0x43, 0x12, 0x22, 0x83,
+		0xce, 0xae, 0x5c, 0x8a, 0x69, 0x2a, 0xfe, 0x2e, 0xa7, 0x44, 0x52, 0x9d, 0xf8, 0xad, 0x8a, 0xfb,
+		0x21, 0xeb, 0xd3, 0x4c, 0x18, 0xd5, 0x17, 0x49, 0x06, 0x32, 0x19, 0x4f, 0x14, 0xd5, 0x69, 0xc8,
+		0xf9, 0xb5, 0x91, 0xfa, 0xf1, 0x08, 0x64, 0x7d, 0x8a, 0x06, 0x31, 0xfd, 0xb1, 0xcb, 0x8c, 0x17,
+		0x96, 0xbc, 0x47, 0x6e, 0x43, 0xb2, 0x9d, 0x1f, 0x67, 0xe5, 0x58, 0xa5, 0xd8, 0x05, 0xf1, 0x21,
+		0x82, 0xee, 0x99, 0xae, 0x0b, 0x12, 0x63, 0x20, 0xd0, 0x3d, 0xd3, 0x95, 0x00, 0x77, 0x43, 0x0e,
+		0xc7, 0xa6, 0xfb, 0x2d, 0x95, 0x8c, 0x96, 0xc5, 0x3a, 0x01, 0xa2, 0xfe, 0x41, 0x14, 0x94, 0x41,
+		0x0d, 0xe6, 0x06, 0x35, 0x23, 0x07, 0x0e, 0x6a, 0x1e, 0x1b, 0x0c, 0xa7, 0x7a, 0x11, 0xd4, 0x65,
+		0x50, 0xbc, 0x40, 0x20, 0xdf, 0x49, 0xc6, 0x5b, 0x5e, 0x03, 0x26, 0xa2, 0x96, 0x6f, 0x04, 0x2b,
+		0xc8, 0x85, 0xc0, 0xe1, 0x8f, 0xfc, 0x59, 0xad, 0xc1, 0x49, 0x75, 0x0d, 0x8e, 0xcd, 0x6e, 0xd3,
+		0x70, 0xa8, 0x0c, 0xcc, 0x78, 0x98, 0x68, 0x17, 0x60, 0xdc, 0xd1, 0x76, 0x0c, 0x87, 0x0a, 0xbb,
+		0x20, 0xc1, 0xe3, 0x93, 0x46, 0xb7, 0xcb, 0x7e, 0x85, 0x84, 0xdb, 0x05, 0xf7, 0xc2, 0x34, 0x2a,
+		0xf5, 0x96, 0xd1, 0xd6, 0x85, 0xab, 0x95, 0xe4, 0xe6, 0x83, 0xa8, 0xbd, 0xc4, 0x2a, 0xd5, 0xa6,
+		0x3b, 0xe3, 0x4c, 0x72, 0x5c, 0x3f, 0x2f, 0xe2, 0xf3, 0xf3, 0x88, 0x78, 0x57, 0x82, 0xf3, 0x47,
+		0xbe, 0xc5, 0x31, 0x3a, 0xbc, 0x3c, 0xc7, 0x7c, 0xc2, 0xeb, 0x3c, 0xe2, 0x92, 0xd6, 0x78, 0x41,
+		0xfd, 0x58, 0x14, 0x66, 0x86, 0x54, 0xff, 0xc8, 0xb8, 0xbb, 0xe7, 0xe0, 0x46, 0x0f, 0xe4, 0xe0,
+		0x5e, 0x09, 0xc6, 0x95, 0x7d, 0x5b, 0xe7, 0xf1, 0x21, 0x26, 0x73, 0xc5, 0x8b, 0x02, 0x2d, 0x88,
+		0xf8, 0x42, 0xcf, 0x4c, 0xcc, 0x37, 0x61, 0x6e, 0x6b, 0xef, 0x45, 0xc3, 0x74, 0x5a, 0x26, 0xd5,
+		0x87, 0x66, 0x6d, 0x78, 0x2f, 0x5e, 0x69, 0xd9, 0x5b, 0x74, 0xd7, 0xb8, 0xde, 0xb2, 0x64, 0xb7,
+		0x66, 0x5d, 0x7c, 0x2f, 0xba, 0xac, 0x6a, 0x30, 0x1d, 0xdc, 0xbb, 0xc8, 0x34, 0x44, 0x9d, 0x9b,
+		0x62, 0xfc, 0x51, 0xe7, 0x26, 0x79, 0x54, 0x04, 0xa2, 0xf8, 0x6b, 0x5a, 0xc3, 0x1f, 0x12, 0x78,
+		0x5e, 0x14, 0x4a, 0x55, 0x41, 0x19, 0xdc, 0xcf, 0x06, 0xa9, 0xaa, 0x0f, 0x40, 0x7e, 0x60, 0xc3,
+		0x1a, 0xe7, 0x80, 0xab, 0x79, 0x98, 0x12, 0xa0, 0x9c, 0x1d, 0xea, 0x11, 0x98, 0x1b, 0xb5, 0xd9,
+		0xa8, 0xbb, 0x30, 0x37, 0x6a, 0xd3, 0x20, 0x67, 0x21, 0xed, 0xee, 0x36, 0x23, 0xc2, 0x20, 0x6c,
+		0x14, 0x12, 0x58, 0x73, 0x41, 0x03, 0xd1, 0xf4, 0x68, 0x20, 0x9a, 0xae, 0x7e, 0x04, 0x0a, 0xe3,
+		0x76, 0x92, 0x81, 0x61, 0xc4, 0x5d, 0x29, 0x3c, 0x02, 0x49, 0xf1, 0x48, 0x55, 0x94, 0x9d, 0x1f,
+		0x89, 0x12, 0x4a, 0x27, 0xdf, 0x55, 0x62, 0xac, 0x9a, 0x17, 0x54, 0x1d, 0x8e, 0x8d, 0xdd, 0x4d,
+		0xc6, 0x9f, 0x44, 0x71, 0x42, 0xe2, 0x24, 0xaa, 0x21, 0xbb, 0x63, 0xb3, 0xb1, 0xca, 0x6c, 0x0b,
+		0x5e, 0x52, 0x5f, 0x8d, 0xc1, 0x91, 0xd1, 0x7b, 0x0a, 0x99, 0x87, 0x1c, 0x1a, 0xee, 0x4e, 0xd0,
+		0xc6, 0x87, 0x8e, 0x71, 0x73, 0x43, 0x18, 0xf8, 0x22, 0x92, 0x1f, 0x75, 0x23, 0xf9, 0x64, 0x13,
+		0x66, 0xda, 0x56, 0xc3, 0x68, 0xeb, 0x3e, 0x89, 0x17, 0xc2, 0x7e, 0xcf, 0x10, 0xb3, 0x6b, 0xfc,
+		0xe7, 0x76, 0x9a, 0x43, 0x42, 0x9f, 0x67, 0x34, 0x96, 0x5d, 0xc9, 0x27, 0x55, 0xc8, 0x76, 0x3c,
+		0x41, 0x3e, 0x80, 0xb0, 0xfb, 0xd1, 0x7c, 0x53, 0x92, 0x18, 0x79, 0xee, 0x94, 0x3c, 0xb0, 0x8a,
+		0x1e, 0x77, 0x84, 0x93, 0x1a, 0x7b, 0x84, 0x33, 0xea, 0xbc, 0x24, 0x3d, 0xfa, 0xbc, 0xe4, 0x65,
+		0xff, 0xd4, 0x04, 0x77, 0xe1, 0xa1, 0x23, 0x14, 0x52, 0x87, 0x39, 0x81, 0xdf, 0x0c, 0xf0, 0x3e,
+		0x3a, 0xa9, 0xa2, 0x21, 0x12, 0x7d, 0x3c, 0xdb, 0x63, 0xb7, 0xc7, 0x76, 0xa9, 0x4b, 0xe3, 0x3e,
+		0x5d, 0xfa, 0x7f, 0xd8, 0x54, 0x3c, 0xe8, 0xa9, 0x29, 0x61, 0xc7, 0xa0, 0xbd, 0xd1, 0xe3, 0x55,
+		0x32, 0xff, 0x40, 0x14, 0xd5, 0xef, 0x05, 0x48, 0x6b, 0xd4, 0xee, 0xe2, 0x2e, 0x8b, 0x81, 0x6f,
+		0x7a, 0xb3, 0x41, 0xbb, 0x8e, 0x77, 0xa8, 0x3a, 0xca, 0xf5, 0xe0, 0xd0, 0x35, 0x09, 0x89, 0x76,
+		0xbf, 0x8b, 0x86, 0x67, 0x00, 0xcc, 0xb5, 0x1b, 0xef, 0xa5, 0x09, 0x74, 0xbf, 0x6f, 0x77, 0x4e,
+		0xfa, 0x76, 0xb1, 0xb1, 0xa6, 0x3e, 0xc7, 0x1a, 0x70, 0xee, 0x1e, 0x17, 0xce, 0x5d, 0x3c, 0xe4,
+		0x63, 0x01, 0xef, 0xae, 0x12, 0xf0, 0xee, 0x92, 0x21, 0xc3, 0x1c, 0xe3, 0xde, 0x9d, 0x93, 0xee,
+		0x5d, 0x2a, 0xa4, 0xc7, 0x03, 0xfe, 0xdd, 0x85, 0xa0, 0x7f, 0x97, 0x1e, 0xa3, 0x6d, 0x24, 0xf6,
+		0x58, 0x07, 0xef, 0xbd, 0x3e, 0x07, 0x2f, 0x33, 0xd6, 0xb2, 0xe5, 0x44, 0x46, 0x78, 0x78, 0x95,
+		0x80, 0x87, 0x07, 0x21, 0x3c, 0x18, 0xe3, 0xe2, 0x7d, 0xc0, 0xef, 0xe2, 0x65, 0xc7, 0x7a, 0x89,
+		0x62, 0xbe, 0x47, 0xf9, 0x78, 0xe7, 0x5d, 0x1f, 0x2f, 0x37, 0xd6, 0x49, 0x15, 0x63, 0x18, 0x74,
+		0xf2, 0xd6, 0x86, 0x9c, 0xbc, 0x29, 0xf1, 0x3b, 0x86, 0xe3, 0x48, 0x84, 0x78, 0x79, 0x6b, 0x43,
+		0x5e, 0xde, 0x74, 0x08, 0xc1, 0x10, 0x37, 0xef, 0x6b, 0x47, 0xbb, 0x79, 0xe3, 0x1d, 0x31, 0xd1,
+		0xcd, 0xc9, 0xfc, 0x3c, 0x7d, 0x8c, 0x9f, 0xc7, 0x7d, 0xb1, 0x07, 0xc7, 0x92, 0x9f, 0xd8, 0xd1,
+		0xdb, 0x1c, 0xe1, 0xe8, 0x71, 0x97, 0xec, 0xd4, 0x58, 0xe2, 0x13, 0x78, 0x7a, 0x9b, 0x23, 0x3c,
+		0x3d, 0x12, 0x4a, 0x36, 0xd4, 0xd5, 0xfb, 0x80, 0xdf, 0xd5, 0x9b, 0x0d, 0x93, 0xc5, 0x10, 0x5f,
+		0x0f, 0xdf, 0xd1, 0x7e, 0x00, 0x66, 0x5c, 0x70, 0x57, 0xb9, 0xcd, 0x41, 0x82, 0xf6, 0x7a, 0x56,
+		0x4f, 0x66, 0x12, 0xb3, 0x82, 0x7a, 0x0a, 0x72, 0x2e, 0xe8, 0xfe, 0x7e, 0x21, 0x33, 0x0b, 0x7d,
+		0x9a, 0x4c, 0xfd, 0xd5, 0x08, 0xe4, 0xfc, 0x4a, 0x2a, 0xe0, 0x37, 0x64, 0x84, 0xdf, 0xe0, 0xf3,
+		0x16, 0xa3, 0x41, 0x6f, 0x11, 0x1f, 0x4c, 0xef, 0x0e, 0x39, 0x82, 0x46, 0xd7, 0x75, 0x04, 0x65,
+		0x0a, 0x05, 0xf7, 0x29, 0xc5, 0x2e, 0xc6, 0x4f, 0xae, 0xf2, 0x6e, 0x3a, 0x09, 0x77, 0x61, 0xc8,
+		0xc3, 0x30, 0xeb, 0x83, 0x75, 0xcd, 0x48, 0xee, 0x15, 0x29, 0x2e, 0x74, 0x49, 0xd8, 0x93, 0xff,
+		0x26, 0x02, 0x33, 0x43, 0x4a, 0x72, 0xa4, 0xb3, 0x17, 0xb9, 0x43, 0xce, 0x5e, 0xf4, 0xb6, 0x9d,
+		0x3d, 0xbf, 0x59, 0x1c, 0x0b, 0x9a, 0xc5, 0xff, 0x23, 0xe2, 0xcd, 0x89, 0xeb, 0xba, 0x35, 0xac,
+		0x26, 0x15, 0x86, 0x2a, 0xfb, 0x1b, 0x6d, 0x98, 0xb6, 0xb5, 0x23, 0xcc, 0x51, 0xfc, 0x13, 0xa1,
+		0xdc, 0xad, 0x27, 0x23, 0x76, 0x16, 0xd7, 0xc6, 0x4d, 0xf8, 0xf3, 0x9d, 0x45, 0x12, 0x70, 0xd2,
+		0x4b, 0x02, 0x76, 0xef, 0xea, 0xa5, 0x7c, 0x77, 0xf5, 0xc8, 0x53, 0x90, 0x61, 0x11, 0x5c, 0x1d,
+		0x13, 0x80, 0xd3, 0xc3, 0xa6, 0x50, 0x30, 0x01, 0xd8, 0x66, 0xa9, 0x89, 0xec, 0x2f, 0x9f, 0x81,
+		0x92, 0x19, 0x3c, 0x06, 0xc4, 0xde, 0xf3, 0xdf, 0x19, 0x02, 0x71, 0xd1, 0x53, 0x56, 0xa8, 0x1f,
+		0x06, 0x32, 0xbc, 0xcd, 0x90, 0x4b, 0x90, 0xa4, 0xd7, 0xd9, 0xcb, 0x8c, 0x3c, 0xb1, 0xf2, 0xc8,
+		0xb0, 0x25, 0x8c, 0xcd, 0xe5, 0x02, 0x32, 0xf9, 0xcd, 0xd7, 0x4e, 0x2a, 0x1c, 0xfa, 0x21, 0xf7,
+		0x5e, 0x83, 0x26, 0xf0, 0xd5, 0x3f, 0x8d, 0x42, 0x5e, 0x7e, 0x40, 0x3a, 0x6a, 0xa3, 0x78, 0x3b,
+		0xea, 0x48, 0x74, 0x32, 0x7e, 0x9f, 0x00, 0xd8, 0x31, 0x6c, 0xfd, 0x86, 0x61, 0x3a, 0xe2, 0xb7,
+		0x47, 0x63, 0x9a, 0xaf, 0x06, 0x13, 0x8a, 0xb1, 0xd4, 0xb7, 0xc5, 0xaf, 0x8f, 0xc6, 0x34, 0xb7,
+		0xec, 0x1b, 0x67, 0xea, 0x2b, 0x1b, 0x67, 0x90, 0xcb, 0xe9, 0x01, 0x2e, 0xfb, 0x7c, 0x99, 0x8c,
+		0xdf, 0x97, 0xe1, 0x89, 0xd0, 0x22, 0x1f, 0x13, 0x78, 0xdf, 0x64, 0x19, 0x83, 0x40, 0x1d, 0xda,
+		0xe9, 0x5a, 0x56, 0x5b, 0xe7, 0xea, 0x86, 0xff, 0x2e, 0x58, 0x4e, 0x54, 0xd6, 0x98, 0xd6, 0xf9,
+		0xc6, 0x28, 0xcc, 0x0c, 0x6d, 0xd0, 0xff, 0xf4, 0x18, 0xcc, 0x7e, 0x19, 0x64, 0xd0, 0xc8, 0x20,
+		0x75, 0x7f, 0x96, 0x4c, 0x9f, 0xa9, 0x05, 0x29, 0xd0, 0x93, 0xea, 0x0f, 0xe5, 0x7a, 0xb0, 0xda,
+		0x26, 0xcf, 0xc1, 0xd1, 0x01, 0xdd, 0xe6, 0x92, 0x8e, 0x4e, 0xaa, 0xe2, 0x0e, 0x07, 0x55, 0x9c,
+		0x24, 0xed, 0x31, 0x2b, 0xf6, 0x15, 0xae, 0xba, 0x25, 0x98, 0x96, 0xdc, 0x10, 0x8e, 0xd1, 0xa8,
+		0xe9, 0x67, 0xbf, 0x43, 0xe9, 0x60, 0xbc, 0x2e, 0x10, 0x7d, 0xca, 0xf1, 0x4a, 0x11, 0xd3, 0x5a,
+		0x87, 0xc3, 0x92, 0x54, 0xc0, 0x76, 0x22, 0x4f, 0x42, 0xc6, 0x33, 0xbb, 0x38, 0x57, 0xf7, 0x89,
+		0x4e, 0x78, 0xb0, 0xea, 0xaf, 0x47, 0xe0, 0xf0, 0x48, 0xeb, 0x89, 0xd4, 0x20, 0xc9, 0xd3, 0xfc,
+		0x44, 0xf2, 0xd0, 0xc3, 0x93, 0x59, 0x5d, 0x0b, 0x3c, 0x07, 0x50, 0x13, 0xc8, 0xea, 0x87, 0x31,
+		0x35, 0x02, 0xff, 0x22, 0x59, 0x48, 0x05, 0x5e, 0x75, 0x2e, 0x55, 0x2a, 0xb5, 0x75, 0xf9, 0x12,
+		0x6a, 0x99, 0xbd, 0x9b, 0x8c, 0xd5, 0x5a, 0xed, 0x72, 0xad, 0xb2, 0xa1, 0xc4, 0xf0, 0xc7, 0x57,
+		0xf9, 0xdf, 0xe2, 0x05, 0x66, 0x25, 0xee, 0xab, 0xaa, 0xd7, 0x56, 0xab, 0x35, 0x4d, 0x49, 0xa8,
+		0x8f, 0xc1, 0x31, 0xd9, 0x8f, 0xe1, 0x28, 0x8a, 0x1b, 0xcc, 0x88, 0xf8, 0x82, 0x19, 0xea, 0xab,
+		0x51, 0x28, 0x8e, 0x37, 0xbe, 0xc8, 0xe5, 0x81, 0x81, 0x9f, 0x39, 0x80, 0xe5, 0x36, 0x30, 0x7a,
+		0x8c, 0x55, 0xf6, 0xe8, 0x36, 0x75, 0x1a, 0xbb, 0xdc, 0x18, 0xe4, 0x5b, 0xe6, 0x94, 0x36, 0x25,
+		0x6a, 0x19, 0x92, 0xcd, 0xc1, 0x5e, 0xa0, 0x0d, 0x47, 0xe7, 0xba, 0x88, 0x0b, 0x5d, 0x46, 0x9b,
+		0xe2, 0xb5, 0x75, 0x5e, 0xa9, 0x7e, 0xe4, 0x40, 0xbc, 0xcc, 0x40, 0x42, 0xab, 0xe1, 0xaf, 0xd9,
+		0xb0, 0x5f, 0x98, 0x65, 0x7f, 0xea, 0xf5, 0xd5, 0xd2, 0x7a, 0xfd, 0xd2, 0x1a, 0xf2, 0x72, 0x16,
+		0xf2, 0x92, 0x97, 0xb2, 0x32, 0xa1, 0x3e, 0x08, 0x47, 0xc7, 0x58, 0x8e, 0x23, 0xf2, 0x2e, 0x3f,
+		0x19, 0xf1, 0x43, 0x07, 0xad, 0xbf, 0x35, 0x48, 0xda, 0x8e, 0xe1, 0xf4, 0x6d, 0xc1, 0xc4, 0x27,
+		0x27, 0x35, 0x25, 0x17, 0xe4, 0x1f, 0x75, 0x86, 0xae, 0x09, 0x32, 0xea, 0x59, 0x98, 0x0e, 0xb6,
+		0x8c, 0xe7, 0x81, 0x27, 0x44, 0x51, 0xb5, 0xe7, 0x53, 0x45, 0xd2, 0xf7, 0xde, 0xc7, 0x9c, 0x88,
+		0x7a, 0xda, 0x37, 0xa0, 0xe3, 0x62, 0x83, 0x9b, 0x08, 0xff, 0x89, 0x6d, 0x46, 0x57, 0x44, 0x26,
+		0xdc, 0xb2, 0xfa, 0x1c, 0x80, 0x2f, 0xe4, 0xea, 0x66, 0xcd, 0x45, 0xfc, 0x59, 0x73, 0x67, 0x21,
+		0x81, 0x59, 0x2b, 0xd2, 0x84, 0x1a, 0x5e, 0xac, 0x98, 0xd0, 0xea, 0x8b, 0xaf, 0x70, 0x68, 0xb5,
+		0x05, 0x64, 0x38, 0xec, 0x35, 0xe6, 0x13, 0xef, 0x0d, 0x7e, 0xe2, 0xee, 0xb1, 0x01, 0xb4, 0xd1,
+		0x9f, 0x7a, 0x11, 0x12, 0x4c, 0xc3, 0x8d, 0xbc, 0x46, 0xf5, 0x21, 0x00, 0xc3, 0x71, 0x7a, 0xad,
+		0xad, 0xbe, 0xf7, 0x81, 0x93, 0xa3, 0x35, 0x64, 0x49, 0xc2, 0x95, 0xdf, 0x25, 0x54, 0xe5, 0x9c,
+		0x87, 0xea, 0x53, 0x97, 0x3e, 0x82, 0xea, 0x2a, 0x4c, 0x07, 0x71, 0x47, 0x5f, 0x0b, 0x1b, 0xfe,
+		0x51, 0x2d, 0xcf, 0xe0, 0x13, 0x4f, 0x9e, 0xb0, 0x82, 0xfa, 0x4a, 0x04, 0xd2, 0x1b, 0x37, 0xc5,
+		0xda, 0xd9, 0x27, 0x71, 0xd5, 0xbb, 0x1b, 0xe7, 0xc6, 0x43, 0x79, 0xc8, 0x39, 0xe6, 0x06, 0xb2,
+		0x3f, 0xe0, 0x6a, 0x87, 0xf8, 0xa4, 0x3e, 0xba, 0x0c, 0xe8, 0x0b, 0x8d, 0xf8, 0xf4, 0x64, 0xf7,
+		0x50, 0xf0, 0xbc, 0xc1, 0x77, 0x87, 0x84, 0x17, 0xd4, 0xa6, 0x2f, 0x75, 0x83, 0x6f, 0x55, 0xfe,
+		0x0b, 0x2b, 0x91, 0x03, 0x5f, 0x58, 0x71, 0xbf, 0x12, 0xf5, 0x7f, 0xe5, 0x3a, 0xa4, 0xa5, 0x50,
+		0x90, 0xf7, 0xf9, 0xf3, 0x73, 0xe4, 0x31, 0xd4, 0xd8, 0x0d, 0x5b, 0x90, 0xf7, 0x50, 0xd0, 0xe1,
+		0x11, 0x69, 0x8d, 0x9e, 0x2f, 0x23, 0x7e, 0x48, 0x2a, 0xcf, 0x1b, 0x96, 0xa5, 0x23, 0xa3, 0xfe,
+		0x44, 0x04, 0x94, 0x41, 0xa9, 0xfc, 0x6a, 0x76, 0x00, 0x15, 0x31, 0x4a, 0xbf, 0xef, 0xb7, 0x4b,
+		0xf8, 0xcc, 0x4f, 0x61, 0xad, 0xf7, 0xeb, 0x25, 0x1f, 0x8b, 0x42, 0xd6, 0x17, 0xb6, 0x24, 0x4f,
+		0x04, 0xd2, 0x6c, 0xe7, 0xf7, 0x0b, 0x71, 0xfa, 0xf2, 0x6c, 0x03, 0x03, 0x8b, 0x1e, 0x7c, 0x60,
+		0x77, 0xfe, 0x22, 0xc4, 0xe8, 0x1b, 0x55, 0x89, 0x31, 0x37, 0xaa, 0xbe, 0x21, 0x02, 0x69, 0xd7,
+		0x5c, 0x38, 0xe8, 0x81, 0xc5, 0x11, 0x48, 0x8a, 0x1d, 0x91, 0x9f, 0x58, 0x88, 0xd2, 0xc8, 0x70,
+		0x6f, 0x11, 0xd2, 0xf2, 0x67, 0x5a, 0x85, 0xf3, 0xeb, 0x96, 0x4f, 0x9f, 0x87, 0xac, 0xef, 0xec,
+		0x08, 0xf5, 0xc4, 0x6a, 0xed, 0x19, 0xe5, 0x50, 0x31, 0xf5, 0xca, 0xad, 0xf9, 0xd8, 0x2a, 0xbd,
+		0x81, 0x2b, 0x4c, 0xab, 0x55, 0x2e, 0xd5, 0x2a, 0x57, 0x94, 0x48, 0x31, 0xfb, 0xca, 0xad, 0xf9,
+		0x94, 0x46, 0x59, 0xd0, 0xed, 0xf4, 0x15, 0xc8, 0x0f, 0x4c, 0x4c, 0x70, 0x4f, 0x21, 0x30, 0x5d,
+		0xdd, 0xc4, 0x1f, 0xd7, 0x28, 0x6d, 0xd4, 0x74, 0x9e, 0x72, 0x88, 0x0f, 0xa4, 0x2f, 0x2f, 0x5d,
+		0xbc, 0xb4, 0xa1, 0x57, 0x96, 0x97, 0x6a, 0xab, 0x1b, 0x7a, 0x69, 0x63, 0xa3, 0x54, 0xb9, 0xa2,
+		0x44, 0xcf, 0xfc, 0x41, 0x16, 0xf2, 0xa5, 0x72, 0x65, 0x09, 0x6d, 0x82, 0x96, 0x78, 0xee, 0xbf,
+		0x02, 0x71, 0x16, 0x7e, 0xd8, 0x37, 0x9d, 0xa6, 0xb8, 0x7f, 0x44, 0x96, 0x5c, 0x80, 0x04, 0x8b,
+		0x4c, 0x90, 0xfd, 0xf3, 0x6b, 0x8a, 0x21, 0x21, 0x5a, 0xec, 0x0c, 0x5b, 0x4e, 0xfb, 0x26, 0xdc,
+		0x14, 0xf7, 0x8f, 0xd8, 0x12, 0x0d, 0x32, 0x9e, 0x67, 0x13, 0x9e, 0x80, 0x52, 0x9c, 0x40, 0x3b,
+		0x92, 0x65, 0x48, 0x49, 0x67, 0x34, 0x2c, 0x25, 0xa6, 0x18, 0x1a, 0x52, 0x45, 0x76, 0xf1, 0x5d,
+		0x7e, 0xff, 0xfc, 0x9e, 0x62, 0x48, 0x7c, 0x98, 0x2c, 0xb9, 0x17, 0x1d, 0x42, 0xd2, 0x5c, 0x8a,
+		0x61, 0x21, 0x52, 0x64, 0x9a, 0x17, 0x8e, 0x09, 0xcf, 0x5a, 0x2a, 0x4e, 0x10, 0xfa, 0x26, 0x9b,
+		0x00, 0xbe, 0x10, 0xc1, 0x04, 0xe9, 0x48, 0xc5, 0x49, 0x42, 0xda, 0x64, 0x0d, 0xd2, 0xae, 0xc7,
+		0x16, 0x9a, 0x1c, 0x54, 0x0c, 0x8f, 0x2d, 0x93, 0x0f, 0xc3, 0x54, 0xd0, 0x53, 0x99, 0x2c, 0xe5,
+		0xa7, 0x38, 0x61, 0xd0, 0x18, 0xe9, 0x07, 0xdd, 0x96, 0xc9, 0x52, 0x80, 0x8a, 0x13, 0xc6, 0x90,
+		0xc9, 0x0b, 0x30, 0x33, 0xec, 0x56, 0x4c, 0x9e, 0x11, 0x54, 0x3c, 0x40, 0x54, 0x99, 0x74, 0x80,
+		0x8c, 0x70, 0x47, 0x0e, 0x90, 0x20, 0x54, 0x3c, 0x48, 0x90, 0x99, 0x34, 0x21, 0x3f, 0x68, 0xe3,
+		0x4f, 0x9a, 0x30, 0x54, 0x9c, 0x38, 0xe0, 0xcc, 0xbf, 0x12, 0xf4, 0x0d, 0x26, 0x4d, 0x20, 0x2a,
+		0x4e, 0x1c, 0x7f, 0x66, 0x72, 0x2b, 0xcd, 0xfb, 0xd0, 0x84, 0xa2, 0x62, 0x78, 0x1c, 0xba, 0x5c,
+		0x1a, 0x9b, 0x54, 0x7a, 0xff, 0xbe, 0x49, 0xa5, 0x5e, 0x9a, 0xa8, 0x9b, 0x48, 0xfa, 0x5b, 0x15,
+		0x78, 0xb7, 0x78, 0x01, 0xc2, 0x76, 0x8c, 0x6b, 0x2d, 0x73, 0xc7, 0x7d, 0xd2, 0x43, 0x94, 0x45,
+		0x46, 0xe9, 0x11, 0x0e, 0xb5, 0x20, 0x6b, 0x43, 0x1e, 0xf6, 0x18, 0xfb, 0x58, 0x59, 0x58, 0xe6,
+		0x77, 0x78, 0xbe, 0xe8, 0x3e, 0x8f, 0x86, 0x84, 0x3c, 0x4d, 0x32, 0xe2, 0x51, 0x91, 0x90, 0xcc,
+		0xd7, 0xfd, 0x92, 0x6c, 0xd5, 0xef, 0x8e, 0xc0, 0xf4, 0xa5, 0x96, 0xed, 0x58, 0xbd, 0x56, 0xc3,
+		0x68, 0xb3, 0x2d, 0xe8, 0xe9, 0x49, 0x6f, 0xe6, 0x94, 0x33, 0x68, 0xdd, 0x88, 0x97, 0x48, 0x38,
+		0x0a, 0xa9, 0x42, 0xf2, 0xba, 0xd1, 0xe6, 0xf7, 0x62, 0xfc, 0x6f, 0x06, 0x0d, 0xf2, 0xdc, 0x67,
+		0x76, 0xf9, 0xa9, 0x70, 0x5c, 0xf5, 0xd3, 0x2c, 0x73, 0xbe, 0xd3, 0x69, 0xd9, 0x68, 0x0d, 0x6a,
+		0x2c, 0x5e, 0xb4, 0x0e, 0xf1, 0x9e, 0xe1, 0x08, 0x2f, 0xa9, 0xfc, 0x1e, 0xf1, 0x7e, 0xc8, 0x7d,
+		0xe1, 0xaf, 0x80, 0x2c, 0x0c, 0x3f, 0x31, 0xc2, 0x28, 0x91, 0x67, 0x00, 0x33, 0xb6, 0x75, 0x46,
+		0x35, 0x7a, 0x07, 0xa8, 0x62, 0xfa, 0x3a, 0xf6, 0x15, 0x97, 0x24, 0x12, 0x6e, 0xec, 0x1a, 0xe6,
+		0x0e, 0xe5, 0xf4, 0x63, 0x77, 0x80, 0xfe, 0x54, 0xc7, 0xb8, 0x59, 0x61, 0x34, 0xf1, 0x2b, 0x8b,
+		0xe9, 0x57, 0x3f, 0x71, 0xf2, 0x10, 0x4b, 0x00, 0xff, 0xed, 0x08, 0x80, 0xc7, 0x2e, 0x62, 0xe0,
+		0x81, 0x84, 0x2c, 0xb1, 0xcf, 0xcb, 0x03, 0x89, 0xfb, 0xc7, 0xcd, 0xc6, 0x00, 0xb3, 0xcb, 0x53,
+		0xd8, 0xd1, 0xcf, 0xbc, 0x76, 0x32, 0xc2, 0xe7, 0x25, 0xdf, 0x18, 0x98, 0x8c, 0xcb, 0x90, 0xe5,
+		0x71, 0x40, 0x9d, 0x19, 0xc2, 0xd1, 0x50, 0x43, 0x78, 0x4a, 0x1a, 0xc2, 0x9c, 0x20, 0x70, 0x6c,
+		0x6c, 0xf7, 0x8d, 0xe3, 0xd3, 0x11, 0xc8, 0x56, 0x7d, 0x6f, 0x83, 0xe1, 0xa9, 0x92, 0x65, 0xb6,
+		0xae, 0xd1, 0x9e, 0x7b, 0xaa, 0xc4, 0x8b, 0x68, 0xb0, 0xf2, 0xdf, 0x81, 0x74, 0xf6, 0xe4, 0xbb,
+		0x19, 0xb2, 0x8c, 0x58, 0x37, 0xe8, 0x96, 0xdd, 0x92, 0x5c, 0xd7, 0x64, 0x11, 0x33, 0x0a, 0x6c,
+		0xda, 0xe8, 0x63, 0xc0, 0x19, 0x7f, 0x10, 0xd7, 0xc1, 0xdf, 0x6d, 0xe5, 0xb1, 0xdf, 0xbc, 0xac,
+		0xaf, 0xf0, 0x6a, 0x24, 0xd2, 0xa4, 0x8e, 0xd1, 0x6a, 0xf3, 0x1c, 0xb9, 0x8c, 0x26, 0x8b, 0xbe,
+		0xee, 0xfe, 0x78, 0xc6, 0xef, 0x68, 0x56, 0x40, 0xb1, 0xba, 0xb4, 0x17, 0xb8, 0xa4, 0xc8, 0x65,
+		0xb5, 0xf0, 0x47, 0xbf, 0xfc, 0xf0, 0x9c, 0x60, 0xbc, 0xc8, 0x58, 0xe0, 0xcf, 0x61, 0x6b, 0x79,
+		0x89, 0x21, 0xaa, 0xc9, 0x73, 0x81, 0xb3, 0xa4, 0xfe, 0x96, 0xf7, 0x48, 0xc2, 0xdc, 0x10, 0x73,
+		0x4b, 0xe6, 0x5e, 0xb9, 0xf0, 0xfb, 0x1e, 0x69, 0xcf, 0x11, 0xc5, 0x67, 0x09, 0x7c, 0x07, 0x4b,
+		0x8c, 0x0c, 0xfa, 0x03, 0x2f, 0x18, 0xad, 0xb6, 0xfc, 0x11, 0x61, 0x4d, 0x94, 0xc8, 0xa2, 0x1b,
+		0x48, 0xe2, 0xbf, 0x4f, 0xa6, 0x8e, 0x93, 0x91, 0xb2, 0x65, 0x36, 0x83, 0x31, 0x23, 0xb2, 0x01,
+		0x49, 0xc7, 0xba, 0x46, 0x4d, 0xc1, 0xa4, 0x03, 0xc9, 0xf7, 0xf0, 0x8b, 0x41, 0x82, 0x16, 0xd9,
+		0x01, 0xa5, 0x49, 0xdb, 0x74, 0x87, 0xb1, 0xd2, 0xde, 0x35, 0xf0, 0x26, 0x6a, 0xf2, 0x0e, 0xac,
+		0x9f, 0xbc, 0x4b, 0xb5, 0xce, 0x88, 0x92, 0xf5, 0xe0, 0xeb, 0x74, 0x29, 0x91, 0x99, 0x30, 0x66,
+		0xfc, 0x3e, 0xc9, 0xf4, 0xeb, 0x2c, 0x3f, 0x09, 0x94, 0xb0, 0xbe, 0xb9, 0x65, 0x99, 0xec, 0x97,
+		0xbf, 0x84, 0xbb, 0x96, 0xe6, 0x67, 0x8f, 0x6e, 0xbd, 0x38, 0x7b, 0x5c, 0x87, 0x69, 0x0f, 0x94,
+		0xad, 0xa2, 0xcc, 0x41, 0x57, 0xd1, 0x94, 0x4b, 0x00, 0x41, 0xf0, 0x27, 0xc2, 0xbc, 0x75, 0xea,
+		0x66, 0x38, 0x84, 0xae, 0x78, 0xff, 0x60, 0x7c, 0x04, 0x48, 0x1b, 0x66, 0x3b, 0x2d, 0x53, 0xb7,
+		0x69, 0x7b, 0x5b, 0x17, 0x9c, 0x43, 0xba, 0xd9, 0x3b, 0x30, 0xd3, 0x33, 0x9d, 0x96, 0x59, 0xa7,
+		0xed, 0xed, 0xaa, 0x4b, 0x96, 0xbc, 0x07, 0x8e, 0x7b, 0xec, 0xb0, 0x4c, 0x7d, 0xd7, 0x6a, 0x37,
+		0xf5, 0x1e, 0xdd, 0xd6, 0x1b, 0xec, 0xc1, 0xaa, 0x1c, 0x63, 0xe2, 0x51, 0x17, 0x64, 0xcd, 0xbc,
+		0x64, 0xb5, 0x9b, 0x1a, 0xdd, 0xae, 0x60, 0x33, 0x06, 0xf7, 0x3d, 0xec, 0x56, 0x13, 0x33, 0x23,
+		0x62, 0x98, 0x43, 0xec, 0x56, 0x2e, 0x35, 0xf1, 0x6a, 0xb0, 0x22, 0x07, 0x13, 0xb8, 0x0b, 0xbf,
+		0xdf, 0x0a, 0x9d, 0xb6, 0x79, 0x37, 0xe5, 0x02, 0x2d, 0x41, 0xbe, 0x47, 0xdb, 0xc6, 0x9e, 0x2f,
+		0x27, 0x29, 0x1f, 0x46, 0x42, 0x20, 0x48, 0x12, 0x17, 0x81, 0x34, 0x76, 0x8d, 0x76, 0x9b, 0xf2,
+		0x1f, 0xf7, 0x10, 0x54, 0x94, 0x10, 0x2a, 0x33, 0x1e, 0x8e, 0x24, 0x74, 0x14, 0x52, 0x5b, 0x6d,
+		0x9b, 0xc5, 0xa5, 0x66, 0x98, 0xd3, 0x9e, 0xdc, 0x6a, 0xdb, 0x57, 0xe8, 0xde, 0x62, 0xee, 0xe5,
+		0x4f, 0x9c, 0x3c, 0x24, 0xd4, 0xd4, 0x21, 0x75, 0x9d, 0xbd, 0x25, 0x23, 0x90, 0xa8, 0x4d, 0xce,
+		0x41, 0xc6, 0x90, 0x05, 0x7e, 0x0b, 0x69, 0x9f, 0xcf, 0x7a, 0xa0, 0x5c, 0xf1, 0xbd, 0xf4, 0xa7,
+		0xf3, 0x11, 0xf5, 0xc7, 0x23, 0x90, 0xac, 0x5e, 0x5d, 0x37, 0x5a, 0x3d, 0x52, 0xc3, 0xe7, 0x04,
+		0xe5, 0x5a, 0x9d, 0x54, 0xed, 0x79, 0xcb, 0x5b, 0x0e, 0xa5, 0x36, 0xee, 0x8a, 0xf7, 0xbe, 0x64,
+		0x06, 0x2f, 0x7f, 0x0f, 0x0c, 0xfc, 0x32, 0xa4, 0x78, 0x2f, 0x6d, 0xf2, 0x7e, 0x48, 0x74, 0xf1,
+		0x8f, 0x42, 0x24, 0xf0, 0xb8, 0xe0, 0xf0, 0x1a, 0x67, 0xf0, 0xfe, 0x15, 0xc1, 0xf1, 0xd4, 0x7f,
+		0x88, 0x00, 0x54, 0xaf, 0x5e, 0xdd, 0xe8, 0xb5, 0xba, 0x6d, 0xea, 0xdc, 0xa9, 0x61, 0x2f, 0xc3,
+		0x61, 0x6f, 0xd8, 0x76, 0xaf, 0x31, 0xf1, 0xd0, 0x67, 0x5d, 0xb4, 0x7a, 0xaf, 0x31, 0x92, 0x5a,
+		0xd3, 0x76, 0x5c, 0x6a, 0xb1, 0x89, 0xa9, 0x55, 0x6d, 0x67, 0x34, 0x2f, 0x9f, 0x85, 0xac, 0x37,
+		0x7c, 0x9b, 0x2c, 0x41, 0xda, 0x11, 0x7f, 0x0b, 0x96, 0xaa, 0xe3, 0x59, 0x2a, 0xd1, 0xfc, 0x6c,
+		0x75, 0xd1, 0xd5, 0x7f, 0x44, 0xce, 0x7a, 0x7a, 0xe0, 0x1d, 0x25, 0x50, 0xb8, 0xc1, 0x89, 0x0d,
+		0xe8, 0x4e, 0x18, 0x70, 0x82, 0xd6, 0x00, 0x6b, 0x5f, 0x8e, 0xe2, 0xcf, 0x68, 0x0b, 0x3d, 0xf5,
+		0x8e, 0xe5, 0xc4, 0x26, 0xa4, 0xa8, 0xe9, 0xf4, 0x5a, 0x54, 0x9e, 0xd7, 0x3e, 0x3a, 0x6e, 0xc2,
+		0x47, 0x8c, 0xa5, 0x66, 0x3a, 0xbd, 0x3d, 0xff, 0xf4, 0x4b, 0x5a, 0x03, 0xac, 0xf8, 0xcd, 0x18,
+		0x14, 0xc6, 0xa1, 0xe3, 0x0b, 0x1b, 0x8d, 0x1e, 0x65, 0x15, 0x7a, 0xe0, 0x7c, 0x61, 0x5a, 0x56,
+		0x8b, 0x9d, 0x55, 0x03, 0xb4, 0x57, 0x51, 0xba, 0x10, 0xf4, 0xf6, 0x0c, 0xd4, 0x69, 0x8f, 0x02,
+		0xdb, 0x5b, 0x29, 0xe4, 0xe5, 0x9d, 0x88, 0x2d, 0xa3, 0x6d, 0x98, 0x8d, 0xdb, 0x31, 0xe9, 0x87,
+		0x37, 0x42, 0x79, 0xd1, 0xa2, 0xcc, 0x69, 0x92, 0xab, 0x90, 0x92, 0xe4, 0xe3, 0x77, 0x80, 0xbc,
+		0x24, 0x86, 0xb7, 0x63, 0xfc, 0xfb, 0x23, 0x33, 0xd7, 0xe2, 0x5a, 0xd6, 0xad, 0x5b, 0x6a, 0x86,
+		0x6d, 0xc0, 0xc9, 0x7d, 0x37, 0x60, 0x9f, 0x55, 0xfc, 0xaf, 0x62, 0x98, 0xa4, 0xd1, 0xfc, 0x27,
+		0x38, 0x79, 0x5f, 0x03, 0xc0, 0x17, 0x38, 0x2a, 0xdf, 0x42, 0xfc, 0x0e, 0x28, 0x8c, 0x0c, 0xa7,
+		0x57, 0xb5, 0x9d, 0xaf, 0xe6, 0x0c, 0xfe, 0x87, 0x28, 0xe4, 0xfc, 0x33, 0xf8, 0x4f, 0x60, 0xb7,
+		0x23, 0xab, 0x9e, 0x7a, 0xe3, 0xb7, 0x17, 0x1e, 0x18, 0xa7, 0xde, 0x86, 0x64, 0x7b, 0x02, 0xbd,
+		0xf6, 0x66, 0x12, 0x92, 0x22, 0xbf, 0x6f, 0x6d, 0xc8, 0xec, 0x8f, 0x84, 0x5d, 0x42, 0x9f, 0x92,
+		0x97, 0xd0, 0x47, 0x5a, 0xfd, 0xf7, 0x02, 0x5e, 0x4d, 0xd7, 0x03, 0x49, 0x83, 0x78, 0xde, 0x83,
+		0xd1, 0x02, 0x2f, 0xa3, 0x1e, 0xf3, 0x26, 0x11, 0xcc, 0xd3, 0xe1, 0x08, 0x83, 0x97, 0x45, 0x6a,
+		0xbc, 0x86, 0x3c, 0x0c, 0x64, 0xd7, 0x0d, 0x04, 0xe9, 0x1e, 0x33, 0x10, 0x6e, 0xc6, 0x6b, 0x91,
+		0xe0, 0xf8, 0xda, 0x88, 0x65, 0x36, 0x75, 0xfe, 0xcc, 0xac, 0x78, 0xfb, 0x17, 0x6b, 0xaa, 0x58,
+		0x41, 0xbe, 0x8e, 0x3b, 0x0f, 0x03, 0x71, 0x08, 0xe1, 0xc6, 0x2d, 0x1f, 0x6c, 0x51, 0xfc, 0xdd,
+		0x6b, 0x27, 0x8b, 0x7b, 0x46, 0xa7, 0xbd, 0xa8, 0x8e, 0x20, 0xa9, 0x32, 0x67, 0x22, 0x18, 0xbf,
+		0x18, 0xe7, 0xba, 0xa4, 0xde, 0x1e, 0xd7, 0xe5, 0x21, 0x20, 0x78, 0xad, 0xbf, 0x27, 0x7e, 0xaa,
+		0x18, 0xbf, 0x68, 0xec, 0x31, 0xb7, 0x2f, 0xae, 0x29, 0xd7, 0xe8, 0x9e, 0x26, 0x1a, 0xaa, 0x58,
+		0x4f, 0xce, 0xc0, 0xe1, 0x00, 0x74, 0xc3, 0xb2, 0xda, 0x4d, 0xeb, 0x86, 0xc9, 0xdc, 0xbf, 0xb8,
+		0x36, 0xeb, 0x43, 0xa8, 0x88, 0x26, 0xbc, 0x3c, 0x11, 0xc0, 0xb1, 0xae, 0xd3, 0x5e, 0xdb, 0xe8,
+		0x32, 0x1f, 0x2f, 0xae, 0x11, 0x1f, 0xca, 0x1a, 0x6f, 0x21, 0x5d, 0x38, 0xcc, 0x46, 0xcf, 0xb4,
+		0xab, 0xef, 0x26, 0x9a, 0x48, 0x5a, 0x7f, 0x6c, 0x9c, 0x74, 0xe3, 0xd0, 0x2a, 0x0c, 0x67, 0xe0,
+		0x01, 0x03, 0xbf, 0x94, 0xcf, 0xda, 0xc3, 0x50, 0xe4, 0x43, 0x50, 0xc0, 0x90, 0x83, 0x88, 0x5a,
+		0x78, 0x7d, 0xdd, 0xa6, 0x54, 0xa4, 0xba, 0x1f, 0x5b, 0x18, 0xf1, 0xaa, 0xf2, 0x02, 0xbe, 0x57,
+		0xec, 0x27, 0xce, 0xd2, 0xc5, 0x78, 0xcc, 0x42, 0x0e, 0xea, 0x02, 0xa5, 0x8b, 0xa7, 0xa4, 0x7a,
+		0x7a, 0xe5, 0xf5, 0x9f, 0x3f, 0x7d, 0xdc, 0x37, 0x51, 0x37, 0xdd, 0xa0, 0x2f, 0xef, 0xa8, 0xfa,
+		0x2d, 0x31, 0x38, 0x36, 0x76, 0x18, 0x18, 0xd8, 0xa1, 0xa6, 0xb1, 0x85, 0x71, 0x90, 0x08, 0xff,
+		0x29, 0x0f, 0x51, 0x24, 0x1f, 0x81, 0x29, 0x57, 0x68, 0x50, 0x90, 0x0b, 0xd1, 0x3b, 0x20, 0x2e,
+		0x59, 0x21, 0x2e, 0x18, 0x3c, 0x21, 0x2f, 0x60, 0x50, 0xa9, 0x6b, 0xd9, 0xec, 0xce, 0x55, 0x6c,
+		0x7f, 0x8e, 0x9c, 0xc5, 0xcf, 0xfe, 0xcc, 0x9f, 0x9d, 0x3c, 0x35, 0xc1, 0x67, 0x11, 0xc1, 0x16,
+		0x0a, 0x48, 0x7c, 0x00, 0xdf, 0xd5, 0x37, 0xda, 0x6d, 0xeb, 0x86, 0xde, 0x6e, 0xb1, 0xcd, 0x28,
+		0xcc, 0xcd, 0x43, 0xd8, 0xe5, 0x16, 0xdf, 0x68, 0x68, 0xd7, 0x6a, 0xec, 0xea, 0xe8, 0x6c, 0x3a,
+		0xbb, 0x72, 0xa3, 0x61, 0x75, 0xcb, 0xac, 0x0a, 0x1f, 0x8e, 0x40, 0x5d, 0xd2, 0xa5, 0x3d, 0x9d,
+		0x55, 0xb3, 0x65, 0x3d, 0xa5, 0xa1, 0x82, 0x59, 0xa7, 0xbd, 0x1a, 0x56, 0x89, 0x87, 0xa3, 0xff,
+		0x75, 0x04, 0x14, 0x77, 0x06, 0xaa, 0xa2, 0x6b, 0x23, 0x2d, 0xd2, 0xc8, 0x81, 0x2d, 0xd2, 0x5d,
+		0xdf, 0x6b, 0xd6, 0x6f, 0x0f, 0x33, 0x05, 0x7d, 0xf5, 0xdf, 0x45, 0x21, 0x7b, 0xc5, 0x13, 0xc7,
+		0x3b, 0x35, 0x80, 0x11, 0xb1, 0x84, 0xe8, 0x1d, 0x89, 0x25, 0xc4, 0xbe, 0xa2, 0x58, 0x42, 0xdc,
+		0x1f, 0x4b, 0xc0, 0xc8, 0x8a, 0xdd, 0xdf, 0x62, 0x0f, 0x2e, 0xfa, 0x2f, 0x84, 0xe5, 0x78, 0xa5,
+		0x30, 0xda, 0x1e, 0xc4, 0xdf, 0x36, 0x70, 0x5a, 0xd7, 0x03, 0xf6, 0x1d, 0xb7, 0x37, 0x14, 0xaf,
+		0x81, 0x03, 0xab, 0x7f, 0x1f, 0x85, 0x19, 0x1f, 0x37, 0x35, 0xda, 0xb0, 0x7a, 0xcd, 0x3b, 0xc5,
+		0xd3, 0xcb, 0x78, 0xcf, 0x8e, 0x5e, 0xd7, 0x0f, 0xca, 0x58, 0x82, 0x58, 0x5a, 0x90, 0xb9, 0xeb,
+		0x70, 0x94, 0xd1, 0xba, 0x0d, 0x0e, 0x1f, 0x46, 0xc4, 0xca, 0x10, 0x97, 0xe7, 0x21, 0xc7, 0x28,
+		0x06, 0x59, 0x0d, 0x58, 0x57, 0xe6, 0xec, 0x1e, 0xc9, 0xc9, 0xc4, 0x68, 0x4e, 0xe2, 0xc6, 0x23,
+		0x76, 0x02, 0x1d, 0x6f, 0x25, 0x05, 0xf9, 0x2e, 0x5a, 0x6a, 0x66, 0x53, 0xf0, 0xfd, 0xcd, 0x28,
+		0x1c, 0xab, 0x70, 0xdd, 0xea, 0x63, 0x3f, 0x3f, 0xfc, 0xd9, 0xbb, 0x33, 0xe1, 0xeb, 0xab, 0x90,
+		0x47, 0x9b, 0xd3, 0xb7, 0x0f, 0xdc, 0x66, 0xf4, 0x7a, 0xca, 0x6a, 0x37, 0x2b, 0xee, 0x3e, 0x80,
+		0x74, 0x4d, 0x7a, 0x23, 0x40, 0x37, 0x76, 0x7b, 0x74, 0x4d, 0x7a, 0xc3, 0x47, 0xd7, 0xcb, 0xa9,
+		0x89, 0x07, 0x12, 0x7c, 0xce, 0x41, 0x0c, 0xb7, 0xad, 0xc4, 0x01, 0xb6, 0x2d, 0x44, 0x58, 0x4c,
+		0xbf, 0x2c, 0x2d, 0xbe, 0x9f, 0x8b, 0x00, 0xf1, 0x4c, 0x04, 0xf7, 0xba, 0xe1, 0x0a, 0xbb, 0x84,
+		0x26, 0x6a, 0xdd, 0xfb, 0x86, 0x63, 0x03, 0xce, 0x12, 0xd2, 0xff, 0x21, 0x1f, 0x01, 0xf2, 0x3e,
+		0xcf, 0x5d, 0x8c, 0x1e, 0xa0, 0xaf, 0x12, 0xc9, 0xb5, 0xf9, 0x0f, 0xa9, 0xaf, 0x45, 0x30, 0xcd,
+		0x77, 0xc0, 0xb2, 0x75, 0xbb, 0xdd, 0x00, 0xd2, 0xf3, 0x35, 0x32, 0xeb, 0x50, 0x26, 0xc3, 0xdd,
+		0x9e, 0xa1, 0x3c, 0xd3, 0x1b, 0x6c, 0x7d, 0xbb, 0x7c, 0x5f, 0xb1, 0x13, 0xfd, 0x5e, 0x04, 0xaf,
+		0x9d, 0x37, 0x87, 0xa7, 0xa4, 0x0e, 0x39, 0x7f, 0x5f, 0xc4, 0xa8, 0xde, 0x3d, 0xc9, 0xa8, 0xfc,
+		0x03, 0x0a, 0x10, 0xc1, 0xb1, 0x48, 0x0b, 0x9a, 0x6f, 0x4e, 0x8f, 0x4d, 0xcc, 0x25, 0x37, 0x69,
+		0x64, 0x94, 0x5b, 0x11, 0x67, 0x93, 0xf5, 0x1d, 0x51, 0x88, 0xaf, 0x5b, 0x56, 0x9b, 0x7c, 0x43,
+		0x04, 0x66, 0x4c, 0xcb, 0x61, 0xe6, 0x0a, 0x6d, 0xea, 0xe2, 0x2c, 0x86, 0x2f, 0xdb, 0xab, 0x07,
+		0xe3, 0xde, 0x9b, 0xaf, 0x9d, 0x1c, 0x26, 0x35, 0xea, 0x37, 0x27, 0xf2, 0xa6, 0xe5, 0x94, 0x19,
+		0xd0, 0x06, 0x83, 0x21, 0x37, 0x60, 0x2a, 0xf8, 0x7d, 0xae, 0x6b, 0xb5, 0x03, 0x7f, 0x7f, 0x2a,
+		0xf4, 0xdb, 0xb9, 0x2d, 0xdf, 0x87, 0xf9, 0xcb, 0xef, 0x7f, 0x83, 0x93, 0xfb, 0x1c, 0x28, 0x03,
+		0x49, 0x9b, 0x18, 0xf7, 0x4a, 0x1d, 0xf4, 0x16, 0x84, 0x9f, 0xe3, 0x02, 0xf7, 0xf4, 0xaf, 0x44,
+		0x00, 0xbc, 0x93, 0x2f, 0x7c, 0x2e, 0xaf, 0xbc, 0xb6, 0x5a, 0xd5, 0xeb, 0x1b, 0xa5, 0x8d, 0xcd,
+		0x7a, 0xf0, 0x77, 0x86, 0xe4, 0xe3, 0x7a, 0x76, 0x97, 0x36, 0x5a, 0xdb, 0x2d, 0xda, 0x24, 0xf7,
+		0xc1, 0x5c, 0x10, 0x1a, 0x4b, 0xb5, 0xaa, 0x12, 0x29, 0xe6, 0x5e, 0xb9, 0x35, 0x9f, 0xe6, 0xa1,
+		0x2e, 0x8a, 0x4f, 0x1b, 0x1f, 0x1e, 0x86, 0xc3, 0xdf, 0x28, 0x8a, 0x16, 0xa7, 0x5e, 0xb9, 0x35,
+		0x9f, 0x71, 0x63, 0x62, 0x44, 0x05, 0xe2, 0x87, 0x14, 0xf4, 0x62, 0x45, 0x78, 0xe5, 0xd6, 0x7c,
+		0x92, 0x4f, 0x4b, 0x31, 0x8e, 0x4f, 0xe8, 0x9d, 0xfe, 0x10, 0xc0, 0x92, 0xb9, 0xdd, 0xc3, 0x4d,
+		0xc3, 0x32, 0x49, 0x11, 0x8e, 0x2c, 0xad, 0x5e, 0xd0, 0x4a, 0x95, 0x8d, 0xa5, 0xb5, 0xd5, 0x60,
+		0xb7, 0x07, 0xda, 0xaa, 0x6b, 0x9b, 0xe5, 0xe5, 0x9a, 0x8e, 0x8f, 0xf6, 0xf1, 0x74, 0xbc, 0x40,
+		0xdb, 0x33, 0xab, 0x1b, 0x4b, 0x2b, 0x35, 0x25, 0x5a, 0xbe, 0x30, 0x36, 0x7f, 0xe3, 0xa1, 0x7d,
+		0x27, 0xdc, 0x33, 0xd7, 0x03, 0x49, 0x1c, 0xff, 0x7b, 0x00, 0x0f, 0xaf, 0x9b, 0xce, 0x7f, 0xb1,
 		0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
@@ -2327,43 +2873,31 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
 		return false
 	}
-	return true
-}
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.KeyRotationDelay != that1.KeyRotationDelay {
+		return false
 	}
-
-	that1, ok := that.(*RedelegationEntryResponse)
-	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.KeyRotationCooldown != that1.KeyRotationCooldown {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.KeyRotationOverlap != that1.KeyRotationOverlap {
 		return false
 	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+	if !this.SelfCreateValidator.Equal(&that1.SelfCreateValidator) {
 		return false
 	}
-	if !this.Balance.Equal(that1.Balance) {
+	if !this.ConsPubkeyRotationFee.Equal(&that1.ConsPubkeyRotationFee) {
 		return false
 	}
 	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
+func (this *SelfCreateValidatorParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Pool)
+	that1, ok := that.(*SelfCreateValidatorParams)
 	if !ok {
-		that2, ok := that.(Pool)
+		that2, ok := that.(SelfCreateValidatorParams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2375,16 +2909,92 @@ func (this *Pool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+	if this.Enabled != that1.Enabled {
 		return false
 	}
-	if !this.BondedTokens.Equal(that1.BondedTokens) {
+	if !this.MinSelfBond.Equal(that1.MinSelfBond) {
 		return false
 	}
-	return true
-}
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	if len(this.AllowList) != len(that1.AllowList) {
+		return false
+	}
+	for i := range this.AllowList {
+		if this.AllowList[i] != that1.AllowList[i] {
+			return false
+		}
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.MaxPerEpoch != that1.MaxPerEpoch {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
+}
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+		return false
+	}
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -3252,6 +3862,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ConsPubkeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.SelfCreateValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.KeyRotationOverlap != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.KeyRotationOverlap))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.KeyRotationCooldown != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.KeyRotationCooldown))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.KeyRotationDelay != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.KeyRotationDelay))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -3294,18 +3939,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *SelfCreateValidatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3315,83 +3960,73 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SelfCreateValidatorParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SelfCreateValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if m.MaxPerEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxPerEpoch))
+		i--
+		dAtA[i] = 0x30
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Balance.Size()
+		size := m.MinSelfBond.Size()
 		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinSelfBond.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3401,20 +4036,20 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3425,20 +4060,17 @@ func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3448,40 +4080,58 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.BondedTokens.Size()
-		i -= size
-		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.NotBondedTokens.Size()
-		i -= size
-		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if m.SubmitHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+func (m *KeyRotationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3491,300 +4141,360 @@ func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+func (m *KeyRotationRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KeyRotationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.OverlapEndHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.OverlapEndHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.ActivationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PrevBlsKey) > 0 {
+		i -= len(m.PrevBlsKey)
+		copy(dAtA[i:], m.PrevBlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PrevBlsKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.PrevChallengerAddress) > 0 {
+		i -= len(m.PrevChallengerAddress)
+		copy(dAtA[i:], m.PrevChallengerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PrevChallengerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PrevRelayerAddress) > 0 {
+		i -= len(m.PrevRelayerAddress)
+		copy(dAtA[i:], m.PrevRelayerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PrevRelayerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	return n
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
-	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
-	}
-	if len(m.UnbondingIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingIds {
-			l += sovStaking(uint64(e))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovStaking(uint64(l)) + l
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.SelfDelAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.RelayerAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ChallengerAddress)
-	if l > 0 {
-		n += 2 + l + sovStaking(uint64(l))
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BlsKey)
-	if l > 0 {
-		n += 2 + l + sovStaking(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	return n
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
-}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotBondedTokens.Size()
+		i -= size
+		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -3792,143 +4502,243 @@ func (m *UnbondingDelegation) Size() (n int) {
 	return n
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	l = m.Rate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
+	l = m.MaxRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.MaxChangeRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
-	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
-	}
 	return n
 }
 
-func (m *RedelegationEntry) Size() (n int) {
+func (m *Commission) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
+	l = m.CommissionRates.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.SharesDst.Size()
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
 	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
-	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
 	return n
 }
 
-func (m *Redelegation) Size() (n int) {
+func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.OperatorAddress)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = len(m.ValidatorSrcAddress)
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	l = len(m.SelfDelAddress)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = len(m.ValidatorDstAddress)
+	l = len(m.RelayerAddress)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *DVPair) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.BondDenom)
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *DelegationResponse) Size() (n int) {
+func (m *DVVTriplets) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *Delegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *RedelegationResponse) Size() (n int) {
+func (m *UnbondingDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	if len(m.Entries) > 0 {
 		for _, e := range m.Entries {
 			l = e.Size()
@@ -3938,59 +4748,1750 @@ func (m *RedelegationResponse) Size() (n int) {
 	return n
 }
 
-func (m *Pool) Size() (n int) {
+func (m *UnbondingDelegationEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.NotBondedTokens.Size()
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
-func (m *ValidatorUpdates) Size() (n int) {
+func (m *RedelegationEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.KeyRotationDelay != 0 {
+		n += 1 + sovStaking(uint64(m.KeyRotationDelay))
+	}
+	if m.KeyRotationCooldown != 0 {
+		n += 1 + sovStaking(uint64(m.KeyRotationCooldown))
+	}
+	if m.KeyRotationOverlap != 0 {
+		n += 1 + sovStaking(uint64(m.KeyRotationOverlap))
+	}
+	l = m.SelfCreateValidator.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ConsPubkeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SelfCreateValidatorParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinSelfBond.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
+	if m.MaxPerEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.MaxPerEpoch))
+	}
+	return n
+}
+
+func (m *ValidatorDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovStaking(uint64(m.SubmitHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *KeyRotationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PrevRelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PrevChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PrevBlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ActivationHeight))
+	}
+	if m.OverlapEndHeight != 0 {
+		n += 1 + sovStaking(uint64(m.OverlapEndHeight))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfDelAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4013,48 +6514,15 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4081,8 +6549,8 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4107,7 +6575,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +6598,15 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4166,13 +6634,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4200,13 +6666,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4234,9 +6698,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4259,7 +6721,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4282,48 +6744,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4350,7 +6779,8 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4375,7 +6805,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4398,15 +6828,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4434,11 +6864,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4466,11 +6896,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4498,11 +6928,63 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4530,13 +7012,45 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4546,23 +7060,25 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4585,7 +7101,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4608,17 +7124,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var stringLen uint64
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4628,27 +7144,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4675,55 +7178,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4751,13 +7212,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4785,48 +7246,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			m.UnbondingHeight = 0
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4836,16 +7264,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
 			}
-			var msglen int
+			m.UnbondingOnHoldRefCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4855,97 +7283,66 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4955,92 +7352,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
-			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5050,27 +7371,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SelfDelAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +7420,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5130,13 +7454,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			var byteLen int
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5146,26 +7472,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
 			}
-			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlsKey == nil {
-				m.BlsKey = []byte{}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5187,7 +7517,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5210,15 +7540,15 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5246,61 +7576,43 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5328,13 +7640,13 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5344,23 +7656,25 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5383,7 +7697,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5406,15 +7720,15 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5441,64 +7755,70 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5526,11 +7846,11 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5558,11 +7878,13 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5590,61 +7912,103 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationDelay", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.KeyRotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationCooldown", wireType)
 			}
-			if iNdEx >= l {
+			m.KeyRotationCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationOverlap", wireType)
+			}
+			m.KeyRotationOverlap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationOverlap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfCreateValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SelfCreateValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5671,8 +8035,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsPubkeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5697,7 +8060,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *SelfCreateValidatorParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5720,17 +8083,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SelfCreateValidatorParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SelfCreateValidatorParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5740,27 +8103,15 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5788,13 +8139,15 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MinSelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5804,79 +8157,29 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types2.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5904,13 +8207,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 			}
-			var stringLen uint64
+			m.EpochLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5920,29 +8223,16 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerEpoch", wireType)
 			}
-			var msglen int
+			m.MaxPerEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5952,26 +8242,11 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPerEpoch |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5993,7 +8268,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6016,67 +8291,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6104,68 +8327,13 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6175,11 +8343,26 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types2.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6201,7 +8384,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6224,17 +8407,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6244,16 +8427,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6263,28 +8459,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6312,15 +8507,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6330,31 +8523,31 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
 			}
-			m.UnbondingId = 0
+			m.SubmitHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6364,16 +8557,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				m.SubmitHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6383,7 +8576,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6409,7 +8602,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *KeyRotationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6432,15 +8625,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6468,11 +8661,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRelayerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6500,11 +8693,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.PrevRelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevChallengerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6532,13 +8725,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			m.PrevChallengerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlsKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6548,26 +8741,64 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrevBlsKey = append(m.PrevBlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevBlsKey == nil {
+				m.PrevBlsKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapEndHeight", wireType)
+			}
+			m.OverlapEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6589,7 +8820,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6612,17 +8843,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6632,30 +8863,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6665,54 +8895,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
 			}
-		case 5:
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6722,29 +8931,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6754,31 +8967,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6788,23 +8986,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
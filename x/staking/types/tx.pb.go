@@ -124,6 +124,91 @@ func (m *MsgCreateValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateValidatorResponse proto.InternalMessageInfo
 
+// MsgCreateValidatorSelf defines a SDK message for creating a new validator signed by its operator.
+// The operator is the self delegator of the validator.
+type MsgCreateValidatorSelf struct {
+	Description       Description                            `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
+	Commission        CommissionRates                        `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission"`
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	ValidatorAddress  string                                 `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pubkey            *types.Any                             `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Value             types1.Coin                            `protobuf:"bytes,6,opt,name=value,proto3" json:"value"`
+	RelayerAddress    string                                 `protobuf:"bytes,7,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	ChallengerAddress string                                 `protobuf:"bytes,8,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	BlsKey            string                                 `protobuf:"bytes,9,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	BlsProof          string                                 `protobuf:"bytes,10,opt,name=bls_proof,json=blsProof,proto3" json:"bls_proof,omitempty"`
+}
+
+func (m *MsgCreateValidatorSelf) Reset()         { *m = MsgCreateValidatorSelf{} }
+func (m *MsgCreateValidatorSelf) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateValidatorSelf) ProtoMessage()    {}
+func (*MsgCreateValidatorSelf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{2}
+}
+func (m *MsgCreateValidatorSelf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateValidatorSelf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateValidatorSelf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateValidatorSelf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateValidatorSelf.Merge(m, src)
+}
+func (m *MsgCreateValidatorSelf) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateValidatorSelf) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateValidatorSelf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateValidatorSelf proto.InternalMessageInfo
+
+// MsgCreateValidatorSelfResponse defines the Msg/CreateValidatorSelf response type.
+type MsgCreateValidatorSelfResponse struct {
+}
+
+func (m *MsgCreateValidatorSelfResponse) Reset()         { *m = MsgCreateValidatorSelfResponse{} }
+func (m *MsgCreateValidatorSelfResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateValidatorSelfResponse) ProtoMessage()    {}
+func (*MsgCreateValidatorSelfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{3}
+}
+func (m *MsgCreateValidatorSelfResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateValidatorSelfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateValidatorSelfResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateValidatorSelfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateValidatorSelfResponse.Merge(m, src)
+}
+func (m *MsgCreateValidatorSelfResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateValidatorSelfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateValidatorSelfResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateValidatorSelfResponse proto.InternalMessageInfo
+
 // MsgEditValidator defines a SDK message for editing an existing validator.
 type MsgEditValidator struct {
 	Description      Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
@@ -144,7 +229,7 @@ func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
 func (m *MsgEditValidator) String() string { return proto.CompactTextString(m) }
 func (*MsgEditValidator) ProtoMessage()    {}
 func (*MsgEditValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{2}
+	return fileDescriptor_0926ef28816b35ab, []int{4}
 }
 func (m *MsgEditValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,7 +266,7 @@ func (m *MsgEditValidatorResponse) Reset()         { *m = MsgEditValidatorRespon
 func (m *MsgEditValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditValidatorResponse) ProtoMessage()    {}
 func (*MsgEditValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{3}
+	return fileDescriptor_0926ef28816b35ab, []int{5}
 }
 func (m *MsgEditValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +307,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{4}
+	return fileDescriptor_0926ef28816b35ab, []int{6}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +344,7 @@ func (m *MsgDelegateResponse) Reset()         { *m = MsgDelegateResponse{} }
 func (m *MsgDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateResponse) ProtoMessage()    {}
 func (*MsgDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{5}
+	return fileDescriptor_0926ef28816b35ab, []int{7}
 }
 func (m *MsgDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +386,7 @@ func (m *MsgBeginRedelegate) Reset()         { *m = MsgBeginRedelegate{} }
 func (m *MsgBeginRedelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegate) ProtoMessage()    {}
 func (*MsgBeginRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{6}
+	return fileDescriptor_0926ef28816b35ab, []int{8}
 }
 func (m *MsgBeginRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +424,7 @@ func (m *MsgBeginRedelegateResponse) Reset()         { *m = MsgBeginRedelegateRe
 func (m *MsgBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegateResponse) ProtoMessage()    {}
 func (*MsgBeginRedelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{7}
+	return fileDescriptor_0926ef28816b35ab, []int{9}
 }
 func (m *MsgBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +472,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{8}
+	return fileDescriptor_0926ef28816b35ab, []int{10}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +510,7 @@ func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
 func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateResponse) ProtoMessage()    {}
 func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{9}
+	return fileDescriptor_0926ef28816b35ab, []int{11}
 }
 func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +562,7 @@ func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbonding
 func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{10}
+	return fileDescriptor_0926ef28816b35ab, []int{12}
 }
 func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +601,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelU
 func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{11}
+	return fileDescriptor_0926ef28816b35ab, []int{13}
 }
 func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,6 +630,82 @@ func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgRotateConsPubKey defines a SDK message for rotating the consensus pubkey of a validator.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NewPubkey        *types.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse defines the Msg/RotateConsPubKey response type.
+type MsgRotateConsPubKeyResponse struct {
+}
+
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -561,7 +722,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{12}
+	return fileDescriptor_0926ef28816b35ab, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +776,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{13}
+	return fileDescriptor_0926ef28816b35ab, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,6 +808,8 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
+	proto.RegisterType((*MsgCreateValidatorSelf)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorSelf")
+	proto.RegisterType((*MsgCreateValidatorSelfResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorSelfResponse")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.v1beta1.MsgEditValidator")
 	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.v1beta1.MsgDelegate")
@@ -657,6 +820,8 @@ func init() {
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
 	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 }
@@ -664,82 +829,92 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0xdd, 0x24, 0x9b, 0x64, 0xd2, 0x64, 0x1b, 0x27, 0x69, 0x36, 0x6e, 0xd9, 0xad, 0xb6,
-	0xa1, 0x89, 0x42, 0xe3, 0xa5, 0x01, 0xf1, 0x11, 0x22, 0x44, 0xb6, 0x69, 0xa1, 0x94, 0x48, 0x91,
-	0x43, 0x39, 0x20, 0xa4, 0xd5, 0xd8, 0x9e, 0x78, 0xad, 0xd8, 0x1e, 0xd7, 0x33, 0x1b, 0x75, 0x6f,
-	0x88, 0x13, 0xe2, 0x42, 0x0f, 0x1c, 0xb8, 0x20, 0xf5, 0xc8, 0x31, 0x87, 0x5e, 0x39, 0x22, 0x55,
-	0x9c, 0xaa, 0x9e, 0x10, 0x87, 0x16, 0x25, 0x87, 0xf0, 0x1f, 0x20, 0x71, 0x42, 0x63, 0x8f, 0x3f,
-	0xf7, 0x33, 0x25, 0x3d, 0xd0, 0xcb, 0xae, 0x3d, 0xf3, 0x7b, 0xbf, 0x79, 0xef, 0xf7, 0xde, 0x9b,
-	0x19, 0x83, 0xb2, 0x8a, 0x89, 0x85, 0x49, 0x95, 0x50, 0xb8, 0x6f, 0xd8, 0x7a, 0xf5, 0xe0, 0xba,
-	0x82, 0x28, 0xbc, 0x5e, 0xa5, 0xf7, 0x25, 0xc7, 0xc5, 0x14, 0x0b, 0x17, 0x7c, 0x80, 0xc4, 0x01,
-	0x12, 0x07, 0x88, 0x0b, 0x3a, 0xc6, 0xba, 0x89, 0xaa, 0x1e, 0x4a, 0x69, 0xee, 0x55, 0xa1, 0xdd,
-	0xf2, 0x4d, 0xc4, 0x72, 0x7a, 0x8a, 0x1a, 0x16, 0x22, 0x14, 0x5a, 0x0e, 0x07, 0xcc, 0xea, 0x58,
-	0xc7, 0xde, 0x63, 0x95, 0x3d, 0xf1, 0xd1, 0x05, 0x7f, 0xa5, 0xba, 0x3f, 0xc1, 0x97, 0xf5, 0xa7,
-	0x4a, 0xdc, 0x4b, 0x05, 0x12, 0x14, 0xba, 0xa8, 0x62, 0xc3, 0xe6, 0xf3, 0x8b, 0x5d, 0xa2, 0x08,
-	0x9c, 0xf6, 0x51, 0xf3, 0x1c, 0x65, 0x11, 0x86, 0x60, 0x7f, 0x7c, 0x62, 0x1a, 0x5a, 0x86, 0x8d,
-	0xab, 0xde, 0xaf, 0x3f, 0x54, 0xf9, 0x25, 0x0f, 0x84, 0x6d, 0xa2, 0xdf, 0x70, 0x11, 0xa4, 0xe8,
-	0x0b, 0x68, 0x1a, 0x1a, 0xa4, 0xd8, 0x15, 0x76, 0xc0, 0x84, 0x86, 0x88, 0xea, 0x1a, 0x0e, 0x35,
-	0xb0, 0x5d, 0xcc, 0x5e, 0xce, 0x2e, 0x4f, 0xac, 0x5d, 0x91, 0x3a, 0x6b, 0x24, 0x6d, 0x45, 0xd0,
-	0xda, 0xf8, 0xe3, 0x67, 0xe5, 0xcc, 0xcf, 0x27, 0x87, 0x2b, 0x59, 0x39, 0x4e, 0x21, 0xc8, 0x00,
-	0xa8, 0xd8, 0xb2, 0x0c, 0x42, 0x18, 0x61, 0xce, 0x23, 0x5c, 0xea, 0x46, 0x78, 0x23, 0x44, 0xca,
-	0x90, 0x22, 0x12, 0x27, 0x8d, 0xb1, 0x08, 0x26, 0x98, 0xb1, 0x0c, 0xbb, 0x4e, 0x90, 0xb9, 0x57,
-	0xd7, 0x90, 0x89, 0x74, 0xe8, 0x79, 0x3b, 0x74, 0x39, 0xbb, 0x3c, 0x5e, 0xdb, 0x60, 0x36, 0x7f,
-	0x3c, 0x2b, 0x5f, 0xd5, 0x0d, 0xda, 0x68, 0x2a, 0x92, 0x8a, 0x2d, 0x2e, 0x36, 0xff, 0x5b, 0x25,
-	0xda, 0x7e, 0x95, 0xb6, 0x1c, 0x44, 0xa4, 0xdb, 0x36, 0x7d, 0xfa, 0x68, 0x15, 0x70, 0x6f, 0x6e,
-	0xdb, 0x54, 0x9e, 0xb6, 0x0c, 0x7b, 0x17, 0x99, 0x7b, 0x5b, 0x21, 0xad, 0x70, 0x13, 0x4c, 0xf3,
-	0x45, 0xb0, 0x5b, 0x87, 0x9a, 0xe6, 0x22, 0x42, 0x8a, 0xc3, 0xde, 0x5a, 0xc5, 0xa7, 0x8f, 0x56,
-	0x67, 0xb9, 0xf5, 0xa6, 0x3f, 0xb3, 0x4b, 0x5d, 0xc3, 0xd6, 0xe5, 0xf3, 0xa1, 0x09, 0x1f, 0x67,
-	0x34, 0x07, 0x81, 0xce, 0x21, 0xcd, 0x48, 0x3f, 0x9a, 0xd0, 0x24, 0xa0, 0xb9, 0x05, 0xf2, 0x4e,
-	0x53, 0xd9, 0x47, 0xad, 0x62, 0xde, 0xd3, 0x72, 0x56, 0xf2, 0xab, 0x51, 0x0a, 0xaa, 0x51, 0xda,
-	0xb4, 0x5b, 0xb5, 0xe2, 0x6f, 0x11, 0xa3, 0xea, 0xb6, 0x1c, 0x8a, 0xa5, 0x9d, 0xa6, 0x72, 0x07,
-	0xb5, 0x64, 0x6e, 0x2d, 0xac, 0x83, 0x91, 0x03, 0x68, 0x36, 0x51, 0x71, 0xd4, 0xa3, 0x59, 0x08,
-	0x52, 0xc2, 0x4a, 0x30, 0x96, 0x0f, 0x23, 0x91, 0x59, 0xdf, 0x44, 0xb8, 0x06, 0x86, 0xf7, 0x5c,
-	0x6c, 0x15, 0xc7, 0xfa, 0x78, 0xef, 0xa1, 0x84, 0x4d, 0x50, 0x70, 0x91, 0x09, 0x5b, 0x28, 0x0a,
-	0x7b, 0xbc, 0x8f, 0xe1, 0x14, 0x37, 0x08, 0x82, 0xfe, 0x18, 0x08, 0x6a, 0x03, 0x9a, 0x26, 0xb2,
-	0xf5, 0x18, 0x0b, 0xe8, 0xc3, 0x32, 0x1d, 0xd9, 0x04, 0x44, 0xf3, 0x60, 0x54, 0x31, 0x49, 0x9d,
-	0xc9, 0x37, 0xc1, 0xac, 0xe5, 0xbc, 0x62, 0x92, 0x3b, 0xa8, 0x25, 0x5c, 0x04, 0xe3, 0x6c, 0xc2,
-	0x71, 0x31, 0xde, 0x2b, 0x9e, 0xf3, 0xa6, 0xc6, 0x14, 0x93, 0xec, 0xb0, 0xf7, 0xf5, 0xe9, 0x6f,
-	0x1f, 0x96, 0x33, 0x7f, 0x3d, 0x2c, 0x67, 0xbe, 0x39, 0x39, 0x5c, 0xf1, 0x82, 0xaa, 0x5c, 0x02,
-	0x62, 0x7b, 0xfb, 0xc8, 0x88, 0x38, 0xd8, 0x26, 0xa8, 0xf2, 0xcf, 0x30, 0x38, 0xbf, 0x4d, 0xf4,
-	0x9b, 0x9a, 0x41, 0x5f, 0x66, 0x6f, 0x75, 0x2c, 0xa9, 0xdc, 0xa9, 0x4b, 0x0a, 0x82, 0x42, 0xd4,
-	0x5c, 0x75, 0x17, 0x52, 0xc4, 0x5b, 0xe9, 0xbd, 0x01, 0xdb, 0x68, 0x0b, 0xa9, 0xb1, 0x36, 0xda,
-	0x42, 0xaa, 0x3c, 0xa5, 0x26, 0x3a, 0x59, 0x68, 0x74, 0xee, 0xd8, 0xe1, 0x53, 0x2d, 0x33, 0x50,
-	0xb7, 0x76, 0xa8, 0xb6, 0x91, 0x33, 0xa9, 0xb6, 0xfc, 0x7f, 0xaa, 0xb6, 0xd1, 0xee, 0xd5, 0x36,
-	0x96, 0xaa, 0xb6, 0x0f, 0xe3, 0xd5, 0xd6, 0x9e, 0xe0, 0xef, 0x4e, 0x0e, 0x57, 0x2e, 0xc6, 0xb4,
-	0x49, 0xd7, 0x59, 0x45, 0x04, 0xc5, 0xf4, 0x58, 0x58, 0x98, 0x3f, 0xe4, 0xc0, 0xc4, 0x36, 0xd1,
-	0xb9, 0x5e, 0xa8, 0xf3, 0xde, 0x96, 0x3d, 0x9b, 0xbd, 0xed, 0xf4, 0x85, 0xb8, 0x01, 0xf2, 0xd0,
-	0xc2, 0x4d, 0x9b, 0x7a, 0xf5, 0x37, 0xe8, 0xa6, 0xc4, 0x6d, 0xd6, 0xdf, 0x4f, 0xe8, 0xd6, 0x16,
-	0x16, 0xd3, 0xed, 0x42, 0x52, 0xb7, 0x40, 0x86, 0xca, 0x1c, 0x98, 0x89, 0xbd, 0x86, 0x6a, 0xfd,
-	0x9d, 0xf3, 0x0e, 0xc9, 0x1a, 0xd2, 0x0d, 0x5b, 0x46, 0xda, 0x19, 0x8b, 0xf6, 0x19, 0x98, 0x8b,
-	0x44, 0x23, 0xae, 0x3a, 0xb0, 0x70, 0x33, 0xa1, 0xd9, 0xae, 0xab, 0x76, 0x64, 0xd3, 0x08, 0x0d,
-	0xd9, 0x86, 0x06, 0x66, 0xdb, 0x22, 0xb4, 0x3d, 0x13, 0xc3, 0x2f, 0x90, 0x89, 0x8f, 0xfa, 0x67,
-	0xe2, 0xb5, 0x64, 0x26, 0x52, 0x12, 0x57, 0x1c, 0x6f, 0x7b, 0x4d, 0x8d, 0x06, 0x79, 0x11, 0x64,
-	0x6f, 0xc3, 0x72, 0x4c, 0xc4, 0x3a, 0xbe, 0xce, 0x6e, 0x5f, 0x7c, 0x37, 0x15, 0xdb, 0x0e, 0xc3,
-	0xcf, 0x83, 0xab, 0x59, 0x6d, 0x92, 0xf9, 0xf9, 0xe0, 0x79, 0x39, 0xeb, 0xfb, 0x3a, 0x15, 0x31,
-	0x30, 0x4c, 0xe5, 0xc7, 0x1c, 0x98, 0xdc, 0x26, 0xfa, 0x5d, 0x5b, 0x7b, 0x15, 0x7b, 0xe3, 0x83,
-	0xfe, 0x19, 0x29, 0x26, 0x33, 0x12, 0x09, 0x51, 0xd9, 0x07, 0x73, 0x89, 0x81, 0x97, 0x9a, 0x87,
-	0xe7, 0x39, 0x70, 0x89, 0x9d, 0xac, 0xd0, 0x56, 0x91, 0x79, 0xd7, 0x56, 0xb0, 0xad, 0x19, 0xb6,
-	0xde, 0xef, 0x3a, 0xf6, 0xff, 0x4c, 0x8b, 0xb0, 0x04, 0x0a, 0x2a, 0xbb, 0x42, 0x30, 0xf9, 0x1a,
-	0xc8, 0xd0, 0x1b, 0x7e, 0xbf, 0x0d, 0xc9, 0x53, 0xc1, 0xf0, 0x27, 0xde, 0xe8, 0xfa, 0xa7, 0xfd,
-	0xf3, 0xb7, 0x94, 0xcc, 0x5f, 0x57, 0x01, 0x2b, 0x57, 0xc1, 0x62, 0xaf, 0xf9, 0x70, 0xf7, 0xfb,
-	0x35, 0x0b, 0x0a, 0x2c, 0xef, 0x8e, 0x06, 0x29, 0xda, 0x81, 0x2e, 0xb4, 0x88, 0xf0, 0x0e, 0x18,
-	0x87, 0x4d, 0xda, 0xc0, 0xae, 0x41, 0x5b, 0x7d, 0x45, 0x8f, 0xa0, 0xc2, 0x26, 0xc8, 0x3b, 0x1e,
-	0x03, 0xff, 0x02, 0x28, 0x75, 0xbb, 0xf6, 0xf8, 0xeb, 0x24, 0xb4, 0xf2, 0x0d, 0xd7, 0xdf, 0x65,
-	0xa1, 0x47, 0x94, 0x2c, 0xe4, 0xc5, 0x58, 0xc8, 0xf7, 0xc3, 0x8f, 0xa3, 0x94, 0xcf, 0x95, 0x05,
-	0x30, 0x9f, 0x1a, 0x0a, 0x42, 0x5c, 0xfb, 0x29, 0x0f, 0x86, 0xb6, 0x89, 0x2e, 0xdc, 0x03, 0x85,
-	0xf4, 0x97, 0xd0, 0x4a, 0x37, 0x0f, 0xdb, 0xaf, 0x7d, 0xe2, 0xda, 0xe0, 0xd8, 0xb0, 0x77, 0xf6,
-	0xc1, 0x64, 0xf2, 0x7a, 0xb8, 0xdc, 0x83, 0x24, 0x81, 0x14, 0xdf, 0x1c, 0x14, 0x19, 0x2e, 0xf6,
-	0x15, 0x18, 0x0b, 0x8f, 0xfc, 0x2b, 0x3d, 0xac, 0x03, 0x90, 0xf8, 0xc6, 0x00, 0xa0, 0x90, 0xfd,
-	0x1e, 0x28, 0xa4, 0x8f, 0xc8, 0x5e, 0xea, 0xa5, 0xb0, 0x3d, 0xd5, 0xeb, 0x76, 0x02, 0x28, 0x00,
-	0xc4, 0x76, 0xea, 0xd7, 0x7b, 0x30, 0x44, 0x30, 0x71, 0x75, 0x20, 0x58, 0xb8, 0xc6, 0xf7, 0x59,
-	0xb0, 0xd0, 0x7d, 0x1b, 0x7a, 0xbb, 0x57, 0xce, 0xbb, 0x59, 0x89, 0x1b, 0x2f, 0x62, 0x15, 0x7a,
-	0xd4, 0x00, 0xe7, 0x12, 0xdd, 0xb8, 0xd4, 0x2b, 0xa0, 0x18, 0x50, 0xac, 0x0e, 0x08, 0x0c, 0x56,
-	0x12, 0x47, 0xbe, 0x66, 0xbd, 0x57, 0xbb, 0xf5, 0xf8, 0xa8, 0x94, 0x7d, 0x72, 0x54, 0xca, 0xfe,
-	0x79, 0x54, 0xca, 0x3e, 0x38, 0x2e, 0x65, 0x9e, 0x1c, 0x97, 0x32, 0xbf, 0x1f, 0x97, 0x32, 0x5f,
-	0x5e, 0xeb, 0x79, 0x5f, 0x8f, 0x9a, 0xd1, 0xbb, 0xb9, 0x2b, 0x79, 0xef, 0x1c, 0x78, 0xeb, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x9c, 0x3c, 0x78, 0x8e, 0x11, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x3d, 0x6c, 0x1c, 0x45,
+	0x14, 0xf6, 0x9e, 0xed, 0xb3, 0xef, 0x39, 0xb1, 0xe3, 0x75, 0x7e, 0xce, 0x9b, 0xe4, 0x2e, 0x5c,
+	0x42, 0x1c, 0x99, 0x78, 0x8f, 0x38, 0x28, 0x80, 0x89, 0x10, 0x76, 0x9c, 0x40, 0x08, 0x27, 0x59,
+	0x1b, 0x42, 0x81, 0x90, 0x4e, 0xb3, 0xbb, 0xe3, 0xbd, 0x95, 0x77, 0x77, 0x36, 0x3b, 0x73, 0x49,
+	0xae, 0x40, 0x42, 0x54, 0x88, 0x86, 0x08, 0x51, 0x50, 0xa6, 0xa4, 0x4c, 0x91, 0x96, 0x12, 0x29,
+	0xa2, 0x8a, 0x52, 0x21, 0x8a, 0x04, 0x25, 0x45, 0xa8, 0x68, 0x91, 0x68, 0x40, 0xfb, 0xff, 0x73,
+	0x7b, 0x7f, 0x89, 0x53, 0x80, 0x68, 0xec, 0xbb, 0x99, 0xef, 0x7d, 0x6f, 0xde, 0x7b, 0xdf, 0x9b,
+	0x9f, 0x83, 0xaa, 0x42, 0xa8, 0x49, 0x68, 0x9d, 0x32, 0xb4, 0xa3, 0x5b, 0x5a, 0xfd, 0xc6, 0x19,
+	0x19, 0x33, 0x74, 0xa6, 0xce, 0x6e, 0x89, 0xb6, 0x43, 0x18, 0xe1, 0x0f, 0xfa, 0x00, 0x31, 0x00,
+	0x88, 0x01, 0x40, 0x58, 0xd4, 0x08, 0xd1, 0x0c, 0x5c, 0xf7, 0x50, 0x72, 0x7b, 0xbb, 0x8e, 0xac,
+	0x8e, 0x6f, 0x22, 0x54, 0xb3, 0x53, 0x4c, 0x37, 0x31, 0x65, 0xc8, 0xb4, 0x03, 0xc0, 0x7e, 0x8d,
+	0x68, 0xc4, 0xfb, 0x58, 0x77, 0x3f, 0x05, 0xa3, 0x8b, 0xbe, 0xa7, 0xa6, 0x3f, 0x11, 0xb8, 0xf5,
+	0xa7, 0x2a, 0xc1, 0x2a, 0x65, 0x44, 0x71, 0xb4, 0x44, 0x85, 0xe8, 0x56, 0x30, 0x7f, 0xa2, 0x47,
+	0x14, 0xe1, 0xa2, 0x7d, 0xd4, 0xa1, 0x00, 0x65, 0x52, 0x17, 0xe1, 0xfe, 0x0b, 0x26, 0xe6, 0x91,
+	0xa9, 0x5b, 0xa4, 0xee, 0xfd, 0xf5, 0x87, 0x6a, 0x3f, 0x16, 0x81, 0x6f, 0x50, 0xed, 0x82, 0x83,
+	0x11, 0xc3, 0x9f, 0x20, 0x43, 0x57, 0x11, 0x23, 0x0e, 0xbf, 0x05, 0x33, 0x2a, 0xa6, 0x8a, 0xa3,
+	0xdb, 0x4c, 0x27, 0x56, 0x99, 0x3b, 0xc6, 0x9d, 0x9a, 0x59, 0x3d, 0x2e, 0xe6, 0xe7, 0x48, 0xdc,
+	0x8c, 0xa1, 0x1b, 0xa5, 0xfb, 0x8f, 0xaa, 0x63, 0x3f, 0x3c, 0xbb, 0xbb, 0xcc, 0x49, 0x49, 0x0a,
+	0x5e, 0x02, 0x50, 0x88, 0x69, 0xea, 0x94, 0xba, 0x84, 0x05, 0x8f, 0x70, 0xa9, 0x17, 0xe1, 0x85,
+	0x08, 0x29, 0x21, 0x86, 0x69, 0x92, 0x34, 0xc1, 0xc2, 0x1b, 0xb0, 0x60, 0xea, 0x56, 0x93, 0x62,
+	0x63, 0xbb, 0xa9, 0x62, 0x03, 0x6b, 0xc8, 0x5b, 0xed, 0xf8, 0x31, 0xee, 0x54, 0x69, 0xe3, 0xbc,
+	0x6b, 0xf3, 0xeb, 0xa3, 0xea, 0x49, 0x4d, 0x67, 0xad, 0xb6, 0x2c, 0x2a, 0xc4, 0x0c, 0x92, 0x1d,
+	0xfc, 0x5b, 0xa1, 0xea, 0x4e, 0x9d, 0x75, 0x6c, 0x4c, 0xc5, 0xcb, 0x16, 0x7b, 0x78, 0x6f, 0x05,
+	0x82, 0xd5, 0x5c, 0xb6, 0x98, 0x34, 0x6f, 0xea, 0xd6, 0x55, 0x6c, 0x6c, 0x6f, 0x46, 0xb4, 0xfc,
+	0x45, 0x98, 0x0f, 0x9c, 0x10, 0xa7, 0x89, 0x54, 0xd5, 0xc1, 0x94, 0x96, 0x27, 0x3c, 0x5f, 0xe5,
+	0x87, 0xf7, 0x56, 0xf6, 0x07, 0xd6, 0xeb, 0xfe, 0xcc, 0x55, 0xe6, 0xe8, 0x96, 0x26, 0xed, 0x8b,
+	0x4c, 0x82, 0x71, 0x97, 0xe6, 0x46, 0x98, 0xe7, 0x88, 0x66, 0x72, 0x10, 0x4d, 0x64, 0x12, 0xd2,
+	0x5c, 0x82, 0xa2, 0xdd, 0x96, 0x77, 0x70, 0xa7, 0x5c, 0xf4, 0x72, 0xb9, 0x5f, 0xf4, 0xd5, 0x28,
+	0x86, 0x6a, 0x14, 0xd7, 0xad, 0xce, 0x46, 0xf9, 0xe7, 0x98, 0x51, 0x71, 0x3a, 0x36, 0x23, 0xe2,
+	0x56, 0x5b, 0xbe, 0x82, 0x3b, 0x52, 0x60, 0xcd, 0xaf, 0xc1, 0xe4, 0x0d, 0x64, 0xb4, 0x71, 0x79,
+	0xca, 0xa3, 0x59, 0x0c, 0x4b, 0xe2, 0x4a, 0x30, 0x51, 0x0f, 0x3d, 0x55, 0x59, 0xdf, 0x84, 0x3f,
+	0x0d, 0x13, 0xdb, 0x0e, 0x31, 0xcb, 0xd3, 0x03, 0x56, 0xef, 0xa1, 0xf8, 0x75, 0x98, 0x73, 0xb0,
+	0x81, 0x3a, 0x38, 0x0e, 0xbb, 0x34, 0xc0, 0x70, 0x36, 0x30, 0x08, 0x83, 0x7e, 0x1f, 0x78, 0xa5,
+	0x85, 0x0c, 0x03, 0x5b, 0x5a, 0x82, 0x05, 0x06, 0xb0, 0xcc, 0xc7, 0x36, 0x21, 0xd1, 0x21, 0x98,
+	0x92, 0x0d, 0xda, 0x74, 0xd3, 0x37, 0xe3, 0x5a, 0x4b, 0x45, 0xd9, 0xa0, 0x57, 0x70, 0x87, 0x3f,
+	0x0c, 0x25, 0x77, 0xc2, 0x76, 0x08, 0xd9, 0x2e, 0xef, 0xf1, 0xa6, 0xa6, 0x65, 0x83, 0x6e, 0xb9,
+	0xdf, 0xd7, 0xe6, 0xbf, 0xba, 0x53, 0x1d, 0xfb, 0xfd, 0x4e, 0x75, 0xec, 0xcb, 0x67, 0x77, 0x97,
+	0xbd, 0xa0, 0x6a, 0x47, 0x40, 0xe8, 0x6e, 0x1f, 0x09, 0x53, 0x9b, 0x58, 0x14, 0xd7, 0xfe, 0x9e,
+	0x84, 0x83, 0xdd, 0xd3, 0xae, 0xae, 0xfe, 0xef, 0xb0, 0xfc, 0x0e, 0xeb, 0x6e, 0x8d, 0x89, 0x17,
+	0x68, 0x8d, 0xc9, 0xdd, 0x69, 0x8d, 0xe2, 0xe8, 0xad, 0x91, 0x23, 0xf6, 0xa9, 0x5d, 0x11, 0xfb,
+	0xf4, 0x0b, 0x89, 0xbd, 0xd4, 0x5b, 0xec, 0x90, 0x11, 0xfb, 0x66, 0x52, 0xec, 0xdd, 0x75, 0xf9,
+	0xfa, 0xd9, 0xdd, 0xe5, 0x57, 0x12, 0x65, 0xce, 0x97, 0x79, 0xed, 0x18, 0x54, 0xf2, 0x67, 0xa2,
+	0x1e, 0xf9, 0x6b, 0x02, 0xf6, 0x35, 0xa8, 0x76, 0x51, 0xd5, 0xd9, 0xcb, 0x3c, 0x7f, 0x72, 0xb5,
+	0x55, 0x18, 0x59, 0x5b, 0x08, 0xe6, 0xe2, 0xf6, 0x68, 0x3a, 0x88, 0xe1, 0xa0, 0x19, 0xde, 0x1a,
+	0xb2, 0x11, 0x36, 0xb1, 0x92, 0x68, 0x84, 0x4d, 0xac, 0x48, 0xb3, 0x4a, 0xaa, 0x17, 0xf9, 0x56,
+	0x7e, 0xcf, 0x4d, 0x8c, 0xe4, 0x66, 0xa8, 0x7e, 0xcb, 0x11, 0xe9, 0xe4, 0xae, 0x88, 0xb4, 0xf8,
+	0x42, 0x22, 0x9d, 0xea, 0x2d, 0xd2, 0xe9, 0x8c, 0x48, 0xdf, 0x1d, 0x2c, 0xd2, 0xc3, 0x69, 0x91,
+	0xa6, 0x74, 0x56, 0x13, 0xa0, 0x9c, 0x1d, 0x8b, 0x84, 0xf9, 0x5d, 0x01, 0x66, 0x1a, 0x54, 0x0b,
+	0xf2, 0x85, 0xf3, 0xcf, 0x7f, 0x6e, 0x77, 0xce, 0xff, 0xd1, 0x85, 0x78, 0x1e, 0x8a, 0xc8, 0x24,
+	0x6d, 0x8b, 0x95, 0xc7, 0x47, 0xd8, 0x9d, 0x02, 0x9b, 0xb5, 0xb7, 0x53, 0x79, 0xeb, 0x0a, 0xcb,
+	0xcd, 0xdb, 0xc1, 0x74, 0xde, 0xc2, 0x34, 0xd4, 0x0e, 0xc0, 0x42, 0xe2, 0x6b, 0x94, 0xad, 0x3f,
+	0x0b, 0xde, 0x45, 0x72, 0x03, 0x6b, 0xba, 0x25, 0x61, 0x75, 0x97, 0x93, 0xf6, 0x11, 0x1c, 0x88,
+	0x93, 0x46, 0x1d, 0x65, 0xe8, 0xc4, 0x2d, 0x44, 0x66, 0x57, 0x1d, 0x25, 0x97, 0x4d, 0xa5, 0x2c,
+	0x62, 0x1b, 0x1f, 0x9a, 0x6d, 0x93, 0xb2, 0xee, 0x4a, 0x4c, 0x3c, 0x47, 0x25, 0xde, 0x1b, 0x5c,
+	0x89, 0xa3, 0xe9, 0x4a, 0x64, 0x52, 0x5c, 0xb3, 0x41, 0xe8, 0x1e, 0x0d, 0xeb, 0xc2, 0x4b, 0xde,
+	0x86, 0x65, 0x1b, 0xd8, 0xed, 0xf8, 0xa6, 0xfb, 0x42, 0x09, 0x76, 0x53, 0xa1, 0xeb, 0x54, 0xfc,
+	0x38, 0x7c, 0xbe, 0x6c, 0xec, 0x75, 0xd7, 0x79, 0xfb, 0x71, 0x95, 0xf3, 0xd7, 0x3a, 0x1b, 0x33,
+	0xb8, 0x98, 0xda, 0xf7, 0x05, 0xd8, 0xdb, 0xa0, 0xda, 0x35, 0x4b, 0xfd, 0x2f, 0xf6, 0xc6, 0x3b,
+	0x83, 0x2b, 0x52, 0x4e, 0x57, 0x24, 0x4e, 0x44, 0x6d, 0x07, 0x0e, 0xa4, 0x06, 0x5e, 0x6a, 0x1d,
+	0x1e, 0x17, 0xe0, 0x88, 0x7b, 0xba, 0x22, 0x4b, 0xc1, 0xc6, 0x35, 0x4b, 0x26, 0x96, 0xaa, 0x5b,
+	0xda, 0xa0, 0x27, 0xcb, 0xbf, 0xb3, 0x2c, 0xfc, 0x12, 0xcc, 0x29, 0x0e, 0xf6, 0xe2, 0x6a, 0xb6,
+	0xb0, 0xae, 0xb5, 0xfc, 0x7e, 0x1b, 0x97, 0x66, 0xc3, 0xe1, 0x0f, 0xbc, 0xd1, 0xb5, 0x0f, 0x07,
+	0xd7, 0x6f, 0x29, 0x73, 0x71, 0xe9, 0x95, 0xc0, 0xda, 0x49, 0x38, 0xd1, 0x6f, 0x3e, 0xda, 0xfd,
+	0xfe, 0xe0, 0xbc, 0x5d, 0x51, 0x22, 0x0c, 0x31, 0x7c, 0x81, 0x58, 0xd4, 0xbf, 0x4a, 0xe6, 0x67,
+	0x8e, 0x1b, 0x39, 0x73, 0x0d, 0x00, 0x0b, 0xdf, 0x6c, 0x06, 0xb7, 0xda, 0xc2, 0x73, 0xdd, 0x6a,
+	0x4b, 0x16, 0xbe, 0xb9, 0xe5, 0x11, 0xac, 0xad, 0x0f, 0x3e, 0x35, 0x2b, 0xe9, 0x0c, 0x65, 0x03,
+	0xab, 0x1d, 0x85, 0xc3, 0x39, 0xc3, 0x51, 0x3e, 0x7e, 0xe2, 0x60, 0xce, 0xed, 0x03, 0x5b, 0x45,
+	0x0c, 0x6f, 0x21, 0x07, 0x99, 0x94, 0x3f, 0x07, 0x25, 0xd4, 0x66, 0x2d, 0xe2, 0xe8, 0xac, 0x33,
+	0x30, 0x07, 0x31, 0x94, 0x5f, 0x87, 0xa2, 0xed, 0x31, 0x04, 0x81, 0x57, 0x7a, 0x5d, 0x03, 0x7d,
+	0x3f, 0x29, 0xed, 0xf8, 0x86, 0x6b, 0x6f, 0xba, 0x81, 0xc6, 0x94, 0x6e, 0x80, 0x27, 0x12, 0x01,
+	0xde, 0x8a, 0x7e, 0x50, 0xc9, 0xac, 0xb9, 0xb6, 0x08, 0x87, 0x32, 0x43, 0x61, 0x88, 0xab, 0xdf,
+	0x4e, 0xc3, 0x78, 0x83, 0x6a, 0xfc, 0x75, 0x98, 0xcb, 0xfe, 0x7a, 0xb2, 0xdc, 0x6b, 0x85, 0xdd,
+	0x57, 0x61, 0x61, 0x75, 0x78, 0x6c, 0xb4, 0x97, 0x7c, 0x0e, 0x0b, 0x79, 0x4f, 0x4a, 0x71, 0x78,
+	0x2a, 0x17, 0x2f, 0x9c, 0x1b, 0x0d, 0x1f, 0xb9, 0xdf, 0x81, 0xbd, 0xe9, 0xdb, 0xfa, 0xa9, 0x3e,
+	0x44, 0x29, 0xa4, 0xf0, 0xfa, 0xb0, 0xc8, 0xc8, 0xd9, 0x67, 0x30, 0x1d, 0xdd, 0xc0, 0x8e, 0xf7,
+	0xb1, 0x0e, 0x41, 0xc2, 0x6b, 0x43, 0x80, 0x22, 0xf6, 0xeb, 0x30, 0x97, 0xbd, 0xb1, 0xf4, 0x2b,
+	0x5e, 0x06, 0x2b, 0xac, 0x0e, 0x8f, 0x8d, 0x5c, 0xca, 0x00, 0x89, 0x83, 0xf3, 0xd5, 0x3e, 0x0c,
+	0x31, 0x4c, 0x58, 0x19, 0x0a, 0x16, 0xf9, 0xf8, 0x86, 0x83, 0xc5, 0xde, 0xa7, 0xc2, 0x1b, 0xfd,
+	0xea, 0xde, 0xcb, 0x4a, 0x38, 0xff, 0x3c, 0x56, 0xd1, 0x8a, 0x18, 0xec, 0xeb, 0xda, 0x1c, 0xfb,
+	0x55, 0x2a, 0x0b, 0x16, 0xce, 0x8e, 0x00, 0x8e, 0xbc, 0xb6, 0x60, 0x4f, 0x6a, 0x0b, 0x5a, 0xea,
+	0x97, 0xc6, 0x04, 0x50, 0xa8, 0x0f, 0x09, 0x0c, 0x3d, 0x09, 0x93, 0x5f, 0xb8, 0x1b, 0xce, 0xc6,
+	0xa5, 0xfb, 0x4f, 0x2a, 0xdc, 0x83, 0x27, 0x15, 0xee, 0xb7, 0x27, 0x15, 0xee, 0xf6, 0xd3, 0xca,
+	0xd8, 0x83, 0xa7, 0x95, 0xb1, 0x5f, 0x9e, 0x56, 0xc6, 0x3e, 0x3d, 0xdd, 0xf7, 0xd1, 0x16, 0xef,
+	0x40, 0xde, 0xf3, 0x4d, 0x2e, 0x7a, 0x9b, 0xfa, 0xd9, 0x7f, 0x06, 0x00, 0xb0, 0x9f, 0x1d, 0x12,
+	0xb7, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -756,6 +931,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// CreateValidator defines a method for creating a new validator.
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
+	// CreateValidatorSelf defines a method for creating a new validator signed by its operator, without
+	// a governance proposal. It is only available if enabled in the params.
+	CreateValidatorSelf(ctx context.Context, in *MsgCreateValidatorSelf, opts ...grpc.CallOption) (*MsgCreateValidatorSelfResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
 	// Delegate defines a method for performing a delegation of coins
@@ -772,6 +950,8 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -795,6 +975,15 @@ func (c *msgClient) CreateValidator(ctx context.Context, in *MsgCreateValidator,
 	return out, nil
 }
 
+func (c *msgClient) CreateValidatorSelf(ctx context.Context, in *MsgCreateValidatorSelf, opts ...grpc.CallOption) (*MsgCreateValidatorSelfResponse, error) {
+	out := new(MsgCreateValidatorSelfResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/CreateValidatorSelf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error) {
 	out := new(MsgEditValidatorResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/EditValidator", in, out, opts...)
@@ -840,6 +1029,15 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -853,6 +1051,9 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
+	// CreateValidatorSelf defines a method for creating a new validator signed by its operator, without
+	// a governance proposal. It is only available if enabled in the params.
+	CreateValidatorSelf(context.Context, *MsgCreateValidatorSelf) (*MsgCreateValidatorSelfResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
 	// Delegate defines a method for performing a delegation of coins
@@ -869,6 +1070,8 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -882,6 +1085,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateValidator(ctx context.Context, req *MsgCreateValidator) (*MsgCreateValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateValidator not implemented")
 }
+func (*UnimplementedMsgServer) CreateValidatorSelf(ctx context.Context, req *MsgCreateValidatorSelf) (*MsgCreateValidatorSelfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateValidatorSelf not implemented")
+}
 func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
@@ -897,6 +1103,9 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -923,6 +1132,24 @@ func _Msg_CreateValidator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateValidatorSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateValidatorSelf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateValidatorSelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/CreateValidatorSelf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateValidatorSelf(ctx, req.(*MsgCreateValidatorSelf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgEditValidator)
 	if err := dec(in); err != nil {
@@ -1013,6 +1240,24 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1039,6 +1284,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateValidator",
 			Handler:    _Msg_CreateValidator_Handler,
 		},
+		{
+			MethodName: "CreateValidatorSelf",
+			Handler:    _Msg_CreateValidatorSelf_Handler,
+		},
 		{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
@@ -1059,6 +1308,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1215,7 +1468,7 @@ func (m *MsgCreateValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateValidatorSelf) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1225,12 +1478,12 @@ func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateValidatorSelf) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateValidatorSelf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1240,60 +1493,78 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.BlsProof)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsProof)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x52
 	}
 	if len(m.BlsKey) > 0 {
 		i -= len(m.BlsKey)
 		copy(dAtA[i:], m.BlsKey)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsKey)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x4a
 	}
 	if len(m.ChallengerAddress) > 0 {
 		i -= len(m.ChallengerAddress)
 		copy(dAtA[i:], m.ChallengerAddress)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ChallengerAddress)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
 	if len(m.RelayerAddress) > 0 {
 		i -= len(m.RelayerAddress)
 		copy(dAtA[i:], m.RelayerAddress)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.RelayerAddress)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
-	if m.MinSelfDelegation != nil {
-		{
-			size := m.MinSelfDelegation.Size()
-			i -= size
-			if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x22
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.CommissionRate != nil {
+	i--
+	dAtA[i] = 0x32
+	if m.Pubkey != nil {
 		{
-			size := m.CommissionRate.Size()
-			i -= size
-			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1307,7 +1578,7 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateValidatorSelfResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1317,12 +1588,12 @@ func (m *MsgEditValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEditValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateValidatorSelfResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateValidatorSelfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1330,7 +1601,7 @@ func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1340,44 +1611,159 @@ func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.BlsProof) > 0 {
+		i -= len(m.BlsProof)
+		copy(dAtA[i:], m.BlsProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsProof)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsKey)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinSelfDelegation != nil {
+		{
+			size := m.MinSelfDelegation.Size()
+			i -= size
+			if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1474,12 +1860,12 @@ func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n8
-	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1552,12 +1938,12 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1638,6 +2024,71 @@ func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubkey != nil {
+		{
+			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1770,6 +2221,56 @@ func (m *MsgCreateValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateValidatorSelf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlsProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateValidatorSelfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgEditValidator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1941,6 +2442,32 @@ func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1960,18 +2487,511 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateValidatorSelf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1994,10 +3014,10 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateValidatorSelf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateValidatorSelf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2101,38 +3121,6 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -2164,7 +3152,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
@@ -2200,7 +3188,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -2233,39 +3221,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
@@ -2297,7 +3253,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
 			}
@@ -2329,7 +3285,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
 			}
@@ -2361,7 +3317,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.BlsKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlsProof", wireType)
 			}
@@ -2414,7 +3370,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateValidatorSelfResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,10 +3393,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateValidatorSelfResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateValidatorSelfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3734,6 +4690,174 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &types.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0